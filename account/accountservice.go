@@ -1,24 +1,31 @@
 package account
 
 import (
+	"bytes"
+	"errors"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/account/key"
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/contract/name"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
 
+var errNoAuditLog = errors.New("audit log of the signing is not available")
+
 type AccountService struct {
 	*component.BaseComponent
 	cfg         *cfg.Config
 	sdb         *state.ChainStateDB
 	ks          *key.Store
+	audit       *auditLog
 	accountLock sync.RWMutex
 	accounts    []*types.Account
 	testConfig  bool
@@ -30,13 +37,28 @@ func NewAccountService(cfg *cfg.Config, sdb *state.ChainStateDB) *AccountService
 		cfg: cfg,
 		sdb: sdb,
 	}
-	actor.BaseComponent = component.NewBaseComponent(message.AccountsSvc, actor, log.NewLogger("account"))
+	actor.BaseComponent = component.NewBaseComponent(message.AccountsSvc, actor, logctl.NewLogger("account"))
 
 	return actor
 }
 
 func (as *AccountService) BeforeStart() {
 	as.ks = key.NewStore(as.cfg.DataDir, as.cfg.Account.UnlockTimeout)
+	as.ks.SetMaxUnlockDuration(time.Duration(as.cfg.Account.MaxUnlockDuration) * time.Second)
+	err := as.ks.SetArgon2Params(key.Argon2Params{
+		Time:    uint32(as.cfg.Account.Argon2Time),
+		Memory:  uint32(as.cfg.Account.Argon2Memory),
+		Threads: uint8(as.cfg.Account.Argon2Threads),
+	})
+	if err != nil {
+		as.Logger.Error().Err(err).Msg("invalid argon2id params of the keystore, using the default")
+	}
+
+	as.audit, err = newAuditLog(filepath.Join(as.cfg.DataDir, "audit"),
+		int64(as.cfg.Account.AuditMaxSize)*1024*1024, int(as.cfg.Account.AuditMaxFiles))
+	if err != nil {
+		as.Logger.Error().Err(err).Msg("could not open the audit log of the signing")
+	}
 
 	as.accounts = []*types.Account{}
 	addresses, err := as.ks.GetAddresses()
@@ -52,6 +74,9 @@ func (as *AccountService) AfterStart() {}
 
 func (as *AccountService) BeforeStop() {
 	as.ks.CloseStore()
+	if as.audit != nil {
+		as.audit.Close()
+	}
 	as.accounts = nil
 }
 
@@ -63,7 +88,11 @@ func (as *AccountService) resolveName(namedAddress []byte) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return name.GetAddress(scs, namedAddress), nil
+	rsp, err := as.RequestToFuture(message.ChainSvc, &message.GetBestBlockNo{}, time.Second*2).Result()
+	if err != nil {
+		return nil, err
+	}
+	return name.GetAddress(scs, namedAddress, rsp.(message.GetBestBlockNoRsp).BlockNo), nil
 }
 
 func (as *AccountService) Receive(context actor.Context) {
@@ -101,14 +130,71 @@ func (as *AccountService) Receive(context actor.Context) {
 				})
 			}
 		}
-		account, err := as.unlockAccount(actualAddress, msg.Passphrase)
+		account, err := as.unlockAccount(actualAddress, msg.Passphrase, msg.Timeout)
 		context.Respond(&message.AccountRsp{Account: account, Err: err})
+	case *message.GetUnlockStatus:
+		actualAddress := msg.Account.Address
+		var err error
+		if len(actualAddress) == types.NameLength {
+			actualAddress, err = as.resolveName(actualAddress)
+			if err != nil {
+				context.Respond(&message.GetUnlockStatusRsp{Err: err})
+				return
+			}
+		}
+		context.Respond(&message.GetUnlockStatusRsp{Status: as.getUnlockStatus(actualAddress)})
 	case *message.ImportAccount:
-		account, err := as.importAccount(msg.Wif, msg.OldPass, msg.NewPass)
+		account, err := as.importAccount(msg.Wif, msg.OldPass, msg.NewPass, msg.Keystore)
 		context.Respond(&message.ImportAccountRsp{Account: account, Err: err})
 	case *message.ExportAccount:
-		wif, err := as.exportAccount(msg.Account.Address, msg.Pass)
+		wif, err := as.exportAccount(msg.Account.Address, msg.Pass, msg.Kdf)
 		context.Respond(&message.ExportAccountRsp{Wif: wif, Err: err})
+	case *message.CreateHDWallet:
+		mnemonic, err := as.ks.CreateHDWallet(msg.Mnemonic, msg.SeedPass, msg.Words, msg.Pass)
+		context.Respond(&message.HDWalletRsp{Mnemonic: mnemonic, Err: err})
+	case *message.ExportMnemonic:
+		mnemonic, err := as.ks.ExportMnemonic(msg.Pass)
+		context.Respond(&message.HDWalletRsp{Mnemonic: mnemonic, Err: err})
+	case *message.DeriveAccounts:
+		accounts, err := as.deriveAccounts(msg.Pass, msg.Account, msg.Index, msg.Count)
+		context.Respond(&message.HDAccountsRsp{Accounts: accounts, Err: err})
+	case *message.GetHDAccounts:
+		accounts, err := as.ks.GetHDAccounts()
+		context.Respond(&message.HDAccountsRsp{Accounts: toHDAccountList(accounts), Err: err})
+	case *message.SetAlias:
+		err := as.ks.SetAlias(msg.Alias.GetLabel(), msg.Alias.GetAddress())
+		if err != nil {
+			context.Respond(&message.AliasRsp{Err: err})
+			return
+		}
+		context.Respond(&message.AliasRsp{Alias: msg.Alias})
+	case *message.DeleteAlias:
+		address, err := as.ks.DeleteAlias(msg.Label)
+		if err != nil {
+			context.Respond(&message.AliasRsp{Err: err})
+			return
+		}
+		context.Respond(&message.AliasRsp{Alias: &types.Alias{Label: msg.Label, Address: address}})
+	case *message.GetAliases:
+		aliases, err := as.ks.GetAliases()
+		context.Respond(&message.AliasesRsp{Aliases: toAliasList(aliases), Err: err})
+	case *message.AddWatchAccount:
+		if err := as.ks.AddWatchAddress(msg.Address); err != nil {
+			context.Respond(&message.WatchAccountRsp{Err: err})
+			return
+		}
+		account, err := as.getWatchAccount(msg.Address)
+		context.Respond(&message.WatchAccountRsp{Account: account, Err: err})
+	case *message.RemoveWatchAccount:
+		err := as.ks.RemoveWatchAddress(msg.Address)
+		if err != nil {
+			context.Respond(&message.WatchAccountRsp{Err: err})
+			return
+		}
+		context.Respond(&message.WatchAccountRsp{Account: &types.WatchAccount{Address: msg.Address}})
+	case *message.GetWatchAccounts:
+		accounts, err := as.getWatchAccounts()
+		context.Respond(&message.WatchAccountsRsp{Accounts: accounts, Err: err})
 	case *message.SignTx:
 		var err error
 		actualAddress := msg.Tx.GetBody().GetAccount()
@@ -123,6 +209,13 @@ func (as *AccountService) Receive(context actor.Context) {
 		if err != nil {
 			context.Respond(&message.SignTxRsp{Tx: nil, Err: err})
 		}
+	case *message.GetSignAudits:
+		if as.audit == nil {
+			context.Respond(&message.SignAuditsRsp{Err: errNoAuditLog})
+			return
+		}
+		audits, err := as.audit.Query(msg.Query)
+		context.Respond(&message.SignAuditsRsp{Audits: &types.SignAuditList{Audits: audits}, Err: err})
 	case *message.VerifyTx:
 		err := as.verifyTx(msg.Tx)
 		if err != nil {
@@ -155,8 +248,14 @@ func (as *AccountService) createAccount(passphrase string) (*types.Account, erro
 	return account, nil
 }
 
-func (as *AccountService) importAccount(wif []byte, old string, new string) (*types.Account, error) {
-	address, err := as.ks.ImportKey(wif, old, new)
+func (as *AccountService) importAccount(wif []byte, old string, new string, keystore bool) (*types.Account, error) {
+	var address key.Address
+	var err error
+	if keystore {
+		address, err = as.ks.ImportKeystore(wif, old, new)
+	} else {
+		address, err = as.ks.ImportKey(wif, old, new)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -170,16 +269,88 @@ func (as *AccountService) importAccount(wif []byte, old string, new string) (*ty
 	return account, nil
 }
 
-func (as *AccountService) exportAccount(address []byte, pass string) ([]byte, error) {
-	wif, err := as.ks.ExportKey(address, pass)
+func (as *AccountService) exportAccount(address []byte, pass string, kdf string) ([]byte, error) {
+	var wif []byte
+	var err error
+	if kdf != "" {
+		wif, err = as.ks.ExportKeystore(address, pass, kdf)
+	} else {
+		wif, err = as.ks.ExportKey(address, pass)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return wif, nil
 }
 
-func (as *AccountService) unlockAccount(address []byte, passphrase string) (*types.Account, error) {
-	addr, err := as.ks.Unlock(address, passphrase)
+func (as *AccountService) deriveAccounts(pass string, account, index, count uint32) (*types.HDAccountList, error) {
+	as.accountLock.Lock()
+	defer as.accountLock.Unlock()
+	derived, err := as.ks.DeriveHDAccounts(pass, account, index, count)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range derived {
+		if !as.hasAccount(d.Address) {
+			as.accounts = append(as.accounts, types.NewAccount(d.Address))
+		}
+	}
+	return toHDAccountList(derived), nil
+}
+
+func (as *AccountService) hasAccount(address []byte) bool {
+	for _, a := range as.accounts {
+		if bytes.Equal(a.Address, address) {
+			return true
+		}
+	}
+	return false
+}
+
+func toHDAccountList(hdAccounts []*key.HDAccount) *types.HDAccountList {
+	list := &types.HDAccountList{}
+	for _, a := range hdAccounts {
+		list.Accounts = append(list.Accounts, &types.HDAccount{Account: types.NewAccount(a.Address), Path: a.Path})
+	}
+	return list
+}
+
+func toAliasList(aliases []*key.Alias) *types.AliasList {
+	list := &types.AliasList{}
+	for _, a := range aliases {
+		list.Aliases = append(list.Aliases, &types.Alias{Label: a.Label, Address: a.Address})
+	}
+	return list
+}
+
+// getWatchAccount returns the watched address with its nonce and balance in
+// the best state.
+func (as *AccountService) getWatchAccount(address []byte) (*types.WatchAccount, error) {
+	st, err := as.sdb.GetStateDB().GetAccountState(types.ToAccountID(address))
+	if err != nil {
+		return nil, err
+	}
+	return &types.WatchAccount{Address: address, Nonce: st.GetNonce(), Balance: st.GetBalance()}, nil
+}
+
+func (as *AccountService) getWatchAccounts() (*types.WatchAccountList, error) {
+	addresses, err := as.ks.GetWatchAddresses()
+	if err != nil {
+		return nil, err
+	}
+	list := &types.WatchAccountList{}
+	for _, address := range addresses {
+		account, err := as.getWatchAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		list.Accounts = append(list.Accounts, account)
+	}
+	return list, nil
+}
+
+func (as *AccountService) unlockAccount(address []byte, passphrase string, timeout time.Duration) (*types.Account, error) {
+	addr, err := as.ks.UnlockFor(address, passphrase, timeout)
 	if err != nil {
 		as.Warn().Err(err).Msg("could not find the key")
 		return nil, err
@@ -187,6 +358,18 @@ func (as *AccountService) unlockAccount(address []byte, passphrase string) (*typ
 	return &types.Account{Address: addr}, nil
 }
 
+func (as *AccountService) getUnlockStatus(address []byte) *types.UnlockStatus {
+	s := as.ks.GetUnlockStatus(address)
+	status := &types.UnlockStatus{Account: types.NewAccount(address), Unlocked: s.Unlocked}
+	if s.Unlocked {
+		status.UnlockedAt = s.UnlockedAt.UnixNano()
+	}
+	if !s.ExpireAt.IsZero() {
+		status.ExpireAt = s.ExpireAt.UnixNano()
+	}
+	return status
+}
+
 func (as *AccountService) lockAccount(address []byte, passphrase string) (*types.Account, error) {
 	addr, err := as.ks.Lock(address, passphrase)
 	if err != nil {
@@ -198,7 +381,7 @@ func (as *AccountService) lockAccount(address []byte, passphrase string) (*types
 
 func (as *AccountService) signTx(c actor.Context, msg *message.SignTx) error {
 	//sign tx
-	prop := actor.FromInstance(NewSigner(as.ks))
+	prop := actor.FromInstance(NewSigner(as.ks, as.audit))
 	signer := c.Spawn(prop)
 	signer.Request(msg, c.Sender())
 	return nil
@@ -84,7 +84,7 @@ func TestNewAccountAndUnlockLock(t *testing.T) {
 	}
 	for i := 0; i < testsize; i++ {
 		passphrase := fmt.Sprintf("test%d", i)
-		account, err := as.unlockAccount(testaccounts[i].Address, passphrase)
+		account, err := as.unlockAccount(testaccounts[i].Address, passphrase, 0)
 		if err != nil || account == nil {
 			t.Errorf("failed to unlock account[%d]:%s", i, err)
 		}
@@ -113,7 +113,7 @@ func TestNewAccountAndUnlockFail(t *testing.T) {
 	}
 	for i := 0; i < testsize; i++ {
 		passphrase := fmt.Sprintf("test_Error%d", i)
-		account, err := as.unlockAccount(testaccounts[i].Address, passphrase)
+		account, err := as.unlockAccount(testaccounts[i].Address, passphrase, 0)
 		if err == nil || account != nil {
 			t.Errorf("should not unlock the account[%d]:%s", i, err)
 		}
@@ -131,7 +131,7 @@ func TestNewAccountUnlockSignVerfiy(t *testing.T) {
 	assert.NoError(t, err, "failed to create account")
 	assert.Equalf(t, types.AddressLength, len(account.Address), "wrong address length : %s", account.Address)
 
-	unlockedAccount, err := as.unlockAccount(account.Address, passphrase)
+	unlockedAccount, err := as.unlockAccount(account.Address, passphrase, 0)
 	if err != nil || unlockedAccount == nil {
 		t.Errorf("failed to unlock account:%s", err)
 		t.FailNow()
@@ -153,7 +153,7 @@ func TestVerfiyFail(t *testing.T) {
 	assert.NoError(t, err, "failed to create account")
 	assert.Equalf(t, types.AddressLength, len(account.Address), "wrong address length : %s", account.Address)
 
-	unlockedAccount, err := as.unlockAccount(account.Address, passphrase)
+	unlockedAccount, err := as.unlockAccount(account.Address, passphrase, 0)
 	if err != nil || unlockedAccount == nil {
 		t.Errorf("failed to unlock account:%s", err)
 		t.FailNow()
@@ -185,3 +185,35 @@ func TestBase58CheckEncoding(t *testing.T) {
 	_, err := types.DecodeAddress("AmJaNDXoPbBRn9XHh9onKbDKuAzj88n5Bzt7KniYA78qUEc5EwBA")
 	assert.NotEmpty(t, err, "decoding address with wrong checksum")
 }
+
+func TestDeriveAccounts(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	_, err := as.ks.CreateHDWallet("", "", 12, "pass")
+	assert.NoError(t, err)
+
+	derived, err := as.deriveAccounts("pass", 0, 0, 2)
+	assert.NoError(t, err)
+	assert.Len(t, derived.Accounts, 2)
+	_, err = as.deriveAccounts("pass", 0, 1, 2)
+	assert.NoError(t, err)
+	assert.Len(t, as.getAccounts(), 3, "derived accounts are listed once")
+
+	_, err = as.deriveAccounts("wrong", 0, 3, 1)
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+}
+
+func TestWatchAccounts(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	cold := make([]byte, types.AddressLength)
+	cold[0] = 2
+	assert.NoError(t, as.ks.AddWatchAddress(cold))
+
+	accounts, err := as.getWatchAccounts()
+	assert.NoError(t, err)
+	if assert.Len(t, accounts.GetAccounts(), 1) {
+		assert.Equal(t, cold, accounts.GetAccounts()[0].GetAddress())
+		assert.Equal(t, uint64(0), accounts.GetAccounts()[0].GetNonce())
+	}
+}
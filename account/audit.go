@@ -0,0 +1,187 @@
+package account
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+)
+
+const auditFileName = "sign.log"
+
+// auditLog appends the records of the signing to a file in JSON lines. The
+// file is rotated to sign.log.1, sign.log.2, ... when it exceeds maxSize if
+// not 0, and the oldest one beyond maxFiles is removed.
+type auditLog struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+type auditRecord struct {
+	Time    time.Time `json:"time"`
+	Account string    `json:"account"`
+	TxHash  string    `json:"txhash"`
+	Client  string    `json:"client,omitempty"`
+	Peer    string    `json:"peer,omitempty"`
+	Error   string    `json:"error,omitempty"`
+}
+
+func newAuditLog(dir string, maxSize int64, maxFiles int) (*auditLog, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	l := &auditLog{dir: dir, maxSize: maxSize, maxFiles: maxFiles}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *auditLog) path(n int) string {
+	if n == 0 {
+		return filepath.Join(l.dir, auditFileName)
+	}
+	return filepath.Join(l.dir, fmt.Sprintf("%s.%d", auditFileName, n))
+}
+
+func (l *auditLog) open() error {
+	file, err := os.OpenFile(l.path(0), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	l.file, l.size = file, info.Size()
+	return nil
+}
+
+// Record appends the record of the signing, and syncs it to the disk.
+func (l *auditLog) Record(audit *types.SignAudit) error {
+	data, err := json.Marshal(&auditRecord{
+		Time:    time.Unix(0, audit.GetTime()).UTC(),
+		Account: types.EncodeAddress(audit.GetAccount()),
+		TxHash:  enc.ToString(audit.GetTxHash()),
+		Client:  audit.GetClient(),
+		Peer:    audit.GetPeer(),
+		Error:   audit.GetError(),
+	})
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.file == nil {
+		return os.ErrClosed
+	}
+	if l.maxSize > 0 && l.size > 0 && l.size+int64(len(data)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+func (l *auditLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file = nil
+	os.Remove(l.path(l.maxFiles))
+	for n := l.maxFiles - 1; n >= 0; n-- {
+		if err := os.Rename(l.path(n), l.path(n+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return l.open()
+}
+
+// Query returns the latest records selected by q in the order of the time.
+func (l *auditLog) Query(q *types.SignAuditQuery) ([]*types.SignAudit, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var audits []*types.SignAudit
+	// from the newest file, whose records are prepended to the older ones
+	for n := 0; n <= l.maxFiles; n++ {
+		selected, err := l.queryFile(l.path(n), q)
+		if os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		audits = append(selected, audits...)
+		if q.GetLimit() != 0 && len(audits) >= int(q.GetLimit()) {
+			break
+		}
+	}
+	if q.GetLimit() != 0 && len(audits) > int(q.GetLimit()) {
+		audits = audits[len(audits)-int(q.GetLimit()):]
+	}
+	return audits, nil
+}
+
+func (l *auditLog) queryFile(path string, q *types.SignAuditQuery) ([]*types.SignAudit, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var audits []*types.SignAudit
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var r auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("broken audit log %s: %s", path, err.Error())
+		}
+		account, err := types.DecodeAddress(r.Account)
+		if err != nil {
+			return nil, fmt.Errorf("broken audit log %s: %s", path, err.Error())
+		}
+		t := r.Time.UnixNano()
+		if (len(q.GetAccount()) != 0 && string(account) != string(q.GetAccount())) ||
+			(q.GetFrom() != 0 && t < q.GetFrom()) || (q.GetTo() != 0 && t > q.GetTo()) {
+			continue
+		}
+		txHash, _ := enc.ToBytes(r.TxHash)
+		audits = append(audits, &types.SignAudit{
+			Time:    t,
+			Account: account,
+			TxHash:  txHash,
+			Client:  r.Client,
+			Peer:    r.Peer,
+			Error:   r.Error,
+		})
+	}
+	return audits, scanner.Err()
+}
+
+func (l *auditLog) Close() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	accounts := [][]byte{make([]byte, types.AddressLength), make([]byte, types.AddressLength)}
+	accounts[0][0], accounts[1][0] = 2, 3
+	// a file holds a record at most
+	l, err := newAuditLog(dir, 200, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		err := l.Record(&types.SignAudit{
+			Time:    now.Add(time.Duration(i) * time.Second).UnixNano(),
+			Account: accounts[i%2],
+			TxHash:  []byte{byte(i)},
+			Client:  "token:0123abcd",
+		})
+		assert.NoError(t, err)
+	}
+	l.Close()
+	assert.Error(t, l.Record(&types.SignAudit{}), "closed")
+
+	l, err = newAuditLog(dir, 200, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer l.Close()
+	audits, err := l.Query(&types.SignAuditQuery{})
+	assert.NoError(t, err)
+	if assert.Len(t, audits, 3, "the older files are removed") {
+		assert.Equal(t, []byte{2}, audits[0].TxHash)
+		assert.Equal(t, []byte{4}, audits[2].TxHash)
+		assert.Equal(t, accounts[0], audits[2].Account)
+		assert.Equal(t, "token:0123abcd", audits[2].Client)
+		assert.Equal(t, now.Add(4*time.Second).UnixNano(), audits[2].Time)
+	}
+
+	audits, _ = l.Query(&types.SignAuditQuery{Limit: 2})
+	if assert.Len(t, audits, 2) {
+		assert.Equal(t, []byte{3}, audits[0].TxHash)
+	}
+	audits, _ = l.Query(&types.SignAuditQuery{Account: accounts[1]})
+	if assert.Len(t, audits, 1) {
+		assert.Equal(t, []byte{3}, audits[0].TxHash)
+	}
+	audits, _ = l.Query(&types.SignAuditQuery{To: now.Add(3 * time.Second).UnixNano()})
+	assert.Len(t, audits, 2)
+}
+
+func TestSignerAudit(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	account, err := as.createAccount("test")
+	assert.NoError(t, err)
+
+	signer := NewSigner(as.ks, as.audit)
+	tx := &types.Tx{Body: &types.TxBody{Account: account.Address}}
+	signer.record(&message.SignTx{Tx: tx, Client: "anonymous", Peer: "127.0.0.1:7845"}, errors.New("locked"))
+
+	audits, err := as.audit.Query(&types.SignAuditQuery{Account: account.Address})
+	assert.NoError(t, err)
+	if assert.Len(t, audits, 1) {
+		assert.Equal(t, "anonymous", audits[0].Client)
+		assert.Equal(t, "127.0.0.1:7845", audits[0].Peer)
+		assert.Equal(t, "locked", audits[0].Error)
+	}
+}
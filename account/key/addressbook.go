@@ -0,0 +1,95 @@
+package key
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// The address book holds the aliases of the addresses labeled locally, which
+// are distinct from the names registered on the chain.
+
+var (
+	addressBookKey = []byte("addressbook")
+
+	aliasLabel = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+	ErrAliasNotFound = errors.New("alias not found in the address book")
+	ErrInvalidLabel  = errors.New("label should be up to 64 letters, digits, '.', '_' or '-' beginning with a letter or a digit")
+)
+
+// Alias is a label of an address in the address book.
+type Alias struct {
+	Label   string  `json:"label"`
+	Address Address `json:"address"`
+}
+
+func (ks *Store) getAddressBook() (map[string]Address, error) {
+	book := make(map[string]Address)
+	if data := ks.storage.Get(addressBookKey); len(data) != 0 {
+		if err := json.Unmarshal(data, &book); err != nil {
+			return nil, err
+		}
+	}
+	return book, nil
+}
+
+func (ks *Store) putAddressBook(book map[string]Address) error {
+	data, err := json.Marshal(book)
+	if err != nil {
+		return err
+	}
+	ks.storage.Set(addressBookKey, data)
+	return nil
+}
+
+// SetAlias labels the address, replacing the address of the label if any.
+func (ks *Store) SetAlias(label string, address Address) error {
+	if !aliasLabel.MatchString(label) {
+		return ErrInvalidLabel
+	}
+	if len(address) != types.AddressLength {
+		return errors.New("invalid address length")
+	}
+	book, err := ks.getAddressBook()
+	if err != nil {
+		return err
+	}
+	book[label] = address
+	return ks.putAddressBook(book)
+}
+
+// DeleteAlias removes the label from the address book, and returns its
+// address.
+func (ks *Store) DeleteAlias(label string) (Address, error) {
+	book, err := ks.getAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	address, exist := book[label]
+	if !exist {
+		return nil, ErrAliasNotFound
+	}
+	delete(book, label)
+	return address, ks.putAddressBook(book)
+}
+
+// GetAliases returns the aliases of the address book in the order of the
+// labels.
+func (ks *Store) GetAliases() ([]*Alias, error) {
+	book, err := ks.getAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	aliases := make([]*Alias, 0, len(book))
+	for label, address := range book {
+		aliases = append(aliases, &Alias{Label: label, Address: address})
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i].Label < aliases[j].Label
+	})
+	return aliases, nil
+}
@@ -0,0 +1,36 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressBook(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	alice := make([]byte, types.AddressLength)
+	alice[0] = 2
+	bob := make([]byte, types.AddressLength)
+	bob[0] = 3
+
+	assert.NoError(t, ks.SetAlias("bob", bob))
+	assert.NoError(t, ks.SetAlias("alice", bob))
+	assert.NoError(t, ks.SetAlias("alice", alice), "replaced")
+	assert.Equal(t, ErrInvalidLabel, ks.SetAlias("@alice", alice))
+	assert.Equal(t, ErrInvalidLabel, ks.SetAlias("", alice))
+	assert.Error(t, ks.SetAlias("carol", []byte{1}))
+
+	aliases, err := ks.GetAliases()
+	assert.NoError(t, err)
+	assert.Equal(t, []*Alias{{Label: "alice", Address: alice}, {Label: "bob", Address: bob}}, aliases)
+
+	address, err := ks.DeleteAlias("bob")
+	assert.NoError(t, err)
+	assert.Equal(t, bob, address)
+	_, err = ks.DeleteAlias("bob")
+	assert.Equal(t, ErrAliasNotFound, err)
+	aliases, _ = ks.GetAliases()
+	assert.Len(t, aliases, 1)
+}
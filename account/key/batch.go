@@ -0,0 +1,123 @@
+package key
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// VerifyTxs verifies the signatures of the txs by the keys of their accounts
+// in a batch, on the workers up to the number of CPUs if workers is 0. The
+// public key of each account is parsed once for all of its txs, which are
+// usually many in a block. It returns the error of each tx, nil if it is
+// valid.
+func VerifyTxs(txs []*types.Tx, workers int) []error {
+	errs := make([]error, len(txs))
+	if len(txs) == 0 {
+		return errs
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+
+	keys := parsePubKeys(txs, workers)
+
+	var (
+		next int64 = -1
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(txs) {
+					return
+				}
+				errs[i] = verifyTxWithKey(txs[i], keys[string(txs[i].GetBody().GetAccount())])
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// VerifyAddressTxs verifies the signatures of the txs by addresses in a batch,
+// and reports whether each tx is verified. The txs by names, which depend on
+// the state, are reported unverified as well as the invalid and nil ones, and
+// should be verified one by one.
+func VerifyAddressTxs(txs []*types.Tx, workers int) []bool {
+	signed := make([]bool, len(txs))
+
+	idx := make([]int, 0, len(txs))
+	batch := make([]*types.Tx, 0, len(txs))
+	for i, tx := range txs {
+		if tx.GetBody().GetAccount() == nil || tx.NeedNameVerify() {
+			continue
+		}
+		idx = append(idx, i)
+		batch = append(batch, tx)
+	}
+	for i, err := range VerifyTxs(batch, workers) {
+		signed[idx[i]] = err == nil
+	}
+	return signed
+}
+
+type parsedKey struct {
+	pubKey *btcec.PublicKey
+	err    error
+}
+
+// parsePubKeys parses the distinct accounts of the txs into the public keys.
+func parsePubKeys(txs []*types.Tx, workers int) map[string]*parsedKey {
+	keys := make(map[string]*parsedKey)
+	accounts := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		account := string(tx.GetBody().GetAccount())
+		if _, exist := keys[account]; !exist {
+			keys[account] = &parsedKey{}
+			accounts = append(accounts, account)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(accounts); w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(accounts); i += workers {
+				k := keys[accounts[i]]
+				k.pubKey, k.err = btcec.ParsePubKey([]byte(accounts[i]), btcec.S256())
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	return keys
+}
+
+func verifyTxWithKey(tx *types.Tx, key *parsedKey) error {
+	if tx.GetBody() == nil {
+		return types.ErrTxFormatInvalid
+	}
+	sign, err := btcec.ParseSignature(tx.Body.Sign, btcec.S256())
+	if err != nil {
+		return err
+	}
+	if key.err != nil {
+		return key.err
+	}
+	if !sign.Verify(CalculateHashWithoutSign(tx.Body), key.pubKey) {
+		return types.ErrSignNotMatch
+	}
+	return nil
+}
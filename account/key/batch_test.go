@@ -0,0 +1,89 @@
+package key
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func signedTxs(t testing.TB, accounts, count int) []*types.Tx {
+	keys := make([]*btcec.PrivateKey, accounts)
+	for i := range keys {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err)
+		keys[i] = key
+	}
+	txs := make([]*types.Tx, count)
+	for i := range txs {
+		key := keys[i%accounts]
+		txs[i] = &types.Tx{Body: &types.TxBody{
+			Nonce:     uint64(i/accounts + 1),
+			Account:   GenerateAddress(&key.PublicKey),
+			Recipient: GenerateAddress(&keys[(i+1)%accounts].PublicKey),
+			Amount:    new(big.Int).SetUint64(uint64(i)).Bytes(),
+		}}
+		assert.NoError(t, SignTx(txs[i], key))
+	}
+	return txs
+}
+
+func TestVerifyTxs(t *testing.T) {
+	txs := signedTxs(t, 3, 20)
+	txs[3].Body.Amount = []byte{0xff}
+	txs[5].Body.Sign = []byte{0x01}
+	txs[7].Body.Account = []byte("invalid account")
+
+	errs := VerifyTxs(txs, 4)
+	assert.Len(t, errs, len(txs))
+	for i, err := range errs {
+		assert.Equal(t, VerifyTx(txs[i]), err, "tx %d", i)
+		if i == 3 || i == 5 || i == 7 {
+			assert.Error(t, err, "tx %d", i)
+		} else {
+			assert.NoError(t, err, "tx %d", i)
+		}
+	}
+
+	assert.Len(t, VerifyTxs(nil, 0), 0)
+	assert.Equal(t, []error{types.ErrTxFormatInvalid}, VerifyTxs([]*types.Tx{{}}, 0))
+}
+
+func TestVerifyAddressTxs(t *testing.T) {
+	txs := signedTxs(t, 2, 4)
+	txs[1].Body.Sign = []byte{0x01}
+	txs[2].Body.Account = []byte("aergo.name00")
+	txs = append(txs, &types.Tx{Body: &types.TxBody{}}, nil)
+
+	assert.Equal(t, []bool{true, false, false, true, false, false}, VerifyAddressTxs(txs, 2))
+	assert.Len(t, VerifyAddressTxs(nil, 0), 0)
+}
+
+// The batch verification is accepted if it verifies the txs of a block
+// faster than one by one, on a multi-core machine.
+
+func BenchmarkVerifyTxsOneByOne(b *testing.B) {
+	txs := signedTxs(b, 100, 2000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, tx := range txs {
+			if err := VerifyTx(tx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyTxsBatch(b *testing.B) {
+	txs := signedTxs(b, 100, 2000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, err := range VerifyTxs(txs, 0) {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package key
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// The keys of the HD wallet are derived by BIP32 from the seed of a BIP39
+// mnemonic along the BIP44 paths m/44'/441'/account'/0/index, 441 being the
+// coin type of aergo registered in SLIP-0044. The keystore holds a single
+// wallet, whose derived keys are stored and unlocked like the other keys.
+const (
+	CoinType = 441
+
+	hardenedKeyStart = 0x80000000
+
+	// MaxDeriveCount is the number of the keys derived at once at most
+	MaxDeriveCount = 100
+)
+
+var (
+	hdWalletKey   = []byte("hdwallet")
+	hdAccountsKey = []byte("hdaccounts")
+
+	ErrNoHDWallet      = errors.New("no hd wallet in the keystore")
+	ErrHDWalletExists  = errors.New("hd wallet already exists in the keystore")
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	ErrInvalidHDPath   = errors.New("invalid hd path")
+)
+
+// HDPath returns the BIP44 path of the key of the index in the account.
+func HDPath(account, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/0/%d", CoinType, account, index)
+}
+
+// ParseHDPath returns the child indices of a BIP32 path like m/44'/441'/0'/0/1.
+func ParseHDPath(path string) ([]uint32, error) {
+	elems := strings.Split(path, "/")
+	if elems[0] != "m" {
+		return nil, ErrInvalidHDPath
+	}
+	indices := make([]uint32, 0, len(elems)-1)
+	for _, e := range elems[1:] {
+		var offset uint32
+		if strings.HasSuffix(e, "'") {
+			e, offset = e[:len(e)-1], hardenedKeyStart
+		}
+		i, err := strconv.ParseUint(e, 10, 32)
+		if err != nil || i >= hardenedKeyStart {
+			return nil, ErrInvalidHDPath
+		}
+		indices = append(indices, uint32(i)+offset)
+	}
+	return indices, nil
+}
+
+// extendedKey is a private key of BIP32 with its chain code.
+type extendedKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+func newExtendedKey(data, key []byte) *extendedKey {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return &extendedKey{key: sum[:32], chainCode: sum[32:]}
+}
+
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	master := newExtendedKey(seed, []byte("Bitcoin seed"))
+	if k := new(big.Int).SetBytes(master.key); k.Sign() == 0 || k.Cmp(btcec.S256().N) >= 0 {
+		return nil, errors.New("invalid seed")
+	}
+	return master, nil
+}
+
+// child returns the child key of the index. It fails for the indices
+// without a valid key, which happens with a probability lower than 1 in
+// 2^127.
+func (k *extendedKey) child(i uint32) (*extendedKey, error) {
+	data := make([]byte, 0, 37)
+	if i >= hardenedKeyStart {
+		data = append(data, 0)
+		data = append(data, k.key...)
+	} else {
+		_, pub := btcec.PrivKeyFromBytes(btcec.S256(), k.key)
+		data = append(data, pub.SerializeCompressed()...)
+	}
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[len(data)-4:], i)
+
+	child := newExtendedKey(data, k.chainCode)
+	n := btcec.S256().N
+	il := new(big.Int).SetBytes(child.key)
+	if il.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("no valid key of the child %d", i)
+	}
+	il.Add(il, new(big.Int).SetBytes(k.key))
+	il.Mod(il, n)
+	if il.Sign() == 0 {
+		return nil, fmt.Errorf("no valid key of the child %d", i)
+	}
+	// the key is kept in 32 bytes to derive its hardened children
+	child.key = make([]byte, 32)
+	b := il.Bytes()
+	copy(child.key[32-len(b):], b)
+	return child, nil
+}
+
+// DeriveKey returns the private key of the BIP32 path derived from the seed.
+func DeriveKey(seed []byte, path string) (*btcec.PrivateKey, error) {
+	indices, err := ParseHDPath(path)
+	if err != nil {
+		return nil, err
+	}
+	k, err := newMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range indices {
+		if k, err = k.child(i); err != nil {
+			return nil, err
+		}
+	}
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), k.key)
+	return priv, nil
+}
+
+// NewMnemonic returns a new BIP39 mnemonic of the number of the words: 12,
+// 15, 18, 21 or 24.
+func NewMnemonic(words int) (string, error) {
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+	if words%3 != 0 || err != nil {
+		return "", fmt.Errorf("invalid number of the words %d", words)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// MnemonicToSeed returns the seed of the mnemonic with the passphrase of
+// BIP39, which may be empty.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(normalizeMnemonic(mnemonic), passphrase)
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	return seed, nil
+}
+
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+}
+
+// HDAccount is a key of the keystore derived from the HD wallet.
+type HDAccount struct {
+	Address Address `json:"address"`
+	Path    string  `json:"path"`
+}
+
+type hdWallet struct {
+	Mnemonic string `json:"mnemonic"`
+	Seed     []byte `json:"seed"`
+}
+
+// CreateHDWallet makes the HD wallet of the keystore from the mnemonic and
+// its BIP39 passphrase, or from a new mnemonic of the number of the words if
+// mnemonic is empty. The wallet is encrypted with pass, and the mnemonic is
+// returned.
+func (ks *Store) CreateHDWallet(mnemonic string, seedPass string, words int, pass string) (string, error) {
+	if len(ks.storage.Get(hdWalletKey)) != 0 {
+		return "", ErrHDWalletExists
+	}
+	var err error
+	if mnemonic == "" {
+		if mnemonic, err = NewMnemonic(words); err != nil {
+			return "", err
+		}
+	}
+	mnemonic = normalizeMnemonic(mnemonic)
+	seed, err := MnemonicToSeed(mnemonic, seedPass)
+	if err != nil {
+		return "", err
+	}
+	if err := ks.putHDWallet(&hdWallet{Mnemonic: mnemonic, Seed: seed}, pass); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+func (ks *Store) putHDWallet(w *hdWallet, pass string) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	sealed, err := ks.seal(data, pass)
+	if err != nil {
+		return err
+	}
+	ks.storage.Set(hdWalletKey, sealed)
+	return nil
+}
+
+func (ks *Store) getHDWallet(pass string) (*hdWallet, error) {
+	stored := ks.storage.Get(hdWalletKey)
+	if len(stored) == 0 {
+		return nil, ErrNoHDWallet
+	}
+	data, current, err := ks.open(stored, pass)
+	if err == errLegacyFormat {
+		data, err = openLegacyHDWallet(stored, pass)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var w hdWallet
+	if err := json.Unmarshal(data, &w); err != nil {
+		return nil, err
+	}
+	if !current {
+		if err := ks.putHDWallet(&w, pass); err != nil {
+			return nil, err
+		}
+	}
+	return &w, nil
+}
+
+// openLegacyHDWallet returns the wallet of the legacy format, which is
+// encrypted by AES-GCM with the hash of a salt and the passphrase.
+func openLegacyHDWallet(stored []byte, pass string) ([]byte, error) {
+	if len(stored) < 16 {
+		return nil, errors.New("broken hd wallet")
+	}
+	salt := stored[:16]
+	data, err := decrypt(salt, hashBytes(salt, []byte(pass)), stored[16:])
+	if err != nil {
+		return nil, types.ErrWrongAddressOrPassWord
+	}
+	return data, nil
+}
+
+// ExportMnemonic returns the mnemonic of the HD wallet.
+func (ks *Store) ExportMnemonic(pass string) (string, error) {
+	w, err := ks.getHDWallet(pass)
+	if err != nil {
+		return "", err
+	}
+	return w.Mnemonic, nil
+}
+
+// DeriveHDAccounts derives the keys of the count indices from index in the
+// account of the HD wallet, and adds them to the keystore with pass. The keys
+// derived before are returned as they are.
+func (ks *Store) DeriveHDAccounts(pass string, account uint32, index uint32, count uint32) ([]*HDAccount, error) {
+	if count == 0 || count > MaxDeriveCount {
+		return nil, fmt.Errorf("count should be from 1 to %d", MaxDeriveCount)
+	}
+	if account >= hardenedKeyStart || index+count > hardenedKeyStart || index+count < index {
+		return nil, ErrInvalidHDPath
+	}
+	w, err := ks.getHDWallet(pass)
+	if err != nil {
+		return nil, err
+	}
+	hdAccounts, err := ks.GetHDAccounts()
+	if err != nil {
+		return nil, err
+	}
+	derivedPaths := make(map[string]*HDAccount, len(hdAccounts))
+	for _, a := range hdAccounts {
+		derivedPaths[a.Path] = a
+	}
+	addresses, err := ks.GetAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	var derived []*HDAccount
+	for i := index; i < index+count; i++ {
+		path := HDPath(account, i)
+		if a, exist := derivedPaths[path]; exist {
+			derived = append(derived, a)
+			continue
+		}
+		priv, err := DeriveKey(w.Seed, path)
+		if err != nil {
+			return nil, err
+		}
+		address, err := ks.addKey(priv, pass)
+		if err != nil {
+			return nil, err
+		}
+		// the key may have been imported before
+		if !containsAddress(addresses, address) {
+			if err := ks.SaveAddress(address); err != nil {
+				return nil, err
+			}
+		}
+		a := &HDAccount{Address: address, Path: path}
+		hdAccounts = append(hdAccounts, a)
+		derived = append(derived, a)
+	}
+	data, err := json.Marshal(hdAccounts)
+	if err != nil {
+		return nil, err
+	}
+	ks.storage.Set(hdAccountsKey, data)
+	return derived, nil
+}
+
+// GetHDAccounts returns the keys derived from the HD wallet in the order of
+// their derivation.
+func (ks *Store) GetHDAccounts() ([]*HDAccount, error) {
+	var hdAccounts []*HDAccount
+	if data := ks.storage.Get(hdAccountsKey); len(data) != 0 {
+		if err := json.Unmarshal(data, &hdAccounts); err != nil {
+			return nil, err
+		}
+	}
+	return hdAccounts, nil
+}
+
+func containsAddress(addresses []Address, address Address) bool {
+	for _, a := range addresses {
+		if string(a) == string(address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package key
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeriveKeyVectors(t *testing.T) {
+	// the test vectors of BIP32
+	tests := []struct {
+		seed string
+		path string
+		key  string
+	}{
+		{"000102030405060708090a0b0c0d0e0f", "m",
+			"e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{"000102030405060708090a0b0c0d0e0f", "m/0'/1/2'/2/1000000000",
+			"471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+		// the master key has a leading zero
+		{"4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be", "m/0'",
+			"491f7a2eebc7b57028e0d3faa0acda02e75c33b03c48fb288c41e2ea44e1daef"},
+	}
+	for _, tt := range tests {
+		seed, _ := hex.DecodeString(tt.seed)
+		priv, err := DeriveKey(seed, tt.path)
+		if assert.NoError(t, err, tt.path) {
+			assert.Equal(t, tt.key, hex.EncodeToString(priv.Serialize()), tt.path)
+		}
+	}
+
+	for _, path := range []string{"", "44'/0", "m/a", "m/1''", "m/2147483648"} {
+		_, err := ParseHDPath(path)
+		assert.Equal(t, ErrInvalidHDPath, err, path)
+	}
+}
+
+func TestMnemonicToSeed(t *testing.T) {
+	// a test vector of BIP39
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	seed, err := MnemonicToSeed(mnemonic, "TREZOR")
+	assert.NoError(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(seed))
+
+	_, err = MnemonicToSeed(strings.Repeat("abandon ", 12), "")
+	assert.Equal(t, ErrInvalidMnemonic, err, "wrong checksum")
+
+	mnemonic, err = NewMnemonic(12)
+	assert.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 12)
+	_, err = NewMnemonic(13)
+	assert.Error(t, err)
+}
+
+func TestHDWallet(t *testing.T) {
+	initTest()
+	defer deinitTest()
+
+	_, err := ks.DeriveHDAccounts("pass", 0, 0, 1)
+	assert.Equal(t, ErrNoHDWallet, err)
+
+	mnemonic, err := ks.CreateHDWallet("", "", 24, "pass")
+	assert.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+	_, err = ks.CreateHDWallet("", "", 24, "pass")
+	assert.Equal(t, ErrHDWalletExists, err)
+
+	exported, err := ks.ExportMnemonic("pass")
+	assert.NoError(t, err)
+	assert.Equal(t, mnemonic, exported)
+	_, err = ks.ExportMnemonic("wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+
+	derived, err := ks.DeriveHDAccounts("pass", 0, 0, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, derived, 2) {
+		assert.Equal(t, "m/44'/441'/0'/0/1", derived[1].Path)
+	}
+	// the derived keys are unlocked by the pass of the wallet
+	_, err = ks.Unlock(derived[0].Address, "pass")
+	assert.NoError(t, err)
+
+	// the same keys are derived from the mnemonic
+	seed, _ := MnemonicToSeed(mnemonic, "")
+	priv, _ := DeriveKey(seed, derived[1].Path)
+	assert.Equal(t, GenerateAddress(&priv.PublicKey), derived[1].Address)
+
+	again, err := ks.DeriveHDAccounts("pass", 0, 1, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, again, 2) {
+		assert.Equal(t, derived[1], again[0], "derived before")
+	}
+	hdAccounts, err := ks.GetHDAccounts()
+	assert.NoError(t, err)
+	assert.Len(t, hdAccounts, 3)
+	addresses, _ := ks.GetAddresses()
+	assert.Len(t, addresses, 3)
+
+	_, err = ks.DeriveHDAccounts("pass", 0, 0, MaxDeriveCount+1)
+	assert.Error(t, err)
+}
+
+func TestMigrateLegacyHDWallet(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	seed, _ := MnemonicToSeed(mnemonic, "")
+	data, _ := json.Marshal(&hdWallet{Mnemonic: mnemonic, Seed: seed})
+	salt, _ := randomBytes(16)
+	encrypted, err := encrypt(salt, hashBytes(salt, []byte("pass")), data)
+	assert.NoError(t, err)
+	ks.storage.Set(hdWalletKey, append(salt, encrypted...))
+
+	_, err = ks.ExportMnemonic("wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+	exported, err := ks.ExportMnemonic("pass")
+	assert.NoError(t, err)
+	assert.Equal(t, mnemonic, exported)
+	_, err = parseStoredKey(ks.storage.Get(hdWalletKey))
+	assert.NoError(t, err, "migrated")
+	exported, err = ks.ExportMnemonic("pass")
+	assert.NoError(t, err)
+	assert.Equal(t, mnemonic, exported)
+}
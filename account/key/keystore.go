@@ -0,0 +1,276 @@
+package key
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The keystore is a JSON document holding a private key encrypted with a
+// passphrase:
+//
+//  {
+//   "aergo_address": base58check encoded address of the key,
+//   "ks_version": "1",
+//   "cipher": {
+//    "algorithm": "aes-128-ctr",
+//    "params": {"iv": hex of the initial counter},
+//    "ciphertext": hex of the encrypted private key
+//   },
+//   "kdf": {
+//    "algorithm": "scrypt" or "argon2id",
+//    "params": {"dklen", "salt", and "n", "r", "p" or "time", "memory", "threads"},
+//    "mac": hex of sha256(derived key[16:32] || ciphertext)
+//   }
+//  }
+//
+// The first half of the key derived from the passphrase encrypts the private
+// key and the second half authenticates the ciphertext.
+const (
+	KeystoreVersion = "1"
+
+	KdfScrypt   = "scrypt"
+	KdfArgon2id = "argon2id"
+
+	cipherAES128CTR = "aes-128-ctr"
+
+	keystoreDkLen = 32
+)
+
+var (
+	// scrypt parameters for a new keystore
+	scryptN = 1 << 18
+	scryptR = 8
+	scryptP = 1
+
+	// argon2id parameters for a new keystore; the memory is in KiB
+	argon2Time    uint32 = 1
+	argon2Memory  uint32 = 64 * 1024
+	argon2Threads uint8  = 4
+
+	ErrKeystoreVersion  = errors.New("unsupported keystore version")
+	ErrKeystoreCipher   = errors.New("unsupported keystore cipher")
+	ErrKeystoreKdf      = errors.New("unsupported keystore kdf")
+	ErrKeystorePassword = errors.New("wrong keystore password")
+	ErrKeystoreAddress  = errors.New("keystore address does not match the key")
+)
+
+// Keystore is the encrypted form of a private key exchanged with other nodes
+// and wallets.
+type Keystore struct {
+	Address string         `json:"aergo_address"`
+	Version string         `json:"ks_version"`
+	Cipher  KeystoreCipher `json:"cipher"`
+	Kdf     KeystoreKdf    `json:"kdf"`
+}
+
+type KeystoreCipher struct {
+	Algorithm  string               `json:"algorithm"`
+	Params     KeystoreCipherParams `json:"params"`
+	Ciphertext string               `json:"ciphertext"`
+}
+
+type KeystoreCipherParams struct {
+	Iv string `json:"iv"`
+}
+
+type KeystoreKdf struct {
+	Algorithm string            `json:"algorithm"`
+	Params    KeystoreKdfParams `json:"params"`
+	Mac       string            `json:"mac"`
+}
+
+// KeystoreKdfParams are the parameters of scrypt (n, r and p) or argon2id
+// (time, memory and threads).
+type KeystoreKdfParams struct {
+	DkLen   int    `json:"dklen"`
+	Salt    string `json:"salt"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// EncryptKeystore encrypts a private key into a keystore with a key derived
+// from the passphrase by kdf.
+func EncryptKeystore(key []byte, pass string, kdf string) ([]byte, error) {
+	var params KeystoreKdfParams
+	switch kdf {
+	case KdfScrypt:
+		params.N, params.R, params.P = scryptN, scryptR, scryptP
+	case KdfArgon2id:
+		params.Time, params.Memory, params.Threads = argon2Time, argon2Memory, argon2Threads
+	default:
+		return nil, ErrKeystoreKdf
+	}
+	c, k, err := encryptWithKdf(key, pass, kdf, params)
+	if err != nil {
+		return nil, err
+	}
+	_, pubkey := btcec.PrivKeyFromBytes(btcec.S256(), key)
+	ks := &Keystore{
+		Address: types.EncodeAddress(GenerateAddress(pubkey.ToECDSA())),
+		Version: KeystoreVersion,
+		Cipher:  *c,
+		Kdf:     *k,
+	}
+	return json.MarshalIndent(ks, "", " ")
+}
+
+// DecryptKeystore returns the private key in the keystore.
+func DecryptKeystore(data []byte, pass string) ([]byte, error) {
+	var ks Keystore
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %s", err.Error())
+	}
+	if ks.Version != KeystoreVersion {
+		return nil, ErrKeystoreVersion
+	}
+	key, err := decryptWithKdf(&ks.Cipher, &ks.Kdf, pass)
+	if err != nil {
+		return nil, err
+	}
+	_, pubkey := btcec.PrivKeyFromBytes(btcec.S256(), key)
+	if ks.Address != types.EncodeAddress(GenerateAddress(pubkey.ToECDSA())) {
+		return nil, ErrKeystoreAddress
+	}
+	return key, nil
+}
+
+// encryptWithKdf encrypts data with a key derived from the passphrase by kdf
+// with the params and a new salt.
+func encryptWithKdf(data []byte, pass string, kdf string, params KeystoreKdfParams) (*KeystoreCipher, *KeystoreKdf, error) {
+	salt, err := randomBytes(32)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.DkLen = keystoreDkLen
+	params.Salt = hex.EncodeToString(salt)
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	derived, err := deriveKey(kdf, params, pass)
+	if err != nil {
+		return nil, nil, err
+	}
+	ciphertext, err := aesCTR(derived[:16], iv, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &KeystoreCipher{
+		Algorithm:  cipherAES128CTR,
+		Params:     KeystoreCipherParams{Iv: hex.EncodeToString(iv)},
+		Ciphertext: hex.EncodeToString(ciphertext),
+	}
+	k := &KeystoreKdf{
+		Algorithm: kdf,
+		Params:    params,
+		Mac:       hex.EncodeToString(keystoreMac(derived, ciphertext)),
+	}
+	return c, k, nil
+}
+
+func decryptWithKdf(c *KeystoreCipher, k *KeystoreKdf, pass string) ([]byte, error) {
+	if c.Algorithm != cipherAES128CTR {
+		return nil, ErrKeystoreCipher
+	}
+	iv, err := hex.DecodeString(c.Params.Iv)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := hex.DecodeString(c.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(k.Mac)
+	if err != nil {
+		return nil, err
+	}
+
+	derived, err := deriveKey(k.Algorithm, k.Params, pass)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(mac, keystoreMac(derived, ciphertext)) {
+		return nil, ErrKeystorePassword
+	}
+	return aesCTR(derived[:16], iv, ciphertext)
+}
+
+//ImportKeystore is to import a key in keystore format
+func (ks *Store) ImportKeystore(keystore []byte, oldpass string, newpass string) (Address, error) {
+	key, err := DecryptKeystore(keystore, oldpass)
+	if err != nil {
+		return nil, err
+	}
+	return ks.importPrivKey(key, newpass)
+}
+
+//ExportKeystore is to export a key in keystore format
+func (ks *Store) ExportKeystore(addr Address, pass string, kdf string) ([]byte, error) {
+	key, err := ks.getKey(addr, pass)
+	if key == nil {
+		return nil, err
+	}
+	return EncryptKeystore(key, pass, kdf)
+}
+
+func deriveKey(kdf string, params KeystoreKdfParams, pass string) ([]byte, error) {
+	if params.DkLen != keystoreDkLen {
+		return nil, fmt.Errorf("invalid dklen %d", params.DkLen)
+	}
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	switch kdf {
+	case KdfScrypt:
+		return scrypt.Key([]byte(pass), salt, params.N, params.R, params.P, params.DkLen)
+	case KdfArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, errors.New("invalid argon2id params")
+		}
+		return argon2.IDKey([]byte(pass), salt, params.Time, params.Memory, params.Threads, uint32(params.DkLen)), nil
+	default:
+		return nil, ErrKeystoreKdf
+	}
+}
+
+func keystoreMac(derived, ciphertext []byte) []byte {
+	return hashBytes(derived[16:32], ciphertext)
+}
+
+func aesCTR(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid iv length")
+	}
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,80 @@
+package key
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeystore(t *testing.T) {
+	// lighter parameters to keep the test fast
+	defer func(n int, memory uint32) {
+		scryptN, argon2Memory = n, memory
+	}(scryptN, argon2Memory)
+	scryptN, argon2Memory = 1<<10, 1024
+
+	privkey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.NoError(t, err)
+	address := types.EncodeAddress(GenerateAddress(&privkey.PublicKey))
+
+	for _, kdf := range []string{KdfScrypt, KdfArgon2id} {
+		keystore, err := EncryptKeystore(privkey.Serialize(), "pass", kdf)
+		assert.NoError(t, err, kdf)
+
+		var ks Keystore
+		assert.NoError(t, json.Unmarshal(keystore, &ks), kdf)
+		assert.Equal(t, address, ks.Address, kdf)
+		assert.Equal(t, KeystoreVersion, ks.Version, kdf)
+		assert.Equal(t, kdf, ks.Kdf.Algorithm, kdf)
+
+		key, err := DecryptKeystore(keystore, "pass")
+		assert.NoError(t, err, kdf)
+		assert.Equal(t, privkey.Serialize(), key, kdf)
+
+		_, err = DecryptKeystore(keystore, "wrong")
+		assert.Equal(t, ErrKeystorePassword, err, kdf)
+	}
+
+	keystore, err := EncryptKeystore(privkey.Serialize(), "pass", KdfScrypt)
+	assert.NoError(t, err)
+	var ks Keystore
+	assert.NoError(t, json.Unmarshal(keystore, &ks))
+
+	ks.Address = types.EncodeAddress(make([]byte, types.AddressLength))
+	tampered, _ := json.Marshal(&ks)
+	_, err = DecryptKeystore(tampered, "pass")
+	assert.Equal(t, ErrKeystoreAddress, err, "address of another key")
+
+	ks.Version = "0"
+	unknown, _ := json.Marshal(&ks)
+	_, err = DecryptKeystore(unknown, "pass")
+	assert.Equal(t, ErrKeystoreVersion, err, "unknown version")
+
+	_, err = EncryptKeystore(privkey.Serialize(), "pass", "pbkdf2")
+	assert.Equal(t, ErrKeystoreKdf, err, "unsupported kdf")
+}
+
+func TestImportExportKeystore(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	defer func(n int) { scryptN = n }(scryptN)
+	scryptN = 1 << 10
+
+	addr, err := ks.CreateKey("pass")
+	assert.NoError(t, err)
+	_, err = ks.ExportKeystore(addr, "wrong", KdfScrypt)
+	assert.Error(t, err, "wrong password")
+	keystore, err := ks.ExportKeystore(addr, "pass", KdfScrypt)
+	assert.NoError(t, err)
+
+	other := NewStore(testDir+"/other", 0)
+	defer other.CloseStore()
+	imported, err := other.ImportKeystore(keystore, "pass", "newpass")
+	assert.NoError(t, err)
+	assert.Equal(t, addr, imported)
+	_, err = other.Unlock(imported, "newpass")
+	assert.NoError(t, err, "unlock with the new password")
+}
@@ -39,11 +39,11 @@ func (ks *Store) SignTx(tx *types.Tx, requester []byte) error {
 	if requester != nil {
 		addr = requester
 	}
-	keyPair, exist := ks.unlocked[types.EncodeAddress(addr)]
-	if !exist {
+	key, unlocked := ks.getUnlocked(addr)
+	if !unlocked {
 		return types.ErrShouldUnlockAccount
 	}
-	return SignTx(tx, keyPair.key)
+	return SignTx(tx, key)
 }
 
 //VerifyTx return result to varify sign
@@ -85,5 +85,11 @@ func CalculateHashWithoutSign(txBody *types.TxBody) []byte {
 	h.Write(txBody.GasPrice)
 	binary.Write(h, binary.LittleEndian, txBody.Type)
 	h.Write(txBody.ChainIdHash)
+	if txBody.Expiry != 0 || txBody.NotBefore != 0 {
+		binary.Write(h, binary.LittleEndian, txBody.Expiry)
+	}
+	if txBody.NotBefore != 0 {
+		binary.Write(h, binary.LittleEndian, txBody.NotBefore)
+	}
 	return h.Sum(nil)
 }
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
+	"encoding/json"
 	"errors"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/aergoio/aergo-lib/db"
@@ -17,15 +19,68 @@ import (
 type aergokey = btcec.PrivateKey
 
 type keyPair struct {
-	key   *aergokey
-	timer *time.Timer
+	key        *aergokey
+	timer      *time.Timer
+	unlockedAt time.Time
+	expireAt   time.Time // zero if the key is not locked automatically
+}
+
+// UnlockStatus is the unlock session of an account.
+type UnlockStatus struct {
+	Unlocked   bool
+	UnlockedAt time.Time
+	ExpireAt   time.Time // zero if the account is not locked automatically
+}
+
+// The keys are stored by their address in the versioned format below,
+// encrypted with a key derived from the passphrase by argon2id:
+//
+//  {
+//   "version": 2,
+//   "cipher": the cipher of the keystore,
+//   "kdf": the kdf of the keystore
+//  }
+//
+// The keys of the legacy format, version 1, are stored by the hash of the
+// address and the passphrase, and encrypted by AES-GCM with the hash. They
+// and the keys with other argon2id parameters are encrypted again by the
+// current format when they are unlocked or reencrypted.
+const StoreVersion = 2
+
+var (
+	storedKeyPrefix = []byte("key/")
+
+	errLegacyFormat = errors.New("legacy format")
+)
+
+type storedKey struct {
+	Version int            `json:"version"`
+	Cipher  KeystoreCipher `json:"cipher"`
+	Kdf     KeystoreKdf    `json:"kdf"`
+}
+
+// Argon2Params are the parameters of argon2id encrypting the stored keys.
+// The memory is in KiB.
+type Argon2Params struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// DefaultArgon2Params returns the parameters of argon2id used unless set by
+// SetArgon2Params.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{Time: argon2Time, Memory: argon2Memory, Threads: argon2Threads}
 }
 
 // Store stucture of keystore
 type Store struct {
-	timeout  time.Duration
-	unlocked map[string]*keyPair
-	storage  db.DB
+	timeout   time.Duration
+	maxUnlock time.Duration
+	unlocked  map[string]*keyPair
+	unlockMu  sync.Mutex
+	storage   db.DB
+	argon2    Argon2Params
 }
 
 // NewStore make new instance of keystore
@@ -36,10 +91,36 @@ func NewStore(storePath string, unlockTimeout uint) *Store {
 		timeout:  time.Duration(unlockTimeout) * time.Second,
 		unlocked: map[string]*keyPair{},
 		storage:  db.NewDB(db.LevelImpl, dbPath),
+		argon2:   DefaultArgon2Params(),
+	}
+}
+
+// SetArgon2Params sets the parameters of argon2id encrypting the keys. The
+// keys stored with other parameters are encrypted again when they are
+// unlocked.
+func (ks *Store) SetArgon2Params(params Argon2Params) error {
+	if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+		return errors.New("invalid argon2id params")
 	}
+	ks.argon2 = params
+	return nil
 }
+
+// SetMaxUnlockDuration limits the unlock sessions to d, including the ones
+// unlocked without a timeout. They are not limited if d is 0.
+func (ks *Store) SetMaxUnlockDuration(d time.Duration) {
+	ks.maxUnlock = d
+}
+
 func (ks *Store) CloseStore() {
+	ks.unlockMu.Lock()
+	for _, kp := range ks.unlocked {
+		if kp.timer != nil {
+			kp.timer.Stop()
+		}
+	}
 	ks.unlocked = nil
+	ks.unlockMu.Unlock()
 	ks.storage.Close()
 }
 
@@ -61,6 +142,10 @@ func (ks *Store) ImportKey(imported []byte, oldpass string, newpass string) (Add
 	if err != nil {
 		return nil, err
 	}
+	return ks.importPrivKey(key, newpass)
+}
+
+func (ks *Store) importPrivKey(key []byte, newpass string) (Address, error) {
 	privkey, pubkey := btcec.PrivKeyFromBytes(btcec.S256(), key)
 	address := GenerateAddress(pubkey.ToECDSA())
 	addresses, err := ks.GetAddresses()
@@ -97,29 +182,40 @@ func EncryptKey(key []byte, pass string) ([]byte, error) {
 
 //Unlock is to unlock account for signing
 func (ks *Store) Unlock(addr Address, pass string) (Address, error) {
+	return ks.UnlockFor(addr, pass, 0)
+}
+
+// UnlockFor unlocks the account for signing until the timeout, or the
+// default timeout of the store if it is 0. The account unlocked again starts
+// a new session.
+func (ks *Store) UnlockFor(addr Address, pass string, timeout time.Duration) (Address, error) {
 	key, err := ks.getKey(addr, pass)
 	if key == nil {
 		return nil, err
 	}
 	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
 	addrKey := types.EncodeAddress(addr)
-	unlockedKeyPair, exist := ks.unlocked[addrKey]
 
-	if ks.timeout == 0 {
-		ks.unlocked[addrKey] = &keyPair{key: pk, timer: nil}
-		return addr, nil
+	if timeout == 0 {
+		timeout = ks.timeout
+	}
+	if ks.maxUnlock != 0 && (timeout == 0 || timeout > ks.maxUnlock) {
+		timeout = ks.maxUnlock
 	}
 
-	if exist {
-		unlockedKeyPair.timer.Reset(ks.timeout)
-	} else {
-		lockTimer := time.AfterFunc(ks.timeout,
-			func() {
-				ks.Lock(addr, pass)
-			},
-		)
-		ks.unlocked[addrKey] = &keyPair{key: pk, timer: lockTimer}
+	ks.unlockMu.Lock()
+	defer ks.unlockMu.Unlock()
+	if prev, exist := ks.unlocked[addrKey]; exist && prev.timer != nil {
+		prev.timer.Stop()
 	}
+	kp := &keyPair{key: pk, unlockedAt: time.Now()}
+	if timeout != 0 {
+		kp.expireAt = kp.unlockedAt.Add(timeout)
+		kp.timer = time.AfterFunc(timeout, func() {
+			ks.lockSession(addrKey, kp)
+		})
+	}
+	ks.unlocked[addrKey] = kp
 	return addr, nil
 }
 
@@ -130,35 +226,177 @@ func (ks *Store) Lock(addr Address, pass string) (Address, error) {
 		return nil, err
 	}
 	b58addr := types.EncodeAddress(addr)
-	if _, exist := ks.unlocked[b58addr]; exist {
-		ks.unlocked[b58addr] = nil
+	ks.unlockMu.Lock()
+	defer ks.unlockMu.Unlock()
+	if kp, exist := ks.unlocked[b58addr]; exist {
+		if kp.timer != nil {
+			kp.timer.Stop()
+		}
 		delete(ks.unlocked, b58addr)
 	}
 	return addr, nil
 }
 
+// lockSession locks the account if it is still in the session of kp.
+func (ks *Store) lockSession(addrKey string, kp *keyPair) {
+	ks.unlockMu.Lock()
+	defer ks.unlockMu.Unlock()
+	if ks.unlocked[addrKey] == kp {
+		delete(ks.unlocked, addrKey)
+	}
+}
+
+// getUnlocked returns the key of the account if it is unlocked.
+func (ks *Store) getUnlocked(addr Address) (*aergokey, bool) {
+	ks.unlockMu.Lock()
+	defer ks.unlockMu.Unlock()
+	kp, exist := ks.unlocked[types.EncodeAddress(addr)]
+	if !exist || (!kp.expireAt.IsZero() && time.Now().After(kp.expireAt)) {
+		return nil, false
+	}
+	return kp.key, true
+}
+
+// GetUnlockStatus returns the unlock session of the account.
+func (ks *Store) GetUnlockStatus(addr Address) UnlockStatus {
+	ks.unlockMu.Lock()
+	defer ks.unlockMu.Unlock()
+	kp, exist := ks.unlocked[types.EncodeAddress(addr)]
+	if !exist || (!kp.expireAt.IsZero() && time.Now().After(kp.expireAt)) {
+		return UnlockStatus{}
+	}
+	return UnlockStatus{Unlocked: true, UnlockedAt: kp.unlockedAt, ExpireAt: kp.expireAt}
+}
+
 func (ks *Store) getKey(address []byte, pass string) ([]byte, error) {
+	if sealed := ks.storage.Get(storedKeyID(address)); len(sealed) != 0 {
+		key, current, err := ks.open(sealed, pass)
+		if err != nil {
+			return nil, err
+		}
+		if !current {
+			if err := ks.putKey(address, key, pass); err != nil {
+				return nil, err
+			}
+		}
+		return key, nil
+	}
+	return ks.migrateKey(address, pass)
+}
+
+// migrateKey returns the key of the legacy format after storing it in the
+// current format.
+func (ks *Store) migrateKey(address []byte, pass string) ([]byte, error) {
 	encryptkey := hashBytes(address, []byte(pass))
-	key := ks.storage.Get(hashBytes(address, encryptkey))
-	if cap(key) == 0 {
+	legacyID := hashBytes(address, encryptkey)
+	encrypted := ks.storage.Get(legacyID)
+	if len(encrypted) == 0 {
 		return nil, types.ErrWrongAddressOrPassWord
 	}
-	return decrypt(address, encryptkey, key)
+	key, err := decrypt(address, encryptkey, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := ks.seal(key, pass)
+	if err != nil {
+		return nil, err
+	}
+	tx := ks.storage.NewTx()
+	tx.Set(storedKeyID(address), sealed)
+	tx.Delete(legacyID)
+	tx.Commit()
+	return key, nil
 }
 
 func (ks *Store) addKey(key *btcec.PrivateKey, pass string) (Address, error) {
 	//gen new address
 	address := GenerateAddress(&key.PublicKey)
-	//save pass/address/key
-	encryptkey := hashBytes(address, []byte(pass))
-	encrypted, err := encrypt(address, encryptkey, key.Serialize())
-	if err != nil {
+	if err := ks.putKey(address, key.Serialize(), pass); err != nil {
 		return nil, err
 	}
-	ks.storage.Set(hashBytes(address, encryptkey), encrypted)
 	return address, nil
 }
 
+func (ks *Store) putKey(address Address, key []byte, pass string) error {
+	sealed, err := ks.seal(key, pass)
+	if err != nil {
+		return err
+	}
+	ks.storage.Set(storedKeyID(address), sealed)
+	return nil
+}
+
+// ReencryptKeys encrypts the keys opened by pass again by the current format
+// and parameters, and returns their addresses. The keys already in the
+// current ones are left as they are, and the others are skipped.
+func (ks *Store) ReencryptKeys(pass string) (reencrypted []Address, skipped []Address, err error) {
+	addresses, err := ks.GetAddresses()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, address := range addresses {
+		if sk, err := parseStoredKey(ks.storage.Get(storedKeyID(address))); err == nil && ks.isCurrent(sk) {
+			continue
+		}
+		if _, err := ks.getKey(address, pass); err != nil {
+			skipped = append(skipped, address)
+			continue
+		}
+		reencrypted = append(reencrypted, address)
+	}
+	if _, err := ks.getHDWallet(pass); err != nil && err != ErrNoHDWallet && err != types.ErrWrongAddressOrPassWord {
+		return reencrypted, skipped, err
+	}
+	return reencrypted, skipped, nil
+}
+
+// seal encrypts data by the current format.
+func (ks *Store) seal(data []byte, pass string) ([]byte, error) {
+	params := KeystoreKdfParams{Time: ks.argon2.Time, Memory: ks.argon2.Memory, Threads: ks.argon2.Threads}
+	c, k, err := encryptWithKdf(data, pass, KdfArgon2id, params)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&storedKey{Version: StoreVersion, Cipher: *c, Kdf: *k})
+}
+
+// open returns the data sealed, and whether it is sealed by the current
+// parameters.
+func (ks *Store) open(sealed []byte, pass string) ([]byte, bool, error) {
+	sk, err := parseStoredKey(sealed)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := decryptWithKdf(&sk.Cipher, &sk.Kdf, pass)
+	if err == ErrKeystorePassword {
+		return nil, false, types.ErrWrongAddressOrPassWord
+	} else if err != nil {
+		return nil, false, err
+	}
+	return data, ks.isCurrent(sk), nil
+}
+
+func (ks *Store) isCurrent(sk *storedKey) bool {
+	p := sk.Kdf.Params
+	return sk.Kdf.Algorithm == KdfArgon2id &&
+		p.Time == ks.argon2.Time && p.Memory == ks.argon2.Memory && p.Threads == ks.argon2.Threads
+}
+
+func parseStoredKey(sealed []byte) (*storedKey, error) {
+	var sk storedKey
+	if err := json.Unmarshal(sealed, &sk); err != nil {
+		return nil, errLegacyFormat
+	}
+	if sk.Version != StoreVersion {
+		return nil, ErrKeystoreVersion
+	}
+	return &sk, nil
+}
+
+func storedKeyID(address Address) []byte {
+	return append(append([]byte{}, storedKeyPrefix...), address...)
+}
+
 func hashBytes(b1 []byte, b2 []byte) []byte {
 	h := sha256.New()
 	h.Write(b1)
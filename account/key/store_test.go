@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -127,3 +130,106 @@ func TestSign(t *testing.T) {
 		}
 	}
 }
+
+// addLegacyKey stores a new key by the legacy format.
+func addLegacyKey(t *testing.T, pass string) Address {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := GenerateAddress(&key.PublicKey)
+	encryptkey := hashBytes(address, []byte(pass))
+	encrypted, err := encrypt(address, encryptkey, key.Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks.storage.Set(hashBytes(address, encryptkey), encrypted)
+	ks.SaveAddress(address)
+	return address
+}
+
+func TestMigrateLegacyKey(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	addr := addLegacyKey(t, "pass")
+	exported, err := ks.ExportKey(addr, "pass")
+	assert.NoError(t, err)
+
+	_, err = ks.Unlock(addr, "wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+	_, err = ks.Unlock(addr, "pass")
+	assert.NoError(t, err)
+	encryptkey := hashBytes(addr, []byte("pass"))
+	assert.Empty(t, ks.storage.Get(hashBytes(addr, encryptkey)), "legacy entry is removed")
+	sk, err := parseStoredKey(ks.storage.Get(storedKeyID(addr)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, KdfArgon2id, sk.Kdf.Algorithm)
+	}
+
+	migrated, err := ks.ExportKey(addr, "pass")
+	assert.NoError(t, err)
+	assert.Equal(t, exported, migrated)
+	_, err = ks.Unlock(addr, "wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+}
+
+func TestReencryptKeys(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	legacy := addLegacyKey(t, "pass")
+	other := addLegacyKey(t, "other")
+	created, err := ks.CreateKey("pass")
+	assert.NoError(t, err)
+	ks.SaveAddress(created)
+
+	reencrypted, skipped, err := ks.ReencryptKeys("pass")
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{legacy}, reencrypted)
+	assert.Equal(t, []Address{other}, skipped)
+
+	// the keys with other params are encrypted again
+	params := DefaultArgon2Params()
+	params.Time++
+	assert.NoError(t, ks.SetArgon2Params(params))
+	reencrypted, _, err = ks.ReencryptKeys("pass")
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{legacy, created}, reencrypted)
+	sk, _ := parseStoredKey(ks.storage.Get(storedKeyID(created)))
+	assert.Equal(t, params.Time, sk.Kdf.Params.Time)
+
+	assert.Error(t, ks.SetArgon2Params(Argon2Params{}))
+}
+
+func TestUnlockSession(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	addr, err := ks.CreateKey("pass")
+	assert.NoError(t, err)
+	tx := &types.Tx{Body: &types.TxBody{Account: addr}}
+
+	assert.False(t, ks.GetUnlockStatus(addr).Unlocked)
+	_, err = ks.UnlockFor(addr, "pass", 50*time.Millisecond)
+	assert.NoError(t, err)
+	status := ks.GetUnlockStatus(addr)
+	assert.True(t, status.Unlocked)
+	assert.Equal(t, 50*time.Millisecond, status.ExpireAt.Sub(status.UnlockedAt))
+	assert.NoError(t, ks.SignTx(tx, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, ks.GetUnlockStatus(addr).Unlocked, "locked automatically")
+	assert.Equal(t, types.ErrShouldUnlockAccount, ks.SignTx(tx, nil))
+
+	// the store doesn't lock by default, but the sessions are limited
+	_, err = ks.Unlock(addr, "pass")
+	assert.NoError(t, err)
+	assert.True(t, ks.GetUnlockStatus(addr).ExpireAt.IsZero())
+	ks.SetMaxUnlockDuration(time.Minute)
+	_, err = ks.UnlockFor(addr, "pass", time.Hour)
+	assert.NoError(t, err)
+	status = ks.GetUnlockStatus(addr)
+	assert.Equal(t, time.Minute, status.ExpireAt.Sub(status.UnlockedAt))
+
+	_, err = ks.Lock(addr, "pass")
+	assert.NoError(t, err)
+	assert.False(t, ks.GetUnlockStatus(addr).Unlocked)
+}
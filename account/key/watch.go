@@ -0,0 +1,64 @@
+package key
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// The watch-only addresses are tracked by the node without their private
+// keys, such as the ones kept in cold storages.
+
+var (
+	watchAddresses = []byte("WATCHONLY")
+
+	ErrWatchNotFound  = errors.New("address is not watched")
+	ErrAlreadyWatched = errors.New("address is already watched")
+	ErrAddressHasKey  = errors.New("address has its key in the keystore")
+)
+
+// AddWatchAddress adds the address to watch, which should not have its key
+// in the keystore.
+func (ks *Store) AddWatchAddress(addr Address) error {
+	if len(addr) != types.AddressLength {
+		return errors.New("invalid address length")
+	}
+	owned, _ := ks.GetAddresses()
+	if containsAddress(owned, addr) {
+		return ErrAddressHasKey
+	}
+	watched, _ := ks.GetWatchAddresses()
+	if containsAddress(watched, addr) {
+		return ErrAlreadyWatched
+	}
+	ks.storage.Set(watchAddresses, append(ks.storage.Get(watchAddresses), addr...))
+	return nil
+}
+
+// RemoveWatchAddress stops watching the address.
+func (ks *Store) RemoveWatchAddress(addr Address) error {
+	watched := ks.storage.Get(watchAddresses)
+	remains := make([]byte, 0, len(watched))
+	for i := 0; i+types.AddressLength <= len(watched); i += types.AddressLength {
+		if !bytes.Equal(watched[i:i+types.AddressLength], addr) {
+			remains = append(remains, watched[i:i+types.AddressLength]...)
+		}
+	}
+	if len(remains) == len(watched) {
+		return ErrWatchNotFound
+	}
+	ks.storage.Set(watchAddresses, remains)
+	return nil
+}
+
+// GetWatchAddresses returns the watched addresses in the order they were
+// added.
+func (ks *Store) GetWatchAddresses() ([]Address, error) {
+	b := ks.storage.Get(watchAddresses)
+	var ret []Address
+	for i := 0; i+types.AddressLength <= len(b); i += types.AddressLength {
+		ret = append(ret, b[i:i+types.AddressLength])
+	}
+	return ret, nil
+}
@@ -0,0 +1,35 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchAddress(t *testing.T) {
+	initTest()
+	defer deinitTest()
+	owned, err := ks.CreateKey("pass")
+	assert.NoError(t, err)
+	assert.NoError(t, ks.SaveAddress(owned))
+	cold := make([]byte, types.AddressLength)
+	cold[0] = 2
+	cold2 := make([]byte, types.AddressLength)
+	cold2[0] = 3
+
+	assert.Equal(t, ErrAddressHasKey, ks.AddWatchAddress(owned))
+	assert.Error(t, ks.AddWatchAddress([]byte{1}))
+	assert.NoError(t, ks.AddWatchAddress(cold))
+	assert.NoError(t, ks.AddWatchAddress(cold2))
+	assert.Equal(t, ErrAlreadyWatched, ks.AddWatchAddress(cold))
+
+	watched, err := ks.GetWatchAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []Address{cold, cold2}, watched)
+
+	assert.NoError(t, ks.RemoveWatchAddress(cold))
+	assert.Equal(t, ErrWatchNotFound, ks.RemoveWatchAddress(cold))
+	watched, _ = ks.GetWatchAddresses()
+	assert.Equal(t, []Address{cold2}, watched)
+}
@@ -1,17 +1,24 @@
 package account
 
 import (
+	"time"
+
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
 )
 
+var logger = logctl.NewLogger("account")
+
 type Signer struct {
 	keystore *key.Store
+	audit    *auditLog
 }
 
-func NewSigner(s *key.Store) *Signer {
-	return &Signer{keystore: s}
+func NewSigner(s *key.Store, audit *auditLog) *Signer {
+	return &Signer{keystore: s, audit: audit}
 }
 
 //Receive actor message
@@ -19,6 +26,7 @@ func (s *Signer) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *message.SignTx:
 		err := s.keystore.SignTx(msg.Tx, msg.Requester)
+		s.record(msg, err)
 		defer context.Self().Stop()
 		if err != nil {
 			context.Respond(&message.SignTxRsp{Tx: nil, Err: err})
@@ -28,3 +36,27 @@ func (s *Signer) Receive(context actor.Context) {
 		}
 	}
 }
+
+// record writes the signing to the audit log. A failure of the audit log
+// doesn't fail the signing but is logged.
+func (s *Signer) record(msg *message.SignTx, err error) {
+	if s.audit == nil {
+		return
+	}
+	audit := &types.SignAudit{
+		Time:    time.Now().UnixNano(),
+		Account: msg.Tx.GetBody().GetAccount(),
+		TxHash:  msg.Tx.GetHash(),
+		Client:  msg.Client,
+		Peer:    msg.Peer,
+	}
+	if msg.Requester != nil {
+		audit.Account = msg.Requester
+	}
+	if err != nil {
+		audit.Error = err.Error()
+	}
+	if err := s.audit.Record(audit); err != nil {
+		logger.Error().Err(err).Msg("failed to write the signing to the audit log")
+	}
+}
@@ -0,0 +1,155 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+)
+
+// The address index maps the accounts to the txs of the main chain they sent
+// or received. An entry is keyed by the address and the position of the tx,
+// the block number and the index in the block, so the txs of an account are
+// iterated in the block order. The recipient of a system or governance tx is
+// the name of the system contract, so those txs are indexed both for the
+// sender and for the system contract.
+//
+// The blocks connected before the index was introduced are not indexed, nor
+// the ones connected while the index is paused for the short disk space.
+
+var addrTxPrefix = []byte("a_tx.")
+
+const addrTxPosLength = 12
+
+func addrTxPos(blockNo types.BlockNo, idx int32) []byte {
+	pos := make([]byte, addrTxPosLength)
+	binary.BigEndian.PutUint64(pos, blockNo)
+	binary.BigEndian.PutUint32(pos[8:], uint32(idx))
+	return pos
+}
+
+// addrTxKeyPrefix returns the prefix of the entries of the address. The
+// length of the address is a part of the prefix since the names are shorter
+// than the addresses.
+func addrTxKeyPrefix(addr []byte) []byte {
+	prefix := make([]byte, 0, len(addrTxPrefix)+1+len(addr)+addrTxPosLength)
+	prefix = append(prefix, addrTxPrefix...)
+	prefix = append(prefix, byte(len(addr)))
+	return append(prefix, addr...)
+}
+
+func addrTxKey(addr []byte, pos []byte) []byte {
+	return append(addrTxKeyPrefix(addr), pos...)
+}
+
+// addrsOfTx returns the accounts of the tx without duplicates.
+func addrsOfTx(tx *types.Tx) [][]byte {
+	body := tx.GetBody()
+	addrs := make([][]byte, 0, 2)
+	if len(body.GetAccount()) != 0 {
+		addrs = append(addrs, body.GetAccount())
+	}
+	if len(body.GetRecipient()) != 0 && !bytes.Equal(body.GetRecipient(), body.GetAccount()) {
+		addrs = append(addrs, body.GetRecipient())
+	}
+	return addrs
+}
+
+func (cdb *ChainDB) addAddrTxsOfBlock(dbTx *db.Transaction, block *types.Block) {
+	for i, tx := range block.GetBody().GetTxs() {
+		pos := addrTxPos(block.BlockNo(), int32(i))
+		for _, addr := range addrsOfTx(tx) {
+			(*dbTx).Set(addrTxKey(addr, pos), tx.GetHash())
+		}
+	}
+}
+
+func (cdb *ChainDB) deleteAddrTxsOfBlock(dbTx *db.Transaction, block *types.Block) {
+	for i, tx := range block.GetBody().GetTxs() {
+		pos := addrTxPos(block.BlockNo(), int32(i))
+		for _, addr := range addrsOfTx(tx) {
+			(*dbTx).Delete(addrTxKey(addr, pos))
+		}
+	}
+}
+
+// getAddrTxPositions returns the positions of up to size txs of the address
+// from the position of the cursor, the latest first, and the cursor of the
+// following ones.
+func (cdb *ChainDB) getAddrTxPositions(addr []byte, cursor []byte, size int) ([][]byte, []byte, error) {
+	prefix := addrTxKeyPrefix(addr)
+	start := cursor
+	if len(start) == 0 {
+		start = bytes.Repeat([]byte{0xff}, addrTxPosLength)
+	} else if len(start) != addrTxPosLength {
+		return nil, nil, errors.New("invalid cursor")
+	}
+	// the iteration is in the reverse order since start is bigger than prefix
+	var positions [][]byte
+	for iter := cdb.store.Iterator(append(prefix, start...), prefix); iter.Valid(); iter.Next() {
+		pos := iter.Key()[len(prefix):]
+		if len(positions) == size {
+			return positions, append([]byte{}, pos...), nil
+		}
+		positions = append(positions, append([]byte{}, pos...))
+	}
+	return positions, nil, nil
+}
+
+// getAccountTxHistory returns a page of the txs sent or received by the
+// account of params, the latest first.
+func (cs *ChainService) getAccountTxHistory(params *types.AccountTxHistoryParams) (*types.AccountTxHistory, error) {
+	if len(params.GetAddress()) == 0 {
+		return nil, errors.New("no address")
+	}
+	size := params.GetSize()
+	if size == 0 {
+		size = defaultEventListSize
+	} else if size > maxEventListSize {
+		return nil, fmt.Errorf("too big size %d (max %d)", size, maxEventListSize)
+	}
+	positions, next, err := cs.cdb.getAddrTxPositions(params.GetAddress(), params.GetCursor(), int(size))
+	if err != nil {
+		return nil, err
+	}
+
+	history := &types.AccountTxHistory{NextCursor: next}
+	var block *types.Block
+	var receipts []*types.Receipt
+	for _, pos := range positions {
+		blockNo := binary.BigEndian.Uint64(pos)
+		idx := int32(binary.BigEndian.Uint32(pos[8:]))
+		if block == nil || block.BlockNo() != blockNo {
+			if block, err = cs.cdb.GetBlockByNo(blockNo); err != nil {
+				return nil, err
+			}
+			receipts = nil
+			if r, err := cs.cdb.getReceipts(block.BlockHash(), blockNo); err == nil {
+				receipts = r.Get()
+			}
+		}
+		txs := block.GetBody().GetTxs()
+		if int(idx) >= len(txs) {
+			return nil, fmt.Errorf("wrong tx idx: %d", idx)
+		}
+		accountTx := &types.AccountTx{
+			Tx:        txs[idx],
+			BlockHash: block.BlockHash(),
+			BlockNo:   blockNo,
+			TxIdx:     idx,
+		}
+		if int(idx) < len(receipts) {
+			accountTx.Status = receipts[idx].Status
+		}
+		history.Txs = append(history.Txs, accountTx)
+	}
+	return history, nil
+}
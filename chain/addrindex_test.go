@@ -0,0 +1,77 @@
+package chain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountTxHistory(t *testing.T) {
+	const dir = "test_addrindex"
+	defer os.RemoveAll(dir)
+
+	cdb := NewChainDB()
+	assert.NoError(t, cdb.Init(string(db.BadgerImpl), dir))
+	defer cdb.Close()
+	cs := &ChainService{Core: &Core{cdb: cdb}}
+
+	alice, bob := []byte("alice"), []byte("bob")
+	newTx := func(from, to []byte, nonce uint64) *types.Tx {
+		tx := &types.Tx{Body: &types.TxBody{Account: from, Recipient: to, Nonce: nonce}}
+		tx.Hash = tx.CalculateTxHash()
+		return tx
+	}
+	connect := func(prev *types.Block, txs ...*types.Tx) *types.Block {
+		block := types.NewBlock(prev, nil, nil, txs, nil, 0)
+		dbTx := cdb.NewTx()
+		cdb.connectToChain(&dbTx, block, false)
+		cdb.addTxsOfBlock(&dbTx, block)
+		cdb.addAddrTxsOfBlock(&dbTx, block)
+		dbTx.Commit()
+		receipts := &types.Receipts{}
+		for range txs {
+			receipts.Set(append(receipts.Get(), types.NewReceipt(nil, "SUCCESS", "{}")))
+		}
+		cdb.writeReceipts(block.BlockHash(), block.BlockNo(), receipts)
+		return block
+	}
+	genesis := connect(nil)
+	b1 := connect(genesis, newTx(alice, bob, 1), newTx(bob, []byte(types.AergoSystem), 1))
+	b2 := connect(b1, newTx(alice, alice, 2), newTx(bob, alice, 2), newTx(alice, []byte(types.AergoName), 3))
+
+	history, err := cs.getAccountTxHistory(&types.AccountTxHistoryParams{Address: alice, Size: 2})
+	assert.NoError(t, err)
+	if assert.Len(t, history.Txs, 2) {
+		assert.Equal(t, b2.BlockHash(), history.Txs[0].BlockHash)
+		assert.Equal(t, int32(2), history.Txs[0].TxIdx, "the latest first")
+		assert.Equal(t, []byte(types.AergoName), history.Txs[0].Tx.Body.Recipient, "governance tx")
+		assert.Equal(t, int32(1), history.Txs[1].TxIdx, "received")
+		assert.Equal(t, "SUCCESS", history.Txs[1].Status)
+	}
+	history, err = cs.getAccountTxHistory(&types.AccountTxHistoryParams{Address: alice, Size: 2, Cursor: history.NextCursor})
+	assert.NoError(t, err)
+	if assert.Len(t, history.Txs, 2) {
+		assert.Equal(t, uint64(2), history.Txs[0].BlockNo)
+		assert.Equal(t, int32(0), history.Txs[0].TxIdx, "a tx to itself is listed once")
+		assert.Equal(t, uint64(1), history.Txs[1].BlockNo)
+	}
+	assert.Nil(t, history.NextCursor, "last page")
+
+	history, err = cs.getAccountTxHistory(&types.AccountTxHistoryParams{Address: []byte(types.AergoSystem)})
+	assert.NoError(t, err)
+	assert.Len(t, history.Txs, 1)
+
+	// the txs of a dropped block are removed from the index
+	assert.NoError(t, cdb.dropBlock(b2.BlockNo()))
+	history, err = cs.getAccountTxHistory(&types.AccountTxHistoryParams{Address: alice})
+	assert.NoError(t, err)
+	if assert.Len(t, history.Txs, 1) {
+		assert.Equal(t, b1.BlockHash(), history.Txs[0].BlockHash)
+	}
+
+	_, err = cs.getAccountTxHistory(&types.AccountTxHistoryParams{Address: alice, Cursor: []byte{1}})
+	assert.Error(t, err)
+}
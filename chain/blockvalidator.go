@@ -16,6 +16,7 @@ import (
 
 type BlockValidator struct {
 	signVerifier *SignVerifier
+	pipeline     *verifyPipeline
 	sdb          *state.ChainStateDB
 	isNeedWait   bool
 }
@@ -38,7 +39,14 @@ func NewBlockValidator(comm component.IComponentRequester, sdb *state.ChainState
 	return &bv
 }
 
+// startPipeline starts the pipeline verifying the blocks ahead of their
+// execution, where verifySign verifies the block signature.
+func (bv *BlockValidator) startPipeline(verifySign func(block *types.Block) error) {
+	bv.pipeline = newVerifyPipeline(verifySign, VerifierCount)
+}
+
 func (bv *BlockValidator) Stop() {
+	bv.pipeline.stop()
 	bv.signVerifier.Stop()
 }
 
@@ -70,6 +78,20 @@ func (bv *BlockValidator) ValidateHeader(header *types.BlockHeader) error {
 func (bv *BlockValidator) ValidateBody(block *types.Block) error {
 	txs := block.GetBody().GetTxs()
 
+	// the block verified ahead by the pipeline. The hash covers only the
+	// header, so a body other than the verified one is verified as usual.
+	if task := bv.pipeline.take(block); task != nil && task.verifies(block) {
+		if err := task.wait(); err != nil {
+			return err
+		}
+		if len(txs) == 0 {
+			return nil
+		}
+		bv.signVerifier.requestVerifyTxs(txs, task.signed, block.BlockNo())
+		bv.isNeedWait = true
+		return nil
+	}
+
 	// TxRootHash
 	logger.Debug().Int("Txlen", len(txs)).Str("TxRoot", enc.ToString(block.GetHeader().GetTxsRootHash())).
 		Msg("tx root verify")
@@ -88,7 +110,7 @@ func (bv *BlockValidator) ValidateBody(block *types.Block) error {
 		return nil
 	}
 
-	bv.signVerifier.RequestVerifyTxs(&types.TxList{Txs: txs})
+	bv.signVerifier.RequestVerifyTxs(&types.TxList{Txs: txs}, block.BlockNo())
 	bv.isNeedWait = true
 
 	return nil
@@ -18,6 +18,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/storage"
 	"github.com/aergoio/aergo/types"
 	"github.com/gogo/protobuf/proto"
 )
@@ -33,6 +34,7 @@ var (
 	ErrNoChainDB           = fmt.Errorf("chaindb not prepared")
 	ErrorLoadBestBlock     = errors.New("failed to load latest block from DB")
 	ErrCantDropGenesis     = errors.New("can't drop genesis block")
+	ErrCantDropPruned      = errors.New("can't drop block whose body is pruned")
 	ErrTooBigResetHeight   = errors.New("reset height is too big")
 	ErrInvalidHardState    = errors.New("invalid hard state")
 	ErrInvalidRaftSnapshot = errors.New("invalid raft snapshot")
@@ -40,11 +42,13 @@ var (
 	latestKey      = []byte(chainDBName + ".latest")
 	receiptsPrefix = []byte("r")
 
-	raftIdentityKey     = []byte("r_identity")
-	raftStateKey        = []byte("r_state")
-	raftSnapKey         = []byte("r_snap")
-	raftEntryLastIdxKey = []byte("r_last")
-	raftEntryPrefix     = []byte("r_entry.")
+	raftIdentityKey       = []byte("r_identity")
+	raftProposedKey       = []byte("r_proposed")
+	raftStateKey          = []byte("r_state")
+	raftSnapKey           = []byte("r_snap")
+	raftEntryLastIdxKey   = []byte("r_last")
+	raftEntryPrefix       = []byte("r_entry.")
+	raftEntryInvertPrefix = []byte("r_inv.")
 )
 
 // ErrNoBlock reports there is no such a block with id (hash or block number).
@@ -72,6 +76,19 @@ type ChainDB struct {
 	bestBlock atomic.Value // *types.Block
 	//	blocks []*types.Block
 	store db.DB
+
+	// cold is the secondary storage of old block bodies and receipts
+	cold     db.DB
+	hotCount uint64
+	coldTail types.BlockNo // blocks lower than coldTail are in cold storage
+
+	// the bodies of the blocks lower than pruneTail are pruned
+	pruning   bool
+	pruneTail types.BlockNo
+
+	// the receipts of the blocks lower than packTail are packed
+	packTail       types.BlockNo
+	receiptsPacked bool // all the receipts are packed
 }
 
 func NewChainDB() *ChainDB {
@@ -92,13 +109,20 @@ func (cdb *ChainDB) NewTx() db.Transaction {
 func (cdb *ChainDB) Init(dbType string, dataDir string) error {
 	if cdb.store == nil {
 		dbPath := common.PathMkdirAll(dataDir, chainDBName)
-		cdb.store = db.NewDB(db.ImplType(dbType), dbPath)
+		store, err := storage.Open(dbType, dbPath)
+		if err != nil {
+			return err
+		}
+		cdb.store = store
 	}
 
 	// load data
 	if err := cdb.loadChainData(); err != nil {
 		return err
 	}
+	if tail := cdb.store.Get(receiptsPackTailKey); len(tail) != 0 {
+		cdb.packTail = types.BlockNoFromBytes(tail)
+	}
 
 	// recover from reorg marker
 	if err := cdb.recover(); err != nil {
@@ -207,6 +231,9 @@ func (cdb *ChainDB) Close() {
 	if cdb.store != nil {
 		cdb.store.Close()
 	}
+	if cdb.cold != nil {
+		cdb.cold.Close()
+	}
 	return
 }
 
@@ -283,7 +310,7 @@ func (cdb *ChainDB) loadChainData() error {
 }
 
 func (cdb *ChainDB) loadData(key []byte, pb proto.Message) error {
-	buf := cdb.store.Get(key)
+	buf := cdb.getTiered(key)
 	if buf == nil || len(buf) == 0 {
 		return fmt.Errorf("failed to load data: key=%v", key)
 	}
@@ -452,14 +479,9 @@ type txInfo struct {
 	idx       int
 }
 
-func (cdb *ChainDB) addTxsOfBlock(dbTx *db.Transaction, txs []*types.Tx, blockHash []byte) error {
-	for i, txEntry := range txs {
-		if err := cdb.addTx(dbTx, txEntry, blockHash, i); err != nil {
-			logger.Error().Err(err).Str("hash", enc.ToString(blockHash)).Int("txidx", i).
-				Msg("failed to add tx")
-
-			return err
-		}
+func (cdb *ChainDB) addTxsOfBlock(dbTx *db.Transaction, block *types.Block) error {
+	for i, txEntry := range block.GetBody().GetTxs() {
+		cdb.addTx(dbTx, txEntry, block.BlockNo(), i)
 
 		if err := TestDebugger.Check(DEBUG_CHAIN_STOP, 4, nil); err != nil {
 			return err
@@ -469,24 +491,6 @@ func (cdb *ChainDB) addTxsOfBlock(dbTx *db.Transaction, txs []*types.Tx, blockHa
 	return nil
 }
 
-// stor tx info to DB
-func (cdb *ChainDB) addTx(dbtx *db.Transaction, tx *types.Tx, blockHash []byte, idx int) error {
-	txidx := types.TxIdx{
-		BlockHash: blockHash,
-		Idx:       int32(idx),
-	}
-	txidxbytes, err := proto.Marshal(&txidx)
-	if err != nil {
-		return err
-	}
-	(*dbtx).Set(tx.Hash, txidxbytes)
-	return nil
-}
-
-func (cdb *ChainDB) deleteTx(dbtx *db.Transaction, tx *types.Tx) {
-	(*dbtx).Delete(tx.Hash)
-}
-
 // store block info to DB
 func (cdb *ChainDB) addBlock(dbtx *db.Transaction, block *types.Block) error {
 	blockNo := block.GetHeader().GetBlockNo()
@@ -527,14 +531,20 @@ func (cdb *ChainDB) dropBlock(dropNo types.BlockNo) error {
 	if err != nil {
 		return err
 	}
+	if cdb.isPruned(dropNo) {
+		return ErrCantDropPruned
+	}
 
 	// remove tx mapping
 	for _, tx := range dropBlock.GetBody().GetTxs() {
 		cdb.deleteTx(&dbTx, tx)
 	}
+	cdb.deleteAddrTxsOfBlock(&dbTx, dropBlock)
 
 	// remove receipt
 	cdb.deleteReceipts(&dbTx, dropBlock.BlockHash(), dropBlock.BlockNo())
+	cdb.deleteInternalOps(&dbTx, dropBlock)
+	cdb.deleteEventIndex(&dbTx, dropBlock)
 
 	// remove (hash/block)
 	dbTx.Delete(dropBlock.BlockHash())
@@ -549,6 +559,8 @@ func (cdb *ChainDB) dropBlock(dropNo types.BlockNo) error {
 
 	dbTx.Commit()
 
+	cdb.dropCold(dropBlock)
+
 	prevBlock, err := cdb.GetBlockByNo(dropNo - 1)
 	if err != nil {
 		return err
@@ -613,12 +625,15 @@ func (cdb *ChainDB) getHashByNo(blockNo types.BlockNo) ([]byte, error) {
 	return blockHash, nil
 }
 
+// getTx returns the tx and its location. Only the location is returned for a
+// tx whose block body is pruned.
 func (cdb *ChainDB) getTx(txHash []byte) (*types.Tx, *types.TxIdx, error) {
-	txIdx := &types.TxIdx{}
-
-	err := cdb.loadData(txHash, txIdx)
+	blockNo, txIdx, err := cdb.getTxLocation(txHash)
 	if err != nil {
-		return nil, nil, fmt.Errorf("tx not found: txHash=%v", enc.ToString(txHash))
+		return nil, nil, err
+	}
+	if cdb.isPruned(blockNo) {
+		return nil, txIdx, nil
 	}
 	block, err := cdb.getBlock(txIdx.BlockHash)
 	if err != nil {
@@ -639,6 +654,9 @@ func (cdb *ChainDB) getReceipt(blockHash []byte, blockNo types.BlockNo, idx int3
 	if err != nil {
 		return nil, err
 	}
+	if err := storedReceipts.Err(); err != nil {
+		return nil, err
+	}
 	receipts := storedReceipts.Get()
 
 	if idx < 0 || idx > int32(len(receipts)) {
@@ -649,18 +667,15 @@ func (cdb *ChainDB) getReceipt(blockHash []byte, blockNo types.BlockNo, idx int3
 	return receipts[idx], nil
 }
 
+// getReceipts returns the receipts of the block. The packed receipts are
+// decoded on the first access of them, so the decoding error is returned by
+// their Err.
 func (cdb *ChainDB) getReceipts(blockHash []byte, blockNo types.BlockNo) (*types.Receipts, error) {
-	data := cdb.store.Get(receiptsKey(blockHash, blockNo))
+	data := cdb.getTiered(receiptsKey(blockHash, blockNo))
 	if len(data) == 0 {
 		return nil, errors.New("cannot find a receipt")
 	}
-	var b bytes.Buffer
-	b.Write(data)
-	var receipts types.Receipts
-	decoder := gob.NewDecoder(&b)
-	decoder.Decode(&receipts)
-
-	return &receipts, nil
+	return decodeReceipts(data)
 }
 
 type ChainTree struct {
@@ -689,17 +704,22 @@ func (cdb *ChainDB) GetChainTree() ([]byte, error) {
 	return jsonBytes, nil
 }
 
-func (cdb *ChainDB) writeReceipts(blockHash []byte, blockNo types.BlockNo, receipts *types.Receipts) {
+// writeReceipts stores the receipts of the block packed. It returns the sizes
+// of the receipts before and after the packing.
+func (cdb *ChainDB) writeReceipts(blockHash []byte, blockNo types.BlockNo, receipts *types.Receipts) (int, int, error) {
+	packed, rawSize, err := receipts.MarshalPacked()
+	if err != nil {
+		return 0, 0, err
+	}
+
 	dbTx := cdb.store.NewTx()
 	defer dbTx.Discard()
 
-	var val bytes.Buffer
-	gob := gob.NewEncoder(&val)
-	gob.Encode(receipts)
-
-	dbTx.Set(receiptsKey(blockHash, blockNo), val.Bytes())
+	dbTx.Set(receiptsKey(blockHash, blockNo), packed)
 
 	dbTx.Commit()
+
+	return rawSize, len(packed), nil
 }
 
 func (cdb *ChainDB) deleteReceipts(dbTx *db.Transaction, blockHash []byte, blockNo types.BlockNo) {
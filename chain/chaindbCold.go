@@ -0,0 +1,109 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/storage"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	// maxColdMoveCount limits the number of blocks moved to cold storage at
+	// once, so that enabling cold storage on an existing chain does not stall
+	// the block connection.
+	maxColdMoveCount = 100
+)
+
+var (
+	coldTailKey = []byte(chainDBName + ".coldTail")
+)
+
+// InitColdStorage opens the secondary storage which the bodies and receipts
+// of old blocks are moved to. Only the latest hotCount blocks are kept on
+// the primary storage. Reads of moved blocks fall through to the cold
+// storage.
+func (cdb *ChainDB) InitColdStorage(dbType string, coldDir string, hotCount uint64) error {
+	if cdb.cold == nil {
+		dbPath := common.PathMkdirAll(coldDir, chainDBName)
+		store, err := storage.Open(dbType, dbPath)
+		if err != nil {
+			return err
+		}
+		cdb.cold = store
+	}
+	cdb.hotCount = hotCount
+	if tail := cdb.store.Get(coldTailKey); len(tail) != 0 {
+		cdb.coldTail = types.BlockNoFromBytes(tail)
+	}
+	logger.Info().Str("dir", coldDir).Uint64("hotCount", hotCount).Uint64("coldTail", cdb.coldTail).
+		Msg("cold storage enabled")
+	return nil
+}
+
+// getTiered returns the value of key from the primary storage, or from the
+// cold storage if the key has been moved there.
+func (cdb *ChainDB) getTiered(key []byte) []byte {
+	buf := cdb.store.Get(key)
+	if len(buf) == 0 && cdb.cold != nil {
+		buf = cdb.cold.Get(key)
+	}
+	return buf
+}
+
+// moveToCold moves the bodies and receipts of the main chain blocks which
+// are not among the latest hotCount blocks to the cold storage.
+func (cdb *ChainDB) moveToCold() error {
+	if cdb.cold == nil {
+		return nil
+	}
+	best := cdb.getBestBlockNo()
+	for moved := 0; cdb.coldTail+cdb.hotCount <= best && moved < maxColdMoveCount; moved++ {
+		blockNo := cdb.coldTail
+		blockHash, err := cdb.getHashByNo(blockNo)
+		if err != nil {
+			return err
+		}
+		rKey := receiptsKey(blockHash, blockNo)
+
+		// copy to cold storage before deleting from primary storage, so
+		// that the data is readable at any time
+		coldTx := cdb.cold.NewTx()
+		if blockBytes := cdb.store.Get(blockHash); len(blockBytes) != 0 {
+			coldTx.Set(blockHash, blockBytes)
+		}
+		if receipts := cdb.store.Get(rKey); len(receipts) != 0 {
+			coldTx.Set(rKey, receipts)
+		}
+		coldTx.Commit()
+
+		hotTx := cdb.store.NewTx()
+		hotTx.Delete(blockHash)
+		hotTx.Delete(rKey)
+		hotTx.Set(coldTailKey, types.BlockNoToBytes(blockNo+1))
+		hotTx.Commit()
+
+		cdb.coldTail = blockNo + 1
+	}
+	return nil
+}
+
+// dropCold removes a dropped block from the cold storage.
+func (cdb *ChainDB) dropCold(block *types.Block) {
+	if cdb.cold == nil || block.BlockNo() >= cdb.coldTail {
+		return
+	}
+	coldTx := cdb.cold.NewTx()
+	coldTx.Delete(block.BlockHash())
+	coldTx.Delete(receiptsKey(block.BlockHash(), block.BlockNo()))
+	coldTx.Commit()
+
+	hotTx := cdb.store.NewTx()
+	hotTx.Set(coldTailKey, types.BlockNoToBytes(block.BlockNo()))
+	hotTx.Commit()
+
+	cdb.coldTail = block.BlockNo()
+}
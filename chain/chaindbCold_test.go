@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainDBColdStorage(t *testing.T) {
+	const (
+		hotDir  = "test_hot"
+		coldDir = "test_cold"
+	)
+	defer os.RemoveAll(hotDir)
+	defer os.RemoveAll(coldDir)
+
+	cdb := NewChainDB()
+	err := cdb.Init(string(db.BadgerImpl), hotDir)
+	assert.NoError(t, err)
+	defer cdb.Close()
+	err = cdb.InitColdStorage(string(db.BadgerImpl), coldDir, 2)
+	assert.NoError(t, err)
+
+	var blocks []*types.Block
+	var prev *types.Block
+	for i := 0; i < 5; i++ {
+		block := types.NewBlock(prev, nil, nil, nil, nil, int64(i))
+		block.BlockHash()
+		tx := cdb.NewTx()
+		cdb.connectToChain(&tx, block, false)
+		tx.Commit()
+		receipts := &types.Receipts{}
+		receipts.Set([]*types.Receipt{types.NewReceipt(nil, "SUCCESS", "{}")})
+		cdb.writeReceipts(block.BlockHash(), block.BlockNo(), receipts)
+		assert.NoError(t, cdb.moveToCold())
+		blocks = append(blocks, block)
+		prev = block
+	}
+
+	// blocks 0, 1 and 2 are moved, 3 and 4 are kept on primary storage
+	assert.Equal(t, types.BlockNo(3), cdb.coldTail)
+	for _, block := range blocks {
+		moved := block.BlockNo() < cdb.coldTail
+		assert.Equal(t, moved, len(cdb.store.Get(block.BlockHash())) == 0)
+		assert.Equal(t, moved, len(cdb.cold.Get(block.BlockHash())) != 0)
+
+		// reads fall through to the cold storage
+		found, err := cdb.GetBlockByNo(block.BlockNo())
+		assert.NoError(t, err)
+		assert.Equal(t, block.BlockHash(), found.BlockHash())
+		_, err = cdb.getReceipts(block.BlockHash(), block.BlockNo())
+		assert.NoError(t, err)
+	}
+}
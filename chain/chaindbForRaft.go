@@ -16,6 +16,7 @@ var (
 	ErrNoWalEntry         = errors.New("no entry")
 	ErrEncodeRaftIdentity = errors.New("failed encoding of raft identity")
 	ErrDecodeRaftIdentity = errors.New("failed decoding of raft identity")
+	ErrDecodeProposed     = errors.New("failed decoding of proposed block")
 )
 
 // implement ChainWAL interface
@@ -73,6 +74,13 @@ func getRaftEntryKey(idx uint64) []byte {
 	return key.Bytes()
 }
 
+func getRaftEntryInvertKey(blockHash []byte) []byte {
+	var key bytes.Buffer
+	key.Write(raftEntryInvertPrefix)
+	key.Write(blockHash)
+	return key.Bytes()
+}
+
 func (cdb *ChainDB) WriteRaftEntry(ents []*consensus.WalEntry, blocks []*types.Block) error {
 	var data []byte
 	var err error
@@ -88,6 +96,7 @@ func (cdb *ChainDB) WriteRaftEntry(ents []*consensus.WalEntry, blocks []*types.B
 				panic("add block entry")
 				return err
 			}
+			dbTx.Set(getRaftEntryInvertKey(blocks[i].BlockHash()), types.BlockNoToBytes(entry.Index))
 		}
 
 		if data, err = entry.ToBytes(); err != nil {
@@ -130,6 +139,15 @@ func (cdb *ChainDB) GetRaftEntry(idx uint64) (*consensus.WalEntry, error) {
 	return &entry, nil
 }
 
+// GetRaftEntryIndexOfBlock returns the index of the raft entry of the block.
+func (cdb *ChainDB) GetRaftEntryIndexOfBlock(hash []byte) (uint64, error) {
+	data := cdb.store.Get(getRaftEntryInvertKey(hash))
+	if len(data) != 8 {
+		return 0, ErrNoWalEntry
+	}
+	return types.BlockNoFromBytes(data), nil
+}
+
 func (cdb *ChainDB) GetRaftEntryLastIdx() (uint64, error) {
 	lastBytes := cdb.store.Get(raftEntryLastIdxKey)
 	if lastBytes == nil || len(lastBytes) == 0 {
@@ -280,3 +298,38 @@ func (cdb *ChainDB) GetIdentity() (*consensus.RaftIdentity, error) {
 
 	return &id, nil
 }
+
+// WriteProposed saves the block proposed but not committed yet, or deletes
+// the saved one if block is nil.
+func (cdb *ChainDB) WriteProposed(block *types.Block) error {
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	if block == nil {
+		dbTx.Delete(raftProposedKey)
+	} else {
+		data, err := proto.Marshal(block)
+		if err != nil {
+			return err
+		}
+		dbTx.Set(raftProposedKey, data)
+	}
+	dbTx.Commit()
+
+	return nil
+}
+
+// GetProposed returns the block saved by WriteProposed, or nil if there is
+// none.
+func (cdb *ChainDB) GetProposed() (*types.Block, error) {
+	data := cdb.store.Get(raftProposedKey)
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var block types.Block
+	if err := proto.Unmarshal(data, &block); err != nil {
+		return nil, ErrDecodeProposed
+	}
+	return &block, nil
+}
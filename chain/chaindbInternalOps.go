@@ -0,0 +1,65 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+var (
+	internalOpsPrefix = []byte("iop.")
+)
+
+// internalOpsKey is the key of the internal operations of a tx. The key holds
+// the hash of the block as well so that the operations of a tx executed again
+// in another block after a reorganization do not mix.
+func internalOpsKey(blockHash, txHash []byte) []byte {
+	var key bytes.Buffer
+	key.Write(internalOpsPrefix)
+	key.Write(blockHash)
+	key.Write(txHash)
+	return key.Bytes()
+}
+
+// writeInternalOps stores the internal operations of the txs of the block.
+func (cdb *ChainDB) writeInternalOps(blockHash []byte, ops []*types.InternalOperations) error {
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	for _, txOps := range ops {
+		data, err := proto.Marshal(txOps)
+		if err != nil {
+			return err
+		}
+		dbTx.Set(internalOpsKey(blockHash, txOps.GetTxHash()), data)
+	}
+	dbTx.Commit()
+	return nil
+}
+
+// getInternalOps returns the internal operations of the tx in the block, or
+// nil if the tx made none.
+func (cdb *ChainDB) getInternalOps(blockHash, txHash []byte) (*types.InternalOperations, error) {
+	data := cdb.store.Get(internalOpsKey(blockHash, txHash))
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var ops types.InternalOperations
+	if err := proto.Unmarshal(data, &ops); err != nil {
+		return nil, err
+	}
+	return &ops, nil
+}
+
+func (cdb *ChainDB) deleteInternalOps(dbTx *db.Transaction, block *types.Block) {
+	for _, tx := range block.GetBody().GetTxs() {
+		(*dbTx).Delete(internalOpsKey(block.BlockHash(), tx.GetHash()))
+	}
+}
@@ -0,0 +1,72 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"errors"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	pruneTailKey = []byte(chainDBName + ".pruneTail")
+)
+
+// InitBodyPruning makes the bodies of the main chain blocks which are not
+// among the latest hotCount blocks dropped. Their headers, receipts and the
+// tx index are kept, so the txs are still located, but the pruned blocks
+// can't be served to the syncing peers.
+func (cdb *ChainDB) InitBodyPruning(hotCount uint64) error {
+	if cdb.cold != nil {
+		return errors.New("body pruning and cold storage are exclusive")
+	}
+	if hotCount == 0 {
+		return errors.New("body pruning needs a positive hot block count")
+	}
+	cdb.pruning = true
+	cdb.hotCount = hotCount
+	if tail := cdb.store.Get(pruneTailKey); len(tail) != 0 {
+		cdb.pruneTail = types.BlockNoFromBytes(tail)
+	}
+	logger.Info().Uint64("hotCount", hotCount).Uint64("pruneTail", cdb.pruneTail).Msg("body pruning enabled")
+	return nil
+}
+
+// isPruned reports whether the body of the main chain block is pruned.
+func (cdb *ChainDB) isPruned(blockNo types.BlockNo) bool {
+	return blockNo < cdb.pruneTail
+}
+
+// pruneBodies replaces the main chain blocks which are not among the latest
+// hotCount blocks by their headers.
+func (cdb *ChainDB) pruneBodies() error {
+	if !cdb.pruning {
+		return nil
+	}
+	best := cdb.getBestBlockNo()
+	// the same limit as the moves to cold storage not to stall the block
+	// connection on enabling pruning on an existing chain
+	for pruned := 0; cdb.pruneTail+cdb.hotCount <= best && pruned < maxColdMoveCount; pruned++ {
+		blockNo := cdb.pruneTail
+		block, err := cdb.GetBlockByNo(blockNo)
+		if err != nil {
+			return err
+		}
+		blockBytes, err := proto.Marshal(&types.Block{Hash: block.Hash, Header: block.Header})
+		if err != nil {
+			return err
+		}
+
+		dbTx := cdb.store.NewTx()
+		dbTx.Set(block.BlockHash(), blockBytes)
+		dbTx.Set(pruneTailKey, types.BlockNoToBytes(blockNo+1))
+		dbTx.Commit()
+
+		cdb.pruneTail = blockNo + 1
+	}
+	return nil
+}
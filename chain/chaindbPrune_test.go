@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainDBBodyPruning(t *testing.T) {
+	const dir = "test_prune"
+	defer os.RemoveAll(dir)
+
+	cdb := NewChainDB()
+	err := cdb.Init(string(db.BadgerImpl), dir)
+	assert.NoError(t, err)
+	defer cdb.Close()
+	assert.Error(t, cdb.InitBodyPruning(0))
+	assert.NoError(t, cdb.InitBodyPruning(2))
+
+	var (
+		blocks []*types.Block
+		txs    []*types.Tx
+		prev   *types.Block
+	)
+	for i := 0; i < 5; i++ {
+		tx := &types.Tx{Body: &types.TxBody{Account: []byte("alice"), Nonce: uint64(i + 1)}}
+		tx.Hash = tx.CalculateTxHash()
+		block := types.NewBlock(prev, nil, nil, []*types.Tx{tx}, nil, int64(i))
+		dbTx := cdb.NewTx()
+		cdb.connectToChain(&dbTx, block, false)
+		assert.NoError(t, cdb.addTxsOfBlock(&dbTx, block))
+		dbTx.Commit()
+		assert.NoError(t, cdb.pruneBodies())
+		blocks = append(blocks, block)
+		txs = append(txs, tx)
+		prev = block
+	}
+
+	// the bodies of blocks 0, 1 and 2 are pruned, 3 and 4 are kept
+	assert.Equal(t, types.BlockNo(3), cdb.pruneTail)
+	for i, block := range blocks {
+		pruned := cdb.isPruned(block.BlockNo())
+		found, err := cdb.GetBlockByNo(block.BlockNo())
+		assert.NoError(t, err)
+		assert.Equal(t, block.BlockHash(), found.BlockHash())
+		assert.Equal(t, pruned, found.GetBody() == nil)
+
+		// the txs are located without the bodies
+		tx, txIdx, err := cdb.getTx(txs[i].GetHash())
+		assert.NoError(t, err)
+		assert.Equal(t, block.BlockHash(), txIdx.GetBlockHash())
+		assert.Equal(t, pruned, tx == nil)
+	}
+
+	// a tx of a pruned block is detected as a duplicate
+	dup := types.NewBlock(prev, nil, nil, []*types.Tx{txs[0]}, nil, 5)
+	assert.Equal(t, types.ErrTxAlreadyInChain, cdb.checkDuplicateTxs(dup))
+	fresh := &types.Tx{Body: &types.TxBody{Account: []byte("alice"), Nonce: 6}}
+	fresh.Hash = fresh.CalculateTxHash()
+	assert.NoError(t, cdb.checkDuplicateTxs(types.NewBlock(prev, nil, nil, []*types.Tx{fresh}, nil, 5)))
+
+	// the entries of the old format are still read
+	legacy, _ := proto.Marshal(&types.TxIdx{BlockHash: blocks[1].BlockHash(), Idx: 0})
+	dbTx := cdb.NewTx()
+	dbTx.Set(txs[1].GetHash(), legacy)
+	dbTx.Commit()
+	blockNo, txIdx, err := cdb.getTxLocation(txs[1].GetHash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNo(1), blockNo)
+	assert.Equal(t, blocks[1].BlockHash(), txIdx.GetBlockHash())
+
+	assert.Equal(t, ErrCantDropPruned, cdb.dropBlock(2))
+	assert.NoError(t, cdb.dropBlock(4))
+}
@@ -0,0 +1,98 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	// maxReceiptsPackCount limits the number of blocks whose receipts are
+	// packed at once, so that the packing of the receipts stored before does
+	// not stall the block connection.
+	maxReceiptsPackCount = 100
+)
+
+var (
+	receiptsPackTailKey = []byte(chainDBName + ".receiptsPackTail")
+)
+
+// decodeReceipts decodes the stored receipts, either packed or gob encoded as
+// stored before the packing.
+func decodeReceipts(data []byte) (*types.Receipts, error) {
+	var receipts types.Receipts
+	if types.IsPackedReceipts(data) {
+		if err := receipts.UnmarshalPacked(data); err != nil {
+			return nil, err
+		}
+		return &receipts, nil
+	}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&receipts); err != nil {
+		return nil, err
+	}
+	return &receipts, nil
+}
+
+// packOldReceipts packs the receipts of the main chain blocks stored before
+// the packing, from the lowest block not checked yet. It returns the number
+// of the blocks packed and the sizes of their receipts before and after the
+// packing.
+func (cdb *ChainDB) packOldReceipts() (int, int, int, error) {
+	if cdb.receiptsPacked {
+		return 0, 0, 0, nil
+	}
+	var blocks, rawSize, packedSize int
+	best := cdb.getBestBlockNo()
+	for n := 0; cdb.packTail <= best && n < maxReceiptsPackCount; n++ {
+		blockNo := cdb.packTail
+		blockHash, err := cdb.getHashByNo(blockNo)
+		if err != nil {
+			return blocks, rawSize, packedSize, err
+		}
+		rKey := receiptsKey(blockHash, blockNo)
+
+		// the receipts may have been moved to the cold storage
+		var store db.DB = cdb.store
+		data := store.Get(rKey)
+		if len(data) == 0 && cdb.cold != nil {
+			store = cdb.cold
+			data = store.Get(rKey)
+		}
+		if len(data) != 0 && !types.IsPackedReceipts(data) {
+			receipts, err := decodeReceipts(data)
+			if err != nil {
+				return blocks, rawSize, packedSize, err
+			}
+			packed, _, err := receipts.MarshalPacked()
+			if err != nil {
+				return blocks, rawSize, packedSize, err
+			}
+			dbTx := store.NewTx()
+			dbTx.Set(rKey, packed)
+			dbTx.Commit()
+
+			blocks++
+			rawSize += len(data)
+			packedSize += len(packed)
+		}
+
+		dbTx := cdb.store.NewTx()
+		dbTx.Set(receiptsPackTailKey, types.BlockNoToBytes(blockNo+1))
+		dbTx.Commit()
+
+		cdb.packTail = blockNo + 1
+	}
+	// the receipts of the blocks connected from now on are written packed
+	if cdb.packTail > best {
+		cdb.receiptsPacked = true
+		logger.Info().Uint64("tail", cdb.packTail).Msg("receipts of the chain are packed")
+	}
+	return blocks, rawSize, packedSize, nil
+}
@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/gob"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainDBPackOldReceipts(t *testing.T) {
+	const dir = "test_pack"
+	defer os.RemoveAll(dir)
+
+	cdb := NewChainDB()
+	err := cdb.Init(string(db.BadgerImpl), dir)
+	assert.NoError(t, err)
+	defer cdb.Close()
+
+	newReceipts := func(status string) *types.Receipts {
+		receipts := &types.Receipts{}
+		receipts.Set([]*types.Receipt{types.NewReceipt(nil, status, "{}")})
+		return receipts
+	}
+
+	var blocks []*types.Block
+	var prev *types.Block
+	for i := 0; i < 5; i++ {
+		block := types.NewBlock(prev, nil, nil, nil, nil, int64(i))
+		block.BlockHash()
+		tx := cdb.NewTx()
+		cdb.connectToChain(&tx, block, false)
+		tx.Commit()
+		blocks = append(blocks, block)
+		prev = block
+
+		// blocks 0, 1 and 2 have receipts stored before the packing
+		if i >= 3 {
+			rawSize, packedSize, err := cdb.writeReceipts(block.BlockHash(), block.BlockNo(), newReceipts("SUCCESS"))
+			assert.NoError(t, err)
+			assert.NotZero(t, rawSize)
+			assert.NotZero(t, packedSize)
+			continue
+		}
+		var val bytes.Buffer
+		assert.NoError(t, gob.NewEncoder(&val).Encode(newReceipts("ERROR")))
+		dbTx := cdb.store.NewTx()
+		dbTx.Set(receiptsKey(block.BlockHash(), block.BlockNo()), val.Bytes())
+		dbTx.Commit()
+	}
+
+	readAll := func() {
+		for _, block := range blocks {
+			r, err := cdb.getReceipt(block.BlockHash(), block.BlockNo(), 0)
+			assert.NoError(t, err)
+			if block.BlockNo() < 3 {
+				assert.Equal(t, "ERROR", r.Status)
+			} else {
+				assert.Equal(t, "SUCCESS", r.Status)
+			}
+		}
+	}
+	// both formats are readable
+	readAll()
+
+	packed, rawSize, packedSize, err := cdb.packOldReceipts()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, packed)
+	assert.NotZero(t, rawSize)
+	assert.NotZero(t, packedSize)
+	assert.True(t, cdb.receiptsPacked)
+	assert.Equal(t, types.BlockNo(5), cdb.packTail)
+	assert.Equal(t, types.BlockNoToBytes(5), cdb.store.Get(receiptsPackTailKey))
+	for _, block := range blocks {
+		assert.True(t, types.IsPackedReceipts(cdb.store.Get(receiptsKey(block.BlockHash(), block.BlockNo()))))
+	}
+	readAll()
+
+	packed, _, _, err = cdb.packOldReceipts()
+	assert.NoError(t, err)
+	assert.Zero(t, packed)
+}
@@ -0,0 +1,80 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// The tx index maps the hash of a main chain tx to its location: the number
+// of its block and its index in the block. The location is resolved by the
+// number index of the chain, without the body of the block, so that a tx is
+// found after the body is pruned. The entries written before were the
+// marshaled TxIdx and are still read.
+const txLocationLength = 8 + 4
+
+func encodeTxLocation(blockNo types.BlockNo, idx int) []byte {
+	loc := make([]byte, txLocationLength)
+	binary.BigEndian.PutUint64(loc, blockNo)
+	binary.BigEndian.PutUint32(loc[8:], uint32(idx))
+	return loc
+}
+
+// store tx location to DB
+func (cdb *ChainDB) addTx(dbtx *db.Transaction, tx *types.Tx, blockNo types.BlockNo, idx int) {
+	(*dbtx).Set(tx.Hash, encodeTxLocation(blockNo, idx))
+}
+
+func (cdb *ChainDB) deleteTx(dbtx *db.Transaction, tx *types.Tx) {
+	(*dbtx).Delete(tx.Hash)
+}
+
+// getTxLocation returns the number of the block of the tx and its TxIdx.
+func (cdb *ChainDB) getTxLocation(txHash []byte) (types.BlockNo, *types.TxIdx, error) {
+	buf := cdb.store.Get(txHash)
+	if len(buf) == 0 {
+		return 0, nil, fmt.Errorf("tx not found: txHash=%v", enc.ToString(txHash))
+	}
+	if len(buf) == txLocationLength {
+		blockNo := binary.BigEndian.Uint64(buf)
+		blockHash, err := cdb.getHashByNo(blockNo)
+		if err != nil {
+			return 0, nil, err
+		}
+		return blockNo, &types.TxIdx{BlockHash: blockHash, Idx: int32(binary.BigEndian.Uint32(buf[8:]))}, nil
+	}
+
+	txIdx := &types.TxIdx{}
+	if err := proto.Unmarshal(buf, txIdx); err != nil {
+		return 0, nil, fmt.Errorf("invalid tx index: txHash=%v", enc.ToString(txHash))
+	}
+	// the header of a block is kept after its body is pruned
+	block, err := cdb.getBlock(txIdx.BlockHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	return block.BlockNo(), txIdx, nil
+}
+
+// checkDuplicateTxs returns ErrTxAlreadyInChain if any tx of the block, which
+// extends the main chain, is already in the main chain.
+func (cdb *ChainDB) checkDuplicateTxs(block *types.Block) error {
+	for _, tx := range block.GetBody().GetTxs() {
+		blockNo, _, err := cdb.getTxLocation(tx.GetHash())
+		if err == nil && blockNo < block.BlockNo() {
+			logger.Warn().Str("hash", enc.ToString(tx.GetHash())).Uint64("blockNo", blockNo).
+				Msg("tx is already in the main chain")
+			return types.ErrTxAlreadyInChain
+		}
+	}
+	return nil
+}
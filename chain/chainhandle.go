@@ -8,16 +8,22 @@ package chain
 import (
 	"bytes"
 	"container/list"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/name"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/diskmon"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/tracing"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -132,6 +138,66 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	return r, nil
 }
 
+// getInternalOperations returns the operations made by the contracts during
+// the tx of the main chain.
+func (cs *ChainService) getInternalOperations(txHash []byte) (*types.InternalOperations, error) {
+	_, i, err := cs.cdb.getTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := cs.cdb.getBlock(i.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	blockInMainChain, err := cs.cdb.GetBlockByNo(block.BlockNo())
+	if err != nil || !bytes.Equal(block.BlockHash(), blockInMainChain.BlockHash()) {
+		return nil, errors.New("cannot find the internal operations")
+	}
+	ops, err := cs.cdb.getInternalOps(block.BlockHash(), txHash)
+	if err != nil || ops != nil {
+		return ops, err
+	}
+	return &types.InternalOperations{TxHash: txHash, BlockNo: block.BlockNo()}, nil
+}
+
+// getBlockReceipts returns the receipts of the txs of the block in the order of
+// the txs.
+func (cs *ChainService) getBlockReceipts(block *types.Block) ([]*types.Receipt, error) {
+	txs := block.GetBody().GetTxs()
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	blockHash, blockNo := block.BlockHash(), block.GetHeader().GetBlockNo()
+	stored, err := cs.cdb.getReceipts(blockHash, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := stored.Err(); err != nil {
+		return nil, err
+	}
+	return receiptsOfBlock(block, stored.Get())
+}
+
+// receiptsOfBlock returns copies of the receipts of the txs of the block with
+// the block, the index and the accounts of the txs set.
+func receiptsOfBlock(block *types.Block, receipts []*types.Receipt) ([]*types.Receipt, error) {
+	txs := block.GetBody().GetTxs()
+	blockHash, blockNo := block.BlockHash(), block.GetHeader().GetBlockNo()
+	if len(receipts) != len(txs) {
+		return nil, fmt.Errorf("%d receipts for %d txs of block %d", len(receipts), len(txs), blockNo)
+	}
+	out := make([]*types.Receipt, len(receipts))
+	for i, stored := range receipts {
+		r := *stored
+		r.SetMemoryInfo(blockHash, blockNo, int32(i))
+		r.ContractAddress = types.AddressOrigin(r.ContractAddress)
+		r.From = txs[i].GetBody().GetAccount()
+		r.To = txs[i].GetBody().GetRecipient()
+		out[i] = &r
+	}
+	return out, nil
+}
+
 func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, filter *types.FilterInfo,
 	argFilter []types.ArgFilter) uint64 {
 	blkHash, err := cs.cdb.getHashByNo(blkNo)
@@ -158,14 +224,22 @@ func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, f
 			}
 		}
 	}
+	for _, e := range receipts.BlockEvents() {
+		if e.Filter(filter, argFilter) {
+			e.SetMemoryInfo(nil, blkHash, blkNo, int32(len(receipts.Get())))
+			*events = append(*events, e)
+			totalSize += uint64(proto.Size(e))
+		}
+	}
 	return totalSize
 }
 
 const MaxEventSize = 4 * 1024 * 1024
 
-func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, error) {
-	from := filter.Blockfrom
-	to := filter.Blockto
+// eventRange returns the block range of the filter.
+func (cs *ChainService) eventRange(filter *types.FilterInfo) (from, to uint64, err error) {
+	from = filter.Blockfrom
+	to = filter.Blockto
 
 	if filter.RecentBlockCnt > 0 {
 		to = cs.cdb.getBestBlockNo()
@@ -179,7 +253,12 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 			to = cs.cdb.getBestBlockNo()
 		}
 	}
-	err := filter.ValidateCheck(to)
+	err = filter.ValidateCheck(to)
+	return
+}
+
+func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, error) {
+	from, to, err := cs.eventRange(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +286,76 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 	return events, nil
 }
 
+const eventCursorLength = 12
+
+// listEventPage returns a page of the events matching params.Filter in the
+// block order of the filter. The cursor consists of the block number and the
+// position among the matching events of the block where the page starts.
+func (cs *ChainService) listEventPage(params *types.EventListParams) (*types.EventPage, error) {
+	filter := params.GetFilter()
+	if filter == nil {
+		return nil, errors.New("no event filter")
+	}
+	size := params.GetSize()
+	if size == 0 {
+		size = defaultEventListSize
+	} else if size > maxEventListSize {
+		return nil, fmt.Errorf("too big size %d (max %d)", size, maxEventListSize)
+	}
+	from, to, err := cs.eventRange(filter)
+	if err != nil {
+		return nil, err
+	}
+	argFilter, err := filter.GetExArgFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	blkNo, skip := from, uint32(0)
+	if filter.Desc {
+		blkNo = to
+	}
+	if cursor := params.GetCursor(); len(cursor) != 0 {
+		if len(cursor) != eventCursorLength {
+			return nil, errors.New("invalid cursor")
+		}
+		blkNo = binary.BigEndian.Uint64(cursor)
+		skip = binary.BigEndian.Uint32(cursor[8:])
+		if blkNo < from || blkNo > to {
+			return nil, errors.New("cursor out of the block range")
+		}
+	}
+
+	page := &types.EventPage{}
+	for {
+		var events []*types.Event
+		cs.getEvents(&events, types.BlockNo(blkNo), filter, argFilter)
+		for i := int(skip); i < len(events); i++ {
+			if uint32(len(page.Events)) == size {
+				page.NextCursor = make([]byte, eventCursorLength)
+				binary.BigEndian.PutUint64(page.NextCursor, blkNo)
+				binary.BigEndian.PutUint32(page.NextCursor[8:], uint32(i))
+				return page, nil
+			}
+			page.Events = append(page.Events, events[i])
+		}
+		skip = 0
+
+		if filter.Desc {
+			if blkNo == from || blkNo == 0 {
+				break
+			}
+			blkNo--
+		} else {
+			if blkNo == to {
+				break
+			}
+			blkNo++
+		}
+	}
+	return page, nil
+}
+
 type chainProcessor struct {
 	*ChainService
 	block       *types.Block // starting block
@@ -354,12 +503,33 @@ func (cp *chainProcessor) connectToChain(block *types.Block) (types.BlockNo, err
 
 	// skip to add hash/block if wal of block is already written
 	oldLatest := cp.cdb.connectToChain(&dbTx, block, cp.isByBP && cp.HasWAL())
-	if err := cp.cdb.addTxsOfBlock(&dbTx, block.GetBody().GetTxs(), block.BlockHash()); err != nil {
+	if err := cp.cdb.addTxsOfBlock(&dbTx, block); err != nil {
 		return 0, err
 	}
+	paused := cp.indexPaused()
+	if !paused {
+		cp.cdb.addAddrTxsOfBlock(&dbTx, block)
+	}
 
 	dbTx.Commit()
 
+	if paused {
+		return oldLatest, nil
+	}
+	if err := cp.cdb.moveToCold(); err != nil {
+		logger.Warn().Err(err).Msg("failed to move old blocks to cold storage")
+	}
+	if err := cp.cdb.pruneBodies(); err != nil {
+		logger.Warn().Err(err).Msg("failed to prune old block bodies")
+	}
+	blocks, rawSize, packedSize, err := cp.cdb.packOldReceipts()
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to pack old receipts")
+	}
+	if blocks > 0 {
+		cp.stat.updateEvent(ReceiptStat, blocks, rawSize, packedSize, true)
+	}
+
 	return oldLatest, nil
 }
 
@@ -382,6 +552,15 @@ func (cp *chainProcessor) reorganize() error {
 	return nil
 }
 
+// verifySign verifies the signature of the block, or waits for the verify
+// pipeline verifying the block.
+func (cs *ChainService) verifySign(block *types.Block) error {
+	if task := cs.validator.pipeline.get(block.BlockHash()); task != nil {
+		return task.wait()
+	}
+	return cs.VerifySign(block)
+}
+
 func (cs *ChainService) addBlockInternal(newBlock *types.Block, usedBstate *state.BlockState, peerID peer.ID) (err error, cache bool) {
 	if !cs.VerifyTimestamp(newBlock) {
 		return &ErrBlock{
@@ -416,11 +595,14 @@ func (cs *ChainService) addBlockInternal(newBlock *types.Block, usedBstate *stat
 	}
 
 	if !newBlock.ValidChildOf(bestBlock) {
-		return fmt.Errorf("invalid chain id - best: %v, current: %v",
-			bestBlock.GetHeader().GetChainID(), newBlock.GetHeader().GetChainID()), false
+		return &types.ChainMismatchError{
+			Subject:  "block",
+			Expected: types.ChainIDString(bestBlock.GetHeader().GetChainID()),
+			Actual:   types.ChainIDString(newBlock.GetHeader().GetChainID()),
+		}, false
 	}
 
-	if err := cs.VerifySign(newBlock); err != nil {
+	if err := cs.verifySign(newBlock); err != nil {
 		return err, true
 	}
 
@@ -471,6 +653,14 @@ func (cs *ChainService) addBlock(newBlock *types.Block, usedBstate *state.BlockS
 		return ErrBlockCachedErrLRU
 	}
 
+	// stop before the db fails to write the block for the short disk space,
+	// which may leave the chain and the state inconsistent
+	if cs.diskMon.Level() >= diskmon.LevelStop {
+		logger.Warn().Uint64("no", newBlock.BlockNo()).Uint64("free", cs.diskMon.Free()).
+			Msg("block rejected for the short disk space")
+		return ErrDiskSpaceShort
+	}
+
 	var err error
 	if !cs.HasWAL() {
 		_, err = cs.getBlock(newBlock.BlockHash())
@@ -484,6 +674,7 @@ func (cs *ChainService) addBlock(newBlock *types.Block, usedBstate *state.BlockS
 	}
 
 	var needCache bool
+	start := time.Now()
 	err, needCache = cs.addBlockInternal(newBlock, usedBstate, peerID)
 	if err != nil {
 		if needCache {
@@ -495,6 +686,11 @@ func (cs *ChainService) addBlock(newBlock *types.Block, usedBstate *state.BlockS
 		return err
 	}
 
+	txs := newBlock.GetBody().GetTxs()
+	tags := tracing.BlockTags(newBlock)
+	tracing.RecordTxsStage(tracing.StageConnect, txs, start, tags)
+	tracing.FinishTxs(txs, tags)
+
 	return nil
 }
 
@@ -533,9 +729,11 @@ type blockExecutor struct {
 	*state.BlockState
 	sdb              *state.ChainStateDB
 	execTx           TxExecFn
+	blockNo          types.BlockNo
 	txs              []*types.Tx
 	validatePost     ValidatePostFn
 	coinbaseAcccount []byte
+	bpID             []byte
 	commitOnly       bool
 	validateSignWait ValidateSignWaitFn
 }
@@ -569,12 +767,19 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		commitOnly = true
 	}
 
+	var bpID []byte
+	if id, err := block.BPID(); err == nil {
+		bpID = []byte(id)
+	}
+
 	return &blockExecutor{
 		BlockState:       bState,
 		sdb:              cs.sdb,
 		execTx:           exec,
+		blockNo:          block.BlockNo(),
 		txs:              block.GetBody().GetTxs(),
 		coinbaseAcccount: block.GetHeader().GetCoinbaseAccount(),
+		bpID:             bpID,
 		validatePost: func() error {
 			return cs.validator.ValidatePost(bState.GetRoot(), bState.Receipts(), block)
 		},
@@ -630,7 +835,11 @@ func (e *blockExecutor) execute() error {
 		}
 
 		//TODO check result of verifing txs
-		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount); err != nil {
+		if err := UpdateSystemState(e.BlockState, e.blockNo); err != nil {
+			return err
+		}
+
+		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount, e.bpID, e.blockNo); err != nil {
 			return err
 		}
 
@@ -666,6 +875,10 @@ func (e *blockExecutor) commit() error {
 	if err := e.sdb.UpdateRoot(e.BlockState); err != nil {
 		return err
 	}
+	if err := applySystemParams(e.sdb, e.blockNo); err != nil {
+		return err
+	}
+	publishSystemChanges(e.BlockState, e.blockNo)
 
 	return nil
 }
@@ -689,6 +902,32 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 		return err
 	}
 
+	// the txs of the main chain are located after their bodies are pruned.
+	// the blocks of a reorganization, which don't extend the best block, are
+	// not checked against the txs of the chain being replaced.
+	if bytes.Equal(block.GetHeader().GetPrevBlockHash(), bestBlock.BlockHash()) {
+		if err = cs.cdb.checkDuplicateTxs(block); err != nil {
+			return err
+		}
+	}
+
+	// the block executed before is committed with its cached state. The
+	// block is validated as the one executed anew, and the changes of the
+	// system state are applied to the node by the commit as well.
+	cached := false
+	if bstate == nil {
+		if bstate = cs.execCache.get(block.BlockHash()); bstate != nil {
+			logger.Debug().Str("hash", block.ID()).Msg("commit cached block state")
+			if err = cs.validator.ValidateBlock(block); err != nil {
+				return err
+			}
+			if err = cs.validator.WaitVerifyDone(); err != nil {
+				return err
+			}
+			cached = true
+		}
+	}
+
 	// TODO refactoring: receive execute function as argument (executeBlock or executeBlockReco)
 	ex, err := newBlockExecutor(cs, bstate, block)
 	if err != nil {
@@ -697,11 +936,37 @@ func (cs *ChainService) executeBlock(bstate *state.BlockState, block *types.Bloc
 
 	// contract & state DB update is done during execution.
 	if err := ex.execute(); err != nil {
-		return err
+		if !cached {
+			return err
+		}
+		// the cached state doesn't match the block, e.g. its state root.
+		// drop it and execute the block again.
+		logger.Warn().Err(err).Str("hash", block.ID()).Msg("invalidate cached block state")
+		cs.execCache.remove(block.BlockHash())
+
+		if ex, err = newBlockExecutor(cs, nil, block); err != nil {
+			return err
+		}
+		if err := ex.execute(); err != nil {
+			return err
+		}
 	}
+	cs.execCache.add(block.BlockHash(), ex.BlockState)
 
 	if len(ex.BlockState.Receipts().Get()) != 0 {
-		cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), ex.BlockState.Receipts())
+		rawSize, packedSize, err := cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), ex.BlockState.Receipts())
+		if err != nil {
+			return err
+		}
+		cs.stat.updateEvent(ReceiptStat, 1, rawSize, packedSize, false)
+	}
+	if ops := ex.BlockState.InternalOperations(); len(ops) != 0 {
+		if err := cs.cdb.writeInternalOps(block.BlockHash(), ops); err != nil {
+			return err
+		}
+	}
+	if err := cs.cdb.writeEventIndex(block, ex.BlockState); err != nil {
+		return err
 	}
 
 	cs.notifyEvents(block, ex.BlockState)
@@ -743,6 +1008,9 @@ func (cs *ChainService) executeBlockReco(_ *state.BlockState, block *types.Block
 		return fmt.Errorf("failed to set sdb(branchRoot:no=%d,hash=%v)", block.GetHeader().GetBlockNo(),
 			block.ID())
 	}
+	if err := applySystemParams(cs.sdb, block.BlockNo()); err != nil {
+		return err
+	}
 
 	cs.Update(block)
 
@@ -761,7 +1029,11 @@ func (cs *ChainService) notifyEvents(block *types.Block, bstate *state.BlockStat
 		Block: block,
 	})
 
-	cs.TellTo(message.RPCSvc, block)
+	receipts, err := receiptsOfBlock(block, bstate.Receipts().Get())
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to notify the receipts of the block")
+	}
+	cs.TellTo(message.RPCSvc, &message.NotifyBlock{Block: block, Receipts: receipts})
 
 	events := []*types.Event{}
 	for idx, receipt := range bstate.Receipts().Get() {
@@ -770,6 +1042,11 @@ func (cs *ChainService) notifyEvents(block *types.Block, bstate *state.BlockStat
 			events = append(events, e)
 		}
 	}
+	// the block events follow the events of the txs
+	for _, e := range bstate.Receipts().BlockEvents() {
+		e.SetMemoryInfo(nil, blkHash, blkNo, int32(len(bstate.Receipts().Get())))
+		events = append(events, e)
+	}
 
 	if len(events) != 0 {
 		cs.TellTo(message.RPCSvc, events)
@@ -795,30 +1072,48 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 	if tx.HasVerifedAccount() {
 		account = tx.GetVerifedAccount()
 		tx.RemoveVerifedAccount()
-		resolvedAccount := name.Resolve(bs, txBody.GetAccount())
+		resolvedAccount := name.Resolve(bs, txBody.GetAccount(), blockNo)
 		if !bytes.Equal(account, resolvedAccount) {
 			return types.ErrSignNotMatch
 		}
 	} else {
-		account = name.Resolve(bs, txBody.GetAccount())
+		account = name.Resolve(bs, txBody.GetAccount(), blockNo)
 	}
 
 	err := tx.Validate(chainIDHash)
 	if err != nil {
 		return err
 	}
+	if err = types.ValidateWithFeatures(txBody, blockNo); err != nil {
+		return err
+	}
+	if txBody.IsExpired(blockNo, ts) {
+		return types.ErrTxExpired
+	}
+	if txBody.IsPremature(blockNo) {
+		return types.ErrTxPremature
+	}
+	if err = validateTxPolicy(bs, txBody); err != nil {
+		return err
+	}
 
 	sender, err := bs.GetAccountStateV(account)
 	if err != nil {
 		return err
 	}
 
+	free, err := isFreeTx(bs, account, txBody, ts)
+	if err != nil {
+		return err
+	}
+	tx.SetFreeTx(free)
+
 	err = tx.ValidateWithSenderState(sender.State())
 	if err != nil {
 		return err
 	}
 
-	recipient := name.Resolve(bs, txBody.Recipient)
+	recipient := name.Resolve(bs, txBody.Recipient, blockNo)
 	var receiver *state.V
 	var status string
 	if len(recipient) > 0 {
@@ -833,12 +1128,34 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 	}
 
 	var txFee *big.Int
+	var gasUsed uint64
 	var rv string
 	var events []*types.Event
+	// the called contract pays the fee of a fee-delegated tx
+	payer := sender
 	switch txBody.Type {
-	case types.TxType_NORMAL:
-		rv, events, txFee, err = contract.Execute(bs, cdb, tx.GetTx(), blockNo, ts, prevBlockHash, sender, receiver, preLoadService)
-		sender.SubBalance(txFee)
+	case types.TxType_NORMAL, types.TxType_FEEDELEGATION:
+		if fee.IsGasFeeEnabled() {
+			if err = validateGasPrice(bs, txBody); err != nil {
+				return err
+			}
+		}
+		if len(txBody.Recipient) == 0 {
+			if err = validateDeployer(bs, sender.ID()); err != nil {
+				return err
+			}
+		}
+		if txBody.Type == types.TxType_FEEDELEGATION {
+			if err = checkFeeDelegation(bs, receiver, sender.ID(), tx.GetMaxFee(), blockNo); err != nil {
+				return err
+			}
+			payer = receiver
+		}
+		rv, events, txFee, gasUsed, err = contract.Execute(bs, cdb, tx.GetTx(), blockNo, ts, prevBlockHash, sender, receiver, preLoadService)
+		if free {
+			txFee = new(big.Int).SetUint64(0)
+		}
+		payer.SubBalance(txFee)
 	case types.TxType_GOVERNANCE:
 		txFee = new(big.Int).SetUint64(0)
 		events, err = executeGovernanceTx(bs, txBody, sender, receiver, blockNo)
@@ -852,7 +1169,15 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 			return err
 		}
 		sender.Reset()
-		sender.SubBalance(txFee)
+		if payer != sender {
+			receiver.Reset()
+			receiver.SubBalance(txFee)
+			if rErr := receiver.PutState(); rErr != nil {
+				return rErr
+			}
+		} else {
+			sender.SubBalance(txFee)
+		}
 		sender.SetNonce(txBody.Nonce)
 		sErr := sender.PutState()
 		if sErr != nil {
@@ -874,19 +1199,100 @@ func executeTx(cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transa
 		}
 		rv = adjustRv(rv)
 	}
+	if payer != sender {
+		if err = chargeFeeDelegation(bs, receiver, sender.ID(), txFee, blockNo); err != nil {
+			return err
+		}
+	}
+	if free {
+		if err = useFreeTx(bs, account, txBody, ts); err != nil {
+			return err
+		}
+	}
 	bs.BpReward = new(big.Int).Add(new(big.Int).SetBytes(bs.BpReward), txFee).Bytes()
+	bs.TxSize += uint64(proto.Size(tx.GetTx()))
 
 	receipt := types.NewReceipt(receiver.ID(), status, rv)
 	receipt.FeeUsed = txFee.Bytes()
+	receipt.GasUsed = gasUsed
+	receipt.FeeDelegation = payer != sender
 	receipt.TxHash = tx.GetHash()
 	receipt.Events = events
 
 	return bs.AddReceipt(receipt)
 }
 
-func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte) error {
+// isFreeTx reports whether the fee of a normal tx is waived by the free-tx
+// quota of its sender.
+func isFreeTx(bs *state.BlockState, account []byte, txBody *types.TxBody, ts int64) (bool, error) {
+	if txBody.Type != types.TxType_NORMAL || !fee.IsFreeTxEnabled() {
+		return false, nil
+	}
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return false, err
+	}
+	return system.IsFreeTx(scs, account, len(txBody.GetPayload()), ts)
+}
+
+// useFreeTx counts a tx whose fee is waived against the free-tx quota of its
+// sender.
+func useFreeTx(bs *state.BlockState, account []byte, txBody *types.TxBody, ts int64) error {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	if err = system.UseFreeTx(scs, account, len(txBody.GetPayload()), ts); err != nil {
+		return err
+	}
+	return bs.StageContractState(scs)
+}
+
+// checkFeeDelegation checks that the contract called by a fee-delegated tx
+// pays up to maxFee for it.
+func checkFeeDelegation(bs *state.BlockState, receiver *state.V, account []byte,
+	maxFee *big.Int, blockNo types.BlockNo) error {
+	contractState, err := bs.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return err
+	}
+	return contract.CheckFeeDelegation(contractState, account, maxFee, blockNo)
+}
+
+// chargeFeeDelegation records the fee paid by the contract against its
+// budgets.
+func chargeFeeDelegation(bs *state.BlockState, receiver *state.V, account []byte,
+	txFee *big.Int, blockNo types.BlockNo) error {
+	contractState, err := bs.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return err
+	}
+	if err = contract.ChargeFeeDelegation(contractState, account, txFee, blockNo); err != nil {
+		return err
+	}
+	return bs.StageContractState(contractState)
+}
+
+func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte, bpID []byte, blockNo types.BlockNo) error {
 	bpReward := new(big.Int).SetBytes(bState.BpReward)
-	if bpReward.Cmp(new(big.Int).SetUint64(0)) <= 0 || coinbaseAccount == nil {
+	if bpReward.Cmp(new(big.Int).SetUint64(0)) <= 0 {
+		logger.Debug().Str("reward", new(big.Int).SetBytes(bState.BpReward).String()).Msg("coinbase is skipped")
+		return nil
+	}
+
+	bpReward, err := splitFee(bState, bpReward)
+	if err != nil {
+		return err
+	}
+
+	// The reward of a voted BP is shared with its voters and claimed later.
+	if types.IsFeatureActive(types.FeatureVoterReward, blockNo) {
+		if accrued, err := accrueReward(bState, bpID, coinbaseAccount, bpReward); err != nil || accrued {
+			return err
+		}
+	}
+
+	if coinbaseAccount == nil {
 		logger.Debug().Str("reward", new(big.Int).SetBytes(bState.BpReward).String()).Msg("coinbase is skipped")
 		return nil
 	}
@@ -6,16 +6,18 @@
 package chain
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"runtime"
 	"sync/atomic"
+	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
 	cfg "github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract"
@@ -23,17 +25,32 @@ import (
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/diskmon"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/pkg/storage"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/hashicorp/golang-lru"
 	"github.com/libp2p/go-libp2p-peer"
 )
 
+const (
+	defaultStorageListSize = 100
+	maxStorageListSize     = 1000
+
+	defaultEventListSize = 100
+	maxEventListSize     = 1000
+
+	stateDiffPageSize = 100
+
+	diskCheckInterval = 10 * time.Second
+)
+
 var (
-	logger = log.NewLogger("chain")
+	logger = logctl.NewLogger("chain")
 
 	dfltErrBlocks = 128
 
@@ -41,6 +58,11 @@ var (
 	ErrRecoNoBestStateRoot   = errors.New("state root of best block is not exist")
 	ErrRecoInvalidSdbRoot    = errors.New("state root of sdb is invalid")
 
+	errStorageListFull   = errors.New("storage list is full")
+	errStateDiffPageFull = errors.New("state diff page is full")
+
+	ErrDiskSpaceShort = errors.New("new blocks are not accepted for the short disk space")
+
 	TestDebugger *Debugger
 )
 
@@ -52,6 +74,10 @@ type Core struct {
 
 // NewCore returns an instance of Core.
 func NewCore(dbType string, dataDir string, testModeOn bool, forceResetHeight types.BlockNo) (*Core, error) {
+	if !storage.IsSupported(dbType) {
+		return nil, fmt.Errorf("unsupported db type: %s", dbType)
+	}
+
 	core := &Core{
 		cdb: NewChainDB(),
 		sdb: state.NewChainStateDB(),
@@ -67,6 +93,11 @@ func NewCore(dbType string, dataDir string, testModeOn bool, forceResetHeight ty
 
 // Init prepares Core (chain & state DB).
 func (core *Core) init(dbType string, dataDir string, testModeOn bool, forceResetHeight types.BlockNo) error {
+	if err := storage.CheckLayout(dataDir, dbType); err != nil {
+		logger.Fatal().Err(err).Str("datadir", dataDir).Msg("incompatible data directory")
+		return err
+	}
+
 	// init chaindb
 	if err := core.cdb.Init(dbType, dataDir); err != nil {
 		logger.Fatal().Err(err).Msg("failed to initialize chaindb")
@@ -181,12 +212,28 @@ type IChainHandler interface {
 	getAccountVote(id []string, addr []byte) (*types.AccountVoteInfo, error)
 	getVotes(id string, n uint32) (*types.VoteList, error)
 	getStaking(addr []byte) (*types.Staking, error)
+	getWithdrawals(addr []byte) (*types.WithdrawalList, error)
+	getSystemAccountInfo(addr []byte) (*types.SystemAccountInfo, error)
+	simulateSystemTx(tx *types.Tx) (*types.SystemTxSimulation, error)
+	getQuorumStatus() ([]*types.QuorumStatus, error)
+	getFeeEstimate(txBody *types.TxBody) (*types.FeeEstimate, error)
+	getBaseFee() (*types.BaseFee, error)
+	getElectionTally() (*types.ElectionTally, error)
 	getNameInfo(name string, blockNo types.BlockNo) (*types.NameInfo, error)
+	listNameOffers() ([]*types.NameInfo, error)
 	addBlock(newBlock *types.Block, usedBstate *state.BlockState, peerID peer.ID) error
 	getAnchorsNew() (ChainAnchor, types.BlockNo, error)
 	findAncestor(Hashes [][]byte) (*types.BlockInfo, error)
 	setSync(val bool)
 	listEvents(filter *types.FilterInfo) ([]*types.Event, error)
+	listEventPage(params *types.EventListParams) (*types.EventPage, error)
+	getAccountTxHistory(params *types.AccountTxHistoryParams) (*types.AccountTxHistory, error)
+	getBlockReceipts(block *types.Block) ([]*types.Receipt, error)
+	getStateDiff(fromBlockHash, toBlockHash, cursor []byte) ([]*types.AccountDiff, []byte, error)
+	listContractStorage(params *types.StorageListParams) (*types.StorageList, error)
+	getContractStorageUsage(contract []byte) (*types.ContractStorageUsage, error)
+	getInternalOperations(txHash []byte) (*types.InternalOperations, error)
+	listIndexedEvents(params *types.EventListParams) (*types.EventPage, error)
 }
 
 // ChainService manage connectivity of blocks
@@ -198,6 +245,7 @@ type ChainService struct {
 	cfg       *cfg.Config
 	op        *OrphanPool
 	errBlocks *lru.Cache
+	execCache *execCache
 
 	validator *BlockValidator
 
@@ -206,6 +254,9 @@ type ChainService struct {
 
 	stat stats
 
+	// diskMon is nil if no threshold of the free space is set
+	diskMon *diskmon.Monitor
+
 	recovered  atomic.Value
 	debuggable bool
 }
@@ -225,6 +276,20 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		logger.Fatal().Err(err).Msg("failed to initialize DB")
 		panic(err)
 	}
+	cs.sdb.SetBatchSize(cfg.Blockchain.StateBatchSize)
+
+	if cfg.Blockchain.ColdStorageDir != "" {
+		if err = cs.cdb.InitColdStorage(cfg.DbType, cfg.Blockchain.ColdStorageDir, cfg.Blockchain.HotBlockCount); err != nil {
+			logger.Fatal().Err(err).Msg("failed to initialize cold storage")
+			panic(err)
+		}
+	}
+	if cfg.Blockchain.PruneBodies {
+		if err = cs.cdb.InitBodyPruning(cfg.Blockchain.HotBlockCount); err != nil {
+			logger.Fatal().Err(err).Msg("failed to initialize body pruning")
+			panic(err)
+		}
+	}
 
 	if err = Init(cfg.Blockchain.MaxBlockSize,
 		cfg.Blockchain.CoinbaseAccount,
@@ -235,7 +300,19 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		panic("invalid config: blockchain")
 	}
 
+	thresholds := diskmon.Thresholds{
+		Warn:  cfg.Blockchain.DiskWarnFree * 1024 * 1024,
+		Pause: cfg.Blockchain.DiskPauseFree * 1024 * 1024,
+		Stop:  cfg.Blockchain.DiskStopFree * 1024 * 1024,
+	}
+	if thresholds.Enabled() {
+		cs.diskMon = diskmon.NewMonitor(cfg.DataDir, thresholds)
+	}
+
 	cs.validator = NewBlockValidator(cs, cs.sdb)
+	cs.validator.startPipeline(func(block *types.Block) error {
+		return cs.VerifySign(block)
+	})
 	cs.BaseComponent = component.NewBaseComponent(message.ChainSvc, cs, logger)
 	cs.chainManager = newChainManager(cs, cs.Core)
 	cs.chainWorker = newChainWorker(cs, defaultChainWorkerCount, cs.Core)
@@ -245,6 +322,10 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		logger.Fatal().Err(err).Msg("failed to init lru")
 		return nil
 	}
+	if cs.execCache, err = newExecCache(cfg.Blockchain.ExecCacheSize); err != nil {
+		logger.Fatal().Err(err).Msg("failed to init block state cache")
+		return nil
+	}
 
 	// init genesis block
 	if _, err := cs.initGenesis(nil, !cfg.UseTestnet, cfg.EnableTestmode); err != nil {
@@ -268,7 +349,27 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 	if !pubNet && cfg.Blockchain.ZeroFee {
 		fee.EnableZeroFee()
 	}
-	logger.Info().Bool("enablezerofee", fee.IsZeroFee()).Msg("fee")
+	if !pubNet && cfg.Blockchain.GasFee {
+		fee.EnableGasFee()
+	}
+	if !pubNet && cfg.Blockchain.DynamicFee {
+		fee.EnableDynamicFee()
+	}
+	if !pubNet {
+		fee.SetFreeTxQuota(cfg.Blockchain.FreeTxCount, cfg.Blockchain.FreeTxBytes)
+	}
+	if err := cs.verifyChainSpec(); err != nil {
+		logger.Fatal().Err(err).Msg("failed to verify the chain spec")
+		panic(err)
+	}
+	if err := applySystemParams(cs.sdb, cs.cdb.getBestBlockNo()); err != nil {
+		logger.Error().Err(err).Msg("failed to load the fork schedule and the fee parameters")
+	}
+	logger.Info().Bool("enablezerofee", fee.IsZeroFee()).Bool("enablegasfee", fee.IsGasFeeEnabled()).
+		Bool("enabledynamicfee", fee.IsDynamicFeeEnabled()).Bool("enablefreetx", fee.IsFreeTxEnabled()).
+		Str("aerperbyte", fee.AerPerByte().String()).Str("basetxfee", fee.BaseTxFee().String()).Msg("fee")
+	logger.Info().Uint64("version", types.ForkVersionAt(cs.cdb.getBestBlockNo()+1)).
+		Uint64("latest", types.LatestForkVersion).Msg("fork")
 	contract.PubNet = pubNet
 	contract.StartLStateFactory()
 
@@ -319,6 +420,9 @@ func (cs *ChainService) SetChainConsensus(cc consensus.ChainConsensus) {
 
 // BeforeStart initialize chain database and generate empty genesis block if necessary
 func (cs *ChainService) BeforeStart() {
+	if cs.diskMon != nil {
+		cs.diskMon.Start(diskCheckInterval)
+	}
 }
 
 // AfterStart ... do nothing
@@ -329,6 +433,9 @@ func (cs *ChainService) AfterStart() {
 
 // BeforeStop close chain database and stop BlockValidator
 func (cs *ChainService) BeforeStop() {
+	if cs.diskMon != nil {
+		cs.diskMon.Stop()
+	}
 	cs.Close()
 
 	cs.chainManager.Stop()
@@ -350,6 +457,13 @@ func (cs *ChainService) notifyBlock(block *types.Block, isByBP bool) {
 		})
 }
 
+// indexPaused returns whether the writes not essential to the chain, the
+// address index and the moves to cold storage, are paused for the short disk
+// space.
+func (cs *ChainService) indexPaused() bool {
+	return cs.diskMon.Level() >= diskmon.LevelPause
+}
+
 func (cs *ChainService) setRecovered(val bool) {
 	cs.recovered.Store(val)
 	return
@@ -388,17 +502,37 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetStateAndProof,
 		*message.GetTx,
 		*message.GetReceipt,
+		*message.GetBlockReceipts,
 		*message.GetABI,
 		*message.GetQuery,
 		*message.GetStateQuery,
 		*message.GetElected,
 		*message.GetVote,
 		*message.GetStaking,
+		*message.GetWithdrawals,
+		*message.GetSystemAccount,
+		*message.SimulateSystemTx,
+		*message.GetQuorumStatus,
+		*message.GetFeeEstimate,
+		*message.GetBaseFee,
+		*message.GetElectionTally,
 		*message.GetNameInfo,
-		*message.ListEvents:
+		*message.ListNameOffers,
+		*message.ListEvents,
+		*message.ListEventPage,
+		*message.GetAccountTxHistory,
+		*message.GetStateDiff,
+		*message.ListContractStorage,
+		*message.GetContractStorageUsage,
+		*message.GetInternalOperations,
+		*message.ListIndexedEvents:
 		cs.chainWorker.Request(msg, context.Sender())
 
 		//handle directly
+	case *message.PreVerifyBlocks:
+		for _, block := range msg.Blocks {
+			cs.validator.pipeline.push(block)
+		}
 	case *message.GetBestBlockNo:
 		context.Respond(message.GetBestBlockNoRsp{
 			BlockNo: cs.getBestBlockNo(),
@@ -458,7 +592,13 @@ func (cs *ChainService) getAccountVote(ids []string, addr []byte) (*types.Accoun
 	if err != nil {
 		return nil, err
 	}
+	return accountVote(scs, ids, addr, cs.getBestBlockNo())
+}
 
+// accountVote returns the votes of the account for the ids in the system
+// contract state, where the remaining blocks of the BP vote are counted from
+// bestNo.
+func accountVote(scs *state.ContractState, ids []string, addr []byte, bestNo types.BlockNo) (*types.AccountVoteInfo, error) {
 	var voteInfo types.AccountVoteInfo
 
 	for _, id := range ids {
@@ -481,7 +621,16 @@ func (cs *ChainService) getAccountVote(ids []string, addr []byte) (*types.Accoun
 				return nil, err
 			}
 		}
-		voteInfo.Voting = append(voteInfo.Voting, &types.VoteInfo{Id: id, Candidates: candidates})
+		info := &types.VoteInfo{Id: id, Candidates: candidates}
+		if id == types.VoteBP[2:] {
+			if info.Expiry, err = system.GetVoteExpiry(scs, addr); err != nil {
+				return nil, err
+			}
+			if info.Expiry > bestNo {
+				info.Remaining = info.Expiry - bestNo
+			}
+		}
+		voteInfo.Voting = append(voteInfo.Voting, info)
 	}
 
 	return &voteInfo, nil
@@ -500,13 +649,257 @@ func (cs *ChainService) getStaking(addr []byte) (*types.Staking, error) {
 	if err != nil {
 		return nil, err
 	}
-	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr))
+	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()))
 	if err != nil {
 		return nil, err
 	}
 	return staking, nil
 }
 
+func (cs *ChainService) getWithdrawals(addr []byte) (*types.WithdrawalList, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return nil, err
+	}
+	namescs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+	if err != nil {
+		return nil, err
+	}
+	return system.GetWithdrawals(scs, name.GetAddress(namescs, addr, cs.getBestBlockNo()))
+}
+
+// getElectionTally joins the votes of the BP candidates with the metadata of
+// the registered candidates. The registered candidates without votes follow
+// the voted ones.
+func (cs *ChainService) getElectionTally() (*types.ElectionTally, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+	scs, err := cs.sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	votes, err := system.GetVoteResult(cs.sdb, []byte(types.VoteBP[2:]), math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := system.GetBPCandidates(scs)
+	if err != nil {
+		return nil, err
+	}
+	registered := map[string]*system.BPCandidate{}
+	for _, c := range candidates {
+		registered[string(c.PeerID)] = c
+	}
+	var tally types.ElectionTally
+	add := func(peerID, amount []byte) {
+		info := &types.BPCandidateInfo{Candidate: peerID, Amount: amount}
+		if c, ok := registered[string(peerID)]; ok {
+			info.Registered = true
+			info.Owner = c.Owner
+			info.Name = c.Name
+			info.Website = c.Website
+			info.Commission = c.Commission
+			delete(registered, string(peerID))
+		}
+		tally.Candidates = append(tally.Candidates, info)
+	}
+	for _, v := range votes.GetVotes() {
+		add(v.GetCandidate(), v.GetAmount())
+	}
+	for _, c := range candidates {
+		if _, ok := registered[string(c.PeerID)]; ok {
+			add(c.PeerID, nil)
+		}
+	}
+	return &tally, nil
+}
+
+func (cs *ChainService) getQuorumStatus() ([]*types.QuorumStatus, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+	scs, err := cs.sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return system.GetQuorumStatus(scs)
+}
+
+// getFeeEstimate estimates the fee of the tx under the active fee parameters
+// and, if any fee parameter is pending, under the pending ones.
+func (cs *ChainService) getFeeEstimate(txBody *types.TxBody) (*types.FeeEstimate, error) {
+	if txBody == nil {
+		return nil, types.ErrTxFormatInvalid
+	}
+	estimate := toFeeEstimate(fee.Estimate(txBody))
+	scs, err := cs.sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	pending, activation, err := system.GetPendingFeeParams(scs)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		estimate.Pending = toFeeEstimate(fee.EstimateWith(pending, txBody))
+		estimate.Pending.Activation = activation
+	}
+	return estimate, nil
+}
+
+// getBaseFee returns the fee per byte charged to the txs of the next block.
+func (cs *ChainService) getBaseFee() (*types.BaseFee, error) {
+	scs, err := cs.sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	aerPerByte, err := system.GetParam(scs, types.VoteAerPerByte)
+	if err != nil {
+		return nil, err
+	}
+	next := cs.cdb.getBestBlockNo() + 1
+	dynamic := system.IsDynamicFeeEnabled(next)
+	if dynamic {
+		if aerPerByte, err = system.GetBaseFee(scs); err != nil {
+			return nil, err
+		}
+	}
+	return &types.BaseFee{
+		AerPerByte: aerPerByte.Bytes(),
+		Dynamic:    dynamic,
+		BlockNo:    next,
+	}, nil
+}
+
+func toFeeEstimate(e *fee.Estimation) *types.FeeEstimate {
+	estimate := &types.FeeEstimate{
+		Kind:         e.Kind.String(),
+		MinFee:       e.MinFee.Bytes(),
+		MaxFee:       e.MaxFee.Bytes(),
+		IntrinsicGas: e.IntrinsicGas,
+		GasLimit:     e.GasLimit,
+	}
+	if e.GasPrice != nil {
+		estimate.GasPrice = e.GasPrice.Bytes()
+	}
+	return estimate
+}
+
+// getSystemAccountInfo collects everything the system contract holds for the
+// account: its staking, pending withdrawals, votes, delegation and reward.
+func (cs *ChainService) getSystemAccountInfo(addr []byte) (*types.SystemAccountInfo, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+
+	scs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return nil, err
+	}
+	namescs, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+	if err != nil {
+		return nil, err
+	}
+	blockNo := cs.getBestBlockNo()
+	return systemAccountInfo(scs, name.GetAddress(namescs, addr, blockNo), blockNo)
+}
+
+// systemAccountInfo returns the records of the account in the system
+// contract state.
+func systemAccountInfo(scs *state.ContractState, account []byte, bestNo types.BlockNo) (*types.SystemAccountInfo, error) {
+	var err error
+	info := &types.SystemAccountInfo{Account: account}
+	if info.Staking, err = system.GetStaking(scs, account); err != nil {
+		return nil, err
+	}
+	withdrawals, err := system.GetWithdrawals(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	info.Withdrawals = withdrawals.GetWithdrawals()
+	var ids []string
+	for _, v := range types.AllVotes {
+		ids = append(ids, v[2:])
+	}
+	voteInfo, err := accountVote(scs, ids, account, bestNo)
+	if err != nil {
+		return nil, err
+	}
+	info.Voting = voteInfo.GetVoting()
+	delegation, err := system.GetDelegation(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if delegation.GetAmountBigInt().Sign() > 0 {
+		info.DelegatedTo = types.EncodeB58(delegation.Candidate)
+		info.Delegated = delegation.Amount
+	}
+	reward, err := system.GetReward(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	info.Reward = reward.Bytes()
+	return info, nil
+}
+
+// simulateSystemTx runs the system tx at the next block against a copy of the
+// best state, which is dropped afterwards. A failure of the tx is reported in
+// the result together with the records of the sender left untouched.
+func (cs *ChainService) simulateSystemTx(tx *types.Tx) (*types.SystemTxSimulation, error) {
+	if cs.GetType() != consensus.ConsensusDPOS {
+		return nil, ErrNotSupportedConsensus
+	}
+	txBody := tx.GetBody()
+	if txBody.GetType() != types.TxType_GOVERNANCE || string(txBody.GetRecipient()) != types.AergoSystem {
+		return nil, types.ErrTxInvalidRecipient
+	}
+	best, err := cs.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	blockNo := best.BlockNo() + 1
+
+	bs := cs.sdb.NewBlockState(best.GetHeader().GetBlocksRootHash())
+	account := name.Resolve(bs, txBody.GetAccount(), blockNo)
+	sender, err := bs.GetAccountStateV(account)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := bs.GetAccountStateV([]byte(types.AergoSystem))
+	if err != nil {
+		return nil, err
+	}
+	scs, err := bs.StateDB.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.SystemTxSimulation{}
+	err = types.ValidateSystemTx(txBody)
+	if err == nil {
+		err = types.ValidateWithFeatures(txBody, blockNo)
+	}
+	if err == nil {
+		result.Events, err = executeSystemTx(bs, scs, txBody, sender, receiver, blockNo)
+	}
+	if err != nil {
+		result.Error = err.Error()
+		// the records are read again without the changes of the failed tx
+		if scs, err = bs.StateDB.OpenContractState(receiver.AccountID(), receiver.State()); err != nil {
+			return nil, err
+		}
+	}
+	if result.Info, err = systemAccountInfo(scs, account, best.BlockNo()); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (cs *ChainService) getNameInfo(qname string, blockNo types.BlockNo) (*types.NameInfo, error) {
 	var stateDB *state.StateDB
 	if blockNo != 0 {
@@ -521,6 +914,114 @@ func (cs *ChainService) getNameInfo(qname string, blockNo types.BlockNo) (*types
 	return name.GetNameInfo(stateDB, qname)
 }
 
+func (cs *ChainService) listNameOffers() ([]*types.NameInfo, error) {
+	return name.ListNameOffers(cs.sdb.GetStateDB())
+}
+
+// getStateDiff returns a page of the accounts changed between the states of
+// two blocks, which starts right after the account id cursor. The account id
+// to continue from is returned when more accounts remain.
+func (cs *ChainService) getStateDiff(fromBlockHash, toBlockHash, cursor []byte) ([]*types.AccountDiff, []byte, error) {
+	from, err := cs.getBlock(fromBlockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := cs.getBlock(toBlockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		diffs []*types.AccountDiff
+		next  []byte
+	)
+	err = cs.sdb.GetStateDB().Diff(from.GetHeader().GetBlocksRootHash(), to.GetHeader().GetBlocksRootHash(), cursor,
+		func(diff *types.AccountDiff) error {
+			if len(diffs) == stateDiffPageSize {
+				next = diffs[stateDiffPageSize-1].AccountID
+				return errStateDiffPageFull
+			}
+			diffs = append(diffs, diff)
+			return nil
+		})
+	if err != nil && err != errStateDiffPageFull {
+		return nil, nil, err
+	}
+	return diffs, next, nil
+}
+
+// listContractStorage returns a page of the contract storage whose original
+// keys start with params.Prefix. The page starts right after params.Cursor
+// and NextCursor is set when more entries remain.
+func (cs *ChainService) listContractStorage(params *types.StorageListParams) (*types.StorageList, error) {
+	size := params.GetSize()
+	if size == 0 {
+		size = defaultStorageListSize
+	} else if size > maxStorageListSize {
+		return nil, fmt.Errorf("too big size %d (max %d)", size, maxStorageListSize)
+	}
+	address, err := cs.getAddressNameResolved(params.GetContractAddress())
+	if err != nil {
+		return nil, err
+	}
+	var stateDB *state.StateDB
+	if params.GetLatest() {
+		stateDB = cs.sdb.GetStateDB()
+	} else {
+		block, err := cs.cdb.GetBlockByNo(params.GetBlockNo())
+		if err != nil {
+			return nil, err
+		}
+		stateDB = cs.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
+	}
+	ctrState, err := stateDB.OpenContractStateAccount(types.ToAccountID(address))
+	if err != nil {
+		return nil, err
+	}
+	list := &types.StorageList{}
+	cursor := params.GetCursor()
+	err = ctrState.IterateData(cursor, func(trieKey, key, value []byte) error {
+		if bytes.Equal(trieKey, cursor) || !bytes.HasPrefix(key, params.GetPrefix()) {
+			return nil
+		}
+		if uint32(len(list.Entries)) == size {
+			list.NextCursor = list.Entries[size-1].TrieKey
+			return errStorageListFull
+		}
+		list.Entries = append(list.Entries, &types.StorageEntry{Key: key, TrieKey: trieKey, Value: value})
+		return nil
+	})
+	if err != nil && err != errStorageListFull {
+		return nil, err
+	}
+	return list, nil
+}
+
+// getContractStorageUsage returns the storage bytes used by the contract and
+// the storage quota of the contracts.
+func (cs *ChainService) getContractStorageUsage(contract []byte) (*types.ContractStorageUsage, error) {
+	address, err := cs.getAddressNameResolved(contract)
+	if err != nil {
+		return nil, err
+	}
+	ctrState, err := cs.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(address))
+	if err != nil {
+		return nil, err
+	}
+	usage, err := ctrState.StorageUsage()
+	if err != nil {
+		return nil, err
+	}
+	scs, err := cs.sdb.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	quota, err := system.GetParam(scs, types.VoteStorageQuota)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ContractStorageUsage{Usage: usage, Quota: quota.Uint64()}, nil
+}
+
 type ChainManager struct {
 	*SubComponent
 	IChainHandler //to use chain APIs
@@ -605,14 +1106,15 @@ func (cm *ChainManager) Receive(context actor.Context) {
 	}
 }
 
-func getAddressNameResolved(sdb *state.ChainStateDB, account []byte) ([]byte, error) {
+// getAddressNameResolved resolves the name at the best block.
+func (core *Core) getAddressNameResolved(account []byte) ([]byte, error) {
 	if len(account) <= types.NameLength {
-		scs, err := sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+		scs, err := core.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(account)).Err(err).Msg("failed to get state for account")
 			return nil, err
 		}
-		return name.GetAddress(scs, account), nil
+		return name.GetAddress(scs, account, core.cdb.getBestBlockNo()), nil
 	}
 	return account, nil
 }
@@ -639,7 +1141,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:   err,
 		})
 	case *message.GetState:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		address, err := cw.getAddressNameResolved(msg.Account)
 		if err != nil {
 			context.Respond(message.GetStateRsp{
 				Account: msg.Account,
@@ -659,7 +1161,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:     err,
 		})
 	case *message.GetStateAndProof:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		address, err := cw.getAddressNameResolved(msg.Account)
 		if err != nil {
 			context.Respond(message.GetStateAndProofRsp{
 				StateProof: nil,
@@ -690,8 +1192,14 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Receipt: receipt,
 			Err:     err,
 		})
+	case *message.GetBlockReceipts:
+		receipts, err := cw.getBlockReceipts(msg.Block)
+		context.Respond(message.GetBlockReceiptsRsp{
+			Receipts: receipts,
+			Err:      err,
+		})
 	case *message.GetABI:
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		address, err := cw.getAddressNameResolved(msg.Contract)
 		if err != nil {
 			context.Respond(message.GetABIRsp{
 				ABI: nil,
@@ -703,8 +1211,10 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		if err == nil {
 			abi, err := contract.GetABI(contractState)
 			context.Respond(message.GetABIRsp{
-				ABI: abi,
-				Err: err,
+				ABI:      abi,
+				Address:  address,
+				CodeHash: contractState.GetCodeHash(),
+				Err:      err,
 			})
 		} else {
 			context.Respond(message.GetABIRsp{
@@ -715,7 +1225,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 	case *message.GetQuery:
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		address, err := cw.getAddressNameResolved(msg.Contract)
 		if err != nil {
 			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
 			break
@@ -734,7 +1244,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		var contractProof *types.AccountProof
 		var err error
 
-		address, err := getAddressNameResolved(cw.sdb, msg.ContractAddress)
+		address, err := cw.getAddressNameResolved(msg.ContractAddress)
 		if err != nil {
 			context.Respond(message.GetStateQueryRsp{
 				Result: nil,
@@ -785,18 +1295,125 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Staking: staking,
 			Err:     err,
 		})
+	case *message.GetWithdrawals:
+		withdrawals, err := cw.getWithdrawals(msg.Addr)
+		context.Respond(&message.GetWithdrawalsRsp{
+			Withdrawals: withdrawals,
+			Err:         err,
+		})
+	case *message.GetSystemAccount:
+		info, err := cw.getSystemAccountInfo(msg.Addr)
+		context.Respond(&message.GetSystemAccountRsp{
+			Info: info,
+			Err:  err,
+		})
+	case *message.SimulateSystemTx:
+		result, err := cw.simulateSystemTx(msg.Tx)
+		context.Respond(&message.SimulateSystemTxRsp{
+			Result: result,
+			Err:    err,
+		})
+	case *message.GetElectionTally:
+		tally, err := cw.getElectionTally()
+		context.Respond(&message.GetElectionTallyRsp{
+			Tally: tally,
+			Err:   err,
+		})
+	case *message.GetQuorumStatus:
+		params, err := cw.getQuorumStatus()
+		context.Respond(&message.GetQuorumStatusRsp{
+			Params: params,
+			Err:    err,
+		})
+	case *message.GetFeeEstimate:
+		estimate, err := cw.getFeeEstimate(msg.TxBody)
+		context.Respond(&message.GetFeeEstimateRsp{
+			Estimate: estimate,
+			Err:      err,
+		})
+	case *message.GetBaseFee:
+		baseFee, err := cw.getBaseFee()
+		context.Respond(&message.GetBaseFeeRsp{
+			BaseFee: baseFee,
+			Err:     err,
+		})
 	case *message.GetNameInfo:
 		owner, err := cw.getNameInfo(msg.Name, msg.BlockNo)
 		context.Respond(&message.GetNameInfoRsp{
 			Owner: owner,
 			Err:   err,
 		})
+	case *message.ListNameOffers:
+		names, err := cw.listNameOffers()
+		context.Respond(&message.ListNameOffersRsp{
+			Names: names,
+			Err:   err,
+		})
 	case *message.ListEvents:
 		events, err := cw.listEvents(msg.Filter)
 		context.Respond(&message.ListEventsRsp{
 			Events: events,
 			Err:    err,
 		})
+	case *message.ListEventPage:
+		page, err := cw.listEventPage(msg.Params)
+		context.Respond(&message.ListEventPageRsp{
+			Page: page,
+			Err:  err,
+		})
+	case *message.GetAccountTxHistory:
+		history, err := cw.getAccountTxHistory(msg.Params)
+		context.Respond(&message.GetAccountTxHistoryRsp{
+			History: history,
+			Err:     err,
+		})
+	case *message.GetStateDiff:
+		diffs, next, err := cw.getStateDiff(msg.FromBlockHash, msg.ToBlockHash, msg.Cursor)
+		if err != nil {
+			logger.Debug().Err(err).Str("from", enc.ToString(msg.FromBlockHash)).
+				Str("to", enc.ToString(msg.ToBlockHash)).Msg("failed to get state diff")
+		}
+		context.Respond(&message.GetStateDiffRsp{
+			Diffs:      diffs,
+			NextCursor: next,
+			Err:        err,
+		})
+	case *message.ListContractStorage:
+		list, err := cw.listContractStorage(msg.Params)
+		if err != nil {
+			logger.Debug().Err(err).Str("contract", enc.ToString(msg.Params.GetContractAddress())).
+				Msg("failed to list contract storage")
+		}
+		context.Respond(&message.ListContractStorageRsp{
+			List: list,
+			Err:  err,
+		})
+	case *message.GetContractStorageUsage:
+		usage, err := cw.getContractStorageUsage(msg.Contract)
+		if err != nil {
+			logger.Debug().Err(err).Str("contract", enc.ToString(msg.Contract)).
+				Msg("failed to get contract storage usage")
+		}
+		context.Respond(&message.GetContractStorageUsageRsp{
+			Usage: usage,
+			Err:   err,
+		})
+	case *message.GetInternalOperations:
+		ops, err := cw.getInternalOperations(msg.TxHash)
+		if err != nil {
+			logger.Debug().Err(err).Str("hash", enc.ToString(msg.TxHash)).
+				Msg("failed to get internal operations")
+		}
+		context.Respond(&message.GetInternalOperationsRsp{
+			Operations: ops,
+			Err:        err,
+		})
+	case *message.ListIndexedEvents:
+		page, err := cw.listIndexedEvents(msg.Params)
+		context.Respond(&message.ListIndexedEventsRsp{
+			Page: page,
+			Err:  err,
+		})
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 	default:
 		debug := fmt.Sprintf("[%s] Missed message. (%v) %s", cw.name, reflect.TypeOf(msg), msg)
@@ -3,8 +3,11 @@ package chain
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
+	"github.com/aergoio/aergo-lib/db"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/state"
@@ -14,8 +17,19 @@ import (
 
 var (
 	testCfg *config.Config
+	// testDataDirs are the data directories of the chains made by the tests,
+	// which are removed after the tests
+	testDataDirs []string
 )
 
+func TestMain(m *testing.M) {
+	code := m.Run()
+	for _, dir := range testDataDirs {
+		os.RemoveAll(dir)
+	}
+	os.Exit(code)
+}
+
 const (
 	testPeer = "testpeer1"
 )
@@ -62,11 +76,14 @@ func (stubC *StubConsensus) HasWAL() bool {
 func makeBlockChain() *ChainService {
 	serverCtx := config.NewServerContext("", "")
 	testCfg = serverCtx.GetDefaultConfig().(*config.Config)
-	testCfg.DbType = "memorydb"
+	// the in-memory db is not a backend of the node, so each chain is kept
+	// in a fresh directory
+	testCfg.DbType = string(db.BadgerImpl)
+	testCfg.DataDir, _ = ioutil.TempDir("", "chainservice")
+	testDataDirs = append(testDataDirs, testCfg.DataDir)
 	//TODO use testnet genesis for test for now
 	testCfg.UseTestnet = true
 
-	//TODO drop data when close memorydb when test mode
 	cs := NewChainService(testCfg)
 
 	stubConsensus := &StubConsensus{}
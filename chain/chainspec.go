@@ -0,0 +1,67 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"os"
+	"path/filepath"
+
+	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+)
+
+// loadChainSpec returns the chain spec of the node, which is the file of the
+// config or the one written in the data directory by init. It returns nil if
+// there is neither.
+func loadChainSpec(conf *cfg.Config) (*types.ChainSpec, error) {
+	path := conf.Blockchain.ChainSpec
+	if path == "" {
+		path = filepath.Join(conf.DataDir, types.ChainSpecFile)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+	return types.LoadChainSpec(path)
+}
+
+// nodeFeeSpec returns the fee policy set by the config, which is ignored on a
+// public chain.
+func nodeFeeSpec(conf *cfg.Config) *types.FeeSpec {
+	if pubNet {
+		return &types.FeeSpec{}
+	}
+	return &types.FeeSpec{
+		ZeroFee:     conf.Blockchain.ZeroFee,
+		GasFee:      conf.Blockchain.GasFee,
+		DynamicFee:  conf.Blockchain.DynamicFee,
+		FreeTxCount: conf.Blockchain.FreeTxCount,
+		FreeTxBytes: conf.Blockchain.FreeTxBytes,
+	}
+}
+
+// verifyChainSpec checks that the chain and the config of the node match its
+// chain spec, if any.
+func (cs *ChainService) verifyChainSpec() error {
+	spec, err := loadChainSpec(cs.cfg)
+	if err != nil || spec == nil {
+		return err
+	}
+	genesis := cs.cdb.GetGenesisInfo()
+	consensus := &types.ConsensusSpec{
+		Type:          ConsensusName(),
+		BlockInterval: cs.cfg.Consensus.BlockInterval,
+	}
+	if raft := cs.cfg.Consensus.Raft; raft != nil {
+		for _, bp := range raft.BPs {
+			consensus.RaftMembers = append(consensus.RaftMembers, &types.RaftMember{Name: bp.Name, Url: bp.Url, PeerID: bp.P2pID})
+		}
+	}
+	if err = spec.Verify(genesis, genesis.Block().GetHash(), consensus, nodeFeeSpec(cs.cfg)); err != nil {
+		return err
+	}
+	logger.Info().Str("genesis", spec.GenesisHash).Msg("chain spec verified")
+	return nil
+}
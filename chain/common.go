@@ -77,6 +77,10 @@ func initChainParams(genesis *types.Genesis) {
 		logger.Panic().Err(err).Msg("invalid consensus type in genesis block")
 	}
 	system.InitDefaultBpCount(len(genesis.BPs))
+	if err := genesis.Forks.Validate(); err != nil {
+		logger.Panic().Err(err).Msg("invalid fork schedule in genesis block, the node may need an upgrade")
+	}
+	types.SetForkSchedule(genesis.Forks)
 	if genesis.TotalBalance() != nil {
 		types.MaxAER = genesis.TotalBalance()
 		logger.Info().Str("TotalBalance", types.MaxAER.String()).Msg("set total from genesis")
@@ -23,10 +23,12 @@ const (
 	DEBUG_CHAIN_OTHER_SLEEP
 	DEBUG_SYNCER_CRASH
 	DEBUG_RAFT_SNAP_FREQ // change snap frequency after first snapshot
+	DEBUG_RAFT_WAL_SLEEP // sleep before saving raft entries to wal
+	DEBUG_RAFT_CRASH     // crash raft server while processing raft ready
 )
 
 const (
-	DEBUG_CHAIN_STOP_INF = DEBUG_RAFT_SNAP_FREQ
+	DEBUG_CHAIN_STOP_INF = DEBUG_RAFT_CRASH
 )
 
 var (
@@ -36,6 +38,8 @@ var (
 	EnvNameChainOtherSleep = "DEBUG_CHAIN_OTHER_SLEEP" // non bp node sleeps before connecting block for each block (ms).
 	EnvNameSyncCrash       = "DEBUG_SYNCER_CRASH"      // case 1
 	EnvNameRaftSnapFreq    = "DEBUG_RAFT_SNAP_FREQ"    // case 1
+	EnvNameRaftWalSleep    = "DEBUG_RAFT_WAL_SLEEP"    // raft node sleeps before saving entries to wal (ms). it simulates slow disk
+	EnvNameRaftCrash       = "DEBUG_RAFT_CRASH"        // 1: before saving entries to wal, 2: after saving entries and before applying committed entries
 )
 
 var stopConds = [...]string{
@@ -45,6 +49,8 @@ var stopConds = [...]string{
 	EnvNameChainOtherSleep,
 	EnvNameSyncCrash,
 	EnvNameRaftSnapFreq,
+	EnvNameRaftWalSleep,
+	EnvNameRaftCrash,
 }
 
 type DebugHandler func(value int) error
@@ -61,8 +67,15 @@ type Debugger struct {
 	isEnv   map[StopCond]bool
 }
 
+// NewDebugger returns a debugger without any condition. The conditions are
+// set by Set, not by the environment variables, so that each node of a test
+// cluster has its own conditions.
+func NewDebugger() *Debugger {
+	return &Debugger{condMap: make(map[StopCond]int), isEnv: make(map[StopCond]bool)}
+}
+
 func newDebugger() *Debugger {
-	dbg := &Debugger{condMap: make(map[StopCond]int), isEnv: make(map[StopCond]bool)}
+	dbg := NewDebugger()
 
 	checkEnv := func(condName StopCond) {
 		envName := stopConds[condName]
@@ -86,6 +99,8 @@ func newDebugger() *Debugger {
 	checkEnv(DEBUG_CHAIN_OTHER_SLEEP)
 	checkEnv(DEBUG_SYNCER_CRASH)
 	checkEnv(DEBUG_RAFT_SNAP_FREQ)
+	checkEnv(DEBUG_RAFT_WAL_SLEEP)
+	checkEnv(DEBUG_RAFT_CRASH)
 
 	return dbg
 }
@@ -104,6 +119,16 @@ func (debug *Debugger) set(cond StopCond, value int, env bool) {
 	debug.isEnv[cond] = env
 }
 
+// Set sets the condition to value.
+func (debug *Debugger) Set(cond StopCond, value int) {
+	debug.set(cond, value, false)
+}
+
+// Unset removes the condition.
+func (debug *Debugger) Unset(cond StopCond) {
+	debug.unset(cond)
+}
+
 func (debug *Debugger) unset(cond StopCond) {
 	if debug == nil {
 		return
@@ -152,7 +177,7 @@ func (debug *Debugger) Check(cond StopCond, value int, handler DebugHandler) err
 			go crashRandom(setVal)
 			handleCrashRandom(setVal)
 
-		case DEBUG_CHAIN_OTHER_SLEEP, DEBUG_CHAIN_BP_SLEEP:
+		case DEBUG_CHAIN_OTHER_SLEEP, DEBUG_CHAIN_BP_SLEEP, DEBUG_RAFT_WAL_SLEEP:
 			handleChainSleep(setVal)
 
 		case DEBUG_SYNCER_CRASH:
@@ -161,6 +186,14 @@ func (debug *Debugger) Check(cond StopCond, value int, handler DebugHandler) err
 			}
 		case DEBUG_RAFT_SNAP_FREQ:
 			handler(setVal)
+		case DEBUG_RAFT_CRASH:
+			if setVal == value {
+				if debug.isEnv[cond] {
+					logger.Fatal().Str("cond", stopConds[cond]).Int("val", setVal).Msg("shutdown by DEBUG_RAFT_CRASH")
+				} else {
+					return &ErrDebug{cond: cond, value: value}
+				}
+			}
 		}
 	}
 
@@ -0,0 +1,235 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// The event index maps the values of the indexed arguments of the events to
+// the events of the main chain. A contract marks the leading arguments of an
+// event as indexed when it emits the event. An entry is keyed by the
+// contract, the event name, the position of the argument, the encoded value
+// and the position of the event, so the events of a value are iterated in the
+// block order and the values of a kind in the order of the values.
+//
+// The keys of the entries of a block are kept as well to delete them when the
+// block is dropped or reorganized out.
+
+var (
+	eventIndexPrefix      = []byte("e_idx.")
+	eventIndexBlockPrefix = []byte("e_idxblk.")
+)
+
+// blockNo(8) + txIdx(4) + eventIdx(4)
+const eventIndexPosLength = 16
+
+// eventIndexBase returns the prefix of the entries of an argument of the
+// event.
+func eventIndexBase(contract []byte, eventName string, argNo int) []byte {
+	base := make([]byte, 0, len(eventIndexPrefix)+3+len(contract)+len(eventName))
+	base = append(base, eventIndexPrefix...)
+	base = append(base, byte(len(contract)))
+	base = append(base, contract...)
+	base = append(base, byte(len(eventName)))
+	base = append(base, eventName...)
+	return append(base, byte(argNo))
+}
+
+func eventIndexPos(blockNo types.BlockNo, txIdx, eventIdx int32) []byte {
+	pos := make([]byte, eventIndexPosLength)
+	binary.BigEndian.PutUint64(pos, blockNo)
+	binary.BigEndian.PutUint32(pos[8:], uint32(txIdx))
+	binary.BigEndian.PutUint32(pos[12:], uint32(eventIdx))
+	return pos
+}
+
+func eventIndexBlockKey(blockHash []byte) []byte {
+	return append(append([]byte{}, eventIndexBlockPrefix...), blockHash...)
+}
+
+// writeEventIndex indexes the indexed arguments of the events emitted by the
+// txs of the block.
+func (cdb *ChainDB) writeEventIndex(block *types.Block, bs *state.BlockState) error {
+	var keys bytes.Buffer
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	for txIdx, r := range bs.Receipts().Get() {
+		for _, e := range r.Events {
+			n := bs.IndexedEventArgs(r.TxHash, e.EventIdx)
+			if n == 0 {
+				continue
+			}
+			var args []interface{}
+			if err := json.Unmarshal([]byte(e.JsonArgs), &args); err != nil {
+				continue
+			}
+			pos := eventIndexPos(block.BlockNo(), int32(txIdx), e.EventIdx)
+			for argNo := 0; argNo < n && argNo < len(args); argNo++ {
+				value := types.EncodeEventIndexValue(args[argNo])
+				if value == nil {
+					continue
+				}
+				key := eventIndexBase(e.ContractAddress, e.EventName, argNo)
+				key = append(append(key, value...), pos...)
+				dbTx.Set(key, block.BlockHash())
+
+				var l [4]byte
+				binary.BigEndian.PutUint32(l[:], uint32(len(key)))
+				keys.Write(l[:])
+				keys.Write(key)
+			}
+		}
+	}
+	if keys.Len() == 0 {
+		return nil
+	}
+	dbTx.Set(eventIndexBlockKey(block.BlockHash()), keys.Bytes())
+	dbTx.Commit()
+	return nil
+}
+
+func (cdb *ChainDB) deleteEventIndex(dbTx *db.Transaction, block *types.Block) {
+	blockKey := eventIndexBlockKey(block.BlockHash())
+	keys := cdb.store.Get(blockKey)
+	for len(keys) >= 4 {
+		l := binary.BigEndian.Uint32(keys)
+		if uint32(len(keys)-4) < l {
+			break
+		}
+		(*dbTx).Delete(keys[4 : 4+l])
+		keys = keys[4+l:]
+	}
+	(*dbTx).Delete(blockKey)
+}
+
+// indexedArgFilter returns the filter of an indexed argument to look up in
+// the index, preferring a value to a range.
+func indexedArgFilter(argFilter []types.ArgFilter) *types.ArgFilter {
+	var found *types.ArgFilter
+	for i := range argFilter {
+		f := &argFilter[i]
+		if f.ArgNo() >= types.MaxIndexedEventArgs {
+			continue
+		}
+		if _, _, ok := f.IndexRange(); !ok {
+			continue
+		}
+		if !f.IsRange() {
+			return f
+		}
+		if found == nil {
+			found = f
+		}
+	}
+	return found
+}
+
+// listIndexedEvents returns a page of the events matching params.Filter
+// looked up in the event index by an indexed argument of the filter. The
+// events are ordered by the value of the argument and then by the block
+// order of the filter. The cursor is the entry of the index where the page
+// starts.
+func (cs *ChainService) listIndexedEvents(params *types.EventListParams) (*types.EventPage, error) {
+	filter := params.GetFilter()
+	if filter == nil {
+		return nil, errors.New("no event filter")
+	}
+	if len(filter.EventName) == 0 {
+		return nil, errors.New("no event name")
+	}
+	size := params.GetSize()
+	if size == 0 {
+		size = defaultEventListSize
+	} else if size > maxEventListSize {
+		return nil, fmt.Errorf("too big size %d (max %d)", size, maxEventListSize)
+	}
+	from, to, err := cs.eventRange(filter)
+	if err != nil {
+		return nil, err
+	}
+	argFilter, err := filter.GetExArgFilter()
+	if err != nil {
+		return nil, err
+	}
+	indexed := indexedArgFilter(argFilter)
+	if indexed == nil {
+		return nil, errors.New("no filter of an indexed argument")
+	}
+	lower, upper, _ := indexed.IndexRange()
+	base := eventIndexBase(filter.ContractAddress, filter.EventName, indexed.ArgNo())
+	start := append(append([]byte{}, base...), lower...)
+	end := append(append([]byte{}, base...), upper...)
+	if cursor := params.GetCursor(); len(cursor) != 0 {
+		c := append(append([]byte{}, base...), cursor...)
+		if len(cursor) <= eventIndexPosLength || bytes.Compare(c, start) < 0 || bytes.Compare(c, end) >= 0 {
+			return nil, errors.New("invalid cursor")
+		}
+		if filter.Desc {
+			end = c
+		} else {
+			start = c
+		}
+	}
+	// the iteration is in the reverse order if start is bigger than end
+	iter := cs.cdb.store.Iterator(start, end)
+	if filter.Desc {
+		iter = cs.cdb.store.Iterator(end, start)
+	}
+
+	page := &types.EventPage{}
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < len(base)+eventIndexPosLength || !bytes.HasPrefix(key, base) {
+			continue
+		}
+		pos := key[len(key)-eventIndexPosLength:]
+		blockNo := binary.BigEndian.Uint64(pos)
+		if blockNo < from || blockNo > to {
+			continue
+		}
+		e := cs.indexedEvent(iter.Value(), blockNo, int32(binary.BigEndian.Uint32(pos[8:])),
+			int32(binary.BigEndian.Uint32(pos[12:])))
+		if e == nil || !e.Filter(filter, argFilter) {
+			continue
+		}
+		if uint32(len(page.Events)) == size {
+			page.NextCursor = append([]byte{}, key[len(base):]...)
+			return page, nil
+		}
+		page.Events = append(page.Events, e)
+	}
+	return page, nil
+}
+
+// indexedEvent returns the event of an entry of the index, or nil if the
+// block of the entry is no longer of the main chain.
+func (cs *ChainService) indexedEvent(blockHash []byte, blockNo types.BlockNo, txIdx, eventIdx int32) *types.Event {
+	mainHash, err := cs.cdb.getHashByNo(blockNo)
+	if err != nil || !bytes.Equal(mainHash, blockHash) {
+		return nil
+	}
+	r, err := cs.cdb.getReceipt(mainHash, blockNo, txIdx)
+	if err != nil {
+		return nil
+	}
+	for _, e := range r.Events {
+		if e.EventIdx == eventIdx {
+			e.SetMemoryInfo(r, mainHash, blockNo, txIdx)
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// execCache keeps the block states of the latest executed blocks by their
+// hash, so that a block executed again, e.g. the block produced by this node
+// or a block of the branch which becomes the main chain again by a
+// reorganization, is committed without re-executing its transactions.
+//
+// The states of the blocks which updated the SQL databases of the contracts
+// are not cached, since the databases are out of the state trie and rolled
+// back with the chain.
+type execCache struct {
+	cache *lru.Cache
+}
+
+// newExecCache returns a cache of size block states, or nil which caches
+// nothing if size is 0.
+func newExecCache(size int) (*execCache, error) {
+	if size <= 0 {
+		return nil, nil
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &execCache{cache: cache}, nil
+}
+
+func (ec *execCache) get(blockHash []byte) *state.BlockState {
+	if ec == nil {
+		return nil
+	}
+	if bState, ok := ec.cache.Get(types.ToBlockID(blockHash)); ok {
+		return bState.(*state.BlockState)
+	}
+	return nil
+}
+
+func (ec *execCache) add(blockHash []byte, bState *state.BlockState) {
+	if ec == nil || bState.SqlUpdated {
+		return
+	}
+	ec.cache.Add(types.ToBlockID(blockHash), bState)
+}
+
+func (ec *execCache) remove(blockHash []byte) {
+	if ec == nil {
+		return
+	}
+	ec.cache.Remove(types.ToBlockID(blockHash))
+}
+
+func (ec *execCache) len() int {
+	if ec == nil {
+		return 0
+	}
+	return ec.cache.Len()
+}
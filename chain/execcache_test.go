@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecCache(t *testing.T) {
+	genesis := types.NewBlock(nil, nil, nil, nil, nil, 0)
+	blocks := []*types.Block{genesis}
+	for i := 1; i < 4; i++ {
+		blocks = append(blocks, types.NewBlock(blocks[i-1], nil, nil, nil, nil, int64(i)))
+	}
+
+	ec, err := newExecCache(2)
+	assert.NoError(t, err)
+
+	bStates := make([]*state.BlockState, len(blocks))
+	for i, block := range blocks[:3] {
+		bStates[i] = &state.BlockState{}
+		ec.add(block.BlockHash(), bStates[i])
+	}
+
+	// the least recently used is evicted
+	assert.Equal(t, 2, ec.len())
+	assert.Nil(t, ec.get(blocks[0].BlockHash()))
+	assert.Equal(t, bStates[1], ec.get(blocks[1].BlockHash()))
+	assert.Equal(t, bStates[2], ec.get(blocks[2].BlockHash()))
+
+	ec.remove(blocks[2].BlockHash())
+	assert.Nil(t, ec.get(blocks[2].BlockHash()))
+
+	// the state updated the sql databases isn't cached
+	ec.add(blocks[3].BlockHash(), &state.BlockState{SqlUpdated: true})
+	assert.Nil(t, ec.get(blocks[3].BlockHash()))
+
+	// disabled
+	ec, err = newExecCache(0)
+	assert.NoError(t, err)
+	ec.add(blocks[1].BlockHash(), bStates[1])
+	assert.Nil(t, ec.get(blocks[1].BlockHash()))
+	assert.Equal(t, 0, ec.len())
+}
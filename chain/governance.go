@@ -9,12 +9,55 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/aergoio/aergo/contract/bridge"
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/internal/nodeevent"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
 
+// The changes of the governance published as node events
+const (
+	SystemChangeParamActivated    = "PARAM_ACTIVATED"
+	SystemChangeProposalFinalized = "PROPOSAL_FINALIZED"
+	SystemChangeForkScheduled     = "FORK_SCHEDULED"
+	SystemChangeDeployAllowList   = "DEPLOY_ALLOWLIST_CHANGED"
+)
+
+var systemChangeMessages = map[string]string{
+	SystemChangeParamActivated:    "the voted parameters are activated",
+	SystemChangeProposalFinalized: "the voting periods of the proposals end",
+	SystemChangeForkScheduled:     "the voted version of the features is activated from the next block",
+	SystemChangeDeployAllowList:   "the approved proposals change the accounts allowed to deploy contracts",
+}
+
+// applySystemParams makes the modules consult the fork schedule and the fee
+// parameters in the system state of the chain, which the txs of the block
+// after blockNo follow. It is called only when the state of the main chain
+// moves, so that the blocks of the side branches and of the block factory
+// leave them intact until they are committed.
+func applySystemParams(sdb *state.ChainStateDB, blockNo types.BlockNo) error {
+	scs, err := sdb.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	if err = system.UpdateForkSchedule(scs, genesisForks()); err != nil {
+		return err
+	}
+	// the txs of the next block are charged by the fee parameters active at it
+	return system.UpdateFeeParams(scs, blockNo+1)
+}
+
+// publishSystemChanges publishes the changes of the governance at the block
+// committed as node events.
+func publishSystemChanges(bs *state.BlockState, blockNo types.BlockNo) {
+	for _, change := range bs.SystemChanges {
+		nodeevent.Publish(nodeevent.CategoryGovernance, change, systemChangeMessages[change],
+			map[string]interface{}{"blockNo": blockNo})
+	}
+}
+
 func executeGovernanceTx(bs *state.BlockState, txBody *types.TxBody, sender, receiver *state.V,
 	blockNo types.BlockNo) ([]*types.Event, error) {
 
@@ -23,7 +66,7 @@ func executeGovernanceTx(bs *state.BlockState, txBody *types.TxBody, sender, rec
 	}
 
 	governance := string(txBody.Recipient)
-	if governance != types.AergoSystem && governance != types.AergoName {
+	if governance != types.AergoSystem && governance != types.AergoName && governance != types.AergoBridge {
 		return nil, errors.New("receive unknown recipient")
 	}
 
@@ -34,9 +77,11 @@ func executeGovernanceTx(bs *state.BlockState, txBody *types.TxBody, sender, rec
 	var events []*types.Event
 	switch governance {
 	case types.AergoSystem:
-		events, err = system.ExecuteSystemTx(scs, txBody, sender, receiver, blockNo)
+		events, err = executeSystemTx(bs, scs, txBody, sender, receiver, blockNo)
 	case types.AergoName:
 		events, err = name.ExecuteNameTx(bs, scs, txBody, sender, receiver, blockNo)
+	case types.AergoBridge:
+		events, err = bridge.ExecuteBridgeTx(bs, scs, txBody, sender, receiver, blockNo, BridgeConfig())
 	default:
 		logger.Warn().Str("governance", governance).Msg("receive unknown recipient")
 		err = types.ErrTxInvalidRecipient
@@ -48,6 +93,82 @@ func executeGovernanceTx(bs *state.BlockState, txBody *types.TxBody, sender, rec
 	return events, err
 }
 
+// executeSystemTx executes the system tx on behalf of the multisig account if
+// it is sent by a signer of the account.
+func executeSystemTx(bs *state.BlockState, scs *state.ContractState, txBody *types.TxBody,
+	sender, receiver *state.V, blockNo types.BlockNo) ([]*types.Event, error) {
+	multisig := system.MultisigCallerOf(txBody)
+	if multisig == nil {
+		return system.ExecuteSystemTx(scs, txBody, sender, receiver, blockNo)
+	}
+	account, err := bs.GetAccountStateV(multisig)
+	if err != nil {
+		return nil, err
+	}
+	events, err := system.ExecuteMultisigTx(scs, txBody, sender, account, receiver, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	return events, account.PutState()
+}
+
+// genesisForks returns the versions of the features scheduled in the genesis.
+func genesisForks() types.ForkSchedule {
+	if Genesis == nil {
+		return nil
+	}
+	return Genesis.Forks
+}
+
+// BridgeConfig returns the bridge of the chain in the genesis, or nil if it is
+// not enabled.
+func BridgeConfig() *types.BridgeConfig {
+	if Genesis == nil {
+		return nil
+	}
+	return Genesis.Bridge
+}
+
+// validateGasPrice checks that a contract tx offers at least the gas price
+// voted in the system contract.
+func validateGasPrice(bs *state.BlockState, txBody *types.TxBody) error {
+	scs, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
+	if err != nil {
+		return err
+	}
+	if txBody.GetGasPriceBigInt().Cmp(system.GetGasPrice(scs)) < 0 {
+		return types.ErrTxGasPriceTooLow
+	}
+	return nil
+}
+
+// validateDeployer checks that the account is allowed to deploy contracts if
+// the deployment is permissioned.
+func validateDeployer(bs *state.BlockState, account []byte) error {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	allowed, err := system.IsDeployAllowed(scs, account)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return types.ErrDeployNotAllowed
+	}
+	return nil
+}
+
+// validateTxPolicy checks the payload of the tx against the policy of the
+// chain.
+func validateTxPolicy(bs *state.BlockState, txBody *types.TxBody) error {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	return system.ValidateTxPolicy(scs, txBody)
+}
+
 // InitGenesisBPs opens system contract and put initial voting result
 // it also set *State in Genesis to use statedb
 func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
@@ -68,6 +189,20 @@ func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
 	// Set genesis.BPs to the votes-ordered BPs. This will be used later for
 	// bootstrapping.
 	genesis.BPs = system.BuildOrderedCandidates(voteResult)
+	if len(genesis.DeployAllowList) != 0 {
+		accounts, err := genesis.DeployAllowAccounts()
+		if err != nil {
+			return err
+		}
+		if err = system.InitDeployAllowList(scs, accounts); err != nil {
+			return err
+		}
+	}
+	if genesis.TxPolicy != nil {
+		if err = system.InitTxPolicy(scs, genesis.TxPolicy); err != nil {
+			return err
+		}
+	}
 	if err = states.StageContractState(scs); err != nil {
 		return err
 	}
@@ -80,3 +215,169 @@ func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
 
 	return nil
 }
+
+// UpdateSystemState applies the changes of the system contract which happen
+// at the block regardless of the transactions: it activates the voted
+// parameters, adjusts the dynamic fee to the size of the block, expires the
+// stale BP votes, finalizes the proposals whose voting
+// period ends, distributes the voting reward of the block from the pool and
+// returns the unstaked amounts which are released at the block from the
+// system account to the balances of their accounts. The changes are made only
+// to the block state, and the modules follow them once the block is
+// committed.
+func UpdateSystemState(bs *state.BlockState, blockNo types.BlockNo) error {
+	receiver, err := bs.GetAccountStateV([]byte(types.AergoSystem))
+	if err != nil {
+		return err
+	}
+	scs, err := bs.StateDB.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return err
+	}
+	activated, err := system.ActivateParams(scs, blockNo)
+	if err != nil {
+		return err
+	}
+	var forked bool
+	if activated {
+		if forked, err = system.ScheduleForks(scs, blockNo); err != nil {
+			return err
+		}
+	}
+	// the versions voted at the block are in effect from the next block, so
+	// the schedule of the block state is consulted until it is committed
+	schedule, err := system.GetForkSchedule(scs, genesisForks())
+	if err != nil {
+		return err
+	}
+	var adjusted bool
+	if system.IsDynamicFeeScheduled(schedule, blockNo+1) {
+		if adjusted, err = system.AdjustBaseFee(scs, bs.TxSize); err != nil {
+			return err
+		}
+	}
+	expired, err := system.ExpireVotes(scs, blockNo)
+	if err != nil {
+		return err
+	}
+	finalized, deployEvents, err := system.FinalizeProposals(scs, blockNo)
+	if err != nil {
+		return err
+	}
+	if err = bs.AddBlockEvents(deployEvents...); err != nil {
+		return err
+	}
+	releases, err := system.ReleaseWithdrawals(scs, blockNo)
+	if err != nil {
+		return err
+	}
+	distributed, err := distributeVotingReward(bs, scs, receiver)
+	if err != nil {
+		return err
+	}
+	if activated {
+		bs.SystemChanges = append(bs.SystemChanges, SystemChangeParamActivated)
+	}
+	if finalized {
+		bs.SystemChanges = append(bs.SystemChanges, SystemChangeProposalFinalized)
+	}
+	if forked {
+		bs.SystemChanges = append(bs.SystemChanges, SystemChangeForkScheduled)
+	}
+	if len(deployEvents) != 0 {
+		bs.SystemChanges = append(bs.SystemChanges, SystemChangeDeployAllowList)
+	}
+	if !activated && !adjusted && !expired && !finalized && !distributed && len(releases) == 0 {
+		return nil
+	}
+	for _, r := range releases {
+		account, err := bs.GetAccountStateV(r.Account)
+		if err != nil {
+			return err
+		}
+		account.AddBalance(r.Amount)
+		receiver.SubBalance(r.Amount)
+		if err = account.PutState(); err != nil {
+			return err
+		}
+		logger.Debug().Str("account", types.EncodeAddress(r.Account)).
+			Str("amount", r.Amount.String()).Msg("release unstaked amount")
+	}
+	if err = bs.StateDB.StageContractState(scs); err != nil {
+		return err
+	}
+	return receiver.PutState()
+}
+
+// distributeVotingReward moves the voting reward of the block from the pool
+// account to the system account, where the voters claim it, and reports
+// whether any is distributed.
+func distributeVotingReward(bs *state.BlockState, scs *state.ContractState, receiver *state.V) (bool, error) {
+	pool, err := bs.GetAccountStateV([]byte(types.AergoVault))
+	if err != nil {
+		return false, err
+	}
+	amount, err := system.DistributeVotingReward(scs, pool.Balance())
+	if err != nil || amount == nil {
+		return false, err
+	}
+	pool.SubBalance(amount)
+	receiver.AddBalance(amount)
+	if err = pool.PutState(); err != nil {
+		return false, err
+	}
+	logger.Debug().Str("amount", amount.String()).Msg("distribute voting reward")
+	return true, bs.AddBlockEvents(system.VotingRewardEvent(amount))
+}
+
+// splitFee burns and sends to the treasury account their shares of the fees
+// collected in the block, and returns the share of the BP.
+func splitFee(bs *state.BlockState, collected *big.Int) (*big.Int, error) {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	split, err := system.SplitFee(scs, collected)
+	if err != nil || split == nil {
+		return collected, err
+	}
+	if split.Treasury.Sign() > 0 {
+		treasury, err := bs.GetAccountStateV([]byte(types.AergoTreasury))
+		if err != nil {
+			return nil, err
+		}
+		treasury.AddBalance(split.Treasury)
+		if err = treasury.PutState(); err != nil {
+			return nil, err
+		}
+	}
+	logger.Debug().Str("burn", split.Burn.String()).Str("treasury", split.Treasury.String()).
+		Str("bp", split.BP.String()).Msg("split fee")
+	return split.BP, bs.AddBlockEvents(split.Events()...)
+}
+
+// accrueReward shares the block reward between the BP and its voters in the
+// system contract, and reports false if the BP is not voted.
+func accrueReward(bs *state.BlockState, bpID, coinbaseAccount []byte, reward *big.Int) (bool, error) {
+	if len(bpID) == 0 {
+		return false, nil
+	}
+	receiver, err := bs.GetAccountStateV([]byte(types.AergoSystem))
+	if err != nil {
+		return false, err
+	}
+	scs, err := bs.StateDB.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return false, err
+	}
+	accrued, ok, err := system.AccrueReward(scs, bpID, coinbaseAccount, reward)
+	if err != nil || !ok {
+		return false, err
+	}
+	receiver.AddBalance(accrued)
+	if err = bs.StateDB.StageContractState(scs); err != nil {
+		return false, err
+	}
+	logger.Debug().Str("reward", reward.String()).Str("accrued", accrued.String()).Msg("accrue reward to system account")
+	return true, receiver.PutState()
+}
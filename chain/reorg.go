@@ -8,6 +8,7 @@ import (
 
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/nodeevent"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -171,6 +172,15 @@ func (cs *ChainService) reorg(topBlock *types.Block, marker *ReorgMarker) error
 	}
 
 	cs.stat.updateEvent(ReorgStat, time.Since(begT), reorg.oldBlocks[0], reorg.newBlocks[0], reorg.brStartBlock)
+	nodeevent.Publish(nodeevent.CategoryReorg, "REORG", "the best chain is reorganized", map[string]interface{}{
+		"branchNo":   reorg.brStartBlock.BlockNo(),
+		"branchHash": reorg.brStartBlock.ID(),
+		"oldTopNo":   reorg.oldBlocks[0].BlockNo(),
+		"oldTopHash": reorg.oldBlocks[0].ID(),
+		"newTopNo":   reorg.newBlocks[0].BlockNo(),
+		"newTopHash": reorg.newBlocks[0].ID(),
+		"elapsed":    time.Since(begT).String(),
+	})
 	logger.Info().Msg("reorg end")
 
 	return nil
@@ -296,6 +306,14 @@ func (reorg *reorganizer) swapTxMapping() error {
 		}
 	}
 
+	// delete the address index of the old blocks before indexing the new
+	// blocks at the same heights
+	for _, oldBlock := range reorg.oldBlocks {
+		dbTx := cs.cdb.store.NewTx()
+		cdb.deleteAddrTxsOfBlock(&dbTx, oldBlock)
+		dbTx.Commit()
+	}
+
 	var overwrap int
 
 	// insert new tx mapping
@@ -311,10 +329,13 @@ func (reorg *reorganizer) swapTxMapping() error {
 
 		dbTx := cs.cdb.store.NewTx()
 
-		if err := cdb.addTxsOfBlock(&dbTx, newBlock.GetBody().GetTxs(), newBlock.BlockHash()); err != nil {
+		if err := cdb.addTxsOfBlock(&dbTx, newBlock); err != nil {
 			dbTx.Discard()
 			return err
 		}
+		if !cs.indexPaused() {
+			cdb.addAddrTxsOfBlock(&dbTx, newBlock)
+		}
 
 		dbTx.Commit()
 	}
@@ -334,18 +355,13 @@ func (reorg *reorganizer) swapTxMapping() error {
 	logger.Debug().Int("tx count", count).Int("overwrapped count", overwrap).Msg("tx add to mempool")
 
 	if count > 0 {
-		//txs := make([]*types.Tx, 0, count)
-
+		txs := make([]*types.Tx, 0, count)
 		for _, tx := range oldTxs {
-			//			logger.Debug().Str("txID", txID.String()).Msg("tx added")
-			//			txs = append(txs, tx)
-			cs.RequestTo(message.MemPoolSvc, &message.MemPoolPut{
-				Tx: tx,
-			})
+			txs = append(txs, tx)
 		}
-		//	cs.RequestTo(message.MemPoolSvc, &message.MemPoolPut{
-		//		Txs: txs,
-		//	})
+		cs.RequestTo(message.MemPoolSvc, &message.MemPoolPutTxs{
+			Txs: txs,
+		})
 	}
 	return nil
 }
@@ -482,6 +498,9 @@ func (reorg *reorganizer) rollback() error {
 		return fmt.Errorf("failed to rollback sdb(branchRoot:no=%d,hash=%v)", brStartBlockNo,
 			brStartBlock.ID())
 	}
+	if err := applySystemParams(reorg.cs.sdb, brStartBlockNo); err != nil {
+		return err
+	}
 
 	reorg.cs.Update(brStartBlock)
 
@@ -492,6 +511,8 @@ func (reorg *reorganizer) deleteOldReceipts() {
 	dbTx := reorg.cs.cdb.NewTx()
 	for _, blk := range reorg.oldBlocks {
 		reorg.cs.cdb.deleteReceipts(&dbTx, blk.GetHash(), blk.BlockNo())
+		reorg.cs.cdb.deleteInternalOps(&dbTx, blk)
+		reorg.cs.cdb.deleteEventIndex(&dbTx, blk)
 	}
 	dbTx.Commit()
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/message"
@@ -33,6 +34,11 @@ type verifyWork struct {
 	idx        int
 	tx         *types.Tx
 	useMempool bool // not to use aop for performance
+	signed     bool // the signature is verified by the verify pipeline
+	blockNo    types.BlockNo
+	// authState is the state the authorization contracts are called on,
+	// shared by the txs of a request. nil if the feature is not active.
+	authState *state.BlockState
 }
 
 type verifyWorkRes struct {
@@ -80,7 +86,7 @@ func (sv *SignVerifier) verifyTxLoop(workerNo int) {
 
 	for txWork := range sv.workCh {
 		//logger.Debug().Int("worker", workerNo).Int("idx", txWork.idx).Msg("get work to verify tx")
-		hit, err := sv.verifyTx(sv.comm, txWork.tx, txWork.useMempool)
+		hit, err := sv.verifyTx(sv.comm, &txWork)
 
 		if err != nil {
 			logger.Error().Int("worker", workerNo).Bool("hit", hit).Str("hash", enc.ToString(txWork.tx.GetHash())).
@@ -116,13 +122,14 @@ func (sv *SignVerifier) isExistInMempool(comm component.IComponentRequester, tx
 	return false, nil
 }
 
-func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.Tx, useMempool bool) (hit bool, err error) {
+func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, work *verifyWork) (hit bool, err error) {
+	tx := work.tx
 	account := tx.GetBody().GetAccount()
 	if account == nil {
 		return false, ErrTxFormatInvalid
 	}
 
-	if useMempool {
+	if work.useMempool {
 		if hit, err = sv.isExistInMempool(comm, tx); err != nil {
 			return false, err
 		}
@@ -137,13 +144,13 @@ func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.T
 			logger.Error().Err(err).Msg("failed to get verify because of openning contract error")
 			return false, err
 		}
-		address := name.GetOwner(cs, tx.Body.Account)
-		err = key.VerifyTxWithAddress(tx, address)
+		address := name.GetOwner(cs, tx.Body.Account, work.blockNo)
+		err = contract.VerifyTxSign(work.authState, tx, address, false)
 		if err != nil {
 			return false, err
 		}
 	} else {
-		err := key.VerifyTx(tx)
+		err := contract.VerifyTxSign(work.authState, tx, account, work.signed)
 		if err != nil {
 			return false, err
 		}
@@ -151,8 +158,14 @@ func (sv *SignVerifier) verifyTx(comm component.IComponentRequester, tx *types.T
 	return false, nil
 }
 
-func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList) {
-	txs := txlist.GetTxs()
+// RequestVerifyTxs verifies the txs of the block numbered blockNo.
+func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList, blockNo types.BlockNo) {
+	sv.requestVerifyTxs(txlist.GetTxs(), nil, blockNo)
+}
+
+// requestVerifyTxs verifies the txs of the block numbered blockNo, skipping
+// the signatures of the txs marked in signed.
+func (sv *SignVerifier) requestVerifyTxs(txs []*types.Tx, signed []bool, blockNo types.BlockNo) {
 	txLen := len(txs)
 
 	if txLen == 0 {
@@ -165,10 +178,20 @@ func (sv *SignVerifier) RequestVerifyTxs(txlist *types.TxList) {
 	//logger.Debug().Int("txlen", txLen).Msg("verify tx start")
 	useMempool := sv.useMempool && !sv.skipMempool
 
+	var authState *state.BlockState
+	if types.IsFeatureActive(types.FeatureAuthContract, blockNo) {
+		authState = state.NewBlockState(sv.sdb.OpenNewStateDB(sv.sdb.GetRoot()))
+	}
+
 	go func() {
+		// without the mempool, which has verified most of the txs, the
+		// signatures are verified in a batch ahead
+		if signed == nil && !useMempool {
+			signed = key.VerifyAddressTxs(txs, sv.workerCnt)
+		}
 		for i, tx := range txs {
 			//logger.Debug().Int("idx", i).Msg("push tx start")
-			sv.workCh <- verifyWork{idx: i, tx: tx, useMempool: useMempool}
+			sv.workCh <- verifyWork{idx: i, tx: tx, useMempool: useMempool, signed: signed != nil && signed[i], blockNo: blockNo, authState: authState}
 		}
 	}()
 
@@ -226,7 +249,7 @@ func (sv *SignVerifier) WaitDone() (bool, []error) {
 	}
 }
 
-func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList) (bool, []error) {
+func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList, blockNo types.BlockNo) (bool, []error) {
 	txs := txlist.GetTxs()
 	txLen := len(txs)
 	errs := make([]error, txLen, txLen)
@@ -236,7 +259,7 @@ func (sv *SignVerifier) verifyTxsInplace(txlist *types.TxList) (bool, []error) {
 	logger.Debug().Int("txlen", txLen).Msg("verify tx inplace start")
 
 	for i, tx := range txs {
-		hit, errs[i] = sv.verifyTx(sv.comm, tx, false)
+		hit, errs[i] = sv.verifyTx(sv.comm, &verifyWork{idx: i, tx: tx, blockNo: blockNo})
 		failed = true
 
 		if hit {
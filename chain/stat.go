@@ -18,6 +18,9 @@ const (
 
 	// ReorgStat is a constant representing a stat about reorganization.
 	ReorgStat statIndex = iota
+	// ReceiptStat is a constant representing a stat about the packing of
+	// the receipts.
+	ReceiptStat
 	// MaxStat is a constant representing a value less than which all the
 	// constants corresponding chain stats must be.
 	MaxStat
@@ -28,7 +31,8 @@ var (
 	// its constructor here. Additionally you need to add a constant
 	// corresponding to its index like statReorg above.
 	statItemCtors = map[statIndex]func() statItem{
-		ReorgStat: newStReorg,
+		ReorgStat:   newStReorg,
+		ReceiptStat: newStReceipt,
 	}
 )
 
@@ -159,3 +163,47 @@ func (sr *stReorg) clone() interface{} {
 
 	return &c
 }
+
+type stReceipt struct {
+	Blocks      int64
+	RawBytes    int64   `json:"Raw Bytes"`
+	PackedBytes int64   `json:"Packed Bytes"`
+	Ratio       float64 `json:"Compression Ratio,omitempty"`
+	// Migrated is the number of the blocks whose receipts stored before the
+	// packing are packed.
+	Migrated int64 `json:",omitempty"`
+}
+
+func newStReceipt() statItem {
+	return &stReceipt{}
+}
+
+func (sr *stReceipt) updateEvent(args ...interface{}) {
+	if len(args) != 4 {
+		logger.Info().Int("len", len(args)).Msg("invalid # of arguments for the receipt stat update")
+		return
+	}
+	blocks, ok1 := args[0].(int)
+	rawSize, ok2 := args[1].(int)
+	packedSize, ok3 := args[2].(int)
+	migrated, ok4 := args[3].(bool)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		logger.Info().Msg("invalid type of argument for the receipt stat update")
+		return
+	}
+
+	sr.Blocks += int64(blocks)
+	sr.RawBytes += int64(rawSize)
+	sr.PackedBytes += int64(packedSize)
+	if migrated {
+		sr.Migrated += int64(blocks)
+	}
+	if sr.RawBytes > 0 {
+		sr.Ratio = float64(sr.PackedBytes) / float64(sr.RawBytes)
+	}
+}
+
+func (sr *stReceipt) clone() interface{} {
+	c := *sr
+	return &c
+}
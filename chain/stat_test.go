@@ -47,3 +47,14 @@ func TestChainStatJSON(t *testing.T) {
 	chk.NotZero(len(s))
 	fmt.Println(s)
 }
+
+func TestChainStatReceipt(t *testing.T) {
+	stats := newStats()
+	stats.updateEvent(ReceiptStat, 1, 400, 100, false)
+	stats.updateEvent(ReceiptStat, 3, 600, 300, true)
+	stats.updateEvent(ReceiptStat, 1, "invalid", 100, false)
+
+	r := stats.clone(ReceiptStat).(*stReceipt)
+	assert.Equal(t, &stReceipt{Blocks: 4, RawBytes: 1000, PackedBytes: 400, Ratio: 0.4, Migrated: 3}, r)
+	assert.Contains(t, stats.JSON(), `"ReceiptStat":{"Blocks":4,"Raw Bytes":1000,"Packed Bytes":400,"Compression Ratio":0.4,"Migrated":3}`)
+}
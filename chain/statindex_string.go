@@ -4,9 +4,9 @@ package chain
 
 import "strconv"
 
-const _statIndex_name = "ReorgStatMaxStat"
+const _statIndex_name = "ReorgStatReceiptStatMaxStat"
 
-var _statIndex_index = [...]uint8{0, 9, 16}
+var _statIndex_index = [...]uint8{0, 9, 20, 27}
 
 func (i statIndex) String() string {
 	if i < 0 || i >= statIndex(len(_statIndex_index)-1) {
@@ -0,0 +1,196 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// verifyPipelineDepth is the number of the blocks each stage of the
+	// verify pipeline holds
+	verifyPipelineDepth = 8
+)
+
+// verifyPipeline verifies the blocks to be connected ahead of their
+// execution, so that the signatures of the next blocks are checked while the
+// current block is executed during sync. The blocks go through the stages
+// connected by the bounded channels:
+//
+//  1. header: the block signature and the tx root hash
+//  2. txs: the tx signatures, verified in a batch
+//
+// and then the execution stage, which is the chain itself, takes the result
+// of the block when it is connected. The tx signatures which depend on the
+// state, i.e. of the txs by a name, are left to the execution stage.
+type verifyPipeline struct {
+	verifySign func(block *types.Block) error
+	workerCnt  int
+
+	headerCh chan *verifyTask
+	txCh     chan *verifyTask
+
+	mutex   sync.Mutex
+	tasks   map[types.BlockID]*verifyTask
+	stopped bool
+}
+
+type verifyTask struct {
+	block *types.Block
+	done  chan struct{}
+	// err is the error of the block signature or the tx root hash
+	err error
+	// signed reports the signature of each tx is verified
+	signed []bool
+}
+
+func newVerifyPipeline(verifySign func(block *types.Block) error, workerCnt int) *verifyPipeline {
+	vp := &verifyPipeline{
+		verifySign: verifySign,
+		workerCnt:  workerCnt,
+		headerCh:   make(chan *verifyTask, verifyPipelineDepth),
+		txCh:       make(chan *verifyTask, verifyPipelineDepth),
+		tasks:      make(map[types.BlockID]*verifyTask),
+	}
+	if vp.workerCnt < 1 {
+		vp.workerCnt = 1
+	}
+
+	go vp.headerLoop()
+	go vp.txLoop()
+
+	return vp
+}
+
+func (vp *verifyPipeline) stop() {
+	if vp == nil {
+		return
+	}
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+	if !vp.stopped {
+		vp.stopped = true
+		close(vp.headerCh)
+	}
+}
+
+// push puts the block into the pipeline unless it is full or has the block.
+// It never blocks.
+func (vp *verifyPipeline) push(block *types.Block) {
+	if vp == nil {
+		return
+	}
+	id := types.ToBlockID(block.BlockHash())
+
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+
+	if _, exist := vp.tasks[id]; exist || vp.stopped || len(vp.tasks) >= verifyPipelineDepth*2 {
+		return
+	}
+	task := &verifyTask{block: block, done: make(chan struct{})}
+	select {
+	case vp.headerCh <- task:
+		vp.tasks[id] = task
+	default:
+	}
+}
+
+// get returns the task of the block, or nil if the block is not in the
+// pipeline.
+func (vp *verifyPipeline) get(blockHash []byte) *verifyTask {
+	if vp == nil {
+		return nil
+	}
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+	return vp.tasks[types.ToBlockID(blockHash)]
+}
+
+// take removes the task of the block from the pipeline and returns it, or nil
+// if the block is not in the pipeline. The tasks of the blocks not higher
+// than the block, which will never be taken, are dropped as well.
+func (vp *verifyPipeline) take(block *types.Block) *verifyTask {
+	if vp == nil {
+		return nil
+	}
+	id := types.ToBlockID(block.BlockHash())
+
+	vp.mutex.Lock()
+	defer vp.mutex.Unlock()
+
+	task := vp.tasks[id]
+	for tid, t := range vp.tasks {
+		if tid == id || t.block.BlockNo() <= block.BlockNo() {
+			delete(vp.tasks, tid)
+		}
+	}
+	return task
+}
+
+// verifies reports whether the task verifies the body of the block, which
+// may differ from the verified one under the same header.
+func (task *verifyTask) verifies(block *types.Block) bool {
+	if task.block == block {
+		return true
+	}
+	verified, txs := task.block.GetBody().GetTxs(), block.GetBody().GetTxs()
+	if len(verified) != len(txs) {
+		return false
+	}
+	for i, tx := range txs {
+		if !proto.Equal(verified[i], tx) {
+			return false
+		}
+	}
+	return true
+}
+
+// wait waits for the task to be done and returns the error of the block.
+func (task *verifyTask) wait() error {
+	<-task.done
+	return task.err
+}
+
+func (vp *verifyPipeline) headerLoop() {
+	defer close(vp.txCh)
+
+	for task := range vp.headerCh {
+		if task.err = vp.verifyHeader(task.block); task.err != nil {
+			close(task.done)
+			continue
+		}
+		vp.txCh <- task
+	}
+}
+
+func (vp *verifyPipeline) verifyHeader(block *types.Block) error {
+	if err := vp.verifySign(block); err != nil {
+		return err
+	}
+	if !bytes.Equal(block.GetHeader().GetTxsRootHash(), types.CalculateTxsRootHash(block.GetBody().GetTxs())) {
+		return ErrorBlockVerifyTxRoot
+	}
+	return nil
+}
+
+func (vp *verifyPipeline) txLoop() {
+	for task := range vp.txCh {
+		vp.verifyTxs(task)
+		close(task.done)
+	}
+}
+
+// verifyTxs verifies the signatures of the txs in a batch. A tx failed to be
+// verified here is verified again at the execution, which reports the error.
+func (vp *verifyPipeline) verifyTxs(task *verifyTask) {
+	task.signed = key.VerifyAddressTxs(task.block.GetBody().GetTxs(), vp.workerCnt)
+}
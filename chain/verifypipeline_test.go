@@ -0,0 +1,71 @@
+package chain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyPipeline(t *testing.T) {
+	assert.NoError(t, beforeTest(4))
+
+	errBadSign := errors.New("bad block signature")
+	vp := newVerifyPipeline(func(block *types.Block) error {
+		if block.GetHeader().GetTimestamp() < 0 {
+			return errBadSign
+		}
+		return nil
+	}, 2)
+	defer vp.stop()
+
+	badTx := genTx(0, 1, 100, 1)
+	badTx.Body.Amount = []byte{100}
+
+	genesis := types.NewBlock(nil, nil, nil, nil, nil, 0)
+	block1 := types.NewBlock(genesis, nil, nil, append(txs[:4:4], badTx), nil, 1)
+	block2 := types.NewBlock(block1, nil, nil, txs[:1], nil, -1)
+	block3 := types.NewBlock(block1, nil, nil, txs[:1], nil, 3)
+	block3.Header.TxsRootHash = nil
+
+	for _, block := range []*types.Block{block1, block2, block3, block1} {
+		vp.push(block)
+	}
+	assert.Len(t, vp.tasks, 3)
+
+	task := vp.get(block1.BlockHash())
+	assert.NotNil(t, task)
+	assert.NoError(t, task.wait())
+	assert.Equal(t, []bool{true, true, true, true, false}, task.signed)
+
+	assert.Equal(t, errBadSign, vp.get(block2.BlockHash()).wait())
+	assert.Equal(t, ErrorBlockVerifyTxRoot, vp.get(block3.BlockHash()).wait())
+
+	// a body other than the verified one under the same header is not
+	// verified by the task
+	forged := &types.Block{Header: block1.Header, Body: &types.BlockBody{Txs: append(txs[1:4:4], badTx)}}
+	assert.Equal(t, block1.BlockHash(), forged.BlockHash())
+	assert.True(t, task.verifies(block1))
+	assert.True(t, task.verifies(&types.Block{Header: block1.Header, Body: &types.BlockBody{Txs: append(txs[:4:4], badTx)}}))
+	assert.False(t, task.verifies(forged))
+
+	// taking a block drops the blocks not higher than it
+	assert.Equal(t, task, vp.take(block1))
+	assert.Len(t, vp.tasks, 2)
+	assert.Equal(t, ErrorBlockVerifyTxRoot, vp.take(block3).wait())
+	assert.Len(t, vp.tasks, 0)
+
+	// the forged body is checked against the tx root instead of taking the
+	// result of the verified block
+	vp.push(block1)
+	assert.NoError(t, vp.get(block1.BlockHash()).wait())
+	bv := &BlockValidator{pipeline: vp}
+	assert.Equal(t, ErrorBlockVerifyTxRoot, bv.ValidateBody(forged))
+	assert.Len(t, vp.tasks, 0)
+
+	// the stopped pipeline takes no block
+	vp.stop()
+	vp.push(block1)
+	assert.Nil(t, vp.get(block1.BlockHash()))
+}
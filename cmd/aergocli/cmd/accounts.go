@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/types"
@@ -26,17 +28,22 @@ func init() {
 	newCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
 
 	listCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	listCmd.Flags().BoolVar(&withAliases, "aliases", false, "Print the labels of the accounts in the address book")
 
 	unlockCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	unlockCmd.MarkFlagRequired("address")
 	unlockCmd.Flags().StringVar(&pw, "password", "", "Password")
+	unlockCmd.Flags().Uint64Var(&unlockTimeout, "timeout", 0, "Seconds to lock the account after (default: the timeout of the node)")
+
+	unlockStatusCmd.Flags().StringVar(&address, "address", "", "Address of account")
+	unlockStatusCmd.MarkFlagRequired("address")
 
 	lockCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	lockCmd.MarkFlagRequired("address")
 	lockCmd.Flags().StringVar(&pw, "password", "", "Password")
 
 	importCmd.Flags().StringVar(&importFormat, "if", "", "Base58 import format string")
-	importCmd.MarkFlagRequired("if")
+	importCmd.Flags().StringVar(&keystoreFile, "keystore", "", "Path to a keystore file to import")
 	importCmd.Flags().StringVar(&pw, "password", "", "Password when exporting")
 	importCmd.Flags().StringVar(&to, "newpassword", "", "Password to be reset")
 	importCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
@@ -45,6 +52,34 @@ func init() {
 	exportCmd.MarkFlagRequired("address")
 	exportCmd.Flags().StringVar(&pw, "password", "", "Password")
 	exportCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	exportCmd.Flags().BoolVar(&exportKeystore, "keystore", false, "Export in keystore format")
+	exportCmd.Flags().StringVar(&keystoreKdf, "kdf", key.KdfScrypt, "Key derivation function of the keystore: scrypt or argon2id")
+	exportCmd.Flags().StringVar(&keystoreFile, "file", "", "File to save the keystore to (default: the standard output)")
+
+	defaultArgon2 := key.DefaultArgon2Params()
+	reencryptCmd.Flags().StringVar(&pw, "password", "", "Password of the keys")
+	reencryptCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	reencryptCmd.MarkFlagRequired("path")
+	reencryptCmd.Flags().Uint32Var(&argon2Time, "argon2time", defaultArgon2.Time, "Number of the passes of argon2id")
+	reencryptCmd.Flags().Uint32Var(&argon2Memory, "argon2memory", defaultArgon2.Memory, "Memory of argon2id in KiB")
+	reencryptCmd.Flags().Uint8Var(&argon2Threads, "argon2threads", defaultArgon2.Threads, "Number of the threads of argon2id")
+
+	hdWalletCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "Mnemonic to restore the hd wallet from (default: a new mnemonic)")
+	hdWalletCmd.Flags().StringVar(&seedPassword, "seedpassword", "", "Passphrase of the seed of the mnemonic")
+	hdWalletCmd.Flags().IntVar(&mnemonicWords, "words", 24, "Number of the words of a new mnemonic: 12, 15, 18, 21 or 24")
+	hdWalletCmd.Flags().StringVar(&pw, "password", "", "Password")
+	hdWalletCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+
+	mnemonicCmd.Flags().StringVar(&pw, "password", "", "Password")
+	mnemonicCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+
+	deriveCmd.Flags().Uint32Var(&hdAccount, "hdaccount", 0, "Account of the hd path")
+	deriveCmd.Flags().Uint32Var(&hdIndex, "index", 0, "First address index of the hd path")
+	deriveCmd.Flags().Uint32Var(&hdCount, "count", 1, "Number of the addresses to derive")
+	deriveCmd.Flags().StringVar(&pw, "password", "", "Password")
+	deriveCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+
+	hdListCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
 
 	voteCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
 	voteCmd.MarkFlagRequired("address")
@@ -54,14 +89,60 @@ func init() {
 
 	stakeCmd.Flags().StringVar(&address, "address", "", "Account address")
 	stakeCmd.MarkFlagRequired("address")
-	stakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of staking")
+	stakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of staking (e.g. 10000aergo)")
 	stakeCmd.MarkFlagRequired("amount")
 	unstakeCmd.Flags().StringVar(&address, "address", "", "Account address")
 	unstakeCmd.MarkFlagRequired("address")
-	unstakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of staking")
+	unstakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of unstaking (e.g. 10000aergo)")
 	unstakeCmd.MarkFlagRequired("amount")
+	delegateCmd.Flags().StringVar(&address, "address", "", "Account address")
+	delegateCmd.MarkFlagRequired("address")
+	delegateCmd.Flags().StringVar(&to, "to", "", "Base58 address of candidate(peer)")
+	delegateCmd.MarkFlagRequired("to")
+	delegateCmd.Flags().StringVar(&amount, "amount", "0", "Amount of delegation")
+	delegateCmd.MarkFlagRequired("amount")
+	undelegateCmd.Flags().StringVar(&address, "address", "", "Account address")
+	undelegateCmd.MarkFlagRequired("address")
+	undelegateCmd.Flags().StringVar(&amount, "amount", "0", "Amount of delegation (0 for all)")
+	claimRewardCmd.Flags().StringVar(&address, "address", "", "Account address")
+	claimRewardCmd.MarkFlagRequired("address")
+	claimCmd.Flags().StringVar(&address, "address", "", "Account address")
+	claimCmd.MarkFlagRequired("address")
+	proposeCmd.Flags().StringVar(&address, "address", "", "Account address of proposer")
+	proposeCmd.MarkFlagRequired("address")
+	proposeCmd.Flags().StringVar(&proposalID, "id", "", "Identifier of the proposal")
+	proposeCmd.MarkFlagRequired("id")
+	proposeCmd.Flags().StringVar(&descriptionHash, "hash", "", "Base58 hash of the description of the proposal")
+	proposeCmd.MarkFlagRequired("hash")
+	proposeCmd.Flags().Uint64Var(&period, "period", 0, "Voting period in blocks")
+	proposeCmd.MarkFlagRequired("period")
+	proposeCmd.Flags().StringSliceVar(&options, "options", nil, "Options of the proposal")
+	proposeCmd.MarkFlagRequired("options")
+	voteProposalCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
+	voteProposalCmd.MarkFlagRequired("address")
+	voteProposalCmd.Flags().StringVar(&proposalID, "id", "", "Identifier of the proposal")
+	voteProposalCmd.MarkFlagRequired("id")
+	voteProposalCmd.Flags().StringSliceVar(&options, "options", nil, "Chosen options of the proposal")
+	voteProposalCmd.MarkFlagRequired("options")
+	registerBPCmd.Flags().StringVar(&address, "address", "", "Account address")
+	registerBPCmd.MarkFlagRequired("address")
+	registerBPCmd.Flags().StringVar(&to, "peer", "", "Base58 address of candidate(peer)")
+	registerBPCmd.MarkFlagRequired("peer")
+	registerBPCmd.Flags().StringVar(&name, "name", "", "Name of candidate")
+	registerBPCmd.MarkFlagRequired("name")
+	registerBPCmd.Flags().StringVar(&website, "website", "", "Website of candidate")
+	registerBPCmd.Flags().Uint32Var(&commission, "commission", 0, "Commission of candidate in percent")
+	unregisterBPCmd.Flags().StringVar(&address, "address", "", "Account address")
+	unregisterBPCmd.MarkFlagRequired("address")
+	unregisterBPCmd.Flags().StringVar(&to, "peer", "", "Base58 address of candidate(peer)")
+	unregisterBPCmd.MarkFlagRequired("peer")
+	setAuthContractCmd.Flags().StringVar(&address, "address", "", "Account address")
+	setAuthContractCmd.MarkFlagRequired("address")
+	setAuthContractCmd.Flags().StringVar(&to, "contract", "", "Address of the authorization contract, or empty to sign by the key")
 
-	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd)
+	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, unlockStatusCmd, aliasCmd, watchCmd, importCmd, exportCmd, reencryptCmd,
+		hdWalletCmd, mnemonicCmd, deriveCmd, hdListCmd, voteCmd, stakeCmd, unstakeCmd, delegateCmd, undelegateCmd, claimRewardCmd, claimCmd,
+		proposeCmd, voteProposalCmd, registerBPCmd, unregisterBPCmd, setAuthContractCmd)
 	rootCmd.AddCommand(accountCmd)
 }
 
@@ -114,13 +195,20 @@ var listCmd = &cobra.Command{
 		var err error
 		var msg *types.AccountList
 		var addrs [][]byte
+		var aliases *types.AliasList
 		if cmd.Flags().Changed("path") == false {
 			msg, err = client.GetAccounts(context.Background(), &types.Empty{})
+			if err == nil && withAliases {
+				aliases, err = client.ListAliases(context.Background(), &types.Empty{})
+			}
 		} else {
 			dataEnvPath := os.ExpandEnv(dataDir)
 			ks := key.NewStore(dataEnvPath, 0)
 			defer ks.CloseStore()
 			addrs, err = ks.GetAddresses()
+			if err == nil && withAliases {
+				aliases, err = getLocalAliases(ks)
+			}
 		}
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -130,14 +218,14 @@ var listCmd = &cobra.Command{
 		if msg != nil {
 			addresslist := msg.GetAccounts()
 			for _, a := range addresslist {
-				out = fmt.Sprintf("%s%s, ", out, types.EncodeAddress(a.Address))
+				out = fmt.Sprintf("%s%s, ", out, encodeAddressWithAlias(a.Address, aliases))
 			}
 			if addresslist != nil {
 				out = out[:len(out)-2]
 			}
 		} else if addrs != nil {
 			for _, a := range addrs {
-				out = fmt.Sprintf("%s%s, ", out, types.EncodeAddress(a))
+				out = fmt.Sprintf("%s%s, ", out, encodeAddressWithAlias(a, aliases))
 			}
 			out = out[:len(out)-2]
 		}
@@ -173,6 +261,7 @@ var unlockCmd = &cobra.Command{
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		param.Timeout = unlockTimeout
 		msg, err := client.UnlockAccount(context.Background(), param)
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -182,15 +271,57 @@ var unlockCmd = &cobra.Command{
 	},
 }
 
+var unlockStatusCmd = &cobra.Command{
+	Use:   "unlockstatus [flags]",
+	Short: "Print whether account is unlocked in the node",
+	Run: func(cmd *cobra.Command, args []string) {
+		account, err := decodeAddress(address)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		msg, err := client.GetUnlockStatus(context.Background(), &types.Account{Address: account})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(unlockStatusString(msg))
+	},
+}
+
+func unlockStatusString(status *types.UnlockStatus) string {
+	addr := types.EncodeAddress(status.GetAccount().GetAddress())
+	if !status.GetUnlocked() {
+		return addr + " locked"
+	}
+	s := addr + " unlocked at " + time.Unix(0, status.GetUnlockedAt()).Format(time.RFC3339)
+	if status.GetExpireAt() != 0 {
+		s += ", locked at " + time.Unix(0, status.GetExpireAt()).Format(time.RFC3339)
+	}
+	return s
+}
+
 var importCmd = &cobra.Command{
 	Use:   "import [flags]",
 	Short: "Import account",
 	Run: func(cmd *cobra.Command, args []string) {
 		var err error
 		var address []byte
-		importBuf, err := types.DecodePrivKey(importFormat)
-		if err != nil {
-			cmd.Printf("Failed to decode input: %s\n", err.Error())
+		var importBuf []byte
+		if keystoreFile != "" {
+			importBuf, err = ioutil.ReadFile(keystoreFile)
+			if err != nil {
+				cmd.Printf("Failed to read keystore: %s\n", err.Error())
+				return
+			}
+		} else if importFormat != "" {
+			importBuf, err = types.DecodePrivKey(importFormat)
+			if err != nil {
+				cmd.Printf("Failed to decode input: %s\n", err.Error())
+				return
+			}
+		} else {
+			cmd.Printf("Failed: --if or --keystore is required\n")
 			return
 		}
 		wif := &types.ImportFormat{Wif: &types.SingleBytes{Value: importBuf}}
@@ -211,7 +342,11 @@ var importCmd = &cobra.Command{
 		}
 
 		if cmd.Flags().Changed("path") == false {
-			msg, errRemote := client.ImportAccount(context.Background(), wif)
+			importAccount := client.ImportAccount
+			if keystoreFile != "" {
+				importAccount = client.ImportAccountKeystore
+			}
+			msg, errRemote := importAccount(context.Background(), wif)
 			if errRemote != nil {
 				cmd.Printf("Failed: %s\n", errRemote.Error())
 				return
@@ -221,7 +356,11 @@ var importCmd = &cobra.Command{
 			dataEnvPath := os.ExpandEnv(dataDir)
 			ks := key.NewStore(dataEnvPath, 0)
 			defer ks.CloseStore()
-			address, err = ks.ImportKey(importBuf, wif.Oldpass, wif.Newpass)
+			if keystoreFile != "" {
+				address, err = ks.ImportKeystore(importBuf, wif.Oldpass, wif.Newpass)
+			} else {
+				address, err = ks.ImportKey(importBuf, wif.Oldpass, wif.Newpass)
+			}
 			if err != nil {
 				cmd.Printf("Failed: %s\n", err.Error())
 				return
@@ -240,6 +379,10 @@ var exportCmd = &cobra.Command{
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		if exportKeystore {
+			execExportKeystore(cmd, param)
+			return
+		}
 		var result []byte
 		if cmd.Flags().Changed("path") == false {
 			msg, err := client.ExportAccount(context.Background(), param)
@@ -263,11 +406,77 @@ var exportCmd = &cobra.Command{
 	},
 }
 
+func execExportKeystore(cmd *cobra.Command, param *types.Personal) {
+	var keystore []byte
+	if cmd.Flags().Changed("path") == false {
+		msg, err := client.ExportAccountKeystore(context.Background(),
+			&types.KeystoreParams{Account: param.Account, Passphrase: param.Passphrase, Kdf: keystoreKdf})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		keystore = msg.Value
+	} else {
+		dataEnvPath := os.ExpandEnv(dataDir)
+		ks := key.NewStore(dataEnvPath, 0)
+		defer ks.CloseStore()
+		var err error
+		keystore, err = ks.ExportKeystore(param.Account.Address, param.Passphrase, keystoreKdf)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+	}
+	if keystoreFile == "" {
+		cmd.Println(string(keystore))
+		return
+	}
+	if err := ioutil.WriteFile(keystoreFile, keystore, 0600); err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Printf("keystore saved to %s\n", keystoreFile)
+}
+
+var reencryptCmd = &cobra.Command{
+	Use:   "reencrypt [flags]",
+	Short: "Encrypt the keys in the cli again by the current keystore format",
+	Long: `Encrypt the keys opened by the password again by the current keystore format
+and the argon2id parameters. The keys with other passwords are skipped.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		pass := pw
+		if pass == "" {
+			pass, err = getPasswd(cmd, false)
+			if err != nil {
+				cmd.Printf("Failed get password: %s\n", err.Error())
+				return
+			}
+		}
+		ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+		defer ks.CloseStore()
+		err = ks.SetArgon2Params(key.Argon2Params{Time: argon2Time, Memory: argon2Memory, Threads: argon2Threads})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		reencrypted, skipped, err := ks.ReencryptKeys(pass)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, a := range reencrypted {
+			cmd.Println(types.EncodeAddress(a))
+		}
+		cmd.Printf("%d keys reencrypted, %d keys skipped\n", len(reencrypted), len(skipped))
+	},
+}
+
 func parsePersonalParam(cmd *cobra.Command) (*types.Personal, error) {
 	var err error
 	param := &types.Personal{Account: &types.Account{}}
 	if address != "" {
-		param.Account.Address, err = types.DecodeAddress(address)
+		param.Account.Address, err = decodeAddress(address)
 		if err != nil {
 			return nil, err
 		}
@@ -1,13 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"os"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestAccountWithPath(t *testing.T) {
@@ -41,3 +45,128 @@ func TestAccountWithPath(t *testing.T) {
 	os.RemoveAll(testDir)
 	os.RemoveAll(testDir2)
 }
+
+func TestAccountKeystoreWithPath(t *testing.T) {
+	const testDir = "test"
+	const testDir2 = "test2"
+	const keystore = "test.keystore"
+	defer func() {
+		importFormat, keystoreFile, exportKeystore, keystoreKdf = "", "", false, "scrypt"
+		os.RemoveAll(testDir)
+		os.RemoveAll(testDir2)
+		os.Remove(keystore)
+	}()
+
+	outputNew, err := executeCommand(rootCmd, "account", "new", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	address := strings.TrimSpace(outputNew)
+
+	output, err := executeCommand(rootCmd, "account", "export", "--address", address, "--password", "1", "--path", testDir,
+		"--keystore", "--kdf", "argon2id", "--file", keystore)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "keystore saved to "+keystore+"\n", output)
+
+	output, err = executeCommand(rootCmd, "account", "import", "--if", "", "--keystore", keystore, "--password", "1", "--newpassword", "2", "--path", testDir2)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, address+"\n", output)
+
+	output, err = executeCommand(rootCmd, "account", "import", "--if", "", "--keystore", keystore, "--password", "wrong", "--path", testDir2)
+	assert.Equal(t, "Failed: wrong keystore password\n", output)
+}
+
+func TestAccountHDWalletWithPath(t *testing.T) {
+	const testDir = "test"
+	const testDir2 = "test2"
+	defer func() {
+		mnemonic, pw = "", ""
+		os.RemoveAll(testDir)
+		os.RemoveAll(testDir2)
+	}()
+
+	output, err := executeCommand(rootCmd, "account", "hdwallet", "--words", "12", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	words := strings.TrimSpace(output)
+	assert.Len(t, strings.Fields(words), 12)
+
+	output, err = executeCommand(rootCmd, "account", "mnemonic", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, words+"\n", output)
+
+	derived, err := executeCommand(rootCmd, "account", "derive", "--count", "2", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	lines := strings.Split(strings.TrimSpace(derived), "\n")
+	if assert.Len(t, lines, 2) {
+		assert.True(t, strings.HasPrefix(lines[1], "m/44'/441'/0'/0/1 "), lines[1])
+	}
+	output, err = executeCommand(rootCmd, "account", "hdlist", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, derived, output)
+
+	// the same accounts are derived from the mnemonic in another keystore
+	_, err = executeCommand(rootCmd, "account", "hdwallet", "--mnemonic", words, "--password", "2", "--path", testDir2)
+	assert.NoError(t, err, "should be success")
+	output, err = executeCommand(rootCmd, "account", "derive", "--count", "2", "--password", "2", "--path", testDir2)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, derived, output)
+}
+
+func TestAccountReencryptWithPath(t *testing.T) {
+	const testDir = "test"
+	defer func() {
+		pw = ""
+		os.RemoveAll(testDir)
+	}()
+
+	outputNew, err := executeCommand(rootCmd, "account", "new", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	_, err = executeCommand(rootCmd, "account", "new", "--password", "2", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+
+	output, err := executeCommand(rootCmd, "account", "reencrypt", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "0 keys reencrypted, 0 keys skipped\n", output, "already current")
+
+	output, err = executeCommand(rootCmd, "account", "reencrypt", "--password", "1", "--path", testDir, "--argon2time", "2")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, outputNew+"1 keys reencrypted, 1 keys skipped\n", output)
+}
+
+func TestAccountUnlockWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		unlockTimeout = 0
+		pw = ""
+	}()
+	const testAddress = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAddress)
+
+	mock.EXPECT().UnlockAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.Personal, opts ...grpc.CallOption) (*types.Account, error) {
+			assert.Equal(t, uint64(60), in.Timeout)
+			assert.Equal(t, "1", in.Passphrase)
+			return in.Account, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "account", "unlock", "--address", testAddress, "--password", "1", "--timeout", "60")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, testAddress+"\n", output)
+
+	unlockedAt := time.Now()
+	mock.EXPECT().GetUnlockStatus(gomock.Any(), &types.Account{Address: account}).Return(&types.UnlockStatus{
+		Account:    &types.Account{Address: account},
+		Unlocked:   true,
+		UnlockedAt: unlockedAt.UnixNano(),
+		ExpireAt:   unlockedAt.Add(time.Minute).UnixNano(),
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "account", "unlockstatus", "--address", testAddress)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, testAddress+" unlocked at "+unlockedAt.Format(time.RFC3339)+
+		", locked at "+unlockedAt.Add(time.Minute).Format(time.RFC3339)+"\n", output)
+
+	mock.EXPECT().GetUnlockStatus(gomock.Any(), gomock.Any()).Return(&types.UnlockStatus{
+		Account: &types.Account{Address: account},
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "account", "unlockstatus", "--address", testAddress)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, testAddress+" locked\n", output)
+}
@@ -0,0 +1,118 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logModule  string
+	auditSince time.Duration
+	auditLimit uint32
+)
+
+func init() {
+	adminCmd := &cobra.Command{
+		Use:   "admin [flags] subcommand",
+		Short: "Administrate the node at runtime",
+	}
+	logLevelCmd.Flags().StringVar(&logModule, "module", "", "module of the loggers to change, like chain, mempool, p2p or raft (default: all the modules)")
+	signAuditCmd.Flags().StringVar(&address, "address", "", "account of the signing (default: all the accounts)")
+	signAuditCmd.Flags().DurationVar(&auditSince, "since", 0, "print the signing within the duration like 24h (default: all the signing)")
+	signAuditCmd.Flags().Uint32Var(&auditLimit, "limit", 100, "number of the latest signing to print (0 for no limit)")
+	adminCmd.AddCommand(logLevelCmd, profilingCmd, dumpCmd, signAuditCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+var logLevelCmd = &cobra.Command{
+	Use:   "loglevel [flags] [debug|info|warn|error]",
+	Short: "Print the log levels of the modules, or change the level of a module",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var msg *types.LogLevelList
+		var err error
+		if len(args) == 0 {
+			msg, err = client.GetLogLevels(context.Background(), &types.Empty{})
+		} else {
+			msg, err = client.SetLogLevel(context.Background(), &types.LogLevel{Module: logModule, Level: args[0]})
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, l := range msg.Levels {
+			cmd.Printf("%s\t%s\n", l.Module, l.Level)
+		}
+	},
+}
+
+var profilingCmd = &cobra.Command{
+	Use:       "profiling on|off",
+	Short:     "Start or stop serving the pprof endpoints of the node",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"on", "off"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if args[0] != "on" && args[0] != "off" {
+			cmd.Printf("Failed: invalid argument %s, should be on or off\n", args[0])
+			return
+		}
+		msg, err := client.SetProfiling(context.Background(), &types.Profiling{Enabled: args[0] == "on"})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.JSON(msg))
+	},
+}
+
+var dumpCmd = &cobra.Command{
+	Use:   "dump goroutine|heap|allocs|block|mutex|threadcreate",
+	Short: "Write a profile of the node to a file in the data directory of the node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.DumpProfile(context.Background(), &types.ProfileDump{Kind: args[0]})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(msg.Path)
+	},
+}
+
+var signAuditCmd = &cobra.Command{
+	Use:   "signaudit [flags]",
+	Short: "Print the audit log of the transactions signed by the node",
+	Run: func(cmd *cobra.Command, args []string) {
+		query := &types.SignAuditQuery{Limit: auditLimit}
+		if address != "" {
+			account, err := decodeAddress(address)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			query.Account = account
+		}
+		if auditSince != 0 {
+			query.From = time.Now().Add(-auditSince).UnixNano()
+		}
+		msg, err := client.ListSignAudits(context.Background(), query)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, a := range msg.GetAudits() {
+			cmd.Printf("%s\t%s\t%s\t%s\t%s\t%s\n", time.Unix(0, a.GetTime()).Format(time.RFC3339),
+				types.EncodeAddress(a.GetAccount()), base58.Encode(a.GetTxHash()), a.GetClient(), a.GetPeer(), a.GetError())
+		}
+	},
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestAdminWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		logModule = ""
+		logLevelCmd.Flags().Lookup("module").Changed = false
+	}()
+
+	levels := &types.LogLevelList{Levels: []*types.LogLevel{
+		{Module: "chain", Level: "info"},
+		{Module: "raft", Level: "debug"},
+	}}
+	mock.EXPECT().GetLogLevels(gomock.Any(), gomock.Any()).Return(levels, nil).Times(1)
+	output, err := executeCommand(rootCmd, "admin", "loglevel")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "chain\tinfo\nraft\tdebug\n", output)
+
+	mock.EXPECT().SetLogLevel(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.LogLevel, opts ...grpc.CallOption) (*types.LogLevelList, error) {
+			assert.Equal(t, &types.LogLevel{Module: "raft", Level: "debug"}, in)
+			return levels, nil
+		}).Times(1)
+	_, err = executeCommand(rootCmd, "admin", "loglevel", "--module", "raft", "debug")
+	assert.NoError(t, err, "should be success")
+
+	output, err = executeCommand(rootCmd, "admin", "profiling", "maybe")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: invalid argument")
+
+	mock.EXPECT().SetProfiling(gomock.Any(), &types.Profiling{Enabled: true}).Return(
+		&types.Profiling{Enabled: true, Address: "0.0.0.0:6060"}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "admin", "profiling", "on")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"address": "0.0.0.0:6060"`)
+
+	mock.EXPECT().DumpProfile(gomock.Any(), &types.ProfileDump{Kind: "heap"}).Return(
+		&types.ProfileDump{Kind: "heap", Path: "data/dumps/heap.pprof"}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "admin", "dump", "heap")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "data/dumps/heap.pprof\n", output)
+}
+
+func TestSignAuditWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		address = ""
+		auditSince = 0
+	}()
+	const testAddress = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAddress)
+	signed := time.Now()
+
+	mock.EXPECT().ListSignAudits(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.SignAuditQuery, opts ...grpc.CallOption) (*types.SignAuditList, error) {
+			assert.Equal(t, account, in.Account)
+			assert.Equal(t, uint32(10), in.Limit)
+			assert.True(t, in.From > signed.Add(-2*time.Hour).UnixNano())
+			return &types.SignAuditList{Audits: []*types.SignAudit{
+				{Time: signed.UnixNano(), Account: account, TxHash: []byte{1}, Client: "anonymous", Peer: "127.0.0.1:7845"},
+			}}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "admin", "signaudit", "--address", testAddress, "--since", "1h", "--limit", "10")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, signed.Format(time.RFC3339)+"\t"+testAddress+"\t2\tanonymous\t127.0.0.1:7845\t\n", output)
+}
@@ -0,0 +1,157 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+// aliasPrefix marks a label of the address book in place of an address.
+const aliasPrefix = "@"
+
+func init() {
+	aliasSetCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	aliasDeleteCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	aliasListCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	aliasCmd.AddCommand(aliasSetCmd, aliasDeleteCmd, aliasListCmd)
+}
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias [flags] subcommand",
+	Short: "Manage the address book, whose labels are used as @label in place of the addresses",
+}
+
+var aliasSetCmd = &cobra.Command{
+	Use:   "set [flags] <label> <address>",
+	Short: "Label an address in the address book",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		label := strings.TrimPrefix(args[0], aliasPrefix)
+		address, err := types.DecodeAddress(args[1])
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		if cmd.Flags().Changed("path") == false {
+			_, err = client.SetAlias(context.Background(), &types.Alias{Label: label, Address: address})
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			err = ks.SetAlias(label, address)
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(aliasPrefix + label + " " + types.EncodeAddress(address))
+	},
+}
+
+var aliasDeleteCmd = &cobra.Command{
+	Use:   "delete [flags] <label>",
+	Short: "Remove a label from the address book",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		label := strings.TrimPrefix(args[0], aliasPrefix)
+		var address []byte
+		if cmd.Flags().Changed("path") == false {
+			alias, err := client.DeleteAlias(context.Background(), &types.Alias{Label: label})
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			address = alias.GetAddress()
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			var err error
+			if address, err = ks.DeleteAlias(label); err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+		}
+		cmd.Println(aliasPrefix + label + " " + types.EncodeAddress(address))
+	},
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:   "list [flags]",
+	Short: "Print the address book",
+	Run: func(cmd *cobra.Command, args []string) {
+		var aliases *types.AliasList
+		var err error
+		if cmd.Flags().Changed("path") == false {
+			aliases, err = client.ListAliases(context.Background(), &types.Empty{})
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			aliases, err = getLocalAliases(ks)
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, a := range aliases.GetAliases() {
+			cmd.Println(aliasPrefix + a.GetLabel() + " " + types.EncodeAddress(a.GetAddress()))
+		}
+	},
+}
+
+func getLocalAliases(ks *key.Store) (*types.AliasList, error) {
+	aliases, err := ks.GetAliases()
+	if err != nil {
+		return nil, err
+	}
+	list := &types.AliasList{}
+	for _, a := range aliases {
+		list.Aliases = append(list.Aliases, &types.Alias{Label: a.Label, Address: a.Address})
+	}
+	return list, nil
+}
+
+// decodeAddress decodes the address, or resolves it in the address book of
+// the node if it's given as @label.
+func decodeAddress(encoded string) ([]byte, error) {
+	if !strings.HasPrefix(encoded, aliasPrefix) {
+		return types.DecodeAddress(encoded)
+	}
+	if client == nil {
+		return nil, errors.New("not connected to resolve the alias " + encoded)
+	}
+	aliases, err := client.ListAliases(context.Background(), &types.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range aliases.GetAliases() {
+		if aliasPrefix+a.GetLabel() == encoded {
+			return a.GetAddress(), nil
+		}
+	}
+	return nil, key.ErrAliasNotFound
+}
+
+// encodeAddressWithAlias returns the encoded address followed by its labels
+// in the aliases like "Am... (@label)".
+func encodeAddressWithAlias(address []byte, aliases *types.AliasList) string {
+	encoded := types.EncodeAddress(address)
+	var labels []string
+	for _, a := range aliases.GetAliases() {
+		if string(a.GetAddress()) == string(address) {
+			labels = append(labels, aliasPrefix+a.GetLabel())
+		}
+	}
+	if len(labels) != 0 {
+		encoded += " (" + strings.Join(labels, ", ") + ")"
+	}
+	return encoded
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasWithPath(t *testing.T) {
+	const testDir = "test"
+	defer func() {
+		withAliases = false
+		os.RemoveAll(testDir)
+	}()
+	outputNew, err := executeCommand(rootCmd, "account", "new", "--password", "1", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	addr := strings.TrimSpace(outputNew)
+
+	output, err := executeCommand(rootCmd, "account", "alias", "set", "@alice", addr, "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "@alice "+addr+"\n", output)
+	output, err = executeCommand(rootCmd, "account", "alias", "set", "bad label", addr, "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: label should be")
+
+	output, err = executeCommand(rootCmd, "account", "alias", "list", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "@alice "+addr+"\n", output)
+	output, err = executeCommand(rootCmd, "account", "list", "--aliases", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "["+addr+" (@alice)]\n", output)
+
+	output, err = executeCommand(rootCmd, "account", "alias", "delete", "alice", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "@alice "+addr+"\n", output)
+	output, err = executeCommand(rootCmd, "account", "alias", "list", "--path", testDir)
+	assert.NoError(t, err, "should be success")
+	assert.Empty(t, output)
+}
+
+func TestDecodeAliasWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	const testAddress = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAddress)
+
+	mock.EXPECT().ListAliases(gomock.Any(), gomock.Any()).Return(&types.AliasList{Aliases: []*types.Alias{
+		{Label: "alice", Address: account},
+	}}, nil).Times(2)
+	decoded, err := decodeAddress("@alice")
+	assert.NoError(t, err)
+	assert.Equal(t, account, decoded)
+	_, err = decodeAddress("@bob")
+	assert.Error(t, err)
+
+	decoded, err = decodeAddress(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, account, decoded, "not resolved")
+}
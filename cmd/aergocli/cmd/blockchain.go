@@ -14,10 +14,12 @@ import (
 )
 
 var printHex bool
+var printConsensus bool
 
 func init() {
 	rootCmd.AddCommand(blockchainCmd)
 	blockchainCmd.Flags().BoolVar(&printHex, "hex", false, "Print bytes to hex format")
+	blockchainCmd.Flags().BoolVar(&printConsensus, "consensus", false, "Print the consensus info with the progress of the members")
 }
 
 var blockchainCmd = &cobra.Command{
@@ -29,6 +31,17 @@ var blockchainCmd = &cobra.Command{
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		if printConsensus {
+			info, err := client.GetConsensusInfo(context.Background(), &aergorpc.Empty{})
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			if msg.ConsensusInfo, err = convConsensusInfo(info); err != nil {
+				cmd.Printf("failed decode consensus info: %v\n", err)
+				return
+			}
+		}
 		if printHex {
 			cmd.Println(util.ConvHexBlockchainStatus(msg))
 		} else {
@@ -24,7 +24,7 @@ func TestBlockchainWithMock(t *testing.T) {
 	).Return(
 		&types.BlockchainStatus{BestBlockHash: testBlockHash, BestHeight: 1, ConsensusInfo: ""},
 		nil,
-	).MaxTimes(3)
+	).MaxTimes(4)
 
 	output, err := executeCommand(rootCmd, "blockchain")
 	assert.NoError(t, err, "should be success")
@@ -51,4 +51,28 @@ func TestBlockchainWithMock(t *testing.T) {
 	testBlockHashByte, _ := base58.Decode(testBlockHashString)
 	assert.Equal(t, hex.EncodeToString(testBlockHashByte), result["Hash"])
 	assert.Equal(t, float64(1), result["Height"])
+
+	mock.EXPECT().GetConsensusInfo(gomock.Any(), gomock.Any()).Return(
+		&types.ConsensusInfo{
+			Type: "raft",
+			Info: `{"Leader":"aergo1"}`,
+			Bps:  []string{`{"Name":"aergo2","Match":7,"Lag":3,"Snapshot":"sending"}`},
+		},
+		nil,
+	).Times(1)
+	defer func() { printHex, printConsensus = false, false }()
+	output, err = executeCommand(rootCmd, "blockchain", "--hex=false", "--consensus")
+	assert.NoError(t, err, "should be success")
+	t.Log(output)
+
+	result = nil
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, float64(1), result["Height"])
+	consensus := result["ConsensusInfo"].(map[string]interface{})
+	assert.Equal(t, "raft", consensus["Type"])
+	bp := consensus["Bps"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, float64(3), bp["Lag"], "lag of the follower")
+	assert.Equal(t, "sending", bp["Snapshot"], "snapshot of the follower")
 }
@@ -0,0 +1,32 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(getBlockTemplateCmd)
+}
+
+var getBlockTemplateCmd = &cobra.Command{
+	Use:   "getblocktemplate",
+	Short: "Print the candidate of the next block: the parent, the txs in the tx order of the node and the consensus",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.GetBlockTemplate(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.BlockTemplateConvBase58Addr(msg))
+	},
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlockTemplateWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	template := &types.BlockTemplate{
+		PrevBlockHash: []byte("parent"),
+		BlockNo:       11,
+		ConsensusType: "dpos",
+		ConsensusInfo: `{"Status":{"LibNo":9}}`,
+		TxOrder:       "fee",
+		Txs:           []*types.Tx{{Hash: []byte("tx"), Body: &types.TxBody{Nonce: 3}}},
+	}
+	gomock.InOrder(
+		mock.EXPECT().GetBlockTemplate(gomock.Any(), gomock.Any()).Return(template, nil),
+		mock.EXPECT().GetBlockTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("accessor is not initilized")),
+	)
+
+	output, err := executeCommand(rootCmd, "getblocktemplate")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"PrevBlockHash": "`+base58.Encode([]byte("parent"))+`"`)
+	assert.Contains(t, output, `"BlockNo": 11`)
+	assert.Contains(t, output, `"LibNo": 9`)
+	assert.Contains(t, output, `"TxOrder": "fee"`)
+	assert.Contains(t, output, `"Nonce": 3`)
+
+	output, err = executeCommand(rootCmd, "getblocktemplate")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: accessor is not initilized")
+}
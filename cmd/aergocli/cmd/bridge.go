@@ -0,0 +1,224 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var bridgeCmd = &cobra.Command{
+	Use:   "bridge [flags] subcommand",
+	Short: "Transfer aergo to and from the paired chain by the aergo.bridge contract",
+}
+
+var (
+	bridgeHeight   uint64
+	bridgeRoot     string
+	bridgeReceiver string
+	bridgeProof    string
+	bridgeBlockNo  uint64
+)
+
+func init() {
+	rootCmd.AddCommand(bridgeCmd)
+	anchorCmd := &cobra.Command{
+		Use:   "anchor",
+		Short: "Vote as a validator for the state root of the paired chain at a block",
+		RunE:  execBridgeAnchor,
+	}
+	anchorCmd.Flags().StringVar(&from, "from", "", "Validator account address")
+	anchorCmd.MarkFlagRequired("from")
+	anchorCmd.Flags().Uint64Var(&bridgeHeight, "height", 0, "Block number on the paired chain")
+	anchorCmd.MarkFlagRequired("height")
+	anchorCmd.Flags().StringVar(&bridgeRoot, "root", "", "State root of the block on the paired chain in base58")
+	anchorCmd.MarkFlagRequired("root")
+
+	depositCmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit aergo to an account on the paired chain",
+		RunE:  execBridgeDeposit,
+	}
+	depositCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	depositCmd.MarkFlagRequired("from")
+	depositCmd.Flags().StringVar(&to, "to", "", "Receiver account address on the paired chain")
+	depositCmd.MarkFlagRequired("to")
+	depositCmd.Flags().StringVar(&amount, "amount", "0", "Amount to deposit (e.g. 10aergo)")
+	depositCmd.MarkFlagRequired("amount")
+
+	proofCmd := &cobra.Command{
+		Use:   "proof",
+		Short: "Show the deposits to an account with their proof, to claim them on the paired chain",
+		RunE:  execBridgeProof,
+	}
+	proofCmd.Flags().StringVar(&bridgeReceiver, "receiver", "", "Receiver account address")
+	proofCmd.MarkFlagRequired("receiver")
+	proofCmd.Flags().Uint64VarP(&bridgeBlockNo, "blockno", "n", 0, "Block number anchored on the paired chain (default the best block)")
+
+	claimCmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Claim the deposits on the paired chain by their proof",
+		RunE:  execBridgeClaim,
+	}
+	claimCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	claimCmd.MarkFlagRequired("from")
+	claimCmd.Flags().StringVar(&bridgeReceiver, "receiver", "", "Receiver account address (default the sender)")
+	claimCmd.Flags().StringVar(&bridgeProof, "proof", "", "Proof of the deposits by bridge proof on the paired chain")
+	claimCmd.MarkFlagRequired("proof")
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the anchored root of the paired chain, and the deposits and claims of an account",
+		RunE:  execBridgeStatus,
+	}
+	statusCmd.Flags().StringVar(&bridgeReceiver, "receiver", "", "Account address")
+
+	bridgeCmd.AddCommand(anchorCmd, depositCmd, proofCmd, claimCmd, statusCmd)
+}
+
+func execBridgeAnchor(cmd *cobra.Command, args []string) error {
+	return sendBridgeTx(cmd, new(big.Int), &types.CallInfo{
+		Name: types.BridgeAnchor,
+		Args: []interface{}{strconv.FormatUint(bridgeHeight, 10), bridgeRoot},
+	})
+}
+
+func execBridgeDeposit(cmd *cobra.Command, args []string) error {
+	amountBigInt, err := util.ParseUnit(amount)
+	if err != nil {
+		return errors.New("Failed to parse --amount flag\n" + err.Error())
+	}
+	return sendBridgeTx(cmd, amountBigInt, &types.CallInfo{
+		Name: types.BridgeDeposit,
+		Args: []interface{}{to},
+	})
+}
+
+func execBridgeClaim(cmd *cobra.Command, args []string) error {
+	receiver := bridgeReceiver
+	if receiver == "" {
+		receiver = from
+	}
+	return sendBridgeTx(cmd, new(big.Int), &types.CallInfo{
+		Name: types.BridgeClaim,
+		Args: []interface{}{receiver, bridgeProof},
+	})
+}
+
+// sendBridgeTx checks the bridge tx as the bridge does and sends it.
+func sendBridgeTx(cmd *cobra.Command, amount *big.Int, ci *types.CallInfo) error {
+	account, err := decodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	payload, err := json.Marshal(ci)
+	if err != nil {
+		return err
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoBridge),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	if _, err = types.ParseBridgeTx(tx.Body); err != nil {
+		return err
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
+type bridgeProofOutput struct {
+	BlockNo   uint64 `json:"blockNo"`
+	BlockHash string `json:"blockHash"`
+	Root      string `json:"root"`
+	Deposited string `json:"deposited"`
+	Proof     string `json:"proof"`
+}
+
+func execBridgeProof(cmd *cobra.Command, args []string) error {
+	receiver, err := decodeAddress(bridgeReceiver)
+	if err != nil {
+		return errors.New("Wrong address in --receiver flag\n" + err.Error())
+	}
+	msg, err := client.GetBridgeProof(context.Background(), &types.BridgeProofQuery{
+		Receiver: receiver,
+		BlockNo:  bridgeBlockNo,
+	})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	encoded, err := types.EncodeBridgeProof(msg.GetProof())
+	if err != nil {
+		return err
+	}
+	printSystemJSON(cmd, &bridgeProofOutput{
+		BlockNo:   msg.GetBlockNo(),
+		BlockHash: enc.ToString(msg.GetBlockHash()),
+		Root:      enc.ToString(msg.GetRoot()),
+		Deposited: types.BridgeAmount(msg.GetDeposited()).String(),
+		Proof:     encoded,
+	})
+	return nil
+}
+
+type bridgeStatus struct {
+	AnchorHeight uint64 `json:"anchorHeight,omitempty"`
+	AnchorRoot   string `json:"anchorRoot,omitempty"`
+	Balance      string `json:"balance"`
+	Deposited    string `json:"deposited,omitempty"`
+	Claimed      string `json:"claimed,omitempty"`
+}
+
+func execBridgeStatus(cmd *cobra.Command, args []string) error {
+	keys := []string{types.BridgeAnchorKey}
+	if bridgeReceiver != "" {
+		receiver, err := decodeAddress(bridgeReceiver)
+		if err != nil {
+			return errors.New("Wrong address in --receiver flag\n" + err.Error())
+		}
+		keys = append(keys, types.BridgeDepositKey(receiver), types.BridgeClaimKey(receiver))
+	}
+	msg, err := client.QueryContractState(context.Background(), &types.StateQuery{
+		ContractAddress: []byte(types.AergoBridge),
+		StorageKeys:     keys,
+	})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	status := &bridgeStatus{
+		Balance: new(big.Int).SetBytes(msg.GetContractProof().GetState().GetBalance()).String(),
+	}
+	for i, varProof := range msg.GetVarProofs() {
+		switch i {
+		case 0:
+			if anchor := types.DecodeBridgeRoot(varProof.GetValue()); anchor != nil {
+				status.AnchorHeight, status.AnchorRoot = anchor.Height, enc.ToString(anchor.Root)
+			}
+		case 1:
+			status.Deposited = types.BridgeAmount(varProof.GetValue()).String()
+		case 2:
+			status.Claimed = types.BridgeAmount(varProof.GetValue()).String()
+		}
+	}
+	printSystemJSON(cmd, status)
+	return nil
+}
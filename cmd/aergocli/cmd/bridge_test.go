@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestBridgeWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() { from, to, amount, bridgeReceiver = "", "", "0", "" }()
+
+	testAddress := "AmNrsAqkXhQfE6sGxTutQkf9ekaYowaJFLekEm8qvDr1RB1AnsiM"
+	testAccount, _ := types.DecodeAddress(testAddress)
+
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.Tx, opts ...grpc.CallOption) (*types.CommitResult, error) {
+			call, err := types.ParseBridgeTx(in.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, types.BridgeDeposit, call.Name)
+			assert.Equal(t, []byte(types.AergoBridge), in.Body.Recipient)
+			assert.Equal(t, big.NewInt(10), in.Body.GetAmountBigInt())
+			return &types.CommitResult{Hash: []byte("hash")}, nil
+		}).Times(1)
+	_, err := executeCommand(rootCmd, "bridge", "deposit", "--from", testAddress, "--to", testAddress, "--amount", "10")
+	assert.NoError(t, err)
+	_, err = executeCommand(rootCmd, "bridge", "deposit", "--from", testAddress, "--to", "invalid", "--amount", "10")
+	assert.Error(t, err, "rejected before sending")
+
+	mock.EXPECT().GetBridgeProof(gomock.Any(), &types.BridgeProofQuery{Receiver: testAccount, BlockNo: 5}).Return(&types.BridgeProof{
+		BlockNo:   5,
+		Deposited: big.NewInt(10).Bytes(),
+		Proof:     &types.StateQueryProof{ContractProof: &types.AccountProof{Key: []byte(types.AergoBridge)}},
+	}, nil).Times(1)
+	output, err := executeCommand(rootCmd, "bridge", "proof", "--receiver", testAddress, "--blockno", "5")
+	assert.NoError(t, err)
+	var proof bridgeProofOutput
+	assert.NoError(t, json.Unmarshal([]byte(output), &proof))
+	assert.Equal(t, "10", proof.Deposited)
+
+	// the proof of the paired chain is claimed as it is printed
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.Tx, opts ...grpc.CallOption) (*types.CommitResult, error) {
+			call, err := types.ParseBridgeTx(in.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccount, []byte(call.Receiver))
+			assert.Equal(t, []byte(types.AergoBridge), call.Proof.GetContractProof().GetKey())
+			return &types.CommitResult{Hash: []byte("hash")}, nil
+		}).Times(1)
+	_, err = executeCommand(rootCmd, "bridge", "claim", "--from", testAddress, "--proof", proof.Proof)
+	assert.NoError(t, err)
+
+	anchor := &types.BridgeRoot{Height: 7, Root: make([]byte, types.HashIDLength)}
+	mock.EXPECT().QueryContractState(gomock.Any(), gomock.Any()).Return(&types.StateQueryProof{
+		ContractProof: &types.AccountProof{State: &types.State{Balance: big.NewInt(100).Bytes()}},
+		VarProofs: []*types.ContractVarProof{
+			{Value: anchor.Bytes()}, {Value: big.NewInt(10).Bytes()}, {},
+		},
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "bridge", "status", "--receiver", testAddress)
+	assert.NoError(t, err)
+	var status bridgeStatus
+	assert.NoError(t, json.Unmarshal([]byte(output), &status))
+	assert.Equal(t, uint64(7), status.AnchorHeight)
+	assert.Equal(t, "100", status.Balance)
+	assert.Equal(t, "10", status.Deposited)
+	assert.Equal(t, "0", status.Claimed)
+}
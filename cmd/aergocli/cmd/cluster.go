@@ -2,9 +2,15 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
 	aergorpc "github.com/aergoio/aergo/types"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/spf13/cobra"
-	"strconv"
 )
 
 var (
@@ -12,8 +18,17 @@ var (
 	nodeidStr string
 	url       string
 	peerid    string
+
+	waitChange    bool
+	changeTimeout uint64
+
+	// clusterPollInterval is the interval of polling the cluster status while
+	// waiting for a membership change
+	clusterPollInterval = time.Second
 )
 
+var errChangeTimeout = errors.New("timeout waiting for the membership change")
+
 func init() {
 	clusterCmd := &cobra.Command{
 		Use:   "cluster [flags] subcommand",
@@ -26,14 +41,35 @@ func init() {
 	addCmd.MarkFlagRequired("url")
 	addCmd.Flags().StringVar(&peerid, "peerid", "", "peer id of node to add to the cluster")
 	addCmd.MarkFlagRequired("peerid")
+	addCmd.Flags().BoolVar(&waitChange, "wait", false, "wait until the new member catches up with the leader")
+	addCmd.Flags().Uint64Var(&changeTimeout, "timeout", 60, "seconds to wait for the membership change")
 
 	removeCmd.Flags().StringVar(&nodeidStr, "nodeid", "", "node id to remove to the cluster")
 	removeCmd.MarkFlagRequired("nodeid")
+	removeCmd.Flags().BoolVar(&waitChange, "wait", false, "wait until the member leaves the cluster")
+	removeCmd.Flags().Uint64Var(&changeTimeout, "timeout", 60, "seconds to wait for the membership change")
+
+	transferLeaderCmd.Flags().StringVar(&nodeidStr, "nodeid", "", "node id of the new leader")
+	transferLeaderCmd.MarkFlagRequired("nodeid")
 
-	clusterCmd.AddCommand(addCmd, removeCmd)
+	clusterCmd.AddCommand(statusCmd, addCmd, removeCmd, transferLeaderCmd, snapshotCmd)
 	rootCmd.AddCommand(clusterCmd)
 }
 
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Print the members, the leader and the replication lag of the cluster. The lag is known only by the leader.",
+	Run: func(cmd *cobra.Command, args []string) {
+		status, err := client.GetClusterStatus(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			cmd.Printf("Failed to get cluster status: %s\n", err.Error())
+			return
+		}
+
+		cmd.Println(convClusterStatus(status))
+	},
+}
+
 var addCmd = &cobra.Command{
 	Use:   "add [flags]",
 	Short: "Add new member node to cluster. This command can only be used for raft consensus.",
@@ -54,6 +90,14 @@ var addCmd = &cobra.Command{
 		}
 
 		cmd.Printf("added member to cluster: %s\n", reply.Attr.ToString())
+
+		if waitChange {
+			if err := waitMembership(cmd, reply.Attr.ID, true); err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Printf("member %x caught up with the leader\n", reply.Attr.ID)
+		}
 		return
 	},
 }
@@ -69,7 +113,7 @@ var removeCmd = &cobra.Command{
 
 		nodeid, err := strconv.ParseUint(nodeidStr, 16, 64)
 		if err != nil {
-			cmd.Printf("Failed to add member: %s\n", err.Error())
+			cmd.Printf("Failed to remove member: %s\n", err.Error())
 			return
 		}
 
@@ -80,9 +124,156 @@ var removeCmd = &cobra.Command{
 		reply, err := client.ChangeMembership(context.Background(), changeReq)
 		if err != nil {
 			cmd.Printf("Failed to remove member: %s\n", err.Error())
+			return
 		}
 
 		cmd.Printf("removed member from cluster: %s\n", reply.Attr.ToString())
+
+		if waitChange {
+			if err := waitMembership(cmd, nodeid, false); err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Printf("member %x left the cluster\n", nodeid)
+		}
 		return
 	},
 }
+
+var transferLeaderCmd = &cobra.Command{
+	Use:   "transfer-leader [flags]",
+	Short: "Transfer the leadership to the member with given node id. This command must be sent to the leader.",
+	Run: func(cmd *cobra.Command, args []string) {
+		nodeid, err := strconv.ParseUint(nodeidStr, 16, 64)
+		if err != nil {
+			cmd.Printf("Failed: nodeid flag must be string of hex format: %s\n", err.Error())
+			return
+		}
+
+		cmd.Printf("transferring leadership to %x...\n", nodeid)
+		leader, err := client.TransferLeader(context.Background(), &aergorpc.MemberAttr{ID: nodeid})
+		if err != nil {
+			cmd.Printf("Failed to transfer leadership: %s\n", err.Error())
+			return
+		}
+
+		cmd.Printf("new leader of cluster: %s\n", leader.ToString())
+	},
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Take a snapshot of the raft log of the node and compact the log",
+	Run: func(cmd *cobra.Command, args []string) {
+		snap, err := client.CreateClusterSnapshot(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			cmd.Printf("Failed to create snapshot: %s\n", err.Error())
+			return
+		}
+
+		cmd.Printf("created snapshot at index %d\n", snap.Index)
+	},
+}
+
+// waitMembership polls the cluster status until the member with nodeid joins
+// and catches up with the leader, or leaves the cluster.
+func waitMembership(cmd *cobra.Command, nodeid uint64, join bool) error {
+	deadline := time.Now().Add(time.Duration(changeTimeout) * time.Second)
+	for {
+		status, err := client.GetClusterStatus(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			return err
+		}
+
+		var member *aergorpc.ClusterMemberStatus
+		for _, m := range status.Members {
+			if m.Attr.GetID() == nodeid {
+				member = m
+				break
+			}
+		}
+
+		if join && member != nil {
+			if !status.HasProgress {
+				// only the leader knows the progress of the members
+				return nil
+			}
+			cmd.Printf("catching up: match=%d commit=%d lag=%d\n", member.Match, status.Commit, member.Lag)
+			if member.Lag == 0 {
+				return nil
+			}
+		} else if !join && member == nil {
+			return nil
+		} else {
+			cmd.Printf("waiting for the membership change of %x\n", nodeid)
+		}
+
+		if time.Now().After(deadline) {
+			return errChangeTimeout
+		}
+		time.Sleep(clusterPollInterval)
+	}
+}
+
+type printClusterMember struct {
+	ID       string
+	Name     string
+	Url      string
+	PeerID   string
+	IsLeader bool
+	Match    uint64 `json:",omitempty"`
+	Lag      uint64 `json:",omitempty"`
+	State    string `json:",omitempty"`
+	Active   bool   `json:",omitempty"`
+
+	LastContact string `json:",omitempty"`
+	Snapshot    string `json:",omitempty"`
+}
+
+type printClusterStatus struct {
+	NodeID        string
+	Leader        string
+	Term          uint64
+	Commit        uint64
+	Applied       uint64
+	SnapshotIndex uint64
+	HasProgress   bool
+	Members       []*printClusterMember
+}
+
+func convClusterStatus(status *aergorpc.ClusterStatus) string {
+	out := &printClusterStatus{
+		NodeID:        fmt.Sprintf("%x", status.NodeID),
+		Leader:        fmt.Sprintf("%x", status.Leader),
+		Term:          status.Term,
+		Commit:        status.Commit,
+		Applied:       status.Applied,
+		SnapshotIndex: status.SnapshotIndex,
+		HasProgress:   status.HasProgress,
+	}
+	for _, m := range status.Members {
+		var lastContact string
+		if m.LastContact != 0 {
+			lastContact = time.Unix(0, m.LastContact).Format(time.RFC3339Nano)
+		}
+		out.Members = append(out.Members, &printClusterMember{
+			ID:       fmt.Sprintf("%x", m.Attr.GetID()),
+			Name:     m.Attr.GetName(),
+			Url:      m.Attr.GetUrl(),
+			PeerID:   peer.ID(m.Attr.GetPeerID()).Pretty(),
+			IsLeader: m.IsLeader,
+			Match:    m.Match,
+			Lag:      m.Lag,
+			State:    m.State,
+			Active:   m.Active,
+
+			LastContact: lastContact,
+			Snapshot:    m.Snapshot,
+		})
+	}
+	jsonout, err := json.MarshalIndent(out, "", " ")
+	if err != nil {
+		return ""
+	}
+	return string(jsonout)
+}
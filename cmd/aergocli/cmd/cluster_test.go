@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func(interval time.Duration) {
+		clusterPollInterval, waitChange = interval, false
+	}(clusterPollInterval)
+	clusterPollInterval = time.Millisecond
+
+	leader := &types.MemberAttr{ID: 0x1, Name: "aergo1", Url: "http://127.0.0.1:11001"}
+	joiner := &types.MemberAttr{ID: 0x2, Name: "aergo2", Url: "http://127.0.0.1:11002"}
+	statusOf := func(lag uint64) *types.ClusterStatus {
+		return &types.ClusterStatus{
+			NodeID: 0x1, Leader: 0x1, Commit: 10, HasProgress: true,
+			Members: []*types.ClusterMemberStatus{
+				{Attr: leader, IsLeader: true, Match: 10},
+				{Attr: joiner, Match: 10 - lag, Lag: lag},
+			},
+		}
+	}
+
+	mock.EXPECT().GetClusterStatus(gomock.Any(), gomock.Any()).Return(statusOf(0), nil).Times(1)
+	output, err := executeCommand(rootCmd, "cluster", "status")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"Leader": "1"`, "leader id in hex")
+	assert.Contains(t, output, `"Name": "aergo2"`, "member")
+
+	mock.EXPECT().ChangeMembership(gomock.Any(), gomock.Any()).Return(
+		&types.MembershipChangeReply{Attr: joiner}, nil).Times(1)
+	gomock.InOrder(
+		mock.EXPECT().GetClusterStatus(gomock.Any(), gomock.Any()).Return(statusOf(4), nil).Times(1),
+		mock.EXPECT().GetClusterStatus(gomock.Any(), gomock.Any()).Return(statusOf(0), nil).Times(1),
+	)
+	output, err = executeCommand(rootCmd, "cluster", "add", "--name", "aergo2", "--url", joiner.Url,
+		"--peerid", "16Uiu2HAmPZE7gT1hF2bjpg1UVH65xyNUbBVCJ", "--wait")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "lag=4", "progress of the new member")
+	assert.Contains(t, output, "member 2 caught up with the leader", "finished")
+
+	mock.EXPECT().TransferLeader(gomock.Any(), gomock.Any()).Return(joiner, nil).Times(1)
+	output, err = executeCommand(rootCmd, "cluster", "transfer-leader", "--nodeid", "2")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "new leader of cluster: { name=aergo2", "new leader")
+
+	mock.EXPECT().CreateClusterSnapshot(gomock.Any(), gomock.Any()).Return(
+		&types.ClusterSnapshot{Index: 42}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "cluster", "snapshot")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "created snapshot at index 42", "snapshot index")
+}
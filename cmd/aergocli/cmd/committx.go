@@ -45,13 +45,14 @@ func init() {
  }
 }`)
 	committxCmd.Flags().StringVar(&jsonPath, "jsontxpath", "", "Transaction list json file path")
+	committxCmd.Flags().StringVar(&jsonPath, "file", "", "Transaction list json file path, such as the file saved by signtx --file")
 }
 
 func execCommitTX(cmd *cobra.Command, args []string) error {
 	if jsonPath != "" {
 		b, readerr := ioutil.ReadFile(jsonPath)
 		if readerr != nil {
-			return errors.New("Failed to read --jsontxpath\n" + readerr.Error())
+			return errors.New("Failed to read the transaction file\n" + readerr.Error())
 		}
 		jsonTx = string(b)
 	}
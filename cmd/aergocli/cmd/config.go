@@ -21,8 +21,22 @@ func NewCliContext(homePath string, configFilePath string) *CliContext {
 
 // CliConfig is configs for aergo cli.
 type CliConfig struct {
-	Host string `mapstructure:"host" description:"Target server host. default is localhost"`
-	Port int    `mapstructure:"port" description:"Target server port. default is 7845"`
+	Host     string                 `mapstructure:"host" description:"Target server host. default is localhost"`
+	Port     int                    `mapstructure:"port" description:"Target server port. default is 7845"`
+	Profile  string                 `mapstructure:"profile" description:"Name of the profile to connect with"`
+	Profiles map[string]*CliProfile `mapstructure:"profiles" description:"Named connection profiles"`
+}
+
+// CliProfile is a named connection to a server with the account to use by
+// default.
+type CliProfile struct {
+	Host      string `mapstructure:"host" description:"Target server host"`
+	Port      int    `mapstructure:"port" description:"Target server port"`
+	TLSCACert string `mapstructure:"tlscacert" description:"CA certificate file to verify the server"`
+	TLSCert   string `mapstructure:"tlscert" description:"Certificate file of the client"`
+	TLSKey    string `mapstructure:"tlskey" description:"Private key file of the client"`
+	Account   string `mapstructure:"account" description:"Account address used when --from or --address is not given"`
+	Token     string `mapstructure:"token" description:"Auth token sent to the server"`
 }
 
 // GetDefaultConfig return cliconfig with default value. It ALWAYS returns NEW object.
@@ -48,4 +62,15 @@ func (ctx *CliContext) GetTemplate() string {
 const configTemplate = `# aergo cli TOML Configuration File (https://github.com/toml-lang/toml)
 host = "{{.Host}}"
 port = "{{.Port}}"
+profile = "{{.Profile}}"
+
+# named connection profiles, selected with --profile or "aergocli config use <name>"
+# [profiles.testnet]
+# host = "testnet-api.aergo.io"
+# port = 7845
+# tlscacert = ""
+# tlscert = ""
+# tlskey = ""
+# account = ""
+# token = ""
 `
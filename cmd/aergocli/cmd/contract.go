@@ -8,8 +8,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/big"
 	"os"
+	"time"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	luacEncoding "github.com/aergoio/aergo/cmd/aergoluac/encoding"
@@ -19,11 +19,12 @@ import (
 )
 
 var (
-	client *util.ConnClient
-	data   string
-	nonce  uint64
-	toJson bool
-	gover  bool
+	client        *util.ConnClient
+	data          string
+	nonce         uint64
+	toJson        bool
+	gover         bool
+	feeDelegation bool
 )
 
 func init() {
@@ -33,14 +34,18 @@ func init() {
 	}
 
 	deployCmd := &cobra.Command{
-		Use:   "deploy [flags] --payload 'payload string' creator\n  aergocli contract deploy [flags] creator bcfile abifile",
-		Short: "Deploy a compiled contract to the server",
-		Args:  cobra.MinimumNArgs(1),
-		Run:   runDeployCmd,
+		Use:                   "deploy [flags] --payload 'payload string' creator\n  aergocli contract deploy [flags] --src srcpath creator\n  aergocli contract deploy [flags] creator bcfile abifile",
+		Short:                 "Deploy a compiled contract to the server",
+		Args:                  cobra.MinimumNArgs(1),
+		Run:                   runDeployCmd,
 		DisableFlagsInUseLine: true,
 	}
 	deployCmd.PersistentFlags().StringVar(&data, "payload", "", "result of compiling a contract")
-	deployCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount")
+	deployCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount (e.g. 1.5aergo, 5000gaer, 100aer)")
+	deployCmd.PersistentFlags().StringVar(&srcPath, "src", "", "lua source file or directory to compile with aergoluac")
+	deployCmd.PersistentFlags().StringVar(&luacPath, "luac", "aergoluac", "path of aergoluac")
+	deployCmd.PersistentFlags().BoolVar(&waitReceipt, "wait", false, "wait for the receipt of the deployment")
+	deployCmd.PersistentFlags().Uint64Var(&receiptTimeout, "timeout", 30, "seconds to wait for the receipt")
 
 	callCmd := &cobra.Command{
 		Use:   "call [flags] sender contract funcname '[argument...]'",
@@ -49,10 +54,11 @@ func init() {
 		Run:   runCallCmd,
 	}
 	callCmd.PersistentFlags().Uint64Var(&nonce, "nonce", 0, "setting nonce manually")
-	callCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount")
+	callCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount (e.g. 1.5aergo, 5000gaer, 100aer)")
 	callCmd.PersistentFlags().StringVar(&chainIdHash, "chainidhash", "", "chain id hash value encoded by base58")
 	callCmd.PersistentFlags().BoolVar(&toJson, "tojson", false, "get jsontx")
 	callCmd.PersistentFlags().BoolVar(&gover, "governance", false, "setting type")
+	callCmd.PersistentFlags().BoolVar(&feeDelegation, "feedelegation", false, "let the contract pay the fee")
 
 	stateQueryCmd := &cobra.Command{
 		Use:   "statequery [flags] contract varname varindex",
@@ -79,13 +85,19 @@ func init() {
 			Run:   runQueryCmd,
 		},
 		stateQueryCmd,
+		&cobra.Command{
+			Use:   "storageusage [flags] contract",
+			Short: "Get the storage bytes used by the contract and the storage quota",
+			Args:  cobra.ExactArgs(1),
+			Run:   runStorageUsageCmd,
+		},
 	)
 	rootCmd.AddCommand(contractCmd)
 }
 
 func runDeployCmd(cmd *cobra.Command, args []string) {
 	var err error
-	creator, err := types.DecodeAddress(args[0])
+	creator, err := decodeAddress(args[0])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -93,6 +105,12 @@ func runDeployCmd(cmd *cobra.Command, args []string) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	if len(srcPath) != 0 {
+		data, err = buildContract(srcPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 	var payload []byte
 	if len(data) == 0 {
 		if len(args) < 3 {
@@ -150,8 +168,8 @@ func runDeployCmd(cmd *cobra.Command, args []string) {
 			os.Exit(1)
 		}
 	}
-	amountBigInt, ok := new(big.Int).SetString(amount, 10)
-	if !ok {
+	amountBigInt, err := util.ParseUnit(amount)
+	if err != nil {
 		_, _ = fmt.Fprint(os.Stderr, "failed to parse --amount flags")
 		os.Exit(1)
 	}
@@ -169,10 +187,22 @@ func runDeployCmd(cmd *cobra.Command, args []string) {
 		log.Fatal(err)
 	}
 	cmd.Println(util.JSON(msg))
+	if msg.Error != types.CommitStatus_TX_OK {
+		return
+	}
+	cmd.Printf("expected contract address: %s\n", types.EncodeAddress(types.CreateContractID(creator, tx.Body.Nonce)))
+
+	if waitReceipt {
+		receipt, err := waitForReceipt(msg.Hash, time.Duration(receiptTimeout)*time.Second)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cmd.Println(util.JSON(receipt))
+	}
 }
 
 func runCallCmd(cmd *cobra.Command, args []string) {
-	caller, err := types.DecodeAddress(args[0])
+	caller, err := decodeAddress(args[0])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -183,49 +213,54 @@ func runCallCmd(cmd *cobra.Command, args []string) {
 		}
 		nonce = state.GetNonce() + 1
 	}
-	contract, err := types.DecodeAddress(args[1])
+	contract, err := decodeAddress(args[1])
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	var ci types.CallInfo
-	ci.Name = args[2]
-	if len(args) > 3 {
-		err = json.Unmarshal([]byte(args[3]), &ci.Args)
-		if err != nil {
-			log.Fatal(err)
-		}
-	}
-	payload, err := json.Marshal(ci)
+	amountBigInt, err := util.ParseUnit(amount)
 	if err != nil {
-		log.Fatal(err)
+		_, _ = fmt.Fprint(os.Stderr, "failed to parse --amount flags")
+		os.Exit(1)
+	}
+	var jsonArgs string
+	if len(args) > 3 {
+		jsonArgs = args[3]
 	}
 
-	if !toJson && !gover {
-		abi, err := client.GetABI(context.Background(), &types.SingleBytes{Value: contract})
+	var payload []byte
+	if toJson {
+		var ci types.CallInfo
+		ci.Name = args[2]
+		if jsonArgs != "" {
+			err = json.Unmarshal([]byte(jsonArgs), &ci.Args)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		payload, err = json.Marshal(ci)
 		if err != nil {
 			log.Fatal(err)
 		}
-		var found bool
-		for _, fn := range abi.Functions {
-			if fn.GetName() == args[2] {
-				found = true
-				break
-			}
-		}
-		if !found {
-			log.Fatal(args[2], " function not found in contract :", args[1])
+	} else {
+		// the node checks the call against the ABI of the contract
+		encoded, err := client.EncodeCall(context.Background(), &types.CallEncodeParams{
+			ContractAddress: contract,
+			Name:            args[2],
+			JsonArgs:        jsonArgs,
+			Amount:          amountBigInt.Bytes(),
+		})
+		if err != nil {
+			log.Fatal(err)
 		}
+		payload = encoded.Value
 	}
 
-	amountBigInt, ok := new(big.Int).SetString(amount, 10)
-	if !ok {
-		_, _ = fmt.Fprint(os.Stderr, "failed to parse --amount flags")
-		os.Exit(1)
-	}
 	txType := types.TxType_NORMAL
 	if gover {
 		txType = types.TxType_GOVERNANCE
+	} else if feeDelegation {
+		txType = types.TxType_FEEDELEGATION
 	}
 
 	tx := &types.Tx{
@@ -272,7 +307,7 @@ func runCallCmd(cmd *cobra.Command, args []string) {
 }
 
 func runGetABICmd(cmd *cobra.Command, args []string) {
-	contract, err := types.DecodeAddress(args[0])
+	contract, err := decodeAddress(args[0])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -283,8 +318,22 @@ func runGetABICmd(cmd *cobra.Command, args []string) {
 	cmd.Println(util.JSON(abi))
 }
 
+func runStorageUsageCmd(cmd *cobra.Command, args []string) {
+	contract, err := decodeAddress(args[0])
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	usage, err := client.GetContractStorageUsage(context.Background(), &types.SingleBytes{Value: contract})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.JSON(usage))
+}
+
 func runQueryCmd(cmd *cobra.Command, args []string) {
-	contract, err := types.DecodeAddress(args[0])
+	contract, err := decodeAddress(args[0])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -317,7 +366,7 @@ func runQueryCmd(cmd *cobra.Command, args []string) {
 func runQueryStateCmd(cmd *cobra.Command, args []string) {
 	var root []byte
 	var err error
-	contract, err := types.DecodeAddress(args[0])
+	contract, err := decodeAddress(args[0])
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractCallWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() { nonce, amount = 0, "0" }()
+
+	const (
+		testSender   = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+		testContract = "AmNfacq5A3orqn3MhgkHSncufXEP8gVJgqDy8jTgBphXQeuuaHHF"
+	)
+	contract, _ := types.DecodeAddress(testContract)
+	payload := []byte(`{"Name":"set","Args":["a",1]}`)
+
+	mock.EXPECT().EncodeCall(gomock.Any(), &types.CallEncodeParams{
+		ContractAddress: contract,
+		Name:            "set",
+		JsonArgs:        `["a", 1]`,
+		Amount:          []byte{0x64},
+	}).Return(&types.SingleBytes{Value: payload}, nil)
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, tx *types.Tx, _ ...interface{}) (*types.CommitResult, error) {
+			assert.Equal(t, payload, tx.Body.Payload, "the payload encoded by the node")
+			assert.Equal(t, uint64(3), tx.Body.Nonce)
+			return &types.CommitResult{Hash: []byte{1}}, nil
+		})
+	_, err := executeCommand(rootCmd, "contract", "call", "--nonce", "3", "--amount", "100", testSender, testContract, "set", `["a", 1]`)
+	assert.NoError(t, err)
+
+}
+
+func TestContractStorageUsageWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	const testContract = "AmNfacq5A3orqn3MhgkHSncufXEP8gVJgqDy8jTgBphXQeuuaHHF"
+	contract, _ := types.DecodeAddress(testContract)
+
+	gomock.InOrder(
+		mock.EXPECT().GetContractStorageUsage(gomock.Any(), &types.SingleBytes{Value: contract}).Return(
+			&types.ContractStorageUsage{Usage: 1234, Quota: 4096}, nil),
+		mock.EXPECT().GetContractStorageUsage(gomock.Any(), gomock.Any()).Return(nil, errors.New("cannot find the name")),
+	)
+	output, err := executeCommand(rootCmd, "contract", "storageusage", testContract)
+	assert.NoError(t, err)
+	assert.Contains(t, output, `"usage": 1234`)
+	assert.Contains(t, output, `"quota": 4096`)
+
+	output, err = executeCommand(rootCmd, "contract", "storageusage", "unknownname")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "Failed: cannot find the name")
+}
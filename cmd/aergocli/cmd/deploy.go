@@ -0,0 +1,126 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// mainSource is the file of a source directory that is placed after all the
+// other files, which it may use.
+const mainSource = "main.lua"
+
+var (
+	srcPath        string
+	luacPath       string
+	waitReceipt    bool
+	receiptTimeout uint64
+
+	// receiptPollInterval is the interval of polling the receipt while
+	// waiting for a deployment
+	receiptPollInterval = time.Second
+)
+
+// buildContract compiles the lua source with aergoluac and returns the
+// payload. The files of a source directory are merged into one source in the
+// name order, with main.lua at the end.
+func buildContract(src string) (string, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		merged, err := mergeSources(src)
+		if err != nil {
+			return "", err
+		}
+		defer os.Remove(merged)
+		src = merged
+	}
+
+	var stdout, stderr bytes.Buffer
+	luac := exec.Command(luacPath, "--payload", src)
+	luac.Stdout = &stdout
+	luac.Stderr = &stderr
+	if err = luac.Run(); err != nil {
+		return "", fmt.Errorf("failed to run %s: %s %s", luacPath, err.Error(), stderr.String())
+	}
+	// aergoluac reports a compile error on the standard error only
+	if stderr.Len() != 0 {
+		return "", errors.New(strings.TrimSpace(stderr.String()))
+	}
+	payload := strings.TrimSpace(stdout.String())
+	if len(payload) == 0 {
+		return "", fmt.Errorf("no payload from %s", luacPath)
+	}
+	return payload, nil
+}
+
+func mergeSources(dir string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.lua"))
+	if err != nil {
+		return "", err
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("no lua source in %s", dir)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		iMain, jMain := filepath.Base(files[i]) == mainSource, filepath.Base(files[j]) == mainSource
+		if iMain != jMain {
+			return jMain
+		}
+		return files[i] < files[j]
+	})
+
+	var merged bytes.Buffer
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		merged.Write(src)
+		merged.WriteByte('\n')
+	}
+
+	f, err := ioutil.TempFile("", "aergocli-*.lua")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err = f.Write(merged.Bytes()); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// waitForReceipt polls the receipt of the tx until it is found or the
+// timeout expires.
+func waitForReceipt(txHash []byte, timeout time.Duration) (*types.Receipt, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		receipt, err := client.GetReceipt(context.Background(), &types.SingleBytes{Value: txHash})
+		if err == nil {
+			return receipt, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for the receipt: %s", err.Error())
+		}
+		time.Sleep(receiptPollInterval)
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	luacEncoding "github.com/aergoio/aergo/cmd/aergoluac/encoding"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploySourceWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func(interval time.Duration) {
+		srcPath, luacPath, data, waitReceipt, receiptPollInterval = "", "aergoluac", "", false, interval
+	}(receiptPollInterval)
+	receiptPollInterval = time.Millisecond
+
+	const testAddr = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	dir, err := ioutil.TempDir("", "deploy")
+	assert.NoError(t, err, "could not create temp dir")
+	defer os.RemoveAll(dir)
+	srcDir := filepath.Join(dir, "src")
+	assert.NoError(t, os.Mkdir(srcDir, 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(srcDir, "main.lua"), []byte("abi.register(hello)"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(srcDir, "util.lua"), []byte("function hello() end"), 0600))
+
+	// a fake aergoluac keeps the merged source and prints a payload
+	built := filepath.Join(dir, "built.lua")
+	luac := filepath.Join(dir, "aergoluac")
+	script := "#!/bin/sh\ncp \"$2\" " + built + "\necho " + luacEncoding.EncodeCode([]byte("bytecode")) + "\n"
+	assert.NoError(t, ioutil.WriteFile(luac, []byte(script), 0700))
+
+	creator, _ := types.DecodeAddress(testAddr)
+	txHash := []byte("hash-of-the-deployment")
+	mock.EXPECT().GetState(gomock.Any(), gomock.Any()).Return(&types.State{Nonce: 6}, nil).Times(1)
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).Return(&types.CommitResult{Hash: txHash}, nil).Times(1)
+	gomock.InOrder(
+		mock.EXPECT().GetReceipt(gomock.Any(), gomock.Any()).Return(nil, errors.New("tx not found")).Times(1),
+		mock.EXPECT().GetReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{Status: "CREATED"}, nil).Times(1),
+	)
+	output, err := executeCommand(rootCmd, "contract", "deploy", "--src", srcDir, "--luac", luac, "--wait", testAddr)
+	assert.NoError(t, err, "should be success")
+
+	merged, err := ioutil.ReadFile(built)
+	assert.NoError(t, err, "aergoluac should run")
+	assert.Equal(t, "function hello() end\nabi.register(hello)\n", string(merged), "main.lua goes last")
+	expected := types.EncodeAddress(types.CreateContractID(creator, 7))
+	assert.Contains(t, output, "expected contract address: "+expected, "contract address")
+	assert.Contains(t, output, "CREATED", "receipt")
+}
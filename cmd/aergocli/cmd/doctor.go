@@ -0,0 +1,233 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the health of the node and show what to fix",
+	Long: "Check the consensus, the peers, the sync, the mempool, the disk space and the clock of the node " +
+		"and report each of them as OK, WARN or FAIL.",
+	Args: cobra.NoArgs,
+	RunE: execNodeDoctor,
+}
+
+var (
+	doctorMinPeers   int
+	doctorMaxLag     uint64
+	doctorMaxMempool int
+	doctorMinDisk    float64
+	doctorMaxSkew    time.Duration
+	doctorNoColor    bool
+)
+
+func init() {
+	nodeCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().IntVar(&doctorMinPeers, "minpeers", 3, "Number of peers below which a warning is shown")
+	doctorCmd.Flags().Uint64Var(&doctorMaxLag, "maxlag", 10, "Number of blocks behind the peers above which a warning is shown")
+	doctorCmd.Flags().IntVar(&doctorMaxMempool, "maxmempool", 10000, "Number of txs in the mempool above which a warning is shown")
+	doctorCmd.Flags().Float64Var(&doctorMinDisk, "mindisk", 10, "Percentage of free disk space below which a warning is shown")
+	doctorCmd.Flags().DurationVar(&doctorMaxSkew, "maxskew", time.Second, "Clock difference from the node above which a warning is shown")
+	doctorCmd.Flags().BoolVar(&doctorNoColor, "nocolor", false, "Print the report without colors")
+}
+
+type checkLevel int
+
+const (
+	checkOK checkLevel = iota
+	checkWarn
+	checkFail
+)
+
+var checkLabels = []string{"OK", "WARN", "FAIL"}
+
+// ANSI colors of the levels: green, yellow and red
+var checkColors = []string{"\x1b[32m", "\x1b[33m", "\x1b[31m"}
+
+// checkResult is the result of a check with the action to take when it is
+// not OK.
+type checkResult struct {
+	name   string
+	level  checkLevel
+	detail string
+	action string
+}
+
+func execNodeDoctor(cmd *cobra.Command, args []string) error {
+	status, err := client.Blockchain(context.Background(), &types.Empty{})
+	if err != nil {
+		return fmt.Errorf("node is not reachable: %s", err.Error())
+	}
+
+	results := checkConsensus()
+	results = append(results, checkPeers(status.GetBestHeight())...)
+	results = append(results, checkMempool())
+	results = append(results, checkServer()...)
+
+	failed := 0
+	for _, r := range results {
+		label := checkLabels[r.level]
+		if !doctorNoColor {
+			label = checkColors[r.level] + label + "\x1b[0m"
+		}
+		cmd.Printf("[%s] %s: %s\n", label, r.name, r.detail)
+		if r.level != checkOK && r.action != "" {
+			cmd.Printf("       -> %s\n", r.action)
+		}
+		if r.level == checkFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(results))
+	}
+	return nil
+}
+
+func queryFailed(name string, err error) *checkResult {
+	return &checkResult{name: name, level: checkWarn, detail: "cannot query: " + err.Error()}
+}
+
+func checkConsensus() []*checkResult {
+	info, err := client.GetConsensusInfo(context.Background(), &types.Empty{})
+	if err != nil {
+		return []*checkResult{queryFailed("consensus", err)}
+	}
+	results := []*checkResult{{name: "consensus", detail: info.GetType()}}
+	if info.GetType() != "raft" {
+		return results
+	}
+	cluster, err := client.GetClusterStatus(context.Background(), &types.Empty{})
+	if err != nil {
+		return append(results, queryFailed("raft", err))
+	}
+	if cluster.GetLeader() == 0 {
+		return append(results, &checkResult{name: "raft", level: checkFail, detail: "no leader",
+			action: "check that a quorum of the members is running and reachable"})
+	}
+	r := &checkResult{name: "raft", detail: fmt.Sprintf("leader %x, term %d, %d members",
+		cluster.GetLeader(), cluster.GetTerm(), len(cluster.GetMembers()))}
+	if lag := cluster.GetCommit() - cluster.GetApplied(); cluster.GetCommit() > cluster.GetApplied() && lag > doctorMaxLag {
+		r.level = checkWarn
+		r.detail += fmt.Sprintf(", %d committed entries not applied", lag)
+		r.action = "the node is slow to apply the raft log; check the disk and the cpu"
+	}
+	return append(results, r)
+}
+
+func checkPeers(bestHeight uint64) []*checkResult {
+	peers, err := client.GetPeers(context.Background(), &types.PeersParams{})
+	if err != nil {
+		return []*checkResult{queryFailed("peers", err)}
+	}
+	count := 0
+	peerHeight := uint64(0)
+	for _, p := range peers.GetPeers() {
+		if p.GetSelfpeer() {
+			continue
+		}
+		count++
+		if no := p.GetBestblock().GetBlockNo(); no > peerHeight {
+			peerHeight = no
+		}
+	}
+	peerResult := &checkResult{name: "peers", detail: fmt.Sprintf("%d connected", count)}
+	switch {
+	case count == 0:
+		peerResult.level = checkFail
+		peerResult.action = "check the p2p port, the firewall and the npaddpeers of the config"
+	case count < doctorMinPeers:
+		peerResult.level = checkWarn
+		peerResult.action = "add more peers to npaddpeers of the config"
+	}
+
+	syncResult := &checkResult{name: "sync", detail: fmt.Sprintf("best block %d", bestHeight)}
+	if peerHeight > bestHeight {
+		lag := peerHeight - bestHeight
+		syncResult.detail += fmt.Sprintf(", %d blocks behind the peers", lag)
+		if lag > doctorMaxLag {
+			syncResult.level = checkWarn
+			syncResult.action = "the node is syncing; if the lag does not shrink, check the peers and the logs"
+		}
+	}
+	return []*checkResult{peerResult, syncResult}
+}
+
+func checkMempool() *checkResult {
+	timeout := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timeout, 3)
+	msg, err := client.NodeState(context.Background(), &types.NodeReq{Timeout: timeout, Component: []byte("MemPoolSvc")})
+	if err != nil {
+		return queryFailed("mempool", err)
+	}
+	var state map[string]struct {
+		Actor struct {
+			Total  int `json:"total"`
+			Orphan int `json:"orphan"`
+		} `json:"actor"`
+	}
+	if err := json.Unmarshal(msg.GetValue(), &state); err != nil {
+		return queryFailed("mempool", err)
+	}
+	mempool := state["MemPoolSvc"].Actor
+	r := &checkResult{name: "mempool", detail: fmt.Sprintf("%d txs, %d orphans", mempool.Total, mempool.Orphan)}
+	if mempool.Total > doctorMaxMempool {
+		r.level = checkWarn
+		r.action = "txs are not taken into blocks fast enough; check the block production"
+	}
+	return r
+}
+
+func checkServer() []*checkResult {
+	sent := time.Now()
+	info, err := client.GetServerInfo(context.Background(), &types.KeyParams{})
+	received := time.Now()
+	if err != nil {
+		return []*checkResult{queryFailed("server", err)}
+	}
+	var results []*checkResult
+
+	total, totalErr := strconv.ParseUint(info.GetStatus()["disktotal"], 10, 64)
+	free, freeErr := strconv.ParseUint(info.GetStatus()["diskfree"], 10, 64)
+	if totalErr != nil || freeErr != nil || total == 0 {
+		results = append(results, &checkResult{name: "disk", level: checkWarn, detail: "not reported by the node"})
+	} else {
+		percent := float64(free) * 100 / float64(total)
+		r := &checkResult{name: "disk", detail: fmt.Sprintf("%.1f%% free (%d of %d MiB)", percent, free>>20, total>>20)}
+		if percent < doctorMinDisk {
+			r.level = checkWarn
+			r.action = "free disk space of the data directory"
+		}
+		if free == 0 {
+			r.level = checkFail
+		}
+		results = append(results, r)
+	}
+
+	nodeTime, err := time.Parse(time.RFC3339Nano, info.GetStatus()["time"])
+	if err != nil {
+		return append(results, &checkResult{name: "clock", level: checkWarn, detail: "not reported by the node"})
+	}
+	// the node read its clock about the middle of the round trip
+	skew := nodeTime.Sub(sent.Add(received.Sub(sent) / 2))
+	r := &checkResult{name: "clock", detail: fmt.Sprintf("%v from the local clock", skew.Round(time.Millisecond))}
+	if skew > doctorMaxSkew || skew < -doctorMaxSkew {
+		r.level = checkWarn
+		r.action = "synchronize the clocks of the node and this host with NTP"
+	}
+	return append(results, r)
+}
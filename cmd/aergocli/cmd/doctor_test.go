@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeDoctorWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() { doctorNoColor = false }()
+
+	mempool := &types.SingleBytes{Value: []byte(`{"MemPoolSvc": {"status": "started", "actor": {"total": 3, "orphan": 1}}}`)}
+	mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{BestHeight: 100}, nil).Times(2)
+	mock.EXPECT().NodeState(gomock.Any(), gomock.Any()).Return(mempool, nil).Times(2)
+
+	// a healthy dpos node
+	mock.EXPECT().GetConsensusInfo(gomock.Any(), gomock.Any()).Return(&types.ConsensusInfo{Type: "dpos"}, nil).Times(1)
+	mock.EXPECT().GetPeers(gomock.Any(), gomock.Any()).Return(&types.PeerList{Peers: []*types.Peer{
+		{Selfpeer: true},
+		{Bestblock: &types.NewBlockNotice{BlockNo: 101}},
+		{Bestblock: &types.NewBlockNotice{BlockNo: 100}},
+		{Bestblock: &types.NewBlockNotice{BlockNo: 99}},
+	}}, nil).Times(1)
+	mock.EXPECT().GetServerInfo(gomock.Any(), gomock.Any()).Return(&types.ServerInfo{Status: map[string]string{
+		"time":      time.Now().UTC().Format(time.RFC3339Nano),
+		"disktotal": "1000000",
+		"diskfree":  "500000",
+	}}, nil).Times(1)
+	output, err := executeCommand(rootCmd, "node", "doctor", "--nocolor")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "[OK] peers: 3 connected")
+	assert.Contains(t, output, "[OK] sync: best block 100, 1 blocks behind the peers")
+	assert.Contains(t, output, "[OK] mempool: 3 txs, 1 orphans")
+	assert.Contains(t, output, "[OK] disk: 50.0% free")
+	assert.NotContains(t, output, "WARN")
+
+	// a raft node without a leader, peers and a synchronized clock
+	mock.EXPECT().GetConsensusInfo(gomock.Any(), gomock.Any()).Return(&types.ConsensusInfo{Type: "raft"}, nil).Times(1)
+	mock.EXPECT().GetClusterStatus(gomock.Any(), gomock.Any()).Return(&types.ClusterStatus{Term: 3}, nil).Times(1)
+	mock.EXPECT().GetPeers(gomock.Any(), gomock.Any()).Return(&types.PeerList{}, nil).Times(1)
+	mock.EXPECT().GetServerInfo(gomock.Any(), gomock.Any()).Return(&types.ServerInfo{Status: map[string]string{
+		"time": time.Now().Add(time.Minute).UTC().Format(time.RFC3339Nano),
+	}}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "node", "doctor", "--nocolor")
+	assert.EqualError(t, err, "2 of 7 checks failed")
+	assert.Contains(t, output, "[FAIL] raft: no leader")
+	assert.Contains(t, output, "[FAIL] peers: 0 connected")
+	assert.Contains(t, output, "[WARN] disk: not reported by the node")
+	assert.Contains(t, output, "[WARN] clock: 1m0")
+	assert.Contains(t, output, "-> synchronize the clocks")
+}
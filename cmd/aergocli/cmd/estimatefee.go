@@ -0,0 +1,110 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var estimatefeeCmd = &cobra.Command{
+	Use:   "estimatefee",
+	Short: "Estimate the fee of a transaction",
+	Long: "Estimate the fee of a transaction under the active fee parameters. " +
+		"A transaction without a recipient is estimated as a deployment.",
+	Args: cobra.MinimumNArgs(0),
+	RunE: execEstimateFee,
+}
+
+var basefeeCmd = &cobra.Command{
+	Use:   "basefee",
+	Short: "Print the fee per byte charged to the transactions of the next block",
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.GetBaseFee(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(convBaseFeeMsg(msg))
+	},
+}
+
+var (
+	gasLimit uint64
+	gasPrice string
+)
+
+func init() {
+	rootCmd.AddCommand(estimatefeeCmd)
+	rootCmd.AddCommand(basefeeCmd)
+	estimatefeeCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	estimatefeeCmd.Flags().StringVar(&to, "to", "", "Recipient account address")
+	estimatefeeCmd.Flags().StringVar(&data, "payload", "", "Payload of the transaction")
+	estimatefeeCmd.Flags().BoolVar(&gover, "governance", false, "Estimate a governance transaction")
+	estimatefeeCmd.Flags().Uint64Var(&gasLimit, "gaslimit", 0, "Gas limit of the transaction")
+	estimatefeeCmd.Flags().StringVar(&gasPrice, "gasprice", "0", "Gas price in AER")
+}
+
+func execEstimateFee(cmd *cobra.Command, args []string) error {
+	body := &types.TxBody{
+		Payload:  []byte(data),
+		GasLimit: gasLimit,
+	}
+	if from != "" {
+		account, err := decodeAddress(from)
+		if err != nil {
+			return errors.New("Wrong address in --from flag\n" + err.Error())
+		}
+		body.Account = account
+	}
+	if to != "" {
+		recipient, err := decodeAddress(to)
+		if err != nil {
+			return errors.New("Wrong address in --to flag\n" + err.Error())
+		}
+		body.Recipient = recipient
+	}
+	price, err := util.ParseUnit(gasPrice)
+	if err != nil {
+		return errors.New("Wrong value in --gasprice flag\n" + err.Error())
+	}
+	body.GasPrice = price.Bytes()
+	if gover {
+		body.Type = types.TxType_GOVERNANCE
+	}
+	msg, err := client.EstimateFee(context.Background(), body)
+	if err != nil {
+		cmd.Println(err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
+type printBaseFee struct {
+	AerPerByte string
+	Dynamic    bool
+	BlockNo    uint64
+}
+
+func convBaseFeeMsg(msg *types.BaseFee) string {
+	out := &printBaseFee{
+		AerPerByte: new(big.Int).SetBytes(msg.AerPerByte).String(),
+		Dynamic:    msg.Dynamic,
+		BlockNo:    msg.BlockNo,
+	}
+	jsonout, err := json.MarshalIndent(out, "", " ")
+	if err != nil {
+		return ""
+	}
+	return string(jsonout)
+}
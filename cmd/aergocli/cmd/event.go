@@ -7,10 +7,16 @@ package cmd
 
 import (
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"log"
+	"os"
+	"strconv"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +27,16 @@ var start uint64
 var end uint64
 var desc bool
 var recentBlockCnt int32
+var eventPageSize uint32
+var eventCursor string
+var allEvents bool
+var eventOutput string
+var indexedEvents bool
+
+const (
+	eventOutputJSON = "json"
+	eventOutputCSV  = "csv"
+)
 
 func init() {
 	eventCmd := &cobra.Command{
@@ -41,7 +57,15 @@ func init() {
 	listCmd.Flags().BoolVar(&desc, "desc", false, "descending order")
 	listCmd.Flags().StringVarP(&argFilter, "argfilter", "", "", "argument filter")
 	listCmd.Flags().Int32Var(&recentBlockCnt, "recent", 0, "recent block count")
-	listCmd.MarkFlagRequired("address")
+	listCmd.Flags().StringVar(&contractAddress, "contract", "", "Contract Address (same as --address)")
+	listCmd.Flags().StringVar(&eventName, "name", "", "Event Name (same as --event)")
+	listCmd.Flags().Uint64Var(&start, "from", 0, "start block number (same as --start)")
+	listCmd.Flags().Uint64Var(&end, "to", 0, "end block number (same as --end)")
+	listCmd.Flags().Uint32Var(&eventPageSize, "size", 0, "number of events in a page (default: 100)")
+	listCmd.Flags().StringVar(&eventCursor, "cursor", "", "cursor of the page returned with the previous page")
+	listCmd.Flags().BoolVar(&allEvents, "all", false, "fetch all the pages")
+	listCmd.Flags().StringVar(&eventOutput, "output", eventOutputJSON, "output format: json or csv")
+	listCmd.Flags().BoolVar(&indexedEvents, "indexed", false, "look up the events by the indexed arguments of --argfilter")
 
 	streamCmd := &cobra.Command{
 		Use:   "stream [flags]",
@@ -62,10 +86,19 @@ func init() {
 }
 
 func execListEvent(cmd *cobra.Command, args []string) {
-	ba, err := aergorpc.DecodeAddress(contractAddress)
+	if len(contractAddress) == 0 {
+		cmd.Printf("Failed: --contract or --address is required\n")
+		return
+	}
+	ba, err := decodeAddress(contractAddress)
 	if err != nil {
 		log.Fatal(err)
 	}
+	w, err := newEventWriter(cmd, eventOutput)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
 	filter := &aergorpc.FilterInfo{
 		Blockfrom:       start,
 		Blockto:         end,
@@ -76,18 +109,91 @@ func execListEvent(cmd *cobra.Command, args []string) {
 		RecentBlockCnt:  recentBlockCnt,
 	}
 
-	events, err := client.ListEvents(context.Background(), filter)
-	if err != nil {
-		cmd.Printf("Failed: %s\n", err.Error())
+	paged := cmd.Flags().Changed("size") || cmd.Flags().Changed("cursor") || allEvents || indexedEvents
+	if !paged {
+		events, err := client.ListEvents(context.Background(), filter)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		w.write(events.GetEvents())
 		return
 	}
-	for _, ev := range events.GetEvents() {
-		cmd.Println(util.JSON(ev))
+
+	params := &aergorpc.EventListParams{Filter: filter, Size: eventPageSize}
+	if len(eventCursor) != 0 {
+		if params.Cursor, err = base58.Decode(eventCursor); err != nil {
+			cmd.Printf("Failed: invalid cursor: %s\n", err.Error())
+			return
+		}
+	}
+	listPage := client.ListEventPage
+	if indexedEvents {
+		listPage = client.ListIndexedEvents
+	}
+	for {
+		page, err := listPage(context.Background(), params)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		w.write(page.GetEvents())
+		if len(page.GetNextCursor()) == 0 {
+			return
+		}
+		if !allEvents {
+			// the cursor goes to the standard error to keep the events parsable
+			fmt.Fprintf(os.Stderr, "next cursor: %s\n", base58.Encode(page.GetNextCursor()))
+			return
+		}
+		params.Cursor = page.GetNextCursor()
+	}
+}
+
+// eventWriter prints events in an output format.
+type eventWriter struct {
+	cmd *cobra.Command
+	csv *csv.Writer
+}
+
+func newEventWriter(cmd *cobra.Command, format string) (*eventWriter, error) {
+	switch format {
+	case eventOutputJSON:
+		return &eventWriter{cmd: cmd}, nil
+	case eventOutputCSV:
+		w := &eventWriter{cmd: cmd, csv: csv.NewWriter(cmd.OutOrStdout())}
+		w.csv.Write([]string{"blockNo", "blockHash", "txIndex", "txHash", "eventIdx", "contract", "eventName", "jsonArgs"})
+		w.csv.Flush()
+		return w, nil
+	default:
+		return nil, errors.New("unsupported output format: " + format)
+	}
+}
+
+func (w *eventWriter) write(events []*aergorpc.Event) {
+	if w.csv == nil {
+		for _, ev := range events {
+			w.cmd.Println(util.JSON(ev))
+		}
+		return
+	}
+	for _, ev := range events {
+		w.csv.Write([]string{
+			strconv.FormatUint(ev.BlockNo, 10),
+			base58.Encode(ev.BlockHash),
+			strconv.FormatInt(int64(ev.TxIndex), 10),
+			base58.Encode(ev.TxHash),
+			strconv.FormatInt(int64(ev.EventIdx), 10),
+			aergorpc.EncodeAddress(ev.ContractAddress),
+			ev.EventName,
+			ev.JsonArgs,
+		})
 	}
+	w.csv.Flush()
 }
 
 func execStreamEvent(cmd *cobra.Command, args []string) {
-	ba, err := aergorpc.DecodeAddress(contractAddress)
+	ba, err := decodeAddress(contractAddress)
 	if err != nil {
 		log.Fatal(err)
 	}
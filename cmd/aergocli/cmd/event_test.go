@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestEventListWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		contractAddress, eventName, start, end = "", "", 0, 0
+		eventPageSize, eventCursor, allEvents, eventOutput, receiptEvents = 0, "", false, eventOutputJSON, false
+	}()
+
+	const testAddr = "AmgKtCaGjH4XkXwny2Jb1YH5gdsJGJh78ibWEgLmRWBS5LMfQuTf"
+	contract, _ := types.DecodeAddress(testAddr)
+	cursor := []byte("blocknoindex")
+	gomock.InOrder(
+		mock.EXPECT().ListEventPage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *types.EventListParams, opts ...grpc.CallOption) (*types.EventPage, error) {
+				assert.Equal(t, uint64(10), in.Filter.Blockfrom, "--from")
+				assert.Equal(t, uint64(20), in.Filter.Blockto, "--to")
+				assert.Equal(t, "transfer", in.Filter.EventName, "--name")
+				assert.Equal(t, uint32(1), in.Size, "--size")
+				assert.Empty(t, in.Cursor, "first page")
+				return &types.EventPage{
+					Events:     []*types.Event{{ContractAddress: contract, EventName: "transfer", JsonArgs: `["a",1]`, BlockNo: 11}},
+					NextCursor: cursor,
+				}, nil
+			}).Times(1),
+		mock.EXPECT().ListEventPage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *types.EventListParams, opts ...grpc.CallOption) (*types.EventPage, error) {
+				assert.Equal(t, cursor, in.Cursor, "next page")
+				return &types.EventPage{
+					Events: []*types.Event{{ContractAddress: contract, EventName: "transfer", JsonArgs: `["b",2]`, BlockNo: 15}},
+				}, nil
+			}).Times(1),
+	)
+	output, err := executeCommand(rootCmd, "event", "list", "--contract", testAddr, "--name", "transfer",
+		"--from", "10", "--to", "20", "--size", "1", "--all", "--output", "csv")
+	assert.NoError(t, err, "should be success")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	assert.Len(t, lines, 3, "header and two events")
+	assert.Equal(t, "blockNo,blockHash,txIndex,txHash,eventIdx,contract,eventName,jsonArgs", lines[0], "header")
+	assert.Equal(t, "15,,0,,0,"+testAddr+",transfer,\"[\"\"b\"\",2]\"", lines[2], "second page")
+
+	mock.EXPECT().GetReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{
+		Events: []*types.Event{{ContractAddress: contract, EventName: "mint", BlockNo: 3}},
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "receipt", "get", "--events", "--output", "csv", "bad-hash!", "8dPnFhBbyhDZJ4wW5P3uDq1zaWPTLH6fb1yfUUNKBe5z")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: invalid tx hash bad-hash!", "keeps going after an invalid hash")
+	assert.Contains(t, output, "3,,0,,0,"+testAddr+",mint,", "events of the receipt")
+}
+
+func TestEventListIndexedWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		contractAddress, eventName, argFilter, indexedEvents = "", "", "", false
+	}()
+
+	const testAddr = "AmgKtCaGjH4XkXwny2Jb1YH5gdsJGJh78ibWEgLmRWBS5LMfQuTf"
+	contract, _ := types.DecodeAddress(testAddr)
+	mock.EXPECT().ListIndexedEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.EventListParams, opts ...grpc.CallOption) (*types.EventPage, error) {
+			assert.Equal(t, "transfer", in.Filter.EventName, "--name")
+			assert.Equal(t, `{"1":{"gte":10}}`, string(in.Filter.ArgFilter), "--argfilter")
+			return &types.EventPage{
+				Events: []*types.Event{{ContractAddress: contract, EventName: "transfer", JsonArgs: `["a",12]`, BlockNo: 7}},
+			}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "event", "list", "--contract", testAddr, "--name", "transfer",
+		"--argfilter", `{"1":{"gte":10}}`, "--indexed")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"BlockNo": 7`, "indexed event")
+}
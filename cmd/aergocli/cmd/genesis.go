@@ -0,0 +1,251 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis [flags] subcommand",
+	Short: "Create and inspect the chain spec of a new chain",
+	// the genesis is built without connecting to a server
+	PersistentPreRun:  func(cmd *cobra.Command, args []string) {},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var (
+	genesisMagic       string
+	genesisConsensus   string
+	genesisPublic      bool
+	genesisMainNet     bool
+	genesisTimestamp   int64
+	genesisAllocs      []string
+	genesisBPs         []string
+	genesisRaft        []string
+	genesisInterval    int64
+	genesisForks       []string
+	genesisDeployAllow []string
+	genesisTxPolicy    types.TxPolicy
+	genesisFee         types.FeeSpec
+	genesisBridge      types.BridgeConfig
+	genesisOut         string
+)
+
+func init() {
+	rootCmd.AddCommand(genesisCmd)
+	createCmd := &cobra.Command{
+		Use:   "create [flags]",
+		Short: "Create the chain spec, to init the data dir of the nodes by aergosvr init --spec",
+		Args:  cobra.NoArgs,
+		RunE:  execGenesisCreate,
+	}
+	createCmd.Flags().StringVar(&genesisMagic, "magic", "", "Magic of the chain id")
+	createCmd.Flags().StringVar(&genesisConsensus, "consensus", "dpos", "Consensus of the chain: dpos, raft or sbp")
+	createCmd.Flags().BoolVar(&genesisPublic, "public", false, "Create a public chain")
+	createCmd.Flags().BoolVar(&genesisMainNet, "mainnet", false, "Create a main net, which is public")
+	createCmd.Flags().Int64Var(&genesisTimestamp, "timestamp", 0, "Timestamp of the genesis block in nanoseconds (default now)")
+	createCmd.Flags().StringArrayVar(&genesisAllocs, "alloc", nil, "Initial balance as <address>=<amount>, the amount with an optional unit")
+	createCmd.Flags().StringArrayVar(&genesisBPs, "bp", nil, "Peer id of an initial BP")
+	createCmd.Flags().StringArrayVar(&genesisRaft, "raft", nil, "Initial raft member as <name>,<url>,<peer id>")
+	createCmd.Flags().Int64Var(&genesisInterval, "blockinterval", 0, "Block production interval in seconds (default any)")
+	createCmd.Flags().StringArrayVar(&genesisForks, "fork", nil, "Activation of a version of the features as <version>=<height>")
+	createCmd.Flags().StringArrayVar(&genesisDeployAllow, "deployallow", nil, "Address allowed to deploy contracts, which makes the deployment permissioned")
+	createCmd.Flags().Uint64Var(&genesisTxPolicy.MaxPayloadSize, "maxpayloadsize", 0, "Maximum size of a tx payload in bytes (default the chain default)")
+	createCmd.Flags().StringSliceVar(&genesisTxPolicy.PayloadTypes, "payloadtypes", nil, "Allowed payload types: transfer, call, deploy and governance (default all)")
+	createCmd.Flags().BoolVar(&genesisFee.ZeroFee, "zerofee", false, "Zero fee mode of the private chain")
+	createCmd.Flags().BoolVar(&genesisFee.GasFee, "gasfee", false, "Charge the contract txs by gas on the private chain")
+	createCmd.Flags().BoolVar(&genesisFee.DynamicFee, "dynamicfee", false, "Adjust the fee per byte to the block fullness on the private chain")
+	createCmd.Flags().Uint64Var(&genesisFee.FreeTxCount, "freetxcount", 0, "Number of the txs an account sends a day without fee on the private chain")
+	createCmd.Flags().Uint64Var(&genesisFee.FreeTxBytes, "freetxbytes", 0, "Payload bytes an account sends a day without fee on the private chain")
+	createCmd.Flags().StringArrayVar(&genesisBridge.Validators, "bridgevalidator", nil, "Validator of the bridge to the paired chain, which enables the bridge")
+	createCmd.Flags().IntVar(&genesisBridge.Threshold, "bridgethreshold", 1, "Number of the bridge validators anchoring a root of the paired chain")
+	createCmd.Flags().BoolVar(&genesisBridge.Mint, "bridgemint", false, "Mint the aergo claimed by the bridge, on a sidechain")
+	createCmd.Flags().StringVar(&genesisOut, "out", "", "File to write the chain spec (default stdout)")
+
+	inspectCmd := &cobra.Command{
+		Use:   "inspect <file>",
+		Short: "Validate and summarize the chain spec or the genesis json file",
+		Args:  cobra.ExactArgs(1),
+		RunE:  execGenesisInspect,
+	}
+	genesisCmd.AddCommand(createCmd, inspectCmd)
+}
+
+func execGenesisCreate(cmd *cobra.Command, args []string) error {
+	b := types.NewGenesisBuilder(genesisMagic, genesisConsensus)
+	if genesisPublic || genesisMainNet {
+		b.PublicNet(genesisMainNet)
+	}
+	if genesisTimestamp != 0 {
+		b.Timestamp(genesisTimestamp)
+	}
+	for _, alloc := range genesisAllocs {
+		kv := strings.SplitN(alloc, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid allocation %s, expected <address>=<amount>", alloc)
+		}
+		amount, err := util.ParseUnit(kv[1])
+		if err != nil {
+			return fmt.Errorf("invalid amount of allocation %s: %s", alloc, err)
+		}
+		b.Allocate(kv[0], amount)
+	}
+	for _, bp := range genesisBPs {
+		b.AddBP(bp)
+	}
+	for _, member := range genesisRaft {
+		fields := strings.Split(member, ",")
+		if len(fields) != 3 {
+			return fmt.Errorf("invalid raft member %s, expected <name>,<url>,<peer id>", member)
+		}
+		b.AddRaftMember(fields[0], fields[1], fields[2])
+	}
+	if genesisInterval != 0 {
+		b.BlockInterval(genesisInterval)
+	}
+	for _, fork := range genesisForks {
+		kv := strings.SplitN(fork, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid fork %s, expected <version>=<height>", fork)
+		}
+		version, err := strconv.ParseUint(kv[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version of fork %s", fork)
+		}
+		height, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid height of fork %s", fork)
+		}
+		b.ScheduleFork(version, height)
+	}
+	for _, address := range genesisDeployAllow {
+		b.AllowDeploy(address)
+	}
+	if genesisTxPolicy.MaxPayloadSize != 0 || len(genesisTxPolicy.PayloadTypes) != 0 {
+		policy := genesisTxPolicy
+		b.TxPolicy(&policy)
+	}
+	if genesisFee != (types.FeeSpec{}) {
+		b.Fee(genesisFee)
+	}
+	if len(genesisBridge.Validators) != 0 {
+		bridge := genesisBridge
+		b.Bridge(&bridge)
+	}
+
+	spec, err := b.Build()
+	if err != nil {
+		return err
+	}
+	data, err := spec.JSON()
+	if err != nil {
+		return err
+	}
+	if genesisOut == "" {
+		cmd.Println(string(data))
+		return nil
+	}
+	if err = ioutil.WriteFile(genesisOut, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	cmd.Printf("chain spec is written in %s\n", genesisOut)
+	return nil
+}
+
+// loadGenesisFile reads the chain spec, or the genesis json file of aergosvr
+// init --genesis as the spec of the genesis only.
+func loadGenesisFile(path string) (*types.ChainSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var probe map[string]json.RawMessage
+	if json.Unmarshal(data, &probe) == nil {
+		if _, isSpec := probe["genesis"]; !isSpec {
+			genesis := &types.Genesis{}
+			if err = json.Unmarshal(data, genesis); err != nil {
+				return nil, err
+			}
+			spec := &types.ChainSpec{Genesis: genesis}
+			return spec, spec.Validate()
+		}
+	}
+	return types.ParseChainSpec(data)
+}
+
+func execGenesisInspect(cmd *cobra.Command, args []string) error {
+	spec, err := loadGenesisFile(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid %s: %s", args[0], err)
+	}
+	g := spec.Genesis
+
+	cmd.Printf("chain id: %s\n", g.ID.ToJSON())
+	cmd.Printf("timestamp: %d (%s)\n", g.Timestamp, time.Unix(0, g.Timestamp).UTC().Format(time.RFC3339))
+	if spec.GenesisHash != "" {
+		cmd.Printf("genesis hash: %s\n", spec.GenesisHash)
+	}
+
+	addresses := make([]string, 0, len(g.Balance))
+	total := new(big.Int)
+	for address, balance := range g.Balance {
+		addresses = append(addresses, address)
+		v, _ := new(big.Int).SetString(balance, 10)
+		total.Add(total, v)
+	}
+	sort.Strings(addresses)
+	cmd.Printf("allocations: %d, total %s\n", len(addresses), util.NewAmount(total).String())
+	for _, address := range addresses {
+		v, _ := new(big.Int).SetString(g.Balance[address], 10)
+		cmd.Printf("  %s %s\n", address, util.NewAmount(v).String())
+	}
+	cmd.Printf("bps: %d\n", len(g.BPs))
+	for _, bp := range g.BPs {
+		cmd.Printf("  %s\n", bp)
+	}
+
+	if c := spec.Consensus; c != nil {
+		if c.BlockInterval != 0 {
+			cmd.Printf("block interval: %ds\n", c.BlockInterval)
+		}
+		if len(c.RaftMembers) > 0 {
+			cmd.Printf("raft members: %d\n", len(c.RaftMembers))
+			for _, m := range c.RaftMembers {
+				cmd.Printf("  %s %s %s\n", m.Name, m.Url, m.PeerID)
+			}
+		}
+	}
+	for _, fork := range g.Forks {
+		cmd.Printf("fork: %s\n", fork)
+	}
+	if len(g.DeployAllowList) > 0 {
+		cmd.Printf("deploy allow list: %s\n", strings.Join(g.DeployAllowList, ", "))
+	}
+	if p := g.TxPolicy; p != nil {
+		cmd.Printf("tx policy: max payload size %d, payload types %v\n", p.MaxPayloadSize, p.PayloadTypes)
+	}
+	if fee := spec.GetFee(); fee != (types.FeeSpec{}) {
+		cmd.Printf("fee: zero fee %t, gas fee %t, dynamic fee %t, free txs %d, free bytes %d\n",
+			fee.ZeroFee, fee.GasFee, fee.DynamicFee, fee.FreeTxCount, fee.FreeTxBytes)
+	}
+	if bridge := g.Bridge; bridge != nil {
+		cmd.Printf("bridge: %d of %d validators, mint %t\n", bridge.Threshold, len(bridge.Validators), bridge.Mint)
+	}
+	cmd.Println("valid")
+	return nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenesisCreateInspect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	specFile := filepath.Join(dir, "spec.json")
+
+	output, err := executeCommand(rootCmd, "genesis", "create", "--magic", "cli.test", "--consensus", "raft",
+		"--timestamp", "1", "--alloc", "AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ=10aergo",
+		"--raft", "bp1,http://127.0.0.1:11001,16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n",
+		"--blockinterval", "3", "--fork", "1=100", "--payloadtypes", "transfer,call", "--gasfee",
+		"--bridgevalidator", "AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ", "--bridgemint", "--out", specFile)
+	assert.NoError(t, err)
+	assert.Contains(t, output, "chain spec is written in")
+
+	spec, err := types.LoadChainSpec(specFile)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10000000000000000000", spec.Genesis.Balance["AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ"])
+		assert.Equal(t, int64(3), spec.Consensus.BlockInterval)
+		assert.Equal(t, "bp1", spec.Consensus.RaftMembers[0].Name)
+		assert.True(t, spec.GetFee().GasFee)
+	}
+
+	output, err = executeCommand(rootCmd, "genesis", "inspect", specFile)
+	assert.NoError(t, err)
+	assert.Contains(t, output, `"magic":"cli.test"`)
+	assert.Contains(t, output, "allocations: 1, total 10 aergo")
+	assert.Contains(t, output, "raft members: 1")
+	assert.Contains(t, output, "fork: version 1 at 100")
+	assert.Contains(t, output, "bridge: 1 of 1 validators, mint true")
+	assert.Contains(t, output, "valid")
+
+	// the genesis json file of aergosvr init --genesis
+	output, err = executeCommand(rootCmd, "genesis", "inspect", "../../../examples/genesis.json")
+	assert.NoError(t, err)
+	assert.Contains(t, output, "bps: 13")
+
+	_, err = executeCommand(rootCmd, "genesis", "create", "--magic", "cli.test", "--timestamp", "1",
+		"--alloc", "AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ=10aergo", "--raft", "bp1,127.0.0.1,invalid",
+		"--fork", "", "--payloadtypes", "", "--gasfee=false", "--out", "")
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ package cmd
 import (
 	"context"
 	"encoding/binary"
+	"encoding/json"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
@@ -25,12 +26,16 @@ var getblockCmd = &cobra.Command{
 var stream bool
 var number uint64
 var hash string
+var blockReceipts, blockConsensus, blockFees bool
 
 func init() {
 	rootCmd.AddCommand(getblockCmd)
 	getblockCmd.Flags().Uint64VarP(&number, "number", "n", 0, "Block height")
 	getblockCmd.Flags().StringVarP(&hash, "hash", "", "", "Block hash")
 	getblockCmd.Flags().BoolVar(&stream, "stream", false, "Get the block information by streamming")
+	getblockCmd.Flags().BoolVar(&blockReceipts, "receipts", false, "Include the receipts of the txs of the block")
+	getblockCmd.Flags().BoolVar(&blockConsensus, "consensus", false, "Include the consensus info of the block: raft term and index or DPoS LIB")
+	getblockCmd.Flags().BoolVar(&blockFees, "fees", false, "Include the total fee and gas used by the txs of the block")
 }
 
 func execGetBlock(cmd *cobra.Command, args []string) {
@@ -55,8 +60,14 @@ func execGetBlock(cmd *cobra.Command, args []string) {
 		return
 	}
 	fflags := cmd.Flags()
+	if fflags.Changed("from") || fflags.Changed("to") {
+		if err := execGetBlockRange(cmd); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+		}
+		return
+	}
 	if fflags.Changed("number") == false && fflags.Changed("hash") == false {
-		cmd.Println("no block --hash or --number specified")
+		cmd.Println("no block --hash, --number or --from/--to specified")
 		return
 	}
 	var blockQuery []byte
@@ -77,6 +88,12 @@ func execGetBlock(cmd *cobra.Command, args []string) {
 		blockQuery = decoded
 	}
 
+	if blockReceipts || blockConsensus || blockFees {
+		if err := execGetBlockDetail(cmd, blockQuery); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+		}
+		return
+	}
 	msg, err := client.GetBlock(context.Background(), &aergorpc.SingleBytes{Value: blockQuery})
 	if nil == err {
 		cmd.Println(util.BlockConvBase58Addr(msg))
@@ -84,3 +101,25 @@ func execGetBlock(cmd *cobra.Command, args []string) {
 		cmd.Printf("Failed: %s\n", err.Error())
 	}
 }
+
+func execGetBlockDetail(cmd *cobra.Command, blockQuery []byte) error {
+	msg, err := client.GetBlockDetail(context.Background(), &aergorpc.BlockDetailParams{
+		Hashornumber: blockQuery,
+		Receipts:     blockReceipts,
+		Consensus:    blockConsensus,
+		Fees:         blockFees,
+	})
+	if err != nil {
+		return err
+	}
+	out := util.ConvBlockDetail(msg)
+	if !blockFees {
+		out.Fees = nil
+	}
+	jsonout, err := json.MarshalIndent(out, "", " ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(jsonout))
+	return nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGetBlockRangeWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	mock.EXPECT().GetBlock(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, in *types.SingleBytes, opts ...grpc.CallOption) (*types.Block, error) {
+		return &types.Block{Header: &types.BlockHeader{BlockNo: binary.LittleEndian.Uint64(in.Value)}}, nil
+	}).AnyTimes()
+
+	output, err := executeCommand(rootCmd, "getblock", "--from", "3", "--to", "7", "--batch", "2", "--format", "jsonl", "--file", "")
+	assert.NoError(t, err, "should be success")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	assert.Len(t, lines, 5, "a line per block")
+	assert.Contains(t, lines[0], `"BlockNo":3`, "first block")
+	assert.Contains(t, lines[4], `"BlockNo":7`, "last block")
+
+	dir, err := ioutil.TempDir("", "getblock")
+	assert.NoError(t, err, "could not create temp dir")
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "blocks.pb")
+	_, err = executeCommand(rootCmd, "getblock", "--from", "1", "--to", "3", "--batch", "16", "--format", "proto", "--file", file)
+	assert.NoError(t, err, "should be success")
+
+	f, err := os.Open(file)
+	assert.NoError(t, err, "could not open output")
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for no := uint64(1); no <= 3; no++ {
+		size, err := binary.ReadUvarint(r)
+		assert.NoError(t, err, "could not read size")
+		data := make([]byte, size)
+		_, err = io.ReadFull(r, data)
+		assert.NoError(t, err, "could not read block")
+		var b types.Block
+		assert.NoError(t, proto.Unmarshal(data, &b), "could not decode block")
+		assert.Equal(t, no, b.GetHeader().GetBlockNo(), "block in order")
+	}
+
+	output, err = executeCommand(rootCmd, "getblock", "--from", "5", "--to", "4", "--file", "")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed", "range in reverse")
+}
+
+func TestGetBlockDetailWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	for _, name := range []string{"from", "to"} {
+		getblockCmd.Flags().Lookup(name).Changed = false
+	}
+	defer func() { blockReceipts, blockConsensus, blockFees = false, false, false }()
+
+	mock.EXPECT().GetBlockDetail(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.BlockDetailParams, opts ...grpc.CallOption) (*types.BlockDetail, error) {
+			assert.Equal(t, uint64(5), binary.LittleEndian.Uint64(in.Hashornumber))
+			assert.True(t, in.Receipts && in.Consensus && in.Fees, "all details requested")
+			return &types.BlockDetail{
+				Block:         &types.Block{Header: &types.BlockHeader{BlockNo: 5}},
+				Receipts:      []*types.Receipt{{Status: "SUCCESS", GasUsed: 10}},
+				ConsensusInfo: `{"Term":2,"Index":9}`,
+				TotalFee:      []byte{0x03, 0xe8},
+				TotalGasUsed:  10,
+			}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "getblock", "--number", "5", "--receipts", "--consensus", "--fees")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"BlockNo": 5`)
+	assert.Contains(t, output, `"status": "SUCCESS"`)
+	assert.Contains(t, output, `"Term": 2`)
+	assert.Contains(t, output, `"TotalFee": "1000"`)
+
+	// the fees are left out unless requested
+	mock.EXPECT().GetBlockDetail(gomock.Any(), gomock.Any()).Return(&types.BlockDetail{
+		Block: &types.Block{Header: &types.BlockHeader{BlockNo: 5}},
+	}, nil).Times(1)
+	blockReceipts, blockConsensus, blockFees = false, false, false
+	output, err = executeCommand(rootCmd, "getblock", "--number", "5", "--receipts")
+	assert.NoError(t, err, "should be success")
+	assert.NotContains(t, output, "TotalFee")
+	assert.NotContains(t, output, "ConsensusInfo")
+}
@@ -0,0 +1,236 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	aergorpc "github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cobra"
+)
+
+const (
+	blockFormatJSON  = "json"
+	blockFormatJSONL = "jsonl"
+	blockFormatProto = "proto"
+
+	progressBarWidth = 40
+)
+
+var (
+	fromBlock   uint64
+	toBlock     uint64
+	blockFile   string
+	blockFormat string
+	blockBatch  int
+)
+
+func init() {
+	getblockCmd.Flags().Uint64Var(&fromBlock, "from", 0, "First block height of a range")
+	getblockCmd.Flags().Uint64Var(&toBlock, "to", 0, "Last block height of a range (default: the best block)")
+	getblockCmd.Flags().StringVar(&blockFile, "file", "", "Write the blocks of a range to the file instead of the standard output")
+	getblockCmd.Flags().StringVar(&blockFormat, "format", blockFormatJSON, "Output format of a range: json, jsonl or proto (length-delimited protobuf)")
+	getblockCmd.Flags().IntVar(&blockBatch, "batch", 16, "Number of blocks fetched in parallel")
+}
+
+// blockWriter writes the blocks of a range in an output format.
+type blockWriter interface {
+	write(b *aergorpc.Block) error
+	close() error
+}
+
+func newBlockWriter(format string, w io.Writer) (blockWriter, error) {
+	switch format {
+	case blockFormatJSON:
+		return &jsonBlockWriter{w: w}, nil
+	case blockFormatJSONL:
+		return &jsonlBlockWriter{w: w}, nil
+	case blockFormatProto:
+		return &protoBlockWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// jsonBlockWriter writes a JSON array of the blocks.
+type jsonBlockWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (jw *jsonBlockWriter) write(b *aergorpc.Block) error {
+	sep := ",\n"
+	if jw.count == 0 {
+		sep = "[\n"
+	}
+	jw.count++
+	_, err := io.WriteString(jw.w, sep+util.BlockConvBase58Addr(b))
+	return err
+}
+
+func (jw *jsonBlockWriter) close() error {
+	if jw.count == 0 {
+		_, err := io.WriteString(jw.w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(jw.w, "\n]\n")
+	return err
+}
+
+// jsonlBlockWriter writes a block in JSON per line.
+type jsonlBlockWriter struct {
+	w io.Writer
+}
+
+func (jw *jsonlBlockWriter) write(b *aergorpc.Block) error {
+	out, err := json.Marshal(util.ConvBlock(b))
+	if err != nil {
+		return err
+	}
+	_, err = jw.w.Write(append(out, '\n'))
+	return err
+}
+
+func (jw *jsonlBlockWriter) close() error {
+	return nil
+}
+
+// protoBlockWriter writes the blocks in protobuf, each preceded by its size
+// as a varint.
+type protoBlockWriter struct {
+	w io.Writer
+}
+
+func (pw *protoBlockWriter) write(b *aergorpc.Block) error {
+	out, err := proto.Marshal(b)
+	if err != nil {
+		return err
+	}
+	l := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(l, uint64(len(out)))
+	if _, err = pw.w.Write(l[:n]); err != nil {
+		return err
+	}
+	_, err = pw.w.Write(out)
+	return err
+}
+
+func (pw *protoBlockWriter) close() error {
+	return nil
+}
+
+func execGetBlockRange(cmd *cobra.Command) error {
+	if blockBatch <= 0 {
+		return errors.New("--batch must be positive")
+	}
+	to := toBlock
+	if !cmd.Flags().Changed("to") {
+		status, err := client.Blockchain(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			return err
+		}
+		to = status.BestHeight
+	}
+	if fromBlock > to {
+		return fmt.Errorf("--from %d is above --to %d", fromBlock, to)
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	var bw *bufio.Writer
+	if blockFile != "" {
+		f, err := os.Create(blockFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		bw = bufio.NewWriter(f)
+		out = bw
+	}
+	w, err := newBlockWriter(strings.ToLower(blockFormat), out)
+	if err != nil {
+		return err
+	}
+
+	// the progress goes to the standard error, which is not mixed with the
+	// blocks unless they are written to the standard output
+	var progress io.Writer
+	if blockFile != "" && !test {
+		progress = os.Stderr
+	}
+	total := to - fromBlock + 1
+	for start := fromBlock; start <= to; start += uint64(blockBatch) {
+		end := start + uint64(blockBatch) - 1
+		if end > to || end < start {
+			end = to
+		}
+		blocks, err := fetchBlocks(start, end)
+		if err != nil {
+			return err
+		}
+		for _, b := range blocks {
+			if err = w.write(b); err != nil {
+				return err
+			}
+		}
+		printProgress(progress, end-fromBlock+1, total)
+		if end == to {
+			break
+		}
+	}
+	if progress != nil {
+		fmt.Fprintln(progress)
+	}
+	if err = w.close(); err != nil {
+		return err
+	}
+	if bw != nil {
+		return bw.Flush()
+	}
+	return nil
+}
+
+// fetchBlocks gets the blocks from start to end in parallel.
+func fetchBlocks(start, end uint64) ([]*aergorpc.Block, error) {
+	blocks := make([]*aergorpc.Block, end-start+1)
+	errs := make([]error, len(blocks))
+	var wg sync.WaitGroup
+	for i := range blocks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			query := make([]byte, 8)
+			binary.LittleEndian.PutUint64(query, start+uint64(i))
+			blocks[i], errs[i] = client.GetBlock(context.Background(), &aergorpc.SingleBytes{Value: query})
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %s", start+uint64(i), err.Error())
+		}
+	}
+	return blocks, nil
+}
+
+func printProgress(w io.Writer, done, total uint64) {
+	if w == nil {
+		return
+	}
+	filled := int(done * progressBarWidth / total)
+	fmt.Fprintf(w, "\r[%s%s] %d/%d", strings.Repeat("=", filled),
+		strings.Repeat(" ", progressBarWidth-filled), done, total)
+}
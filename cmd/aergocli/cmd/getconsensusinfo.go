@@ -27,35 +27,45 @@ var getConsensusInfoCmd = &cobra.Command{
 			return
 		}
 
-		type outInfo struct {
-			Type string             `json:",omitempty"`
-			Info *json.RawMessage   `json:",omitempty"`
-			Bps  []*json.RawMessage `json:",omitempty"`
+		out, err := convConsensusInfo(msg)
+		if err != nil {
+			cmd.Printf("failed decode consensus info: %v\n", err)
+			return
 		}
 
-		var out = &outInfo{}
-		out.Type = msg.Type
+		cmd.Println(out)
+	},
+}
 
-		//cmd.Println(fmt.Sprintf("consensus:%s, leader:%d", msg.Type, uinfo.Leader))
-		if len(msg.Info) > 0 {
-			infoB := json.RawMessage(msg.Info)
-			out.Info = &infoB
-		}
+// convConsensusInfo returns the consensus info as JSON, where the info and
+// the bps are embedded as JSON.
+func convConsensusInfo(msg *aergorpc.ConsensusInfo) (string, error) {
+	type outInfo struct {
+		Type string             `json:",omitempty"`
+		Info *json.RawMessage   `json:",omitempty"`
+		Bps  []*json.RawMessage `json:",omitempty"`
+	}
 
-		if len(msg.Bps) > 0 {
-			out.Bps = make([]*json.RawMessage, len(msg.Bps))
-			for i, bpstr := range msg.Bps {
-				b := json.RawMessage([]byte(bpstr))
-				out.Bps[i] = &b
-			}
-		}
+	var out = &outInfo{}
+	out.Type = msg.Type
 
-		jsonout, err := json.Marshal(out)
-		if err != nil {
-			cmd.Printf("failed decode consensus info: %v\n", err)
-			return
+	//cmd.Println(fmt.Sprintf("consensus:%s, leader:%d", msg.Type, uinfo.Leader))
+	if len(msg.Info) > 0 {
+		infoB := json.RawMessage(msg.Info)
+		out.Info = &infoB
+	}
+
+	if len(msg.Bps) > 0 {
+		out.Bps = make([]*json.RawMessage, len(msg.Bps))
+		for i, bpstr := range msg.Bps {
+			b := json.RawMessage([]byte(bpstr))
+			out.Bps[i] = &b
 		}
+	}
 
-		cmd.Println(string(jsonout))
-	},
+	jsonout, err := json.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonout), nil
 }
@@ -8,12 +8,14 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"sort"
 	"strings"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +28,8 @@ var getpeersCmd = &cobra.Command{
 var nohidden bool
 var showself bool
 var sortFlag string
+var peersPageSize uint32
+var peersCursor string
 
 const (
 	sortAddr    = "addr"
@@ -39,11 +43,21 @@ func init() {
 	getpeersCmd.Flags().BoolVar(&nohidden, "nohidden", false, "exclude hidden peers")
 	getpeersCmd.Flags().BoolVar(&showself, "self", false, "show self peer info")
 	getpeersCmd.Flags().StringVar(&sortFlag, "sort", "no", "sort peers by address, id or other")
+	getpeersCmd.Flags().Uint32Var(&peersPageSize, "size", 0, "number of peers in a page ordered by id (default: all the peers)")
+	getpeersCmd.Flags().StringVar(&peersCursor, "cursor", "", "cursor of the page returned with the previous page")
 }
 
 func execGetPeers(cmd *cobra.Command, args []string) {
 	sorter := GetSorter(cmd, sortFlag)
-	msg, err := client.GetPeers(context.Background(), &types.PeersParams{NoHidden: nohidden, ShowSelf: showself})
+	params := &types.PeersParams{NoHidden: nohidden, ShowSelf: showself, Size: peersPageSize}
+	if len(peersCursor) != 0 {
+		var err error
+		if params.Cursor, err = base58.Decode(peersCursor); err != nil {
+			cmd.Printf("Failed: invalid cursor: %s\n", err.Error())
+			return
+		}
+	}
+	msg, err := client.GetPeers(context.Background(), params)
 	if err != nil {
 		cmd.Printf("Failed to get peer from server: %s\n", err.Error())
 		return
@@ -51,6 +65,10 @@ func execGetPeers(cmd *cobra.Command, args []string) {
 	// address and peerid should be encoded, respectively
 	sorter.Sort(msg.Peers)
 	cmd.Println(util.PeerListToString(msg))
+	if len(msg.NextCursor) != 0 {
+		// the cursor goes to the standard error to keep the peers parsable
+		fmt.Fprintf(os.Stderr, "next cursor: %s\n", base58.Encode(msg.NextCursor))
+	}
 }
 
 func Must(a0 string, _ error) string {
@@ -27,6 +27,7 @@ func init() {
 	getstateCmd.Flags().BoolVar(&proof, "proof", false, "Get the proof for the state")
 	getstateCmd.Flags().BoolVar(&compressed, "compressed", false, "Get a compressed proof for the state")
 	getstateCmd.Flags().BoolVar(&staking, "staking", false, "Get the staking info from the address")
+	getstateCmd.Flags().BoolVar(&withdrawals, "withdrawals", false, "Get the unstaked amounts waiting for release from the address")
 	getstateCmd.Flags().StringVar(&unit, "unit", "aergo", "display unit of balance")
 	rootCmd.AddCommand(getstateCmd)
 }
@@ -41,7 +42,7 @@ func execGetState(cmd *cobra.Command, args []string) {
 			return
 		}
 	}
-	addr, err := types.DecodeAddress(address)
+	addr, err := decodeAddress(address)
 	if err != nil {
 		cmd.Printf("Failed: %s\n", err.Error())
 		return
@@ -63,6 +64,16 @@ func execGetState(cmd *cobra.Command, args []string) {
 
 		return
 	}
+	if withdrawals {
+		msg, err := client.GetPendingWithdrawals(context.Background(),
+			&types.AccountAddress{Value: addr})
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		cmd.Println(util.JSON(msg))
+		return
+	}
 
 	if !proof {
 		// NOTE GetState first queries the statedb buffer.
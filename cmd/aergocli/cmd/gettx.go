@@ -7,6 +7,9 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
@@ -22,8 +25,21 @@ var gettxCmd = &cobra.Command{
 	Run:   execGetTX,
 }
 
+var (
+	txWait          bool
+	txConfirmations uint64
+	txWaitTimeout   uint
+
+	// txPollInterval is the interval of polling the status of the tx while
+	// waiting for it.
+	txPollInterval = time.Second
+)
+
 func init() {
 	rootCmd.AddCommand(gettxCmd)
+	gettxCmd.Flags().BoolVar(&txWait, "wait", false, "Wait until the tx is included in a block, printing the changes of its status")
+	gettxCmd.Flags().Uint64Var(&txConfirmations, "confirmations", 0, "Number of blocks on top of the block of the tx to wait for")
+	gettxCmd.Flags().UintVar(&txWaitTimeout, "timeout", 60, "Seconds to wait for the tx")
 	// args := make([]string, 0, 10)
 	// args = append(args, "subCommand")
 	// blockCmd.SetArgs(args)
@@ -35,6 +51,15 @@ func execGetTX(cmd *cobra.Command, args []string) {
 		cmd.Printf("Failed decode: %s", err.Error())
 		return
 	}
+	if txWait {
+		msgblock, err := waitForTx(cmd, txHash, txConfirmations, time.Duration(txWaitTimeout)*time.Second)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.TxInBlockConvBase58Addr(msgblock))
+		return
+	}
 	msg, err := client.GetTX(context.Background(), &aergorpc.SingleBytes{Value: txHash})
 	if err == nil {
 		cmd.Println(util.TxConvBase58Addr(msg))
@@ -48,3 +73,69 @@ func execGetTX(cmd *cobra.Command, args []string) {
 	}
 
 }
+
+// txStatus is the status of a tx seen while waiting for it.
+type txStatus struct {
+	state   string
+	blockNo uint64
+	confirm uint64
+}
+
+func (s txStatus) String() string {
+	switch s.state {
+	case "included":
+		return fmt.Sprintf("included in block %d with %d confirmations", s.blockNo, s.confirm)
+	case "pending":
+		return "pending in the mempool"
+	}
+	return "not found"
+}
+
+// waitForTx polls the status of the tx until it is included in a block with
+// the number of blocks on top of it, printing each change of the status.
+// The tx may be not found before it reaches the mempool and goes back to the
+// mempool when its block is reorganized.
+func waitForTx(cmd *cobra.Command, txHash []byte, confirmations uint64, timeout time.Duration) (*aergorpc.TxInBlock, error) {
+	deadline := time.Now().Add(timeout)
+	var last txStatus
+	for {
+		status, msgblock, err := getTxStatus(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if status != last {
+			cmd.Println(status)
+			last = status
+		}
+		if status.state == "included" && status.confirm >= confirmations {
+			return msgblock, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.New("timeout waiting for the tx: " + status.String())
+		}
+		time.Sleep(txPollInterval)
+	}
+}
+
+func getTxStatus(txHash []byte) (txStatus, *aergorpc.TxInBlock, error) {
+	msgblock, err := client.GetBlockTX(context.Background(), &aergorpc.SingleBytes{Value: txHash})
+	if err == nil {
+		meta, err := client.GetBlockMetadata(context.Background(), &aergorpc.SingleBytes{Value: msgblock.GetTxIdx().GetBlockHash()})
+		if err != nil {
+			return txStatus{}, nil, errors.New("Failed request to aergo server\n" + err.Error())
+		}
+		best, err := client.Blockchain(context.Background(), &aergorpc.Empty{})
+		if err != nil {
+			return txStatus{}, nil, errors.New("Failed request to aergo server\n" + err.Error())
+		}
+		status := txStatus{state: "included", blockNo: meta.GetHeader().GetBlockNo()}
+		if best.BestHeight > status.blockNo {
+			status.confirm = best.BestHeight - status.blockNo
+		}
+		return status, msgblock, nil
+	}
+	if _, err := client.GetTX(context.Background(), &aergorpc.SingleBytes{Value: txHash}); err == nil {
+		return txStatus{state: "pending"}, nil, nil
+	}
+	return txStatus{state: "unknown"}, nil, nil
+}
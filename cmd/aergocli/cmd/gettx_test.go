@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTxWaitWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func(interval time.Duration) {
+		txWait, txConfirmations, txWaitTimeout, txPollInterval = false, 0, 60, interval
+	}(txPollInterval)
+	txPollInterval = time.Millisecond
+
+	txHash := base58.Encode([]byte("tx hash of 32 bytes for the test"))
+	notFound := errors.New("not found")
+	included := &types.TxInBlock{TxIdx: &types.TxIdx{BlockHash: []byte("block"), Idx: 0}, Tx: &types.Tx{Body: &types.TxBody{}}}
+	gomock.InOrder(
+		mock.EXPECT().GetBlockTX(gomock.Any(), gomock.Any()).Return(nil, notFound),
+		mock.EXPECT().GetBlockTX(gomock.Any(), gomock.Any()).Return(nil, notFound),
+		mock.EXPECT().GetBlockTX(gomock.Any(), gomock.Any()).Return(included, nil).Times(3),
+	)
+	gomock.InOrder(
+		mock.EXPECT().GetTX(gomock.Any(), gomock.Any()).Return(nil, notFound),
+		mock.EXPECT().GetTX(gomock.Any(), gomock.Any()).Return(&types.Tx{}, nil),
+	)
+	mock.EXPECT().GetBlockMetadata(gomock.Any(), gomock.Any()).Return(
+		&types.BlockMetadata{Header: &types.BlockHeader{BlockNo: 10}}, nil).Times(3)
+	gomock.InOrder(
+		mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{BestHeight: 10}, nil).Times(2),
+		mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{BestHeight: 12}, nil),
+	)
+	output, err := executeCommand(rootCmd, "gettx", txHash, "--wait", "--confirmations", "2")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "not found\npending in the mempool\n"+
+		"included in block 10 with 0 confirmations\nincluded in block 10 with 2 confirmations\n")
+	assert.Contains(t, output, `"BlockHash"`)
+
+	// times out while the tx is still pending
+	mock.EXPECT().GetBlockTX(gomock.Any(), gomock.Any()).Return(nil, notFound).AnyTimes()
+	mock.EXPECT().GetTX(gomock.Any(), gomock.Any()).Return(&types.Tx{}, nil).AnyTimes()
+	output, err = executeCommand(rootCmd, "gettx", txHash, "--wait", "--timeout", "0")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: timeout waiting for the tx: pending in the mempool")
+}
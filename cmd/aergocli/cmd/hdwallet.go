@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var hdWalletCmd = &cobra.Command{
+	Use:   "hdwallet [flags]",
+	Short: "Create the hd wallet in the node or cli from a new or given mnemonic",
+	Run: func(cmd *cobra.Command, args []string) {
+		passphrase, err := getHDPassword(cmd, true)
+		if err != nil {
+			cmd.Printf("Failed get password: %s\n", err.Error())
+			return
+		}
+		var result string
+		if cmd.Flags().Changed("path") == false {
+			msg, err := client.CreateHDWallet(context.Background(), &types.HDWalletParams{
+				Passphrase:     passphrase,
+				Mnemonic:       mnemonic,
+				SeedPassphrase: seedPassword,
+				Words:          uint32(mnemonicWords),
+			})
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			result = msg.GetMnemonic()
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			result, err = ks.CreateHDWallet(mnemonic, seedPassword, mnemonicWords, passphrase)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+		}
+		cmd.Println(result)
+	},
+}
+
+var mnemonicCmd = &cobra.Command{
+	Use:   "mnemonic [flags]",
+	Short: "Export the mnemonic of the hd wallet in the node or cli",
+	Run: func(cmd *cobra.Command, args []string) {
+		passphrase, err := getHDPassword(cmd, false)
+		if err != nil {
+			cmd.Printf("Failed get password: %s\n", err.Error())
+			return
+		}
+		var result string
+		if cmd.Flags().Changed("path") == false {
+			msg, err := client.ExportMnemonic(context.Background(), &types.Personal{Passphrase: passphrase})
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			result = msg.GetMnemonic()
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			result, err = ks.ExportMnemonic(passphrase)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+		}
+		cmd.Println(result)
+	},
+}
+
+var deriveCmd = &cobra.Command{
+	Use:   "derive [flags]",
+	Short: "Derive accounts from the hd wallet in the node or cli",
+	Run: func(cmd *cobra.Command, args []string) {
+		passphrase, err := getHDPassword(cmd, false)
+		if err != nil {
+			cmd.Printf("Failed get password: %s\n", err.Error())
+			return
+		}
+		var msg *types.HDAccountList
+		if cmd.Flags().Changed("path") == false {
+			msg, err = client.DeriveAccounts(context.Background(), &types.DeriveParams{
+				Passphrase: passphrase,
+				Account:    hdAccount,
+				Index:      hdIndex,
+				Count:      hdCount,
+			})
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			var derived []*key.HDAccount
+			derived, err = ks.DeriveHDAccounts(passphrase, hdAccount, hdIndex, hdCount)
+			msg = toHDAccountList(derived)
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		printHDAccounts(cmd, msg)
+	},
+}
+
+var hdListCmd = &cobra.Command{
+	Use:   "hdlist [flags]",
+	Short: "Get the accounts derived from the hd wallet in the node or cli",
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+		var msg *types.HDAccountList
+		if cmd.Flags().Changed("path") == false {
+			msg, err = client.ListHDAccounts(context.Background(), &types.Empty{})
+		} else {
+			ks := key.NewStore(os.ExpandEnv(dataDir), 0)
+			defer ks.CloseStore()
+			var hdAccounts []*key.HDAccount
+			hdAccounts, err = ks.GetHDAccounts()
+			msg = toHDAccountList(hdAccounts)
+		}
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		printHDAccounts(cmd, msg)
+	},
+}
+
+func getHDPassword(cmd *cobra.Command, isNew bool) (string, error) {
+	if pw != "" {
+		return pw, nil
+	}
+	return getPasswd(cmd, isNew)
+}
+
+func toHDAccountList(hdAccounts []*key.HDAccount) *types.HDAccountList {
+	list := &types.HDAccountList{}
+	for _, a := range hdAccounts {
+		list.Accounts = append(list.Accounts, &types.HDAccount{Account: types.NewAccount(a.Address), Path: a.Path})
+	}
+	return list
+}
+
+func printHDAccounts(cmd *cobra.Command, list *types.HDAccountList) {
+	for _, a := range list.GetAccounts() {
+		cmd.Printf("%s %s\n", a.GetPath(), types.EncodeAddress(a.GetAccount().GetAddress()))
+	}
+}
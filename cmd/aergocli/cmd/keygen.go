@@ -4,9 +4,12 @@ import (
 	b64 "encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/consensus/signer"
 	"github.com/aergoio/aergo/types"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/libp2p/go-libp2p-crypto"
@@ -26,6 +29,10 @@ var (
 	genID     bool
 	genJSON   bool
 	password  string
+
+	shareThreshold int
+	shareParties   int
+	sharePresigs   int
 )
 
 func init() {
@@ -34,7 +41,11 @@ func init() {
 	keygenCmd.Flags().BoolVar(&genJSON, "json", false, "output combined json object instead of generating files")
 	keygenCmd.Flags().StringVar(&password, "password", "", "password for encrypted private key in json file")
 
-	rootCmd.AddCommand(keygenCmd)
+	splitKeyCmd.Flags().IntVar(&shareThreshold, "threshold", 2, "number of the shares to sign a block, which should be a majority")
+	splitKeyCmd.Flags().IntVar(&shareParties, "parties", 3, "number of the shares")
+	splitKeyCmd.Flags().IntVar(&sharePresigs, "presigs", 100000, "number of the presignatures, each of which signs a block")
+
+	rootCmd.AddCommand(keygenCmd, splitKeyCmd)
 }
 
 var keygenCmd = &cobra.Command{
@@ -140,3 +151,38 @@ func generateKeyJson() error {
 
 	return nil
 }
+
+var splitKeyCmd = &cobra.Command{
+	Use:   "splitkey [flags] <keyfile>",
+	Short: "Split private key into the shares of the threshold signers",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := splitKeyFile(cmd, args[0]); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+		}
+	},
+}
+
+func splitKeyFile(cmd *cobra.Command, keyFile string) error {
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	priv, err := crypto.UnmarshalPrivateKey(data)
+	if err != nil {
+		return err
+	}
+	shares, err := signer.DealKeyShares(priv, shareThreshold, shareParties, sharePresigs)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimSuffix(keyFile, ".key")
+	for _, share := range shares {
+		shareFile := fmt.Sprintf("%s.share%d.json", prefix, share.Index)
+		if err := share.Save(shareFile); err != nil {
+			return err
+		}
+		cmd.Printf("Wrote file %s.\n", shareFile)
+	}
+	return nil
+}
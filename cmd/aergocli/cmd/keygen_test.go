@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/consensus/signer"
+	"github.com/libp2p/go-libp2p-crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keygen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	priv, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	data, _ := priv.Bytes()
+	keyFile := filepath.Join(dir, "bp.key")
+	assert.NoError(t, ioutil.WriteFile(keyFile, data, 0600))
+
+	output, err := executeCommand(rootCmd, "splitkey", "--threshold", "2", "--parties", "3", "--presigs", "10", keyFile)
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "bp.share3.json")
+	share, err := signer.LoadKeyShare(filepath.Join(dir, "bp.share2.json"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(2), share.Index)
+		assert.Len(t, share.Presigs, 10)
+	}
+
+	output, err = executeCommand(rootCmd, "splitkey", "--threshold", "1", "--parties", "3", keyFile)
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: threshold should be a majority")
+}
@@ -7,6 +7,8 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/mr-tron/base58/base58"
 
@@ -25,6 +27,7 @@ var gbhHeight int32
 var gbhSize int
 var gbhOffset int
 var gbhAsc bool
+var gbhCursor string
 
 func init() {
 	rootCmd.AddCommand(listblockheadersCmd)
@@ -34,14 +37,21 @@ func init() {
 	listblockheadersCmd.Flags().IntVar(&gbhSize, "size", 20, "Max list size")
 	listblockheadersCmd.Flags().IntVar(&gbhOffset, "offset", 0, "Offset")
 	listblockheadersCmd.Flags().BoolVar(&gbhAsc, "asc", false, "Order by")
+	listblockheadersCmd.Flags().StringVar(&gbhCursor, "cursor", "", "Cursor of the page returned with the previous page")
 
 }
 
 func execListBlockHeaders(cmd *cobra.Command, args []string) {
-	var blockHash []byte
+	var blockHash, cursor []byte
 	var err error
 
-	if cmd.Flags().Changed("hash") == true {
+	if cmd.Flags().Changed("cursor") {
+		cursor, err = base58.Decode(gbhCursor)
+		if err != nil {
+			cmd.Printf("Failed: invalid cursor: %s", err.Error())
+			return
+		}
+	} else if cmd.Flags().Changed("hash") == true {
 		blockHash, err = base58.Decode(gbhHash)
 		if err != nil {
 			cmd.Printf("Failed: %s", err.Error())
@@ -58,6 +68,7 @@ func execListBlockHeaders(cmd *cobra.Command, args []string) {
 		Size:   uint32(gbhSize),
 		Offset: uint32(gbhOffset),
 		Asc:    gbhAsc,
+		Cursor: cursor,
 	}
 
 	msg, err := client.ListBlockHeaders(context.Background(), uparams)
@@ -65,5 +76,11 @@ func execListBlockHeaders(cmd *cobra.Command, args []string) {
 		cmd.Printf("Failed: %s", err.Error())
 		return
 	}
+	next := msg.NextCursor
+	msg.NextCursor = nil
 	cmd.Println(util.JSON(msg))
+	if len(next) != 0 {
+		// the cursor goes to the standard error to keep the blocks parsable
+		fmt.Fprintf(os.Stderr, "next cursor: %s\n", base58.Encode(next))
+	}
 }
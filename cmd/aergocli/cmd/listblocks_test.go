@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestListCursorsWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		gbhCursor, peersCursor, peersPageSize = "", "", 0
+		listblockheadersCmd.Flags().Lookup("cursor").Changed = false
+	}()
+
+	cursor := []byte{1, 0, 0, 0, 0, 0, 0, 0, 9}
+	mock.EXPECT().ListBlockHeaders(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.ListParams, opts ...grpc.CallOption) (*types.BlockHeaderList, error) {
+			assert.Equal(t, cursor, in.Cursor, "--cursor")
+			assert.Equal(t, uint32(2), in.Size, "--size")
+			return &types.BlockHeaderList{
+				Blocks:     []*types.Block{{Header: &types.BlockHeader{BlockNo: 9}}, {Header: &types.BlockHeader{BlockNo: 8}}},
+				NextCursor: []byte{1, 0, 0, 0, 0, 0, 0, 0, 7},
+				TotalHint:  10,
+			}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "listblocks", "--cursor", base58.Encode(cursor), "--size", "2")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"totalHint": 10`)
+	assert.NotContains(t, output, "nextCursor", "the cursor is printed apart")
+
+	mock.EXPECT().GetPeers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.PeersParams, opts ...grpc.CallOption) (*types.PeerList, error) {
+			assert.Equal(t, uint32(5), in.Size, "--size")
+			assert.Equal(t, []byte{2, 'a'}, in.Cursor, "--cursor")
+			return &types.PeerList{}, nil
+		}).Times(1)
+	_, err = executeCommand(rootCmd, "getpeers", "--size", "5", "--cursor", base58.Encode([]byte{2, 'a'}))
+	assert.NoError(t, err, "should be success")
+}
@@ -35,6 +35,42 @@ func (m *MockAergoRPCServiceClient) EXPECT() *MockAergoRPCServiceClientMockRecor
 	return m.recorder
 }
 
+// AddWatchAccount mocks base method
+func (m *MockAergoRPCServiceClient) AddWatchAccount(arg0 context.Context, arg1 *types.Account, arg2 ...grpc.CallOption) (*types.WatchAccount, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddWatchAccount", varargs...)
+	ret0, _ := ret[0].(*types.WatchAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWatchAccount indicates an expected call of AddWatchAccount
+func (mr *MockAergoRPCServiceClientMockRecorder) AddWatchAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWatchAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).AddWatchAccount), varargs...)
+}
+
+// BanPeer mocks base method
+func (m *MockAergoRPCServiceClient) BanPeer(arg0 context.Context, arg1 *types.BanParams, arg2 ...grpc.CallOption) (*types.BannedPeerList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BanPeer", varargs...)
+	ret0, _ := ret[0].(*types.BannedPeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockAergoRPCServiceClientMockRecorder) BanPeer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).BanPeer), varargs...)
+}
+
 // Blockchain mocks base method
 func (m *MockAergoRPCServiceClient) Blockchain(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.BlockchainStatus, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -125,6 +161,132 @@ func (mr *MockAergoRPCServiceClientMockRecorder) CreateAccount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).CreateAccount), varargs...)
 }
 
+// CreateClusterSnapshot mocks base method
+func (m *MockAergoRPCServiceClient) CreateClusterSnapshot(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ClusterSnapshot, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateClusterSnapshot", varargs...)
+	ret0, _ := ret[0].(*types.ClusterSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterSnapshot indicates an expected call of CreateClusterSnapshot
+func (mr *MockAergoRPCServiceClientMockRecorder) CreateClusterSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterSnapshot", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).CreateClusterSnapshot), varargs...)
+}
+
+// CreateHDWallet mocks base method
+func (m *MockAergoRPCServiceClient) CreateHDWallet(arg0 context.Context, arg1 *types.HDWalletParams, arg2 ...grpc.CallOption) (*types.HDWallet, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateHDWallet", varargs...)
+	ret0, _ := ret[0].(*types.HDWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHDWallet indicates an expected call of CreateHDWallet
+func (mr *MockAergoRPCServiceClientMockRecorder) CreateHDWallet(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHDWallet", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).CreateHDWallet), varargs...)
+}
+
+// DeleteAlias mocks base method
+func (m *MockAergoRPCServiceClient) DeleteAlias(arg0 context.Context, arg1 *types.Alias, arg2 ...grpc.CallOption) (*types.Alias, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAlias", varargs...)
+	ret0, _ := ret[0].(*types.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias
+func (mr *MockAergoRPCServiceClientMockRecorder) DeleteAlias(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).DeleteAlias), varargs...)
+}
+
+// DeriveAccounts mocks base method
+func (m *MockAergoRPCServiceClient) DeriveAccounts(arg0 context.Context, arg1 *types.DeriveParams, arg2 ...grpc.CallOption) (*types.HDAccountList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeriveAccounts", varargs...)
+	ret0, _ := ret[0].(*types.HDAccountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeriveAccounts indicates an expected call of DeriveAccounts
+func (mr *MockAergoRPCServiceClientMockRecorder) DeriveAccounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeriveAccounts", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).DeriveAccounts), varargs...)
+}
+
+// DumpProfile mocks base method
+func (m *MockAergoRPCServiceClient) DumpProfile(arg0 context.Context, arg1 *types.ProfileDump, arg2 ...grpc.CallOption) (*types.ProfileDump, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DumpProfile", varargs...)
+	ret0, _ := ret[0].(*types.ProfileDump)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpProfile indicates an expected call of DumpProfile
+func (mr *MockAergoRPCServiceClientMockRecorder) DumpProfile(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpProfile", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).DumpProfile), varargs...)
+}
+
+// EncodeCall mocks base method
+func (m *MockAergoRPCServiceClient) EncodeCall(arg0 context.Context, arg1 *types.CallEncodeParams, arg2 ...grpc.CallOption) (*types.SingleBytes, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EncodeCall", varargs...)
+	ret0, _ := ret[0].(*types.SingleBytes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncodeCall indicates an expected call of EncodeCall
+func (mr *MockAergoRPCServiceClientMockRecorder) EncodeCall(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncodeCall", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).EncodeCall), varargs...)
+}
+
+// EstimateFee mocks base method
+func (m *MockAergoRPCServiceClient) EstimateFee(arg0 context.Context, arg1 *types.TxBody, arg2 ...grpc.CallOption) (*types.FeeEstimate, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EstimateFee", varargs...)
+	ret0, _ := ret[0].(*types.FeeEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateFee indicates an expected call of EstimateFee
+func (mr *MockAergoRPCServiceClientMockRecorder) EstimateFee(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateFee", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).EstimateFee), varargs...)
+}
+
 // ExportAccount mocks base method
 func (m *MockAergoRPCServiceClient) ExportAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.SingleBytes, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -143,6 +305,42 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ExportAccount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ExportAccount), varargs...)
 }
 
+// ExportAccountKeystore mocks base method
+func (m *MockAergoRPCServiceClient) ExportAccountKeystore(arg0 context.Context, arg1 *types.KeystoreParams, arg2 ...grpc.CallOption) (*types.SingleBytes, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportAccountKeystore", varargs...)
+	ret0, _ := ret[0].(*types.SingleBytes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAccountKeystore indicates an expected call of ExportAccountKeystore
+func (mr *MockAergoRPCServiceClientMockRecorder) ExportAccountKeystore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAccountKeystore", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ExportAccountKeystore), varargs...)
+}
+
+// ExportMnemonic mocks base method
+func (m *MockAergoRPCServiceClient) ExportMnemonic(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.HDWallet, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportMnemonic", varargs...)
+	ret0, _ := ret[0].(*types.HDWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMnemonic indicates an expected call of ExportMnemonic
+func (mr *MockAergoRPCServiceClientMockRecorder) ExportMnemonic(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMnemonic", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ExportMnemonic), varargs...)
+}
+
 // GetABI mocks base method
 func (m *MockAergoRPCServiceClient) GetABI(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ABI, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -161,6 +359,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetABI(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetABI", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetABI), varargs...)
 }
 
+// GetAccountTxHistory mocks base method
+func (m *MockAergoRPCServiceClient) GetAccountTxHistory(arg0 context.Context, arg1 *types.AccountTxHistoryParams, arg2 ...grpc.CallOption) (*types.AccountTxHistory, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountTxHistory", varargs...)
+	ret0, _ := ret[0].(*types.AccountTxHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTxHistory indicates an expected call of GetAccountTxHistory
+func (mr *MockAergoRPCServiceClientMockRecorder) GetAccountTxHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTxHistory", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetAccountTxHistory), varargs...)
+}
+
 // GetAccountVotes mocks base method
 func (m *MockAergoRPCServiceClient) GetAccountVotes(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.AccountVoteInfo, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -197,6 +413,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetAccounts(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetAccounts), varargs...)
 }
 
+// GetBaseFee mocks base method
+func (m *MockAergoRPCServiceClient) GetBaseFee(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.BaseFee, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBaseFee", varargs...)
+	ret0, _ := ret[0].(*types.BaseFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBaseFee(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBaseFee), varargs...)
+}
+
 // GetBlock mocks base method
 func (m *MockAergoRPCServiceClient) GetBlock(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Block, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -233,6 +467,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetBlockBody(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockBody", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBlockBody), varargs...)
 }
 
+// GetBlockDetail mocks base method
+func (m *MockAergoRPCServiceClient) GetBlockDetail(arg0 context.Context, arg1 *types.BlockDetailParams, arg2 ...grpc.CallOption) (*types.BlockDetail, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockDetail", varargs...)
+	ret0, _ := ret[0].(*types.BlockDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockDetail indicates an expected call of GetBlockDetail
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBlockDetail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockDetail", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBlockDetail), varargs...)
+}
+
 // GetBlockMetadata mocks base method
 func (m *MockAergoRPCServiceClient) GetBlockMetadata(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.BlockMetadata, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -269,222 +521,546 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetBlockTX(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBlockTX), varargs...)
 }
 
-// GetChainInfo mocks base method
-func (m *MockAergoRPCServiceClient) GetChainInfo(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ChainInfo, error) {
+// GetBlockTemplate mocks base method
+func (m *MockAergoRPCServiceClient) GetBlockTemplate(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.BlockTemplate, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetChainInfo", varargs...)
-	ret0, _ := ret[0].(*types.ChainInfo)
+	ret := m.ctrl.Call(m, "GetBlockTemplate", varargs...)
+	ret0, _ := ret[0].(*types.BlockTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetChainInfo indicates an expected call of GetChainInfo
-func (mr *MockAergoRPCServiceClientMockRecorder) GetChainInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetBlockTemplate indicates an expected call of GetBlockTemplate
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBlockTemplate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetChainInfo), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTemplate", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBlockTemplate), varargs...)
 }
 
-// GetConsensusInfo mocks base method
-func (m *MockAergoRPCServiceClient) GetConsensusInfo(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ConsensusInfo, error) {
+// GetBlocksBulk mocks base method
+func (m *MockAergoRPCServiceClient) GetBlocksBulk(arg0 context.Context, arg1 *types.BulkParams, arg2 ...grpc.CallOption) (*types.BlockBulk, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetConsensusInfo", varargs...)
-	ret0, _ := ret[0].(*types.ConsensusInfo)
+	ret := m.ctrl.Call(m, "GetBlocksBulk", varargs...)
+	ret0, _ := ret[0].(*types.BlockBulk)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetConsensusInfo indicates an expected call of GetConsensusInfo
-func (mr *MockAergoRPCServiceClientMockRecorder) GetConsensusInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetBlocksBulk indicates an expected call of GetBlocksBulk
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBlocksBulk(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsensusInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetConsensusInfo), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksBulk", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBlocksBulk), varargs...)
 }
 
-// GetNameInfo mocks base method
-func (m *MockAergoRPCServiceClient) GetNameInfo(arg0 context.Context, arg1 *types.Name, arg2 ...grpc.CallOption) (*types.NameInfo, error) {
+// GetBridgeProof mocks base method
+func (m *MockAergoRPCServiceClient) GetBridgeProof(arg0 context.Context, arg1 *types.BridgeProofQuery, arg2 ...grpc.CallOption) (*types.BridgeProof, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetNameInfo", varargs...)
-	ret0, _ := ret[0].(*types.NameInfo)
+	ret := m.ctrl.Call(m, "GetBridgeProof", varargs...)
+	ret0, _ := ret[0].(*types.BridgeProof)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNameInfo indicates an expected call of GetNameInfo
-func (mr *MockAergoRPCServiceClientMockRecorder) GetNameInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetBridgeProof indicates an expected call of GetBridgeProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetBridgeProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetNameInfo), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBridgeProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetBridgeProof), varargs...)
 }
 
-// GetPeers mocks base method
-func (m *MockAergoRPCServiceClient) GetPeers(arg0 context.Context, arg1 *types.PeersParams, arg2 ...grpc.CallOption) (*types.PeerList, error) {
+// GetChainInfo mocks base method
+func (m *MockAergoRPCServiceClient) GetChainInfo(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ChainInfo, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPeers", varargs...)
-	ret0, _ := ret[0].(*types.PeerList)
+	ret := m.ctrl.Call(m, "GetChainInfo", varargs...)
+	ret0, _ := ret[0].(*types.ChainInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPeers indicates an expected call of GetPeers
-func (mr *MockAergoRPCServiceClientMockRecorder) GetPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetChainInfo indicates an expected call of GetChainInfo
+func (mr *MockAergoRPCServiceClientMockRecorder) GetChainInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetPeers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetChainInfo), varargs...)
 }
 
-// GetReceipt mocks base method
-func (m *MockAergoRPCServiceClient) GetReceipt(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Receipt, error) {
+// GetClusterStatus mocks base method
+func (m *MockAergoRPCServiceClient) GetClusterStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ClusterStatus, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetReceipt", varargs...)
-	ret0, _ := ret[0].(*types.Receipt)
+	ret := m.ctrl.Call(m, "GetClusterStatus", varargs...)
+	ret0, _ := ret[0].(*types.ClusterStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReceipt indicates an expected call of GetReceipt
-func (mr *MockAergoRPCServiceClientMockRecorder) GetReceipt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetClusterStatus indicates an expected call of GetClusterStatus
+func (mr *MockAergoRPCServiceClientMockRecorder) GetClusterStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceipt), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStatus", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetClusterStatus), varargs...)
 }
 
-// GetServerInfo mocks base method
-func (m *MockAergoRPCServiceClient) GetServerInfo(arg0 context.Context, arg1 *types.KeyParams, arg2 ...grpc.CallOption) (*types.ServerInfo, error) {
+// GetConsensusInfo mocks base method
+func (m *MockAergoRPCServiceClient) GetConsensusInfo(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ConsensusInfo, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetServerInfo", varargs...)
-	ret0, _ := ret[0].(*types.ServerInfo)
+	ret := m.ctrl.Call(m, "GetConsensusInfo", varargs...)
+	ret0, _ := ret[0].(*types.ConsensusInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetServerInfo indicates an expected call of GetServerInfo
-func (mr *MockAergoRPCServiceClientMockRecorder) GetServerInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetConsensusInfo indicates an expected call of GetConsensusInfo
+func (mr *MockAergoRPCServiceClientMockRecorder) GetConsensusInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetServerInfo), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsensusInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetConsensusInfo), varargs...)
 }
 
-// GetStaking mocks base method
-func (m *MockAergoRPCServiceClient) GetStaking(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.Staking, error) {
+// GetContractABI mocks base method
+func (m *MockAergoRPCServiceClient) GetContractABI(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ContractABI, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetStaking", varargs...)
-	ret0, _ := ret[0].(*types.Staking)
+	ret := m.ctrl.Call(m, "GetContractABI", varargs...)
+	ret0, _ := ret[0].(*types.ContractABI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStaking indicates an expected call of GetStaking
-func (mr *MockAergoRPCServiceClientMockRecorder) GetStaking(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetContractABI indicates an expected call of GetContractABI
+func (mr *MockAergoRPCServiceClientMockRecorder) GetContractABI(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaking", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStaking), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractABI", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetContractABI), varargs...)
 }
 
-// GetState mocks base method
-func (m *MockAergoRPCServiceClient) GetState(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.State, error) {
+// GetContractStorageUsage mocks base method
+func (m *MockAergoRPCServiceClient) GetContractStorageUsage(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.ContractStorageUsage, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetState", varargs...)
-	ret0, _ := ret[0].(*types.State)
+	ret := m.ctrl.Call(m, "GetContractStorageUsage", varargs...)
+	ret0, _ := ret[0].(*types.ContractStorageUsage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetState indicates an expected call of GetState
-func (mr *MockAergoRPCServiceClientMockRecorder) GetState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetContractStorageUsage indicates an expected call of GetContractStorageUsage
+func (mr *MockAergoRPCServiceClientMockRecorder) GetContractStorageUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorageUsage", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetContractStorageUsage), varargs...)
 }
 
-// GetStateAndProof mocks base method
-func (m *MockAergoRPCServiceClient) GetStateAndProof(arg0 context.Context, arg1 *types.AccountAndRoot, arg2 ...grpc.CallOption) (*types.AccountProof, error) {
+// GetElectionTally mocks base method
+func (m *MockAergoRPCServiceClient) GetElectionTally(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.ElectionTally, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetStateAndProof", varargs...)
-	ret0, _ := ret[0].(*types.AccountProof)
+	ret := m.ctrl.Call(m, "GetElectionTally", varargs...)
+	ret0, _ := ret[0].(*types.ElectionTally)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStateAndProof indicates an expected call of GetStateAndProof
-func (mr *MockAergoRPCServiceClientMockRecorder) GetStateAndProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetElectionTally indicates an expected call of GetElectionTally
+func (mr *MockAergoRPCServiceClientMockRecorder) GetElectionTally(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateAndProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStateAndProof), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElectionTally", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetElectionTally), varargs...)
 }
 
-// GetTX mocks base method
-func (m *MockAergoRPCServiceClient) GetTX(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Tx, error) {
+// GetInternalOperations mocks base method
+func (m *MockAergoRPCServiceClient) GetInternalOperations(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.InternalOperations, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetTX", varargs...)
-	ret0, _ := ret[0].(*types.Tx)
+	ret := m.ctrl.Call(m, "GetInternalOperations", varargs...)
+	ret0, _ := ret[0].(*types.InternalOperations)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTX indicates an expected call of GetTX
-func (mr *MockAergoRPCServiceClientMockRecorder) GetTX(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetInternalOperations indicates an expected call of GetInternalOperations
+func (mr *MockAergoRPCServiceClientMockRecorder) GetInternalOperations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetTX), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalOperations", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetInternalOperations), varargs...)
 }
 
-// GetVotes mocks base method
-func (m *MockAergoRPCServiceClient) GetVotes(arg0 context.Context, arg1 *types.VoteParams, arg2 ...grpc.CallOption) (*types.VoteList, error) {
+// GetLogLevels mocks base method
+func (m *MockAergoRPCServiceClient) GetLogLevels(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.LogLevelList, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVotes", varargs...)
-	ret0, _ := ret[0].(*types.VoteList)
+	ret := m.ctrl.Call(m, "GetLogLevels", varargs...)
+	ret0, _ := ret[0].(*types.LogLevelList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetVotes indicates an expected call of GetVotes
-func (mr *MockAergoRPCServiceClientMockRecorder) GetVotes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// GetLogLevels indicates an expected call of GetLogLevels
+func (mr *MockAergoRPCServiceClientMockRecorder) GetLogLevels(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotes", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetVotes), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLevels", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetLogLevels), varargs...)
 }
 
-// ImportAccount mocks base method
-func (m *MockAergoRPCServiceClient) ImportAccount(arg0 context.Context, arg1 *types.ImportFormat, arg2 ...grpc.CallOption) (*types.Account, error) {
+// GetNameInfo mocks base method
+func (m *MockAergoRPCServiceClient) GetNameInfo(arg0 context.Context, arg1 *types.Name, arg2 ...grpc.CallOption) (*types.NameInfo, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ImportAccount", varargs...)
-	ret0, _ := ret[0].(*types.Account)
+	ret := m.ctrl.Call(m, "GetNameInfo", varargs...)
+	ret0, _ := ret[0].(*types.NameInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ImportAccount indicates an expected call of ImportAccount
+// GetNameInfo indicates an expected call of GetNameInfo
+func (mr *MockAergoRPCServiceClientMockRecorder) GetNameInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetNameInfo), varargs...)
+}
+
+// GetPeers mocks base method
+func (m *MockAergoRPCServiceClient) GetPeers(arg0 context.Context, arg1 *types.PeersParams, arg2 ...grpc.CallOption) (*types.PeerList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPeers", varargs...)
+	ret0, _ := ret[0].(*types.PeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeers indicates an expected call of GetPeers
+func (mr *MockAergoRPCServiceClientMockRecorder) GetPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeers", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetPeers), varargs...)
+}
+
+// GetPendingWithdrawals mocks base method
+func (m *MockAergoRPCServiceClient) GetPendingWithdrawals(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.WithdrawalList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPendingWithdrawals", varargs...)
+	ret0, _ := ret[0].(*types.WithdrawalList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingWithdrawals indicates an expected call of GetPendingWithdrawals
+func (mr *MockAergoRPCServiceClientMockRecorder) GetPendingWithdrawals(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingWithdrawals", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetPendingWithdrawals), varargs...)
+}
+
+// GetQuorumStatus mocks base method
+func (m *MockAergoRPCServiceClient) GetQuorumStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.QuorumStatusList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetQuorumStatus", varargs...)
+	ret0, _ := ret[0].(*types.QuorumStatusList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuorumStatus indicates an expected call of GetQuorumStatus
+func (mr *MockAergoRPCServiceClientMockRecorder) GetQuorumStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuorumStatus", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetQuorumStatus), varargs...)
+}
+
+// GetReceipt mocks base method
+func (m *MockAergoRPCServiceClient) GetReceipt(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Receipt, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReceipt", varargs...)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceipt indicates an expected call of GetReceipt
+func (mr *MockAergoRPCServiceClientMockRecorder) GetReceipt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetReceipt), varargs...)
+}
+
+// GetServerInfo mocks base method
+func (m *MockAergoRPCServiceClient) GetServerInfo(arg0 context.Context, arg1 *types.KeyParams, arg2 ...grpc.CallOption) (*types.ServerInfo, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServerInfo", varargs...)
+	ret0, _ := ret[0].(*types.ServerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerInfo indicates an expected call of GetServerInfo
+func (mr *MockAergoRPCServiceClientMockRecorder) GetServerInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetServerInfo), varargs...)
+}
+
+// GetStaking mocks base method
+func (m *MockAergoRPCServiceClient) GetStaking(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.Staking, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStaking", varargs...)
+	ret0, _ := ret[0].(*types.Staking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaking indicates an expected call of GetStaking
+func (mr *MockAergoRPCServiceClientMockRecorder) GetStaking(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaking", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStaking), varargs...)
+}
+
+// GetState mocks base method
+func (m *MockAergoRPCServiceClient) GetState(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.State, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetState", varargs...)
+	ret0, _ := ret[0].(*types.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState
+func (mr *MockAergoRPCServiceClientMockRecorder) GetState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetState), varargs...)
+}
+
+// GetStateAndProof mocks base method
+func (m *MockAergoRPCServiceClient) GetStateAndProof(arg0 context.Context, arg1 *types.AccountAndRoot, arg2 ...grpc.CallOption) (*types.AccountProof, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStateAndProof", varargs...)
+	ret0, _ := ret[0].(*types.AccountProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateAndProof indicates an expected call of GetStateAndProof
+func (mr *MockAergoRPCServiceClientMockRecorder) GetStateAndProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateAndProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStateAndProof), varargs...)
+}
+
+// GetSystemAccountInfo mocks base method
+func (m *MockAergoRPCServiceClient) GetSystemAccountInfo(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.SystemAccountInfo, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemAccountInfo", varargs...)
+	ret0, _ := ret[0].(*types.SystemAccountInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemAccountInfo indicates an expected call of GetSystemAccountInfo
+func (mr *MockAergoRPCServiceClientMockRecorder) GetSystemAccountInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemAccountInfo", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetSystemAccountInfo), varargs...)
+}
+
+// GetTX mocks base method
+func (m *MockAergoRPCServiceClient) GetTX(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Tx, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTX", varargs...)
+	ret0, _ := ret[0].(*types.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTX indicates an expected call of GetTX
+func (mr *MockAergoRPCServiceClientMockRecorder) GetTX(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetTX), varargs...)
+}
+
+// GetTxsBulk mocks base method
+func (m *MockAergoRPCServiceClient) GetTxsBulk(arg0 context.Context, arg1 *types.BulkParams, arg2 ...grpc.CallOption) (*types.TxBulk, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTxsBulk", varargs...)
+	ret0, _ := ret[0].(*types.TxBulk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsBulk indicates an expected call of GetTxsBulk
+func (mr *MockAergoRPCServiceClientMockRecorder) GetTxsBulk(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsBulk", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetTxsBulk), varargs...)
+}
+
+// GetUnlockStatus mocks base method
+func (m *MockAergoRPCServiceClient) GetUnlockStatus(arg0 context.Context, arg1 *types.Account, arg2 ...grpc.CallOption) (*types.UnlockStatus, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUnlockStatus", varargs...)
+	ret0, _ := ret[0].(*types.UnlockStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnlockStatus indicates an expected call of GetUnlockStatus
+func (mr *MockAergoRPCServiceClientMockRecorder) GetUnlockStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnlockStatus", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetUnlockStatus), varargs...)
+}
+
+// GetVotes mocks base method
+func (m *MockAergoRPCServiceClient) GetVotes(arg0 context.Context, arg1 *types.VoteParams, arg2 ...grpc.CallOption) (*types.VoteList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVotes", varargs...)
+	ret0, _ := ret[0].(*types.VoteList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVotes indicates an expected call of GetVotes
+func (mr *MockAergoRPCServiceClientMockRecorder) GetVotes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVotes", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetVotes), varargs...)
+}
+
+// ImportAccount mocks base method
+func (m *MockAergoRPCServiceClient) ImportAccount(arg0 context.Context, arg1 *types.ImportFormat, arg2 ...grpc.CallOption) (*types.Account, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportAccount", varargs...)
+	ret0, _ := ret[0].(*types.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccount indicates an expected call of ImportAccount
 func (mr *MockAergoRPCServiceClientMockRecorder) ImportAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ImportAccount), varargs...)
 }
 
+// ImportAccountKeystore mocks base method
+func (m *MockAergoRPCServiceClient) ImportAccountKeystore(arg0 context.Context, arg1 *types.ImportFormat, arg2 ...grpc.CallOption) (*types.Account, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportAccountKeystore", varargs...)
+	ret0, _ := ret[0].(*types.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccountKeystore indicates an expected call of ImportAccountKeystore
+func (mr *MockAergoRPCServiceClientMockRecorder) ImportAccountKeystore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccountKeystore", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ImportAccountKeystore), varargs...)
+}
+
+// ListAliases mocks base method
+func (m *MockAergoRPCServiceClient) ListAliases(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.AliasList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAliases", varargs...)
+	ret0, _ := ret[0].(*types.AliasList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAliases indicates an expected call of ListAliases
+func (mr *MockAergoRPCServiceClientMockRecorder) ListAliases(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListAliases), varargs...)
+}
+
+// ListBannedPeers mocks base method
+func (m *MockAergoRPCServiceClient) ListBannedPeers(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.BannedPeerList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBannedPeers", varargs...)
+	ret0, _ := ret[0].(*types.BannedPeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBannedPeers indicates an expected call of ListBannedPeers
+func (mr *MockAergoRPCServiceClientMockRecorder) ListBannedPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBannedPeers", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListBannedPeers), varargs...)
+}
+
+// ListBlockDetailStream mocks base method
+func (m *MockAergoRPCServiceClient) ListBlockDetailStream(arg0 context.Context, arg1 *types.BlockStreamParams, arg2 ...grpc.CallOption) (types.AergoRPCService_ListBlockDetailStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBlockDetailStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListBlockDetailStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockDetailStream indicates an expected call of ListBlockDetailStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListBlockDetailStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockDetailStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListBlockDetailStream), varargs...)
+}
+
 // ListBlockHeaders mocks base method
 func (m *MockAergoRPCServiceClient) ListBlockHeaders(arg0 context.Context, arg1 *types.ListParams, arg2 ...grpc.CallOption) (*types.BlockHeaderList, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -557,6 +1133,78 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ListBlockStream(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListBlockStream), varargs...)
 }
 
+// ListConsensusEventStream mocks base method
+func (m *MockAergoRPCServiceClient) ListConsensusEventStream(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (types.AergoRPCService_ListConsensusEventStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConsensusEventStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListConsensusEventStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsensusEventStream indicates an expected call of ListConsensusEventStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListConsensusEventStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsensusEventStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListConsensusEventStream), varargs...)
+}
+
+// ListContractStorage mocks base method
+func (m *MockAergoRPCServiceClient) ListContractStorage(arg0 context.Context, arg1 *types.StorageListParams, arg2 ...grpc.CallOption) (*types.StorageList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListContractStorage", varargs...)
+	ret0, _ := ret[0].(*types.StorageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContractStorage indicates an expected call of ListContractStorage
+func (mr *MockAergoRPCServiceClientMockRecorder) ListContractStorage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContractStorage", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListContractStorage), varargs...)
+}
+
+// ListEventFilterStream mocks base method
+func (m *MockAergoRPCServiceClient) ListEventFilterStream(arg0 context.Context, arg1 *types.EventStreamFilter, arg2 ...grpc.CallOption) (types.AergoRPCService_ListEventFilterStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventFilterStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListEventFilterStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventFilterStream indicates an expected call of ListEventFilterStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListEventFilterStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventFilterStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListEventFilterStream), varargs...)
+}
+
+// ListEventPage mocks base method
+func (m *MockAergoRPCServiceClient) ListEventPage(arg0 context.Context, arg1 *types.EventListParams, arg2 ...grpc.CallOption) (*types.EventPage, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventPage", varargs...)
+	ret0, _ := ret[0].(*types.EventPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventPage indicates an expected call of ListEventPage
+func (mr *MockAergoRPCServiceClientMockRecorder) ListEventPage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventPage", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListEventPage), varargs...)
+}
+
 // ListEventStream mocks base method
 func (m *MockAergoRPCServiceClient) ListEventStream(arg0 context.Context, arg1 *types.FilterInfo, arg2 ...grpc.CallOption) (types.AergoRPCService_ListEventStreamClient, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -593,6 +1241,132 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ListEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListEvents), varargs...)
 }
 
+// ListHDAccounts mocks base method
+func (m *MockAergoRPCServiceClient) ListHDAccounts(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.HDAccountList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHDAccounts", varargs...)
+	ret0, _ := ret[0].(*types.HDAccountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHDAccounts indicates an expected call of ListHDAccounts
+func (mr *MockAergoRPCServiceClientMockRecorder) ListHDAccounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHDAccounts", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListHDAccounts), varargs...)
+}
+
+// ListIndexedEvents mocks base method
+func (m *MockAergoRPCServiceClient) ListIndexedEvents(arg0 context.Context, arg1 *types.EventListParams, arg2 ...grpc.CallOption) (*types.EventPage, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIndexedEvents", varargs...)
+	ret0, _ := ret[0].(*types.EventPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIndexedEvents indicates an expected call of ListIndexedEvents
+func (mr *MockAergoRPCServiceClientMockRecorder) ListIndexedEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIndexedEvents", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListIndexedEvents), varargs...)
+}
+
+// ListNameOffers mocks base method
+func (m *MockAergoRPCServiceClient) ListNameOffers(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.NameInfoList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNameOffers", varargs...)
+	ret0, _ := ret[0].(*types.NameInfoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNameOffers indicates an expected call of ListNameOffers
+func (mr *MockAergoRPCServiceClientMockRecorder) ListNameOffers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNameOffers", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListNameOffers), varargs...)
+}
+
+// ListSignAudits mocks base method
+func (m *MockAergoRPCServiceClient) ListSignAudits(arg0 context.Context, arg1 *types.SignAuditQuery, arg2 ...grpc.CallOption) (*types.SignAuditList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSignAudits", varargs...)
+	ret0, _ := ret[0].(*types.SignAuditList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSignAudits indicates an expected call of ListSignAudits
+func (mr *MockAergoRPCServiceClientMockRecorder) ListSignAudits(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSignAudits", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListSignAudits), varargs...)
+}
+
+// ListStateDiffStream mocks base method
+func (m *MockAergoRPCServiceClient) ListStateDiffStream(arg0 context.Context, arg1 *types.StateDiffParams, arg2 ...grpc.CallOption) (types.AergoRPCService_ListStateDiffStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStateDiffStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListStateDiffStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStateDiffStream indicates an expected call of ListStateDiffStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListStateDiffStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStateDiffStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListStateDiffStream), varargs...)
+}
+
+// ListWatchAccountTxStream mocks base method
+func (m *MockAergoRPCServiceClient) ListWatchAccountTxStream(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (types.AergoRPCService_ListWatchAccountTxStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWatchAccountTxStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListWatchAccountTxStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWatchAccountTxStream indicates an expected call of ListWatchAccountTxStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListWatchAccountTxStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWatchAccountTxStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListWatchAccountTxStream), varargs...)
+}
+
+// ListWatchAccounts mocks base method
+func (m *MockAergoRPCServiceClient) ListWatchAccounts(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.WatchAccountList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWatchAccounts", varargs...)
+	ret0, _ := ret[0].(*types.WatchAccountList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWatchAccounts indicates an expected call of ListWatchAccounts
+func (mr *MockAergoRPCServiceClientMockRecorder) ListWatchAccounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWatchAccounts", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListWatchAccounts), varargs...)
+}
+
 // LockAccount mocks base method
 func (m *MockAergoRPCServiceClient) LockAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.Account, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -683,6 +1457,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) QueryContractState(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContractState", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).QueryContractState), varargs...)
 }
 
+// RemoveWatchAccount mocks base method
+func (m *MockAergoRPCServiceClient) RemoveWatchAccount(arg0 context.Context, arg1 *types.Account, arg2 ...grpc.CallOption) (*types.WatchAccount, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveWatchAccount", varargs...)
+	ret0, _ := ret[0].(*types.WatchAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveWatchAccount indicates an expected call of RemoveWatchAccount
+func (mr *MockAergoRPCServiceClientMockRecorder) RemoveWatchAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWatchAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).RemoveWatchAccount), varargs...)
+}
+
 // SendTX mocks base method
 func (m *MockAergoRPCServiceClient) SendTX(arg0 context.Context, arg1 *types.Tx, arg2 ...grpc.CallOption) (*types.CommitResult, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -701,6 +1493,60 @@ func (mr *MockAergoRPCServiceClientMockRecorder) SendTX(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SendTX), varargs...)
 }
 
+// SetAlias mocks base method
+func (m *MockAergoRPCServiceClient) SetAlias(arg0 context.Context, arg1 *types.Alias, arg2 ...grpc.CallOption) (*types.Alias, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetAlias", varargs...)
+	ret0, _ := ret[0].(*types.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAlias indicates an expected call of SetAlias
+func (mr *MockAergoRPCServiceClientMockRecorder) SetAlias(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAlias", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SetAlias), varargs...)
+}
+
+// SetLogLevel mocks base method
+func (m *MockAergoRPCServiceClient) SetLogLevel(arg0 context.Context, arg1 *types.LogLevel, arg2 ...grpc.CallOption) (*types.LogLevelList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetLogLevel", varargs...)
+	ret0, _ := ret[0].(*types.LogLevelList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLogLevel indicates an expected call of SetLogLevel
+func (mr *MockAergoRPCServiceClientMockRecorder) SetLogLevel(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogLevel", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SetLogLevel), varargs...)
+}
+
+// SetProfiling mocks base method
+func (m *MockAergoRPCServiceClient) SetProfiling(arg0 context.Context, arg1 *types.Profiling, arg2 ...grpc.CallOption) (*types.Profiling, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetProfiling", varargs...)
+	ret0, _ := ret[0].(*types.Profiling)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProfiling indicates an expected call of SetProfiling
+func (mr *MockAergoRPCServiceClientMockRecorder) SetProfiling(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProfiling", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SetProfiling), varargs...)
+}
+
 // SignTX mocks base method
 func (m *MockAergoRPCServiceClient) SignTX(arg0 context.Context, arg1 *types.Tx, arg2 ...grpc.CallOption) (*types.Tx, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -719,6 +1565,78 @@ func (mr *MockAergoRPCServiceClientMockRecorder) SignTX(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SignTX), varargs...)
 }
 
+// SimulateSystemTx mocks base method
+func (m *MockAergoRPCServiceClient) SimulateSystemTx(arg0 context.Context, arg1 *types.Tx, arg2 ...grpc.CallOption) (*types.SystemTxSimulation, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateSystemTx", varargs...)
+	ret0, _ := ret[0].(*types.SystemTxSimulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateSystemTx indicates an expected call of SimulateSystemTx
+func (mr *MockAergoRPCServiceClientMockRecorder) SimulateSystemTx(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateSystemTx", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SimulateSystemTx), varargs...)
+}
+
+// SubmitBlock mocks base method
+func (m *MockAergoRPCServiceClient) SubmitBlock(arg0 context.Context, arg1 *types.Block, arg2 ...grpc.CallOption) (*types.BlockMetadata, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitBlock", varargs...)
+	ret0, _ := ret[0].(*types.BlockMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitBlock indicates an expected call of SubmitBlock
+func (mr *MockAergoRPCServiceClientMockRecorder) SubmitBlock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBlock", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SubmitBlock), varargs...)
+}
+
+// TransferLeader mocks base method
+func (m *MockAergoRPCServiceClient) TransferLeader(arg0 context.Context, arg1 *types.MemberAttr, arg2 ...grpc.CallOption) (*types.MemberAttr, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferLeader", varargs...)
+	ret0, _ := ret[0].(*types.MemberAttr)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeader indicates an expected call of TransferLeader
+func (mr *MockAergoRPCServiceClientMockRecorder) TransferLeader(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).TransferLeader), varargs...)
+}
+
+// UnbanPeer mocks base method
+func (m *MockAergoRPCServiceClient) UnbanPeer(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.BannedPeerList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnbanPeer", varargs...)
+	ret0, _ := ret[0].(*types.BannedPeerList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanPeer indicates an expected call of UnbanPeer
+func (mr *MockAergoRPCServiceClientMockRecorder) UnbanPeer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanPeer", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).UnbanPeer), varargs...)
+}
+
 // UnlockAccount mocks base method
 func (m *MockAergoRPCServiceClient) UnlockAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.Account, error) {
 	varargs := []interface{}{arg0, arg1}
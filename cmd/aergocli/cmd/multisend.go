@@ -0,0 +1,289 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var multisendCmd = &cobra.Command{
+	Use:   "multisend",
+	Short: "Send the transfers listed in a CSV file",
+	Long: "Send the transfers listed in a CSV file of recipient and amount rows. " +
+		"The status of each row is recorded in the status file, and --resume sends only the rows " +
+		"which have not been accepted yet.",
+	Args: cobra.MinimumNArgs(0),
+	RunE: execMultisend,
+}
+
+var (
+	multisendFile   string
+	multisendStatus string
+	multisendBatch  int
+	multisendResume bool
+)
+
+const multisendStatusOK = "TX_OK"
+
+func init() {
+	rootCmd.AddCommand(multisendCmd)
+	multisendCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	multisendCmd.MarkFlagRequired("from")
+	multisendCmd.Flags().StringVar(&multisendFile, "csv", "", "CSV file of recipient and amount rows")
+	multisendCmd.MarkFlagRequired("csv")
+	multisendCmd.Flags().StringVar(&multisendStatus, "status", "", "File to record the status of the rows (default: the CSV file name with .status)")
+	multisendCmd.Flags().IntVar(&multisendBatch, "batch", 100, "Number of transactions committed at once")
+	multisendCmd.Flags().BoolVar(&multisendResume, "resume", false, "Send only the rows not accepted in the previous run")
+	multisendCmd.Flags().StringVar(&privKey, "key", "", "Base58 encoded key to sign with (default: sign in the node)")
+}
+
+// transferRow is a row of the multisend CSV and its status.
+type transferRow struct {
+	line      int
+	recipient string
+	amount    string
+	nonce     uint64
+	txHash    string
+	status    string
+}
+
+func execMultisend(cmd *cobra.Command, args []string) error {
+	if multisendBatch <= 0 {
+		return errors.New("--batch must be positive")
+	}
+	account, err := decodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	rows, err := readTransferRows(multisendFile)
+	if err != nil {
+		return err
+	}
+	statusFile := multisendStatus
+	if statusFile == "" {
+		statusFile = multisendFile + ".status"
+	}
+	if multisendResume {
+		if err = loadTransferStatus(statusFile, rows); err != nil {
+			return err
+		}
+	}
+	sign, err := newTxSigner()
+	if err != nil {
+		return err
+	}
+
+	status, err := client.Blockchain(context.Background(), &types.Empty{})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	state, err := client.GetState(context.Background(), &types.SingleBytes{Value: account})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	nonce := state.GetNonce()
+
+	// the nonces of the rows accepted before are skipped since their txs may
+	// still wait for a failed row in the mempool
+	used := make(map[uint64]bool)
+	var pending []*transferRow
+	for _, row := range rows {
+		if row.status != multisendStatusOK {
+			pending = append(pending, row)
+		} else {
+			used[row.nonce] = true
+		}
+	}
+	defer saveTransferStatus(statusFile, rows)
+	cmd.Printf("sending %d of %d rows, %s in total\n", len(pending), len(rows), util.NewAmount(totalAmount(pending)))
+
+	for start := 0; start < len(pending); start += multisendBatch {
+		end := start + multisendBatch
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+		txs := make([]*types.Tx, len(batch))
+		for i, row := range batch {
+			recipient, _ := types.DecodeAddress(row.recipient)
+			amount, _ := util.ParseUnit(row.amount)
+			for nonce++; used[nonce]; nonce++ {
+			}
+			row.nonce = nonce
+			tx, err := sign(&types.Tx{Body: &types.TxBody{
+				Nonce:       nonce,
+				Account:     account,
+				Recipient:   recipient,
+				Amount:      amount.Bytes(),
+				Type:        types.TxType_NORMAL,
+				ChainIdHash: status.BestChainIdHash,
+			}})
+			if err != nil {
+				return fmt.Errorf("failed to sign the row of line %d: %s", row.line, err.Error())
+			}
+			txs[i] = tx
+		}
+		results, err := client.CommitTX(context.Background(), &types.TxList{Txs: txs})
+		if err != nil {
+			return errors.New("Failed request to aergo server\n" + err.Error())
+		}
+		failed := false
+		for i, row := range batch {
+			row.txHash = base58.Encode(txs[i].Hash)
+			row.status = "NO_RESULT"
+			if i < len(results.GetResults()) {
+				result := results.Results[i]
+				row.status = result.Error.String()
+				if result.Detail != "" {
+					row.status += ": " + result.Detail
+				}
+			}
+			if row.status != multisendStatusOK {
+				failed = true
+			}
+			cmd.Printf("%d\t%s\t%s\t%d\t%s\t%s\n", row.line, row.recipient, row.amount, row.nonce, row.txHash, row.status)
+		}
+		// the nonces of the following rows would not be contiguous
+		if failed {
+			return errors.New("stopped at a failed row; fix it and run again with --resume")
+		}
+	}
+	return nil
+}
+
+// readTransferRows reads the rows of recipient and amount. A header row and
+// lines starting with # are skipped.
+func readTransferRows(path string) ([]*transferRow, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*transferRow
+	for i, text := range strings.Split(string(b), "\n") {
+		line := i + 1
+		text = strings.TrimSpace(text)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		record, err := csv.NewReader(strings.NewReader(text)).Read()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err.Error())
+		}
+		if len(record) != 2 {
+			return nil, fmt.Errorf("line %d: need recipient and amount", line)
+		}
+		recipient, amount := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if len(rows) == 0 && strings.EqualFold(recipient, "recipient") {
+			continue
+		}
+		if _, err := types.DecodeAddress(recipient); err != nil {
+			return nil, fmt.Errorf("line %d: wrong recipient %s: %s", line, recipient, err.Error())
+		}
+		if _, err := util.ParseUnit(amount); err != nil {
+			return nil, fmt.Errorf("line %d: wrong amount %s: %s", line, amount, err.Error())
+		}
+		rows = append(rows, &transferRow{line: line, recipient: recipient, amount: amount})
+	}
+	return rows, nil
+}
+
+// loadTransferStatus restores the status of the rows saved by the previous
+// run. The rows are matched by the line, recipient and amount so that a
+// changed row is sent again.
+func loadTransferStatus(path string, rows []*transferRow) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	byLine := make(map[int]*transferRow, len(rows))
+	for _, row := range rows {
+		byLine[row.line] = row
+	}
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if len(record) != 6 {
+			continue
+		}
+		line, err := strconv.Atoi(record[0])
+		if err != nil {
+			continue
+		}
+		nonce, err := strconv.ParseUint(record[3], 10, 64)
+		if err != nil {
+			continue
+		}
+		if row, ok := byLine[line]; ok && row.recipient == record[1] && row.amount == record[2] {
+			row.nonce, row.txHash, row.status = nonce, record[4], record[5]
+		}
+	}
+	return nil
+}
+
+func saveTransferStatus(path string, rows []*transferRow) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"line", "recipient", "amount", "nonce", "txHash", "status"})
+	for _, row := range rows {
+		w.Write([]string{strconv.Itoa(row.line), row.recipient, row.amount,
+			strconv.FormatUint(row.nonce, 10), row.txHash, row.status})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// newTxSigner returns a function signing txs with --key, or in the node.
+func newTxSigner() (func(tx *types.Tx) (*types.Tx, error), error) {
+	if privKey == "" {
+		return func(tx *types.Tx) (*types.Tx, error) {
+			return client.SignTX(context.Background(), tx)
+		}, nil
+	}
+	rawKey, err := base58.Decode(privKey)
+	if err != nil {
+		return nil, errors.New("Wrong key in --key flag\n" + err.Error())
+	}
+	signKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), rawKey)
+	return func(tx *types.Tx) (*types.Tx, error) {
+		return tx, key.SignTx(tx, signKey)
+	}, nil
+}
+
+// totalAmount returns the sum of the amounts of the rows.
+func totalAmount(rows []*transferRow) *big.Int {
+	total := new(big.Int)
+	for _, row := range rows {
+		amount, _ := util.ParseUnit(row.amount)
+		total.Add(total, amount)
+	}
+	return total
+}
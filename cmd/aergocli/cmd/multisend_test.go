@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestMultisendWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		from, privKey, multisendFile, multisendStatus, multisendBatch, multisendResume = "", "", "", "", 100, false
+	}()
+
+	const testAddr = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	signKey, err := btcec.NewPrivateKey(btcec.S256())
+	assert.NoError(t, err)
+	sender := types.EncodeAddress(key.GenerateAddress(&signKey.PublicKey))
+
+	dir, err := ioutil.TempDir("", "multisend")
+	assert.NoError(t, err, "could not create temp dir")
+	defer os.RemoveAll(dir)
+	csvFile := filepath.Join(dir, "transfers.csv")
+	rows := "recipient,amount\n" + testAddr + ",1aergo\n# skipped\n" + testAddr + ",2\n" + testAddr + ",3\n"
+	assert.NoError(t, ioutil.WriteFile(csvFile, []byte(rows), 0600))
+
+	commit := func(statuses ...types.CommitStatus) func(ctx context.Context, in *types.TxList, opts ...grpc.CallOption) (*types.CommitResultList, error) {
+		return func(ctx context.Context, in *types.TxList, opts ...grpc.CallOption) (*types.CommitResultList, error) {
+			assert.Len(t, in.Txs, len(statuses), "batch size")
+			results := &types.CommitResultList{}
+			for i, tx := range in.Txs {
+				assert.NoError(t, key.VerifyTx(tx), "signed with --key")
+				results.Results = append(results.Results, &types.CommitResult{Hash: tx.Hash, Error: statuses[i]})
+			}
+			return results, nil
+		}
+	}
+	var nonces []uint64
+	record := func(in *types.TxList) {
+		for _, tx := range in.Txs {
+			nonces = append(nonces, tx.Body.Nonce)
+		}
+	}
+
+	// the second row fails in the first batch, so the last row is not sent
+	mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{}, nil).Times(2)
+	gomock.InOrder(
+		mock.EXPECT().GetState(gomock.Any(), gomock.Any()).Return(&types.State{Nonce: 4}, nil).Times(1),
+		mock.EXPECT().CommitTX(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *types.TxList, opts ...grpc.CallOption) (*types.CommitResultList, error) {
+				record(in)
+				return commit(types.CommitStatus_TX_OK, types.CommitStatus_TX_INSUFFICIENT_BALANCE)(ctx, in, opts...)
+			}).Times(1),
+		mock.EXPECT().GetState(gomock.Any(), gomock.Any()).Return(&types.State{Nonce: 5}, nil).Times(1),
+		mock.EXPECT().CommitTX(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *types.TxList, opts ...grpc.CallOption) (*types.CommitResultList, error) {
+				record(in)
+				return commit(types.CommitStatus_TX_OK, types.CommitStatus_TX_OK)(ctx, in, opts...)
+			}).Times(1),
+	)
+
+	encodedKey := base58.Encode(signKey.Serialize())
+	output, err := executeCommand(rootCmd, "multisend", "--from", sender, "--csv", csvFile, "--batch", "2", "--key", encodedKey)
+	assert.Error(t, err, "stops at the failed row")
+	assert.Contains(t, output, "sending 3 of 3 rows, 1.000000000000000005 aergo in total", "summary")
+	assert.Contains(t, output, "TX_INSUFFICIENT_BALANCE", "status of the failed row")
+
+	status, err := ioutil.ReadFile(csvFile + ".status")
+	assert.NoError(t, err, "status file")
+	lines := strings.Split(strings.TrimSpace(string(status)), "\n")
+	assert.Len(t, lines, 4, "header and rows")
+	assert.True(t, strings.HasSuffix(lines[1], ",TX_OK"), "first row")
+	assert.True(t, strings.HasSuffix(lines[2], ",TX_INSUFFICIENT_BALANCE"), "second row")
+	assert.True(t, strings.HasPrefix(lines[3], "5,"+testAddr+",3,0,,"), "third row not sent")
+
+	output, err = executeCommand(rootCmd, "multisend", "--from", sender, "--csv", csvFile, "--batch", "2", "--key", encodedKey, "--resume")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "sending 2 of 3 rows, 5 aer in total", "only the rows not accepted")
+	assert.Equal(t, []uint64{5, 6, 6, 7}, nonces, "the nonce of the accepted row is not reused")
+}
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"log"
 	"math/big"
+	"strconv"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -23,6 +24,8 @@ var nameCmd = &cobra.Command{
 }
 var spending string
 var blockNo uint64
+var price string
+var buyer string
 
 func init() {
 	rootCmd.AddCommand(nameCmd)
@@ -62,11 +65,68 @@ func init() {
 	ownerCmd.MarkFlagRequired("name")
 	ownerCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height")
 
-	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd)
+	renewCmd := &cobra.Command{
+		Use:                   "renew",
+		Short:                 "Extend the registration of account name. It spend at least 1 aergo",
+		RunE:                  execNameRenew,
+		DisableFlagsInUseLine: true,
+	}
+	renewCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	renewCmd.MarkFlagRequired("from")
+	renewCmd.Flags().StringVar(&name, "name", "", "Name of account to renew")
+	renewCmd.MarkFlagRequired("name")
+	renewCmd.Flags().StringVar(&spending, "amount", "1aergo", "Spending for renew name. at least 1 aergo")
+
+	offerCmd := &cobra.Command{
+		Use:                   "offer",
+		Short:                 "Offer account name for sale",
+		RunE:                  execNameOffer,
+		DisableFlagsInUseLine: true,
+	}
+	offerCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	offerCmd.MarkFlagRequired("from")
+	offerCmd.Flags().StringVar(&name, "name", "", "Name of account to offer")
+	offerCmd.MarkFlagRequired("name")
+	offerCmd.Flags().StringVar(&price, "price", "", "Price of name")
+	offerCmd.MarkFlagRequired("price")
+	offerCmd.Flags().StringVar(&buyer, "buyer", "", "Only the buyer can accept the offer if it is given")
+
+	cancelCmd := &cobra.Command{
+		Use:                   "cancel",
+		Short:                 "Cancel the offer of account name",
+		RunE:                  execNameCancelOffer,
+		DisableFlagsInUseLine: true,
+	}
+	cancelCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	cancelCmd.MarkFlagRequired("from")
+	cancelCmd.Flags().StringVar(&name, "name", "", "Name of account to cancel the offer")
+	cancelCmd.MarkFlagRequired("name")
+
+	acceptCmd := &cobra.Command{
+		Use:                   "accept",
+		Short:                 "Buy account name on offer by paying its price",
+		RunE:                  execNameAccept,
+		DisableFlagsInUseLine: true,
+	}
+	acceptCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	acceptCmd.MarkFlagRequired("from")
+	acceptCmd.Flags().StringVar(&name, "name", "", "Name of account to buy")
+	acceptCmd.MarkFlagRequired("name")
+	acceptCmd.Flags().StringVar(&spending, "amount", "", "Price of the offer")
+	acceptCmd.MarkFlagRequired("amount")
+
+	offersCmd := &cobra.Command{
+		Use:                   "offers",
+		Short:                 "List account names on offer",
+		Run:                   execNameOffers,
+		DisableFlagsInUseLine: true,
+	}
+
+	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd, renewCmd, offerCmd, cancelCmd, acceptCmd, offersCmd)
 }
 
 func execNameNew(cmd *cobra.Command, args []string) error {
-	account, err := types.DecodeAddress(from)
+	account, err := decodeAddress(from)
 	if err != nil {
 		return errors.New("Wrong address in --from flag\n" + err.Error())
 	}
@@ -108,7 +168,7 @@ func execNameNew(cmd *cobra.Command, args []string) error {
 }
 
 func execNameUpdate(cmd *cobra.Command, args []string) error {
-	account, err := types.DecodeAddress(from)
+	account, err := decodeAddress(from)
 	if err != nil {
 		return errors.New("Wrong address in --from flag\n" + err.Error())
 	}
@@ -169,5 +229,114 @@ func execNameOwner(cmd *cobra.Command, args []string) {
 	}
 	cmd.Println("{\n \"" + msg.Name.Name + "\": {\n  " +
 		"\"Owner\": \"" + types.EncodeAddress(msg.Owner) + "\",\n  " +
-		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\"\n  }\n}")
+		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\",\n  " +
+		"\"Expiry\": " + strconv.FormatUint(msg.GetExpiry(), 10) + "\n  }\n}")
+}
+
+func execNameRenew(cmd *cobra.Command, args []string) error {
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	amount, err := util.ParseUnit(spending)
+	if err != nil {
+		return errors.New("Wrong value in --amount flag\n" + err.Error())
+	}
+	return sendNameTx(cmd, types.NameRenew, amount, name)
+}
+
+func execNameOffer(cmd *cobra.Command, args []string) error {
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	amount, err := util.ParseUnit(price)
+	if err != nil {
+		return errors.New("Wrong value in --price flag\n" + err.Error())
+	}
+	if buyer == "" {
+		return sendNameTx(cmd, types.NameOffer, big.NewInt(0), name, amount.String())
+	}
+	if _, err = types.DecodeAddress(buyer); err != nil {
+		return errors.New("Wrong address in --buyer flag\n" + err.Error())
+	}
+	return sendNameTx(cmd, types.NameOffer, big.NewInt(0), name, amount.String(), buyer)
+}
+
+func execNameCancelOffer(cmd *cobra.Command, args []string) error {
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	return sendNameTx(cmd, types.NameCancelOffer, big.NewInt(0), name)
+}
+
+func execNameAccept(cmd *cobra.Command, args []string) error {
+	if len(name) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	amount, err := util.ParseUnit(spending)
+	if err != nil {
+		return errors.New("Wrong value in --amount flag\n" + err.Error())
+	}
+	return sendNameTx(cmd, types.NameAccept, amount, name)
+}
+
+func sendNameTx(cmd *cobra.Command, function string, amount *big.Int, args ...interface{}) error {
+	account, err := decodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	payload, err := json.Marshal(&types.CallInfo{Name: function, Args: args})
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		cmd.Printf("Failed request to aergo sever\n" + err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
+func execNameOffers(cmd *cobra.Command, args []string) {
+	msg, err := client.ListNameOffers(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Println(err.Error())
+		return
+	}
+	type nameOffer struct {
+		Name   string
+		Owner  string
+		Expiry uint64
+		Price  string
+		Buyer  string `json:",omitempty"`
+	}
+	offers := []*nameOffer{}
+	for _, info := range msg.GetNames() {
+		offer := &nameOffer{
+			Name:   info.GetName().GetName(),
+			Owner:  types.EncodeAddress(info.GetOwner()),
+			Expiry: info.GetExpiry(),
+			Price:  new(big.Int).SetBytes(info.GetOffer().GetPrice()).String(),
+		}
+		if len(info.GetOffer().GetBuyer()) != 0 {
+			offer.Buyer = types.EncodeAddress(info.GetOffer().GetBuyer())
+		}
+		offers = append(offers, offer)
+	}
+	out, err := json.MarshalIndent(offers, "", " ")
+	if err != nil {
+		cmd.Println(err.Error())
+		return
+	}
+	cmd.Println(string(out))
 }
@@ -0,0 +1,114 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+// offlineInfo is what an air-gapped machine needs from the chain to sign a
+// tx of the account.
+type offlineInfo struct {
+	ChainIdHash string
+	Account     string
+	Nonce       uint64
+}
+
+var offlineInfoCmd = &cobra.Command{
+	Use:   "offlineinfo",
+	Short: "Save the chain id hash and the next nonce of an account for offline signing",
+	Args:  cobra.MinimumNArgs(0),
+	RunE:  execOfflineInfo,
+}
+
+var (
+	offline         bool
+	offlineAccount  string
+	offlineInfoFile string
+	signedTxFile    string
+)
+
+func init() {
+	rootCmd.AddCommand(offlineInfoCmd)
+	offlineInfoCmd.Flags().StringVar(&offlineAccount, "address", "", "Account address signing offline")
+	offlineInfoCmd.MarkFlagRequired("address")
+	offlineInfoCmd.Flags().StringVar(&offlineInfoFile, "file", "", "File to save the information to (default: the standard output)")
+}
+
+func execOfflineInfo(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(offlineAccount)
+	if err != nil {
+		return errors.New("Wrong address in --address flag\n" + err.Error())
+	}
+	status, err := client.Blockchain(context.Background(), &types.Empty{})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	state, err := client.GetState(context.Background(), &types.SingleBytes{Value: account})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	out, err := json.MarshalIndent(&offlineInfo{
+		ChainIdHash: base58.Encode(status.BestChainIdHash),
+		Account:     offlineAccount,
+		Nonce:       state.GetNonce() + 1,
+	}, "", " ")
+	if err != nil {
+		return err
+	}
+	if offlineInfoFile == "" {
+		cmd.Println(string(out))
+		return nil
+	}
+	return ioutil.WriteFile(offlineInfoFile, out, 0600)
+}
+
+// fillOfflineInfo sets the chain id hash, the account and the nonce of the
+// tx body from the information file unless the body has them.
+func fillOfflineInfo(body *types.TxBody, path string) error {
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.New("Failed to read --info\n" + err.Error())
+		}
+		var info offlineInfo
+		if err = json.Unmarshal(b, &info); err != nil {
+			return errors.New("Failed to parse --info\n" + err.Error())
+		}
+		if len(body.ChainIdHash) == 0 {
+			if body.ChainIdHash, err = base58.Decode(info.ChainIdHash); err != nil {
+				return errors.New("Wrong chain id hash in --info\n" + err.Error())
+			}
+		}
+		if len(body.Account) == 0 && info.Account != "" {
+			if body.Account, err = types.DecodeAddress(info.Account); err != nil {
+				return errors.New("Wrong account in --info\n" + err.Error())
+			}
+		}
+		if body.Nonce == 0 {
+			body.Nonce = info.Nonce
+		}
+	}
+	if len(body.ChainIdHash) == 0 {
+		return errors.New("no chain id hash to sign offline: use --info or set ChainIdHash")
+	}
+	return nil
+}
+
+func preConnectSign(cmd *cobra.Command, args []string) {
+	if offline {
+		client = nil
+		return
+	}
+	preConnectAergo(cmd, args)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestOfflineSignWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	mockClient := client
+	defer func() {
+		offline, offlineInfoFile, signedTxFile, jsonPath = false, "", "", ""
+	}()
+
+	const testAddr = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	chainIdHash := []byte("chainidhash-of-the-test-network")
+	dir, err := ioutil.TempDir("", "offline")
+	assert.NoError(t, err, "could not create temp dir")
+	defer os.RemoveAll(dir)
+	infoFile := filepath.Join(dir, "info.json")
+	txFile := filepath.Join(dir, "tx.json")
+
+	mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(
+		&types.BlockchainStatus{BestChainIdHash: chainIdHash}, nil).Times(1)
+	mock.EXPECT().GetState(gomock.Any(), gomock.Any()).Return(
+		&types.State{Nonce: 4}, nil).Times(1)
+	_, err = executeCommand(rootCmd, "offlineinfo", "--address", testAddr, "--file", infoFile)
+	assert.NoError(t, err, "should be success")
+
+	_, err = executeCommand(rootCmd, "signtx", "--offline", "--key", "12345678",
+		"--info", infoFile, "--file", txFile, "--jsontx", `{"Recipient":"`+testAddr+`", "Amount":"1"}`)
+	assert.NoError(t, err, "should be success")
+	assert.Nil(t, client, "offline signing does not connect")
+	client = mockClient
+
+	mock.EXPECT().CommitTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.TxList, opts ...grpc.CallOption) (*types.CommitResultList, error) {
+			assert.Len(t, in.Txs, 1, "signed tx")
+			body := in.Txs[0].GetBody()
+			assert.Equal(t, chainIdHash, body.ChainIdHash, "chain id hash from the info")
+			assert.Equal(t, uint64(5), body.Nonce, "next nonce from the info")
+			assert.Equal(t, testAddr, types.EncodeAddress(body.Account), "account from the info")
+			assert.NotEmpty(t, body.Sign, "signed")
+			return &types.CommitResultList{Results: []*types.CommitResult{{Hash: in.Txs[0].Hash}}}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "committx", "--file", txFile)
+	assert.NoError(t, err, "should be success")
+	assert.NotEmpty(t, output, "commit result")
+
+	output, err = executeCommand(rootCmd, "signtx", "--offline", "--key", "", "--info", "", "--file", "", "--jsontx", `{}`)
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "--key or --path", "offline signing needs a key")
+}
@@ -0,0 +1,152 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var (
+	banDuration time.Duration
+	banReason   string
+)
+
+func init() {
+	p2pCmd := &cobra.Command{
+		Use:   "p2p [flags] subcommand",
+		Short: "Manage the peers of the node",
+	}
+	banCmd.Flags().DurationVar(&banDuration, "duration", 0, "duration of the ban like 24h (default: for ever)")
+	banCmd.Flags().StringVar(&banReason, "reason", "", "reason of the ban")
+	p2pCmd.AddCommand(banCmd, unbanCmd, listBansCmd, p2pStatsCmd)
+	rootCmd.AddCommand(p2pCmd)
+}
+
+var banCmd = &cobra.Command{
+	Use:   "ban [flags] <peer id>",
+	Short: "Ban a peer, which is disconnected and refused until the ban expires or is lifted",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		peerID, err := base58.Decode(args[0])
+		if err != nil {
+			cmd.Printf("Failed: invalid peer id: %s\n", err.Error())
+			return
+		}
+		msg, err := client.BanPeer(context.Background(),
+			&types.BanParams{PeerID: peerID, Duration: int64(banDuration), Reason: banReason})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		printBannedPeers(cmd, msg)
+	},
+}
+
+var unbanCmd = &cobra.Command{
+	Use:   "unban <peer id>",
+	Short: "Lift the ban of a peer",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		peerID, err := base58.Decode(args[0])
+		if err != nil {
+			cmd.Printf("Failed: invalid peer id: %s\n", err.Error())
+			return
+		}
+		msg, err := client.UnbanPeer(context.Background(), &types.SingleBytes{Value: peerID})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		printBannedPeers(cmd, msg)
+	},
+}
+
+var listBansCmd = &cobra.Command{
+	Use:   "list-bans",
+	Short: "Print the banned peers",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.ListBannedPeers(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		printBannedPeers(cmd, msg)
+	},
+}
+
+// printBannedPeers prints a line of the peer id, the expiry of the ban, or
+// "forever", and the reason for each banned peer.
+func printBannedPeers(cmd *cobra.Command, list *types.BannedPeerList) {
+	for _, b := range list.GetPeers() {
+		until := "forever"
+		if b.GetUntil() != 0 {
+			until = time.Unix(0, b.GetUntil()).Format(time.RFC3339)
+		}
+		cmd.Printf("%s\t%s\t%s\n", base58.Encode(b.GetPeerID()), until, b.GetReason())
+	}
+}
+
+var p2pStatsCmd = &cobra.Command{
+	Use:   "stats [peer id]",
+	Short: "Print the statistics of the messages exchanged with the peers, or the peer, by subprotocol",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var peerID []byte
+		if len(args) > 0 {
+			var err error
+			if peerID, err = base58.Decode(args[0]); err != nil {
+				cmd.Printf("Failed: invalid peer id: %s\n", err.Error())
+				return
+			}
+		}
+		msg, err := client.GetPeers(context.Background(), &types.PeersParams{WithStats: true})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		peers := msg.GetPeers()
+		if peerID != nil {
+			peers = nil
+			for _, p := range msg.GetPeers() {
+				if bytes.Equal(p.GetAddress().GetPeerID(), peerID) {
+					peers = append(peers, p)
+				}
+			}
+			if len(peers) == 0 {
+				cmd.Printf("Failed: peer %s is not connected\n", args[0])
+				return
+			}
+		}
+		printProtocolStats(cmd, peers)
+	},
+}
+
+// printProtocolStats prints a table of the message statistics of each
+// subprotocol of each peer.
+func printProtocolStats(cmd *cobra.Command, peers []*types.Peer) {
+	buf := &strings.Builder{}
+	w := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PEER\tPROTOCOL\tSENT\tSENT BYTES\tRECEIVED\tRECEIVED BYTES\tERRORS\tRESPONSES\tAVG LATENCY")
+	for _, p := range peers {
+		for _, s := range p.GetProtocolStats() {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n", base58.Encode(p.GetAddress().GetPeerID()),
+				s.GetProtocol(), s.GetSent(), s.GetSentBytes(), s.GetReceived(), s.GetReceivedBytes(), s.GetErrors(),
+				s.GetResponses(), time.Duration(s.GetAvgLatency()))
+		}
+	}
+	w.Flush()
+	cmd.Print(buf.String())
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestP2PBansWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() {
+		banDuration, banReason = 0, ""
+		banCmd.Flags().Lookup("duration").Changed = false
+		banCmd.Flags().Lookup("reason").Changed = false
+	}()
+
+	peerID := []byte("16Uiu2HAmFqptXPfcdaCdwipB2fhHATgKGVFVPehDAPZsDKSU7jRm")
+	encoded := base58.Encode(peerID)
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	list := &types.BannedPeerList{Peers: []*types.BannedPeer{
+		{PeerID: peerID, Until: until.UnixNano(), Reason: "spam"},
+	}}
+
+	mock.EXPECT().BanPeer(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.BanParams, opts ...grpc.CallOption) (*types.BannedPeerList, error) {
+			assert.Equal(t, &types.BanParams{PeerID: peerID, Duration: int64(time.Hour), Reason: "spam"}, in)
+			return list, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "p2p", "ban", "--duration", "1h", "--reason", "spam", encoded)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, encoded+"\t"+until.Local().Format(time.RFC3339)+"\tspam\n", output)
+
+	list.Peers[0].Until = 0
+	mock.EXPECT().ListBannedPeers(gomock.Any(), gomock.Any()).Return(list, nil).Times(1)
+	output, err = executeCommand(rootCmd, "p2p", "list-bans")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, encoded+"\tforever\tspam\n", output)
+
+	mock.EXPECT().UnbanPeer(gomock.Any(), &types.SingleBytes{Value: peerID}).Return(
+		nil, errors.New("peer is not banned")).Times(1)
+	output, err = executeCommand(rootCmd, "p2p", "unban", encoded)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "Failed: peer is not banned\n", output)
+
+	output, err = executeCommand(rootCmd, "p2p", "unban", "0OIl")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: invalid peer id")
+}
+
+func TestP2PStatsWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	peerID := []byte("16Uiu2HAmFqptXPfcdaCdwipB2fhHATgKGVFVPehDAPZsDKSU7jRm")
+	otherID := []byte("16Uiu2HAmJqEp9f9WAbzFxkLrnHnW4EuUDM69xkCDPF26HmNCsib6")
+	list := &types.PeerList{Peers: []*types.Peer{
+		{Address: &types.PeerAddress{PeerID: peerID}, ProtocolStats: []*types.PeerProtocolStat{
+			{Protocol: "GetBlocksRequest", Sent: 3, SentBytes: 120, Errors: 1, Responses: 2, AvgLatency: int64(time.Millisecond * 150)},
+		}},
+		{Address: &types.PeerAddress{PeerID: otherID}, ProtocolStats: []*types.PeerProtocolStat{
+			{Protocol: "NewTxNotice", Received: 10, ReceivedBytes: 3300},
+		}},
+	}}
+
+	mock.EXPECT().GetPeers(gomock.Any(), &types.PeersParams{WithStats: true}).Return(list, nil).Times(3)
+	output, err := executeCommand(rootCmd, "p2p", "stats")
+	assert.NoError(t, err, "should be success")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	assert.Equal(t, 3, len(lines))
+	assert.Equal(t, []string{"PEER", "PROTOCOL", "SENT", "SENT", "BYTES", "RECEIVED", "RECEIVED", "BYTES", "ERRORS", "RESPONSES", "AVG", "LATENCY"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{base58.Encode(peerID), "GetBlocksRequest", "3", "120", "0", "0", "1", "2", "150ms"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{base58.Encode(otherID), "NewTxNotice", "0", "0", "10", "3300", "0", "0", "0s"}, strings.Fields(lines[2]))
+
+	output, err = executeCommand(rootCmd, "p2p", "stats", base58.Encode(otherID))
+	assert.NoError(t, err, "should be success")
+	lines = strings.Split(strings.TrimSpace(output), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Contains(t, lines[1], "NewTxNotice")
+
+	output, err = executeCommand(rootCmd, "p2p", "stats", base58.Encode([]byte("unknown")))
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: peer")
+}
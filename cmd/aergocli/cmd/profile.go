@@ -0,0 +1,180 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/pelletier/go-toml"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/credentials"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config [flags] subcommand",
+	Short: "Manage the connection profiles of the config file",
+	// the config file is edited without connecting to a server
+	PersistentPreRun:  func(cmd *cobra.Command, args []string) {},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {},
+}
+
+var newProfile CliProfile
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the profiles, marking the one in use",
+		Args:  cobra.NoArgs,
+		RunE:  execConfigList,
+	}
+	useCmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Connect with the profile by default",
+		Args:  cobra.ExactArgs(1),
+		RunE:  execConfigUse,
+	}
+	addCmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add or replace a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE:  execConfigAdd,
+	}
+	addCmd.Flags().StringVar(&newProfile.Host, "endpoint-host", "localhost", "Host address of the server")
+	addCmd.Flags().IntVar(&newProfile.Port, "endpoint-port", 7845, "Port number of the server")
+	addCmd.Flags().StringVar(&newProfile.TLSCACert, "tlscacert", "", "CA certificate file to verify the server")
+	addCmd.Flags().StringVar(&newProfile.TLSCert, "tlscert", "", "Certificate file of the client")
+	addCmd.Flags().StringVar(&newProfile.TLSKey, "tlskey", "", "Private key file of the client")
+	addCmd.Flags().StringVar(&newProfile.Account, "account", "", "Account address used when --from or --address is not given")
+	addCmd.Flags().StringVar(&newProfile.Token, "token", "", "Auth token sent to the server")
+	configCmd.AddCommand(listCmd, useCmd, addCmd)
+}
+
+func execConfigList(cmd *cobra.Command, args []string) error {
+	names := make([]string, 0, len(rootConfig.Profiles))
+	for name := range rootConfig.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := rootConfig.Profiles[name]
+		mark := " "
+		if name == rootConfig.Profile {
+			mark = "*"
+		}
+		tls := ""
+		if p.TLSCACert != "" || p.TLSCert != "" {
+			tls = " (tls)"
+		}
+		cmd.Printf("%s %s\t%s:%d%s\t%s\n", mark, name, p.Host, p.Port, tls, p.Account)
+	}
+	return nil
+}
+
+func execConfigUse(cmd *cobra.Command, args []string) error {
+	if _, ok := rootConfig.Profiles[args[0]]; !ok {
+		return fmt.Errorf("no profile %s in %s", args[0], cliConfigFile)
+	}
+	err := updateConfigFile(func(tree *toml.Tree) {
+		tree.Set("profile", args[0])
+	})
+	if err != nil {
+		return err
+	}
+	cmd.Printf("using profile %s\n", args[0])
+	return nil
+}
+
+func execConfigAdd(cmd *cobra.Command, args []string) error {
+	if (newProfile.TLSCert == "") != (newProfile.TLSKey == "") {
+		return errors.New("--tlscert and --tlskey must be given together")
+	}
+	if _, err := newProfile.credentials(); err != nil {
+		return err
+	}
+	err := updateConfigFile(func(tree *toml.Tree) {
+		path := []string{"profiles", args[0], ""}
+		set := func(key string, value interface{}) {
+			path[2] = key
+			tree.SetPath(path, value)
+		}
+		set("host", newProfile.Host)
+		set("port", int64(newProfile.Port))
+		set("tlscacert", newProfile.TLSCACert)
+		set("tlscert", newProfile.TLSCert)
+		set("tlskey", newProfile.TLSKey)
+		set("account", newProfile.Account)
+		set("token", newProfile.Token)
+	})
+	if err != nil {
+		return err
+	}
+	cmd.Printf("added profile %s\n", args[0])
+	return nil
+}
+
+// updateConfigFile rewrites the config file with the change.
+func updateConfigFile(change func(tree *toml.Tree)) error {
+	tree, err := toml.LoadFile(cliConfigFile)
+	if err != nil {
+		return err
+	}
+	change(tree)
+	s, err := tree.ToTomlString()
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(cliConfigFile)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(cliConfigFile, []byte(s), info.Mode())
+}
+
+// credentials returns the TLS credentials of the profile, or nil when the
+// profile connects without TLS.
+func (p *CliProfile) credentials() (credentials.TransportCredentials, error) {
+	if p.TLSCACert == "" && p.TLSCert == "" {
+		return nil, nil
+	}
+	config := &tls.Config{}
+	if p.TLSCACert != "" {
+		pem, err := ioutil.ReadFile(p.TLSCACert)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in %s", p.TLSCACert)
+		}
+	}
+	if p.TLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(p.TLSCert, p.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// tokenCredentials sends the auth token of the profile with the requests.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestConfigProfilesWithMock(t *testing.T) {
+	const testAddr = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	mock := initMock(t)
+	defer deinitMock()
+
+	dir, err := ioutil.TempDir("", "profile")
+	assert.NoError(t, err, "could not create temp dir")
+	defer os.RemoveAll(dir)
+	defer func() {
+		home, profile, address = "", "", ""
+		newProfile = CliProfile{}
+		getstateCmd.Flags().Lookup("address").Changed = false
+		initConfig()
+	}()
+
+	output, err := executeCommand(rootCmd, "config", "use", "testnet", "--home", dir)
+	assert.Error(t, err, "unknown profile")
+
+	output, err = executeCommand(rootCmd, "config", "add", "testnet", "--home", dir,
+		"--endpoint-host", "testnet.example.com", "--endpoint-port", "17845", "--account", testAddr)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "added profile testnet\n", output)
+
+	output, err = executeCommand(rootCmd, "config", "add", "local", "--home", dir,
+		"--endpoint-host", "localhost", "--tlscert", "client.crt")
+	assert.Error(t, err, "cert without key")
+
+	output, err = executeCommand(rootCmd, "config", "use", "testnet", "--home", dir)
+	assert.NoError(t, err, "should be success")
+
+	output, err = executeCommand(rootCmd, "config", "list", "--home", dir)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "* testnet\ttestnet.example.com:17845\t"+testAddr+"\n", output)
+	assert.Equal(t, "testnet.example.com:17845", GetServerAddress())
+
+	// the account of the profile is used when --address is not given
+	expected, _ := types.DecodeAddress(testAddr)
+	mock.EXPECT().GetState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.SingleBytes, opts ...grpc.CallOption) (*types.State, error) {
+			assert.Equal(t, expected, []byte(in.Value))
+			return &types.State{}, nil
+		}).Times(1)
+	_, err = executeCommand(rootCmd, "getstate", "--home", dir)
+	assert.NoError(t, err, "should be success")
+
+	// --host overrides the endpoint of the profile
+	_, err = executeCommand(rootCmd, "config", "list", "--home", dir, "--host", "other")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, "other:17845", GetServerAddress())
+	host = "localhost"
+	rootCmd.PersistentFlags().Lookup("host").Changed = false
+}
@@ -7,7 +7,6 @@ package cmd
 
 import (
 	"context"
-	"log"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	aergorpc "github.com/aergoio/aergo/types"
@@ -15,6 +14,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var receiptEvents bool
+
 func init() {
 	receiptCmd := &cobra.Command{
 		Use:   "receipt [flags] subcommand",
@@ -22,22 +23,59 @@ func init() {
 	}
 	rootCmd.AddCommand(receiptCmd)
 
-	receiptCmd.AddCommand(
-		&cobra.Command{
-			Use:   "get [flags] tx_hash",
-			Short: "Get a receipt",
-			Args:  cobra.MinimumNArgs(1),
-			Run: func(cmd *cobra.Command, args []string) {
-				txHash, err := base58.Decode(args[0])
+	getReceiptCmd := &cobra.Command{
+		Use:   "get [flags] tx_hash...",
+		Short: "Get receipts",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var w *eventWriter
+			if receiptEvents {
+				var err error
+				if w, err = newEventWriter(cmd, eventOutput); err != nil {
+					cmd.Printf("Failed: %s\n", err.Error())
+					return
+				}
+			}
+			for _, arg := range args {
+				txHash, err := base58.Decode(arg)
 				if err != nil {
-					log.Fatal(err)
+					cmd.Printf("Failed: invalid tx hash %s: %s\n", arg, err.Error())
+					continue
 				}
 				msg, err := client.GetReceipt(context.Background(), &aergorpc.SingleBytes{Value: txHash})
 				if err != nil {
-					log.Fatal(err)
+					cmd.Printf("Failed to get the receipt of %s: %s\n", arg, err.Error())
+					continue
+				}
+				if w != nil {
+					w.write(msg.GetEvents())
+					continue
 				}
 				cmd.Println(util.JSON(msg))
-			},
+			}
+		},
+	}
+	getReceiptCmd.Flags().BoolVar(&receiptEvents, "events", false, "print the events of the receipts only")
+	getReceiptCmd.Flags().StringVar(&eventOutput, "output", eventOutputJSON, "output format of the events: json or csv")
+
+	getInternalOpsCmd := &cobra.Command{
+		Use:   "internalops [flags] tx_hash",
+		Short: "Get the calls, the sends and the deploys made by the contracts during a tx",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			txHash, err := base58.Decode(args[0])
+			if err != nil {
+				cmd.Printf("Failed: invalid tx hash %s: %s\n", args[0], err.Error())
+				return
+			}
+			msg, err := client.GetInternalOperations(context.Background(), &aergorpc.SingleBytes{Value: txHash})
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Println(util.JSON(msg))
 		},
-	)
+	}
+
+	receiptCmd.AddCommand(getReceiptCmd, getInternalOpsCmd)
 }
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInternalOpsWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	txHash := []byte("tx hash of 32 bytes for the test")
+	caller, _ := types.DecodeAddress("AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4")
+	ops := &types.InternalOperations{
+		TxHash:  txHash,
+		BlockNo: 10,
+		Operations: []*types.InternalOperation{{
+			Op:       "call",
+			Caller:   caller,
+			Callee:   []byte("aergo.name"),
+			Function: "transfer",
+			Amount:   big.NewInt(1000).Bytes(),
+			Status:   "SUCCESS",
+			Calls:    []*types.InternalOperation{{Op: "send", Caller: []byte("aergo.name"), Callee: caller, Status: "SUCCESS"}},
+		}},
+	}
+	mock.EXPECT().GetInternalOperations(gomock.Any(), &types.SingleBytes{Value: txHash}).Return(ops, nil)
+
+	output, err := executeCommand(rootCmd, "receipt", "internalops", base58.Encode(txHash))
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, `"callee": "aergo.name"`)
+	assert.Contains(t, output, `"amount": "1000"`)
+	assert.Contains(t, output, `"caller": "AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"`)
+	assert.Contains(t, output, `"op": "send"`)
+
+	output, err = executeCommand(rootCmd, "receipt", "internalops", "invalid!")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Failed: invalid tx hash")
+}
@@ -43,12 +43,44 @@ var (
 	proof      bool
 	compressed bool
 
-	staking bool
+	staking     bool
+	withdrawals bool
+
+	proposalID      string
+	descriptionHash string
+	period          uint64
+	options         []string
+
+	website    string
+	commission uint32
 
 	remote       bool
 	importFormat string
 
+	keystoreFile   string
+	exportKeystore bool
+	keystoreKdf    string
+
+	mnemonic      string
+	seedPassword  string
+	mnemonicWords int
+	hdAccount     uint32
+	hdIndex       uint32
+	hdCount       uint32
+
+	unlockTimeout uint64
+	withAliases   bool
+
+	argon2Time    uint32
+	argon2Memory  uint32
+	argon2Threads uint8
+
 	rootConfig CliConfig
+	profile    string
+
+	// the config file loaded and the profile selected in it
+	cliConfigFile string
+	activeProfile *CliProfile
 
 	rootCmd = &cobra.Command{
 		Use:               "aergocli",
@@ -67,6 +99,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is cliconfig.toml)")
 	rootCmd.PersistentFlags().StringVarP(&host, "host", "H", "localhost", "Host address to aergo server")
 	rootCmd.PersistentFlags().Int32VarP(&port, "port", "p", 7845, "Port number to aergo server")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "Connection profile in the config file (default is the profile of the config file)")
 }
 
 func initConfig() {
@@ -80,6 +113,27 @@ func initConfig() {
 	if err != nil {
 		log.Fatalf("Fail to load configuration file %v: %v", cliCtx.Vc.ConfigFileUsed(), err)
 	}
+	cliConfigFile = cliCtx.Vc.ConfigFileUsed()
+
+	activeProfile = nil
+	if rootConfig.Profile != "" {
+		p, ok := rootConfig.Profiles[rootConfig.Profile]
+		if !ok {
+			log.Fatalf("Unknown profile %s in configuration file %v", rootConfig.Profile, cliConfigFile)
+		}
+		applyProfile(p)
+	}
+}
+
+// applyProfile connects with the profile unless --host or --port is given.
+func applyProfile(p *CliProfile) {
+	if p.Host != "" && !rootCmd.PersistentFlags().Changed("host") {
+		rootConfig.Host = p.Host
+	}
+	if p.Port != 0 && !rootCmd.PersistentFlags().Changed("port") {
+		rootConfig.Port = p.Port
+	}
+	activeProfile = p
 }
 
 func Execute() {
@@ -95,12 +149,32 @@ func GetServerAddress() string {
 }
 
 func connectAergo(cmd *cobra.Command, args []string) {
+	if activeProfile != nil && activeProfile.Account != "" {
+		// the account of the profile is used for the command when not given
+		for _, name := range []string{"from", "address"} {
+			if f := cmd.Flags().Lookup(name); f != nil && !f.Changed {
+				cmd.Flags().Set(name, activeProfile.Account)
+			}
+		}
+	}
 	if test {
 		return
 	}
 
 	serverAddr := GetServerAddress()
 	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if activeProfile != nil {
+		creds, err := activeProfile.credentials()
+		if err != nil {
+			log.Fatalf("Fail to load TLS certificates of the profile: %v", err)
+		}
+		if creds != nil {
+			opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+		}
+		if activeProfile.Token != "" {
+			opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(activeProfile.Token)))
+		}
+	}
 	var ok bool
 	client, ok = util.GetClient(serverAddr, opts).(*util.ConnClient)
 	if !ok {
@@ -22,6 +22,8 @@ var sendtxCmd = &cobra.Command{
 	RunE:  execSendTX,
 }
 var chainIdHash string
+var expiry uint64
+var notBefore uint64
 
 func init() {
 	rootCmd.AddCommand(sendtxCmd)
@@ -29,18 +31,20 @@ func init() {
 	sendtxCmd.MarkFlagRequired("from")
 	sendtxCmd.Flags().StringVar(&to, "to", "", "Recipient account address")
 	sendtxCmd.MarkFlagRequired("to")
-	sendtxCmd.Flags().StringVar(&amount, "amount", "0", "How much in AER")
+	sendtxCmd.Flags().StringVar(&amount, "amount", "0", "How much to send (e.g. 1.5aergo, 5000gaer, 100aer; aer without a unit)")
 	sendtxCmd.MarkFlagRequired("amount")
 	sendtxCmd.Flags().Uint64Var(&nonce, "nonce", 0, "setting nonce manually")
 	sendtxCmd.Flags().StringVar(&chainIdHash, "chainidhash", "", "hash value of chain id in the block")
+	sendtxCmd.Flags().Uint64Var(&expiry, "expiry", 0, "last block number, or last unix time in seconds, to execute the tx (0 for no expiry)")
+	sendtxCmd.Flags().Uint64Var(&notBefore, "notbefore", 0, "first block number to execute the tx (0 for the next block)")
 }
 
 func execSendTX(cmd *cobra.Command, args []string) error {
-	account, err := types.DecodeAddress(from)
+	account, err := decodeAddress(from)
 	if err != nil {
 		return errors.New("Wrong address in --from flag\n" + err.Error())
 	}
-	recipient, err := types.DecodeAddress(to)
+	recipient, err := decodeAddress(to)
 	if err != nil {
 		return errors.New("Wrong address in --to flag\n" + err.Error())
 	}
@@ -53,6 +57,8 @@ func execSendTX(cmd *cobra.Command, args []string) error {
 		Recipient: recipient,
 		Amount:    amountBigInt.Bytes(),
 		Nonce:     nonce,
+		Expiry:    expiry,
+		NotBefore: notBefore,
 	}}
 	if chainIdHash != "" {
 		cid, err := base58.Decode(chainIdHash)
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 
 	"github.com/aergoio/aergo/account/key"
@@ -19,6 +20,9 @@ func init() {
 	signCmd.Flags().StringVar(&address, "address", "1", "address of account to use for signing")
 	signCmd.Flags().StringVar(&pw, "password", "", "local account password")
 	signCmd.Flags().StringVar(&privKey, "key", "", "base58 encoded key for sign")
+	signCmd.Flags().BoolVar(&offline, "offline", false, "sign without connecting to the server, with --key or --path")
+	signCmd.Flags().StringVar(&offlineInfoFile, "info", "", "file saved by offlineinfo to fill the chain id hash, account and nonce of an offline tx")
+	signCmd.Flags().StringVar(&signedTxFile, "file", "", "file to save the signed transaction to, which committx --file sends")
 	rootCmd.AddCommand(verifyCmd)
 	verifyCmd.Flags().StringVar(&jsonTx, "jsontx", "", "transaction list json to verify")
 	verifyCmd.Flags().BoolVar(&remote, "remote", false, "verify in the node")
@@ -28,7 +32,7 @@ var signCmd = &cobra.Command{
 	Use:    "signtx",
 	Short:  "Sign transaction",
 	Args:   cobra.MinimumNArgs(0),
-	PreRun: preConnectAergo,
+	PreRun: preConnectSign,
 	Run: func(cmd *cobra.Command, args []string) {
 		var err error
 		if jsonTx == "" {
@@ -40,6 +44,16 @@ var signCmd = &cobra.Command{
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		if offline {
+			if privKey == "" && cmd.Flags().Changed("path") == false {
+				cmd.Println("Failed: --offline needs --key or --path")
+				return
+			}
+			if err = fillOfflineInfo(param, offlineInfoFile); err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+		}
 
 		var msg *types.Tx
 		if privKey != "" {
@@ -89,7 +103,12 @@ var signCmd = &cobra.Command{
 
 		}
 
-		if nil == err && msg != nil {
+		if nil == err && msg != nil && signedTxFile != "" {
+			err = ioutil.WriteFile(signedTxFile, []byte(util.TxConvBase58Addr(msg)), 0600)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+			}
+		} else if nil == err && msg != nil {
 			cmd.Println(util.TxConvBase58Addr(msg))
 		} else {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -9,6 +9,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math/big"
+	"strconv"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -35,25 +37,159 @@ func execUnstake(cmd *cobra.Command, args []string) error {
 	return sendStake(cmd, false)
 }
 
-func sendStake(cmd *cobra.Command, s bool) error {
-	account, err := types.DecodeAddress(address)
-	if err != nil {
-		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+var delegateCmd = &cobra.Command{
+	Use:   "delegate",
+	Short: "Delegate staked balance to a block producer candidate",
+	RunE:  execDelegate,
+}
+
+func execDelegate(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.Delegate
+	ci.Args = append(ci.Args, to)
+	return sendSystemTx(cmd, &ci)
+}
+
+var undelegateCmd = &cobra.Command{
+	Use:   "undelegate",
+	Short: "Take back delegated balance from the block producer candidate",
+	RunE:  execUndelegate,
+}
+
+func execUndelegate(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.Undelegate
+	return sendSystemTx(cmd, &ci)
+}
+
+var claimRewardCmd = &cobra.Command{
+	Use:   "claimreward",
+	Short: "Claim the reward of the votes and the produced blocks",
+	RunE:  execClaimReward,
+}
+
+func execClaimReward(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.ClaimReward
+	return sendSystemTx(cmd, &ci)
+}
+
+var claimCmd = &cobra.Command{
+	Use:   "claim",
+	Short: "Claim the voting reward paid from the reward pool",
+	RunE:  execClaim,
+}
+
+func execClaim(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.Claim
+	return sendSystemTx(cmd, &ci)
+}
+
+var proposeCmd = &cobra.Command{
+	Use:   "propose",
+	Short: "Propose a poll to the stakers",
+	RunE:  execPropose,
+}
+
+func execPropose(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.Propose
+	ci.Args = append(ci.Args, proposalID, descriptionHash, strconv.FormatUint(period, 10))
+	for _, option := range options {
+		ci.Args = append(ci.Args, option)
+	}
+	return sendSystemTx(cmd, &ci)
+}
+
+var voteProposalCmd = &cobra.Command{
+	Use:   "voteproposal",
+	Short: "Vote for options of a proposal",
+	RunE:  execVoteProposal,
+}
+
+func execVoteProposal(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.VoteProposal
+	ci.Args = append(ci.Args, proposalID)
+	for _, option := range options {
+		ci.Args = append(ci.Args, option)
 	}
+	return sendSystemTx(cmd, &ci)
+}
+
+var registerBPCmd = &cobra.Command{
+	Use:   "registerbp",
+	Short: "Register the metadata of a block producer candidate",
+	RunE:  execRegisterBP,
+}
+
+func execRegisterBP(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.RegisterBP
+	ci.Args = append(ci.Args, to, name, website, strconv.FormatUint(uint64(commission), 10))
+	return sendSystemTx(cmd, &ci)
+}
+
+var unregisterBPCmd = &cobra.Command{
+	Use:   "unregisterbp",
+	Short: "Unregister a block producer candidate",
+	RunE:  execUnregisterBP,
+}
+
+func execUnregisterBP(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.UnregisterBP
+	ci.Args = append(ci.Args, to)
+	return sendSystemTx(cmd, &ci)
+}
+
+var setAuthContractCmd = &cobra.Command{
+	Use:   "setauthcontract",
+	Short: "Designate the contract authorizing the txs of the account",
+	RunE:  execSetAuthContract,
+}
+
+func execSetAuthContract(cmd *cobra.Command, args []string) error {
+	var ci types.CallInfo
+	ci.Name = types.SetAuthContract
+	ci.Args = append(ci.Args, to)
+	return sendSystemTx(cmd, &ci)
+}
+
+func sendStake(cmd *cobra.Command, s bool) error {
 	var ci types.CallInfo
 	if s {
 		ci.Name = types.Stake
 	} else {
 		ci.Name = types.Unstake
 	}
-	amountBigInt, err := util.ParseUnit(amount)
+	return sendSystemTx(cmd, &ci)
+}
+
+func sendSystemTx(cmd *cobra.Command, ci *types.CallInfo) error {
+	_, err := submitSystemTx(cmd, ci)
+	return err
+}
+
+// submitSystemTx sends a system tx and prints the result, which is nil when
+// the tx was not sent.
+func submitSystemTx(cmd *cobra.Command, ci *types.CallInfo) (*types.CommitResult, error) {
+	account, err := decodeAddress(address)
 	if err != nil {
-		return errors.New("Failed to parse --amount flag\n" + err.Error())
+		return nil, errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	amountBigInt := new(big.Int)
+	// the commands without the amount flag send nothing
+	if amount != "" {
+		if amountBigInt, err = util.ParseUnit(amount); err != nil {
+			return nil, errors.New("Failed to parse --amount flag\n" + err.Error())
+		}
 	}
 	payload, err := json.Marshal(ci)
 	if err != nil {
 		cmd.Printf("Failed: %s\n", err.Error())
-		return nil
+		return nil, nil
 	}
 	tx := &types.Tx{
 		Body: &types.TxBody{
@@ -68,8 +204,8 @@ func sendStake(cmd *cobra.Command, s bool) error {
 	msg, err := client.SendTX(context.Background(), tx)
 	if err != nil {
 		cmd.Println(err.Error())
-		return nil
+		return nil, nil
 	}
 	cmd.Println(util.JSON(msg))
-	return nil
+	return msg, nil
 }
@@ -0,0 +1,197 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var stakingCmd = &cobra.Command{
+	Use:   "staking [flags] subcommand",
+	Short: "Stake, unstake and show the staking of an account",
+}
+
+func init() {
+	rootCmd.AddCommand(stakingCmd)
+	stakingStakeCmd := &cobra.Command{
+		Use:   "stake",
+		Short: "Stake balance after checking the staking minimum and the lock of the staking",
+		RunE:  execStakingStake,
+	}
+	stakingStakeCmd.Flags().StringVar(&address, "address", "", "Account address")
+	stakingStakeCmd.MarkFlagRequired("address")
+	stakingStakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount to stake (e.g. 10000aergo)")
+	stakingStakeCmd.MarkFlagRequired("amount")
+
+	stakingUnstakeCmd := &cobra.Command{
+		Use:   "unstake",
+		Short: "Unstake balance after checking the staking minimum and the lock of the staking",
+		RunE:  execStakingUnstake,
+	}
+	stakingUnstakeCmd.Flags().StringVar(&address, "address", "", "Account address")
+	stakingUnstakeCmd.MarkFlagRequired("address")
+	stakingUnstakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount to unstake (e.g. 10000aergo)")
+	stakingUnstakeCmd.MarkFlagRequired("amount")
+
+	stakingStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the staking, its lock and the pending unstakes of an account",
+		RunE:  execStakingStatus,
+	}
+	stakingStatusCmd.Flags().StringVar(&address, "address", "", "Account address")
+	stakingStatusCmd.MarkFlagRequired("address")
+
+	stakingCmd.AddCommand(stakingStakeCmd, stakingUnstakeCmd, stakingStatusCmd)
+}
+
+func execStakingStake(cmd *cobra.Command, args []string) error {
+	return sendCheckedStake(cmd, types.Stake)
+}
+
+func execStakingUnstake(cmd *cobra.Command, args []string) error {
+	return sendCheckedStake(cmd, types.Unstake)
+}
+
+// sendCheckedStake rejects the stake or the unstake which the system contract
+// would fail before sending it.
+func sendCheckedStake(cmd *cobra.Command, name string) error {
+	account, err := decodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	amountBigInt, err := util.ParseUnit(amount)
+	if err != nil {
+		return errors.New("Failed to parse --amount flag\n" + err.Error())
+	}
+	if amountBigInt.Sign() <= 0 {
+		return errors.New("--amount must be positive")
+	}
+	minimum, err := stakingMinimum()
+	if err != nil {
+		return err
+	}
+	staked, err := client.GetStaking(context.Background(), &types.AccountAddress{Value: account})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	next, err := nextBlockNo()
+	if err != nil {
+		return err
+	}
+	if until := staked.GetWhen() + types.StakingDelay; staked.GetWhen() != 0 && until > next {
+		return fmt.Errorf("the staking is locked until block %d (%d blocks left)", until, until-next)
+	}
+	current := staked.GetAmountBigInt()
+	switch name {
+	case types.Stake:
+		if current.Sign() == 0 && amountBigInt.Cmp(minimum) < 0 {
+			return fmt.Errorf("amount %s is less than the staking minimum %s", util.NewAmount(amountBigInt), util.NewAmount(minimum))
+		}
+	case types.Unstake:
+		if current.Cmp(amountBigInt) < 0 {
+			return fmt.Errorf("amount %s exceeds the staked amount %s", util.NewAmount(amountBigInt), util.NewAmount(current))
+		}
+		rest := new(big.Int).Sub(current, amountBigInt)
+		if rest.Sign() != 0 && rest.Cmp(minimum) < 0 {
+			return fmt.Errorf("the remaining stake %s would be less than the staking minimum %s; unstake all of it or less",
+				util.NewAmount(rest), util.NewAmount(minimum))
+		}
+	}
+	result, err := submitSystemTx(cmd, &types.CallInfo{Name: name})
+	if err != nil || result == nil || result.Error != types.CommitStatus_TX_OK {
+		return err
+	}
+	if name == types.Unstake {
+		cmd.Printf("Notice: the unstaked amount is released after %d blocks\n", types.StakingDelay)
+	}
+	cmd.Printf("Notice: the staking is locked for %d blocks\n", types.StakingDelay)
+	return nil
+}
+
+type stakingWithdrawal struct {
+	Amount    string `json:"amount"`
+	Release   uint64 `json:"release"`
+	Remaining uint64 `json:"remaining"`
+}
+
+type stakingStatus struct {
+	Account        string               `json:"account"`
+	Staked         string               `json:"staked"`
+	When           uint64               `json:"when"`
+	StakingMinimum string               `json:"stakingMinimum"`
+	NextBlock      uint64               `json:"nextBlock"`
+	LockedUntil    uint64               `json:"lockedUntil,omitempty"`
+	LockRemaining  uint64               `json:"lockRemaining,omitempty"`
+	Withdrawals    []*stakingWithdrawal `json:"withdrawals"`
+}
+
+func execStakingStatus(cmd *cobra.Command, args []string) error {
+	account, err := decodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	minimum, err := stakingMinimum()
+	if err != nil {
+		return err
+	}
+	info, err := client.GetSystemAccountInfo(context.Background(), &types.AccountAddress{Value: account})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	next, err := nextBlockNo()
+	if err != nil {
+		return err
+	}
+	out := &stakingStatus{
+		Account:        types.EncodeAddress(account),
+		Staked:         info.GetStaking().GetAmountBigInt().String(),
+		When:           info.GetStaking().GetWhen(),
+		StakingMinimum: minimum.String(),
+		NextBlock:      next,
+		Withdrawals:    []*stakingWithdrawal{},
+	}
+	if until := out.When + types.StakingDelay; out.When != 0 && until > next {
+		out.LockedUntil, out.LockRemaining = until, until-next
+	}
+	for _, w := range info.GetWithdrawals() {
+		withdrawal := &stakingWithdrawal{
+			Amount:  new(big.Int).SetBytes(w.GetAmount()).String(),
+			Release: w.GetRelease(),
+		}
+		if w.GetRelease() > next {
+			withdrawal.Remaining = w.GetRelease() - next
+		}
+		out.Withdrawals = append(out.Withdrawals, withdrawal)
+	}
+	printSystemJSON(cmd, out)
+	return nil
+}
+
+// stakingMinimum returns the minimum amount of a stake in the node.
+func stakingMinimum() (*big.Int, error) {
+	info, err := client.GetChainInfo(context.Background(), &types.Empty{})
+	if err != nil {
+		return nil, errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	return new(big.Int).SetBytes(info.GetStakingminimum()), nil
+}
+
+// nextBlockNo returns the number of the block which would include a tx sent
+// now.
+func nextBlockNo() (uint64, error) {
+	status, err := client.Blockchain(context.Background(), &types.Empty{})
+	if err != nil {
+		return 0, errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	return status.GetBestHeight() + 1, nil
+}
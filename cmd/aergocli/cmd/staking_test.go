@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestStakingWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() { address, amount = "", "0" }()
+
+	testAddress := "AmNrsAqkXhQfE6sGxTutQkf9ekaYowaJFLekEm8qvDr1RB1AnsiM"
+	testAccount, _ := types.DecodeAddress(testAddress)
+	chainInfo := &types.ChainInfo{Stakingminimum: big.NewInt(1000).Bytes()}
+	mock.EXPECT().GetChainInfo(gomock.Any(), gomock.Any()).Return(chainInfo, nil).AnyTimes()
+	mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{BestHeight: 100}, nil).AnyTimes()
+
+	// nothing is staked yet
+	mock.EXPECT().GetStaking(gomock.Any(), gomock.Any()).Return(&types.Staking{}, nil).Times(2)
+	_, err := executeCommand(rootCmd, "staking", "stake", "--address", testAddress, "--amount", "999")
+	assert.EqualError(t, err, "amount 999 aer is less than the staking minimum 1000 aer")
+
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.Tx, opts ...grpc.CallOption) (*types.CommitResult, error) {
+			var ci types.CallInfo
+			assert.NoError(t, json.Unmarshal(in.Body.Payload, &ci))
+			assert.Equal(t, types.Stake, ci.Name, "payload")
+			assert.Equal(t, big.NewInt(1000), in.Body.GetAmountBigInt(), "amount")
+			return &types.CommitResult{Hash: []byte("hash")}, nil
+		}).Times(1)
+	output, err := executeCommand(rootCmd, "staking", "stake", "--address", testAddress, "--amount", "1000")
+	assert.NoError(t, err, "should be success")
+	assert.Contains(t, output, "Notice: the staking is locked for 86400 blocks")
+
+	// staked at block 50, so locked until 86450
+	mock.EXPECT().GetStaking(gomock.Any(), gomock.Any()).Return(&types.Staking{Amount: big.NewInt(1500).Bytes(), When: 50}, nil).Times(1)
+	_, err = executeCommand(rootCmd, "staking", "unstake", "--address", testAddress, "--amount", "1500")
+	assert.EqualError(t, err, "the staking is locked until block 86450 (86349 blocks left)")
+
+	mock.EXPECT().GetStaking(gomock.Any(), gomock.Any()).Return(&types.Staking{Amount: big.NewInt(1500).Bytes()}, nil).Times(1)
+	_, err = executeCommand(rootCmd, "staking", "unstake", "--address", testAddress, "--amount", "600")
+	assert.EqualError(t, err, "the remaining stake 900 aer would be less than the staking minimum 1000 aer; unstake all of it or less")
+
+	mock.EXPECT().GetSystemAccountInfo(gomock.Any(), gomock.Any()).Return(&types.SystemAccountInfo{
+		Account:     testAccount,
+		Staking:     &types.Staking{Amount: big.NewInt(1500).Bytes(), When: 50},
+		Withdrawals: []*types.Withdrawal{{Amount: big.NewInt(100).Bytes(), Release: 201}},
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "staking", "status", "--address", testAddress)
+	assert.NoError(t, err, "should be success")
+	var status stakingStatus
+	assert.NoError(t, json.Unmarshal([]byte(output), &status))
+	assert.Equal(t, "1500", status.Staked)
+	assert.Equal(t, "1000", status.StakingMinimum)
+	assert.Equal(t, uint64(86450), status.LockedUntil)
+	assert.Equal(t, uint64(86349), status.LockRemaining)
+	assert.Len(t, status.Withdrawals, 1)
+	assert.Equal(t, uint64(100), status.Withdrawals[0].Remaining, "blocks until the release")
+}
+
+func TestBPVoteWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	defer func() { address, tallyCount = "", 0 }()
+
+	testAddress := "AmNrsAqkXhQfE6sGxTutQkf9ekaYowaJFLekEm8qvDr1RB1AnsiM"
+	testCandidate := "16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9"
+	candidate, _ := base58.Decode(testCandidate)
+	mock.EXPECT().Blockchain(gomock.Any(), gomock.Any()).Return(&types.BlockchainStatus{BestHeight: 100}, nil).AnyTimes()
+
+	_, err := executeCommand(rootCmd, "bp", "vote", "--address", testAddress, "not-a-peer-id")
+	assert.Error(t, err, "invalid candidate")
+
+	mock.EXPECT().GetAccountVotes(gomock.Any(), gomock.Any()).Return(&types.AccountVoteInfo{}, nil).Times(1)
+	_, err = executeCommand(rootCmd, "bp", "vote", "--address", testAddress, testCandidate)
+	assert.EqualError(t, err, "the account must stake before voting")
+
+	// a vote can be changed only after the voting delay from the stake
+	mock.EXPECT().GetAccountVotes(gomock.Any(), gomock.Any()).Return(&types.AccountVoteInfo{
+		Staking: &types.Staking{Amount: big.NewInt(300).Bytes(), When: 50},
+		Voting:  []*types.VoteInfo{{Id: types.VoteBP[2:], Candidates: []string{testCandidate}}},
+	}, nil).Times(1)
+	_, err = executeCommand(rootCmd, "bp", "vote", "--address", testAddress, testCandidate)
+	assert.EqualError(t, err, "the vote is locked until block 86450 (86349 blocks left)")
+
+	mock.EXPECT().GetAccountVotes(gomock.Any(), gomock.Any()).Return(&types.AccountVoteInfo{
+		Staking: &types.Staking{Amount: big.NewInt(300).Bytes(), When: 50},
+	}, nil).Times(1)
+	mock.EXPECT().SendTX(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *types.Tx, opts ...grpc.CallOption) (*types.CommitResult, error) {
+			assert.JSONEq(t, `{"Name":"v1voteBP","Args":["`+testCandidate+`"]}`, string(in.Body.Payload), "payload")
+			return &types.CommitResult{Hash: []byte("hash")}, nil
+		}).Times(1)
+	_, err = executeCommand(rootCmd, "bp", "vote", "--address", testAddress, testCandidate)
+	assert.NoError(t, err, "should be success")
+
+	mock.EXPECT().GetChainInfo(gomock.Any(), gomock.Any()).Return(&types.ChainInfo{BpNumber: 1}, nil).Times(1)
+	mock.EXPECT().GetElectionTally(gomock.Any(), gomock.Any()).Return(&types.ElectionTally{Candidates: []*types.BPCandidateInfo{
+		{Candidate: []byte("second"), Amount: big.NewInt(100).Bytes()},
+		{Candidate: candidate, Amount: big.NewInt(200).Bytes(), Name: "bp1"},
+	}}, nil).Times(1)
+	output, err := executeCommand(rootCmd, "bp", "tally")
+	assert.NoError(t, err, "should be success")
+	var tally bpTally
+	assert.NoError(t, json.Unmarshal([]byte(output), &tally))
+	assert.Equal(t, "300", tally.Total)
+	assert.Len(t, tally.Candidates, 2)
+	assert.Equal(t, testCandidate, tally.Candidates[0].Candidate, "ranked by the votes")
+	assert.Equal(t, "66.66%", tally.Candidates[0].Share)
+	assert.True(t, tally.Candidates[0].Elected)
+	assert.False(t, tally.Candidates[1].Elected)
+}
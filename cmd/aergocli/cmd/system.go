@@ -0,0 +1,173 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var systemCmd = &cobra.Command{
+	Use:   "system [flags] subcommand",
+	Short: "Query the state of the system contract",
+}
+
+func init() {
+	rootCmd.AddCommand(systemCmd)
+	accountCmd := &cobra.Command{
+		Use:                   "account",
+		Short:                 "Show the staking, pending unstakes, votes, delegation and reward of an account",
+		Run:                   execSystemAccount,
+		DisableFlagsInUseLine: true,
+	}
+	accountCmd.Flags().StringVar(&address, "address", "", "address or name of account")
+	accountCmd.MarkFlagRequired("address")
+
+	electionCmd := &cobra.Command{
+		Use:                   "election",
+		Short:                 "Show the votes and the registered metadata of all the BP candidates",
+		Run:                   execSystemElection,
+		DisableFlagsInUseLine: true,
+	}
+	quorumCmd := &cobra.Command{
+		Use:                   "quorum",
+		Short:                 "Show the turnout of the votes on the governance parameters against the quorum",
+		Run:                   execSystemQuorum,
+		DisableFlagsInUseLine: true,
+	}
+	systemCmd.AddCommand(accountCmd, electionCmd, quorumCmd)
+}
+
+type systemWithdrawal struct {
+	Amount  string `json:"amount"`
+	Release uint64 `json:"release"`
+}
+
+type systemAccount struct {
+	Account     string              `json:"account"`
+	Staked      string              `json:"staked"`
+	When        uint64              `json:"when"`
+	Withdrawals []*systemWithdrawal `json:"withdrawals"`
+	Voting      []*types.VoteInfo   `json:"voting"`
+	DelegatedTo string              `json:"delegatedTo,omitempty"`
+	Delegated   string              `json:"delegated,omitempty"`
+	Reward      string              `json:"reward"`
+}
+
+type electionEntry struct {
+	Candidate  string `json:"candidate"`
+	Amount     string `json:"amount"`
+	Name       string `json:"name,omitempty"`
+	Website    string `json:"website,omitempty"`
+	Commission uint32 `json:"commission,omitempty"`
+	Owner      string `json:"owner,omitempty"`
+}
+
+func execSystemAccount(cmd *cobra.Command, args []string) {
+	addr, err := decodeAddress(address)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	msg, err := client.GetSystemAccountInfo(context.Background(), &types.AccountAddress{Value: addr})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	out := &systemAccount{
+		Account:     types.EncodeAddress(msg.GetAccount()),
+		Staked:      msg.GetStaking().GetAmountBigInt().String(),
+		When:        msg.GetStaking().GetWhen(),
+		Withdrawals: []*systemWithdrawal{},
+		Voting:      msg.GetVoting(),
+		DelegatedTo: msg.GetDelegatedTo(),
+		Reward:      new(big.Int).SetBytes(msg.GetReward()).String(),
+	}
+	for _, w := range msg.GetWithdrawals() {
+		out.Withdrawals = append(out.Withdrawals, &systemWithdrawal{
+			Amount:  new(big.Int).SetBytes(w.GetAmount()).String(),
+			Release: w.GetRelease(),
+		})
+	}
+	if len(msg.GetDelegated()) != 0 {
+		out.Delegated = new(big.Int).SetBytes(msg.GetDelegated()).String()
+	}
+	if out.Voting == nil {
+		out.Voting = []*types.VoteInfo{}
+	}
+	printSystemJSON(cmd, out)
+}
+
+func execSystemElection(cmd *cobra.Command, args []string) {
+	msg, err := client.GetElectionTally(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	out := []*electionEntry{}
+	for _, c := range msg.GetCandidates() {
+		entry := &electionEntry{
+			Candidate: base58.Encode(c.GetCandidate()),
+			Amount:    new(big.Int).SetBytes(c.GetAmount()).String(),
+		}
+		if c.GetRegistered() {
+			entry.Name = c.GetName()
+			entry.Website = c.GetWebsite()
+			entry.Commission = c.GetCommission()
+			entry.Owner = types.EncodeAddress(c.GetOwner())
+		}
+		out = append(out, entry)
+	}
+	printSystemJSON(cmd, out)
+}
+
+type quorumEntry struct {
+	Param        string `json:"param"`
+	Turnout      string `json:"turnout"`
+	TotalStaking string `json:"totalStaking"`
+	Quorum       uint64 `json:"quorum"`
+	Reached      bool   `json:"reached"`
+	Pending      string `json:"pending,omitempty"`
+	Activation   uint64 `json:"activation,omitempty"`
+}
+
+func execSystemQuorum(cmd *cobra.Command, args []string) {
+	msg, err := client.GetQuorumStatus(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	out := []*quorumEntry{}
+	for _, q := range msg.GetParams() {
+		entry := &quorumEntry{
+			Param:        q.GetParam(),
+			Turnout:      new(big.Int).SetBytes(q.GetTurnout()).String(),
+			TotalStaking: new(big.Int).SetBytes(q.GetTotalStaking()).String(),
+			Quorum:       q.GetQuorum(),
+			Reached:      q.GetReached(),
+		}
+		if len(q.GetPending()) != 0 {
+			entry.Pending = new(big.Int).SetBytes(q.GetPending()).String()
+			entry.Activation = q.GetActivation()
+		}
+		out = append(out, entry)
+	}
+	printSystemJSON(cmd, out)
+}
+
+func printSystemJSON(cmd *cobra.Command, v interface{}) {
+	data, err := json.MarshalIndent(v, "", " ")
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(string(data))
+}
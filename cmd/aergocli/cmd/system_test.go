@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	testAddress := "AmNrsAqkXhQfE6sGxTutQkf9ekaYowaJFLekEm8qvDr1RB1AnsiM"
+	testAccount, _ := types.DecodeAddress(testAddress)
+	testCandidate := "16Uiu2HAmGiJ2QgVAWHMUtzLKKNM5eFUJ3Ds3FN7nYJq1mHN5ZPj9"
+	candidate, _ := base58.Decode(testCandidate)
+
+	mock.EXPECT().GetSystemAccountInfo(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		&types.SystemAccountInfo{
+			Account:     testAccount,
+			Staking:     &types.Staking{Amount: big.NewInt(300).Bytes(), When: 10},
+			Withdrawals: []*types.Withdrawal{{Amount: big.NewInt(100).Bytes(), Release: 86410}},
+			Voting:      []*types.VoteInfo{{Id: types.VoteBP[2:], Candidates: []string{testCandidate}}},
+			Reward:      big.NewInt(7).Bytes(),
+		},
+		nil,
+	).Times(1)
+
+	output, err := executeCommand(rootCmd, "system", "account", "--address", testAddress)
+	assert.NoError(t, err, "should be success")
+
+	var account map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &account); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, testAddress, account["account"])
+	assert.Equal(t, "300", account["staked"])
+	assert.Equal(t, "7", account["reward"])
+	assert.Len(t, account["withdrawals"], 1)
+	assert.Len(t, account["voting"], 1)
+	assert.Nil(t, account["delegatedTo"])
+
+	mock.EXPECT().GetElectionTally(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		&types.ElectionTally{Candidates: []*types.BPCandidateInfo{
+			{Candidate: candidate, Amount: big.NewInt(300).Bytes(), Registered: true, Owner: testAccount, Name: "bp1", Commission: 5},
+			{Candidate: candidate},
+		}},
+		nil,
+	).Times(1)
+
+	output, err = executeCommand(rootCmd, "system", "election")
+	assert.NoError(t, err, "should be success")
+
+	var election []map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &election); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{"candidate": testCandidate, "amount": "300", "name": "bp1", "commission": float64(5), "owner": testAddress},
+		{"candidate": testCandidate, "amount": "0"},
+	}, election)
+
+	mock.EXPECT().GetQuorumStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		&types.QuorumStatusList{Params: []*types.QuorumStatus{{
+			Param:        types.VoteNumBP[2:],
+			Turnout:      big.NewInt(300).Bytes(),
+			TotalStaking: big.NewInt(1000).Bytes(),
+			Quorum:       40,
+			Pending:      big.NewInt(7).Bytes(),
+			Activation:   86410,
+		}}},
+		nil,
+	).Times(1)
+
+	output, err = executeCommand(rootCmd, "system", "quorum")
+	assert.NoError(t, err, "should be success")
+
+	var quorum []map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &quorum); err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, quorum, 1)
+	assert.Equal(t, "300", quorum[0]["turnout"])
+	assert.Equal(t, false, quorum[0]["reached"])
+	assert.Equal(t, "7", quorum[0]["pending"])
+}
@@ -8,9 +8,12 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"math/big"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
@@ -22,6 +25,7 @@ import (
 
 var revert bool
 var election string
+var tallyCount uint32
 
 func init() {
 	rootCmd.AddCommand(voteStatCmd)
@@ -29,6 +33,21 @@ func init() {
 	voteStatCmd.MarkFlagRequired("address")
 	rootCmd.AddCommand(bpCmd)
 	bpCmd.Flags().Uint64Var(&number, "count", 23, "the number of elected")
+	bpVoteCmd := &cobra.Command{
+		Use:   "vote [flags] <candidate>...",
+		Short: "Vote for BP candidates with the staked balance",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  execBPVote,
+	}
+	bpVoteCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
+	bpVoteCmd.MarkFlagRequired("address")
+	bpTallyCmd := &cobra.Command{
+		Use:   "tally",
+		Short: "Show the ranking and the share of the votes of the BP candidates",
+		RunE:  execBPTally,
+	}
+	bpTallyCmd.Flags().Uint32Var(&tallyCount, "count", 0, "the number of elected (default: the number of BPs in the genesis)")
+	bpCmd.AddCommand(bpVoteCmd, bpTallyCmd)
 	rootCmd.AddCommand(paramCmd)
 	paramCmd.Flags().StringVar(&election, "election", "bp", "block chain parameter")
 }
@@ -60,7 +79,7 @@ var paramCmd = &cobra.Command{
 const PeerIDLength = 39
 
 func execVote(cmd *cobra.Command, args []string) {
-	account, err := types.DecodeAddress(address)
+	account, err := decodeAddress(address)
 	if err != nil {
 		cmd.Printf("Failed: %s\n", err.Error())
 		return
@@ -84,26 +103,25 @@ func execVote(cmd *cobra.Command, args []string) {
 			return
 		}
 
-		for i, v := range ci.Args {
-			if i >= types.MaxCandidates {
-				cmd.Println("too many candidates")
-				return
-			}
-			candidate, err := base58.Decode(v.(string))
-			if err != nil {
-				cmd.Printf("Failed: %s (%s)\n", err.Error(), v)
-				return
-			}
-			_, err = peer.IDFromBytes(candidate)
-			if err != nil {
-				cmd.Printf("Failed: %s (%s)\n", err.Error(), v)
-				return
-			}
+		if err = checkCandidates(ci.Args); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
 		}
 	case "numofbp",
 		"gasprice",
 		"nameprice",
-		"minimumstaking":
+		"minimumstaking",
+		"maxblocksize",
+		"voterrewardrate",
+		"bpexpiry",
+		"slashdoublesign",
+		"slashdowntime",
+		"paramquorum",
+		"aerperbyte",
+		"basetxfee",
+		"feeburnrate",
+		"feetreasuryrate",
+		"forkversion":
 		ci.Name = getVoteCmd(election)
 		numberArg, ok := new(big.Int).SetString(to, 10)
 		if !ok {
@@ -148,8 +166,132 @@ func execVote(cmd *cobra.Command, args []string) {
 	cmd.Println(util.JSON(msg))
 }
 
+// checkCandidates checks that the candidates are peer ids in base58 and not
+// more than a vote can have.
+func checkCandidates(candidates []interface{}) error {
+	if len(candidates) > types.MaxCandidates {
+		return errors.New("too many candidates")
+	}
+	for _, v := range candidates {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("candidate is not a string (%v)", v)
+		}
+		candidate, err := base58.Decode(s)
+		if err != nil {
+			return fmt.Errorf("%s (%s)", err.Error(), s)
+		}
+		if _, err = peer.IDFromBytes(candidate); err != nil {
+			return fmt.Errorf("%s (%s)", err.Error(), s)
+		}
+	}
+	return nil
+}
+
+// execBPVote votes for the candidates after checking that the account has
+// staked and its vote is not locked by a recent stake or unstake.
+func execBPVote(cmd *cobra.Command, args []string) error {
+	account, err := decodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	ci := &types.CallInfo{Name: types.VoteBP}
+	for _, arg := range args {
+		ci.Args = append(ci.Args, arg)
+	}
+	if err := checkCandidates(ci.Args); err != nil {
+		return err
+	}
+	votes, err := client.GetAccountVotes(context.Background(), &types.AccountAddress{Value: account})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	staking := votes.GetStaking()
+	if staking.GetAmountBigInt().Sign() == 0 {
+		return errors.New("the account must stake before voting")
+	}
+	for _, v := range votes.GetVoting() {
+		if v.GetId() != types.VoteBP[2:] || len(v.GetCandidates()) == 0 {
+			continue
+		}
+		next, err := nextBlockNo()
+		if err != nil {
+			return err
+		}
+		if until := staking.GetWhen() + types.VotingDelay; until > next {
+			return fmt.Errorf("the vote is locked until block %d (%d blocks left)", until, until-next)
+		}
+	}
+	return sendSystemTx(cmd, ci)
+}
+
+type tallyEntry struct {
+	Rank      int    `json:"rank"`
+	Candidate string `json:"candidate"`
+	Amount    string `json:"amount"`
+	Share     string `json:"share"`
+	Elected   bool   `json:"elected"`
+	Name      string `json:"name,omitempty"`
+}
+
+type bpTally struct {
+	Total      string        `json:"total"`
+	Elected    uint32        `json:"elected"`
+	Candidates []*tallyEntry `json:"candidates"`
+}
+
+func execBPTally(cmd *cobra.Command, args []string) error {
+	count := tallyCount
+	if count == 0 {
+		info, err := client.GetChainInfo(context.Background(), &types.Empty{})
+		if err != nil {
+			return errors.New("Failed request to aergo server\n" + err.Error())
+		}
+		count = info.GetBpNumber()
+	}
+	msg, err := client.GetElectionTally(context.Background(), &types.Empty{})
+	if err != nil {
+		return errors.New("Failed request to aergo server\n" + err.Error())
+	}
+	candidates := msg.GetCandidates()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return new(big.Int).SetBytes(candidates[i].GetAmount()).Cmp(new(big.Int).SetBytes(candidates[j].GetAmount())) > 0
+	})
+	total := new(big.Int)
+	for _, c := range candidates {
+		total.Add(total, new(big.Int).SetBytes(c.GetAmount()))
+	}
+	out := &bpTally{Total: total.String(), Elected: count, Candidates: []*tallyEntry{}}
+	for i, c := range candidates {
+		amount := new(big.Int).SetBytes(c.GetAmount())
+		out.Candidates = append(out.Candidates, &tallyEntry{
+			Rank:      i + 1,
+			Candidate: base58.Encode(c.GetCandidate()),
+			Amount:    amount.String(),
+			Share:     voteShare(amount, total),
+			Elected:   uint32(i) < count,
+			Name:      c.GetName(),
+		})
+	}
+	printSystemJSON(cmd, out)
+	return nil
+}
+
+// voteShare returns the percentage of amount in total with two decimals.
+func voteShare(amount, total *big.Int) string {
+	if total.Sign() == 0 {
+		return "0.00%"
+	}
+	basisPoints := new(big.Int).Div(new(big.Int).Mul(amount, big.NewInt(10000)), total).Int64()
+	return fmt.Sprintf("%d.%02d%%", basisPoints/100, basisPoints%100)
+}
+
+// revoteReminder is the number of blocks before the expiry of a vote from
+// which a notice to vote again is shown.
+const revoteReminder = 60 * 60 * 24
+
 func execVoteStat(cmd *cobra.Command, args []string) {
-	rawAddr, err := types.DecodeAddress(address)
+	rawAddr, err := decodeAddress(address)
 	if err != nil {
 		cmd.Printf("Failed: %s\n", err.Error())
 		return
@@ -160,6 +302,16 @@ func execVoteStat(cmd *cobra.Command, args []string) {
 		return
 	}
 	cmd.Println(util.JSON(msg))
+	for _, v := range msg.GetVoting() {
+		if v.GetExpiry() == 0 {
+			continue
+		}
+		if v.GetRemaining() == 0 {
+			cmd.Printf("Notice: the vote of %s expired at block %d, vote again to count it\n", v.GetId(), v.GetExpiry())
+		} else if v.GetRemaining() <= revoteReminder {
+			cmd.Printf("Notice: the vote of %s expires in %d blocks\n", v.GetId(), v.GetRemaining())
+		}
+	}
 }
 
 func execBP(cmd *cobra.Command, args []string) {
@@ -185,10 +337,25 @@ func execBP(cmd *cobra.Command, args []string) {
 
 func getVoteCmd(param string) string {
 	numberVote := map[string]string{
-		"numofbp":        types.VoteNumBP,
-		"gasprice":       types.VoteGasPrice,
-		"nameprice":      types.VoteNamePrice,
-		"minimumstaking": types.VoteMinStaking,
+		"numofbp":         types.VoteNumBP,
+		"gasprice":        types.VoteGasPrice,
+		"nameprice":       types.VoteNamePrice,
+		"minimumstaking":  types.VoteMinStaking,
+		"maxblocksize":    types.VoteMaxBlockSize,
+		"voterrewardrate": types.VoteVoterRewardRate,
+		"bpexpiry":        types.VoteBPExpiry,
+		"slashdoublesign": types.VoteSlashDoubleSign,
+		"slashdowntime":   types.VoteSlashDowntime,
+		"paramquorum":     types.VoteParamQuorum,
+		"aerperbyte":      types.VoteAerPerByte,
+		"basetxfee":       types.VoteBaseTxFee,
+		"feeburnrate":     types.VoteFeeBurnRate,
+		"feetreasuryrate": types.VoteFeeTreasuryRate,
+		"forkversion":     types.VoteForkVersion,
+		"votingreward":    types.VoteVotingReward,
+		"storagequota":    types.VoteStorageQuota,
+		"maxpayloadsize":  types.VoteMaxPayloadSize,
+		"payloadtypes":    types.VotePayloadTypes,
 	}
 	return numberVote[election]
 }
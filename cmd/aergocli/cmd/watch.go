@@ -0,0 +1,104 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	watchListCmd.Flags().StringVar(&unit, "unit", "aergo", "display unit of balance")
+	watchCmd.AddCommand(watchAddCmd, watchRemoveCmd, watchListCmd, watchStreamCmd)
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [flags] subcommand",
+	Short: "Manage the watch-only addresses tracked by the node without their private keys",
+}
+
+var watchAddCmd = &cobra.Command{
+	Use:   "add <address>",
+	Short: "Watch an address without its private key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		addr, err := decodeAddress(args[0])
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		msg, err := client.AddWatchAccount(context.Background(), &types.Account{Address: addr})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(types.EncodeAddress(msg.GetAddress()))
+	},
+}
+
+var watchRemoveCmd = &cobra.Command{
+	Use:   "remove <address>",
+	Short: "Stop watching an address",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		addr, err := decodeAddress(args[0])
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		msg, err := client.RemoveWatchAccount(context.Background(), &types.Account{Address: addr})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(types.EncodeAddress(msg.GetAddress()))
+	},
+}
+
+var watchListCmd = &cobra.Command{
+	Use:   "list [flags]",
+	Short: "Print the watched addresses with their nonces and balances",
+	Run: func(cmd *cobra.Command, args []string) {
+		msg, err := client.ListWatchAccounts(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for _, a := range msg.GetAccounts() {
+			balance, err := util.ConvertUnit(new(big.Int).SetBytes(a.GetBalance()), unit)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Println(fmt.Sprintf("%s\t%d\t%s", types.EncodeAddress(a.GetAddress()), a.GetNonce(), balance))
+		}
+	},
+}
+
+var watchStreamCmd = &cobra.Command{
+	Use:   "stream",
+	Short: "Print the txs of the watched addresses in new blocks",
+	Run: func(cmd *cobra.Command, args []string) {
+		stream, err := client.ListWatchAccountTxStream(context.Background(), &types.Empty{})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		for {
+			accountTx, err := stream.Recv()
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Println(util.JSON(accountTx))
+		}
+	},
+}
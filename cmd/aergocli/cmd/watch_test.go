@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+	const testAddress = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAddress)
+
+	mock.EXPECT().AddWatchAccount(gomock.Any(), &types.Account{Address: account}).Return(
+		&types.WatchAccount{Address: account}, nil).Times(1)
+	output, err := executeCommand(rootCmd, "account", "watch", "add", testAddress)
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, testAddress+"\n", output)
+
+	balance := new(big.Int).Mul(big.NewInt(3), big.NewInt(1000000000000000000))
+	mock.EXPECT().ListWatchAccounts(gomock.Any(), gomock.Any()).Return(&types.WatchAccountList{
+		Accounts: []*types.WatchAccount{{Address: account, Nonce: 7, Balance: balance.Bytes()}},
+	}, nil).Times(1)
+	output, err = executeCommand(rootCmd, "account", "watch", "list")
+	assert.NoError(t, err, "should be success")
+	assert.Equal(t, testAddress+"\t7\t3 aergo\n", output)
+}
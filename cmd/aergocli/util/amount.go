@@ -0,0 +1,111 @@
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// units are the denominations of aergo with their number of decimals in aer.
+// They are in the order of the size, which is also the order to match the
+// suffix since aer is a suffix of gaer.
+var units = map[string]int{
+	"aergo": 18,
+	"gaer":  9,
+	"aer":   0,
+}
+var unitlist = []string{"aergo", "gaer", "aer"}
+
+// Amount is an amount of aergo kept exactly in aer.
+type Amount struct {
+	aer big.Int
+}
+
+// NewAmount returns the amount of aer.
+func NewAmount(aer *big.Int) *Amount {
+	a := &Amount{}
+	if aer != nil {
+		a.aer.Set(aer)
+	}
+	return a
+}
+
+// ParseAmount parses a number followed by an optional unit of aergo, gaer or
+// aer, like "1.5 aergo", "5000gaer" or "100". A number without a unit is in
+// aer. The unit is case insensitive and a fraction is allowed as long as it
+// is a whole number of aer.
+func ParseAmount(s string) (*Amount, error) {
+	lower := strings.ToLower(strings.TrimLeft(s, " \t"))
+	number, decimals := strings.TrimSpace(lower), 0
+	for _, u := range unitlist {
+		if strings.HasSuffix(lower, u) {
+			number, decimals = strings.TrimSpace(strings.TrimSuffix(lower, u)), units[u]
+			break
+		}
+	}
+	whole, frac := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		whole, frac = number[:i], number[i+1:]
+	}
+	if whole == "" && frac == "" {
+		return nil, fmt.Errorf("could not parse %s", s)
+	}
+	if len(frac) > decimals {
+		return nil, fmt.Errorf("too small unit %s", s)
+	}
+	digits := whole + frac + strings.Repeat("0", decimals-len(frac))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("could not parse %s", s)
+		}
+	}
+	a := &Amount{}
+	a.aer.SetString(digits, 10)
+	return a, nil
+}
+
+// BigInt returns the amount in aer.
+func (a *Amount) BigInt() *big.Int {
+	return new(big.Int).Set(&a.aer)
+}
+
+// Format returns the amount in the unit without trailing zeros of the
+// fraction, like "1.5 aergo".
+func (a *Amount) Format(unit string) (string, error) {
+	unit = strings.ToLower(unit)
+	decimals, ok := units[unit]
+	if !ok {
+		return "", fmt.Errorf("unknown unit %s", unit)
+	}
+	sign := ""
+	if a.aer.Sign() < 0 {
+		sign = "-"
+	}
+	digits := new(big.Int).Abs(&a.aer).String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if frac != "" {
+		whole += "." + frac
+	}
+	return sign + whole + " " + unit, nil
+}
+
+// String formats the amount in the largest unit of which it is at least one,
+// so that "1500000000000000000" is "1.5 aergo" and "5000" is "5000 aer".
+func (a *Amount) String() string {
+	abs := new(big.Int).Abs(&a.aer)
+	unit := "aergo"
+	for _, u := range unitlist {
+		unit = u
+		if abs.Cmp(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(units[u])), nil)) >= 0 {
+			break
+		}
+	}
+	if abs.Sign() == 0 {
+		unit = "aergo"
+	}
+	s, _ := a.Format(unit)
+	return s
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	for _, test := range []struct {
+		in  string
+		aer string
+	}{
+		{"1.5 aergo", "1500000000000000000"},
+		{"1.5aergo", "1500000000000000000"},
+		{".5 AERGO", "500000000000000000"},
+		{"5000 gaer", "5000000000000"},
+		{"0.000000001 gaer", "1"},
+		{"0.0000000001 gaer", ""},
+		{"0.1 gaer", "100000000"},
+		{"100 aer", "100"},
+		{" 100 ", "100"},
+		{"500000000.000000000000000001 aergo", "500000000000000000000000001"},
+		{"-1 aergo", ""},
+		{"1e18", ""},
+		{"1.5", ""},
+		{"aergo", ""},
+		{"1 aergo aer", ""},
+		{"1.2.3 aergo", ""},
+	} {
+		a, err := ParseAmount(test.in)
+		if test.aer == "" {
+			assert.Error(t, err, test.in)
+			continue
+		}
+		assert.NoError(t, err, test.in)
+		expected, _ := new(big.Int).SetString(test.aer, 10)
+		assert.Equal(t, expected, a.BigInt(), test.in)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	n, _ := new(big.Int).SetString("1500000000000000000", 10)
+	a := NewAmount(n)
+	assert.Equal(t, "1.5 aergo", a.String())
+	s, err := a.Format("gaer")
+	assert.NoError(t, err)
+	assert.Equal(t, "1500000000 gaer", s)
+	_, err = a.Format("wei")
+	assert.Error(t, err, "unknown unit")
+
+	assert.Equal(t, "5000 gaer", NewAmount(big.NewInt(5000000000000)).String())
+	assert.Equal(t, "999 aer", NewAmount(big.NewInt(999)).String())
+	assert.Equal(t, "0 aergo", NewAmount(nil).String())
+	assert.Equal(t, "-1.5 gaer", NewAmount(big.NewInt(-1500000000)).String())
+
+	// the formatted amount is parsed back to the same amount
+	parsed, err := ParseAmount(a.String())
+	assert.NoError(t, err)
+	assert.Equal(t, n, parsed.BigInt())
+}
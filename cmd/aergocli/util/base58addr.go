@@ -27,6 +27,8 @@ type InOutTxBody struct {
 	Type        types.TxType
 	ChainIdHash string
 	Sign        string
+	Expiry      uint64 `json:",omitempty"`
+	NotBefore   uint64 `json:",omitempty"`
 }
 
 type InOutTxIdx struct {
@@ -63,6 +65,30 @@ type InOutBlock struct {
 	Body   InOutBlockBody
 }
 
+type InOutBlockTemplate struct {
+	ChainID         string
+	PrevBlockHash   string
+	BlockNo         uint64
+	Timestamp       int64
+	CoinbaseAccount string
+	ConsensusType   string
+	ConsensusInfo   *json.RawMessage `json:",omitempty"`
+	TxOrder         string
+	Txs             []*InOutTx
+}
+
+type InOutBlockFees struct {
+	TotalFee     string
+	TotalGasUsed uint64
+}
+
+type InOutBlockDetail struct {
+	InOutBlock
+	Receipts      []*types.Receipt `json:",omitempty"`
+	ConsensusInfo *json.RawMessage `json:",omitempty"`
+	Fees          *InOutBlockFees  `json:",omitempty"`
+}
+
 type InOutBlockIdx struct {
 	BlockHash string
 	BlockNo   uint64
@@ -136,6 +162,8 @@ func FillTxBody(source *InOutTxBody, target *types.TxBody) error {
 		}
 	}
 	target.Type = source.Type
+	target.Expiry = source.Expiry
+	target.NotBefore = source.NotBefore
 	return nil
 }
 
@@ -206,6 +234,8 @@ func ConvTx(tx *types.Tx) *InOutTx {
 	out.Body.ChainIdHash = base58.Encode(tx.Body.ChainIdHash)
 	out.Body.Sign = base58.Encode(tx.Body.Sign)
 	out.Body.Type = tx.Body.Type
+	out.Body.Expiry = tx.Body.Expiry
+	out.Body.NotBefore = tx.Body.NotBefore
 	return out
 }
 
@@ -241,6 +271,45 @@ func ConvBlock(b *types.Block) *InOutBlock {
 	return out
 }
 
+func ConvBlockDetail(d *types.BlockDetail) *InOutBlockDetail {
+	out := &InOutBlockDetail{
+		InOutBlock: *ConvBlock(d.GetBlock()),
+		Receipts:   d.GetReceipts(),
+		Fees: &InOutBlockFees{
+			TotalFee:     new(big.Int).SetBytes(d.GetTotalFee()).String(),
+			TotalGasUsed: d.GetTotalGasUsed(),
+		},
+	}
+	if len(d.GetConsensusInfo()) > 0 {
+		m := json.RawMessage(d.GetConsensusInfo())
+		out.ConsensusInfo = &m
+	}
+	return out
+}
+
+func ConvBlockTemplate(t *types.BlockTemplate) *InOutBlockTemplate {
+	out := &InOutBlockTemplate{
+		ChainID:       base58.Encode(t.GetChainID()),
+		PrevBlockHash: base58.Encode(t.GetPrevBlockHash()),
+		BlockNo:       t.GetBlockNo(),
+		Timestamp:     t.GetTimestamp(),
+		ConsensusType: t.GetConsensusType(),
+		TxOrder:       t.GetTxOrder(),
+		Txs:           []*InOutTx{},
+	}
+	if t.GetCoinbaseAccount() != nil {
+		out.CoinbaseAccount = types.EncodeAddress(t.GetCoinbaseAccount())
+	}
+	if len(t.GetConsensusInfo()) > 0 {
+		m := json.RawMessage(t.GetConsensusInfo())
+		out.ConsensusInfo = &m
+	}
+	for _, tx := range t.GetTxs() {
+		out.Txs = append(out.Txs, ConvTx(tx))
+	}
+	return out
+}
+
 func ConvPeer(p *types.Peer) *InOutPeer {
 	out := &InOutPeer{}
 	out.Address.Address = p.GetAddress().GetAddress()
@@ -298,6 +367,10 @@ func BlockConvBase58Addr(b *types.Block) string {
 	return toString(ConvBlock(b))
 }
 
+func BlockTemplateConvBase58Addr(t *types.BlockTemplate) string {
+	return toString(ConvBlockTemplate(t))
+}
+
 func PeerListToString(p *types.PeerList) string {
 	peers := []*InOutPeer{}
 	for _, peer := range p.GetPeers() {
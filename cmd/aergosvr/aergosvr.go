@@ -7,8 +7,6 @@ package main
 import (
 	"fmt"
 	"github.com/aergoio/aergo/p2p/p2pkey"
-	"net/http"
-	_ "net/http/pprof"
 	"os"
 	"strconv"
 	"strings"
@@ -20,7 +18,12 @@ import (
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/impl"
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/internal/nodeevent"
+	"github.com/aergoio/aergo/internal/profiler"
+	"github.com/aergoio/aergo/internal/tracing"
 	"github.com/aergoio/aergo/mempool"
 	"github.com/aergoio/aergo/p2p"
 	"github.com/aergoio/aergo/pkg/component"
@@ -99,6 +102,10 @@ func initConfig() {
 		fmt.Println("Turn off test mode for Aergo Public Chains")
 		os.Exit(1)
 	}
+	if cfg.SimulationMode && !cfg.EnableTestmode {
+		fmt.Println("Simulation mode is only allowed in test mode")
+		os.Exit(1)
+	}
 }
 
 func configureZipkin() {
@@ -118,6 +125,12 @@ func configureZipkin() {
 		if err != nil {
 			panic("Error connecting to kafka endpoints at " + endpoint + ". Error: " + err.Error())
 		}
+	} else if "otlp" == protocol || "otlps" == protocol {
+		scheme := "http"
+		if "otlps" == protocol {
+			scheme = "https"
+		}
+		collector = tracing.NewOTLPCollector(fmt.Sprintf("%s://%s/v1/traces", scheme, endpoint), "aergosvr")
 	}
 
 	if nil != collector {
@@ -132,23 +145,38 @@ func configureZipkin() {
 
 func rootRun(cmd *cobra.Command, args []string) {
 
-	svrlog = log.NewLogger("asvr")
+	svrlog = logctl.NewLogger("asvr")
 	svrlog.Info().Str("revision", gitRevision).Str("branch", gitBranch).Msg("AERGO SVR STARTED")
 
 	configureZipkin()
 
+	if err := nodeevent.Init(cfg.Monitor); err != nil {
+		svrlog.Error().Err(err).Msg("failed to configure the sinks of the node events")
+	}
+
 	if cfg.EnableProfile {
 		svrlog.Info().Msgf("Enable Profiling on localhost: %d", cfg.ProfilePort)
-		go func() {
-			err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", cfg.ProfilePort), nil)
+		if err := profiler.Start(fmt.Sprintf("0.0.0.0:%d", cfg.ProfilePort)); err != nil {
 			svrlog.Info().Err(err).Msg("Run Profile Server")
-		}()
+		}
 	}
 
 	if cfg.EnableTestmode {
 		svrlog.Warn().Msgf("Running with unsafe test mode. Turn off test mode for production use!")
 	}
 
+	if cfg.SimulationMode {
+		// the virtual clock must be set before the services start their timers
+		step := time.Duration(cfg.SimulationStep) * time.Millisecond
+		if step <= 0 {
+			step = time.Millisecond
+		}
+		vc := clock.NewVirtual(time.Now())
+		clock.Set(vc)
+		vc.Run(step)
+		svrlog.Warn().Dur("step", step).Msg("Running on a virtual clock. The block times don't follow the system time!")
+	}
+
 	p2pkey.InitNodeInfo(&cfg.BaseConfig, cfg.P2P, githash, svrlog)
 
 	compMng := component.NewComponentHub()
@@ -189,6 +217,7 @@ func rootRun(cmd *cobra.Command, args []string) {
 	common.HandleKillSig(func() {
 		consensus.Stop(consensusSvc)
 		compMng.Stop()
+		nodeevent.Close()
 	}, svrlog)
 
 	// wait... TODO need to break out when system finished.
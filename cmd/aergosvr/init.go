@@ -3,7 +3,9 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/internal/enc"
@@ -14,11 +16,13 @@ import (
 var (
 	testNet     bool
 	jsonGenesis string
+	specFile    string
 )
 
 func init() {
 	initGenesis.Flags().BoolVar(&testNet, "testnet", false, "create genesis block for Aergo TestNet")
 	initGenesis.Flags().StringVar(&jsonGenesis, "genesis", "", "genesis json file for private net")
+	initGenesis.Flags().StringVar(&specFile, "spec", "", "chain spec file (json or yaml) for private net")
 
 	rootCmd.AddCommand(initGenesis)
 }
@@ -29,7 +33,15 @@ var initGenesis = &cobra.Command{
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 
-		var genesis *types.Genesis
+		var (
+			genesis *types.Genesis
+			spec    *types.ChainSpec
+		)
+
+		if specFile != "" && jsonGenesis != "" {
+			fmt.Println("--spec and --genesis are exclusive")
+			return
+		}
 
 		core := getCore(cfg.DataDir)
 		if core != nil {
@@ -54,6 +66,16 @@ var initGenesis = &cobra.Command{
 			}
 		}
 
+		if specFile != "" {
+			fmt.Println("create genesis block for PrivateNet from the chain spec")
+			var err error
+			if spec, err = types.LoadChainSpec(specFile); err != nil {
+				fmt.Printf("fail to load %s (error:%s)\n", specFile, err)
+				return
+			}
+			genesis = spec.Genesis
+		}
+
 		if genesis == nil {
 			if testNet == false {
 				fmt.Println("create genesis block for Aergo Mainnet")
@@ -70,6 +92,12 @@ var initGenesis = &cobra.Command{
 
 			g := core.GetGenesisInfo()
 			fmt.Printf("genesis block[%s] is created in (%s)\n", enc.ToString(g.Block().GetHash()), cfg.DataDir)
+
+			if spec != nil {
+				if err := writeChainSpec(spec, g.Block().GetHash()); err != nil {
+					fmt.Printf("fail to write the chain spec (error:%s)\n", err)
+				}
+			}
 		}
 	},
 }
@@ -89,6 +117,26 @@ func getGenesis(path string) *types.Genesis {
 	return genesis
 }
 
+// writeChainSpec writes the spec with the hash of the genesis block in the
+// data directory, which the node verifies its chain and config against.
+func writeChainSpec(spec *types.ChainSpec, genesisHash []byte) error {
+	hash := enc.ToString(genesisHash)
+	if spec.GenesisHash != "" && spec.GenesisHash != hash {
+		return fmt.Errorf("genesis block[%s] differs from %s of the spec", hash, spec.GenesisHash)
+	}
+	spec.GenesisHash = hash
+	data, err := spec.JSON()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(cfg.DataDir, types.ChainSpecFile)
+	if err = ioutil.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("chain spec is written in (%s), set the consensus and the fee options of the config as the spec\n", path)
+	return nil
+}
+
 func getCore(dataDir string) *chain.Core {
 	// if initpath is feeded, gaurantee initpath is accessible directory
 	fi, err := os.Stat(dataDir)
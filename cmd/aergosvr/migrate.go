@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/pkg/storage"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateTo     string
+	migrateOutput string
+	migrateLayout bool
+)
+
+func init() {
+	migrateDB.Flags().StringVar(&migrateTo, "to", "", "db implementation to convert to (badgerdb or leveldb)")
+	migrateDB.Flags().StringVar(&migrateOutput, "output", "", "path of the converted data directory")
+	migrateDB.Flags().BoolVar(&migrateLayout, "layout", false, "upgrade the layout of the data directory in place")
+
+	rootCmd.AddCommand(migrateDB)
+}
+
+var migrateDB = &cobra.Command{
+	Use:   "migrate",
+	Short: "Convert the data directory to another db implementation or upgrade its layout",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if migrateLayout {
+			fmt.Printf("upgrade the layout of %s to version %d\n", cfg.DataDir, storage.LayoutVersion)
+			version, err := storage.MigrateLayout(cfg.DataDir, cfg.DbType, cfg.Mempool.DumpFilePath)
+			if err != nil {
+				fmt.Printf("fail to upgrade data directory at version %d (error:%s)\n", version, err)
+				return
+			}
+			fmt.Printf("data directory is at layout version %d\n", version)
+			return
+		}
+		if migrateTo == "" || migrateOutput == "" {
+			fmt.Println("--to and --output are required to convert the data directory")
+			return
+		}
+		if !storage.IsSupported(migrateTo) {
+			fmt.Printf("unsupported db type: %s\n", migrateTo)
+			return
+		}
+		fmt.Printf("convert %s (%s) to %s (%s)\n", cfg.DataDir, cfg.DbType, migrateOutput, migrateTo)
+		if err := storage.Migrate(cfg.DbType, migrateTo, cfg.DataDir, migrateOutput); err != nil {
+			fmt.Printf("fail to convert data directory (error:%s)\n", err)
+			return
+		}
+		fmt.Printf("data directory is converted; set dbtype to %s and datadir to %s to use it\n", migrateTo, migrateOutput)
+	},
+}
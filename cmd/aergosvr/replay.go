@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aergoio/aergo/p2p/capture"
+	"github.com/spf13/cobra"
+)
+
+var (
+	replayProtocol string
+	replayPeer     string
+	replayBody     bool
+)
+
+func init() {
+	replayCapture.Flags().StringVar(&replayProtocol, "protocol", "", "name of the subprotocol to replay like NewBlockNotice (default: all)")
+	replayCapture.Flags().StringVar(&replayPeer, "peer", "", "id of the peer whose messages to replay (default: all)")
+	replayCapture.Flags().BoolVar(&replayBody, "body", false, "print the decoded bodies of the messages")
+
+	rootCmd.AddCommand(replayCapture)
+}
+
+var replayCapture = &cobra.Command{
+	Use:   "replay <capture file>...",
+	Short: "Decode the p2p messages of capture files by this version to check their compatibility",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var total, failed int
+		for _, path := range args {
+			err := capture.ReadFile(path, func(r *capture.Record) error {
+				if (replayProtocol != "" && r.Name != replayProtocol) || (replayPeer != "" && r.Peer != replayPeer) {
+					return nil
+				}
+				total++
+				direction := "out"
+				if r.Inbound {
+					direction = "in"
+				}
+				body, err := capture.Decode(r)
+				if err != nil {
+					failed++
+					fmt.Printf("%s\t%s\t%s\t%s\t%d\tfail: %s\n", r.Time.Format("2006-01-02T15:04:05.000Z07:00"),
+						direction, r.Peer, r.Name, r.Length, err)
+					return nil
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\t%d\tok\n", r.Time.Format("2006-01-02T15:04:05.000Z07:00"),
+					direction, r.Peer, r.Name, r.Length)
+				if replayBody {
+					data, _ := json.Marshal(body)
+					fmt.Println(string(data))
+				}
+				return nil
+			})
+			if err != nil {
+				fmt.Printf("fail to read %s (error:%s)\n", path, err)
+				return
+			}
+		}
+		fmt.Printf("%d messages replayed, %d failed to decode\n", total, failed)
+	},
+}
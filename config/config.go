@@ -59,6 +59,7 @@ func (ctx *ServerContext) GetDefaultBaseConfig() BaseConfig {
 		EnableTestmode: false,
 		Personal:       true,
 		AuthDir:        ctx.ExpandPathEnv("$HOME/auth"),
+		SimulationMode: false,
 	}
 }
 
@@ -68,6 +69,7 @@ func (ctx *ServerContext) GetDefaultRPCConfig() *RPCConfig {
 		NetServicePort:  7845,
 		NetServiceTrace: false,
 		NSKey:           "",
+		NSGatewayPort:   7848,
 	}
 }
 
@@ -86,6 +88,9 @@ func (ctx *ServerContext) GetDefaultP2PConfig() *P2PConfig {
 		NPPeerPool:      100,
 		NPUsePolaris:    true,
 		NPExposeSelf:    true,
+
+		NPCaptureMaxSize:  64,
+		NPCaptureMaxFiles: 4,
 	}
 }
 
@@ -101,25 +106,43 @@ func (ctx *ServerContext) GetDefaultBlockchainConfig() *BlockchainConfig {
 		MaxBlockSize:     types.DefaultMaxBlockSize,
 		CoinbaseAccount:  "",
 		MaxAnchorCount:   20,
+		SyncMinFetchSize: 10,
+		SyncMaxFetchSize: 500,
 		VerifierCount:    types.DefaultVerifierCnt,
 		ForceResetHeight: 0,
 		ZeroFee:          true,
+		GasFee:           false,
+		DynamicFee:       false,
+		FreeTxCount:      0,
+		FreeTxBytes:      0,
+		StateBatchSize:   0,
+		ExecCacheSize:    16,
+		ChainSpec:        "",
+		ColdStorageDir:   "",
+		HotBlockCount:    100000,
+		PruneBodies:      false,
+		DiskWarnFree:     2048,
+		DiskPauseFree:    1024,
+		DiskStopFree:     256,
 	}
 }
 
 func (ctx *ServerContext) GetDefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
-		ShowMetrics:    false,
-		EnableFadeout:  false,
-		FadeoutPeriod:  types.DefaultEvictPeriod,
-		VerifierNumber: runtime.NumCPU(),
-		DumpFilePath:   ctx.ExpandPathEnv("$HOME/mempool.dump"),
+		ShowMetrics:      false,
+		EnableFadeout:    false,
+		FadeoutPeriod:    types.DefaultEvictPeriod,
+		VerifierNumber:   runtime.NumCPU(),
+		DumpFilePath:     ctx.ExpandPathEnv("$HOME/mempool.dump"),
+		ScheduleDistance: 86400,
+		MaxScheduledTxs:  1000,
 	}
 }
 
 func (ctx *ServerContext) GetDefaultConsensusConfig() *ConsensusConfig {
 	return &ConsensusConfig{
 		BlockInterval: 1,
+		TxOrder:       "fifo",
 	}
 }
 
@@ -133,5 +156,10 @@ func (ctx *ServerContext) GetDefaultMonitorConfig() *MonitorConfig {
 func (ctx *ServerContext) GetDefaultAccountConfig() *AccountConfig {
 	return &AccountConfig{
 		UnlockTimeout: 60,
+		Argon2Time:    1,
+		Argon2Memory:  64 * 1024,
+		Argon2Threads: 4,
+		AuditMaxSize:  10,
+		AuditMaxFiles: 10,
 	}
 }
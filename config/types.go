@@ -27,13 +27,15 @@ type Config struct {
 // BaseConfig defines base configurations for aergo server
 type BaseConfig struct {
 	DataDir        string `mapstructure:"datadir" description:"Directory to store datafiles"`
-	DbType         string `mapstructure:"dbtype" description:"db implementation to store data"`
+	DbType         string `mapstructure:"dbtype" description:"db implementation to store data (badgerdb or leveldb)"`
 	EnableProfile  bool   `mapstructure:"enableprofile" description:"enable profiling"`
 	ProfilePort    int    `mapstructure:"profileport" description:"profiling port (default:6060)"`
 	EnableTestmode bool   `mapstructure:"enabletestmode" description:"enable unsafe test mode"`
 	UseTestnet     bool   `mapstructure:"usetestnet" description:"need description"`
 	Personal       bool   `mapstructure:"personal" description:"enable personal account service"`
 	AuthDir        string `mapstructure:"authdir" description:"Directory to store files for auth"`
+	SimulationMode bool   `mapstructure:"simulation" description:"run the timers of the consensus and the mempool on a virtual clock (test mode only)"`
+	SimulationStep int64  `mapstructure:"simulationstep" description:"milliseconds of the system time between the jumps of the virtual clock (default:1)"`
 }
 
 // RPCConfig defines configurations for rpc service
@@ -44,9 +46,17 @@ type RPCConfig struct {
 	NetServiceTrace bool   `mapstructure:"netservicetrace" description:"Trace RPC service"`
 	// RPC API with TLS
 	NSEnableTLS bool   `mapstructure:"nstls" description:"Enable TLS on RPC or REST API"`
-	NSCert      string `mapstructure:"nscert" description:"Certificate file for RPC or REST API"`
+	NSCert      string `mapstructure:"nscert" description:"Certificate file for RPC or REST API, reloaded when changed or on SIGHUP"`
 	NSKey       string `mapstructure:"nskey" description:"Private Key file for RPC or REST API"`
 	NSAllowCORS bool   `mapstructure:"nsallowcors" description:"Allow CORS to RPC or REST API"`
+	NSCACert    string `mapstructure:"nscacert" description:"CA certificate file to verify the client certificates of RPC API"`
+	// RPC API authentication
+	NSAuth          bool     `mapstructure:"nsauth" description:"Authenticate the clients of RPC API and check their roles"`
+	NSAuthTokens    []string `mapstructure:"nsauthtokens" description:"Tokens of RPC clients as role:token, where role is read-only, tx-submit or admin"`
+	NSAnonymousRole string   `mapstructure:"nsanonymousrole" description:"Role of RPC clients without a token or a client certificate"`
+	// JSON-RPC and REST gateway
+	NSGateway     bool `mapstructure:"nsgateway" description:"Enable JSON-RPC and REST gateway"`
+	NSGatewayPort int  `mapstructure:"nsgatewayport" description:"JSON-RPC and REST gateway port"`
 }
 
 // P2PConfig defines configurations for p2p service
@@ -70,6 +80,12 @@ type P2PConfig struct {
 	NPAddPolarises []string `mapstructure:"npaddpolarises" description:"Add addresses of polarises if default polaris is not sufficient"`
 
 	LogFullPeerID bool `mapstructure:"logfullpeerid" description:"Whether to use full legnth peerID or short form"`
+
+	NPCaptureDir       string   `mapstructure:"npcapturedir" description:"directory of the capture files of the p2p messages for debugging (empty: disabled)"`
+	NPCaptureProtocols []string `mapstructure:"npcaptureprotocols" description:"names of the subprotocols to capture like NewBlockNotice (empty: all)"`
+	NPCapturePayload   int      `mapstructure:"npcapturepayload" description:"number of the leading bytes of a payload captured with its header (0: headers only)"`
+	NPCaptureMaxSize   uint     `mapstructure:"npcapturemaxsize" description:"size to rotate the capture file at (MiB, 0 for no rotation)"`
+	NPCaptureMaxFiles  uint     `mapstructure:"npcapturemaxfiles" description:"number of the rotated capture files to keep"`
 	// NPPrivateChain and NPMainNet are not set from configfile, it must be got from genesis block. TODO this properties should not be in config
 }
 
@@ -84,25 +100,56 @@ type BlockchainConfig struct {
 	MaxBlockSize     uint32 `mapstructure:"maxblocksize"  description:"maximum block size in bytes"`
 	CoinbaseAccount  string `mapstructure:"coinbaseaccount" description:"wallet address for coinbase"`
 	MaxAnchorCount   int    `mapstructure:"maxanchorcount" description:"maximun anchor count for sync"`
+	SyncMinFetchSize int    `mapstructure:"syncminfetchsize" description:"minimum number of blocks requested at once for sync, adapted to the latency, failures and block size"`
+	SyncMaxFetchSize int    `mapstructure:"syncmaxfetchsize" description:"maximum number of blocks requested at once for sync, adapted to the latency, failures and block size"`
 	VerifierCount    int    `mapstructure:"verifiercount" description:"maximun transaction verifier count"`
 	ForceResetHeight uint64 `mapstructure:"forceresetheight" description:"best height to reset chain manually"`
 	ZeroFee          bool   `mapstructure:"zerofee" description:"enable zero-fee mode(works only on private network)"`
+	GasFee           bool   `mapstructure:"gasfee" description:"charge contract txs by the gas they use instead of their byte size(works only on private network)"`
+	DynamicFee       bool   `mapstructure:"dynamicfee" description:"adjust the fee per byte every block to the fullness of its parent block(works only on private network)"`
+	FreeTxCount      uint64 `mapstructure:"freetxcount" description:"number of txs an account may send a day without fee (0: unlimited, works only on private network)"`
+	FreeTxBytes      uint64 `mapstructure:"freetxbytes" description:"payload bytes an account may send a day without fee (0: unlimited, works only on private network)"`
+	StateBatchSize   int    `mapstructure:"statebatchsize" description:"maximum number of db writes per batch when committing a block state (0: unlimited)"`
+	ExecCacheSize    int    `mapstructure:"execcachesize" description:"number of the latest executed block states kept to commit the blocks executed again without re-execution (0: disabled)"`
+	ChainSpec        string `mapstructure:"chainspec" description:"chain spec file which the chain and the config are verified against at start (empty: the spec written in the data directory by init, if any)"`
+	ColdStorageDir   string `mapstructure:"coldstoragedir" description:"directory of the secondary storage for old block bodies and receipts (empty: disabled)"`
+	HotBlockCount    uint64 `mapstructure:"hotblockcount" description:"number of latest blocks kept on the primary storage when cold storage is enabled, or kept with their bodies when pruning"`
+	PruneBodies      bool   `mapstructure:"prunebodies" description:"drop the bodies of the blocks older than hotblockcount, keeping their headers, receipts and tx index; the pruned blocks can't be served to syncing peers (exclusive with coldstoragedir)"`
+	DiskWarnFree     uint64 `mapstructure:"diskwarnfree" description:"free space of the data directory in MB below which the node warns (0: disabled)"`
+	DiskPauseFree    uint64 `mapstructure:"diskpausefree" description:"free space of the data directory in MB below which the address index and the moves to cold storage are paused (0: disabled)"`
+	DiskStopFree     uint64 `mapstructure:"diskstopfree" description:"free space of the data directory in MB below which the node stops accepting new blocks (0: disabled)"`
 }
 
 // MempoolConfig defines configurations for mempool service
 type MempoolConfig struct {
-	ShowMetrics    bool   `mapstructure:"showmetrics" description:"show mempool metric periodically"`
-	EnableFadeout  bool   `mapstructure:"enablefadeout" description:"Enable transaction fadeout over timeout period"`
-	FadeoutPeriod  int    `mapstructure:"fadeoutperiod" description:"time period for evict transactions(in hour)"`
-	VerifierNumber int    `mapstructure:"verifiers" description:"number of concurrent verifier"`
-	DumpFilePath   string `mapstructure:"dumpfilepath" description:"file path for recording mempool at process termintation"`
+	ShowMetrics      bool   `mapstructure:"showmetrics" description:"show mempool metric periodically"`
+	EnableFadeout    bool   `mapstructure:"enablefadeout" description:"Enable transaction fadeout over timeout period"`
+	FadeoutPeriod    int    `mapstructure:"fadeoutperiod" description:"time period for evict transactions(in hour)"`
+	VerifierNumber   int    `mapstructure:"verifiers" description:"number of concurrent verifier"`
+	DumpFilePath     string `mapstructure:"dumpfilepath" description:"file path for recording mempool at process termintation"`
+	ScheduleDistance uint64 `mapstructure:"scheduledistance" description:"max number of blocks a scheduled tx waits for its block in mempool"`
+	MaxScheduledTxs  int    `mapstructure:"maxscheduledtxs" description:"max number of scheduled txs waiting for their blocks in mempool"`
 }
 
 // ConsensusConfig defines configurations for consensus service
 type ConsensusConfig struct {
-	EnableBp      bool        `mapstructure:"enablebp" description:"enable block production"`
-	BlockInterval int64       `mapstructure:"blockinterval" description:"block production interval (sec)"`
-	Raft          *RaftConfig `mapstructure:"raft"`
+	EnableBp      bool          `mapstructure:"enablebp" description:"enable block production"`
+	BlockInterval int64         `mapstructure:"blockinterval" description:"block production interval (sec)"`
+	Raft          *RaftConfig   `mapstructure:"raft"`
+	Signer        *SignerConfig `mapstructure:"signer"`
+	TxOrder       string        `mapstructure:"txorder" description:"order of the txs tried for a block: fifo, fee (gas price first) or fair (a tx of each account in turn)"`
+}
+
+// SignerConfig defines the remote signers which sign the blocks produced
+// instead of the node key. They should hold the same key as the node, or its
+// shares if Threshold is set.
+type SignerConfig struct {
+	Endpoints []string `mapstructure:"endpoints" description:"addresses of the remote signers in the order of their priority"`
+	Threshold uint     `mapstructure:"threshold" description:"number of the signers whose shares of the key sign a block together (0 if each signer holds the key)"`
+	CertFile  string   `mapstructure:"certfile" description:"Certificate file of this node for the remote signers"`
+	KeyFile   string   `mapstructure:"keyfile" description:"Private Key file of this node for the remote signers"`
+	CACert    string   `mapstructure:"cacert" description:"CA certificate file to verify the remote signers"`
+	Timeout   uint     `mapstructure:"timeout" description:"timeout of the requests to the remote signers (millisec)"`
 }
 
 type RaftConfig struct {
@@ -115,6 +162,7 @@ type RaftConfig struct {
 	Tick          uint           `mapstructure:"tick" description:"tick of raft server (millisec)"`
 	NewCluster    bool           `mapstructure:"newcluster" description:"create a new raft cluster if it doesn't already exist"`
 	SnapFrequency uint64         `mapstructure:"snapfrequency" description:"frequency which raft make snapshot with log"`
+	Proxy         bool           `mapstructure:"proxy" description:"relay the raft messages to the unreachable members through the other members"`
 }
 
 type RaftBPConfig struct {
@@ -124,13 +172,26 @@ type RaftBPConfig struct {
 }
 
 type MonitorConfig struct {
-	ServerProtocol string `mapstructure:"protocol" description:"Protocol is one of next: http, https or kafka"`
+	ServerProtocol string `mapstructure:"protocol" description:"Protocol is one of next: http, https, kafka, otlp or otlps"`
 	ServerEndpoint string `mapstructure:"endpoint" description:"Endpoint to send"`
+	// node events such as reorgs, membership changes and governance activations
+	EventFile              string   `mapstructure:"eventfile" description:"File to append the node events to in JSON lines"`
+	EventFileCategories    []string `mapstructure:"eventfilecategories" description:"Categories of the node events to the file (reorg, membership, governance or disk, all if empty)"`
+	EventSyslog            bool     `mapstructure:"eventsyslog" description:"Send the node events to the syslog"`
+	EventSyslogCategories  []string `mapstructure:"eventsyslogcategories" description:"Categories of the node events to the syslog (all if empty)"`
+	EventWebhook           string   `mapstructure:"eventwebhook" description:"URL to post the node events to in JSON"`
+	EventWebhookCategories []string `mapstructure:"eventwebhookcategories" description:"Categories of the node events to the webhook (all if empty)"`
 }
 
 // Account defines configurations for account service
 type AccountConfig struct {
-	UnlockTimeout uint `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	UnlockTimeout     uint `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	MaxUnlockDuration uint `mapstructure:"maxunlockduration" description:"lock automatically after the duration at most, even if unlocked with a longer timeout or without timeout (sec, 0 for no limit)"`
+	Argon2Time        uint `mapstructure:"argon2time" description:"number of the passes of argon2id encrypting the keys"`
+	Argon2Memory      uint `mapstructure:"argon2memory" description:"memory of argon2id encrypting the keys (KiB)"`
+	Argon2Threads     uint `mapstructure:"argon2threads" description:"number of the threads of argon2id encrypting the keys"`
+	AuditMaxSize      uint `mapstructure:"auditmaxsize" description:"size to rotate the audit log of the signing at (MiB, 0 for no rotation)"`
+	AuditMaxFiles     uint `mapstructure:"auditmaxfiles" description:"number of the rotated audit logs of the signing to keep"`
 }
 
 /*
@@ -164,6 +225,14 @@ nstls = {{.RPC.NSEnableTLS}}
 nscert = "{{.RPC.NSCert}}"
 nskey = "{{.RPC.NSKey}}"
 nsallowcors = {{.RPC.NSAllowCORS}}
+nscacert = "{{.RPC.NSCACert}}"
+nsauth = {{.RPC.NSAuth}}
+nsauthtokens = [{{range .RPC.NSAuthTokens}}
+"{{.}}", {{end}}
+]
+nsanonymousrole = "{{.RPC.NSAnonymousRole}}"
+nsgateway = {{.RPC.NSGateway}}
+nsgatewayport = {{.RPC.NSGatewayPort}}
 
 [p2p]
 # Set address and port to which the inbound peers connect, and don't set loopback address or private network unless used in local network 
@@ -187,6 +256,13 @@ npusepolaris= {{.P2P.NPUsePolaris}}
 npaddpolarises = [{{range .P2P.NPAddPolarises}}
 "{{.}}", {{end}}
 ]
+npcapturedir = "{{.P2P.NPCaptureDir}}"
+npcaptureprotocols = [{{range .P2P.NPCaptureProtocols}}
+"{{.}}", {{end}}
+]
+npcapturepayload = {{.P2P.NPCapturePayload}}
+npcapturemaxsize = {{.P2P.NPCaptureMaxSize}}
+npcapturemaxfiles = {{.P2P.NPCaptureMaxFiles}}
 
 [polaris]
 allowprivate = {{.Polaris.AllowPrivate}}
@@ -197,8 +273,23 @@ genesisfile = "{{.Polaris.GenesisFile}}"
 maxblocksize = {{.Blockchain.MaxBlockSize}}
 coinbaseaccount = "{{.Blockchain.CoinbaseAccount}}"
 maxanchorcount = "{{.Blockchain.MaxAnchorCount}}"
+syncminfetchsize = {{.Blockchain.SyncMinFetchSize}}
+syncmaxfetchsize = {{.Blockchain.SyncMaxFetchSize}}
 verifiercount = "{{.Blockchain.VerifierCount}}"
 forceresetheight = "{{.Blockchain.ForceResetHeight}}"
+gasfee = {{.Blockchain.GasFee}}
+dynamicfee = {{.Blockchain.DynamicFee}}
+freetxcount = {{.Blockchain.FreeTxCount}}
+freetxbytes = {{.Blockchain.FreeTxBytes}}
+statebatchsize = {{.Blockchain.StateBatchSize}}
+execcachesize = {{.Blockchain.ExecCacheSize}}
+chainspec = "{{.Blockchain.ChainSpec}}"
+coldstoragedir = "{{.Blockchain.ColdStorageDir}}"
+hotblockcount = {{.Blockchain.HotBlockCount}}
+prunebodies = {{.Blockchain.PruneBodies}}
+diskwarnfree = {{.Blockchain.DiskWarnFree}}
+diskpausefree = {{.Blockchain.DiskPauseFree}}
+diskstopfree = {{.Blockchain.DiskStopFree}}
 
 [mempool]
 showmetrics = {{.Mempool.ShowMetrics}}
@@ -206,15 +297,36 @@ enablefadeout = {{.Mempool.EnableFadeout}}
 fadeoutperiod = {{.Mempool.FadeoutPeriod}}
 verifiers = {{.Mempool.VerifierNumber}}
 dumpfilepath = "{{.Mempool.DumpFilePath}}"
+scheduledistance = {{.Mempool.ScheduleDistance}}
+maxscheduledtxs = {{.Mempool.MaxScheduledTxs}}
 
 [consensus]
 enablebp = {{.Consensus.EnableBp}}
 blockinterval = {{.Consensus.BlockInterval}}
+txorder = "{{.Consensus.TxOrder}}"
 
 [monitor]
 protocol = "{{.Monitor.ServerProtocol}}"
 endpoint = "{{.Monitor.ServerEndpoint}}"
+eventfile = "{{.Monitor.EventFile}}"
+eventfilecategories = [{{range .Monitor.EventFileCategories}}
+"{{.}}", {{end}}
+]
+eventsyslog = {{.Monitor.EventSyslog}}
+eventsyslogcategories = [{{range .Monitor.EventSyslogCategories}}
+"{{.}}", {{end}}
+]
+eventwebhook = "{{.Monitor.EventWebhook}}"
+eventwebhookcategories = [{{range .Monitor.EventWebhookCategories}}
+"{{.}}", {{end}}
+]
 
 [account]
 unlocktimeout = "{{.Account.UnlockTimeout}}"
+maxunlockduration = {{.Account.MaxUnlockDuration}}
+argon2time = {{.Account.Argon2Time}}
+argon2memory = {{.Account.Argon2Memory}}
+argon2threads = {{.Account.Argon2Threads}}
+auditmaxsize = {{.Account.AuditMaxSize}}
+auditmaxfiles = {{.Account.AuditMaxFiles}}
 `
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/tracing"
+	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/libp2p/go-libp2p-peer"
 	"time"
@@ -66,7 +68,9 @@ func MaxBlockBodySize() uint32 {
 
 // GenerateBlock generate & return a new block
 func GenerateBlock(hs component.ICompSyncRequester, prevBlock *types.Block, bState *state.BlockState, txOp TxOp, ts int64, skipEmpty bool) (*types.Block, error) {
-	transactions, err := GatherTXs(hs, bState, txOp, MaxBlockBodySize())
+	start := time.Now()
+	// the block is signed by the node key after the generation
+	transactions, err := GatherTXs(hs, bState, txOp, MaxBlockBodySize(), prevBlock.BlockNo()+1, []byte(p2pkey.NodeID()))
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +86,7 @@ func GenerateBlock(hs component.ICompSyncRequester, prevBlock *types.Block, bSta
 	}
 
 	block := types.NewBlock(prevBlock, bState.GetRoot(), bState.Receipts(), txs, chain.CoinbaseAccount, ts)
+	tracing.RecordTxsStage(tracing.StageBlockBuild, txs, start, tracing.BlockTags(block))
 	if len(txs) != 0 && logger.IsDebugEnabled() {
 		logger.Debug().
 			Str("txroothash", types.EncodeB64(block.GetHeader().GetTxsRootHash())).
@@ -7,12 +7,14 @@ package chain
 
 import (
 	"errors"
+	"sync"
 	"time"
 
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/consensus/txorder"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
@@ -25,14 +27,32 @@ var (
 	// chainservice soon.
 	ErrBestBlock = errors.New("best block changed in chainservice")
 
-	logger = log.NewLogger("consensus")
+	logger = logctl.NewLogger("consensus")
+
+	txOrderLock sync.RWMutex
+	txOrder     = txorder.Default
 )
 
+// SetTxOrder sets the policy of the order in which the txs are tried for the
+// blocks generated by the node.
+func SetTxOrder(p txorder.Policy) {
+	txOrderLock.Lock()
+	defer txOrderLock.Unlock()
+	txOrder = p
+}
+
+// GetTxOrder returns the policy of the tx order of the node.
+func GetTxOrder() txorder.Policy {
+	txOrderLock.RLock()
+	defer txOrderLock.RUnlock()
+	return txOrder
+}
+
 // FetchTXs requests to mempool and returns types.Tx array.
 func FetchTXs(hs component.ICompSyncRequester, maxBlockBodySize uint32) []types.Transaction {
 	//bf.RequestFuture(message.MemPoolSvc, &message.MemPoolGenerateSampleTxs{MaxCount: 3}, time.Second)
 	result, err := hs.RequestFuture(message.MemPoolSvc,
-		&message.MemPoolGet{MaxBlockBodySize: maxBlockBodySize}, time.Second,
+		&message.MemPoolGet{MaxBlockBodySize: maxBlockBodySize, Order: GetTxOrder()}, time.Second,
 		"consensus/util/info.FetchTXs").Result()
 	if err != nil {
 		logger.Info().Err(err).Msg("can't fetch transactions from mempool")
@@ -100,7 +120,7 @@ func UnlockChain() {
 
 // GatherTXs returns transactions from txIn. The selection is done by applying
 // txDo.
-func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, maxBlockBodySize uint32) ([]types.Transaction, error) {
+func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, maxBlockBodySize uint32, blockNo types.BlockNo, bpID []byte) ([]types.Transaction, error) {
 	var (
 		nCollected int
 		nCand      int
@@ -118,7 +138,11 @@ func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp T
 	txIn := FetchTXs(hs, maxBlockBodySize)
 	nCand = len(txIn)
 	if nCand == 0 {
-		return txIn, nil
+		// The system state is updated even in an empty block.
+		if err := chain.UpdateSystemState(bState, blockNo); err != nil {
+			return nil, err
+		}
+		return txIn, bState.Update()
 	}
 	txRes := make([]types.Transaction, 0, nCand)
 
@@ -167,7 +191,11 @@ func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp T
 
 	nCollected = len(txRes)
 
-	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount); err != nil {
+	if err := chain.UpdateSystemState(bState, blockNo); err != nil {
+		return nil, err
+	}
+
+	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount, bpID, blockNo); err != nil {
 		return nil, err
 	}
 
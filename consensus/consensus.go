@@ -13,7 +13,8 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo-lib/db"
-	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/clock"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/types"
 	"github.com/aergoio/etcd/raft/raftpb"
 )
@@ -28,7 +29,7 @@ var (
 	// BlockInterval is the maximum block generation time limit.
 	BlockInterval = time.Second * time.Duration(DefaultBlockIntervalSec)
 
-	logger = log.NewLogger("consensus")
+	logger = logctl.NewLogger("consensus")
 )
 
 var (
@@ -65,7 +66,7 @@ type Constructor func() (Consensus, error)
 type Consensus interface {
 	ChainConsensus
 	ConsensusAccessor
-	Ticker() *time.Ticker
+	Ticker() clock.Ticker
 	QueueJob(now time.Time, jq chan<- interface{})
 	BlockFactory() BlockFactory
 	QuitChan() chan interface{}
@@ -75,6 +76,10 @@ type ConsensusAccessor interface {
 	ConsensusInfo() *types.ConsensusInfo
 	ConfChange(req *types.MembershipChange) (*Member, error)
 	ClusterInfo() ([]*types.MemberAttr, []byte, error)
+	ClusterStatus() (*types.ClusterStatus, error)
+	TransferLeader(id uint64) (*types.MemberAttr, error)
+	CreateSnapshot() (uint64, error)
+	BlockConsensusInfo(block *types.Block) (string, error)
 }
 
 // ChainDB is a reader interface for the ChainDB.
@@ -163,7 +168,7 @@ func Start(c Consensus) {
 
 	go func() {
 		ticker := c.Ticker()
-		for now := range ticker.C {
+		for now := range ticker.C() {
 			c.QueueJob(now, bf.JobQueue())
 			select {
 			case <-c.QuitChan():
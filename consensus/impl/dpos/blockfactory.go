@@ -14,13 +14,14 @@ import (
 	"github.com/aergoio/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/consensus/chain"
+	"github.com/aergoio/aergo/consensus/signer"
 	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/davecgh/go-spew/spew"
-	"github.com/libp2p/go-libp2p-crypto"
 )
 
 const (
@@ -52,13 +53,13 @@ type BlockFactory struct {
 	quit             <-chan interface{}
 	maxBlockBodySize uint32
 	ID               string
-	privKey          crypto.PrivKey
+	signer           signer.BlockSigner
 	txOp             chain.TxOp
 	sdb              *state.ChainStateDB
 }
 
 // NewBlockFactory returns a new BlockFactory
-func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, quitC <-chan interface{}) *BlockFactory {
+func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, bs signer.BlockSigner, quitC <-chan interface{}) *BlockFactory {
 	bf := &BlockFactory{
 		ComponentHub:     hub,
 		jobQueue:         make(chan interface{}, slotQueueMax),
@@ -67,7 +68,7 @@ func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, quitC
 		maxBlockBodySize: chain.MaxBlockBodySize(),
 		quit:             quitC,
 		ID:               p2pkey.NodeSID(),
-		privKey:          p2pkey.NodePrivKey(),
+		signer:           bs,
 		sdb:              sdb,
 	}
 
@@ -109,6 +110,11 @@ func (bf *BlockFactory) controller() {
 			return err
 		}
 
+		if err := bf.signer.Ready(); err != nil {
+			logger.Warn().Err(err).Msg("skip block production since the block signer is not ready")
+			return err
+		}
+
 		timeLeft := bpi.slot.RemainingTimeMS()
 		if timeLeft <= 0 {
 			return chain.ErrTimeout{Kind: "slot", Timeout: timeLeft}
@@ -126,7 +132,7 @@ func (bf *BlockFactory) controller() {
 
 	notifyBpTimeout := func(bpi *bpInfo) {
 		timeout := bpi.slot.GetBpTimeout()
-		time.Sleep(time.Duration(timeout) * time.Millisecond)
+		clock.Sleep(time.Duration(timeout) * time.Millisecond)
 		// TODO: skip when the triggered block has already been genearted!
 		bf.bpTimeoutC <- struct{}{}
 		logger.Debug().Int64("timeout", timeout).Msg("block production timeout signaled")
@@ -175,7 +181,7 @@ func (bf *BlockFactory) worker() {
 			}
 
 			if err == chain.ErrBestBlock {
-				time.Sleep(tickDuration())
+				clock.Sleep(tickDuration())
 				// This means the best block is beging changed by the chain
 				// service. If the chain service quickly executes the
 				// block, there may be still some remaining time to produce
@@ -227,7 +233,7 @@ func (bf *BlockFactory) generateBlock(bpi *bpInfo, lpbNo types.BlockNo) (block *
 
 	block.SetConfirms(block.BlockNo() - lpbNo)
 
-	if err = block.Sign(bf.privKey); err != nil {
+	if err = bf.signer.Sign(block); err != nil {
 		return nil, nil, err
 	}
 
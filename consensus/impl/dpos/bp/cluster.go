@@ -13,10 +13,10 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/davecgh/go-spew/spew"
@@ -31,7 +31,7 @@ const (
 )
 
 var (
-	logger  = log.NewLogger("bp")
+	logger  = logctl.NewLogger("bp")
 	errNoBP = errors.New("no block producers found in the block chain")
 
 	genesisBpList []string
@@ -11,11 +11,13 @@ import (
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"time"
 
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
 	"github.com/aergoio/aergo/consensus/impl/dpos/slot"
+	"github.com/aergoio/aergo/consensus/signer"
+	"github.com/aergoio/aergo/internal/clock"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -23,7 +25,7 @@ import (
 )
 
 var (
-	logger = log.NewLogger("dpos")
+	logger = logctl.NewLogger("dpos")
 
 	// blockProducers is the number of block producers
 	blockProducers          uint16
@@ -101,12 +103,21 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
 
 	quitC := make(chan interface{})
 
+	var signerConf *config.SignerConfig
+	if cfg.Consensus.EnableBp {
+		signerConf = cfg.Consensus.Signer
+	}
+	bs, err := signer.New(signerConf, p2pkey.NodePrivKey(), quitC)
+	if err != nil {
+		return nil, err
+	}
+
 	return &DPoS{
 		Status:       NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight),
 		ComponentHub: hub,
 		ChainDB:      cdb,
 		bpc:          bpc,
-		bf:           NewBlockFactory(hub, sdb, quitC),
+		bf:           NewBlockFactory(hub, sdb, bs, quitC),
 		quit:         quitC,
 	}, nil
 }
@@ -124,9 +135,9 @@ func consensusBlockCount(bpCount uint16) uint16 {
 	return bpCount*2/3 + 1
 }
 
-// Ticker returns a time.Ticker for the main consensus loop.
-func (dpos *DPoS) Ticker() *time.Ticker {
-	return time.NewTicker(tickDuration())
+// Ticker returns a clock.Ticker for the main consensus loop.
+func (dpos *DPoS) Ticker() clock.Ticker {
+	return clock.NewTicker(tickDuration())
 }
 
 func tickDuration() time.Duration {
@@ -333,3 +344,40 @@ func (dpos *DPoS) ConfChange(req *types.MembershipChange) (*consensus.Member, er
 func (dpos *DPoS) ClusterInfo() ([]*types.MemberAttr, []byte, error) {
 	return nil, nil, consensus.ErrNotSupportedMethod
 }
+
+func (dpos *DPoS) ClusterStatus() (*types.ClusterStatus, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (dpos *DPoS) TransferLeader(id uint64) (*types.MemberAttr, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (dpos *DPoS) CreateSnapshot() (uint64, error) {
+	return 0, consensus.ErrNotSupportedMethod
+}
+
+// BlockConsensusInfo returns the LIB and whether the block is irreversible by
+// the LIB as JSON.
+func (dpos *DPoS) BlockConsensusInfo(block *types.Block) (string, error) {
+	if dpos.Status == nil {
+		return "", consensus.ErrNotSupportedMethod
+	}
+	lib := dpos.lib()
+	s := struct {
+		BPID         string
+		LibHash      string
+		LibNo        types.BlockNo
+		Irreversible bool
+	}{
+		BPID:         block.BPID2Str(),
+		LibHash:      lib.BlockHash,
+		LibNo:        lib.BlockNo,
+		Irreversible: block.BlockNo() <= lib.BlockNo,
+	}
+	m, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(m), nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
+	"github.com/aergoio/aergo/internal/clock"
 )
 
 var (
@@ -32,7 +33,7 @@ func Init(blockIntervalSec int64) {
 
 // Now returns a Slot corresponding to the current local time.
 func Now() *Slot {
-	return Time(time.Now())
+	return Time(clock.Now())
 }
 
 // NewFromUnixNano returns a Slot corresponding to a UNIX time value (ns).
@@ -121,7 +122,7 @@ func (s *Slot) GetBpTimeout() int64 {
 // RemainingTimeMS returns the remaining duration until the next block
 // generation time.
 func (s *Slot) RemainingTimeMS() int64 {
-	return s.nextIndex*blockIntervalMs - nsToMs(time.Now().UnixNano())
+	return s.nextIndex*blockIntervalMs - nsToMs(clock.Now().UnixNano())
 }
 
 // TimesUp reports whether the reminaing time <= BpMinTimeLimitMs
@@ -19,6 +19,7 @@ type Status struct {
 	bestBlock *types.Block
 	libState  *libStatus
 	bps       *bp.Snapshots
+	bpc       bp.ClusterMember
 }
 
 // NewStatus returns a newly allocated Status.
@@ -26,6 +27,7 @@ func NewStatus(c bp.ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateD
 	s := &Status{
 		libState: newLibStatus(consensusBlockCount(c.Size())),
 		bps:      bp.NewSnapshots(c, cdb, sdb),
+		bpc:      c,
 	}
 	s.init(cdb, resetHeight)
 
@@ -96,6 +98,9 @@ func (s *Status) Update(block *types.Block) {
 		s.bps.UpdateCluster(block.BlockNo())
 	}
 
+	// The BP count may change by the vote on it at an election.
+	s.libState.confirmsRequired = consensusBlockCount(s.bpc.Size())
+
 	s.libState.gc()
 
 	s.bestBlock = block
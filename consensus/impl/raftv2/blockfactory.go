@@ -11,15 +11,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/enc"
-	"github.com/libp2p/go-libp2p-crypto"
 
 	"github.com/aergoio/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/chain"
+	"github.com/aergoio/aergo/consensus/signer"
 	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/internal/tracing"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -42,8 +45,8 @@ var (
 )
 
 func init() {
-	logger = log.NewLogger("raft")
-	httpLogger = log.NewLogger("rafthttp")
+	logger = logctl.NewLogger("raft")
+	httpLogger = logctl.NewLogger("rafthttp")
 }
 
 type txExec struct {
@@ -75,7 +78,7 @@ type BlockFactory struct {
 	blockInterval    time.Duration
 	maxBlockBodySize uint32
 	ID               string
-	privKey          crypto.PrivKey
+	signer           signer.BlockSigner
 	txOp             chain.TxOp
 	sdb              *state.ChainStateDB
 	prevBlock        *types.Block // best block of last job
@@ -110,10 +113,18 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainWAL
 		maxBlockBodySize: chain.MaxBlockBodySize(),
 		quit:             make(chan interface{}),
 		ID:               p2pkey.NodeSID(),
-		privKey:          p2pkey.NodePrivKey(),
 		sdb:              sdb,
 	}
 
+	var signerConf *config.SignerConfig
+	if cfg.Consensus.EnableBp {
+		signerConf = cfg.Consensus.Signer
+	}
+	var err error
+	if bf.signer, err = signer.New(signerConf, p2pkey.NodePrivKey(), bf.quit); err != nil {
+		return nil, err
+	}
+
 	if cfg.Consensus.EnableBp {
 		if err := bf.newRaftServer(cfg); err != nil {
 			logger.Error().Err(err).Msg("failed to init raft server")
@@ -160,6 +171,13 @@ func newRaftOperator(rs *raftServer) *RaftOperator {
 
 func (rop *RaftOperator) propose(block *types.Block, blockState *state.BlockState) {
 	rop.proposed = &Proposed{block: block, blockState: blockState}
+	tracing.BeginTxsStage(tracing.StageConsensus, block.GetBody().GetTxs())
+
+	// journal the block to reconcile it at the restart if this node crashes
+	// before it is committed
+	if err := rop.rs.walDB.WriteProposed(block); err != nil {
+		logger.Error().Err(err).Msg("failed to save proposed block")
+	}
 
 	if err := rop.rs.Propose(block); err != nil {
 		logger.Error().Err(err).Msg("propose error to raft")
@@ -171,9 +189,43 @@ func (rop *RaftOperator) propose(block *types.Block, blockState *state.BlockStat
 
 func (rop *RaftOperator) resetPropose() {
 	rop.proposed = nil
+	if err := rop.rs.walDB.WriteProposed(nil); err != nil {
+		logger.Error().Err(err).Msg("failed to delete proposed block")
+	}
 	logger.Debug().Msg("reset proposed block")
 }
 
+// recoverProposed reconciles the block proposed before the restart with the
+// chain and the raft log. If the block is in the raft log, it is committed or
+// will be, so it is kept as proposed and no block is produced on its parent
+// until it is connected. Otherwise it is discarded.
+func (rop *RaftOperator) recoverProposed(best *types.Block) (*types.Block, error) {
+	block, err := rop.rs.walDB.GetProposed()
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	switch {
+	case best.BlockNo() >= block.BlockNo():
+		logger.Info().Uint64("no", block.BlockNo()).Str("hash", block.ID()).Uint64("best", best.BlockNo()).
+			Msg("proposed block before restart is already connected or superseded")
+	case best.BlockNo()+1 != block.BlockNo() || !bytes.Equal(block.GetHeader().GetPrevBlockHash(), best.BlockHash()):
+		logger.Warn().Uint64("no", block.BlockNo()).Str("hash", block.ID()).Uint64("best", best.BlockNo()).
+			Msg("proposed block before restart doesn't follow the best block. discard it")
+	default:
+		if _, err := rop.rs.walDB.GetRaftEntryIndexOfBlock(block.BlockHash()); err == nil {
+			logger.Info().Uint64("no", block.BlockNo()).Str("hash", block.ID()).
+				Msg("proposed block before restart is in raft log. wait for it to be committed")
+			rop.proposed = &Proposed{block: block}
+			return block, nil
+		}
+		logger.Info().Uint64("no", block.BlockNo()).Str("hash", block.ID()).
+			Msg("proposed block before restart is not in raft log. discard it")
+	}
+
+	return nil, rop.rs.walDB.WriteProposed(nil)
+}
+
 func (rop *RaftOperator) toString() string {
 	buf := "proposed:"
 	if rop.proposed != nil && rop.proposed.block != nil {
@@ -196,6 +248,7 @@ func (bf *BlockFactory) newRaftServer(cfg *config.Config) error {
 	bf.raftServer = newRaftServer(bf.ComponentHub, bf.bpc, cfg.Consensus.Raft.ListenUrl, !cfg.Consensus.Raft.NewCluster,
 		cfg.Consensus.Raft.CertFile, cfg.Consensus.Raft.KeyFile, nil,
 		RaftTick, bf.bpc.confChangeC, bf.raftOp.commitC, false, bf.ChainWAL)
+	bf.raftServer.useProxy = cfg.Consensus.Raft.Proxy
 
 	bf.bpc.rs = bf.raftServer
 	bf.raftOp.rs = bf.raftServer
@@ -204,8 +257,8 @@ func (bf *BlockFactory) newRaftServer(cfg *config.Config) error {
 }
 
 // Ticker returns a time.Ticker for the main consensus loop.
-func (bf *BlockFactory) Ticker() *time.Ticker {
-	return time.NewTicker(bf.blockInterval)
+func (bf *BlockFactory) Ticker() clock.Ticker {
+	return clock.NewTicker(bf.blockInterval)
 }
 
 // QueueJob send a block triggering information to jq.
@@ -293,6 +346,7 @@ func (bf *BlockFactory) NeedReorganization(rootNo types.BlockNo) bool {
 func (bf *BlockFactory) Start() {
 	defer logger.Info().Msg("shutdown initiated. stop the service")
 
+	bf.recoverProposed()
 	bf.raftServer.Start()
 
 	runtime.LockOSThread()
@@ -329,10 +383,37 @@ func (bf *BlockFactory) Start() {
 	}
 }
 
+// recoverProposed makes the block factory wait for the block proposed before
+// the restart, if it may be committed, instead of producing another one on
+// the same best block.
+func (bf *BlockFactory) recoverProposed() {
+	best, err := bf.GetBestBlock()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get best block to recover proposed block")
+		return
+	}
+
+	block, err := bf.raftOp.recoverProposed(best)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to recover proposed block")
+		return
+	}
+	if block != nil {
+		bf.jobLock.Lock()
+		bf.prevBlock = best
+		bf.jobLock.Unlock()
+	}
+}
+
 func (bf *BlockFactory) build(prevBlock *types.Block) error {
+	if err := bf.signer.Ready(); err != nil {
+		logger.Debug().Err(err).Msg("skip producing block since the block signer is not ready")
+		return nil
+	}
+
 	blockState := bf.sdb.NewBlockState(prevBlock.GetHeader().GetBlocksRootHash())
 
-	ts := time.Now().UnixNano()
+	ts := clock.Now().UnixNano()
 
 	txOp := chain.NewCompTxOp(
 		bf.txOp,
@@ -347,7 +428,7 @@ func (bf *BlockFactory) build(prevBlock *types.Block) error {
 		return err
 	}
 
-	if err = block.Sign(bf.privKey); err != nil {
+	if err = bf.signer.Sign(block); err != nil {
 		logger.Error().Err(err).Msg("failed to sign in block")
 		return nil
 	}
@@ -383,15 +464,23 @@ func (bf *BlockFactory) reset() {
 
 // save block/block state to connect after commit
 func (bf *BlockFactory) connect(block *types.Block) error {
+	tracing.EndTxsStage(tracing.StageConsensus, block.GetBody().GetTxs(), tracing.BlockTags(block))
 	proposed := bf.raftOp.proposed
 	var blockState *state.BlockState
 
+	var connectProposed bool
+
 	if proposed != nil {
-		if !bytes.Equal(block.BlockHash(), proposed.block.BlockHash()) {
+		switch {
+		case block.BlockNo() < proposed.block.BlockNo():
+			// the blocks replayed from the wal before the one proposed
+			// before the restart don't supersede it
+		case !bytes.Equal(block.BlockHash(), proposed.block.BlockHash()):
 			logger.Warn().Uint64("prop-no", proposed.block.GetHeader().GetBlockNo()).Str("prop", proposed.block.ID()).Uint64("commit-no", block.GetHeader().GetBlockNo()).Str("commit", block.ID()).Msg("commited block is not proposed by me. this node is probably not leader")
 			bf.raftOp.resetPropose()
-		} else {
+		default:
 			blockState = proposed.blockState
+			connectProposed = true
 		}
 	}
 
@@ -408,6 +497,10 @@ func (bf *BlockFactory) connect(block *types.Block) error {
 		return err
 	}
 
+	if connectProposed {
+		bf.raftOp.resetPropose()
+	}
+
 	return nil
 }
 
@@ -504,3 +597,68 @@ func (bf *BlockFactory) ConfChange(req *types.MembershipChange) (*consensus.Memb
 func (bf *BlockFactory) ClusterInfo() ([]*types.MemberAttr, []byte, error) {
 	return bf.bpc.getMemberAttrs(), bf.bpc.chainID, nil
 }
+
+// ClusterStatus returns the membership and the replication progress of raft cluster
+func (bf *BlockFactory) ClusterStatus() (*types.ClusterStatus, error) {
+	if bf.bpc == nil {
+		return nil, ErrClusterNotReady
+	}
+
+	return bf.bpc.toClusterStatus(), nil
+}
+
+// TransferLeader transfers the leadership of raft cluster to the member of given id and returns the new leader
+func (bf *BlockFactory) TransferLeader(id uint64) (*types.MemberAttr, error) {
+	if bf.bpc == nil || bf.raftServer == nil {
+		return nil, ErrClusterNotReady
+	}
+
+	bf.bpc.Lock()
+	member := bf.bpc.getMembers().getMember(id)
+	bf.bpc.Unlock()
+
+	if member == nil {
+		return nil, ErrNotExistRaftMember
+	}
+
+	if err := bf.raftServer.TransferLeadership(id); err != nil {
+		return nil, err
+	}
+
+	attr := member.MemberAttr
+	return &attr, nil
+}
+
+// CreateSnapshot makes a snapshot of raft log and returns the index of the snapshot
+func (bf *BlockFactory) CreateSnapshot() (uint64, error) {
+	if bf.raftServer == nil {
+		return 0, ErrClusterNotReady
+	}
+
+	return bf.raftServer.RequestSnapshot()
+}
+
+// BlockConsensusInfo returns the term and the index of the raft log entry of the block as JSON
+func (bf *BlockFactory) BlockConsensusInfo(block *types.Block) (string, error) {
+	idx, err := bf.GetRaftEntryIndexOfBlock(block.BlockHash())
+	if err != nil {
+		// the block was written before its entry was indexed or by a snapshot
+		return "", consensus.ErrNotSupportedMethod
+	}
+	entry, err := bf.GetRaftEntry(idx)
+	if err != nil {
+		return "", err
+	}
+	s := struct {
+		Term  uint64
+		Index uint64
+	}{
+		Term:  entry.Term,
+		Index: entry.Index,
+	}
+	m, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(m), nil
+}
@@ -0,0 +1,61 @@
+package raftv2
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRaftOperatorRecoverProposed(t *testing.T) {
+	wal := newMemWAL()
+	rop := newRaftOperator(&raftServer{walDB: NewWalDB(wal)})
+
+	genesis := types.NewBlock(nil, nil, nil, nil, nil, 0)
+	best := types.NewBlock(genesis, nil, nil, nil, nil, 1)
+	proposed := types.NewBlock(best, nil, nil, nil, nil, 2)
+
+	// nothing was proposed
+	block, err := rop.recoverProposed(best)
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+
+	// the proposed block not in the raft log is discarded
+	assert.NoError(t, wal.WriteProposed(proposed))
+	block, err = rop.recoverProposed(best)
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+	assert.Nil(t, rop.proposed)
+	saved, _ := wal.GetProposed()
+	assert.Nil(t, saved)
+
+	// the proposed block in the raft log is kept until it is connected
+	assert.NoError(t, wal.WriteProposed(proposed))
+	assert.NoError(t, wal.WriteRaftEntry([]*consensus.WalEntry{
+		{Type: consensus.EntryBlock, Term: 1, Index: 5, Data: proposed.BlockHash()},
+	}, []*types.Block{proposed}))
+	block, err = rop.recoverProposed(best)
+	assert.NoError(t, err)
+	assert.Equal(t, proposed, block)
+	assert.Equal(t, proposed, rop.proposed.block)
+	assert.Nil(t, rop.proposed.blockState)
+	saved, _ = wal.GetProposed()
+	assert.Equal(t, proposed, saved)
+
+	// the proposed block is discarded if it doesn't follow the best block
+	rop.proposed = nil
+	block, err = rop.recoverProposed(genesis)
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+	saved, _ = wal.GetProposed()
+	assert.Nil(t, saved)
+
+	// or the best block reached it
+	assert.NoError(t, wal.WriteProposed(proposed))
+	block, err = rop.recoverProposed(proposed)
+	assert.NoError(t, err)
+	assert.Nil(t, block)
+	saved, _ = wal.GetProposed()
+	assert.Nil(t, saved)
+}
@@ -1,6 +1,7 @@
 package raftv2
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -12,8 +13,10 @@ import (
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	raftlib "github.com/aergoio/etcd/raft"
 	"github.com/aergoio/etcd/raft/raftpb"
@@ -33,6 +36,7 @@ var (
 	ErrConChangeTimeOut         = errors.New("timeouted membership change request")
 	ErrConfChangeChannelBusy    = errors.New("channel of conf change propose is busy")
 	ErrCCMemberIsNil            = errors.New("memeber is nil")
+	ErrTooManyMembers           = errors.New("cluster has as many members as the voted number of BPs")
 	ErrNotMatchedRaftName       = errors.New("mismatched name of raft identity")
 )
 
@@ -56,6 +60,7 @@ type Cluster struct {
 	chainID        []byte
 	chainTimestamp int64
 	rs             *raftServer
+	sdb            *state.ChainStateDB
 
 	appliedIndex uint64
 	appliedTerm  uint64
@@ -135,6 +140,7 @@ func NewCluster(chainID []byte, bf *BlockFactory, raftName string, chainTimestam
 	}
 	if bf != nil {
 		cl.cdb = bf.ChainWAL
+		cl.sdb = bf.sdb
 	}
 
 	return cl
@@ -318,16 +324,24 @@ func (cl *Cluster) removeMember(member *consensus.Member) error {
 	return nil
 }
 
-// ValidateAndMergeExistingCluster tests if members of existing cluster are matched with this cluster
-func (cl *Cluster) ValidateAndMergeExistingCluster(existingCl *Cluster) bool {
+// ValidateAndMergeExistingCluster tests if existing cluster is of the same chain and its members are matched with this cluster
+func (cl *Cluster) ValidateAndMergeExistingCluster(existingCl *Cluster) error {
 	cl.Lock()
 	defer cl.Unlock()
 
+	if !bytes.Equal(cl.chainID, existingCl.chainID) {
+		return &types.ChainMismatchError{
+			Subject:  "raft cluster",
+			Expected: types.ChainIDString(cl.chainID),
+			Actual:   types.ChainIDString(existingCl.chainID),
+		}
+	}
+
 	myMembers := cl.getMembers().ToArray()
 	exMembers := existingCl.getMembers().ToArray()
 
 	if len(myMembers) != len(exMembers) {
-		return false
+		return fmt.Errorf("%d members in this cluster, but %d in existing cluster", len(myMembers), len(exMembers))
 	}
 
 	// sort by name
@@ -338,7 +352,7 @@ func (cl *Cluster) ValidateAndMergeExistingCluster(existingCl *Cluster) bool {
 		exMember := exMembers[i]
 		if !myMember.IsCompatible(exMember) {
 			logger.Error().Str("mymember", myMember.ToString()).Str("existmember", exMember.ToString()).Msg("not compatible with existing member configuration")
-			return false
+			return fmt.Errorf("member %s is not compatible with existing member configuration", myMember.Name)
 		}
 
 		myMember.SetMemberID(exMember.GetID())
@@ -350,7 +364,7 @@ func (cl *Cluster) ValidateAndMergeExistingCluster(existingCl *Cluster) bool {
 	cl.SetNodeID(myNodeID)
 
 	logger.Debug().Str("my", cl.toStringWithLock()).Msg("cluster merged with existing cluster")
-	return true
+	return nil
 }
 
 func (cl *Cluster) getMemberAttrs() []*types.MemberAttr {
@@ -577,10 +591,20 @@ func (cl *Cluster) toConsensusInfo() *types.ConsensusInfo {
 	}
 
 	type PeerInfo struct {
-		Name   string
-		RaftID string
-		PeerID string
-		Addr   string
+		Name        string
+		RaftID      string
+		PeerID      string
+		Addr        string
+		Match       uint64 `json:",omitempty"`
+		Lag         uint64 `json:",omitempty"`
+		State       string `json:",omitempty"`
+		LastContact string `json:",omitempty"`
+		Snapshot    string `json:",omitempty"`
+	}
+
+	var status raftlib.Status
+	if cl.rs != nil {
+		status = cl.rs.Status()
 	}
 
 	b, err := json.Marshal(cl.getRaftInfo(true))
@@ -601,6 +625,13 @@ func (cl *Cluster) toConsensusInfo() *types.ConsensusInfo {
 
 		for id, m := range cl.getMembers().MapByID {
 			bp := &PeerInfo{Name: m.Name, RaftID: MemberIDToString(m.ID), PeerID: m.GetPeerID().Pretty(), Addr: m.Url}
+
+			ms := &types.ClusterMemberStatus{}
+			cl.setMemberProgress(ms, m.ID, &status)
+			bp.Match, bp.Lag, bp.State, bp.Snapshot = ms.Match, ms.Lag, ms.State, ms.Snapshot
+			if ms.LastContact != 0 {
+				bp.LastContact = time.Unix(0, ms.LastContact).Format(time.RFC3339Nano)
+			}
 			b, err = json.Marshal(bp)
 			if err != nil {
 				logger.Error().Err(err).Str("raftid", MemberIDToString(id)).Msg("failed to marshalEntryData raft consensus bp")
@@ -616,6 +647,61 @@ func (cl *Cluster) toConsensusInfo() *types.ConsensusInfo {
 	return &cons
 }
 
+// toClusterStatus returns the membership of cluster with the replication
+// progress of each member. The progress is tracked only by the leader.
+func (cl *Cluster) toClusterStatus() *types.ClusterStatus {
+	var status raftlib.Status
+	var snapshotIndex uint64
+	if cl.rs != nil {
+		status = cl.rs.Status()
+		if snap, err := cl.rs.raftStorage.Snapshot(); err == nil {
+			snapshotIndex = snap.Metadata.Index
+		}
+	}
+
+	cl.Lock()
+	defer cl.Unlock()
+
+	cs := &types.ClusterStatus{
+		ChainID:       cl.chainID,
+		NodeID:        cl.NodeID(),
+		Leader:        status.Lead,
+		Term:          status.Term,
+		Commit:        status.Commit,
+		Applied:       status.Applied,
+		SnapshotIndex: snapshotIndex,
+		HasProgress:   len(status.Progress) > 0,
+	}
+
+	for _, mbr := range cl.members.ToArray() {
+		attr := mbr.MemberAttr
+		ms := &types.ClusterMemberStatus{Attr: &attr, IsLeader: mbr.ID == status.Lead}
+		cl.setMemberProgress(ms, mbr.ID, &status)
+		cs.Members = append(cs.Members, ms)
+	}
+
+	sort.Slice(cs.Members, func(i, j int) bool { return cs.Members[i].Attr.ID < cs.Members[j].Attr.ID })
+
+	return cs
+}
+
+// setMemberProgress sets the replication progress of the member in the raft
+// status, which only the leader has, and the last contact and the snapshot
+// state tracked by the raft server.
+func (cl *Cluster) setMemberProgress(ms *types.ClusterMemberStatus, id uint64, status *raftlib.Status) {
+	if pr, ok := status.Progress[id]; ok {
+		ms.Match = pr.Match
+		if status.Commit > pr.Match {
+			ms.Lag = status.Commit - pr.Match
+		}
+		ms.State = pr.State.String()
+		ms.Active = pr.RecentActive
+	}
+	if cl.rs != nil && cl.rs.memberProgress != nil {
+		ms.LastContact, ms.Snapshot = cl.rs.memberProgress.get(id)
+	}
+}
+
 func (cl *Cluster) NewMemberFromAddReq(req *types.MembershipChange) (*consensus.Member, error) {
 	peerID, err := peer.IDB58Decode(string(req.Attr.PeerID))
 	if err != nil {
@@ -692,6 +778,13 @@ func (cl *Cluster) requestConfChange(req *types.MembershipChange) (*consensus.Co
 		return nil, err
 	}
 
+	if cc.Type == raftpb.ConfChangeAddNode {
+		if err = cl.checkBpCount(); err != nil {
+			logger.Error().Err(err).Msg("failed to validate request of membership change")
+			return nil, err
+		}
+	}
+
 	replyC := make(chan *consensus.ConfChangeReply)
 
 	// TODO check cancel
@@ -812,6 +905,24 @@ func (cl *Cluster) validateChangeMembership(cc *raftpb.ConfChange, member *conse
 	return nil
 }
 
+// checkBpCount checks that the cluster has room for a new member within the
+// number of BPs voted in the system contract. It is checked only when the
+// change is requested, since the members applying the change may be at
+// different blocks.
+func (cl *Cluster) checkBpCount() error {
+	if cl.sdb == nil {
+		return nil
+	}
+	n, err := system.GetBpCount(cl.sdb)
+	if err != nil {
+		return err
+	}
+	if cl.members.len() >= n {
+		return ErrTooManyMembers
+	}
+	return nil
+}
+
 func (cl *Cluster) makeConfChange(reqType types.MembershipChangeType, member *consensus.Member) (*raftpb.ConfChange, error) {
 	var changeType raftpb.ConfChangeType
 	switch reqType {
@@ -0,0 +1,79 @@
+package raftv2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/internal/clock"
+	raftlib "github.com/aergoio/etcd/raft"
+)
+
+// states of the last snapshot sent to a member
+const (
+	SnapshotSending  = "sending"
+	SnapshotFinished = "finished"
+	SnapshotFailed   = "failed"
+)
+
+// memberProgress tracks what raft doesn't keep in its progress of the
+// members: the time of the last message received from each member and the
+// state of the last snapshot sent to it. The snapshots are sent only by the
+// leader, so the snapshot state is meaningful only on the leader.
+type memberProgress struct {
+	sync.Mutex
+	lastContact map[uint64]time.Time
+	snapshot    map[uint64]string
+}
+
+func newMemberProgress() *memberProgress {
+	return &memberProgress{
+		lastContact: make(map[uint64]time.Time),
+		snapshot:    make(map[uint64]string),
+	}
+}
+
+func (mp *memberProgress) contact(id uint64) {
+	mp.Lock()
+	defer mp.Unlock()
+
+	mp.lastContact[id] = clock.Now()
+}
+
+func (mp *memberProgress) sendSnapshot(id uint64) {
+	mp.Lock()
+	defer mp.Unlock()
+
+	mp.snapshot[id] = SnapshotSending
+}
+
+func (mp *memberProgress) reportSnapshot(id uint64, status raftlib.SnapshotStatus) {
+	mp.Lock()
+	defer mp.Unlock()
+
+	if status == raftlib.SnapshotFinish {
+		mp.snapshot[id] = SnapshotFinished
+	} else {
+		mp.snapshot[id] = SnapshotFailed
+	}
+}
+
+// get returns the last contact time of the member in unix nanoseconds, 0 if
+// nothing is received, and the state of the last snapshot sent to it.
+func (mp *memberProgress) get(id uint64) (int64, string) {
+	mp.Lock()
+	defer mp.Unlock()
+
+	var lastContact int64
+	if t, ok := mp.lastContact[id]; ok {
+		lastContact = t.UnixNano()
+	}
+	return lastContact, mp.snapshot[id]
+}
+
+func (mp *memberProgress) remove(id uint64) {
+	mp.Lock()
+	defer mp.Unlock()
+
+	delete(mp.lastContact, id)
+	delete(mp.snapshot, id)
+}
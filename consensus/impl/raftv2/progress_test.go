@@ -0,0 +1,48 @@
+package raftv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/internal/clock"
+	raftlib "github.com/aergoio/etcd/raft"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberProgress(t *testing.T) {
+	start := time.Unix(1000, 0)
+	vc := clock.NewVirtual(start)
+	clock.Set(vc)
+	defer clock.Set(clock.Real)
+
+	mp := newMemberProgress()
+
+	lastContact, snapshot := mp.get(2)
+	assert.Zero(t, lastContact, "no contact")
+	assert.Empty(t, snapshot, "no snapshot")
+
+	mp.contact(2)
+	vc.Advance(time.Second)
+	mp.contact(3)
+
+	lastContact, _ = mp.get(2)
+	assert.Equal(t, start.UnixNano(), lastContact)
+	lastContact, _ = mp.get(3)
+	assert.Equal(t, start.Add(time.Second).UnixNano(), lastContact)
+
+	mp.sendSnapshot(2)
+	_, snapshot = mp.get(2)
+	assert.Equal(t, SnapshotSending, snapshot)
+	mp.reportSnapshot(2, raftlib.SnapshotFailure)
+	_, snapshot = mp.get(2)
+	assert.Equal(t, SnapshotFailed, snapshot)
+	mp.sendSnapshot(2)
+	mp.reportSnapshot(2, raftlib.SnapshotFinish)
+	_, snapshot = mp.get(2)
+	assert.Equal(t, SnapshotFinished, snapshot)
+
+	mp.remove(2)
+	lastContact, snapshot = mp.get(2)
+	assert.Zero(t, lastContact, "removed member")
+	assert.Empty(t, snapshot, "removed member")
+}
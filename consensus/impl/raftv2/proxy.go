@@ -0,0 +1,205 @@
+package raftv2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+
+	etcdtypes "github.com/aergoio/etcd/pkg/types"
+	"github.com/aergoio/etcd/raft/raftpb"
+	"github.com/aergoio/etcd/rafthttp"
+)
+
+var (
+	// MaxProxyHops is the number of the members a raft message can be relayed
+	// through to reach an unreachable member.
+	MaxProxyHops = 2
+
+	proxyMagic = []byte("aergo-raft-proxy")
+
+	ErrProxyEnvelope = errors.New("invalid raft proxy envelope")
+	ErrProxyDisabled = errors.New("raft proxy is disabled")
+	ErrProxyLoop     = errors.New("raft message is relayed in a loop")
+)
+
+// proxyTransport relays the raft messages to the members the transport
+// doesn't reach through another member, which delivers them if it reaches the
+// target or relays them further up to MaxProxyHops members. It keeps the
+// consensus alive when the members are partially connected, e.g. all the
+// members reach a hub but not each other. The snapshots are not relayed.
+//
+// A relayed message is wrapped in an envelope to the proxy: a MsgUnreachable
+// carrying the members the message was relayed through and the message in the
+// context. The message is never relayed back to those members.
+type proxyTransport struct {
+	rafthttp.Transporter
+	id uint64
+
+	mutex sync.RWMutex
+	peers map[uint64]bool
+}
+
+func newProxyTransport(tr rafthttp.Transporter, id uint64) *proxyTransport {
+	return &proxyTransport{Transporter: tr, id: id, peers: make(map[uint64]bool)}
+}
+
+func (tr *proxyTransport) AddPeer(id etcdtypes.ID, urls []string) {
+	tr.mutex.Lock()
+	tr.peers[uint64(id)] = true
+	tr.mutex.Unlock()
+
+	tr.Transporter.AddPeer(id, urls)
+}
+
+func (tr *proxyTransport) RemovePeer(id etcdtypes.ID) {
+	tr.mutex.Lock()
+	delete(tr.peers, uint64(id))
+	tr.mutex.Unlock()
+
+	tr.Transporter.RemovePeer(id)
+}
+
+func (tr *proxyTransport) RemoveAllPeers() {
+	tr.mutex.Lock()
+	tr.peers = make(map[uint64]bool)
+	tr.mutex.Unlock()
+
+	tr.Transporter.RemoveAllPeers()
+}
+
+// Send sends the messages to the reachable members directly and relays the
+// others. The messages which can't be relayed are sent directly to let the
+// transport report the member unreachable.
+func (tr *proxyTransport) Send(msgs []raftpb.Message) {
+	direct := make([]raftpb.Message, 0, len(msgs))
+	for _, m := range msgs {
+		if m.To == 0 || tr.reachable(m.To) || !tr.relay(m, []uint64{tr.id}) {
+			direct = append(direct, m)
+		}
+	}
+	tr.Transporter.Send(direct)
+}
+
+// forward delivers the message relayed through the members of path to its
+// target, or relays it further.
+func (tr *proxyTransport) forward(m raftpb.Message, path []uint64) {
+	if tr.reachable(m.To) {
+		tr.Transporter.Send([]raftpb.Message{m})
+		return
+	}
+	if !tr.relay(m, append(path, tr.id)) {
+		logger.Debug().Str("from", MemberIDToString(m.From)).Str("to", MemberIDToString(m.To)).
+			Str("type", m.Type.String()).Int("hops", len(path)).Msg("drop raft message which can't be relayed")
+	}
+}
+
+func (tr *proxyTransport) reachable(id uint64) bool {
+	return !tr.ActiveSince(etcdtypes.ID(id)).IsZero()
+}
+
+// relay sends the message in an envelope to a proxy. It returns false if the
+// message was relayed too many times or there is no proxy.
+func (tr *proxyTransport) relay(m raftpb.Message, path []uint64) bool {
+	if len(path) > MaxProxyHops {
+		return false
+	}
+	proxy := tr.pickProxy(m.To, path)
+	if proxy == 0 {
+		return false
+	}
+	env, err := newProxyEnvelope(m, path, proxy)
+	if err != nil {
+		logger.Error().Err(err).Str("to", MemberIDToString(m.To)).Msg("failed to make raft proxy envelope")
+		return false
+	}
+	tr.Transporter.Send([]raftpb.Message{env})
+	return true
+}
+
+// pickProxy returns the reachable member which has been active longest, other
+// than the target and the members of path, or 0 if there is none.
+func (tr *proxyTransport) pickProxy(to uint64, path []uint64) uint64 {
+	tr.mutex.RLock()
+	ids := make([]uint64, 0, len(tr.peers))
+	for id := range tr.peers {
+		ids = append(ids, id)
+	}
+	tr.mutex.RUnlock()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var proxy uint64
+	for _, id := range ids {
+		if id == to || inPath(id, path) {
+			continue
+		}
+		since := tr.ActiveSince(etcdtypes.ID(id))
+		if since.IsZero() {
+			continue
+		}
+		if proxy == 0 || since.Before(tr.ActiveSince(etcdtypes.ID(proxy))) {
+			proxy = id
+		}
+	}
+	return proxy
+}
+
+func inPath(id uint64, path []uint64) bool {
+	for _, p := range path {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+func isProxyEnvelope(m *raftpb.Message) bool {
+	return m.Type == raftpb.MsgUnreachable && bytes.HasPrefix(m.Context, proxyMagic)
+}
+
+// newProxyEnvelope wraps the message relayed through the members of path to
+// the proxy.
+func newProxyEnvelope(m raftpb.Message, path []uint64, proxy uint64) (raftpb.Message, error) {
+	data, err := m.Marshal()
+	if err != nil {
+		return raftpb.Message{}, err
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	ctx := bytes.NewBuffer(append([]byte{}, proxyMagic...))
+	ctx.Write(buf[:binary.PutUvarint(buf, uint64(len(path)))])
+	for _, id := range path {
+		ctx.Write(buf[:binary.PutUvarint(buf, id)])
+	}
+	ctx.Write(data)
+
+	return raftpb.Message{Type: raftpb.MsgUnreachable, From: path[len(path)-1], To: proxy, Context: ctx.Bytes()}, nil
+}
+
+// openProxyEnvelope returns the message in the envelope and the members it
+// was relayed through.
+func openProxyEnvelope(env *raftpb.Message) (raftpb.Message, []uint64, error) {
+	var m raftpb.Message
+
+	if !isProxyEnvelope(env) {
+		return m, nil, ErrProxyEnvelope
+	}
+	r := bytes.NewReader(env.Context[len(proxyMagic):])
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n == 0 || n > uint64(MaxProxyHops) {
+		return m, nil, ErrProxyEnvelope
+	}
+	path := make([]uint64, n)
+	for i := range path {
+		if path[i], err = binary.ReadUvarint(r); err != nil {
+			return m, nil, ErrProxyEnvelope
+		}
+	}
+	data := make([]byte, r.Len())
+	r.Read(data)
+	if err := m.Unmarshal(data); err != nil || isProxyEnvelope(&m) {
+		return m, nil, ErrProxyEnvelope
+	}
+	return m, path, nil
+}
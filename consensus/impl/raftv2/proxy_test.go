@@ -0,0 +1,104 @@
+package raftv2
+
+import (
+	"testing"
+	"time"
+
+	etcdtypes "github.com/aergoio/etcd/pkg/types"
+	"github.com/aergoio/etcd/raft/raftpb"
+	"github.com/aergoio/etcd/rafthttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTransport reaches the members of active and records the sent messages.
+type stubTransport struct {
+	rafthttp.Transporter
+	active map[uint64]time.Time
+	sent   []raftpb.Message
+}
+
+func (tr *stubTransport) Send(msgs []raftpb.Message) {
+	tr.sent = append(tr.sent, msgs...)
+}
+
+func (tr *stubTransport) AddPeer(id etcdtypes.ID, urls []string) {}
+
+func (tr *stubTransport) RemovePeer(id etcdtypes.ID) {}
+
+func (tr *stubTransport) ActiveSince(id etcdtypes.ID) time.Time {
+	return tr.active[uint64(id)]
+}
+
+func TestProxyEnvelope(t *testing.T) {
+	m := raftpb.Message{Type: raftpb.MsgApp, From: 1, To: 4, Term: 3, Index: 10,
+		Entries: []raftpb.Entry{{Term: 3, Index: 11, Data: []byte("block")}}}
+
+	env, err := newProxyEnvelope(m, []uint64{1, 2}, 3)
+	assert.NoError(t, err)
+	assert.True(t, isProxyEnvelope(&env))
+	assert.Equal(t, uint64(2), env.From)
+	assert.Equal(t, uint64(3), env.To)
+
+	opened, path, err := openProxyEnvelope(&env)
+	assert.NoError(t, err)
+	assert.Equal(t, m, opened)
+	assert.Equal(t, []uint64{1, 2}, path)
+
+	assert.False(t, isProxyEnvelope(&m))
+	_, _, err = openProxyEnvelope(&raftpb.Message{Type: raftpb.MsgUnreachable, Context: proxyMagic})
+	assert.Equal(t, ErrProxyEnvelope, err)
+
+	// too long path
+	env, err = newProxyEnvelope(m, []uint64{1, 2, 3}, 5)
+	assert.NoError(t, err)
+	_, _, err = openProxyEnvelope(&env)
+	assert.Equal(t, ErrProxyEnvelope, err)
+
+	// envelope in envelope
+	env, _ = newProxyEnvelope(m, []uint64{1}, 2)
+	env, _ = newProxyEnvelope(env, []uint64{1}, 2)
+	_, _, err = openProxyEnvelope(&env)
+	assert.Equal(t, ErrProxyEnvelope, err)
+}
+
+func TestProxyTransport(t *testing.T) {
+	now := time.Now()
+	stub := &stubTransport{active: map[uint64]time.Time{2: now, 3: now.Add(-time.Minute)}}
+	tr := newProxyTransport(stub, 1)
+	for _, id := range []uint64{2, 3, 4} {
+		tr.AddPeer(etcdtypes.ID(id), nil)
+	}
+
+	// the reachable member gets the message directly and the unreachable one
+	// through the member active longest
+	tr.Send([]raftpb.Message{{Type: raftpb.MsgHeartbeat, From: 1, To: 2}, {Type: raftpb.MsgHeartbeat, From: 1, To: 4}})
+	assert.Equal(t, 2, len(stub.sent))
+	env := stub.sent[0]
+	assert.Equal(t, uint64(3), env.To)
+	m, path, err := openProxyEnvelope(&env)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), m.To)
+	assert.Equal(t, []uint64{1}, path)
+	assert.Equal(t, uint64(2), stub.sent[1].To)
+
+	// the message is not relayed back to the members it was relayed through
+	stub.sent = nil
+	tr.forward(raftpb.Message{Type: raftpb.MsgHeartbeat, From: 3, To: 4}, []uint64{3})
+	assert.Equal(t, 1, len(stub.sent))
+	assert.Equal(t, uint64(2), stub.sent[0].To)
+	_, path, _ = openProxyEnvelope(&stub.sent[0])
+	assert.Equal(t, []uint64{3, 1}, path)
+
+	// nor relayed more than MaxProxyHops
+	stub.sent = nil
+	tr.forward(raftpb.Message{Type: raftpb.MsgHeartbeat, From: 5, To: 4}, []uint64{5, 6})
+	assert.Equal(t, 0, len(stub.sent))
+
+	// the message to the unreachable member without any proxy is sent directly
+	tr.RemovePeer(etcdtypes.ID(2))
+	tr.RemovePeer(etcdtypes.ID(3))
+	tr.Send([]raftpb.Message{{Type: raftpb.MsgHeartbeat, From: 1, To: 4}})
+	assert.Equal(t, 1, len(stub.sent))
+	assert.Equal(t, uint64(4), stub.sent[0].To)
+	assert.Equal(t, raftpb.MsgHeartbeat, stub.sent[0].Type)
+}
@@ -0,0 +1,147 @@
+package raftv2
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterCommit(t *testing.T) {
+	cl := newTestCluster(t, 3)
+	cl.start()
+	defer cl.stop()
+
+	leader := cl.waitLeader()
+	for i := 0; i < 5; i++ {
+		cl.commit(leader)
+	}
+
+	cl.waitHeight(5)
+	cl.checkConsistent()
+}
+
+func TestClusterPartition(t *testing.T) {
+	cl := newTestCluster(t, 3)
+	cl.start()
+	defer cl.stop()
+
+	oldLeader := cl.waitLeader()
+	cl.commit(oldLeader)
+
+	var others []int
+	for i := range cl.nodes {
+		if i != oldLeader {
+			others = append(others, i)
+		}
+	}
+
+	// the majority elects a new leader and goes on without the old leader
+	cl.partition([]int{oldLeader}, others)
+	leader := cl.waitLeader(others...)
+	assert.NotEqual(t, oldLeader, leader)
+	for i := 0; i < 3; i++ {
+		cl.commit(leader)
+	}
+	cl.waitHeight(4, others...)
+	assert.Equal(t, types.BlockNo(1), cl.nodes[oldLeader].bestBlock().BlockNo(), "minority committed")
+
+	// the old leader catches up after the partition heals
+	cl.heal()
+	cl.waitHeight(4)
+	cl.checkConsistent()
+}
+
+func TestClusterProxy(t *testing.T) {
+	cl := newTestCluster(t, 3)
+	cl.proxy = true
+	cl.start()
+	defer cl.stop()
+
+	leader := cl.waitLeader()
+	cl.commit(leader)
+
+	// the messages between the leader and the follower cut from it are
+	// relayed through the other member, so the leader stays and the follower
+	// keeps up
+	follower := (leader + 1) % len(cl.nodes)
+	cl.cut(leader, follower)
+	for i := 0; i < 3; i++ {
+		cl.commit(leader)
+	}
+	cl.waitHeight(4)
+	assert.Equal(t, leader, cl.waitLeader())
+	cl.checkConsistent()
+}
+
+func TestClusterCrashRestart(t *testing.T) {
+	for _, point := range []int{1, 2} {
+		cl := newTestCluster(t, 3)
+		cl.start()
+
+		leader := cl.waitLeader()
+		cl.commit(leader)
+
+		follower := (leader + 1) % len(cl.nodes)
+		cl.crash(follower, point)
+
+		// the other two are the quorum
+		leader = cl.waitLeader()
+		for i := 0; i < 3; i++ {
+			cl.commit(leader)
+		}
+
+		// the crashed one replays its wal and catches up
+		cl.restart(follower)
+		cl.waitHeight(4)
+		cl.checkConsistent()
+
+		cl.stop()
+	}
+}
+
+func TestClusterSnapshot(t *testing.T) {
+	defer func(n uint64) { ConfSnapshotCatchUpEntriesN = n }(ConfSnapshotCatchUpEntriesN)
+	ConfSnapshotCatchUpEntriesN = 1
+
+	cl := newTestCluster(t, 3)
+	cl.snapFrequency = 2
+	cl.start()
+	defer cl.stop()
+
+	leader := cl.waitLeader()
+	cl.commit(leader)
+
+	follower := (leader + 1) % len(cl.nodes)
+	cl.crash(follower, 1)
+
+	// the log is compacted while the follower is down, so that it catches
+	// up from a snapshot of the leader
+	leader = cl.waitLeader()
+	for i := 0; i < 10; i++ {
+		cl.commit(leader)
+	}
+
+	cl.restart(follower)
+	cl.waitHeight(11)
+	cl.checkConsistent()
+}
+
+func TestClusterSlowDisk(t *testing.T) {
+	cl := newTestCluster(t, 3)
+	cl.start()
+	defer cl.stop()
+
+	leader := cl.waitLeader()
+	slow := (leader + 1) % len(cl.nodes)
+	cl.slowDisk(slow, 100)
+
+	// the commits don't wait for the slow one
+	for i := 0; i < 5; i++ {
+		cl.commit(leader)
+	}
+
+	cl.slowDisk(slow, 0)
+	cl.waitHeight(5)
+	cl.checkConsistent()
+}
@@ -35,6 +35,9 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/clock"
+	"github.com/aergoio/aergo/internal/nodeevent"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/types"
 
 	"github.com/aergoio/etcd/etcdserver/stats"
@@ -45,6 +48,13 @@ import (
 	"github.com/aergoio/etcd/snap"
 )
 
+// types of the consensus events streamed by the rpc service
+const (
+	ConsensusEventLeaderChanged = "LEADER_CHANGED"
+	ConsensusEventMemberAdded   = "MEMBER_ADDED"
+	ConsensusEventMemberRemoved = "MEMBER_REMOVED"
+)
+
 //noinspection ALL
 var (
 	raftLogger                  raftlib.Logger
@@ -60,6 +70,10 @@ var (
 	ErrCCNoMemberToRemove  = errors.New("there is no member to remove")
 	ErrEmptySnapshot       = errors.New("received empty snapshot")
 	ErrInvalidRaftIdentity = errors.New("raft identity is not set")
+	ErrNoBlockToSnapshot   = errors.New("no applied block to make snapshot")
+	ErrSnapshotTimeOut     = errors.New("timeouted snapshot request")
+	ErrTransferToSelf      = errors.New("leader can't transfer leadership to itself")
+	ErrTransferTimeOut     = errors.New("timeouted leadership transfer")
 )
 
 const (
@@ -80,6 +94,7 @@ type raftServer struct {
 	cluster *Cluster
 
 	confChangeC <-chan *consensus.ConfChangePropose // proposed cluster config changes
+	snapshotC   chan chan snapshotReply             // requests of snapshot from admin
 	commitC     chan *types.Block                   // entries committed to log (k,v)
 	errorC      chan error                          // errors from raft session
 
@@ -102,13 +117,20 @@ type raftServer struct {
 	snapshotterReady chan *snap.Snapshotter // signals when snapshotter is ready
 
 	snapFrequency uint64
-	transport     *rafthttp.Transport
+	transport     rafthttp.Transporter
 	stopc         chan struct{} // signals proposal channel closed
 	httpstopc     chan struct{} // signals http server to shutdown
 	httpdonec     chan struct{} // signals http server shutdown complete
 
+	newTransport transportFactory // rafthttp is used if nil
+	useProxy     bool             // relay the messages to the unreachable members
+	proxy        *proxyTransport  // set if useProxy
+	debugger     *chain.Debugger  // chain.TestDebugger is used if nil
+
 	leaderStatus LeaderStatus
 
+	memberProgress *memberProgress // contacts and snapshots of the members
+
 	certFile string
 	keyFile  string
 
@@ -122,6 +144,11 @@ type raftServer struct {
 	prevProgress BlockProgress // prev state before appling last block
 }
 
+// transportFactory makes the transport delivering the raft messages of the
+// node id to the other members and the received ones to r. It replaces
+// rafthttp to run the members in a process.
+type transportFactory func(id uint64, r rafthttp.Raft) rafthttp.Transporter
+
 type BlockProgress struct {
 	block     *types.Block //tracking last applied block. It's initillay set at repling wal
 	index     uint64
@@ -184,6 +211,7 @@ func newRaftServer(hub *component.ComponentHub,
 		cluster:       cluster,
 		walDB:         NewWalDB(chainWal),
 		confChangeC:   confChangeC,
+		snapshotC:     make(chan chan snapshotReply),
 		commitC:       commitC,
 		errorC:        errorC,
 		listenUrl:     listenUrl,
@@ -203,6 +231,8 @@ func newRaftServer(hub *component.ComponentHub,
 		lock:       sync.RWMutex{},
 		promotable: true,
 		tickMS:     tickMS,
+
+		memberProgress: newMemberProgress(),
 	}
 
 	if delayPromote {
@@ -313,8 +343,8 @@ func (rs *raftServer) startRaft() {
 		}
 
 		// config validate
-		if !rs.cluster.ValidateAndMergeExistingCluster(existCluster) {
-			logger.Fatal().Str("existcluster", existCluster.toString()).Str("mycluster", rs.cluster.toString()).Msg("this cluster configuration is not compatible with existing cluster")
+		if err := rs.cluster.ValidateAndMergeExistingCluster(existCluster); err != nil {
+			logger.Fatal().Err(err).Str("existcluster", existCluster.toString()).Str("mycluster", rs.cluster.toString()).Msg("this cluster configuration is not compatible with existing cluster")
 		}
 
 		rs.SetID(rs.cluster.NodeID())
@@ -360,22 +390,33 @@ func (rs *raftServer) startRaft() {
 
 	rs.startTransport()
 
-	go rs.serveRaft()
+	if rs.newTransport == nil {
+		go rs.serveRaft()
+	}
 	go rs.serveChannels()
 }
 
 func (rs *raftServer) startTransport() {
-	rs.transport = &rafthttp.Transport{
-		ID:          etcdtypes.ID(rs.id),
-		ClusterID:   0x1000,
-		Raft:        rs,
-		ServerStats: stats.NewServerStats("", ""),
-		LeaderStats: stats.NewLeaderStats(strconv.FormatUint(uint64(rs.id), 10)),
-		Snapshotter: rs.snapshotter,
-		ErrorC:      rs.errorC,
-	}
+	if rs.newTransport != nil {
+		rs.transport = rs.newTransport(rs.id, rs)
+	} else {
+		transport := &rafthttp.Transport{
+			ID:          etcdtypes.ID(rs.id),
+			ClusterID:   0x1000,
+			Raft:        rs,
+			ServerStats: stats.NewServerStats("", ""),
+			LeaderStats: stats.NewLeaderStats(strconv.FormatUint(uint64(rs.id), 10)),
+			Snapshotter: rs.snapshotter,
+			ErrorC:      rs.errorC,
+		}
 
-	rs.transport.SetLogger(httpLogger)
+		transport.SetLogger(httpLogger)
+		rs.transport = transport
+	}
+	if rs.useProxy {
+		rs.proxy = newProxyTransport(rs.transport, rs.id)
+		rs.transport = rs.proxy
+	}
 
 	if err := rs.transport.Start(); err != nil {
 		logger.Fatal().Err(err).Msg("failed to start raft http")
@@ -429,7 +470,30 @@ func (rs *raftServer) stop() {
 func (rs *raftServer) stopHTTP() {
 	rs.transport.Stop()
 	close(rs.httpstopc)
-	<-rs.httpdonec
+	if rs.newTransport == nil {
+		<-rs.httpdonec
+	}
+}
+
+func (rs *raftServer) getDebugger() *chain.Debugger {
+	if rs.debugger != nil {
+		return rs.debugger
+	}
+	return chain.TestDebugger
+}
+
+// crashByDebugger stops the raft server at the point of crash, if the
+// debugger is set to crash there. The entries of the ready not saved or
+// applied yet are lost as a crash of the node.
+func (rs *raftServer) crashByDebugger(point int) bool {
+	err := rs.getDebugger().Check(chain.DEBUG_RAFT_CRASH, point, nil)
+	if err == nil {
+		return false
+	}
+
+	logger.Warn().Err(err).Str("ID", MemberIDToString(rs.id)).Msg("raft server crashed")
+	rs.stop()
+	return true
 }
 
 func (rs *raftServer) writeError(err error) {
@@ -487,7 +551,7 @@ func (rs *raftServer) serveChannels() {
 	rs.setSnapshotIndex(snapshot.Metadata.Index)
 	rs.setAppliedIndex(snapshot.Metadata.Index)
 
-	ticker := time.NewTicker(rs.tickMS)
+	ticker := clock.NewTicker(rs.tickMS)
 	defer ticker.Stop()
 
 	go rs.serveConfChange()
@@ -495,7 +559,7 @@ func (rs *raftServer) serveChannels() {
 	// event loop on raft state machine updates
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			if rs.GetPromotable() {
 				rs.node.Tick()
 			}
@@ -512,6 +576,11 @@ func (rs *raftServer) serveChannels() {
 				}
 			}
 
+			_ = rs.getDebugger().Check(chain.DEBUG_RAFT_WAL_SLEEP, 0, nil)
+			if rs.crashByDebugger(1) {
+				return
+			}
+
 			if err := rs.walDB.SaveEntry(rd.HardState, rd.Entries); err != nil {
 				logger.Fatal().Err(err).Msg("failed to save entry to wal")
 			}
@@ -533,6 +602,10 @@ func (rs *raftServer) serveChannels() {
 				logger.Fatal().Err(err).Msg("failed to append new entries to raft log")
 			}
 
+			if rs.crashByDebugger(2) {
+				return
+			}
+
 			if !rs.IsLeader() {
 				if err := rs.processMessages(rd.Messages); err != nil {
 					logger.Fatal().Err(err).Msg("process message error")
@@ -550,6 +623,8 @@ func (rs *raftServer) serveChannels() {
 			}
 
 			rs.node.Advance()
+		case replyC := <-rs.snapshotC:
+			replyC <- rs.snapshotNow()
 		case err := <-rs.errorC:
 			rs.writeError(err)
 			return
@@ -575,6 +650,7 @@ func (rs *raftServer) processMessages(msgs []raftpb.Message) error {
 				return err
 			}
 			snapMsgs = append(snapMsgs, tmpSnapMsg)
+			rs.memberProgress.sendSnapshot(msg.To)
 
 			msgs[i].To = 0
 		}
@@ -806,6 +882,54 @@ func (rs *raftServer) triggerSnapshot() {
 		return
 	}
 
+	rs.makeSnapshot(newSnapshotIndex)
+
+	rs.getDebugger().Check(chain.DEBUG_RAFT_SNAP_FREQ, 0,
+		func(freq int) error {
+			rs.snapFrequency = uint64(freq)
+			return nil
+		})
+}
+
+type snapshotReply struct {
+	index uint64
+	err   error
+}
+
+// RequestSnapshot makes a snapshot of the last applied block regardless of
+// the snapshot frequency and returns the index of the snapshot. The snapshot
+// is made in the event loop of raft server.
+func (rs *raftServer) RequestSnapshot() (uint64, error) {
+	replyC := make(chan snapshotReply, 1)
+
+	select {
+	case rs.snapshotC <- replyC:
+	case <-time.After(MaxConfChangeTimeOut):
+		return 0, ErrSnapshotTimeOut
+	}
+
+	select {
+	case reply := <-replyC:
+		return reply.index, reply.err
+	case <-time.After(MaxConfChangeTimeOut):
+		return 0, ErrSnapshotTimeOut
+	}
+}
+
+func (rs *raftServer) snapshotNow() snapshotReply {
+	if rs.prevProgress.index == 0 || rs.prevProgress.block == nil {
+		return snapshotReply{err: ErrNoBlockToSnapshot}
+	}
+
+	if rs.prevProgress.index > rs.snapshotIndex {
+		rs.makeSnapshot(rs.prevProgress.index)
+	}
+
+	return snapshotReply{index: rs.snapshotIndex}
+}
+
+// makeSnapshot creates snapshot at newSnapshotIndex, saves it to wal and compacts raft log storage
+func (rs *raftServer) makeSnapshot(newSnapshotIndex uint64) {
 	logger.Info().Uint64("applied", rs.appliedIndex).Uint64("new snap index", newSnapshotIndex).Uint64("last snapshot index", rs.snapshotIndex).Msg("start snapshot")
 
 	// make snapshot data of previous connected block
@@ -843,12 +967,6 @@ func (rs *raftServer) triggerSnapshot() {
 
 	logger.Info().Uint64("index", compactIndex).Msg("compacted raftLog.at index")
 	rs.setSnapshotIndex(newSnapshotIndex)
-
-	chain.TestDebugger.Check(chain.DEBUG_RAFT_SNAP_FREQ, 0,
-		func(freq int) error {
-			rs.snapFrequency = uint64(freq)
-			return nil
-		})
 }
 
 func (rs *raftServer) publishSnapshot(snapshotToSave raftpb.Snapshot) error {
@@ -999,10 +1117,17 @@ func (rs *raftServer) applyConfChange(ent *raftpb.Entry) bool {
 			return false
 		}
 		rs.transport.RemovePeer(etcdtypes.ID(cc.NodeID))
+		rs.memberProgress.remove(cc.NodeID)
 	}
 
 	logger.Debug().Str("cluster", rs.cluster.toString()).Msg("after conf changed")
 
+	if cc.Type == raftpb.ConfChangeAddNode {
+		rs.notifyConsensusEvent(ConsensusEventMemberAdded, ent.Term, member)
+	} else if cc.Type == raftpb.ConfChangeRemoveNode {
+		rs.notifyConsensusEvent(ConsensusEventMemberRemoved, ent.Term, member)
+	}
+
 	rs.cluster.sendConfChangeReply(cc, member, nil)
 
 	return true
@@ -1083,9 +1208,33 @@ func (rs *raftServer) setConfState(state raftpb.ConfState) {
 }
 
 func (rs *raftServer) Process(ctx context.Context, m raftpb.Message) error {
+	if isProxyEnvelope(&m) {
+		return rs.processRelayed(ctx, &m)
+	}
+	rs.memberProgress.contact(m.From)
 	return rs.node.Step(ctx, m)
 }
 
+// processRelayed processes the message in the envelope if it is to this
+// member, or forwards it as a proxy.
+func (rs *raftServer) processRelayed(ctx context.Context, env *raftpb.Message) error {
+	m, path, err := openProxyEnvelope(env)
+	if err != nil {
+		return err
+	}
+	if m.To == rs.id {
+		return rs.Process(ctx, m)
+	}
+	if rs.proxy == nil {
+		return ErrProxyDisabled
+	}
+	if inPath(rs.id, path) {
+		return ErrProxyLoop
+	}
+	rs.proxy.forward(m, path)
+	return nil
+}
+
 func (rs *raftServer) IsIDRemoved(id uint64) bool {
 	return rs.cluster.IsIDRemoved(id)
 }
@@ -1101,6 +1250,7 @@ func (rs *raftServer) ReportSnapshot(id uint64, status raftlib.SnapshotStatus) {
 		logger.Debug().Str("toID", MemberIDToString(id)).Bool("isSucceed", status == raftlib.SnapshotFinish).Msg("report snapshot result")
 	}
 
+	rs.memberProgress.reportSnapshot(id, status)
 	rs.node.ReportSnapshot(id, status)
 }
 
@@ -1121,9 +1271,36 @@ func (rs *raftServer) updateLeader(softState *raftlib.SoftState) {
 		rs.leaderStatus.leaderChanged++
 
 		logger.Info().Str("ID", MemberIDToString(rs.id)).Str("leader", MemberIDToString(softState.Lead)).Msg("leader changed")
+
+		rs.cluster.Lock()
+		leader := rs.cluster.getMembers().getMember(softState.Lead)
+		rs.cluster.Unlock()
+		rs.notifyConsensusEvent(ConsensusEventLeaderChanged, rs.node.Status().Term, leader)
 	}
 }
 
+// notifyConsensusEvent tells the rpc service a change of the leader or the
+// membership of the cluster to stream it, and publishes it as a node event.
+func (rs *raftServer) notifyConsensusEvent(evType string, term uint64, member *consensus.Member) {
+	fields := map[string]interface{}{"term": term}
+	if member != nil {
+		fields["memberID"] = MemberIDToString(member.ID)
+		fields["memberName"] = member.Name
+		fields["memberUrl"] = member.Url
+	}
+	nodeevent.Publish(nodeevent.CategoryMembership, evType, "the raft cluster changed", fields)
+
+	if rs.ComponentHub == nil {
+		return
+	}
+	ev := &types.ConsensusEvent{Type: evType, Term: term, Timestamp: time.Now().UnixNano()}
+	if member != nil {
+		attr := member.MemberAttr
+		ev.Member = &attr
+	}
+	rs.Tell(message.RPCSvc, ev)
+}
+
 func (rs *raftServer) GetLeader() uint64 {
 	return atomic.LoadUint64(&rs.leaderStatus.leader)
 }
@@ -1132,6 +1309,39 @@ func (rs *raftServer) IsLeader() bool {
 	return rs.id != consensus.InvalidMemberID && rs.id == rs.GetLeader()
 }
 
+// TransferLeadership requests the leadership transfer to transferee and waits
+// until the transferee becomes leader.
+func (rs *raftServer) TransferLeadership(transferee uint64) error {
+	node := rs.getNodeSync()
+	if node == nil || !rs.IsLeader() {
+		return ErrNotRaftLeader
+	}
+
+	if transferee == rs.id {
+		return ErrTransferToSelf
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), MaxConfChangeTimeOut)
+	defer cancel()
+
+	node.TransferLeadership(ctx, rs.id, transferee)
+
+	ticker := time.NewTicker(rs.tickMS)
+	defer ticker.Stop()
+
+	for rs.GetLeader() != transferee {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ErrTransferTimeOut
+		}
+	}
+
+	logger.Info().Str("leader", MemberIDToString(transferee)).Msg("leadership transferred")
+
+	return nil
+}
+
 func (rs *raftServer) Status() raftlib.Status {
 	node := rs.getNodeSync()
 	if node == nil {
@@ -0,0 +1,847 @@
+package raftv2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/types"
+	etcdtypes "github.com/aergoio/etcd/pkg/types"
+	raftlib "github.com/aergoio/etcd/raft"
+	"github.com/aergoio/etcd/raft/raftpb"
+	"github.com/aergoio/etcd/rafthttp"
+	"github.com/aergoio/etcd/snap"
+)
+
+// The test cluster runs the raft servers of its members in a process. They
+// exchange the messages by memNetwork and save the entries to memWAL instead
+// of the network and the chain db, and the committed blocks are connected to
+// the chain of each member kept in memory. The faults are injected by
+// cutting the links of memNetwork and by the debugger of each member, which
+// makes the raft server crash or its wal slow.
+
+const (
+	testTickMS      = 10 * time.Millisecond
+	testWaitTimeout = 10 * time.Second
+	memInboxSize    = 4096
+)
+
+var (
+	errNoWalBlock   = errors.New("no block in wal")
+	errLinkCut      = errors.New("link is cut")
+	errNoSnapSource = errors.New("no block of snapshot in the sender")
+)
+
+// memWAL is consensus.ChainWAL in memory. It survives the crash of its raft
+// server to be replayed at the restart.
+type memWAL struct {
+	sync.Mutex
+
+	entries   map[uint64]consensus.WalEntry
+	lastIdx   uint64
+	blocks    map[string]*types.Block
+	blockIdx  map[string]uint64
+	hardState raftpb.HardState
+	snapshot  *raftpb.Snapshot
+	identity  *consensus.RaftIdentity
+	proposed  *types.Block
+}
+
+func newMemWAL() *memWAL {
+	return &memWAL{
+		entries:  make(map[uint64]consensus.WalEntry),
+		blocks:   make(map[string]*types.Block),
+		blockIdx: make(map[string]uint64),
+	}
+}
+
+func (w *memWAL) GetBestBlock() (*types.Block, error)                      { return nil, nil }
+func (w *memWAL) GetBlockByNo(blockNo types.BlockNo) (*types.Block, error) { return nil, errNoWalBlock }
+func (w *memWAL) GetGenesisInfo() *types.Genesis                           { return nil }
+func (w *memWAL) Get(key []byte) []byte                                    { return nil }
+func (w *memWAL) NewTx() db.Transaction                                    { return nil }
+
+func (w *memWAL) IsWALInited() bool {
+	has, _ := w.HasWal()
+	return has
+}
+
+func (w *memWAL) GetBlock(blockHash []byte) (*types.Block, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	block, ok := w.blocks[string(blockHash)]
+	if !ok {
+		return nil, errNoWalBlock
+	}
+	return block, nil
+}
+
+func (w *memWAL) ReadAll() (state raftpb.HardState, ents []raftpb.Entry, err error) {
+	return raftpb.HardState{}, nil, nil
+}
+
+func (w *memWAL) WriteRaftEntry(ents []*consensus.WalEntry, blocks []*types.Block) error {
+	w.Lock()
+	defer w.Unlock()
+
+	for i, entry := range ents {
+		if entry.Type == consensus.EntryBlock {
+			hash := string(blocks[i].BlockHash())
+			w.blocks[hash] = blocks[i]
+			w.blockIdx[hash] = entry.Index
+		}
+		w.entries[entry.Index] = *entry
+		w.lastIdx = entry.Index
+	}
+
+	return nil
+}
+
+func (w *memWAL) GetRaftEntry(idx uint64) (*consensus.WalEntry, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	entry, ok := w.entries[idx]
+	if !ok || idx > w.lastIdx {
+		return nil, chain.ErrNoWalEntry
+	}
+	return &entry, nil
+}
+
+func (w *memWAL) GetRaftEntryIndexOfBlock(hash []byte) (uint64, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	idx, ok := w.blockIdx[string(hash)]
+	if !ok {
+		return 0, chain.ErrNoWalEntry
+	}
+	return idx, nil
+}
+
+func (w *memWAL) HasWal() (bool, error) {
+	last, err := w.GetRaftEntryLastIdx()
+	return last > 0, err
+}
+
+func (w *memWAL) GetRaftEntryLastIdx() (uint64, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	return w.lastIdx, nil
+}
+
+func (w *memWAL) GetHardState() (*raftpb.HardState, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	state := w.hardState
+	return &state, nil
+}
+
+func (w *memWAL) WriteHardState(hardstate *raftpb.HardState) error {
+	w.Lock()
+	defer w.Unlock()
+
+	w.hardState = *hardstate
+	return nil
+}
+
+func (w *memWAL) WriteSnapshot(snap *raftpb.Snapshot) error {
+	w.Lock()
+	defer w.Unlock()
+
+	saved := *snap
+	w.snapshot = &saved
+	return nil
+}
+
+func (w *memWAL) GetSnapshot() (*raftpb.Snapshot, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.snapshot == nil {
+		return nil, nil
+	}
+	snap := *w.snapshot
+	return &snap, nil
+}
+
+func (w *memWAL) WriteIdentity(id *consensus.RaftIdentity) error {
+	w.Lock()
+	defer w.Unlock()
+
+	saved := *id
+	w.identity = &saved
+	return nil
+}
+
+func (w *memWAL) GetIdentity() (*consensus.RaftIdentity, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.identity == nil {
+		return nil, nil
+	}
+	id := *w.identity
+	return &id, nil
+}
+
+func (w *memWAL) WriteProposed(block *types.Block) error {
+	w.Lock()
+	defer w.Unlock()
+
+	w.proposed = block
+	return nil
+}
+
+func (w *memWAL) GetProposed() (*types.Block, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	return w.proposed, nil
+}
+
+// memNetwork delivers the raft messages between the transports of the
+// members in memory. The messages over a cut link are dropped.
+type memNetwork struct {
+	sync.RWMutex
+
+	transports map[uint64]*memTransport
+	cut        map[uint64]map[uint64]bool
+
+	// syncSnap brings the chain of the snapshot to the receiver before the
+	// snapshot is delivered, as the chain snapshotter does over rafthttp
+	syncSnap func(to uint64, msg raftpb.Message) error
+}
+
+func newMemNetwork() *memNetwork {
+	return &memNetwork{
+		transports: make(map[uint64]*memTransport),
+		cut:        make(map[uint64]map[uint64]bool),
+	}
+}
+
+func (net *memNetwork) newTransport(id uint64, r rafthttp.Raft) rafthttp.Transporter {
+	return &memTransport{
+		net:   net,
+		id:    id,
+		raft:  r,
+		peers: make(map[uint64]bool),
+		inbox: make(chan raftpb.Message, memInboxSize),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+}
+
+func (net *memNetwork) register(tr *memTransport) {
+	net.Lock()
+	defer net.Unlock()
+
+	net.transports[tr.id] = tr
+}
+
+func (net *memNetwork) unregister(tr *memTransport) {
+	net.Lock()
+	defer net.Unlock()
+
+	if net.transports[tr.id] == tr {
+		delete(net.transports, tr.id)
+	}
+}
+
+// partition cuts the links between the groups of the member ids.
+func (net *memNetwork) partition(groups ...[]uint64) {
+	net.Lock()
+	defer net.Unlock()
+
+	net.cut = make(map[uint64]map[uint64]bool)
+	for i, group := range groups {
+		for j, other := range groups {
+			if i == j {
+				continue
+			}
+			for _, from := range group {
+				if net.cut[from] == nil {
+					net.cut[from] = make(map[uint64]bool)
+				}
+				for _, to := range other {
+					net.cut[from][to] = true
+				}
+			}
+		}
+	}
+}
+
+// cutLink cuts the link between the members a and b.
+func (net *memNetwork) cutLink(a uint64, b uint64) {
+	net.Lock()
+	defer net.Unlock()
+
+	for _, link := range [][2]uint64{{a, b}, {b, a}} {
+		if net.cut[link[0]] == nil {
+			net.cut[link[0]] = make(map[uint64]bool)
+		}
+		net.cut[link[0]][link[1]] = true
+	}
+}
+
+// heal restores all the links.
+func (net *memNetwork) heal() {
+	net.Lock()
+	defer net.Unlock()
+
+	net.cut = make(map[uint64]map[uint64]bool)
+}
+
+func (net *memNetwork) route(from uint64, to uint64) (*memTransport, error) {
+	net.RLock()
+	defer net.RUnlock()
+
+	if net.cut[from][to] {
+		return nil, errLinkCut
+	}
+	tr, ok := net.transports[to]
+	if !ok {
+		return nil, fmt.Errorf("member %s is down", MemberIDToString(to))
+	}
+	return tr, nil
+}
+
+func (net *memNetwork) deliver(from uint64, msg raftpb.Message) error {
+	tr, err := net.route(from, msg.To)
+	if err != nil {
+		return err
+	}
+	return tr.receive(msg)
+}
+
+func (net *memNetwork) deliverSnapshot(from uint64, msg raftpb.Message) error {
+	if _, err := net.route(from, msg.To); err != nil {
+		return err
+	}
+	if net.syncSnap != nil {
+		if err := net.syncSnap(msg.To, msg); err != nil {
+			return err
+		}
+	}
+	return net.deliver(from, msg)
+}
+
+// memTransport is rafthttp.Transporter over memNetwork. The received messages
+// are passed to raft in the order of arrival by its own goroutine.
+type memTransport struct {
+	net  *memNetwork
+	id   uint64
+	raft rafthttp.Raft
+
+	mutex sync.RWMutex
+	peers map[uint64]bool
+
+	started time.Time
+	inbox   chan raftpb.Message
+	stopc   chan struct{}
+	donec   chan struct{}
+}
+
+func (tr *memTransport) Start() error {
+	tr.started = time.Now()
+	tr.net.register(tr)
+	go tr.loop()
+	return nil
+}
+
+func (tr *memTransport) loop() {
+	defer close(tr.donec)
+	for {
+		select {
+		case msg := <-tr.inbox:
+			if err := tr.raft.Process(context.TODO(), msg); err != nil {
+				logger.Debug().Err(err).Str("to", MemberIDToString(tr.id)).Msg("failed to process raft message")
+			}
+		case <-tr.stopc:
+			return
+		}
+	}
+}
+
+func (tr *memTransport) receive(msg raftpb.Message) error {
+	select {
+	case tr.inbox <- msg:
+		return nil
+	case <-tr.stopc:
+		return fmt.Errorf("member %s is down", MemberIDToString(tr.id))
+	default:
+		return fmt.Errorf("inbox of member %s is full", MemberIDToString(tr.id))
+	}
+}
+
+func (tr *memTransport) Handler() http.Handler {
+	return http.NotFoundHandler()
+}
+
+func (tr *memTransport) isPeer(id uint64) bool {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return tr.peers[id]
+}
+
+func (tr *memTransport) Send(msgs []raftpb.Message) {
+	for _, msg := range msgs {
+		// MsgSnap is sent by SendSnapshot
+		if msg.To == 0 || !tr.isPeer(msg.To) {
+			continue
+		}
+		if err := tr.net.deliver(tr.id, msg); err != nil {
+			tr.raft.ReportUnreachable(msg.To)
+		}
+	}
+}
+
+func (tr *memTransport) SendSnapshot(msg snap.Message) {
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, msg.ReadCloser)
+
+		status := raftlib.SnapshotFinish
+		if !tr.isPeer(msg.To) {
+			status = raftlib.SnapshotFailure
+		} else if err := tr.net.deliverSnapshot(tr.id, msg.Message); err != nil {
+			logger.Debug().Err(err).Str("to", MemberIDToString(msg.To)).Msg("failed to send snapshot")
+			status = raftlib.SnapshotFailure
+		}
+		msg.CloseWithError(nil)
+		tr.raft.ReportSnapshot(msg.To, status)
+	}()
+}
+
+func (tr *memTransport) AddRemote(id etcdtypes.ID, urls []string) {}
+
+func (tr *memTransport) AddPeer(id etcdtypes.ID, urls []string) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	tr.peers[uint64(id)] = true
+}
+
+func (tr *memTransport) RemovePeer(id etcdtypes.ID) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	delete(tr.peers, uint64(id))
+}
+
+func (tr *memTransport) RemoveAllPeers() {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	tr.peers = make(map[uint64]bool)
+}
+
+func (tr *memTransport) UpdatePeer(id etcdtypes.ID, urls []string) {}
+
+// ActiveSince returns the time the transport started if the link to the
+// member is not cut.
+func (tr *memTransport) ActiveSince(id etcdtypes.ID) time.Time {
+	if !tr.isPeer(uint64(id)) {
+		return time.Time{}
+	}
+	if _, err := tr.net.route(tr.id, uint64(id)); err != nil {
+		return time.Time{}
+	}
+	return tr.started
+}
+
+func (tr *memTransport) ActivePeers() int {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return len(tr.peers)
+}
+
+func (tr *memTransport) Stop() {
+	tr.net.unregister(tr)
+	close(tr.stopc)
+	<-tr.donec
+}
+
+// testNode is a member of the test cluster. Its wal and chain are kept over
+// the crashes of its raft server.
+type testNode struct {
+	cl       *testCluster
+	member   *consensus.Member
+	wal      *memWAL
+	debugger *chain.Debugger
+
+	rs          *raftServer
+	confChangeC chan *consensus.ConfChangePropose
+	donec       chan struct{} // closed when the raft server stops
+	running     bool
+
+	mutex  sync.RWMutex
+	blocks []*types.Block // chain connected from the committed blocks
+}
+
+func (n *testNode) start() {
+	cluster := NewCluster(n.cl.chainID, nil, n.member.Name, 0)
+	for _, m := range n.cl.members {
+		mbr := *m
+		if err := cluster.addMember(&mbr, false); err != nil {
+			n.cl.t.Fatalf("failed to add member %s: %v", m.Name, err)
+		}
+	}
+
+	n.confChangeC = make(chan *consensus.ConfChangePropose)
+	commitC := make(chan *types.Block)
+
+	rs := newRaftServer(nil, cluster, n.member.Url, false, "", "", nil, testTickMS,
+		n.confChangeC, commitC, false, n.wal)
+	rs.newTransport = n.cl.network.newTransport
+	rs.useProxy = n.cl.proxy
+	rs.debugger = n.debugger
+	if n.cl.snapFrequency != 0 {
+		rs.snapFrequency = n.cl.snapFrequency
+	}
+	cluster.rs = rs
+
+	n.rs = rs
+	n.donec = make(chan struct{})
+	n.running = true
+
+	go n.connect(commitC, n.donec)
+	rs.Start()
+}
+
+// connect connects the committed blocks to the chain of the node until the
+// raft server stops. The blocks not following the best block, which are
+// proposed on a stale best block or replayed from the wal, are skipped as
+// the chain service does.
+func (n *testNode) connect(commitC chan *types.Block, donec chan struct{}) {
+	defer close(donec)
+
+	for block := range commitC {
+		if block == nil {
+			continue
+		}
+
+		n.mutex.Lock()
+		best := n.blocks[len(n.blocks)-1]
+		if block.BlockNo() == best.BlockNo()+1 && bytes.Equal(block.GetHeader().GetPrevBlockHash(), best.BlockHash()) {
+			n.blocks = append(n.blocks, block)
+		}
+		n.mutex.Unlock()
+	}
+}
+
+// stop stops the raft server gracefully.
+func (n *testNode) stop() {
+	if !n.running {
+		return
+	}
+	close(n.confChangeC)
+	n.wait()
+}
+
+func (n *testNode) wait() {
+	select {
+	case <-n.donec:
+	case <-time.After(testWaitTimeout):
+		n.cl.t.Fatalf("raft server of %s didn't stop", n.member.Name)
+	}
+	n.running = false
+}
+
+func (n *testNode) isLeader() bool {
+	return n.running && n.rs.GetLeader() == n.member.ID
+}
+
+func (n *testNode) bestBlock() *types.Block {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	return n.blocks[len(n.blocks)-1]
+}
+
+func (n *testNode) chain() []*types.Block {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	return append([]*types.Block(nil), n.blocks...)
+}
+
+// syncBlocks appends the blocks missing in the chain of the node.
+func (n *testNode) syncBlocks(blocks []*types.Block) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if len(n.blocks) < len(blocks) {
+		n.blocks = append(n.blocks, blocks[len(n.blocks):]...)
+	}
+}
+
+// testCluster is a raft cluster in a process for the tests.
+type testCluster struct {
+	t       *testing.T
+	chainID []byte
+	genesis *types.Block
+	network *memNetwork
+	members []*consensus.Member
+	nodes   []*testNode
+
+	// snapFrequency overrides ConfSnapFrequency if not 0
+	snapFrequency uint64
+	blockTs       int64
+
+	// proxy makes the members relay the messages to the unreachable members
+	proxy bool
+}
+
+func newTestCluster(t *testing.T, size int) *testCluster {
+	cl := &testCluster{
+		t:       t,
+		chainID: []byte("testcluster"),
+		genesis: types.NewBlock(nil, nil, nil, nil, nil, 0),
+		network: newMemNetwork(),
+	}
+	cl.network.syncSnap = cl.syncSnap
+
+	for i := 0; i < size; i++ {
+		name := fmt.Sprintf("testnode%d", i+1)
+		url := fmt.Sprintf("http://127.0.0.1:%d", 11001+i)
+		member := consensus.NewMember(name, url, "", cl.chainID, 0)
+		member.PeerID = []byte(name)
+		cl.members = append(cl.members, member)
+
+		cl.nodes = append(cl.nodes, &testNode{
+			cl:       cl,
+			member:   member,
+			wal:      newMemWAL(),
+			debugger: chain.NewDebugger(),
+			blocks:   []*types.Block{cl.genesis},
+		})
+	}
+
+	return cl
+}
+
+// start starts all the members as a new cluster.
+func (cl *testCluster) start() {
+	for _, n := range cl.nodes {
+		n.start()
+	}
+}
+
+// stop stops the running members.
+func (cl *testCluster) stop() {
+	cl.network.heal()
+	for _, n := range cl.nodes {
+		n.debugger.Unset(chain.DEBUG_RAFT_WAL_SLEEP)
+		n.stop()
+	}
+}
+
+func (cl *testCluster) indexOf(id uint64) int {
+	for i, m := range cl.members {
+		if m.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (cl *testCluster) running() []int {
+	var idx []int
+	for i, n := range cl.nodes {
+		if n.running {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+func (cl *testCluster) waitFor(what string, cond func() bool) {
+	deadline := time.Now().Add(testWaitTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			cl.t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(testTickMS)
+	}
+}
+
+// waitLeader waits until the members of idx, or all the running members if
+// idx is empty, agree on a leader among them and returns it.
+func (cl *testCluster) waitLeader(idx ...int) int {
+	if len(idx) == 0 {
+		idx = cl.running()
+	}
+
+	leader := -1
+	cl.waitFor("leader", func() bool {
+		leader = -1
+		for _, i := range idx {
+			l := cl.indexOf(cl.nodes[i].rs.GetLeader())
+			if l < 0 || (leader >= 0 && l != leader) {
+				return false
+			}
+			leader = l
+		}
+		for _, i := range idx {
+			if i == leader {
+				return cl.nodes[i].isLeader()
+			}
+		}
+		return false
+	})
+
+	return leader
+}
+
+// propose proposes a block following the best block of the member i, which
+// must be the leader.
+func (cl *testCluster) propose(i int) *types.Block {
+	n := cl.nodes[i]
+	block := types.NewBlock(n.bestBlock(), nil, nil, nil, nil, atomic.AddInt64(&cl.blockTs, 1))
+
+	errC := make(chan error, 1)
+	go func() { errC <- n.rs.Propose(block) }()
+
+	select {
+	case err := <-errC:
+		if err != nil {
+			cl.t.Fatalf("failed to propose block %d to %s: %v", block.BlockNo(), n.member.Name, err)
+		}
+	case <-time.After(testWaitTimeout):
+		cl.t.Fatalf("timeout proposing block %d to %s", block.BlockNo(), n.member.Name)
+	}
+
+	return block
+}
+
+// commit proposes a block to the member i and waits until it is connected to
+// the chain of the member.
+func (cl *testCluster) commit(i int) *types.Block {
+	block := cl.propose(i)
+	cl.waitFor(fmt.Sprintf("commit of block %d", block.BlockNo()), func() bool {
+		return bytes.Equal(cl.nodes[i].bestBlock().BlockHash(), block.BlockHash())
+	})
+	return block
+}
+
+// waitHeight waits until the chains of the members of idx, or all the
+// running members if idx is empty, reach the height.
+func (cl *testCluster) waitHeight(height types.BlockNo, idx ...int) {
+	if len(idx) == 0 {
+		idx = cl.running()
+	}
+	cl.waitFor(fmt.Sprintf("height %d", height), func() bool {
+		for _, i := range idx {
+			if cl.nodes[i].bestBlock().BlockNo() < height {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// checkConsistent fails the test if the chain of any member is not a prefix
+// of the longest one.
+func (cl *testCluster) checkConsistent() {
+	var longest []*types.Block
+	for _, n := range cl.nodes {
+		if blocks := n.chain(); len(blocks) > len(longest) {
+			longest = blocks
+		}
+	}
+	for _, n := range cl.nodes {
+		for no, block := range n.chain() {
+			if !bytes.Equal(block.BlockHash(), longest[no].BlockHash()) {
+				cl.t.Fatalf("chain of %s diverged at block %d", n.member.Name, no)
+			}
+		}
+	}
+}
+
+// partition cuts the links between the groups of the members.
+func (cl *testCluster) partition(groups ...[]int) {
+	idGroups := make([][]uint64, len(groups))
+	for i, group := range groups {
+		for _, idx := range group {
+			idGroups[i] = append(idGroups[i], cl.members[idx].ID)
+		}
+	}
+	cl.network.partition(idGroups...)
+}
+
+// cut cuts the link between the members i and j.
+func (cl *testCluster) cut(i int, j int) {
+	cl.network.cutLink(cl.members[i].ID, cl.members[j].ID)
+}
+
+// heal restores the links cut by partition or cut.
+func (cl *testCluster) heal() {
+	cl.network.heal()
+}
+
+// crash makes the raft server of the member i crash at the point of
+// DEBUG_RAFT_CRASH: 1 before saving the entries to the wal, 2 after saving
+// them and before applying the committed ones.
+func (cl *testCluster) crash(i int, point int) {
+	n := cl.nodes[i]
+	n.debugger.Set(chain.DEBUG_RAFT_CRASH, point)
+	n.wait()
+	n.debugger.Unset(chain.DEBUG_RAFT_CRASH)
+
+	// release the goroutine of conf changes left by the crash
+	close(n.confChangeC)
+}
+
+// restart restarts the raft server of the member i from its wal.
+func (cl *testCluster) restart(i int) {
+	cl.nodes[i].start()
+}
+
+// slowDisk delays each save of the member i to the wal by ms, or restores the
+// delay if ms is 0.
+func (cl *testCluster) slowDisk(i int, ms int) {
+	if ms == 0 {
+		cl.nodes[i].debugger.Unset(chain.DEBUG_RAFT_WAL_SLEEP)
+		return
+	}
+	cl.nodes[i].debugger.Set(chain.DEBUG_RAFT_WAL_SLEEP, ms)
+}
+
+// syncSnap copies the chain up to the block of the snapshot from the sender
+// to the receiver.
+func (cl *testCluster) syncSnap(to uint64, msg raftpb.Message) error {
+	var snapdata consensus.SnapshotData
+	if err := snapdata.Decode(msg.Snapshot.Data); err != nil {
+		return err
+	}
+
+	from, recv := cl.indexOf(msg.From), cl.indexOf(to)
+	if from < 0 || recv < 0 {
+		return errNoSnapSource
+	}
+
+	blocks := cl.nodes[from].chain()
+	no := snapdata.Chain.No
+	if uint64(len(blocks)) <= no || !bytes.Equal(blocks[no].BlockHash(), snapdata.Chain.Hash) {
+		return errNoSnapSource
+	}
+
+	cl.nodes[recv].syncBlocks(blocks[:no+1])
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/chain"
 	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -23,7 +25,7 @@ const (
 var logger *log.Logger
 
 func init() {
-	logger = log.NewLogger("sbp")
+	logger = logctl.NewLogger("sbp")
 }
 
 type txExec struct {
@@ -98,8 +100,8 @@ func New(cfg *config.ConsensusConfig, hub *component.ComponentHub, cdb consensus
 }
 
 // Ticker returns a time.Ticker for the main consensus loop.
-func (s *SimpleBlockFactory) Ticker() *time.Ticker {
-	return time.NewTicker(s.blockInterval)
+func (s *SimpleBlockFactory) Ticker() clock.Ticker {
+	return clock.NewTicker(s.blockInterval)
 }
 
 // QueueJob send a block triggering information to jq.
@@ -179,7 +181,7 @@ func (s *SimpleBlockFactory) Start() {
 			if prevBlock, ok := e.(*types.Block); ok {
 				blockState := s.sdb.NewBlockState(prevBlock.GetHeader().GetBlocksRootHash())
 
-				ts := time.Now().UnixNano()
+				ts := clock.Now().UnixNano()
 
 				txOp := chain.NewCompTxOp(
 					s.txOp,
@@ -235,3 +237,19 @@ func (s *SimpleBlockFactory) ConfChange(req *types.MembershipChange) (*consensus
 func (s *SimpleBlockFactory) ClusterInfo() ([]*types.MemberAttr, []byte, error) {
 	return nil, nil, consensus.ErrNotSupportedMethod
 }
+
+func (s *SimpleBlockFactory) ClusterStatus() (*types.ClusterStatus, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (s *SimpleBlockFactory) TransferLeader(id uint64) (*types.MemberAttr, error) {
+	return nil, consensus.ErrNotSupportedMethod
+}
+
+func (s *SimpleBlockFactory) CreateSnapshot() (uint64, error) {
+	return 0, consensus.ErrNotSupportedMethod
+}
+
+func (s *SimpleBlockFactory) BlockConsensusInfo(block *types.Block) (string, error) {
+	return "", consensus.ErrNotSupportedMethod
+}
@@ -84,6 +84,7 @@ type ChainWAL interface {
 	ReadAll() (state raftpb.HardState, ents []raftpb.Entry, err error)
 	WriteRaftEntry([]*WalEntry, []*types.Block) error
 	GetRaftEntry(idx uint64) (*WalEntry, error)
+	GetRaftEntryIndexOfBlock(hash []byte) (uint64, error)
 	HasWal() (bool, error)
 	GetRaftEntryLastIdx() (uint64, error)
 	GetHardState() (*raftpb.HardState, error)
@@ -92,6 +93,8 @@ type ChainWAL interface {
 	GetSnapshot() (*raftpb.Snapshot, error)
 	WriteIdentity(id *RaftIdentity) error
 	GetIdentity() (*RaftIdentity, error)
+	WriteProposed(block *types.Block) error
+	GetProposed() (*types.Block, error)
 }
 
 type SnapshotData struct {
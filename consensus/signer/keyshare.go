@@ -0,0 +1,222 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package signer
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/libp2p/go-libp2p-crypto"
+)
+
+// The block signing key is shared among the threshold signers by a trusted
+// dealer, who precomputes the presignatures of ECDSA for them. For each
+// presignature, a random nonce k is chosen and r is the x coordinate of kG.
+// The parties get the Shamir's shares of k^-1 and k^-1*x, x being the
+// private key, by the polynomials of degree threshold-1. The share of a
+// signature of the hash z is then k^-1_i*z + r*(k^-1*x)_i, and the
+// signature s = k^-1*(z + r*x) is interpolated from the shares of the
+// threshold parties. So no party holds the key nor its share, and the
+// signatures are the ordinary ECDSA ones of the block headers.
+//
+// A presignature must sign only one header, otherwise its nonce and the key
+// are revealed. The parties use them in order and never twice, and the
+// threshold should be a majority of the parties, or the shares of the
+// different headers by a presignature could be combined to reveal the key.
+// The dealer should deal new shares before the presignatures run out.
+
+var curveN = btcec.S256().N
+
+// KeyShare is the share of the block signing key held by a threshold signer.
+type KeyShare struct {
+	// PubKey is the public key of the whole key, in the format of the block
+	// header.
+	PubKey []byte `json:"pubkey"`
+	// Dealing identifies the shares dealt together, which can be combined.
+	Dealing   string    `json:"dealing"`
+	Index     uint32    `json:"index"`
+	Threshold uint32    `json:"threshold"`
+	Presigs   []*Presig `json:"presigs"`
+}
+
+// Presig is the share of a presignature.
+type Presig struct {
+	R    []byte `json:"r"`
+	KInv []byte `json:"kinv"`
+	W    []byte `json:"w"` // the share of k^-1*x
+}
+
+// DealKeyShares splits key into the shares of the parties with the number
+// of the presignatures. The index of the shares are from 1 to parties.
+func DealKeyShares(key crypto.PrivKey, threshold int, parties int, presigs int) ([]*KeyShare, error) {
+	if threshold < 1 || threshold > parties || threshold*2 <= parties {
+		return nil, fmt.Errorf("threshold should be a majority of the %d parties", parties)
+	}
+	if presigs < 1 {
+		return nil, errors.New("no presignature to deal")
+	}
+	secp256k1Key, ok := key.(*crypto.Secp256k1PrivateKey)
+	if !ok {
+		return nil, errors.New("key is not of secp256k1")
+	}
+	x := (*btcec.PrivateKey)(secp256k1Key).D
+	pubKey, err := key.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	dealing := make([]byte, 8)
+	if _, err := rand.Read(dealing); err != nil {
+		return nil, err
+	}
+
+	shares := make([]*KeyShare, parties)
+	for i := range shares {
+		shares[i] = &KeyShare{
+			PubKey:    pubKey,
+			Dealing:   hex.EncodeToString(dealing),
+			Index:     uint32(i + 1),
+			Threshold: uint32(threshold),
+			Presigs:   make([]*Presig, presigs),
+		}
+	}
+	for j := 0; j < presigs; j++ {
+		k, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		rx, _ := btcec.S256().ScalarBaseMult(k.Bytes())
+		r := new(big.Int).Mod(rx, curveN)
+		if r.Sign() == 0 {
+			j--
+			continue
+		}
+		kInv := new(big.Int).ModInverse(k, curveN)
+		w := new(big.Int).Mul(kInv, x)
+		w.Mod(w, curveN)
+
+		kInvShares, err := splitSecret(kInv, threshold, parties)
+		if err != nil {
+			return nil, err
+		}
+		wShares, err := splitSecret(w, threshold, parties)
+		if err != nil {
+			return nil, err
+		}
+		for i, share := range shares {
+			share.Presigs[j] = &Presig{
+				R:    scalarBytes(r),
+				KInv: scalarBytes(kInvShares[i]),
+				W:    scalarBytes(wShares[i]),
+			}
+		}
+	}
+	return shares, nil
+}
+
+// LoadKeyShare reads the key share from the JSON file.
+func LoadKeyShare(path string) (*KeyShare, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var share KeyShare
+	if err := json.Unmarshal(data, &share); err != nil {
+		return nil, fmt.Errorf("invalid key share %s: %s", path, err.Error())
+	}
+	if share.Index == 0 || share.Threshold == 0 {
+		return nil, fmt.Errorf("invalid key share %s", path)
+	}
+	return &share, nil
+}
+
+// Save writes the key share to the JSON file only readable by its owner.
+func (share *KeyShare) Save(path string) error {
+	data, err := json.Marshal(share)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// signShare returns the share of the signature of hash by the presignature.
+func (p *Presig) signShare(hash []byte) []byte {
+	s := new(big.Int).Mul(new(big.Int).SetBytes(p.KInv), new(big.Int).SetBytes(hash))
+	w := new(big.Int).Mul(new(big.Int).SetBytes(p.R), new(big.Int).SetBytes(p.W))
+	s.Add(s, w)
+	s.Mod(s, curveN)
+	return scalarBytes(s)
+}
+
+// combineShares interpolates the secret at 0 from the shares by their index.
+func combineShares(shares map[uint32]*big.Int) *big.Int {
+	secret := new(big.Int)
+	for i, share := range shares {
+		// the lagrange coefficient of i at 0
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j := range shares {
+			if j == i {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(j)))
+			den.Mul(den, big.NewInt(int64(j)-int64(i)))
+		}
+		den.Mod(den, curveN)
+		term := num.Mul(num, den.ModInverse(den, curveN))
+		term.Mul(term, share)
+		secret.Add(secret, term)
+	}
+	return secret.Mod(secret, curveN)
+}
+
+// splitSecret returns the shares of secret evaluated at 1 to n by a random
+// polynomial of degree t-1.
+func splitSecret(secret *big.Int, t int, n int) ([]*big.Int, error) {
+	coeffs := []*big.Int{secret}
+	for len(coeffs) < t {
+		c, err := randomScalar()
+		if err != nil {
+			return nil, err
+		}
+		coeffs = append(coeffs, c)
+	}
+	shares := make([]*big.Int, n)
+	for i := range shares {
+		x := big.NewInt(int64(i + 1))
+		y := new(big.Int)
+		for k := len(coeffs) - 1; k >= 0; k-- {
+			y.Mul(y, x)
+			y.Add(y, coeffs[k])
+			y.Mod(y, curveN)
+		}
+		shares[i] = y
+	}
+	return shares, nil
+}
+
+func randomScalar() (*big.Int, error) {
+	for {
+		k, err := rand.Int(rand.Reader, curveN)
+		if err != nil {
+			return nil, err
+		}
+		if k.Sign() != 0 {
+			return k, nil
+		}
+	}
+}
+
+func scalarBytes(n *big.Int) []byte {
+	b := make([]byte, 32)
+	nb := n.Bytes()
+	copy(b[32-len(nb):], nb)
+	return b
+}
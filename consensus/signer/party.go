@@ -0,0 +1,94 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package signer
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aergoio/aergo/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Party is a threshold signer serving the ThresholdSignerService by a key
+// share. The index of the next presignature is kept in the file next to the
+// key share, and it's written before a share of a signature is returned so
+// that no presignature is used twice even after a restart.
+type Party struct {
+	share     *KeyShare
+	statePath string
+
+	mutex sync.Mutex
+	next  uint64
+}
+
+// NewParty returns the party of the key share file.
+func NewParty(path string) (*Party, error) {
+	share, err := LoadKeyShare(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &Party{share: share, statePath: path + "." + share.Dealing + ".next"}
+	data, err := ioutil.ReadFile(p.statePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	} else if err == nil {
+		if p.next, err = strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid presignature index in %s", p.statePath)
+		}
+	}
+	return p, nil
+}
+
+// GetKeyShare returns the key share without its presignatures.
+func (p *Party) GetKeyShare(ctx context.Context, in *types.Empty) (*types.KeyShareInfo, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return &types.KeyShareInfo{
+		PubKey:     p.share.PubKey,
+		Dealing:    p.share.Dealing,
+		Index:      p.share.Index,
+		Threshold:  p.share.Threshold,
+		NextPresig: p.next,
+		Presigs:    uint64(len(p.share.Presigs)),
+	}, nil
+}
+
+// SignShare returns the share of the signature of the header by the
+// presignature, which should not be used before.
+func (p *Party) SignShare(ctx context.Context, in *types.SignShareRequest) (*types.SignatureShare, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if in.GetDealing() != p.share.Dealing {
+		return nil, status.Errorf(codes.FailedPrecondition, "unknown dealing %s", in.GetDealing())
+	}
+	if in.GetPresig() < p.next {
+		return nil, status.Errorf(codes.FailedPrecondition, "presignature %d already used", in.GetPresig())
+	}
+	if in.GetPresig() >= uint64(len(p.share.Presigs)) {
+		return nil, status.Errorf(codes.ResourceExhausted, "no presignature %d", in.GetPresig())
+	}
+	next := in.GetPresig() + 1
+	if err := ioutil.WriteFile(p.statePath, []byte(strconv.FormatUint(next, 10)), 0600); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save the presignature index: %s", err.Error())
+	}
+	p.next = next
+
+	presig := p.share.Presigs[in.GetPresig()]
+	hash := sha256.Sum256(in.GetHeader())
+	return &types.SignatureShare{
+		Index: p.share.Index,
+		S:     presig.signShare(hash[:]),
+		R:     presig.R,
+	}, nil
+}
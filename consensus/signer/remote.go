@@ -0,0 +1,266 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const defaultTimeout = 3 * time.Second
+
+// checkInterval is the interval of the health checks of the remote signers.
+var checkInterval = 5 * time.Second
+
+var (
+	// ErrSignerUnavailable indicates that no remote signer is reachable.
+	ErrSignerUnavailable = errors.New("no remote signer available")
+
+	errNotChecked  = errors.New("not checked yet")
+	errKeyMismatch = errors.New("remote signer holds another key than the node")
+	errInvalidSign = errors.New("invalid signature from the remote signer")
+)
+
+// remoteSigner delegates the signing to the remote signers. The first
+// healthy one in the order of the configuration signs the blocks, and the
+// next one is tried if it becomes unreachable.
+type remoteSigner struct {
+	pubKey    crypto.PubKey
+	pubKeyRaw []byte
+	timeout   time.Duration
+	endpoints []*endpoint
+}
+
+type endpoint struct {
+	addr   string
+	conn   *grpc.ClientConn
+	client types.BlockSignerServiceClient
+	shares types.ThresholdSignerServiceClient
+	health healthpb.HealthClient
+
+	mutex sync.RWMutex
+	err   error               // the error of the last check or request, nil if healthy
+	share *types.KeyShareInfo // the key share of a threshold signer at the last check
+}
+
+func newRemoteSigner(conf *config.SignerConfig, pubKey crypto.PubKey, quit <-chan interface{}) (*remoteSigner, error) {
+	pubKeyRaw, err := pubKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := dialEndpoints(conf)
+	if err != nil {
+		return nil, err
+	}
+	rs := &remoteSigner{
+		pubKey:    pubKey,
+		pubKeyRaw: pubKeyRaw,
+		timeout:   requestTimeout(conf),
+		endpoints: endpoints,
+	}
+	runChecks(endpoints, rs.checkAll, quit)
+	return rs, nil
+}
+
+func requestTimeout(conf *config.SignerConfig) time.Duration {
+	if conf.Timeout != 0 {
+		return time.Duration(conf.Timeout) * time.Millisecond
+	}
+	return defaultTimeout
+}
+
+func dialEndpoints(conf *config.SignerConfig) ([]*endpoint, error) {
+	creds, err := dialOption(conf)
+	if err != nil {
+		return nil, err
+	}
+	var endpoints []*endpoint
+	for _, addr := range conf.Endpoints {
+		// the connection is made in the background and retried by grpc
+		conn, err := grpc.Dial(addr, creds)
+		if err != nil {
+			closeEndpoints(endpoints)
+			return nil, fmt.Errorf("failed to connect the remote signer %s: %s", addr, err.Error())
+		}
+		endpoints = append(endpoints, &endpoint{
+			addr:   addr,
+			conn:   conn,
+			client: types.NewBlockSignerServiceClient(conn),
+			shares: types.NewThresholdSignerServiceClient(conn),
+			health: healthpb.NewHealthClient(conn),
+			err:    errNotChecked,
+		})
+	}
+	return endpoints, nil
+}
+
+// runChecks calls checkAll now and periodically until quit is closed, and
+// then closes the endpoints.
+func runChecks(endpoints []*endpoint, checkAll func(), quit <-chan interface{}) {
+	checkAll()
+	go func() {
+		defer closeEndpoints(endpoints)
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				checkAll()
+			case <-quit:
+				return
+			}
+		}
+	}()
+}
+
+// dialOption returns the credentials of mTLS, or no security if no
+// certificate is configured.
+func dialOption(conf *config.SignerConfig) (grpc.DialOption, error) {
+	if conf.CertFile == "" && conf.KeyFile == "" && conf.CACert == "" {
+		logger.Warn().Msg("connecting the remote signers without TLS")
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig := &tls.Config{}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the certificate for the remote signers: %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if conf.CACert != "" {
+		ca, err := ioutil.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA certificate of the remote signers: %s", err.Error())
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("invalid CA certificate of the remote signers")
+		}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+func closeEndpoints(endpoints []*endpoint) {
+	for _, ep := range endpoints {
+		ep.conn.Close()
+	}
+}
+
+func (rs *remoteSigner) checkAll() {
+	for _, ep := range rs.endpoints {
+		ep.setError(rs.check(ep))
+	}
+}
+
+// check returns nil if the signer is serving with the key of the node.
+func (rs *remoteSigner) check(ep *endpoint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	if err := ep.checkHealth(ctx); err != nil {
+		return err
+	}
+	key, err := ep.client.GetPubKey(ctx, &types.Empty{})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key.GetPubKey(), rs.pubKeyRaw) {
+		return errKeyMismatch
+	}
+	return nil
+}
+
+func (rs *remoteSigner) Ready() error {
+	for _, ep := range rs.endpoints {
+		if ep.healthy() {
+			return nil
+		}
+	}
+	return ErrSignerUnavailable
+}
+
+// Sign requests the signature to the healthy signers in order. The next one
+// is tried only if the request doesn't reach the signer or times out, since
+// the signer may refuse to sign, e.g. a conflicting block.
+func (rs *remoteSigner) Sign(block *types.Block) error {
+	for _, ep := range rs.endpoints {
+		if !ep.healthy() {
+			continue
+		}
+		err := block.SignWith(rs.pubKey, func(msg []byte) ([]byte, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+			defer cancel()
+			rsp, err := ep.client.SignBlock(ctx, &types.SignBlockRequest{
+				ChainID: block.GetHeader().GetChainID(),
+				BlockNo: block.BlockNo(),
+				Header:  msg,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return rsp.GetSign(), nil
+		})
+		if err == nil {
+			if valid, _ := block.VerifySign(); valid {
+				return nil
+			}
+			err = errInvalidSign
+		}
+		if code := status.Code(err); err != errInvalidSign && code != codes.Unavailable && code != codes.DeadlineExceeded {
+			return err
+		}
+		ep.setError(err)
+	}
+	return ErrSignerUnavailable
+}
+
+func (ep *endpoint) checkHealth(ctx context.Context) error {
+	rsp, err := ep.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if rsp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("remote signer is %s", rsp.GetStatus())
+	}
+	return nil
+}
+
+func (ep *endpoint) healthy() bool {
+	ep.mutex.RLock()
+	defer ep.mutex.RUnlock()
+	return ep.err == nil
+}
+
+func (ep *endpoint) setError(err error) {
+	ep.mutex.Lock()
+	prev := ep.err
+	ep.err = err
+	ep.mutex.Unlock()
+
+	if err != nil && (prev == nil || prev == errNotChecked) {
+		logger.Warn().Err(err).Str("signer", ep.addr).Msg("remote signer became unavailable")
+	} else if err != nil && err.Error() != prev.Error() {
+		logger.Debug().Err(err).Str("signer", ep.addr).Msg("remote signer is unavailable")
+	} else if err == nil && prev != nil {
+		logger.Info().Str("signer", ep.addr).Msg("remote signer became available")
+	}
+}
@@ -0,0 +1,54 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package signer provides the signers of the blocks produced by the node,
+// which sign them by the node key or delegate the signing to remote signers
+// such as the services in front of HSMs, or to the threshold signers which
+// sign them together by the shares of the key.
+package signer
+
+import (
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-crypto"
+)
+
+var logger = logctl.NewLogger("signer")
+
+// BlockSigner signs the blocks produced.
+type BlockSigner interface {
+	// Ready returns an error if the blocks can't be signed now, in which case
+	// the block production should be skipped.
+	Ready() error
+	// Sign adds the pubkey and the signature to block.
+	Sign(block *types.Block) error
+}
+
+// New returns the remote signers of conf, or the signer by the node key if no
+// remote signer is configured. The remote signers are checked until quit is
+// closed, and are used only if they hold nodeKey, or its shares if the
+// threshold is configured.
+func New(conf *config.SignerConfig, nodeKey crypto.PrivKey, quit <-chan interface{}) (BlockSigner, error) {
+	if conf == nil || len(conf.Endpoints) == 0 {
+		return &localSigner{privKey: nodeKey}, nil
+	}
+	if conf.Threshold != 0 {
+		return newThresholdSigner(conf, nodeKey.GetPublic(), quit)
+	}
+	return newRemoteSigner(conf, nodeKey.GetPublic(), quit)
+}
+
+type localSigner struct {
+	privKey crypto.PrivKey
+}
+
+func (s *localSigner) Ready() error {
+	return nil
+}
+
+func (s *localSigner) Sign(block *types.Block) error {
+	return block.Sign(s.privKey)
+}
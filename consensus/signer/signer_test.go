@@ -0,0 +1,127 @@
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-crypto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type testSigner struct {
+	key    crypto.PrivKey
+	refuse bool
+	signed []types.BlockNo
+}
+
+func (s *testSigner) GetPubKey(ctx context.Context, in *types.Empty) (*types.SignerKey, error) {
+	pubKey, err := s.key.GetPublic().Bytes()
+	return &types.SignerKey{PubKey: pubKey}, err
+}
+
+func (s *testSigner) SignBlock(ctx context.Context, in *types.SignBlockRequest) (*types.BlockSignature, error) {
+	if s.refuse {
+		return nil, status.Error(codes.FailedPrecondition, "conflicting block")
+	}
+	sign, err := s.key.Sign(in.Header)
+	s.signed = append(s.signed, in.BlockNo)
+	return &types.BlockSignature{Sign: sign}, err
+}
+
+func startTestSigner(t *testing.T, s *testSigner) (string, *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	types.RegisterBlockSignerServiceServer(server, s)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	return lis.Addr().String(), server
+}
+
+func newTestKey(t *testing.T) crypto.PrivKey {
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestBlock(no types.BlockNo) *types.Block {
+	return &types.Block{Header: &types.BlockHeader{ChainID: []byte("test"), BlockNo: no}}
+}
+
+func TestLocalSigner(t *testing.T) {
+	s, err := New(nil, newTestKey(t), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Ready())
+	block := newTestBlock(1)
+	assert.NoError(t, s.Sign(block))
+	valid, err := block.VerifySign()
+	assert.True(t, valid)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	nodeKey := newTestKey(t)
+	primary, backup := &testSigner{key: nodeKey}, &testSigner{key: nodeKey}
+	primaryAddr, primaryServer := startTestSigner(t, primary)
+	defer primaryServer.Stop()
+	backupAddr, backupServer := startTestSigner(t, backup)
+	defer backupServer.Stop()
+
+	quit := make(chan interface{})
+	defer close(quit)
+	s, err := New(&config.SignerConfig{Endpoints: []string{primaryAddr, backupAddr}, Timeout: 500}, nodeKey, quit)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, s.Ready())
+	block := newTestBlock(1)
+	assert.NoError(t, s.Sign(block))
+	valid, _ := block.VerifySign()
+	assert.True(t, valid)
+	assert.Equal(t, []types.BlockNo{1}, primary.signed)
+
+	// the refused block is not signed by the backup
+	primary.refuse = true
+	assert.Error(t, s.Sign(newTestBlock(2)))
+	assert.Empty(t, backup.signed)
+	primary.refuse = false
+
+	// the backup signs while the primary is down
+	primaryServer.Stop()
+	assert.NoError(t, s.Sign(newTestBlock(3)))
+	assert.Equal(t, []types.BlockNo{3}, backup.signed)
+
+	// no block is produced without the signers
+	backupServer.Stop()
+	assert.Equal(t, ErrSignerUnavailable, s.Sign(newTestBlock(4)))
+	assert.Equal(t, ErrSignerUnavailable, s.Ready())
+}
+
+func TestRemoteSignerKeyMismatch(t *testing.T) {
+	addr, server := startTestSigner(t, &testSigner{key: newTestKey(t)})
+	defer server.Stop()
+
+	quit := make(chan interface{})
+	defer close(quit)
+	s, err := New(&config.SignerConfig{Endpoints: []string{addr}}, newTestKey(t), quit)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ErrSignerUnavailable, s.Ready())
+	ep := s.(*remoteSigner).endpoints[0]
+	ep.mutex.RLock()
+	defer ep.mutex.RUnlock()
+	assert.Equal(t, errKeyMismatch, ep.err)
+}
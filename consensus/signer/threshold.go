@@ -0,0 +1,250 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/libp2p/go-libp2p-crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// lowPresigs is the number of the presignatures left of a party under which
+// the dealing of new shares is warned.
+const lowPresigs = 10000
+
+var (
+	errThresholdMismatch = errors.New("threshold signer has another threshold than the node")
+	errShareMismatch     = errors.New("inconsistent presignatures of the threshold signers")
+)
+
+// thresholdSigner coordinates the threshold signers, each of which holds a
+// share of the key, to sign the blocks. The shares of the signature are
+// requested to all the healthy signers of the latest dealing, and the
+// signature is combined from the first threshold ones. The block isn't
+// signed if less than threshold signers answer in time.
+type thresholdSigner struct {
+	pubKey    crypto.PubKey
+	pubKeyRaw []byte
+	threshold uint32
+	timeout   time.Duration
+	endpoints []*endpoint
+
+	mutex   sync.Mutex
+	dealing string // the dealing of the shares used
+	next    uint64 // the presignature to use next
+}
+
+func newThresholdSigner(conf *config.SignerConfig, pubKey crypto.PubKey, quit <-chan interface{}) (*thresholdSigner, error) {
+	if conf.Threshold > uint(len(conf.Endpoints)) {
+		return nil, fmt.Errorf("threshold %d is larger than the number of the signers", conf.Threshold)
+	}
+	pubKeyRaw, err := pubKey.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := dialEndpoints(conf)
+	if err != nil {
+		return nil, err
+	}
+	ts := &thresholdSigner{
+		pubKey:    pubKey,
+		pubKeyRaw: pubKeyRaw,
+		threshold: uint32(conf.Threshold),
+		timeout:   requestTimeout(conf),
+		endpoints: endpoints,
+	}
+	runChecks(endpoints, ts.checkAll, quit)
+	return ts, nil
+}
+
+func (ts *thresholdSigner) checkAll() {
+	var wg sync.WaitGroup
+	for _, ep := range ts.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			share, err := ts.check(ep)
+			ep.mutex.Lock()
+			ep.share = share
+			ep.mutex.Unlock()
+			ep.setError(err)
+		}(ep)
+	}
+	wg.Wait()
+	ts.updateDealing()
+}
+
+// check returns the key share of the signer if it's serving the shares of
+// the key of the node.
+func (ts *thresholdSigner) check(ep *endpoint) (*types.KeyShareInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ts.timeout)
+	defer cancel()
+	if err := ep.checkHealth(ctx); err != nil {
+		return nil, err
+	}
+	share, err := ep.shares.GetKeyShare(ctx, &types.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(share.GetPubKey(), ts.pubKeyRaw) {
+		return nil, errKeyMismatch
+	}
+	if share.GetThreshold() != ts.threshold {
+		return nil, errThresholdMismatch
+	}
+	if left := share.GetPresigs() - share.GetNextPresig(); share.GetNextPresig() <= share.GetPresigs() && left < lowPresigs {
+		logger.Warn().Str("signer", ep.addr).Uint64("left", left).Msg("presignatures of the threshold signer are running out")
+	}
+	return share, nil
+}
+
+// updateDealing selects the dealing with the most healthy signers, and the
+// next presignature unused by them.
+func (ts *thresholdSigner) updateDealing() {
+	count := make(map[string]int)
+	next := make(map[string]uint64)
+	for _, ep := range ts.endpoints {
+		share := ep.keyShare()
+		if share == nil {
+			continue
+		}
+		count[share.GetDealing()]++
+		if share.GetNextPresig() > next[share.GetDealing()] {
+			next[share.GetDealing()] = share.GetNextPresig()
+		}
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+	for dealing, n := range count {
+		if n > count[ts.dealing] {
+			logger.Info().Str("dealing", dealing).Msg("threshold signers use a new dealing of the key shares")
+			ts.dealing, ts.next = dealing, 0
+		}
+	}
+	if next[ts.dealing] > ts.next {
+		ts.next = next[ts.dealing]
+	}
+}
+
+func (ep *endpoint) keyShare() *types.KeyShareInfo {
+	ep.mutex.RLock()
+	defer ep.mutex.RUnlock()
+	if ep.err != nil {
+		return nil
+	}
+	return ep.share
+}
+
+// parties returns the healthy signers of the dealing used.
+func (ts *thresholdSigner) parties() (string, []*endpoint) {
+	ts.mutex.Lock()
+	dealing := ts.dealing
+	ts.mutex.Unlock()
+
+	var parties []*endpoint
+	for _, ep := range ts.endpoints {
+		if share := ep.keyShare(); share != nil && share.GetDealing() == dealing {
+			parties = append(parties, ep)
+		}
+	}
+	return dealing, parties
+}
+
+func (ts *thresholdSigner) Ready() error {
+	if _, parties := ts.parties(); len(parties) < int(ts.threshold) {
+		return ErrSignerUnavailable
+	}
+	return nil
+}
+
+func (ts *thresholdSigner) Sign(block *types.Block) error {
+	dealing, parties := ts.parties()
+	if len(parties) < int(ts.threshold) {
+		return ErrSignerUnavailable
+	}
+	ts.mutex.Lock()
+	presig := ts.next
+	ts.next++
+	ts.mutex.Unlock()
+
+	err := block.SignWith(ts.pubKey, func(msg []byte) ([]byte, error) {
+		return ts.sign(parties, &types.SignShareRequest{
+			ChainID: block.GetHeader().GetChainID(),
+			BlockNo: block.BlockNo(),
+			Header:  msg,
+			Dealing: dealing,
+			Presig:  presig,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if valid, _ := block.VerifySign(); !valid {
+		return errInvalidSign
+	}
+	return nil
+}
+
+// sign requests the shares of the signature to the parties, and returns the
+// signature combined from the threshold shares.
+func (ts *thresholdSigner) sign(parties []*endpoint, req *types.SignShareRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ts.timeout)
+	defer cancel()
+
+	type result struct {
+		share *types.SignatureShare
+		err   error
+	}
+	results := make(chan result, len(parties))
+	for _, ep := range parties {
+		go func(ep *endpoint) {
+			share, err := ep.shares.SignShare(ctx, req)
+			if code := status.Code(err); code == codes.Unavailable || code == codes.DeadlineExceeded {
+				ep.setError(err)
+			}
+			results <- result{share, err}
+		}(ep)
+	}
+
+	var r []byte
+	shares := make(map[uint32]*big.Int)
+	var lastErr error
+	for range parties {
+		res := <-results
+		if res.err != nil {
+			lastErr = res.err
+			continue
+		}
+		if r == nil {
+			r = res.share.GetR()
+		} else if !bytes.Equal(r, res.share.GetR()) {
+			return nil, errShareMismatch
+		}
+		shares[res.share.GetIndex()] = new(big.Int).SetBytes(res.share.GetS())
+		if len(shares) == int(ts.threshold) {
+			sig := &btcec.Signature{R: new(big.Int).SetBytes(r), S: combineShares(shares)}
+			// the signature is serialized with the lower s
+			return sig.Serialize(), nil
+		}
+	}
+	logger.Warn().Err(lastErr).Uint64("presig", req.GetPresig()).Msg("not enough shares of the signature")
+	if code := status.Code(lastErr); code == codes.FailedPrecondition || code == codes.ResourceExhausted {
+		return nil, lastErr
+	}
+	return nil, ErrSignerUnavailable
+}
@@ -0,0 +1,127 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestCombineShares(t *testing.T) {
+	secret := big.NewInt(12345)
+	shares, err := splitSecret(secret, 3, 5)
+	assert.NoError(t, err)
+	for _, indices := range [][]uint32{{1, 2, 3}, {2, 4, 5}, {5, 1, 3}} {
+		subset := make(map[uint32]*big.Int)
+		for _, i := range indices {
+			subset[i] = shares[i-1]
+		}
+		assert.Equal(t, secret, combineShares(subset), indices)
+	}
+	assert.NotEqual(t, secret, combineShares(map[uint32]*big.Int{1: shares[0], 2: shares[1]}))
+}
+
+func TestDealKeyShares(t *testing.T) {
+	key := newTestKey(t)
+	_, err := DealKeyShares(key, 2, 4, 1)
+	assert.Error(t, err, "not a majority")
+	_, err = DealKeyShares(key, 4, 3, 1)
+	assert.Error(t, err)
+
+	shares, err := DealKeyShares(key, 2, 3, 2)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, shares, 3)
+	assert.Equal(t, uint32(3), shares[2].Index)
+	assert.Len(t, shares[0].Presigs, 2)
+	assert.Equal(t, shares[0].Presigs[1].R, shares[2].Presigs[1].R)
+}
+
+func startTestParties(t *testing.T, dir string, shares []*KeyShare) ([]string, []*grpc.Server) {
+	var addrs []string
+	var servers []*grpc.Server
+	for i, share := range shares {
+		path := filepath.Join(dir, fmt.Sprintf("share%d.json", i+1))
+		assert.NoError(t, share.Save(path))
+		party, err := NewParty(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		server := grpc.NewServer()
+		types.RegisterThresholdSignerServiceServer(server, party)
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		go server.Serve(lis)
+		addrs = append(addrs, lis.Addr().String())
+		servers = append(servers, server)
+	}
+	return addrs, servers
+}
+
+func TestThresholdSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	nodeKey := newTestKey(t)
+	shares, err := DealKeyShares(nodeKey, 2, 3, 3)
+	if !assert.NoError(t, err) {
+		return
+	}
+	addrs, servers := startTestParties(t, dir, shares)
+	for _, server := range servers {
+		defer server.Stop()
+	}
+
+	quit := make(chan interface{})
+	defer close(quit)
+	_, err = New(&config.SignerConfig{Endpoints: addrs, Threshold: 4}, nodeKey, quit)
+	assert.Error(t, err)
+	s, err := New(&config.SignerConfig{Endpoints: addrs, Threshold: 2, Timeout: 500}, nodeKey, quit)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, s.Ready())
+	block := newTestBlock(1)
+	assert.NoError(t, s.Sign(block))
+	valid, _ := block.VerifySign()
+	assert.True(t, valid)
+
+	// a presignature is never used twice
+	party, err := NewParty(filepath.Join(dir, "share1.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = party.SignShare(context.Background(), &types.SignShareRequest{Dealing: shares[0].Dealing, Presig: 0})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the threshold signers are enough
+	servers[0].Stop()
+	block = newTestBlock(2)
+	assert.NoError(t, s.Sign(block))
+	valid, _ = block.VerifySign()
+	assert.True(t, valid)
+
+	servers[1].Stop()
+	assert.Equal(t, ErrSignerUnavailable, s.Sign(newTestBlock(3)))
+	assert.Equal(t, ErrSignerUnavailable, s.Ready())
+}
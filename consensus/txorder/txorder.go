@@ -0,0 +1,165 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package txorder provides the policies deciding the order in which the
+// pending txs are tried for a new block. The txs of an account are always
+// taken in the order of their nonces, so a policy decides only which account
+// goes next. Every policy is a total order over the accounts, so the same
+// mempool contents give the same block regardless of the order in which the
+// mempool holds the accounts.
+package txorder
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"strings"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// The names of the policies
+const (
+	// FIFO takes the txs in the order of their arrival at the mempool.
+	FIFO = "fifo"
+	// FeePriority takes the txs of the highest gas price first.
+	FeePriority = "fee"
+	// Fair takes a tx of each account in turn.
+	Fair = "fair"
+)
+
+// Entry is the first tx of an account not taken yet.
+type Entry struct {
+	Tx      types.Transaction
+	Account []byte
+	Arrival uint64 // sequence of the tx arriving at the mempool
+	Taken   int    // number of the txs of the account taken before
+}
+
+// Policy decides the order of the accounts whose txs are taken for a block.
+type Policy interface {
+	Name() string
+	// Less reports whether the tx of a goes before the tx of b. The ties
+	// are broken by the accounts.
+	Less(a, b *Entry) bool
+}
+
+// Default is the policy of a node unless configured.
+var Default Policy = fifo{}
+
+var policies = map[string]Policy{
+	FIFO:        fifo{},
+	FeePriority: feePriority{},
+	Fair:        fair{},
+}
+
+// New returns the policy of the name, or the default one if name is empty.
+func New(name string) (Policy, error) {
+	if name == "" {
+		return Default, nil
+	}
+	p, ok := policies[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown tx order %q (%s, %s or %s)", name, FIFO, FeePriority, Fair)
+	}
+	return p, nil
+}
+
+type fifo struct{}
+
+func (fifo) Name() string { return FIFO }
+
+func (fifo) Less(a, b *Entry) bool {
+	return a.Arrival < b.Arrival
+}
+
+type feePriority struct{}
+
+func (feePriority) Name() string { return FeePriority }
+
+func (feePriority) Less(a, b *Entry) bool {
+	if c := a.Tx.GetBody().GetGasPriceBigInt().Cmp(b.Tx.GetBody().GetGasPriceBigInt()); c != 0 {
+		return c > 0
+	}
+	return a.Arrival < b.Arrival
+}
+
+type fair struct{}
+
+func (fair) Name() string { return Fair }
+
+func (fair) Less(a, b *Entry) bool {
+	if a.Taken != b.Taken {
+		return a.Taken < b.Taken
+	}
+	return a.Arrival < b.Arrival
+}
+
+// Queue is the pending txs of an account in the order of their nonces.
+type Queue struct {
+	Account  []byte
+	Txs      []types.Transaction
+	Arrivals []uint64 // arrival sequences of Txs
+}
+
+// Select passes the txs of the queues to take in the order of p until take
+// returns false.
+func Select(p Policy, queues []*Queue, take func(tx types.Transaction) bool) {
+	h := &entryHeap{policy: p}
+	for _, q := range queues {
+		if len(q.Txs) > 0 {
+			h.entries = append(h.entries, &cursor{Entry: Entry{Tx: q.Txs[0], Account: q.Account, Arrival: q.Arrivals[0]}, queue: q})
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		c := h.entries[0]
+		if !take(c.Tx) {
+			return
+		}
+		c.Taken++
+		if c.Taken == len(c.queue.Txs) {
+			heap.Pop(h)
+			continue
+		}
+		c.Tx, c.Arrival = c.queue.Txs[c.Taken], c.queue.Arrivals[c.Taken]
+		heap.Fix(h, 0)
+	}
+}
+
+type cursor struct {
+	Entry
+	queue *Queue
+}
+
+type entryHeap struct {
+	policy  Policy
+	entries []*cursor
+}
+
+func (h *entryHeap) Len() int { return len(h.entries) }
+
+func (h *entryHeap) Less(i, j int) bool {
+	a, b := &h.entries[i].Entry, &h.entries[j].Entry
+	if h.policy.Less(a, b) {
+		return true
+	}
+	if h.policy.Less(b, a) {
+		return false
+	}
+	return bytes.Compare(a.Account, b.Account) < 0
+}
+
+func (h *entryHeap) Swap(i, j int) { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+
+func (h *entryHeap) Push(x interface{}) { h.entries = append(h.entries, x.(*cursor)) }
+
+func (h *entryHeap) Pop() interface{} {
+	old := h.entries
+	c := old[len(old)-1]
+	h.entries = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,121 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package txorder
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTx struct {
+	account byte
+	nonce   uint64
+	price   int64
+	arrival uint64
+}
+
+// queuesOf groups the txs by the accounts in the order of the nonces, with
+// the accounts shuffled as the mempool map does.
+func queuesOf(rnd *rand.Rand, txs []testTx) []*Queue {
+	byAccount := map[byte]*Queue{}
+	var queues []*Queue
+	for _, tx := range txs {
+		q, ok := byAccount[tx.account]
+		if !ok {
+			q = &Queue{Account: []byte{tx.account}}
+			byAccount[tx.account] = q
+			queues = append(queues, q)
+		}
+		body := &types.TxBody{Account: []byte{tx.account}, Nonce: tx.nonce, GasPrice: big.NewInt(tx.price).Bytes()}
+		q.Txs = append(q.Txs, types.NewTransaction(&types.Tx{Body: body}))
+		q.Arrivals = append(q.Arrivals, tx.arrival)
+	}
+	rnd.Shuffle(len(queues), func(i, j int) { queues[i], queues[j] = queues[j], queues[i] })
+	return queues
+}
+
+type selected struct {
+	account byte
+	nonce   uint64
+}
+
+func selectAll(p Policy, queues []*Queue, max int) []selected {
+	var out []selected
+	Select(p, queues, func(tx types.Transaction) bool {
+		if len(out) == max {
+			return false
+		}
+		out = append(out, selected{tx.GetBody().GetAccount()[0], tx.GetBody().GetNonce()})
+		return true
+	})
+	return out
+}
+
+var testTxs = []testTx{
+	// account 1 sends 3 txs first at a low price
+	{1, 1, 10, 1}, {1, 2, 10, 2}, {1, 3, 10, 3},
+	// account 2 sends the second tx before the first one at a high price
+	{2, 1, 30, 5}, {2, 2, 50, 4},
+	// account 3 and 4 send a tx at the same price
+	{3, 7, 20, 6},
+	{4, 1, 20, 7},
+}
+
+func TestTxOrderPolicies(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name     string
+		expected []selected
+	}{
+		{FIFO, []selected{{1, 1}, {1, 2}, {1, 3}, {2, 1}, {2, 2}, {3, 7}, {4, 1}}},
+		{FeePriority, []selected{{2, 1}, {2, 2}, {3, 7}, {4, 1}, {1, 1}, {1, 2}, {1, 3}}},
+		{Fair, []selected{{1, 1}, {2, 1}, {3, 7}, {4, 1}, {1, 2}, {2, 2}, {1, 3}}},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.name)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.name, p.Name())
+		assert.Equal(t, tt.expected, selectAll(p, queuesOf(rnd, testTxs), len(testTxs)), tt.name)
+		assert.Equal(t, tt.expected[:3], selectAll(p, queuesOf(rnd, testTxs), 3), tt.name+" stopped by take")
+	}
+
+	p, err := New("")
+	assert.NoError(t, err)
+	assert.Equal(t, Default, p, "default policy")
+	_, err = New("random")
+	assert.Error(t, err, "unknown policy")
+}
+
+func TestTxOrderDeterminism(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+
+	// many accounts with the same prices and the arrivals to tie
+	var txs []testTx
+	for a := byte(1); a <= 20; a++ {
+		for n := uint64(1); n <= uint64(a%4)+1; n++ {
+			txs = append(txs, testTx{a, n, int64(a % 3), uint64(a % 5)})
+		}
+	}
+	for _, name := range []string{FIFO, FeePriority, Fair} {
+		p, _ := New(name)
+		expected := selectAll(p, queuesOf(rnd, txs), len(txs))
+		assert.Len(t, expected, len(txs), name)
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, expected, selectAll(p, queuesOf(rnd, txs), len(txs)), name)
+		}
+
+		// the txs of each account are in the order of the nonces
+		next := map[byte]uint64{}
+		for _, s := range expected {
+			next[s.account]++
+			assert.Equal(t, next[s.account], s.nonce, name)
+		}
+	}
+}
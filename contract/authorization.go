@@ -0,0 +1,100 @@
+package contract
+
+import (
+	"encoding/hex"
+	"runtime"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// AuthCheckFunction is the function of an authorization contract. It is
+// called with the account, the hash of the tx without the sign and the sign,
+// both in hex, and authorizes the tx by returning true.
+const AuthCheckFunction = "check_authorization"
+
+// AuthorizeTx checks the tx of the account by the authorization contract
+// designated by the account. It reports false if the account designates none
+// or the contract has no code, in which case the tx is signed by the key of
+// the account.
+func AuthorizeTx(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, account []byte) (bool, error) {
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return false, err
+	}
+	authContract, err := system.GetAuthContract(scs, account)
+	if err != nil || authContract == nil {
+		return false, err
+	}
+	return checkAuthorization(authContract, bs, cdb, account,
+		key.CalculateHashWithoutSign(tx.GetBody()), tx.GetBody().GetSign())
+}
+
+// VerifyTxSign verifies the tx of the account by the authorization contract
+// designated by the account on bs, or else by the signature of its key unless
+// signed, which reports the signature is verified already. The contract
+// overrides the key, so a signed tx is still checked by it. bs is nil unless
+// the authorization contracts are active.
+func VerifyTxSign(bs *state.BlockState, tx *types.Tx, account []byte, signed bool) error {
+	if bs != nil {
+		checked, err := AuthorizeTx(bs, nil, tx, account)
+		if err != nil || checked {
+			return err
+		}
+	}
+	if signed {
+		return nil
+	}
+	return key.VerifyTxWithAddress(tx, account)
+}
+
+// checkAuthorization calls the check function of the authorization contract,
+// which runs as a query with a lower instruction limit.
+func checkAuthorization(authContract []byte, bs *state.BlockState, cdb ChainAccessor,
+	account, hash, sign []byte) (checked bool, err error) {
+	contractState, err := bs.OpenContractStateAccount(types.ToAccountID(authContract))
+	if err != nil {
+		return false, err
+	}
+	contract := getContract(contractState, nil)
+	if contract == nil {
+		return false, nil
+	}
+	ci := types.CallInfo{
+		Name: AuthCheckFunction,
+		Args: []interface{}{
+			types.EncodeAddress(account),
+			"0x" + hex.EncodeToString(hash),
+			"0x" + hex.EncodeToString(sign),
+		},
+	}
+
+	// the vm runs on the thread of the caller as for the other queries
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	stateSet := NewContextQuery(bs, cdb, authContract, contractState, "", true,
+		contractState.SqlRecoveryPoint)
+
+	setQueryContext(stateSet)
+	ce := newExecutor(contract, authContract, stateSet, &ci, stateSet.curContract.amount, false, contractState)
+	defer ce.close()
+	defer func() {
+		if dbErr := ce.rollbackToSavepoint(); dbErr != nil {
+			err = dbErr
+		}
+	}()
+	ce.setCountHook(authCheckMaxInstLimit)
+	ce.call(nil)
+
+	curStateSet[stateSet.service] = nil
+	if ce.err != nil {
+		return true, ce.err
+	}
+	if ce.jsonRet != "true" {
+		return true, types.ErrTxNotAuthorized
+	}
+	return true, nil
+}
@@ -0,0 +1,258 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package bridge executes the txs of the aergo.bridge system contract, which
+// transfers the aergo between the chain and its paired chain. The validators
+// of the bridge anchor the state roots of the paired chain, and the deposits
+// made there are claimed here by the merkle proofs of their totals in the
+// bridge storage of the paired chain.
+package bridge
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/proof"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+const votePrefix = "vote-"
+
+var (
+	errStaleAnchor   = errors.New("anchor is not above the anchored height")
+	errProofContract = errors.New("proof is not of the bridge of the paired chain")
+	errBridgeBalance = errors.New("not enough aergo locked in the bridge")
+)
+
+// ExecuteBridgeTx executes the bridge tx sent to receiver, the bridge account,
+// on the chain of the bridge config.
+func ExecuteBridgeTx(bs *state.BlockState, scs *state.ContractState, txBody *types.TxBody,
+	sender, receiver *state.V, blockNo types.BlockNo, config *types.BridgeConfig) ([]*types.Event, error) {
+
+	call, err := ValidateBridgeTx(txBody, sender, receiver, scs, config)
+	if err != nil {
+		return nil, err
+	}
+	var event *types.Event
+	switch call.Name {
+	case types.BridgeAnchor:
+		event, err = anchor(scs, sender, call.Anchor, config)
+	case types.BridgeDeposit:
+		event, err = deposit(scs, sender, receiver, call.Receiver, txBody.GetAmountBigInt(), config)
+	case types.BridgeClaim:
+		event, err = claim(bs, scs, sender, receiver, call, config)
+	}
+	if err != nil {
+		return nil, err
+	}
+	event.ContractAddress = receiver.ID()
+	return []*types.Event{event}, nil
+}
+
+// ValidateBridgeTx checks the bridge tx against the state of the bridge, the
+// account bridge and its storage scs.
+func ValidateBridgeTx(txBody *types.TxBody, sender, bridge *state.V, scs *state.ContractState,
+	config *types.BridgeConfig) (*types.BridgeCall, error) {
+	if config == nil {
+		return nil, types.ErrBridgeDisabled
+	}
+	call, err := types.ParseBridgeTx(txBody)
+	if err != nil {
+		return nil, err
+	}
+	switch call.Name {
+	case types.BridgeAnchor:
+		if !config.IsValidator(txBody.GetAccount()) {
+			return nil, types.ErrBridgeNotValidator
+		}
+		current, err := GetAnchor(scs)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil && call.Anchor.Height <= current.Height {
+			return nil, errStaleAnchor
+		}
+	case types.BridgeDeposit:
+		if sender != nil && sender.Balance().Cmp(txBody.GetAmountBigInt()) < 0 {
+			return nil, types.ErrInsufficientBalance
+		}
+	case types.BridgeClaim:
+		_, amount, err := claimable(scs, call)
+		if err != nil {
+			return nil, err
+		}
+		if !config.Mint && bridge != nil && bridge.Balance().Cmp(amount) < 0 {
+			return nil, errBridgeBalance
+		}
+	}
+	return call, nil
+}
+
+// GetAnchor returns the anchored root of the paired chain, or nil if there is
+// none yet.
+func GetAnchor(scs *state.ContractState) (*types.BridgeRoot, error) {
+	data, err := scs.GetData([]byte(types.BridgeAnchorKey))
+	if err != nil {
+		return nil, err
+	}
+	return types.DecodeBridgeRoot(data), nil
+}
+
+// GetDeposited returns the total deposited to the receiver on the paired
+// chain.
+func GetDeposited(scs *state.ContractState, receiver types.Address) (*big.Int, error) {
+	return getAmount(scs, types.BridgeDepositKey(receiver))
+}
+
+// GetClaimed returns the total claimed by the receiver.
+func GetClaimed(scs *state.ContractState, receiver types.Address) (*big.Int, error) {
+	return getAmount(scs, types.BridgeClaimKey(receiver))
+}
+
+func getAmount(scs *state.ContractState, key string) (*big.Int, error) {
+	data, err := scs.GetData([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return types.BridgeAmount(data), nil
+}
+
+// anchor records the vote of the validator for the root, and anchors it once
+// the threshold of the validators vote for it.
+func anchor(scs *state.ContractState, sender *state.V, root *types.BridgeRoot,
+	config *types.BridgeConfig) (*types.Event, error) {
+	vote := root.Bytes()
+	if err := scs.SetData([]byte(votePrefix+types.EncodeAddress(sender.ID())), vote); err != nil {
+		return nil, err
+	}
+	votes := 0
+	for _, v := range config.Validators {
+		validator, _ := types.DecodeAddress(v)
+		data, err := scs.GetData([]byte(votePrefix + types.EncodeAddress(validator)))
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(data, vote) {
+			votes++
+		}
+	}
+	args := `{"height":` + strconv.FormatUint(root.Height, 10) +
+		`,"root":"` + enc.ToString(root.Root) + `","votes":` + strconv.Itoa(votes) + `}`
+	if votes < config.Threshold {
+		return &types.Event{EventName: "vote anchor", JsonArgs: args}, nil
+	}
+	if err := scs.SetData([]byte(types.BridgeAnchorKey), vote); err != nil {
+		return nil, err
+	}
+	return &types.Event{EventName: "anchor", JsonArgs: args}, nil
+}
+
+// deposit locks the amount in the bridge, or burns it on a minting chain, and
+// adds it to the total of the receiver proven to the paired chain.
+func deposit(scs *state.ContractState, sender, bridge *state.V, to types.Address, amount *big.Int,
+	config *types.BridgeConfig) (*types.Event, error) {
+	total, err := GetDeposited(scs, to)
+	if err != nil {
+		return nil, err
+	}
+	total.Add(total, amount)
+	if err = scs.SetData([]byte(types.BridgeDepositKey(to)), total.Bytes()); err != nil {
+		return nil, err
+	}
+	sender.SubBalance(amount)
+	if !config.Mint {
+		bridge.AddBalance(amount)
+	}
+	return &types.Event{
+		EventName: "deposit",
+		JsonArgs: `{"from":"` + types.EncodeAddress(sender.ID()) +
+			`","to":"` + types.EncodeAddress(to) +
+			`","amount":"` + amount.String() +
+			`","total":"` + total.String() + `"}`,
+	}, nil
+}
+
+// claim pays the receiver the deposits to it on the paired chain which are
+// not claimed yet, unlocked from the bridge or minted on a minting chain.
+func claim(bs *state.BlockState, scs *state.ContractState, sender, bridge *state.V, call *types.BridgeCall,
+	config *types.BridgeConfig) (*types.Event, error) {
+	total, amount, err := claimable(scs, call)
+	if err != nil {
+		return nil, err
+	}
+	if err = scs.SetData([]byte(types.BridgeClaimKey(call.Receiver)), total.Bytes()); err != nil {
+		return nil, err
+	}
+	to := sender
+	if !bytes.Equal(call.Receiver, sender.ID()) {
+		if to, err = bs.GetAccountStateV(call.Receiver); err != nil {
+			return nil, err
+		}
+	}
+	to.AddBalance(amount)
+	if !config.Mint {
+		bridge.SubBalance(amount)
+	}
+	if to != sender {
+		if err = to.PutState(); err != nil {
+			return nil, err
+		}
+	}
+	return &types.Event{
+		EventName: "claim",
+		JsonArgs: `{"to":"` + types.EncodeAddress(call.Receiver) +
+			`","amount":"` + amount.String() +
+			`","total":"` + total.String() + `"}`,
+	}, nil
+}
+
+// claimable verifies the proof of the deposits against the anchored root, and
+// returns their proven total and the amount not claimed yet.
+func claimable(scs *state.ContractState, call *types.BridgeCall) (*big.Int, *big.Int, error) {
+	root, err := GetAnchor(scs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if root == nil {
+		return nil, nil, types.ErrBridgeNoAnchor
+	}
+	total, err := provenDeposit(root.Root, call.Receiver, call.Proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	claimed, err := GetClaimed(scs, call.Receiver)
+	if err != nil {
+		return nil, nil, err
+	}
+	amount := new(big.Int).Sub(total, claimed)
+	if amount.Sign() <= 0 {
+		return nil, nil, types.ErrBridgeNoDeposit
+	}
+	return total, amount, nil
+}
+
+// provenDeposit returns the total deposited to the receiver in the bridge
+// storage of the paired chain at the root.
+func provenDeposit(root []byte, receiver types.Address, query *types.StateQueryProof) (*big.Int, error) {
+	contractProof := query.GetContractProof()
+	if string(contractProof.GetKey()) != types.AergoBridge || !contractProof.GetInclusion() {
+		return nil, errProofContract
+	}
+	if err := proof.VerifyStateQuery(root, query); err != nil {
+		return nil, fmt.Errorf("invalid proof of deposit: %s", err)
+	}
+	key := types.BridgeDepositKey(receiver)
+	for _, varProof := range query.GetVarProofs() {
+		if varProof.GetKey() == key && varProof.GetInclusion() {
+			return types.BridgeAmount(varProof.GetValue()), nil
+		}
+	}
+	return nil, types.ErrBridgeNoDeposit
+}
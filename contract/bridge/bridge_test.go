@@ -0,0 +1,184 @@
+package bridge
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDir    = "test"
+	validator1 = "AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL"
+	validator2 = "AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As"
+	user       = "AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"
+	receiver   = "AmNrsAqkXhQfE6sGxTutQkf9ekaYowaJFLekEm8qvDr1RB1AnsiM"
+)
+
+func newTestChain(t *testing.T, name string) *state.ChainStateDB {
+	genesis := types.GetTestGenesis()
+	sdb := state.NewChainStateDB()
+	if err := sdb.Init(string(db.BadgerImpl), filepath.Join(testDir, name), genesis.Block(), false); err != nil {
+		t.Fatalf("failed init : %s", err.Error())
+	}
+	if err := sdb.SetGenesis(genesis, nil); err != nil {
+		t.Fatalf("failed init : %s", err.Error())
+	}
+	return sdb
+}
+
+func commit(t *testing.T, sdb *state.ChainStateDB, bs *state.BlockState) {
+	assert.NoError(t, bs.Update())
+	assert.NoError(t, bs.Commit())
+	assert.NoError(t, sdb.UpdateRoot(bs))
+}
+
+func fund(t *testing.T, sdb *state.ChainStateDB, address string, amount int64) {
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	account, err := bs.GetAccountStateV(types.ToAddress(address))
+	assert.NoError(t, err)
+	account.AddBalance(big.NewInt(amount))
+	assert.NoError(t, account.PutState())
+	commit(t, sdb, bs)
+}
+
+func balanceOf(t *testing.T, sdb *state.ChainStateDB, address []byte) int64 {
+	account, err := sdb.GetStateDB().GetAccountStateV(address)
+	assert.NoError(t, err)
+	return account.Balance().Int64()
+}
+
+func execute(t *testing.T, sdb *state.ChainStateDB, config *types.BridgeConfig, from string, amount int64,
+	name string, args ...interface{}) ([]*types.Event, error) {
+	payload, err := json.Marshal(&types.CallInfo{Name: name, Args: args})
+	assert.NoError(t, err)
+	txBody := &types.TxBody{
+		Account:   types.ToAddress(from),
+		Recipient: []byte(types.AergoBridge),
+		Amount:    big.NewInt(amount).Bytes(),
+		Payload:   payload,
+		Type:      types.TxType_GOVERNANCE,
+	}
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	sender, err := bs.GetAccountStateV(txBody.Account)
+	assert.NoError(t, err)
+	bridge, err := bs.GetAccountStateV(txBody.Recipient)
+	assert.NoError(t, err)
+	scs, err := bs.StateDB.OpenContractState(bridge.AccountID(), bridge.State())
+	assert.NoError(t, err)
+	events, err := ExecuteBridgeTx(bs, scs, txBody, sender, bridge, 1, config)
+	if err != nil {
+		return nil, err
+	}
+	assert.NoError(t, sender.PutState())
+	assert.NoError(t, bridge.PutState())
+	assert.NoError(t, bs.StageContractState(scs))
+	commit(t, sdb, bs)
+	return events, nil
+}
+
+// depositProof returns the encoded proof of the deposits to the receiver in
+// the latest state of the chain, as GetBridgeProof does.
+func depositProof(t *testing.T, sdb *state.ChainStateDB, to string) (string, []byte) {
+	states := sdb.GetStateDB()
+	root := states.GetRoot()
+	id := types.ToAccountID([]byte(types.AergoBridge))
+	contractProof, err := states.GetAccountAndProof(id[:], root, false)
+	assert.NoError(t, err)
+	contractProof.Key = []byte(types.AergoBridge)
+	key := types.BridgeDepositKey(types.ToAddress(to))
+	varProof, err := states.GetVarAndProof(common.Hasher([]byte(key)), contractProof.State.StorageRoot, false)
+	assert.NoError(t, err)
+	varProof.Key = key
+	encoded, err := types.EncodeBridgeProof(&types.StateQueryProof{ContractProof: contractProof, VarProofs: []*types.ContractVarProof{varProof}})
+	assert.NoError(t, err)
+	return encoded, root
+}
+
+func anchorRoot(t *testing.T, sdb *state.ChainStateDB, config *types.BridgeConfig, height uint64, root []byte) {
+	h := strconv.FormatUint(height, 10)
+	events, err := execute(t, sdb, config, validator1, 0, types.BridgeAnchor, h, enc.ToString(root))
+	assert.NoError(t, err)
+	assert.Equal(t, "vote anchor", events[0].EventName)
+	events, err = execute(t, sdb, config, validator2, 0, types.BridgeAnchor, h, enc.ToString(root))
+	assert.NoError(t, err)
+	assert.Equal(t, "anchor", events[0].EventName)
+}
+
+func TestBridge(t *testing.T) {
+	defer os.RemoveAll(testDir)
+	mainnet := newTestChain(t, "mainnet")
+	defer mainnet.Close()
+	sidechain := newTestChain(t, "sidechain")
+	defer sidechain.Close()
+	locking := &types.BridgeConfig{Validators: []string{validator1, validator2}, Threshold: 2}
+	minting := &types.BridgeConfig{Validators: []string{validator1, validator2}, Threshold: 2, Mint: true}
+	bridgeAccount := []byte(types.AergoBridge)
+
+	_, err := execute(t, mainnet, nil, user, 100, types.BridgeDeposit, receiver)
+	assert.Equal(t, types.ErrBridgeDisabled, err)
+
+	// the deposit on the main net is locked in the bridge
+	fund(t, mainnet, user, 1000)
+	_, err = execute(t, mainnet, locking, user, 2000, types.BridgeDeposit, receiver)
+	assert.Equal(t, types.ErrInsufficientBalance, err)
+	events, err := execute(t, mainnet, locking, user, 100, types.BridgeDeposit, receiver)
+	assert.NoError(t, err)
+	assert.Equal(t, "deposit", events[0].EventName)
+	assert.Equal(t, int64(900), balanceOf(t, mainnet, types.ToAddress(user)))
+	assert.Equal(t, int64(100), balanceOf(t, mainnet, bridgeAccount))
+	proof, root := depositProof(t, mainnet, receiver)
+
+	// the deposit is claimed on the sidechain once the root is anchored
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, receiver, proof)
+	assert.Equal(t, types.ErrBridgeNoAnchor, err)
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeAnchor, "1", enc.ToString(root))
+	assert.Equal(t, types.ErrBridgeNotValidator, err)
+	anchorRoot(t, sidechain, minting, 1, root)
+	_, err = execute(t, sidechain, minting, validator1, 0, types.BridgeAnchor, "1", enc.ToString(root))
+	assert.Equal(t, errStaleAnchor, err)
+
+	events, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, receiver, proof)
+	assert.NoError(t, err)
+	assert.Equal(t, "claim", events[0].EventName)
+	assert.Equal(t, int64(100), balanceOf(t, sidechain, types.ToAddress(receiver)))
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, receiver, proof)
+	assert.Equal(t, types.ErrBridgeNoDeposit, err)
+
+	// a proof does not prove the deposits to another receiver, nor against
+	// another root
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, user, proof)
+	assert.Equal(t, types.ErrBridgeNoDeposit, err)
+	_, err = execute(t, mainnet, locking, user, 50, types.BridgeDeposit, receiver)
+	assert.NoError(t, err)
+	proof, root = depositProof(t, mainnet, receiver)
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, receiver, proof)
+	assert.Error(t, err)
+
+	// only the deposits not claimed yet are paid
+	anchorRoot(t, sidechain, minting, 2, root)
+	_, err = execute(t, sidechain, minting, user, 0, types.BridgeClaim, receiver, proof)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(150), balanceOf(t, sidechain, types.ToAddress(receiver)))
+
+	// the deposit back on the sidechain is burned, and unlocked on the main net
+	_, err = execute(t, sidechain, minting, receiver, 30, types.BridgeDeposit, user)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(120), balanceOf(t, sidechain, types.ToAddress(receiver)))
+	assert.Equal(t, int64(0), balanceOf(t, sidechain, bridgeAccount))
+	proof, root = depositProof(t, sidechain, user)
+	anchorRoot(t, mainnet, locking, 10, root)
+	_, err = execute(t, mainnet, locking, user, 0, types.BridgeClaim, user, proof)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(880), balanceOf(t, mainnet, types.ToAddress(user)))
+	assert.Equal(t, int64(120), balanceOf(t, mainnet, bridgeAccount))
+}
@@ -3,12 +3,10 @@ package contract
 import "C"
 import (
 	"math/big"
-	"strconv"
 
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
-	"github.com/minio/sha256-simd"
 )
 
 type loadedReply struct {
@@ -50,11 +48,22 @@ func SetPreloadTx(tx *types.Tx, service int) {
 }
 
 func Execute(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, blockNo uint64, ts int64, prevBlockHash []byte,
-	sender, receiver *state.V, preLoadService int) (rv string, events []*types.Event, usedFee *big.Int, err error) {
+	sender, receiver *state.V, preLoadService int) (rv string, events []*types.Event, usedFee *big.Int, usedGas uint64, err error) {
 
 	txBody := tx.GetBody()
 
-	usedFee = fee.PayloadTxFee(len(txBody.GetPayload()))
+	var gasLimit uint64
+	if fee.IsGasFeeEnabled() {
+		gasLimit = fee.GasLimit(txBody.GetGasLimit())
+		usedGas = fee.IntrinsicGas(len(txBody.GetPayload()))
+		usedFee = fee.GasFee(usedGas, txBody.GetGasPriceBigInt())
+		if usedGas > gasLimit {
+			err = types.ErrTxInvalidGasLimit
+			return
+		}
+	} else {
+		usedFee = fee.PayloadTxFee(len(txBody.GetPayload()))
+	}
 
 	// Transfer balance
 	if sender.AccountID() != receiver.AccountID() {
@@ -94,12 +103,18 @@ func Execute(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, blockNo uint
 	}
 
 	var cFee *big.Int
+	var stateSet *StateSet
 	if ex != nil {
+		stateSet = ex.stateSet
+		stateSet.gasLimit = gasLimit - usedGas
+		stateSet.traceInternalOps()
 		rv, events, cFee, err = PreCall(ex, bs, sender, contractState, blockNo, ts, receiver.RP(), prevBlockHash)
 	} else {
-		stateSet := NewContext(bs, cdb, sender, receiver, contractState, sender.ID(),
+		stateSet = NewContext(bs, cdb, sender, receiver, contractState, sender.ID(),
 			tx.GetHash(), blockNo, ts, prevBlockHash, "", true,
 			false, receiver.RP(), preLoadService, txBody.GetAmountBigInt())
+		stateSet.gasLimit = gasLimit - usedGas
+		stateSet.traceInternalOps()
 
 		if receiver.IsCreate() {
 			rv, events, cFee, err = Create(contractState, txBody.Payload, receiver.ID(), stateSet)
@@ -108,21 +123,29 @@ func Execute(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, blockNo uint
 		}
 	}
 
-	usedFee.Add(usedFee, cFee)
+	if fee.IsGasFeeEnabled() {
+		usedGas += stateSet.gasUsed
+		usedFee = fee.GasFee(usedGas, txBody.GetGasPriceBigInt())
+	} else {
+		usedFee.Add(usedFee, cFee)
+	}
 
 	if err != nil {
 		if isSystemError(err) {
-			return "", events, usedFee, err
+			return "", events, usedFee, usedGas, err
 		}
-		return "", events, usedFee, newVmError(err)
+		stateSet.addInternalOps()
+		return "", events, usedFee, usedGas, newVmError(err)
 	}
+	stateSet.addInternalOps()
+	stateSet.addIndexedEvents()
 
 	err = bs.StageContractState(contractState)
 	if err != nil {
-		return "", events, usedFee, err
+		return "", events, usedFee, usedGas, err
 	}
 
-	return rv, events, usedFee, nil
+	return rv, events, usedFee, usedGas, nil
 }
 
 func PreLoadRequest(bs *state.BlockState, tx *types.Tx, preLoadService int) {
@@ -148,7 +171,8 @@ func preLoadWorker() {
 		txBody := tx.GetBody()
 		recipient := txBody.Recipient
 
-		if txBody.Type != types.TxType_NORMAL || len(recipient) == 0 {
+		if (txBody.Type != types.TxType_NORMAL && txBody.Type != types.TxType_FEEDELEGATION) ||
+			len(recipient) == 0 {
 			continue
 		}
 
@@ -177,9 +201,5 @@ func preLoadWorker() {
 }
 
 func CreateContractID(account []byte, nonce uint64) []byte {
-	h := sha256.New()
-	h.Write(account)
-	h.Write([]byte(strconv.FormatUint(nonce, 10)))
-	recipientHash := h.Sum(nil)                   // byte array with length 32
-	return append([]byte{0x0C}, recipientHash...) // prepend 0x0C to make it same length as account addresses
+	return types.CreateContractID(account, nonce)
 }
@@ -334,7 +334,7 @@ static int moduleDeploy(lua_State *L)
 	return ret.r0;
 }
 
-static int moduleEvent(lua_State *L)
+static int emitEvent(lua_State *L, int indexed, int argStart)
 {
 	char *event_name;
 	char *json_args;
@@ -346,11 +346,11 @@ static int moduleEvent(lua_State *L)
 	}
 
 	event_name = (char *)luaL_checkstring(L, 1);
-	json_args = lua_util_get_json_from_stack (L, 2, lua_gettop(L), false);
+	json_args = lua_util_get_json_from_stack (L, argStart, lua_gettop(L), false);
 	if (json_args == NULL) {
 		luaL_throwerror(L);
 	}
-	errStr = LuaEvent(L, service, event_name, json_args);
+	errStr = LuaEvent(L, service, event_name, json_args, indexed);
 	if (errStr != NULL) {
 	    strPushAndRelease(L, errStr);
 	    luaL_throwerror(L);
@@ -359,6 +359,20 @@ static int moduleEvent(lua_State *L)
 	return 0;
 }
 
+static int moduleEvent(lua_State *L)
+{
+	return emitEvent(L, 0, 2);
+}
+
+/* contract.indexed_event(name, n, ...) emits an event whose first n
+ * arguments are indexed to be filtered by their values */
+static int moduleIndexedEvent(lua_State *L)
+{
+	int indexed = luaL_checkint(L, 2);
+
+	return emitEvent(L, indexed, 3);
+}
+
 static int governance(lua_State *L, char type) {
 	char *ret;
 	int *service = (int *)getLuaExecContext(L);
@@ -423,6 +437,54 @@ static int moduleVote(lua_State *L) {
     return governance(L, 'V');
 }
 
+static char *amount_arg(lua_State *L, int idx, bool *needfree)
+{
+    char *arg;
+
+    *needfree = false;
+    switch(lua_type(L, idx)) {
+    case LUA_TNUMBER:
+    case LUA_TSTRING:
+        return (char *)lua_tostring(L, idx);
+    case LUA_TUSERDATA:
+        arg = lua_get_bignum_str(L, idx);
+        if (arg == NULL) {
+            luaL_error(L, "not enough memory");
+        }
+        *needfree = true;
+        return arg;
+    default:
+        luaL_error(L, "invalid input");
+    }
+    return NULL;
+}
+
+static int moduleFeeDelegation(lua_State *L)
+{
+	int *service = (int *)getLuaExecContext(L);
+	char *per_account;
+	char *per_block;
+	bool free_account, free_block;
+	char *errStr;
+
+	if (service == NULL) {
+		luaL_error(L, "cannot find execution context");
+	}
+
+	per_account = amount_arg(L, 1, &free_account);
+	per_block = amount_arg(L, 2, &free_block);
+	errStr = LuaFeeDelegation(L, service, per_account, per_block);
+	if (free_account)
+	    free(per_account);
+	if (free_block)
+	    free(per_block);
+	if (errStr != NULL) {
+	    strPushAndRelease(L, errStr);
+	    luaL_throwerror(L);
+	}
+	return 0;
+}
+
 static const luaL_Reg call_methods[] = {
 	{"value", call_value},
 	{"amount", call_value},
@@ -461,9 +523,11 @@ static const luaL_Reg contract_lib[] = {
 	{"send", moduleSend},
 	{"pcall", modulePcall},
 	{"event", moduleEvent},
+	{"indexed_event", moduleIndexedEvent},
 	{"stake", moduleStake},
 	{"unstake", moduleUnstake},
 	{"vote", moduleVote},
+	{"fee_delegation", moduleFeeDelegation},
 	{NULL, NULL}
 };
 
@@ -0,0 +1,133 @@
+package contract
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	feeDelegationKey      = "FeeDelegation"
+	feeDelegationUsageKey = "FeeDelegation-usage"
+)
+
+// FeeDelegation is the budget a contract grants for paying the fee of the
+// txs calling it. Both budgets are renewed every block.
+type FeeDelegation struct {
+	PerAccount *big.Int
+	PerBlock   *big.Int
+}
+
+// feeUsage is the fee a contract paid in a block.
+type feeUsage struct {
+	BlockNo types.BlockNo
+	Amount  *big.Int
+}
+
+func (u *feeUsage) amountAt(blockNo types.BlockNo) *big.Int {
+	if u == nil || u.BlockNo != blockNo {
+		return big.NewInt(0)
+	}
+	return u.Amount
+}
+
+// GetFeeDelegation returns the fee delegation of a contract, or nil if the
+// contract does not pay the fee of its callers.
+func GetFeeDelegation(contractState *state.ContractState) (*FeeDelegation, error) {
+	data, err := contractState.GetData([]byte(feeDelegationKey))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	l := binary.LittleEndian.Uint32(data)
+	return &FeeDelegation{
+		PerAccount: new(big.Int).SetBytes(data[4 : 4+l]),
+		PerBlock:   new(big.Int).SetBytes(data[4+l:]),
+	}, nil
+}
+
+func setFeeDelegation(contractState *state.ContractState, fd *FeeDelegation) error {
+	if fd.PerAccount.Sign() == 0 && fd.PerBlock.Sign() == 0 {
+		return contractState.DeleteData([]byte(feeDelegationKey))
+	}
+	perAccount := fd.PerAccount.Bytes()
+	data := make([]byte, 4, 4+len(perAccount)+len(fd.PerBlock.Bytes()))
+	binary.LittleEndian.PutUint32(data, uint32(len(perAccount)))
+	data = append(data, perAccount...)
+	data = append(data, fd.PerBlock.Bytes()...)
+	return contractState.SetData([]byte(feeDelegationKey), data)
+}
+
+func feeUsageKey(account []byte) []byte {
+	return append([]byte(feeDelegationUsageKey), account...)
+}
+
+func getFeeUsage(contractState *state.ContractState, key []byte) (*feeUsage, error) {
+	data, err := contractState.GetData(key)
+	if err != nil || len(data) < 8 {
+		return nil, err
+	}
+	return &feeUsage{
+		BlockNo: binary.LittleEndian.Uint64(data),
+		Amount:  new(big.Int).SetBytes(data[8:]),
+	}, nil
+}
+
+func setFeeUsage(contractState *state.ContractState, key []byte, u *feeUsage) error {
+	data := make([]byte, 8, 8+len(u.Amount.Bytes()))
+	binary.LittleEndian.PutUint64(data, u.BlockNo)
+	return contractState.SetData(key, append(data, u.Amount.Bytes()...))
+}
+
+// CheckFeeDelegation checks that the contract pays up to maxFee for a tx of
+// the account in the block: it must have opted in, have enough balance and
+// stay within its budgets.
+func CheckFeeDelegation(contractState *state.ContractState, account []byte,
+	maxFee *big.Int, blockNo types.BlockNo) error {
+	if len(contractState.GetCodeHash()) == 0 {
+		return types.ErrNotAllowedFeeDelegation
+	}
+	fd, err := GetFeeDelegation(contractState)
+	if err != nil {
+		return err
+	}
+	if fd == nil {
+		return types.ErrNotAllowedFeeDelegation
+	}
+	if contractState.GetBalance().Cmp(maxFee) < 0 {
+		return types.ErrInsufficientBalance
+	}
+	accountUsage, err := getFeeUsage(contractState, feeUsageKey(account))
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Add(accountUsage.amountAt(blockNo), maxFee).Cmp(fd.PerAccount) > 0 {
+		return types.ErrFeeDelegationBudget
+	}
+	blockUsage, err := getFeeUsage(contractState, []byte(feeDelegationUsageKey))
+	if err != nil {
+		return err
+	}
+	if new(big.Int).Add(blockUsage.amountAt(blockNo), maxFee).Cmp(fd.PerBlock) > 0 {
+		return types.ErrFeeDelegationBudget
+	}
+	return nil
+}
+
+// ChargeFeeDelegation adds the fee paid by the contract for a tx of the
+// account to its usage of the block.
+func ChargeFeeDelegation(contractState *state.ContractState, account []byte,
+	fee *big.Int, blockNo types.BlockNo) error {
+	for _, key := range [][]byte{feeUsageKey(account), []byte(feeDelegationUsageKey)} {
+		u, err := getFeeUsage(contractState, key)
+		if err != nil {
+			return err
+		}
+		used := new(big.Int).Add(u.amountAt(blockNo), fee)
+		if err := setFeeUsage(contractState, key, &feeUsage{blockNo, used}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package contract
+
+/*
+#include "vm.h"
+*/
+import "C"
+import (
+	"math"
+
+	"github.com/aergoio/aergo/fee"
+)
+
+// The gas costs of the builtin functions. They are charged on top of the
+// one gas per VM instruction when the fee is metered by gas.
+const (
+	gasDbGet        = C.int(200)
+	gasDbSet        = C.int(5000)
+	gasDbDel        = C.int(2000)
+	gasStatePerByte = C.int(5)
+	gasEvent        = C.int(500)
+	gasEventIndex   = C.int(1000)
+	gasCrypto       = C.int(1000)
+	gasGovernance   = C.int(10000)
+)
+
+// instLimit returns the number of instructions a call may run. The tx gas
+// limit replaces the fixed instruction limit when the fee is metered by gas.
+func (s *StateSet) instLimit() C.int {
+	if !fee.IsGasFeeEnabled() {
+		return callMaxInstLimit
+	}
+	if s.gasLimit > math.MaxInt32 {
+		return C.int(math.MaxInt32)
+	}
+	if s.gasLimit == 0 {
+		return 1
+	}
+	return C.int(s.gasLimit)
+}
+
+// setGasUsed records the gas spent by the instructions and builtins of ce,
+// which started with the given instruction limit.
+func (ce *Executor) setGasUsed(limit C.int) {
+	if !fee.IsGasFeeEnabled() || ce == nil || ce.L == nil {
+		return
+	}
+	remain := C.luaL_instcount(ce.L)
+	if remain < 0 {
+		remain = 0
+	}
+	ce.stateSet.gasUsed = uint64(limit - remain)
+}
+
+// useGas charges the cost of a builtin to the running contract.
+func useGas(L *LState, cost C.int) {
+	if fee.IsGasFeeEnabled() {
+		setInstMinusCount(L, cost)
+	}
+}
@@ -0,0 +1,82 @@
+package contract
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// The kinds of the internal operations
+const (
+	internalOpCall         = "call"
+	internalOpDelegateCall = "delegatecall"
+	internalOpSend         = "send"
+	internalOpDeploy       = "deploy"
+)
+
+// internalOps records the operations made by the contracts during a tx as a
+// tree of the calls. The operations being executed are kept in the stack.
+type internalOps struct {
+	ops   []*types.InternalOperation
+	stack []*types.InternalOperation
+}
+
+// beginInternalOp records an operation of the caller on the callee. It does
+// nothing unless the operations of the tx are traced.
+func (s *StateSet) beginInternalOp(op string, caller, callee []byte, function, args string, amount *big.Int) {
+	t := s.internalOps
+	if t == nil {
+		return
+	}
+	iop := &types.InternalOperation{
+		Op:       op,
+		Caller:   caller,
+		Callee:   callee,
+		Function: function,
+		Args:     args,
+	}
+	if amount != nil && amount.Sign() > 0 {
+		iop.Amount = amount.Bytes()
+	}
+	if len(t.stack) == 0 {
+		t.ops = append(t.ops, iop)
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, iop)
+	}
+	t.stack = append(t.stack, iop)
+}
+
+// endInternalOp records the result of the operation begun last.
+func (s *StateSet) endInternalOp(err error) {
+	t := s.internalOps
+	if t == nil || len(t.stack) == 0 {
+		return
+	}
+	iop := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	if err != nil {
+		iop.Status = "ERROR"
+		iop.Error = err.Error()
+	} else {
+		iop.Status = "SUCCESS"
+	}
+}
+
+// traceInternalOps makes the operations of the tx recorded.
+func (s *StateSet) traceInternalOps() {
+	s.internalOps = &internalOps{}
+}
+
+// addInternalOps adds the operations recorded during the tx to the block
+// state.
+func (s *StateSet) addInternalOps() {
+	if s.internalOps == nil || len(s.internalOps.ops) == 0 {
+		return
+	}
+	s.bs.AddInternalOperations(&types.InternalOperations{
+		TxHash:     s.txHash,
+		BlockNo:    s.blockHeight,
+		Operations: s.internalOps.ops,
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"strconv"
 
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/state"
@@ -16,7 +18,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 
 	systemContractState, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
 
-	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState)
+	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState, blockNo)
 	if err != nil {
 		return nil, err
 	}
@@ -39,18 +41,19 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 	switch ci.Name {
 	case types.NameCreate:
 		if err = CreateName(scs, txBody, sender, nameState,
-			ci.Args[0].(string)); err != nil {
+			ci.Args[0].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
 			ContractAddress: receiver.ID(),
 			EventIdx:        0,
 			EventName:       "create name",
-			JsonArgs:        `{"name":"` + ci.Args[0].(string) + `"}`,
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","expiry":` + strconv.FormatUint(blockNo+NameRegistrationPeriod, 10) + `}`,
 		})
 	case types.NameUpdate:
 		if err = UpdateName(bs, scs, txBody, sender, nameState,
-			ci.Args[0].(string), ci.Args[1].(string)); err != nil {
+			ci.Args[0].(string), ci.Args[1].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
@@ -60,6 +63,68 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 			JsonArgs: `{"name":"` + ci.Args[0].(string) +
 				`","to":"` + ci.Args[1].(string) + `"}`,
 		})
+	case types.NameRenew:
+		expiry, err := RenewName(scs, txBody, sender, nameState, ci.Args[0].(string))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "renew name",
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","expiry":` + strconv.FormatUint(expiry, 10) + `}`,
+		})
+	case types.NameOffer:
+		price, _ := new(big.Int).SetString(ci.Args[1].(string), 10)
+		var buyer []byte
+		if len(ci.Args) == 3 {
+			buyer, _ = types.DecodeAddress(ci.Args[2].(string))
+		}
+		if err = OfferName(scs, ci.Args[0].(string), price, buyer); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "offer name",
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","price":"` + price.String() +
+				`","buyer":"` + types.EncodeAddress(buyer) + `"}`,
+		})
+	case types.NameCancelOffer:
+		if err = CancelOffer(scs, ci.Args[0].(string)); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "cancel name offer",
+			JsonArgs:        `{"name":"` + ci.Args[0].(string) + `"}`,
+		})
+	case types.NameAccept:
+		seller := getOwner(scs, []byte(ci.Args[0].(string)), false)
+		sellerState := nameState
+		if !bytes.Equal(seller, nameState.ID()) {
+			if sellerState, err = bs.GetAccountStateV(seller); err != nil {
+				return nil, err
+			}
+		}
+		if err = AcceptName(scs, txBody, sender, sellerState, ci.Args[0].(string)); err != nil {
+			return nil, err
+		}
+		if sellerState != nameState {
+			sellerState.PutState()
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "accept name",
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","from":"` + types.EncodeAddress(seller) +
+				`","to":"` + types.EncodeAddress(sender.ID()) +
+				`","price":"` + txBody.GetAmountBigInt().String() + `"}`,
+		})
 	case types.SetContractOwner:
 		ownerState, err := SetContractOwner(bs, scs, ci.Args[0].(string), nameState)
 		if err != nil {
@@ -72,7 +137,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 }
 
 func ValidateNameTx(tx *types.TxBody, sender *state.V,
-	scs, systemcs *state.ContractState) (*types.CallInfo, error) {
+	scs, systemcs *state.ContractState, blockNo types.BlockNo) (*types.CallInfo, error) {
 	if sender != nil && sender.Balance().Cmp(tx.GetAmountBigInt()) < 0 {
 		return nil, types.ErrInsufficientBalance
 	}
@@ -87,8 +152,8 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
 			return nil, types.ErrTooSmallAmount
 		}
-		owner := getOwner(scs, []byte(name), false)
-		if owner != nil {
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap != nil && !nameMap.expired(blockNo) {
 			return nil, fmt.Errorf("aleady occupied %s", string(name))
 		}
 	case types.NameUpdate:
@@ -100,6 +165,65 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 			(!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false))) {
 			return nil, fmt.Errorf("owner not matched : %s", name)
 		}
+		if err := validateNotExpired(scs, name, blockNo); err != nil {
+			return nil, err
+		}
+		if offer, err := getOffer(scs, []byte(name)); err != nil {
+			return nil, err
+		} else if offer != nil {
+			return nil, fmt.Errorf("%s is on offer", name)
+		}
+	case types.NameRenew:
+		namePrice := system.GetNamePrice(systemcs)
+		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+			return nil, types.ErrTooSmallAmount
+		}
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap == nil || !bytes.Equal(tx.Account, nameMap.Owner) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if nameMap.Expiry == 0 {
+			return nil, fmt.Errorf("%s does not expire", name)
+		}
+		if nameMap.expired(blockNo) {
+			return nil, fmt.Errorf("%s is expired", name)
+		}
+	case types.NameOffer:
+		if !bytes.Equal(tx.Account, getOwner(scs, []byte(name), false)) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if err := validateNotExpired(scs, name, blockNo); err != nil {
+			return nil, err
+		}
+	case types.NameCancelOffer:
+		if !bytes.Equal(tx.Account, getOwner(scs, []byte(name), false)) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if offer, err := getOffer(scs, []byte(name)); err != nil {
+			return nil, err
+		} else if offer == nil {
+			return nil, fmt.Errorf("%s is not on offer", name)
+		}
+	case types.NameAccept:
+		offer, err := getOffer(scs, []byte(name))
+		if err != nil {
+			return nil, err
+		}
+		if offer == nil {
+			return nil, fmt.Errorf("%s is not on offer", name)
+		}
+		if offer.Buyer != nil && !bytes.Equal(tx.Account, offer.Buyer) {
+			return nil, fmt.Errorf("%s is not offered to %s", name, types.EncodeAddress(tx.Account))
+		}
+		if bytes.Equal(tx.Account, getOwner(scs, []byte(name), false)) {
+			return nil, fmt.Errorf("%s is already owned by %s", name, types.EncodeAddress(tx.Account))
+		}
+		if new(big.Int).SetBytes(offer.Price).Cmp(tx.GetAmountBigInt()) != 0 {
+			return nil, types.ErrTxInvalidAmount
+		}
+		if err := validateNotExpired(scs, name, blockNo); err != nil {
+			return nil, err
+		}
 	case types.SetContractOwner:
 		owner := getOwner(scs, []byte(types.AergoName), false)
 		if owner != nil {
@@ -111,6 +235,14 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 	return &ci, nil
 }
 
+func validateNotExpired(scs *state.ContractState, name string, blockNo types.BlockNo) error {
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap != nil && nameMap.expired(blockNo) {
+		return fmt.Errorf("%s is expired", name)
+	}
+	return nil
+}
+
 func SetContractOwner(bs *state.BlockState, scs *state.ContractState,
 	address string, nameState *state.V) (*state.V, error) {
 	name := []byte(types.AergoName)
@@ -124,7 +256,7 @@ func SetContractOwner(bs *state.BlockState, scs *state.ContractState,
 	}
 	ownerState.AddBalance(nameState.Balance())
 	nameState.SubBalance(nameState.Balance())
-	if err = registerOwner(scs, name, rawaddr, name); err != nil {
+	if err = registerOwner(scs, name, rawaddr, name, 0); err != nil {
 		return nil, err
 	}
 	return ownerState, nil
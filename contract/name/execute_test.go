@@ -38,9 +38,9 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret := GetAddress(scs, []byte(name))
+	ret := GetAddress(scs, []byte(name), 1)
 	assert.Equal(t, txBody.Account, ret, "pubkey address")
-	ret = GetOwner(scs, []byte(name))
+	ret = GetOwner(scs, []byte(name), 1)
 	assert.Equal(t, txBody.Account, ret, "pubkey owner")
 
 	_, err = ExecuteNameTx(bs, scs, txBody, sender, receiver, 0)
@@ -54,9 +54,9 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret = GetAddress(scs, []byte(name))
+	ret = GetAddress(scs, []byte(name), 1)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey address")
-	ret = GetOwner(scs, []byte(name))
+	ret = GetOwner(scs, []byte(name), 1)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey owner")
 
 	//invalid case
@@ -10,12 +10,24 @@ import (
 	"github.com/aergoio/aergo/types"
 )
 
+// NameRegistrationPeriod is the number of blocks for which a name is
+// registered by a creation or a renewal.
+const NameRegistrationPeriod = 60 * 60 * 24 * 365 //block interval
+
 var prefix = []byte("name")
 
+// NameMap is the registry entry of a name. A name whose Expiry is 0 never
+// expires, which is the case of the names registered before the expiration
+// is introduced (Version 1).
 type NameMap struct {
 	Version     byte
 	Owner       []byte
 	Destination []byte
+	Expiry      types.BlockNo
+}
+
+func (n *NameMap) expired(blockNo types.BlockNo) bool {
+	return n.Expiry != 0 && blockNo > n.Expiry
 }
 
 // AccountStateReader is an interface for getting a name account state.
@@ -23,27 +35,45 @@ type AccountStateReader interface {
 	GetNameAccountState() (*state.ContractState, error)
 }
 
-func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string) error {
+func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
-	return createName(scs, []byte(name), sender.ID())
+	return createName(scs, []byte(name), sender.ID(), blockNo)
 }
 
-func createName(scs *state.ContractState, name []byte, owner []byte) error {
+func createName(scs *state.ContractState, name []byte, owner []byte, blockNo types.BlockNo) error {
+	// the offer of the previous owner of an expired name is void
+	if err := deleteOffer(scs, name); err != nil {
+		return err
+	}
 	//	return setAddress(scs, name, owner)
-	return registerOwner(scs, name, owner, owner)
+	return registerOwner(scs, name, owner, owner, blockNo+NameRegistrationPeriod)
+}
+
+// RenewName extends the registration of the name by NameRegistrationPeriod.
+func RenewName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string) (types.BlockNo, error) {
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap == nil {
+		return 0, fmt.Errorf("%s is not created yet", name)
+	}
+	amount := tx.GetAmountBigInt()
+	sender.SubBalance(amount)
+	receiver.AddBalance(amount)
+	nameMap.Expiry += NameRegistrationPeriod
+	return nameMap.Expiry, setNameMap(scs, []byte(name), nameMap)
 }
 
 //UpdateName is avaliable after bid implement
 func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody,
-	sender, receiver *state.V, name, to string) error {
+	sender, receiver *state.V, name, to string, blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
-	if len(getAddress(scs, []byte(name))) <= types.NameLength {
+	if len(getAddress(scs, []byte(name), blockNo)) <= types.NameLength {
 		return fmt.Errorf("%s is not created yet", string(name))
 	}
 	destination, _ := types.DecodeAddress(to)
-	destination = GetAddress(scs, destination)
+	destination = GetAddress(scs, destination, blockNo)
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
 	contract, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID(destination))
@@ -65,22 +95,28 @@ func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody
 }
 
 func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte) error {
+	var expiry types.BlockNo
+	if nameMap := getNameMap(scs, name, false); nameMap != nil {
+		expiry = nameMap.Expiry
+	}
 	//return setAddress(scs, name, to)
-	return registerOwner(scs, name, owner, to)
+	return registerOwner(scs, name, owner, to, expiry)
 }
 
-//Resolve is resolve name for chain
-func Resolve(bs *state.BlockState, name []byte) []byte {
+//Resolve is resolve name for chain. An expired name is resolved to nil from
+//the block after its expiry.
+func Resolve(bs *state.BlockState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		bytes.Equal(name, []byte(types.AergoSystem)) ||
-		bytes.Equal(name, []byte(types.AergoName)) {
+		bytes.Equal(name, []byte(types.AergoName)) ||
+		bytes.Equal(name, []byte(types.AergoBridge)) {
 		return name
 	}
 	scs, err := openContract(bs)
 	if err != nil {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
 func openContract(bs *state.BlockState) (*state.ContractState, error) {
@@ -95,26 +131,33 @@ func openContract(bs *state.BlockState) (*state.ContractState, error) {
 	return scs, nil
 }
 
-//GetAddress is resolve name for mempool
-func GetAddress(scs *state.ContractState, name []byte) []byte {
+//GetAddress is resolve name for mempool. An expired name is resolved to nil
+//as by Resolve.
+func GetAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	if len(name) == types.AddressLength ||
 		bytes.Equal(name, []byte(types.AergoSystem)) ||
-		bytes.Equal(name, []byte(types.AergoName)) {
+		bytes.Equal(name, []byte(types.AergoName)) ||
+		bytes.Equal(name, []byte(types.AergoBridge)) {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
-func getAddress(scs *state.ContractState, name []byte) []byte {
+func getAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	nameMap := getNameMap(scs, name, true)
-	if nameMap != nil {
+	if nameMap != nil && !nameMap.expired(blockNo) {
 		return nameMap.Destination
 	}
 	return nil
 }
 
-func GetOwner(scs *state.ContractState, name []byte) []byte {
-	return getOwner(scs, name, true)
+//GetOwner returns the owner of the name, which is nil for an expired name.
+func GetOwner(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
+	nameMap := getNameMap(scs, name, true)
+	if nameMap != nil && !nameMap.expired(blockNo) {
+		return nameMap.Owner
+	}
+	return nil
 }
 
 func getOwner(scs *state.ContractState, name []byte, useInitial bool) []byte {
@@ -146,12 +189,22 @@ func GetNameInfo(r AccountStateReader, name string) (*types.NameInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	owner := getOwner(scs, []byte(name), true)
-	return &types.NameInfo{Name: &types.Name{Name: string(name)}, Owner: owner, Destination: GetAddress(scs, []byte(name))}, err
+	// the registry entry is shown with its expiry even after the expiry
+	info := &types.NameInfo{Name: &types.Name{Name: string(name)}}
+	if nameMap := getNameMap(scs, []byte(name), true); nameMap != nil {
+		info.Owner, info.Destination, info.Expiry = nameMap.Owner, nameMap.Destination, nameMap.Expiry
+	}
+	if info.Offer, err = getOffer(scs, []byte(name)); err != nil {
+		return nil, err
+	}
+	return info, nil
 }
 
-func registerOwner(scs *state.ContractState, name, owner, destination []byte) error {
-	nameMap := &NameMap{Version: 1, Owner: owner, Destination: destination}
+func registerOwner(scs *state.ContractState, name, owner, destination []byte, expiry types.BlockNo) error {
+	nameMap := &NameMap{Version: 1, Owner: owner, Destination: destination, Expiry: expiry}
+	if expiry != 0 {
+		nameMap.Version = 2
+	}
 	return setNameMap(scs, name, nameMap)
 }
 
@@ -172,6 +225,10 @@ func serializeNameMap(n *NameMap) []byte {
 		binary.LittleEndian.PutUint64(buf, uint64(len(n.Destination)))
 		ret = append(ret, buf...)
 		ret = append(ret, n.Destination...)
+		if n.Version >= 2 {
+			binary.LittleEndian.PutUint64(buf, n.Expiry)
+			ret = append(ret, buf...)
+		}
 	}
 	return ret
 }
@@ -179,7 +236,7 @@ func serializeNameMap(n *NameMap) []byte {
 func deserializeNameMap(data []byte) *NameMap {
 	if data != nil {
 		version := data[0]
-		if version != 1 {
+		if version != 1 && version != 2 {
 			panic("could not deserializeOwner, not supported version")
 		}
 		offset := 1
@@ -197,10 +254,16 @@ func deserializeNameMap(data []byte) *NameMap {
 		offset = next
 		next = offset + int(sizeOfDest)
 		destination := data[offset:next]
+
+		var expiry types.BlockNo
+		if version >= 2 {
+			expiry = binary.LittleEndian.Uint64(data[next : next+8])
+		}
 		return &NameMap{
 			Version:     version,
 			Owner:       owner,
 			Destination: destination,
+			Expiry:      expiry,
 		}
 	}
 	return nil
@@ -47,23 +47,23 @@ func TestName(t *testing.T) {
 	scs := openContractState(t, bs)
 	systemcs := openSystemContractState(t, bs)
 
-	err := CreateName(scs, tx, sender, receiver, name)
+	err := CreateName(scs, tx, sender, receiver, name, 0)
 	assert.NoError(t, err, "create name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	_, err = ValidateNameTx(tx, sender, scs, systemcs)
+	_, err = ValidateNameTx(tx, sender, scs, systemcs, 0)
 	assert.Error(t, err, "same name")
 
-	ret := getAddress(scs, []byte(name))
+	ret := getAddress(scs, []byte(name), 0)
 	assert.Equal(t, owner, ret, "registed owner")
 
 	tx.Payload = buildNamePayload(name, types.NameUpdate, buyer)
-	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer, 0)
 	assert.NoError(t, err, "update name")
 
 	scs = nextBlockContractState(t, bs, scs)
 
-	ret = getAddress(scs, []byte(name))
+	ret = getAddress(scs, []byte(name), 0)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -81,7 +81,7 @@ func TestNameRecursive(t *testing.T) {
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 	bs := sdb.NewBlockState(sdb.GetRoot())
 	scs := openContractState(t, bs)
-	err := CreateName(scs, tx, sender, receiver, name1)
+	err := CreateName(scs, tx, sender, receiver, name1, 0)
 	assert.NoError(t, err, "create name")
 
 	tx.Account = []byte(name1)
@@ -89,25 +89,25 @@ func TestNameRecursive(t *testing.T) {
 	tx.Payload = buildNamePayload(name2, types.NameCreate, "")
 
 	scs = nextBlockContractState(t, bs, scs)
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "redirect name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	ret := getAddress(scs, []byte(name2))
+	ret := getAddress(scs, []byte(name2), 0)
 	assert.Equal(t, owner, ret, "registed owner")
-	name1Owner := GetOwner(scs, []byte(name1))
+	name1Owner := GetOwner(scs, []byte(name1), 0)
 	t.Logf("name1 owner is %s", types.EncodeAddress(name1Owner))
 	assert.Equal(t, owner, name1Owner, "check registed pubkey owner")
-	name2Owner := GetOwner(scs, []byte(name2))
+	name2Owner := GetOwner(scs, []byte(name2), 0)
 	t.Logf("name2 owner is %s", types.EncodeAddress(name2Owner))
 	assert.Equal(t, owner, name2Owner, "check registed named owner")
 
 	tx.Payload = buildNamePayload(name1, types.NameUpdate, buyer)
 
-	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer, 0)
 	assert.NoError(t, err, "update name")
 	scs = nextBlockContractState(t, bs, scs)
-	ret = getAddress(scs, []byte(name1))
+	ret = getAddress(scs, []byte(name1), 0)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -123,7 +123,7 @@ func TestNameNil(t *testing.T) {
 	sender, _ := sdb.GetStateDB().GetAccountStateV(tx.Account)
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "create name")
 }
 
@@ -213,9 +213,9 @@ func TestNameMap(t *testing.T) {
 	assert.Equal(t, testNameMap.Destination, res.Destination, "Destination")
 	assert.Equal(t, testNameMap.Version, res.Version, "Version")
 
-	resOwner = GetOwner(scs, []byte(name1))
+	resOwner = GetOwner(scs, []byte(name1), 0)
 	assert.Equal(t, testNameMap.Owner, resOwner, "GetOwner")
-	resAddr := getAddress(scs, []byte(name1))
+	resAddr := getAddress(scs, []byte(name1), 0)
 	assert.Equal(t, testNameMap.Destination, resAddr, "getAddress")
 
 }
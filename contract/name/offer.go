@@ -0,0 +1,164 @@
+package name
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var offerPrefix = []byte("offer")
+var offerListKey = []byte("offerlist")
+
+// A name is sold by an offer of its owner and the acceptance of a buyer. The
+// name is held in escrow while it is on offer: it can not be updated, and the
+// buyer pays the price to the owner and receives the name in a single
+// transaction.
+
+// OfferName puts the name on offer at the price. The offer is restricted to
+// the buyer unless it is nil. A new offer replaces the previous one.
+func OfferName(scs *state.ContractState, name string, price *big.Int, buyer []byte) error {
+	offer := &types.NameSaleOffer{Price: price.Bytes(), Buyer: buyer}
+	key := offerKey([]byte(name))
+	data, err := scs.GetData(key)
+	if err != nil {
+		return err
+	}
+	if err = scs.SetData(key, serializeOffer(offer)); err != nil {
+		return err
+	}
+	if data != nil {
+		return nil
+	}
+	names, err := getOfferedNames(scs)
+	if err != nil {
+		return err
+	}
+	return setOfferedNames(scs, append(names, []byte(strings.ToLower(name))))
+}
+
+// CancelOffer withdraws the offer of the name.
+func CancelOffer(scs *state.ContractState, name string) error {
+	return deleteOffer(scs, []byte(name))
+}
+
+// AcceptName transfers the name on offer to the sender and the price from the
+// sender to the seller.
+func AcceptName(scs *state.ContractState, tx *types.TxBody, sender, seller *state.V, name string) error {
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap == nil {
+		return fmt.Errorf("%s is not created yet", name)
+	}
+	amount := tx.GetAmountBigInt()
+	sender.SubBalance(amount)
+	seller.AddBalance(amount)
+	if err := deleteOffer(scs, []byte(name)); err != nil {
+		return err
+	}
+	return registerOwner(scs, []byte(name), sender.ID(), sender.ID(), nameMap.Expiry)
+}
+
+// ListNameOffers returns the names on offer.
+func ListNameOffers(r AccountStateReader) ([]*types.NameInfo, error) {
+	scs, err := r.GetNameAccountState()
+	if err != nil {
+		return nil, err
+	}
+	names, err := getOfferedNames(scs)
+	if err != nil {
+		return nil, err
+	}
+	var infos []*types.NameInfo
+	for _, name := range names {
+		nameMap := getNameMap(scs, name, true)
+		if nameMap == nil {
+			continue
+		}
+		offer, err := getOffer(scs, name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, &types.NameInfo{
+			Name:        &types.Name{Name: string(name)},
+			Owner:       nameMap.Owner,
+			Destination: nameMap.Destination,
+			Expiry:      nameMap.Expiry,
+			Offer:       offer,
+		})
+	}
+	return infos, nil
+}
+
+func offerKey(name []byte) []byte {
+	return append(append([]byte{}, offerPrefix...), strings.ToLower(string(name))...)
+}
+
+func getOffer(scs *state.ContractState, name []byte) (*types.NameSaleOffer, error) {
+	data, err := scs.GetData(offerKey(name))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return deserializeOffer(data), nil
+}
+
+func deleteOffer(scs *state.ContractState, name []byte) error {
+	key := offerKey(name)
+	data, err := scs.GetData(key)
+	if err != nil || data == nil {
+		return err
+	}
+	if err = scs.DeleteData(key); err != nil {
+		return err
+	}
+	names, err := getOfferedNames(scs)
+	if err != nil {
+		return err
+	}
+	lowerCaseName := []byte(strings.ToLower(string(name)))
+	for i, n := range names {
+		if bytes.Equal(n, lowerCaseName) {
+			names = append(names[:i], names[i+1:]...)
+			break
+		}
+	}
+	return setOfferedNames(scs, names)
+}
+
+func getOfferedNames(scs *state.ContractState) ([][]byte, error) {
+	data, err := scs.GetData(offerListKey)
+	if err != nil {
+		return nil, err
+	}
+	var names [][]byte
+	for offset := 0; offset+types.NameLength <= len(data); offset += types.NameLength {
+		names = append(names, data[offset:offset+types.NameLength])
+	}
+	return names, nil
+}
+
+func setOfferedNames(scs *state.ContractState, names [][]byte) error {
+	if len(names) == 0 {
+		return scs.DeleteData(offerListKey)
+	}
+	return scs.SetData(offerListKey, bytes.Join(names, nil))
+}
+
+// serializeOffer encodes the length of the buyer (1 byte), the buyer and the
+// price.
+func serializeOffer(offer *types.NameSaleOffer) []byte {
+	data := []byte{byte(len(offer.Buyer))}
+	data = append(data, offer.Buyer...)
+	return append(data, offer.Price...)
+}
+
+func deserializeOffer(data []byte) *types.NameSaleOffer {
+	size := int(data[0])
+	offer := &types.NameSaleOffer{Price: data[1+size:]}
+	if size != 0 {
+		offer.Buyer = data[1 : 1+size]
+	}
+	return offer
+}
@@ -0,0 +1,112 @@
+package name
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameExpiryAndOffer(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	name := "AB1234567890"
+	ownerAddr := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	buyerAddr := types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay")
+	otherAddr := types.ToAddress("AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As")
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+	owner, _ := bs.GetAccountStateV(ownerAddr)
+	owner.AddBalance(types.MaxAER)
+	buyer, _ := bs.GetAccountStateV(buyerAddr)
+	buyer.AddBalance(types.MaxAER)
+	other, _ := bs.GetAccountStateV(otherAddr)
+	other.AddBalance(types.MaxAER)
+	receiver, _ := bs.GetAccountStateV([]byte(types.AergoName))
+
+	tx := &types.TxBody{Account: ownerAddr, Recipient: []byte(types.AergoName), Amount: types.NamePrice.Bytes()}
+	tx.Payload = buildCallPayload(types.NameCreate, name)
+	_, err := ExecuteNameTx(bs, scs, tx, owner, receiver, 1)
+	assert.NoError(t, err, "create name")
+	scs = nextBlockContractState(t, bs, scs)
+	info, err := GetNameInfo(&bs.StateDB, name)
+	assert.NoError(t, err, "get name info")
+	assert.Equal(t, uint64(1+NameRegistrationPeriod), info.GetExpiry(), "expiry of created name")
+
+	tx.Payload = buildCallPayload(types.NameRenew, name)
+	events, err := ExecuteNameTx(bs, scs, tx, owner, receiver, 2)
+	assert.NoError(t, err, "renew name")
+	assert.Equal(t, "renew name", events[0].EventName)
+	scs = nextBlockContractState(t, bs, scs)
+	info, _ = GetNameInfo(&bs.StateDB, name)
+	assert.Equal(t, uint64(1+2*NameRegistrationPeriod), info.GetExpiry(), "expiry of renewed name")
+
+	price := new(big.Int).Mul(types.NamePrice, big.NewInt(5))
+	tx.Amount = nil
+	tx.Payload = buildCallPayload(types.NameOffer, name, price.String(), types.EncodeAddress(buyerAddr))
+	_, err = ExecuteNameTx(bs, scs, tx, owner, receiver, 3)
+	assert.NoError(t, err, "offer name")
+	scs = nextBlockContractState(t, bs, scs)
+	offers, err := ListNameOffers(&bs.StateDB)
+	assert.NoError(t, err, "list offers")
+	assert.Len(t, offers, 1)
+	assert.Equal(t, price.Bytes(), offers[0].GetOffer().GetPrice(), "offered price")
+	assert.Equal(t, buyerAddr, types.Address(offers[0].GetOffer().GetBuyer()), "offered buyer")
+
+	tx.Amount = types.NamePrice.Bytes()
+	tx.Payload = buildNamePayload(name, types.NameUpdate, types.EncodeAddress(otherAddr))
+	_, err = ExecuteNameTx(bs, scs, tx, owner, receiver, 4)
+	assert.Error(t, err, "update name on offer")
+
+	accept := &types.TxBody{Account: otherAddr, Recipient: []byte(types.AergoName), Amount: price.Bytes()}
+	accept.Payload = buildCallPayload(types.NameAccept, name)
+	_, err = ExecuteNameTx(bs, scs, accept, other, receiver, 4)
+	assert.Error(t, err, "accept by other than the buyer")
+
+	accept.Account = buyerAddr
+	accept.Amount = types.NamePrice.Bytes()
+	_, err = ExecuteNameTx(bs, scs, accept, buyer, receiver, 4)
+	assert.Error(t, err, "accept with a wrong amount")
+
+	seller, _ := bs.GetAccountStateV(ownerAddr)
+	sellerBalance := new(big.Int).Set(seller.Balance())
+	buyerBalance := new(big.Int).Set(buyer.Balance())
+	accept.Amount = price.Bytes()
+	events, err = ExecuteNameTx(bs, scs, accept, buyer, receiver, 4)
+	assert.NoError(t, err, "accept name")
+	assert.Equal(t, "accept name", events[0].EventName)
+	seller, _ = bs.GetAccountStateV(ownerAddr)
+	assert.Equal(t, new(big.Int).Add(sellerBalance, price), seller.Balance(), "seller balance")
+	assert.Equal(t, new(big.Int).Sub(buyerBalance, price), buyer.Balance(), "buyer balance")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, buyerAddr, types.Address(GetOwner(scs, []byte(name), 2)), "owner after sale")
+	assert.Equal(t, buyerAddr, types.Address(GetAddress(scs, []byte(name), 2)), "destination after sale")
+	offers, _ = ListNameOffers(&bs.StateDB)
+	assert.Len(t, offers, 0)
+	info, _ = GetNameInfo(&bs.StateDB, name)
+	assert.Equal(t, uint64(1+2*NameRegistrationPeriod), info.GetExpiry(), "expiry is kept after sale")
+	assert.Equal(t, buyerAddr, types.Address(GetAddress(scs, []byte(name), 1+2*NameRegistrationPeriod)), "destination at expiry")
+	assert.Nil(t, GetAddress(scs, []byte(name), 2+2*NameRegistrationPeriod), "destination after expiry")
+	assert.Nil(t, GetOwner(scs, []byte(name), 2+2*NameRegistrationPeriod), "owner after expiry")
+	assert.Nil(t, Resolve(bs, []byte(name), 2+2*NameRegistrationPeriod), "resolve after expiry")
+
+	create := &types.TxBody{Account: otherAddr, Recipient: []byte(types.AergoName), Amount: types.NamePrice.Bytes()}
+	create.Payload = buildCallPayload(types.NameCreate, name)
+	_, err = ExecuteNameTx(bs, scs, create, other, receiver, 1+2*NameRegistrationPeriod)
+	assert.Error(t, err, "create name before expiry")
+	_, err = ExecuteNameTx(bs, scs, create, other, receiver, 2+2*NameRegistrationPeriod)
+	assert.NoError(t, err, "create expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, otherAddr, types.Address(GetOwner(scs, []byte(name), 2+2*NameRegistrationPeriod)), "owner of recreated name")
+}
+
+func buildCallPayload(function string, args ...interface{}) []byte {
+	payload, err := json.Marshal(&types.CallInfo{Name: function, Args: args})
+	if err != nil {
+		return nil
+	}
+	return payload
+}
@@ -15,7 +15,7 @@ import (
 	"path/filepath"
 	"sync"
 
-	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
@@ -28,7 +28,7 @@ var (
 	database = &Database{}
 	load     sync.Once
 
-	logger = log.NewLogger("statesql")
+	logger = logctl.NewLogger("statesql")
 
 	queryConn     *SQLiteConn
 	queryConnLock sync.Mutex
@@ -132,6 +132,7 @@ func SaveRecoveryPoint(bs *state.BlockState) error {
 				if err != nil {
 					return err
 				}
+				bs.SqlUpdated = true
 			}
 		}
 	}
@@ -0,0 +1,52 @@
+package contract
+
+import (
+	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/types"
+)
+
+// setContractData stores the key and value pair in the storage of the current
+// contract. Once FeatureStorageQuota is active, the bytes of the storage are
+// counted and limited by the storage quota parameter.
+func setContractData(s *StateSet, key, value []byte) error {
+	ctrState := s.curContract.callState.ctrState
+	if !types.IsFeatureActive(types.FeatureStorageQuota, s.blockHeight) {
+		return ctrState.SetData(key, value)
+	}
+	quota, err := s.getStorageQuota()
+	if err != nil {
+		return err
+	}
+	return ctrState.SetDataCounted(key, value, quota)
+}
+
+// deleteContractData removes the key and value pair from the storage of the
+// current contract, taking its bytes off the storage usage once
+// FeatureStorageQuota is active.
+func deleteContractData(s *StateSet, key []byte) error {
+	ctrState := s.curContract.callState.ctrState
+	if !types.IsFeatureActive(types.FeatureStorageQuota, s.blockHeight) {
+		return ctrState.DeleteData(key)
+	}
+	return ctrState.DeleteDataCounted(key)
+}
+
+// getStorageQuota returns the storage quota of the contracts in bytes, 0 for
+// no quota. It is read once for a tx.
+func (s *StateSet) getStorageQuota() (uint64, error) {
+	if s.storageQuota == nil {
+		scs, err := s.bs.GetSystemAccountState()
+		if err != nil {
+			return 0, err
+		}
+		quota, err := system.GetParam(scs, types.VoteStorageQuota)
+		if err != nil {
+			return 0, err
+		}
+		s.storageQuota = quota
+	}
+	if !s.storageQuota.IsUint64() {
+		return 0, nil
+	}
+	return s.storageQuota.Uint64(), nil
+}
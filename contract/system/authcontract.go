@@ -0,0 +1,83 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// An account designates an authorization contract by SetAuthContract. The
+// txs of the account are then authorized by the check function of the
+// contract instead of the signature of its key. An empty address clears the
+// designation.
+
+var authContractKey = []byte("authcontract")
+
+func authContractKeyOf(account []byte) []byte {
+	return append(append([]byte{}, authContractKey...), account...)
+}
+
+// GetAuthContract returns the authorization contract designated by the
+// account, or nil if its txs are signed by its key.
+func GetAuthContract(scs *state.ContractState, account []byte) ([]byte, error) {
+	data, err := scs.GetData(authContractKeyOf(account))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return data, nil
+}
+
+func validateForSetAuthContract(txBody *types.TxBody, blockNo types.BlockNo,
+	context *SystemContext, ci *types.CallInfo) error {
+	if !types.IsFeatureActive(types.FeatureAuthContract, blockNo) {
+		return types.ErrAuthContractNotActive
+	}
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return types.ErrTxInvalidAmount
+	}
+	// a multisig account is authorized by its signers
+	if context.MultisigCaller != nil || len(ci.Args) != 1 {
+		return types.ErrTxInvalidPayload
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return types.ErrTxInvalidPayload
+	}
+	if encoded == "" {
+		return nil
+	}
+	contract, err := types.DecodeAddress(encoded)
+	if err != nil || len(contract) != types.AddressLength {
+		return types.ErrTxInvalidPayload
+	}
+	context.AuthContract = contract
+	return nil
+}
+
+func settingAuthContract(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	var err error
+	if context.AuthContract == nil {
+		err = scs.DeleteData(authContractKeyOf(sender.ID()))
+	} else {
+		err = scs.SetData(authContractKeyOf(sender.ID()), context.AuthContract)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var contract string
+	if context.AuthContract != nil {
+		contract = types.EncodeAddress(context.AuthContract)
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "setAuthContract",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "contract":"` + contract + `"}`,
+	}, nil
+}
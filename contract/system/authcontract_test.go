@@ -0,0 +1,52 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAuthContract(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	defer types.SetForkSchedule(nil)
+
+	contract := append([]byte{}, sender.ID()...)
+	contract[len(contract)-1]++
+	encoded := types.EncodeAddress(contract)
+
+	tx := &types.TxBody{Account: sender.ID(), Payload: []byte(`{"Name":"v1setAuthContract","Args":["abc"]}`)}
+	assert.Equal(t, types.ErrTxInvalidPayload, types.ValidateSystemTx(tx), "invalid address")
+	tx.Payload = []byte(`{"Name":"v1setAuthContract","Args":["` + encoded + `"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.Equal(t, types.ErrAuthContractNotActive, err, "before the activation")
+
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	tx.Amount = types.StakingMinimum.Bytes()
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidAmount, err, "with an amount")
+	tx.Amount = nil
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "could not set auth contract")
+	if assert.Len(t, events, 1, "auth contract set") {
+		assert.Equal(t, "setAuthContract", events[0].EventName, "event name")
+	}
+	authContract, err := GetAuthContract(scs, sender.ID())
+	assert.NoError(t, err, "could not get auth contract")
+	assert.Equal(t, contract, authContract, "designated contract")
+
+	tx.Payload = []byte(`{"Name":"v1setAuthContract","Args":[""]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "could not clear auth contract")
+	authContract, err = GetAuthContract(scs, sender.ID())
+	assert.NoError(t, err, "could not get auth contract")
+	assert.Nil(t, authContract, "cleared")
+}
@@ -0,0 +1,70 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var baseFeeKey = []byte("basefee")
+
+// IsDynamicFeeEnabled reports whether the fee per byte of the block is
+// adjusted to the fullness of the blocks, either by the config of a private
+// network or by the activation of the feature.
+func IsDynamicFeeEnabled(blockNo types.BlockNo) bool {
+	return IsDynamicFeeScheduled(types.GetForkSchedule(), blockNo)
+}
+
+// IsDynamicFeeScheduled is IsDynamicFeeEnabled by the schedule given, which
+// is not yet the one of the chain.
+func IsDynamicFeeScheduled(schedule types.ForkSchedule, blockNo types.BlockNo) bool {
+	return fee.IsDynamicFeeEnabled() || schedule.IsFeatureActive(types.FeatureDynamicFee, blockNo)
+}
+
+// GetBaseFee returns the fee per byte adjusted to the fullness of the blocks.
+// It is never below the voted fee per byte, which is the floor of the
+// adjustment.
+func GetBaseFee(scs *state.ContractState) (*big.Int, error) {
+	floor, err := GetParam(scs, types.VoteAerPerByte)
+	if err != nil {
+		return nil, err
+	}
+	data, err := scs.GetData(baseFeeKey)
+	if err != nil {
+		return nil, err
+	}
+	baseFee := new(big.Int).SetBytes(data)
+	if baseFee.Cmp(floor) < 0 {
+		return floor, nil
+	}
+	return baseFee, nil
+}
+
+// AdjustBaseFee adjusts the fee per byte of the next block to the size of
+// the txs in the block, targeting a half of the maximum block size. It
+// reports whether the fee changed.
+func AdjustBaseFee(scs *state.ContractState, blockSize uint64) (bool, error) {
+	floor, err := GetParam(scs, types.VoteAerPerByte)
+	if err != nil {
+		return false, err
+	}
+	maxBlockSize, err := GetParam(scs, types.VoteMaxBlockSize)
+	if err != nil {
+		return false, err
+	}
+	baseFee, err := GetBaseFee(scs)
+	if err != nil {
+		return false, err
+	}
+	next := fee.NextAerPerByte(baseFee, floor, blockSize, maxBlockSize.Uint64()/2)
+	if next.Cmp(baseFee) == 0 {
+		return false, nil
+	}
+	return true, scs.SetData(baseFeeKey, next.Bytes())
+}
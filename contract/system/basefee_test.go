@@ -0,0 +1,50 @@
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjustBaseFee(t *testing.T) {
+	scs, _, _ := initTest(t)
+	defer deinitTest()
+
+	floor := fee.DefaultAerPerByte()
+	baseFee, err := GetBaseFee(scs)
+	assert.NoError(t, err, "could not get base fee")
+	assert.Equal(t, floor, baseFee, "base fee starts at the voted fee per byte")
+
+	target := uint64(types.DefaultMaxBlockSize / 2)
+	adjusted, err := AdjustBaseFee(scs, target)
+	assert.NoError(t, err, "could not adjust base fee")
+	assert.False(t, adjusted, "block at the target size")
+
+	adjusted, err = AdjustBaseFee(scs, 2*target)
+	assert.NoError(t, err, "could not adjust base fee")
+	assert.True(t, adjusted, "full block")
+	raised := new(big.Int).Add(floor, new(big.Int).Div(floor, big.NewInt(8)))
+	baseFee, err = GetBaseFee(scs)
+	assert.NoError(t, err, "could not get base fee")
+	assert.Equal(t, raised, baseFee, "full block raises the fee by 1/8")
+
+	adjusted, err = AdjustBaseFee(scs, target/2)
+	assert.NoError(t, err, "could not adjust base fee")
+	assert.True(t, adjusted, "block under the target size")
+	baseFee, err = GetBaseFee(scs)
+	assert.NoError(t, err, "could not get base fee")
+	lowered := new(big.Int).Sub(raised, new(big.Int).Div(raised, big.NewInt(16)))
+	assert.Equal(t, lowered, baseFee, "half full block lowers the fee by 1/16")
+
+	for i := 0; i < 10; i++ {
+		_, err = AdjustBaseFee(scs, 0)
+		assert.NoError(t, err, "could not adjust base fee")
+	}
+	baseFee, err = GetBaseFee(scs)
+	assert.NoError(t, err, "could not get base fee")
+	assert.Equal(t, floor, baseFee, "fee never falls below the floor")
+
+}
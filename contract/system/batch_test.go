@@ -0,0 +1,85 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildBatchPayload(ops ...*types.BatchOp) []byte {
+	var ci types.CallInfo
+	ci.Name = types.Batch
+	for _, op := range ops {
+		ci.Args = append(ci.Args, op)
+	}
+	payload, _ := json.Marshal(ci)
+	return payload
+}
+
+func TestBatch(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	sender.AddBalance(types.MaxAER)
+
+	var vote types.CallInfo
+	json.Unmarshal(buildVotingPayloadEx(1, types.VoteBP), &vote)
+	stake := &types.BatchOp{Name: types.Stake, Amount: types.StakingMinimum.String()}
+	voteBP := &types.BatchOp{Name: types.VoteBP, Args: vote.Args}
+	tooMuch := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	unstake := &types.BatchOp{Name: types.Unstake, Amount: tooMuch.String()}
+
+	tx := &types.TxBody{Account: sender.ID(), Payload: buildBatchPayload(stake, voteBP)}
+	assert.Equal(t, types.ErrTxInvalidAmount, types.ValidateSystemTx(tx), "amount of the tx differs from the operations")
+	tx.Amount = types.StakingMinimum.Bytes()
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err := ExecuteSystemTx(scs, &types.TxBody{Account: sender.ID(), Amount: tx.Amount,
+		Payload: buildBatchPayload(voteBP, stake)}, sender, receiver, 0)
+	assert.Equal(t, types.ErrMustStakeBeforeVote, err, "vote before stake")
+
+	balance := new(big.Int).Set(sender.Balance())
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "batch failed")
+	assert.Len(t, events, 2, "events of the operations")
+	assert.Equal(t, "stake", events[0].EventName)
+	assert.Equal(t, int32(0), events[0].EventIdx)
+	assert.Equal(t, "voteBP", events[1].EventName)
+	assert.Equal(t, int32(1), events[1].EventIdx)
+	assert.Equal(t, new(big.Int).Sub(balance, types.StakingMinimum), sender.Balance(), "staked by batch")
+	vinfo, err := GetVote(scs, sender.ID(), []byte(types.VoteBP[2:]))
+	assert.NoError(t, err, "could not get vote")
+	assert.Equal(t, types.StakingMinimum.Bytes(), vinfo.Amount, "voted by batch")
+
+	// the unstake exceeds the staking, so that the preceding stake fails with it
+	tx = &types.TxBody{Account: sender.ID(), Amount: new(big.Int).Add(types.StakingMinimum, tooMuch).Bytes(),
+		Payload: buildBatchPayload(stake, unstake)}
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	staked, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	balance, systemBalance := sender.Balance(), receiver.Balance()
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, StakingDelay)
+	assert.Equal(t, types.ErrExceedAmount, err, "unstake more than staked in the same batch")
+
+	// the failed batch leaves nothing of the preceding stake
+	after, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, staked.Amount, after.Amount, "staking after the failed batch")
+	assert.Equal(t, staked.When, after.When, "staking time after the failed batch")
+	vinfo, err = GetVote(scs, sender.ID(), []byte(types.VoteBP[2:]))
+	assert.NoError(t, err, "could not get vote")
+	assert.Equal(t, types.StakingMinimum.Bytes(), vinfo.Amount, "votes after the failed batch")
+	assert.Equal(t, balance, sender.Balance(), "balance after the failed batch")
+	assert.Equal(t, systemBalance, receiver.Balance(), "system balance after the failed batch")
+
+	nested := &types.TxBody{Account: sender.ID(), Payload: []byte(`{"Name":"v1batch","Args":[{"Name":"v1batch","Args":[]}]}`)}
+	assert.Error(t, types.ValidateSystemTx(nested), "nested batch")
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Account: sender.ID(), Payload: buildBatchPayload()}, sender, receiver, 0)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "empty batch")
+}
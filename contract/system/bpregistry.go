@@ -0,0 +1,234 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+)
+
+var bpRegistryKey = []byte("bpreg")
+var bpRegistryListKey = []byte("bpreglist")
+
+// BPCandidate is the metadata which a BP candidate publishes for the voters.
+// The candidate is registered by a staking account, which alone can update or
+// unregister it.
+type BPCandidate struct {
+	PeerID     []byte
+	Owner      []byte
+	Name       string
+	Website    string
+	Commission uint32
+}
+
+func registeringBP(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	candidate := context.Candidate
+	existing, err := getBPCandidate(scs, candidate.PeerID)
+	if err != nil {
+		return nil, err
+	}
+	if err = setBPCandidate(scs, candidate); err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		ids, err := getRegisteredBPs(scs)
+		if err != nil {
+			return nil, err
+		}
+		if err = setRegisteredBPs(scs, append(ids, candidate.PeerID)); err != nil {
+			return nil, err
+		}
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "registerBP",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "peer":"` + base58.Encode(candidate.PeerID) +
+			`", "name":` + strconv.Quote(candidate.Name) +
+			`, "commission":` + strconv.FormatUint(uint64(candidate.Commission), 10) + `}`,
+	}, nil
+}
+
+func unregisteringBP(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	candidate := context.Candidate
+	if err := scs.DeleteData(bpCandidateKey(candidate.PeerID)); err != nil {
+		return nil, err
+	}
+	ids, err := getRegisteredBPs(scs)
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range ids {
+		if bytes.Equal(id, candidate.PeerID) {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	if err = setRegisteredBPs(scs, ids); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "unregisterBP",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "peer":"` + base58.Encode(candidate.PeerID) + `"}`,
+	}, nil
+}
+
+func validateForRegisterBP(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	ci *types.CallInfo) (*BPCandidate, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	if len(ci.Args) != 4 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, types.ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	peerID, err := base58.Decode(args[0])
+	if err != nil || len(peerID) != PeerIDLength {
+		return nil, types.ErrTxInvalidPayload
+	}
+	commission, err := strconv.ParseUint(args[3], 10, 32)
+	if err != nil || commission > types.MaxBPCommission {
+		return nil, types.ErrTxInvalidPayload
+	}
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return nil, types.ErrMustStakeBeforeRegister
+	}
+	existing, err := getBPCandidate(scs, peerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && !bytes.Equal(existing.Owner, account) {
+		return nil, types.ErrNotBPOwner
+	}
+	return &BPCandidate{
+		PeerID:     peerID,
+		Owner:      account,
+		Name:       args[1],
+		Website:    args[2],
+		Commission: uint32(commission),
+	}, nil
+}
+
+func validateForUnregisterBP(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	ci *types.CallInfo) (*BPCandidate, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	if len(ci.Args) != 1 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, types.ErrTxInvalidPayload
+	}
+	peerID, err := base58.Decode(encoded)
+	if err != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	candidate, err := getBPCandidate(scs, peerID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, types.ErrBPNotRegistered
+	}
+	if !bytes.Equal(candidate.Owner, account) {
+		return nil, types.ErrNotBPOwner
+	}
+	return candidate, nil
+}
+
+// GetBPCandidate returns the registered BP candidate of the peer ID or nil if
+// it is not registered.
+func GetBPCandidate(scs *state.ContractState, peerID []byte) (*BPCandidate, error) {
+	return getBPCandidate(scs, peerID)
+}
+
+// GetBPCandidates returns the registered BP candidates in the order of the
+// registration.
+func GetBPCandidates(scs *state.ContractState) ([]*BPCandidate, error) {
+	ids, err := getRegisteredBPs(scs)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*BPCandidate
+	for _, id := range ids {
+		candidate, err := getBPCandidate(scs, id)
+		if err != nil {
+			return nil, err
+		}
+		if candidate != nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, nil
+}
+
+func bpCandidateKey(peerID []byte) []byte {
+	return append(append([]byte{}, bpRegistryKey...), peerID...)
+}
+
+func getBPCandidate(scs *state.ContractState, peerID []byte) (*BPCandidate, error) {
+	data, err := scs.GetData(bpCandidateKey(peerID))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	var candidate BPCandidate
+	if err = json.Unmarshal(data, &candidate); err != nil {
+		return nil, err
+	}
+	return &candidate, nil
+}
+
+func setBPCandidate(scs *state.ContractState, candidate *BPCandidate) error {
+	data, err := json.Marshal(candidate)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(bpCandidateKey(candidate.PeerID), data)
+}
+
+func getRegisteredBPs(scs *state.ContractState) ([][]byte, error) {
+	data, err := scs.GetData(bpRegistryListKey)
+	if err != nil {
+		return nil, err
+	}
+	var ids [][]byte
+	for offset := 0; offset+PeerIDLength <= len(data); offset += PeerIDLength {
+		ids = append(ids, data[offset:offset+PeerIDLength])
+	}
+	return ids, nil
+}
+
+func setRegisteredBPs(scs *state.ContractState, ids [][]byte) error {
+	if len(ids) == 0 {
+		return scs.DeleteData(bpRegistryListKey)
+	}
+	return scs.SetData(bpRegistryListKey, bytes.Join(ids, nil))
+}
@@ -0,0 +1,83 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBPRegistry(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	_, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	pid, _ := peer.IDFromPublicKey(pub)
+	peerID := peer.IDB58Encode(pid)
+
+	tx := &types.TxBody{Account: sender.ID(), Payload: []byte(`{"Name":"v1registerBP","Args":["` + peerID + `","bp1","https://bp1.io","101"]}`)}
+	assert.Equal(t, types.ErrTxInvalidPayload, types.ValidateSystemTx(tx), "commission over 100%")
+	tx.Payload = []byte(`{"Name":"v1registerBP","Args":["` + peerID + `","bp1","https://bp1.io","5"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.Equal(t, types.ErrMustStakeBeforeRegister, err, "register without staking")
+
+	sender.AddBalance(types.StakingMinimum)
+	stake := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stake, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "register failed")
+	assert.Equal(t, "registerBP", events[0].EventName, "event name")
+
+	candidate, err := GetBPCandidate(scs, []byte(pid))
+	assert.NoError(t, err, "could not get candidate")
+	assert.Equal(t, "bp1", candidate.Name)
+	assert.Equal(t, "https://bp1.io", candidate.Website)
+	assert.Equal(t, uint32(5), candidate.Commission)
+	assert.Equal(t, sender.ID(), candidate.Owner)
+
+	tx.Payload = []byte(`{"Name":"v1registerBP","Args":["` + peerID + `","bp1","https://bp1.io","10"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "update failed")
+	candidates, err := GetBPCandidates(scs)
+	assert.NoError(t, err, "could not get candidates")
+	assert.Len(t, candidates, 1, "updated candidate is listed once")
+	assert.Equal(t, uint32(10), candidates[0].Commission, "updated commission")
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+	other.AddBalance(types.StakingMinimum)
+	stake.Account = other.ID()
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Account = other.ID()
+	_, err = ExecuteSystemTx(scs, tx, other, receiver, 3)
+	assert.Equal(t, types.ErrNotBPOwner, err, "register the candidate of another account")
+	unregister := &types.TxBody{Account: other.ID(), Payload: []byte(`{"Name":"v1unregisterBP","Args":["` + peerID + `"]}`)}
+	assert.NoError(t, types.ValidateSystemTx(unregister), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, unregister, other, receiver, 3)
+	assert.Equal(t, types.ErrNotBPOwner, err, "unregister the candidate of another account")
+
+	unregister.Account = sender.ID()
+	events, err = ExecuteSystemTx(scs, unregister, sender, receiver, 3)
+	assert.NoError(t, err, "unregister failed")
+	assert.Equal(t, "unregisterBP", events[0].EventName, "event name")
+	candidate, err = GetBPCandidate(scs, []byte(pid))
+	assert.NoError(t, err, "could not get candidate")
+	assert.Nil(t, candidate, "unregistered candidate")
+	candidates, err = GetBPCandidates(scs)
+	assert.NoError(t, err, "could not get candidates")
+	assert.Len(t, candidates, 0)
+	_, err = ExecuteSystemTx(scs, unregister, sender, receiver, 4)
+	assert.Equal(t, types.ErrBPNotRegistered, err, "unregister twice")
+}
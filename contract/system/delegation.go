@@ -0,0 +1,311 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+)
+
+var delegationKey = []byte("delegation")
+var delegatorsKey = []byte("delegators")
+
+// Delegation is a part of the staking of an account which is voted for a BP
+// candidate on behalf of the account.
+type Delegation struct {
+	Candidate []byte
+	Amount    []byte
+	When      uint64
+}
+
+// GetAmountBigInt returns the delegated amount.
+func (d *Delegation) GetAmountBigInt() *big.Int {
+	if d == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(d.Amount)
+}
+
+func delegating(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	delegation := context.Delegation
+	amount := txBody.GetAmountBigInt()
+	candidate, err := base58.Decode(context.Call.Args[0].(string))
+	if err != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	// the voting power of the delegated amount follows the candidate
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = voteResult.AddVote(&types.Vote{Candidate: candidate, Amount: amount.Bytes()}); err != nil {
+		return nil, err
+	}
+	if err = voteResult.Sync(scs); err != nil {
+		return nil, err
+	}
+	if len(delegation.Amount) == 0 {
+		if err = addDelegator(scs, candidate, sender.ID()); err != nil {
+			return nil, err
+		}
+	}
+	delegation.Candidate = candidate
+	delegation.Amount = new(big.Int).Add(delegation.GetAmountBigInt(), amount).Bytes()
+	delegation.When = blockNo
+	if err = setDelegation(scs, sender.ID(), delegation); err != nil {
+		return nil, err
+	}
+	// the own votes of the account can not use the delegated amount
+	if err = refreshAllVote(txBody, scs, context); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "delegate",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "to":"` + base58.Encode(candidate) +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func undelegating(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	delegation := context.Delegation
+	amount := txBody.GetAmountBigInt()
+	if amount.Sign() == 0 {
+		// undelegate all
+		amount = delegation.GetAmountBigInt()
+	}
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = voteResult.SubVote(&types.Vote{Candidate: delegation.Candidate, Amount: amount.Bytes()}); err != nil {
+		return nil, err
+	}
+	if err = voteResult.Sync(scs); err != nil {
+		return nil, err
+	}
+	delegation.Amount = new(big.Int).Sub(delegation.GetAmountBigInt(), amount).Bytes()
+	delegation.When = blockNo
+	if len(delegation.Amount) == 0 {
+		if err = subDelegator(scs, delegation.Candidate, sender.ID()); err != nil {
+			return nil, err
+		}
+	}
+	if err = setDelegation(scs, sender.ID(), delegation); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "undelegate",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "from":"` + base58.Encode(delegation.Candidate) +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func validateForDelegation(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	blockNo uint64, ci *types.CallInfo) (*types.Staking, *Delegation, error) {
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return nil, nil, types.ErrMustStakeBeforeDelegate
+	}
+	if len(ci.Args) != 1 {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	candidate, err := base58.Decode(encoded)
+	if err != nil || len(candidate) != PeerIDLength {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	delegation, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(delegation.Amount) != 0 {
+		if !bytes.Equal(delegation.Candidate, candidate) {
+			return nil, nil, types.ErrDelegatedToOther
+		}
+		if delegation.When+StakingDelay > blockNo {
+			return nil, nil, types.ErrLessTimeHasPassed
+		}
+	}
+	amount := txBody.GetAmountBigInt()
+	if amount.Sign() == 0 {
+		return nil, nil, types.ErrTooSmallAmount
+	}
+	toBe := new(big.Int).Add(delegation.GetAmountBigInt(), amount)
+	if toBe.Cmp(staked.GetAmountBigInt()) > 0 {
+		return nil, nil, types.ErrExceedAmount
+	}
+	return staked, delegation, nil
+}
+
+func validateForUndelegation(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	blockNo uint64) (*Delegation, error) {
+	delegation, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if delegation.GetAmountBigInt().Sign() == 0 {
+		return nil, types.ErrMustDelegateBeforeUndelegate
+	}
+	if delegation.When+StakingDelay > blockNo {
+		return nil, types.ErrLessTimeHasPassed
+	}
+	if txBody.GetAmountBigInt().Cmp(delegation.GetAmountBigInt()) > 0 {
+		return nil, types.ErrExceedAmount
+	}
+	return delegation, nil
+}
+
+// votingPower returns the part of the staking which the account votes with
+// by itself.
+func votingPower(scs *state.ContractState, account []byte, staked *types.Staking) (*big.Int, error) {
+	delegation, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Sub(staked.GetAmountBigInt(), delegation.GetAmountBigInt()), nil
+}
+
+func setDelegation(scs *state.ContractState, who []byte, delegation *Delegation) error {
+	key := append(append([]byte{}, delegationKey...), who...)
+	if len(delegation.Amount) == 0 {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, serializeDelegation(delegation))
+}
+
+func getDelegation(scs *state.ContractState, who []byte) (*Delegation, error) {
+	key := append(append([]byte{}, delegationKey...), who...)
+	data, err := scs.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 0 {
+		return deserializeDelegation(data), nil
+	}
+	return &Delegation{}, nil
+}
+
+// GetDelegation returns the delegation of the account address.
+func GetDelegation(scs *state.ContractState, address []byte) (*Delegation, error) {
+	if address != nil {
+		return getDelegation(scs, address)
+	}
+	return nil, errors.New("invalid argument: address should not be nil")
+}
+
+// GetDelegators returns the addresses of the accounts delegating to the
+// candidate.
+func GetDelegators(scs *state.ContractState, candidate []byte) ([][]byte, error) {
+	data, err := scs.GetData(append(append([]byte{}, delegatorsKey...), candidate...))
+	if err != nil {
+		return nil, err
+	}
+	var delegators [][]byte
+	for offset := 0; offset+types.AddressLength <= len(data); offset += types.AddressLength {
+		delegators = append(delegators, data[offset:offset+types.AddressLength])
+	}
+	return delegators, nil
+}
+
+func setDelegators(scs *state.ContractState, candidate []byte, delegators [][]byte) error {
+	key := append(append([]byte{}, delegatorsKey...), candidate...)
+	if len(delegators) == 0 {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, bytes.Join(delegators, nil))
+}
+
+func addDelegator(scs *state.ContractState, candidate, delegator []byte) error {
+	delegators, err := GetDelegators(scs, candidate)
+	if err != nil {
+		return err
+	}
+	return setDelegators(scs, candidate, append(delegators, delegator))
+}
+
+func subDelegator(scs *state.ContractState, candidate, delegator []byte) error {
+	delegators, err := GetDelegators(scs, candidate)
+	if err != nil {
+		return err
+	}
+	for i, d := range delegators {
+		if bytes.Equal(d, delegator) {
+			delegators = append(delegators[:i], delegators[i+1:]...)
+			break
+		}
+	}
+	return setDelegators(scs, candidate, delegators)
+}
+
+// SplitReward divides the reward of a BP candidate between its delegators
+// in proportion of their delegations to the votes of the candidate. The
+// part which is not assigned to a delegator is returned as the share of the
+// candidate itself.
+func SplitReward(scs *state.ContractState, candidate []byte, reward *big.Int) (map[string]*big.Int, *big.Int, error) {
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	votes := voteResult.rmap[base58.Encode(candidate)]
+	rest := new(big.Int).Set(reward)
+	if votes == nil || votes.Sign() == 0 {
+		return map[string]*big.Int{}, rest, nil
+	}
+	delegators, err := GetDelegators(scs, candidate)
+	if err != nil {
+		return nil, nil, err
+	}
+	shares := make(map[string]*big.Int, len(delegators))
+	for _, delegator := range delegators {
+		delegation, err := getDelegation(scs, delegator)
+		if err != nil {
+			return nil, nil, err
+		}
+		share := new(big.Int).Mul(reward, delegation.GetAmountBigInt())
+		share.Div(share, votes)
+		shares[types.EncodeAddress(delegator)] = share
+		rest.Sub(rest, share)
+	}
+	return shares, rest, nil
+}
+
+func serializeDelegation(d *Delegation) []byte {
+	var ret []byte
+	when := make([]byte, 8)
+	binary.LittleEndian.PutUint64(when, d.When)
+	ret = append(ret, when...)
+	ret = append(ret, d.Candidate...)
+	ret = append(ret, d.Amount...)
+	return ret
+}
+
+func deserializeDelegation(data []byte) *Delegation {
+	when := binary.LittleEndian.Uint64(data[:8])
+	candidate := data[8 : 8+PeerIDLength]
+	amount := data[8+PeerIDLength:]
+	return &Delegation{Candidate: candidate, Amount: amount, When: when}
+}
@@ -0,0 +1,145 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-crypto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCandidate(t *testing.T) string {
+	_, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	pid, err := peer.IDFromPublicKey(pub)
+	assert.NoError(t, err, "could not generate peer id")
+	return base58.Encode([]byte(pid))
+}
+
+func TestDelegateUndelegate(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	stakingAmount := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(stakingAmount)
+	tx := &types.TxBody{
+		Account: sender.ID(),
+		Amount:  stakingAmount.Bytes(),
+		Payload: []byte(`{"Name":"v1stake"}`),
+	}
+	candidate := newTestCandidate(t)
+	delegate := []byte(`{"Name":"v1delegate","Args":["` + candidate + `"]}`)
+
+	tx.Payload = delegate
+	_, err := ValidateSystemTx(sender.ID(), tx, sender, scs, 0)
+	assert.Equal(t, types.ErrMustStakeBeforeDelegate, err, "delegate before staking")
+
+	tx.Payload = []byte(`{"Name":"v1stake"}`)
+	context, err := ValidateSystemTx(sender.ID(), tx, sender, scs, 0)
+	assert.NoError(t, err, "staking validation")
+	_, err = staking(tx, sender, receiver, scs, 0, context)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Payload = delegate
+	tx.Amount = new(big.Int).Add(stakingAmount, big.NewInt(1)).Bytes()
+	_, err = ValidateSystemTx(sender.ID(), tx, sender, scs, 1)
+	assert.Equal(t, types.ErrExceedAmount, err, "delegate more than staking")
+
+	tx.Amount = types.StakingMinimum.Bytes()
+	context, err = ValidateSystemTx(sender.ID(), tx, sender, scs, 1)
+	assert.NoError(t, err, "delegation validation")
+	event, err := delegating(tx, sender, receiver, scs, 1, context)
+	assert.NoError(t, err, "delegation failed")
+	assert.Equal(t, "delegate", event.EventName, "event name")
+
+	delegation, err := GetDelegation(scs, sender.ID())
+	assert.NoError(t, err, "get delegation")
+	assert.Equal(t, types.StakingMinimum, delegation.GetAmountBigInt(), "delegated amount")
+	delegators, err := GetDelegators(scs, delegation.Candidate)
+	assert.NoError(t, err, "get delegators")
+	assert.Equal(t, [][]byte{sender.ID()}, delegators, "delegators of the candidate")
+
+	result, err := getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err, "get vote result")
+	assert.Equal(t, candidate, base58.Encode(result.GetVotes()[0].Candidate), "voted candidate")
+	assert.Equal(t, types.StakingMinimum, result.GetVotes()[0].GetAmountBigInt(), "voted amount")
+
+	tx.Payload = []byte(`{"Name":"v1delegate","Args":["` + newTestCandidate(t) + `"]}`)
+	_, err = ValidateSystemTx(sender.ID(), tx, sender, scs, StakingDelay+1)
+	assert.Equal(t, types.ErrDelegatedToOther, err, "delegate to another candidate")
+
+	tx.Payload = []byte(`{"Name":"v1unstake"}`)
+	tx.Amount = stakingAmount.Bytes()
+	_, err = ValidateSystemTx(sender.ID(), tx, sender, scs, StakingDelay+1)
+	assert.Equal(t, types.ErrDelegatedStaking, err, "unstake delegated staking")
+
+	tx.Payload = []byte(`{"Name":"v1undelegate"}`)
+	tx.Amount = nil
+	_, err = ValidateSystemTx(sender.ID(), tx, sender, scs, StakingDelay)
+	assert.Equal(t, types.ErrLessTimeHasPassed, err, "undelegate too early")
+	context, err = ValidateSystemTx(sender.ID(), tx, sender, scs, StakingDelay+1)
+	assert.NoError(t, err, "undelegation validation")
+	event, err = undelegating(tx, sender, receiver, scs, StakingDelay+1, context)
+	assert.NoError(t, err, "undelegation failed")
+	assert.Equal(t, "undelegate", event.EventName, "event name")
+
+	delegation, err = GetDelegation(scs, sender.ID())
+	assert.NoError(t, err, "get delegation")
+	assert.Equal(t, 0, delegation.GetAmountBigInt().Sign(), "delegation should be removed")
+	delegators, err = GetDelegators(scs, context.Delegation.Candidate)
+	assert.NoError(t, err, "get delegators")
+	assert.Empty(t, delegators, "delegators of the candidate")
+
+	_, err = ValidateSystemTx(sender.ID(), tx, sender, scs, StakingDelay+2)
+	assert.Equal(t, types.ErrMustDelegateBeforeUndelegate, err, "undelegate without delegation")
+}
+
+func TestVotingPowerExcludesDelegation(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	stakingAmount := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	sender.AddBalance(stakingAmount)
+	tx := &types.TxBody{
+		Account: sender.ID(),
+		Amount:  stakingAmount.Bytes(),
+		Payload: []byte(`{"Name":"v1stake"}`),
+	}
+	context, err := ValidateSystemTx(sender.ID(), tx, sender, scs, 0)
+	assert.NoError(t, err, "staking validation")
+	_, err = staking(tx, sender, receiver, scs, 0, context)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Payload = buildVotingPayload(1)
+	context, err = ValidateSystemTx(sender.ID(), tx, sender, scs, VotingDelay)
+	assert.NoError(t, err, "voting validation")
+	_, err = voting(tx, sender, receiver, scs, VotingDelay, context)
+	assert.NoError(t, err, "voting failed")
+
+	candidate := newTestCandidate(t)
+	tx.Payload = []byte(`{"Name":"v1delegate","Args":["` + candidate + `"]}`)
+	tx.Amount = types.StakingMinimum.Bytes()
+	context, err = ValidateSystemTx(sender.ID(), tx, sender, scs, VotingDelay+1)
+	assert.NoError(t, err, "delegation validation")
+	_, err = delegating(tx, sender, receiver, scs, VotingDelay+1, context)
+	assert.NoError(t, err, "delegation failed")
+
+	vote, err := GetVote(scs, sender.ID(), defaultVoteKey)
+	assert.NoError(t, err, "get vote")
+	power := new(big.Int).Sub(stakingAmount, types.StakingMinimum)
+	assert.Equal(t, power.Bytes(), vote.Amount, "own vote should exclude the delegation")
+
+	reward := big.NewInt(300)
+	candidateID, _ := base58.Decode(candidate)
+	shares, rest, err := SplitReward(scs, candidateID, reward)
+	assert.NoError(t, err, "split reward")
+	assert.Equal(t, reward, shares[types.EncodeAddress(sender.ID())], "share of the only delegator")
+	assert.Equal(t, 0, rest.Sign(), "rest of the reward")
+}
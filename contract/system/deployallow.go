@@ -0,0 +1,128 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"strings"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// The deployment of contracts is permissioned if the genesis lists the
+// accounts allowed to deploy. The stakers change the list by the proposals
+// whose IDs are AllowDeployPrefix or DenyDeployPrefix followed by the address
+// of the account. Such a proposal takes effect when it is finalized with
+// DeployApproval as the winner.
+const (
+	AllowDeployPrefix = "allowdeploy-"
+	DenyDeployPrefix  = "denydeploy-"
+	DeployApproval    = "yes"
+)
+
+var deployPermissionKey = []byte("deploypermission")
+var deployAllowKey = []byte("deployallow")
+
+func deployAllowKeyOf(account []byte) []byte {
+	return append(append([]byte{}, deployAllowKey...), account...)
+}
+
+// InitDeployAllowList makes the deployment permissioned and allows the
+// accounts to deploy.
+func InitDeployAllowList(scs *state.ContractState, accounts []types.Address) error {
+	if err := scs.SetData(deployPermissionKey, []byte{1}); err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if err := scs.SetData(deployAllowKeyOf(account), []byte{1}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDeployPermissioned reports whether only the allowed accounts deploy.
+func IsDeployPermissioned(scs *state.ContractState) (bool, error) {
+	data, err := scs.GetData(deployPermissionKey)
+	if err != nil {
+		return false, err
+	}
+	return len(data) != 0, nil
+}
+
+// IsDeployAllowed reports whether the account may deploy contracts.
+func IsDeployAllowed(scs *state.ContractState, account []byte) (bool, error) {
+	permissioned, err := IsDeployPermissioned(scs)
+	if err != nil || !permissioned {
+		return !permissioned, err
+	}
+	data, err := scs.GetData(deployAllowKeyOf(account))
+	if err != nil {
+		return false, err
+	}
+	return len(data) != 0, nil
+}
+
+// deployProposalAccount returns the account of a proposal changing the
+// deploy allow list and whether it is allowed or denied. The account is nil
+// for the other proposals.
+func deployProposalAccount(id string) ([]byte, bool, error) {
+	var encoded string
+	var allow bool
+	switch {
+	case strings.HasPrefix(id, AllowDeployPrefix):
+		encoded, allow = id[len(AllowDeployPrefix):], true
+	case strings.HasPrefix(id, DenyDeployPrefix):
+		encoded = id[len(DenyDeployPrefix):]
+	default:
+		return nil, false, nil
+	}
+	account, err := types.DecodeAddress(encoded)
+	if err != nil || len(account) != types.AddressLength {
+		return nil, false, types.ErrTxInvalidPayload
+	}
+	return account, allow, nil
+}
+
+// validateDeployProposal checks that a proposal changing the deploy allow
+// list names a valid account and can be approved.
+func validateDeployProposal(proposal *Proposal) error {
+	account, _, err := deployProposalAccount(proposal.ID)
+	if err != nil || account == nil {
+		return err
+	}
+	if !proposal.hasOption(DeployApproval) {
+		return types.ErrTxInvalidPayload
+	}
+	return nil
+}
+
+// applyDeployProposal changes the deploy allow list by the finalized
+// proposal. It returns the event of the change, or nil if the proposal does
+// not change the list.
+func applyDeployProposal(scs *state.ContractState, proposal *Proposal) (*types.Event, error) {
+	account, allow, err := deployProposalAccount(proposal.ID)
+	if err != nil || account == nil || proposal.Winner != DeployApproval {
+		// a malformed ID is rejected at the proposing
+		return nil, nil
+	}
+	name := "denyDeploy"
+	if allow {
+		name = "allowDeploy"
+		err = scs.SetData(deployAllowKeyOf(account), []byte{1})
+	} else {
+		err = scs.DeleteData(deployAllowKeyOf(account))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: types.AddressPadding([]byte(types.AergoSystem)),
+		EventIdx:        0,
+		EventName:       name,
+		JsonArgs: `{"account":"` + types.EncodeAddress(account) +
+			`", "id":"` + proposal.ID + `"}`,
+	}, nil
+}
@@ -0,0 +1,80 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeployAllowList(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+
+	allowed, err := IsDeployAllowed(scs, otherID)
+	assert.NoError(t, err, "could not check deployer")
+	assert.True(t, allowed, "anyone deploys unless permissioned")
+
+	assert.NoError(t, InitDeployAllowList(scs, []types.Address{sender.ID()}), "could not init allow list")
+	allowed, err = IsDeployAllowed(scs, sender.ID())
+	assert.NoError(t, err, "could not check deployer")
+	assert.True(t, allowed, "listed in the genesis")
+	allowed, err = IsDeployAllowed(scs, otherID)
+	assert.NoError(t, err, "could not check deployer")
+	assert.False(t, allowed, "not listed")
+
+	sender.AddBalance(types.StakingMinimum)
+	stakeTx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stakeTx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	hash := base58.Encode(make([]byte, types.HashIDLength))
+	id := AllowDeployPrefix + types.EncodeAddress(otherID)
+	tx := &types.TxBody{Account: sender.ID(), Payload: []byte(`{"Name":"v1propose","Args":["` +
+		AllowDeployPrefix + `abc","` + hash + `","3600","yes","no"]}`)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "invalid address")
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["` + id + `","` + hash + `","3600","agree","disagree"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "no option to approve")
+
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["` + id + `","` + hash + `","3600","yes","no"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "propose failed")
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["` + id + `","yes"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "vote failed")
+
+	_, events, err := FinalizeProposals(scs, 1+MinProposalPeriod)
+	assert.NoError(t, err, "could not finalize proposals")
+	if assert.Len(t, events, 1, "allow list changed") {
+		assert.Equal(t, "allowDeploy", events[0].EventName, "event name")
+	}
+	allowed, err = IsDeployAllowed(scs, otherID)
+	assert.NoError(t, err, "could not check deployer")
+	assert.True(t, allowed, "approved by the proposal")
+
+	id = DenyDeployPrefix + types.EncodeAddress(otherID)
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["` + id + `","` + hash + `","3600","yes","no"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2+MinProposalPeriod)
+	assert.NoError(t, err, "propose failed")
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["` + id + `","no"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3+MinProposalPeriod)
+	assert.NoError(t, err, "vote failed")
+	_, events, err = FinalizeProposals(scs, 2+2*MinProposalPeriod)
+	assert.NoError(t, err, "could not finalize proposals")
+	assert.Empty(t, events, "rejected proposal")
+	allowed, err = IsDeployAllowed(scs, otherID)
+	assert.NoError(t, err, "could not check deployer")
+	assert.True(t, allowed, "the denial is rejected")
+}
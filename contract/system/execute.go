@@ -5,6 +5,7 @@
 package system
 
 import (
+	"bytes"
 	"encoding/json"
 	"math/big"
 
@@ -13,11 +14,23 @@ import (
 )
 
 type SystemContext struct {
-	BlockNo  uint64
-	Call     *types.CallInfo
-	Args     []string
-	Staked   *types.Staking
-	Vote     *types.Vote
+	BlockNo    uint64
+	Call       *types.CallInfo
+	Args       []string
+	Staked     *types.Staking
+	Vote       *types.Vote
+	Delegation *Delegation
+	Evidence   *Evidence
+	Proposal   *Proposal
+	Multisig   *Multisig
+	Candidate  *BPCandidate
+	// AuthContract is the authorization contract designated by the tx.
+	AuthContract []byte
+	// MultisigCaller is the multisig account on behalf of which the tx is
+	// executed.
+	MultisigCaller *Multisig
+	// Batch is the operations of a batch system tx.
+	Batch    []*types.TxBody
 	Sender   *state.V
 	Receiver *state.V
 }
@@ -29,7 +42,111 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 	if err != nil {
 		return nil, err
 	}
+	// a tx on behalf of a multisig account needs the state of the account
+	if context.MultisigCaller != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	if context.Batch != nil {
+		return atomically(scs, sender, receiver, func() ([]*types.Event, error) {
+			return executeBatch(scs, context.Batch, sender, receiver, blockNo)
+		})
+	}
+	context.Receiver = receiver
+	return executeSystemTx(scs, txBody, sender, receiver, blockNo, context)
+}
+
+// executeBatch executes the operations of a batch system tx in order. An
+// operation is validated against the state changed by the previous ones, and
+// the tx fails as a whole if any of them fails, which is rolled back by the
+// caller. The events of an operation are indexed by its position in the batch.
+func executeBatch(scs *state.ContractState, ops []*types.TxBody, sender, receiver *state.V,
+	blockNo types.BlockNo) ([]*types.Event, error) {
+	var events []*types.Event
+	for i, op := range ops {
+		context, err := ValidateSystemTx(sender.ID(), op, sender, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Receiver = receiver
+		opEvents, err := executeSystemTx(scs, op, sender, receiver, blockNo, context)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range opEvents {
+			event.EventIdx = int32(i)
+		}
+		events = append(events, opEvents...)
+	}
+	return events, nil
+}
+
+// ExecuteMultisigTx executes the system tx sent by the signer on behalf of the
+// multisig account.
+func ExecuteMultisigTx(scs *state.ContractState, txBody *types.TxBody,
+	signer, account, receiver *state.V, blockNo types.BlockNo) ([]*types.Event, error) {
+
+	context, err := ValidateSystemTx(signer.ID(), txBody, account, scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	multisig := context.MultisigCaller
+	if multisig == nil || !bytes.Equal(multisig.Address, account.ID()) {
+		return nil, types.ErrTxInvalidPayload
+	}
 	context.Receiver = receiver
+	return atomically(scs, account, receiver, func() ([]*types.Event, error) {
+		multisig.Nonce++
+		if err := setMultisig(scs, multisig); err != nil {
+			return nil, err
+		}
+		return executeSystemTx(scs, txBody, account, receiver, blockNo, context)
+	})
+}
+
+// atomically runs execute, and rolls back the storage of the system contract
+// and the balances of the sender and the receiver if it fails, so that a
+// failed tx leaves nothing of its partial changes.
+func atomically(scs *state.ContractState, sender, receiver *state.V,
+	execute func() ([]*types.Event, error)) ([]*types.Event, error) {
+	snapshot := scs.Snapshot()
+	senderBalance, receiverBalance := sender.State().Balance, receiver.State().Balance
+	events, err := execute()
+	if err != nil {
+		if rErr := scs.Rollback(snapshot); rErr != nil {
+			return nil, rErr
+		}
+		sender.State().Balance, receiver.State().Balance = senderBalance, receiverBalance
+		return nil, err
+	}
+	return events, nil
+}
+
+// MultisigCallerOf returns the multisig account on behalf of which the system
+// tx is sent, or nil if it is an ordinary system tx.
+func MultisigCallerOf(txBody *types.TxBody) []byte {
+	var ci types.CallInfo
+	if err := json.Unmarshal(txBody.Payload, &ci); err != nil || ci.Name != types.MultisigCall || len(ci.Args) == 0 {
+		return nil
+	}
+	encoded, ok := ci.Args[0].(string)
+	if !ok {
+		return nil
+	}
+	account, err := types.DecodeAddress(encoded)
+	if err != nil {
+		return nil
+	}
+	return account
+}
+
+func executeSystemTx(scs *state.ContractState, txBody *types.TxBody, sender, receiver *state.V,
+	blockNo types.BlockNo, context *SystemContext) ([]*types.Event, error) {
+	var err error
+
+	// the reward accrued by the votes is settled before they are changed
+	if err = settleReward(scs, sender.ID(), blockNo); err != nil {
+		return nil, err
+	}
 
 	var event *types.Event
 	switch context.Call.Name {
@@ -39,39 +156,69 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 		event, err = voting(txBody, sender, receiver, scs, blockNo, context)
 	case types.Unstake:
 		event, err = unstaking(txBody, sender, receiver, scs, blockNo, context)
+	case types.Delegate:
+		event, err = delegating(txBody, sender, receiver, scs, blockNo, context)
+	case types.Undelegate:
+		event, err = undelegating(txBody, sender, receiver, scs, blockNo, context)
+	case types.Slash:
+		event, err = slashing(txBody, sender, receiver, scs, blockNo, context)
+	case types.Propose:
+		event, err = proposing(txBody, sender, receiver, scs, blockNo, context)
+	case types.VoteProposal:
+		event, err = votingProposal(txBody, sender, receiver, scs, blockNo, context)
+	case types.ClaimReward:
+		event, err = claimingReward(txBody, sender, receiver, scs, blockNo, context)
+	case types.Claim:
+		event, err = claiming(txBody, sender, receiver, scs, blockNo, context)
+	case types.CreateMultisig:
+		event, err = creatingMultisig(txBody, sender, receiver, scs, blockNo, context)
+	case types.RegisterBP:
+		event, err = registeringBP(txBody, sender, receiver, scs, blockNo, context)
+	case types.UnregisterBP:
+		event, err = unregisteringBP(txBody, sender, receiver, scs, blockNo, context)
+	case types.SetAuthContract:
+		event, err = settingAuthContract(txBody, sender, receiver, scs, blockNo, context)
 	default:
-		err = types.ErrTxInvalidPayload
+		if !types.IsParamVote(context.Call.Name) {
+			err = types.ErrTxInvalidPayload
+			break
+		}
+		if event, err = voting(txBody, sender, receiver, scs, blockNo, context); err != nil {
+			break
+		}
+		err = tallyParam(scs, params[context.Call.Name], blockNo)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if err = syncRewardSnapshot(scs, sender.ID(), blockNo); err != nil {
+		return nil, err
+	}
 	var events []*types.Event
 	events = append(events, event)
 	return events, nil
 }
 
 func GetNamePrice(scs *state.ContractState) *big.Int {
-	votelist, err := getVoteResult(scs, []byte(types.VoteNamePrice[2:]), 1)
+	namePrice, err := GetParam(scs, types.VoteNamePrice)
 	if err != nil {
-		panic("could not get vote result for min staking")
+		panic("could not get the name price")
 	}
-	if len(votelist.Votes) == 0 {
-		return types.NamePrice
+	return namePrice
+}
+
+func GetGasPrice(scs *state.ContractState) *big.Int {
+	gasPrice, err := GetParam(scs, types.VoteGasPrice)
+	if err != nil {
+		panic("could not get the gas price")
 	}
-	return new(big.Int).SetBytes(votelist.Votes[0].GetCandidate())
+	return gasPrice
 }
 
 func GetMinimumStaking(scs *state.ContractState) *big.Int {
-	votelist, err := getVoteResult(scs, []byte(types.VoteMinStaking[2:]), 1)
+	minimumStaking, err := GetParam(scs, types.VoteMinStaking)
 	if err != nil {
-		panic("could not get vote result for min staking")
-	}
-	if len(votelist.Votes) == 0 {
-		return types.StakingMinimum
-	}
-	minimumStaking, ok := new(big.Int).SetString(string(votelist.Votes[0].GetCandidate()), 10)
-	if !ok {
-		panic("could not get vote result for min staking")
+		panic("could not get the minimum staking")
 	}
 	return minimumStaking
 }
@@ -84,6 +231,22 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 	if err := json.Unmarshal(txBody.Payload, &ci); err != nil {
 		return nil, types.ErrTxInvalidPayload
 	}
+	if ci.Name == types.Batch {
+		return validateForBatch(account, txBody, sender, scs, blockNo, &ci)
+	}
+	if ci.Name == types.MultisigCall {
+		multisig, inner, err := validateForMultisigCall(account, txBody, scs, &ci)
+		if err != nil {
+			return nil, err
+		}
+		// the balance of the multisig account is checked only with its state
+		if sender != nil && !bytes.Equal(sender.ID(), multisig.Address) {
+			sender = nil
+		}
+		account, ci = multisig.Address, *inner
+		context.MultisigCaller = multisig
+		context.Sender = sender
+	}
 	switch ci.Name {
 	case types.Stake:
 		if sender != nil && sender.Balance().Cmp(txBody.GetAmountBigInt()) < 0 {
@@ -95,34 +258,136 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 		}
 		context.Staked = staked
 	case types.VoteBP:
-		staked, err := getStaking(scs, account)
+		staked, oldvote, err := validateForVote(account, scs, blockNo, &ci)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+		context.Vote = oldvote
+	case types.Unstake:
+		staked, err := validateForUnstaking(account, txBody, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+	case types.Delegate:
+		staked, delegation, err := validateForDelegation(account, txBody, scs, blockNo, &ci)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+		context.Delegation = delegation
+	case types.Undelegate:
+		delegation, err := validateForUndelegation(account, txBody, scs, blockNo)
 		if err != nil {
 			return nil, err
 		}
-		if staked.GetAmountBigInt().Cmp(new(big.Int).SetUint64(0)) == 0 {
-			return nil, types.ErrMustStakeBeforeVote
+		context.Delegation = delegation
+	case types.Slash:
+		evidence, err := validateForSlash(account, txBody, scs, blockNo, &ci)
+		if err != nil {
+			return nil, err
 		}
-		oldvote, err := GetVote(scs, account, []byte(ci.Name[2:]))
+		context.Evidence = evidence
+	case types.Propose:
+		proposal, err := validateForPropose(account, txBody, scs, blockNo, &ci)
 		if err != nil {
 			return nil, err
 		}
-		if oldvote.Amount != nil && staked.GetWhen()+VotingDelay > blockNo {
-			return nil, types.ErrLessTimeHasPassed
+		context.Proposal = proposal
+	case types.VoteProposal:
+		proposal, staked, oldvote, err := validateForVoteProposal(account, txBody, scs, blockNo, &ci)
+		if err != nil {
+			return nil, err
 		}
+		context.Proposal = proposal
 		context.Staked = staked
 		context.Vote = oldvote
-	case types.Unstake:
-		staked, err := validateForUnstaking(account, txBody, scs, blockNo)
+	case types.ClaimReward:
+		if err := validateForClaimReward(account, txBody, scs); err != nil {
+			return nil, err
+		}
+	case types.Claim:
+		if err := validateForClaim(account, txBody, scs); err != nil {
+			return nil, err
+		}
+	case types.CreateMultisig:
+		multisig, err := validateForCreateMultisig(account, txBody, scs, &ci)
 		if err != nil {
 			return nil, err
 		}
-		context.Staked = staked
+		context.Multisig = multisig
+	case types.RegisterBP:
+		candidate, err := validateForRegisterBP(account, txBody, scs, &ci)
+		if err != nil {
+			return nil, err
+		}
+		context.Candidate = candidate
+	case types.UnregisterBP:
+		candidate, err := validateForUnregisterBP(account, txBody, scs, &ci)
+		if err != nil {
+			return nil, err
+		}
+		context.Candidate = candidate
+	case types.SetAuthContract:
+		if err := validateForSetAuthContract(txBody, blockNo, context, &ci); err != nil {
+			return nil, err
+		}
 	default:
+		if !types.IsParamVote(ci.Name) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		if err := validateForParam(&ci); err != nil {
+			return nil, err
+		}
+		staked, oldvote, err := validateForVote(account, scs, blockNo, &ci)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+		context.Vote = oldvote
+	}
+	return context, nil
+}
+
+// validateForBatch validates the first operation of the batch system tx. The
+// others depend on the state changed by the previous operations, so that they
+// are validated only when they are executed.
+func validateForBatch(account []byte, txBody *types.TxBody, sender *state.V,
+	scs *state.ContractState, blockNo uint64, ci *types.CallInfo) (*SystemContext, error) {
+	ops, err := types.BatchOps(txBody, ci)
+	if err != nil {
+		return nil, err
+	}
+	context, err := ValidateSystemTx(account, ops[0], sender, scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if context.MultisigCaller != nil {
 		return nil, types.ErrTxInvalidPayload
 	}
+	context.Batch = ops
 	return context, nil
 }
 
+func validateForVote(account []byte, scs *state.ContractState, blockNo uint64, ci *types.CallInfo) (*types.Staking, *types.Vote, error) {
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if staked.GetAmountBigInt().Cmp(new(big.Int).SetUint64(0)) == 0 {
+		return nil, nil, types.ErrMustStakeBeforeVote
+	}
+	oldvote, err := GetVote(scs, account, []byte(ci.Name[2:]))
+	if err != nil {
+		return nil, nil, err
+	}
+	if oldvote.Amount != nil && staked.GetWhen()+VotingDelay > blockNo {
+		return nil, nil, types.ErrLessTimeHasPassed
+	}
+	return staked, oldvote, nil
+}
+
 func validateForStaking(account []byte, txBody *types.TxBody, scs *state.ContractState, blockNo uint64) (*types.Staking, error) {
 	staked, err := getStaking(scs, account)
 	if err != nil {
@@ -131,8 +396,11 @@ func validateForStaking(account []byte, txBody *types.TxBody, scs *state.Contrac
 	if staked.GetAmount() != nil && staked.GetWhen()+StakingDelay > blockNo {
 		return nil, types.ErrLessTimeHasPassed
 	}
-	toBe := new(big.Int).Add(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
-	if GetMinimumStaking(scs).Cmp(toBe) > 0 {
+	// a stake made under a lower minimum stays valid and can be added to
+	if staked.GetAmountBigInt().Sign() != 0 {
+		return staked, nil
+	}
+	if GetMinimumStaking(scs).Cmp(txBody.GetAmountBigInt()) > 0 {
 		return nil, types.ErrTooSmallAmount
 	}
 	return staked, nil
@@ -149,12 +417,19 @@ func validateForUnstaking(account []byte, txBody *types.TxBody, scs *state.Contr
 	if staked.GetAmountBigInt().Cmp(txBody.GetAmountBigInt()) < 0 {
 		return nil, types.ErrExceedAmount
 	}
-	if staked.GetWhen()+StakingDelay > blockNo {
+	if !types.IsFeatureActive(types.FeatureWithdrawalQueue, blockNo) && staked.GetWhen()+StakingDelay > blockNo {
 		return nil, types.ErrLessTimeHasPassed
 	}
 	toBe := new(big.Int).Sub(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
 	if toBe.Cmp(big.NewInt(0)) != 0 && GetMinimumStaking(scs).Cmp(toBe) > 0 {
 		return nil, types.ErrTooSmallAmount
 	}
+	delegation, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if toBe.Cmp(delegation.GetAmountBigInt()) < 0 {
+		return nil, types.ErrDelegatedStaking
+	}
 	return staked, nil
 }
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/mr-tron/base58/base58"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +50,7 @@ func TestBasicExecute(t *testing.T) {
 	tx.Body.Amount = types.StakingMinimum.Bytes()
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
+	releaseTo(t, scs, sender, receiver, VotingDelay+2*StakingDelay)
 	assert.Equal(t, types.StakingMinimum.Bytes(), sender.Balance().Bytes(),
 		"sender.Balance() should be turn back")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
@@ -123,6 +125,7 @@ func TestBalanceExecute(t *testing.T) {
 	//voting still 1
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
+	releaseTo(t, scs, sender, receiver, blockNo+StakingDelay)
 	assert.Equal(t, types.StakingMinimum, new(big.Int).SetBytes(sender.Balance().Bytes()), "sender.Balance() should be turn back")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, balance2, new(big.Int).SetBytes(staking.Amount), "check amount of staking")
@@ -152,6 +155,7 @@ func TestBalanceExecute(t *testing.T) {
 	//voting 0
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "Execute system tx failed in unstaking")
+	releaseTo(t, scs, sender, receiver, blockNo+StakingDelay)
 	assert.Equal(t, balance3, new(big.Int).SetBytes(sender.Balance().Bytes()), "sender.Balance() should be turn back")
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, big.NewInt(0), new(big.Int).SetBytes(staking.Amount), "check amount of staking")
@@ -216,6 +220,7 @@ func TestBasicFailedExecute(t *testing.T) {
 	tx.Body.Amount = types.StakingMinimum.Bytes()
 	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay+StakingDelay)
 	assert.NoError(t, err, "Execute system tx failed in staking")
+	releaseTo(t, scs, sender, receiver, VotingDelay+2*StakingDelay)
 	staking, err = getStaking(scs, tx.GetBody().GetAccount())
 	assert.Equal(t, senderBalance, sender.Balance(),
 		"sender.Balance() should be turn back")
@@ -289,6 +294,11 @@ func TestValidateSystemTxForUnstaking(t *testing.T) {
 	assert.EqualError(t, types.ErrLessTimeHasPassed, err.Error(), "Validate system tx failed")
 	_, err = ValidateSystemTx(tx.Body.Account, tx.GetBody(), nil, scs, StakingDelay)
 	assert.NoError(t, err, "failed to validate system tx for unstaking")
+
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+	_, err = ValidateSystemTx(tx.Body.Account, tx.GetBody(), nil, scs, 1)
+	assert.NoError(t, err, "unstaking should not wait for the staking delay")
 }
 
 func TestValidateSystemTxForVoting(t *testing.T) {
@@ -428,15 +438,12 @@ func TestRemainStakingMinimum(t *testing.T) {
 			Type:    types.TxType_GOVERNANCE,
 		},
 	}
-	blockNo += StakingDelay - 1
-	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
-	assert.EqualError(t, err, types.ErrLessTimeHasPassed.Error(), "check error")
-
-	blockNo += 1
+	blockNo += StakingDelay
 	//balance 1+0.5 =1.5
 	//staking 2-0.5 =1.5
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
+	releaseTo(t, scs, sender, receiver, blockNo+StakingDelay)
 	staked, err := getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
 	assert.Equal(t, balance1_5, sender.Balance(), "could not get staking")
@@ -447,6 +454,7 @@ func TestRemainStakingMinimum(t *testing.T) {
 	//staking 1.5-0.5 =1
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
+	releaseTo(t, scs, sender, receiver, blockNo+StakingDelay)
 	staked, err = getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
 	assert.Equal(t, balance2, sender.Balance(), "could not get staking")
@@ -467,6 +475,7 @@ func TestRemainStakingMinimum(t *testing.T) {
 	//staking 1-1 =0
 	_, err = ExecuteSystemTx(scs, unStakingTx.GetBody(), sender, receiver, blockNo)
 	assert.NoError(t, err, "could not execute system tx")
+	releaseTo(t, scs, sender, receiver, blockNo+StakingDelay)
 	staked, err = getStaking(scs, sender.ID())
 	assert.NoError(t, err, "could not get staking")
 	assert.Equal(t, balance3, sender.Balance(), "could not get staking")
@@ -476,38 +485,66 @@ func TestRemainStakingMinimum(t *testing.T) {
 	assert.EqualError(t, err, types.ErrMustStakeBeforeUnstake.Error(), "check error")
 }
 
-/*
-func TestValidateVoteNumpBP(t *testing.T) {
-scs,sender,receiver:=initTest(t)
+func TestValidateVoteNumBP(t *testing.T) {
+	scs, sender, receiver := initTest(t)
 	defer deinitTest()
-	const testSender = "AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"
-
-	scs, err := cdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte("aergo.system")))
-	assert.NoError(t, err, "could not open contract state")
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
 
-	account, err := types.DecodeAddress(testSender)
-	assert.NoError(t, err, "could not decode test address")
 	tx := &types.Tx{
 		Body: &types.TxBody{
-			Account: account,
+			Account: sender.ID(),
 			Amount:  types.StakingMinimum.Bytes(),
 			Payload: buildStakingPayload(true),
 		},
 	}
-	sender, err := sdb.GetAccountStateV(tx.Body.Account)
-	assert.NoError(t, err, "could not get test address state")
-	receiver, err := sdb.GetAccountStateV(tx.Body.Recipient)
-	assert.NoError(t, err, "could not get test address state")
 	sender.AddBalance(types.StakingMinimum)
 
-	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, 0)
+	_, err := ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, 0)
 	assert.NoError(t, err, "Execute system tx failed in staking")
 
-	tx.Body.Payload = buildVotingPayloadEx(1, types.VoteBP)
-	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, 1)
+	tx.Body.Amount = nil
+	tx.Body.Payload = buildVotingPayloadEx(3, types.VoteBP)
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay)
 	assert.NoError(t, err, "Execute system tx failed in voting")
 	tx.Body.Payload = buildVotingPayloadEx(1, types.VoteNumBP)
-	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, 2)
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, VotingDelay)
 	assert.NoError(t, err, "Execute system tx failed in voting")
+
+	ar := testStateReader{scs}
+	n, err := GetBpCount(ar)
+	assert.NoError(t, err, "could not get BP count")
+	assert.Equal(t, maxBpCount, n, "BP count before the activation")
+	bps, err := GetRankers(ar)
+	assert.NoError(t, err, "could not get rankers")
+	assert.Equal(t, 3, len(bps), "all the candidates are elected")
+
+	_, activation, err := GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	_, err = ActivateParams(scs, activation)
+	assert.NoError(t, err, "could not activate parameters")
+
+	n, err = GetBpCount(ar)
+	assert.NoError(t, err, "could not get BP count")
+	assert.Equal(t, 12, n, "voted BP count")
+	tx.Body.Payload = []byte(`{"Name":"v1voteNumBP","Args":["2"]}`)
+	_, err = ExecuteSystemTx(scs, tx.GetBody(), sender, receiver, activation)
+	assert.NoError(t, err, "Execute system tx failed in voting")
+	_, activation, err = GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	_, err = ActivateParams(scs, activation)
+	assert.NoError(t, err, "could not activate parameters")
+
+	bps, err = GetRankers(ar)
+	assert.NoError(t, err, "could not get rankers")
+	assert.Equal(t, 2, len(bps), "the top rankers within the voted BP count are elected")
+}
+
+// testStateReader reads the system contract state of a test.
+type testStateReader struct {
+	scs *state.ContractState
+}
+
+func (r testStateReader) GetSystemAccountState() (*state.ContractState, error) {
+	return r.scs, nil
 }
-*/
@@ -0,0 +1,129 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var voteExpiryKey = []byte("voteexpiry")
+var expiringKey = []byte("expiring")
+
+// setVoteExpiry schedules the expiry of the BP vote cast at the block. The
+// expiry is decided by the voted parameter at the time of voting, and a vote
+// never expires if the parameter is 0.
+func setVoteExpiry(scs *state.ContractState, voter []byte, blockNo types.BlockNo) error {
+	period, err := GetParam(scs, types.VoteBPExpiry)
+	if err != nil {
+		return err
+	}
+	key := append(append([]byte{}, voteExpiryKey...), voter...)
+	if period.Sign() == 0 {
+		return scs.DeleteData(key)
+	}
+	expiry := blockNo + period.Uint64()
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, expiry)
+	if err = scs.SetData(key, data); err != nil {
+		return err
+	}
+	voters, err := getExpiringVoters(scs, expiry)
+	if err != nil {
+		return err
+	}
+	for _, v := range voters {
+		if bytes.Equal(v, voter) {
+			return nil
+		}
+	}
+	return setExpiringVoters(scs, expiry, append(voters, voter))
+}
+
+// GetVoteExpiry returns the block at which the BP vote of the voter expires.
+// It returns 0 if the vote never expires.
+func GetVoteExpiry(scs *state.ContractState, voter []byte) (types.BlockNo, error) {
+	data, err := scs.GetData(append(append([]byte{}, voteExpiryKey...), voter...))
+	if err != nil || len(data) < 8 {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(data), nil
+}
+
+// ExpireVotes removes the BP votes which expire at the block from the
+// election result and reports whether any vote expired. An expired vote
+// keeps its candidates without an amount until it is cast again.
+func ExpireVotes(scs *state.ContractState, blockNo types.BlockNo) (bool, error) {
+	voters, err := getExpiringVoters(scs, blockNo)
+	if err != nil || len(voters) == 0 {
+		return false, err
+	}
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return false, err
+	}
+	for _, voter := range voters {
+		expiry, err := GetVoteExpiry(scs, voter)
+		if err != nil {
+			return false, err
+		}
+		// the vote is cast again after it is scheduled
+		if expiry != blockNo {
+			continue
+		}
+		vote, err := getVote(scs, defaultVoteKey, voter)
+		if err != nil {
+			return false, err
+		}
+		if vote.GetAmountBigInt().Sign() == 0 {
+			continue
+		}
+		if err = settleReward(scs, voter, blockNo); err != nil {
+			return false, err
+		}
+		if err = voteResult.SubVote(vote); err != nil {
+			return false, err
+		}
+		vote.Amount = nil
+		if err = setVote(scs, defaultVoteKey, voter, vote); err != nil {
+			return false, err
+		}
+		if err = syncRewardSnapshot(scs, voter, blockNo); err != nil {
+			return false, err
+		}
+	}
+	if err = voteResult.Sync(scs); err != nil {
+		return false, err
+	}
+	return true, setExpiringVoters(scs, blockNo, nil)
+}
+
+func expiringKeyOf(blockNo types.BlockNo) []byte {
+	no := make([]byte, 8)
+	binary.BigEndian.PutUint64(no, blockNo)
+	return append(append([]byte{}, expiringKey...), no...)
+}
+
+func setExpiringVoters(scs *state.ContractState, blockNo types.BlockNo, voters [][]byte) error {
+	if len(voters) == 0 {
+		return scs.DeleteData(expiringKeyOf(blockNo))
+	}
+	return scs.SetData(expiringKeyOf(blockNo), bytes.Join(voters, nil))
+}
+
+func getExpiringVoters(scs *state.ContractState, blockNo types.BlockNo) ([][]byte, error) {
+	data, err := scs.GetData(expiringKeyOf(blockNo))
+	if err != nil {
+		return nil, err
+	}
+	var voters [][]byte
+	for offset := 0; offset+types.AddressLength <= len(data); offset += types.AddressLength {
+		voters = append(voters, data[offset:offset+types.AddressLength])
+	}
+	return voters, nil
+}
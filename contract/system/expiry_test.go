@@ -0,0 +1,76 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoteExpiry(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	candidate := newTestCandidate(t)
+	voteBP := []byte(`{"Name":"v1voteBP","Args":["` + candidate + `"]}`)
+	tx.Amount = nil
+	tx.Payload = voteBP
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	expiry, err := GetVoteExpiry(scs, sender.ID())
+	assert.NoError(t, err, "could not get vote expiry")
+	assert.Equal(t, uint64(0), expiry, "a vote never expires by default")
+
+	tx.Payload = []byte(`{"Name":"v1voteBPExpiry","Args":["100"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2*VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	_, err = ActivateParams(scs, 2*VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+
+	voted := uint64(3*VotingDelay + ParamActivationDelay)
+	tx.Payload = voteBP
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, voted)
+	assert.NoError(t, err, "voting failed")
+	expiry, err = GetVoteExpiry(scs, sender.ID())
+	assert.NoError(t, err, "could not get vote expiry")
+	assert.Equal(t, voted+100, expiry, "vote expiry")
+
+	expired, err := ExpireVotes(scs, voted+99)
+	assert.NoError(t, err, "could not expire votes")
+	assert.False(t, expired, "the vote is still valid")
+	expired, err = ExpireVotes(scs, voted+100)
+	assert.NoError(t, err, "could not expire votes")
+	assert.True(t, expired, "the vote should expire")
+
+	result, err := getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err, "could not get vote result")
+	assert.Equal(t, int64(0), result.GetVotes()[0].GetAmountBigInt().Int64(), "an expired vote does not count")
+	vote, err := GetVote(scs, sender.ID(), defaultVoteKey)
+	assert.NoError(t, err, "could not get vote")
+	assert.Equal(t, int64(0), vote.GetAmountBigInt().Int64(), "the amount of an expired vote")
+	assert.NotEmpty(t, vote.GetCandidate(), "an expired vote keeps its candidates")
+
+	// a refreshed vote counts again and the former schedule is ignored
+	refreshed := voted + VotingDelay
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, refreshed)
+	assert.NoError(t, err, "voting failed")
+	result, err = getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err, "could not get vote result")
+	assert.Equal(t, types.StakingMinimum, result.GetVotes()[0].GetAmountBigInt(), "a refreshed vote counts")
+	expired, err = ExpireVotes(scs, voted+100)
+	assert.NoError(t, err, "could not expire votes")
+	assert.False(t, expired, "nothing is scheduled anymore")
+	expiry, err = GetVoteExpiry(scs, sender.ID())
+	assert.NoError(t, err, "could not get vote expiry")
+	assert.Equal(t, refreshed+100, expiry, "vote expiry")
+}
@@ -0,0 +1,74 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// FeeSplit is the division of the tx fees collected in a block between
+// burning, the treasury account and the block producer.
+type FeeSplit struct {
+	Burn     *big.Int
+	Treasury *big.Int
+	BP       *big.Int
+}
+
+// SplitFee divides the collected fee by the voted burn and treasury rates.
+// The treasury rate is capped by what the burn rate leaves, and the block
+// producer gets the rest. It returns nil if neither rate is voted, in which
+// case the block producer gets the whole fee.
+func SplitFee(scs *state.ContractState, collected *big.Int) (*FeeSplit, error) {
+	burnRate, err := GetParam(scs, types.VoteFeeBurnRate)
+	if err != nil {
+		return nil, err
+	}
+	treasuryRate, err := GetParam(scs, types.VoteFeeTreasuryRate)
+	if err != nil {
+		return nil, err
+	}
+	if burnRate.Sign() == 0 && treasuryRate.Sign() == 0 {
+		return nil, nil
+	}
+	if rest := new(big.Int).Sub(big.NewInt(100), burnRate); treasuryRate.Cmp(rest) > 0 {
+		treasuryRate = rest
+	}
+	split := &FeeSplit{
+		Burn:     new(big.Int).Div(new(big.Int).Mul(collected, burnRate), big.NewInt(100)),
+		Treasury: new(big.Int).Div(new(big.Int).Mul(collected, treasuryRate), big.NewInt(100)),
+	}
+	split.BP = new(big.Int).Sub(collected, split.Burn)
+	split.BP.Sub(split.BP, split.Treasury)
+	return split, nil
+}
+
+// Events returns the events recording each part of the split.
+func (s *FeeSplit) Events() []*types.Event {
+	systemAddress := types.AddressPadding([]byte(types.AergoSystem))
+	return []*types.Event{
+		{
+			ContractAddress: systemAddress,
+			EventIdx:        0,
+			EventName:       "burnFee",
+			JsonArgs:        `{"amount":"` + s.Burn.String() + `"}`,
+		},
+		{
+			ContractAddress: systemAddress,
+			EventIdx:        1,
+			EventName:       "treasuryFee",
+			JsonArgs: `{"account":"` + types.AergoTreasury +
+				`", "amount":"` + s.Treasury.String() + `"}`,
+		},
+		{
+			ContractAddress: systemAddress,
+			EventIdx:        2,
+			EventName:       "bpFee",
+			JsonArgs:        `{"amount":"` + s.BP.String() + `"}`,
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFee(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	split, err := SplitFee(scs, big.NewInt(1000))
+	assert.NoError(t, err, "could not split fee")
+	assert.Nil(t, split, "the BP gets the whole fee by default")
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteFeeBurnRate","Args":["30"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	tx.Payload = []byte(`{"Name":"v1voteFeeTreasuryRate","Args":["90"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	tx.Payload = []byte(`{"Name":"v1voteFeeBurnRate","Args":["101"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.Error(t, err, "rate over 100")
+
+	_, err = ActivateParams(scs, VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+
+	split, err = SplitFee(scs, big.NewInt(1001))
+	assert.NoError(t, err, "could not split fee")
+	assert.Equal(t, big.NewInt(300), split.Burn, "burned fee")
+	assert.Equal(t, big.NewInt(700), split.Treasury, "treasury rate is capped by the burn rate")
+	assert.Equal(t, big.NewInt(1), split.BP, "BP gets the rest")
+
+	events := split.Events()
+	assert.Len(t, events, 3, "an event for each part")
+	assert.Equal(t, "burnFee", events[0].EventName, "burn event")
+	assert.Equal(t, `{"amount":"300"}`, events[0].JsonArgs, "burned amount")
+	assert.Equal(t, `{"account":"aergo.treasury", "amount":"700"}`, events[1].JsonArgs, "treasury amount")
+	assert.Equal(t, `{"amount":"1"}`, events[2].JsonArgs, "BP amount")
+}
@@ -0,0 +1,96 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// The versions of the features activated by the votes on VoteForkVersion are
+// recorded with their activation blocks, so that the features active at a
+// block are decided by the state. A version, once activated, stays even if a
+// lower version is voted later.
+
+var forkKey = []byte("fork")
+
+// GetVotedForks returns the versions activated by the votes.
+func GetVotedForks(scs *state.ContractState) (types.ForkSchedule, error) {
+	data, err := scs.GetData(forkKey)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeForks(data), nil
+}
+
+// ScheduleForks activates the versions up to the voted one from the block
+// after blockNo, if it is higher than the activated ones. It reports whether
+// any version is scheduled.
+func ScheduleForks(scs *state.ContractState, blockNo types.BlockNo) (bool, error) {
+	voted, err := GetParam(scs, types.VoteForkVersion)
+	if err != nil {
+		return false, err
+	}
+	forks, err := GetVotedForks(scs)
+	if err != nil {
+		return false, err
+	}
+	var latest uint64
+	if len(forks) != 0 {
+		latest = forks[len(forks)-1].Version
+	}
+	if voted.Uint64() <= latest {
+		return false, nil
+	}
+	for v := latest + 1; v <= voted.Uint64(); v++ {
+		forks = append(forks, &types.Fork{Version: v, Height: blockNo + 1})
+	}
+	return true, scs.SetData(forkKey, serializeForks(forks))
+}
+
+// GetForkSchedule returns the schedule of the genesis merged with the
+// versions activated by the votes.
+func GetForkSchedule(scs *state.ContractState, genesis types.ForkSchedule) (types.ForkSchedule, error) {
+	voted, err := GetVotedForks(scs)
+	if err != nil {
+		return nil, err
+	}
+	return genesis.Merge(voted), nil
+}
+
+// UpdateForkSchedule makes the modules consult the schedule of the genesis
+// merged with the versions activated by the votes.
+func UpdateForkSchedule(scs *state.ContractState, genesis types.ForkSchedule) error {
+	schedule, err := GetForkSchedule(scs, genesis)
+	if err != nil {
+		return err
+	}
+	types.SetForkSchedule(schedule)
+	return nil
+}
+
+// serializeForks encodes the version and the activation block of each fork
+// in 8 bytes each.
+func serializeForks(forks types.ForkSchedule) []byte {
+	data := make([]byte, 16*len(forks))
+	for i, f := range forks {
+		binary.LittleEndian.PutUint64(data[16*i:], f.Version)
+		binary.LittleEndian.PutUint64(data[16*i+8:], f.Height)
+	}
+	return data
+}
+
+func deserializeForks(data []byte) types.ForkSchedule {
+	var forks types.ForkSchedule
+	for i := 0; i+16 <= len(data); i += 16 {
+		forks = append(forks, &types.Fork{
+			Version: binary.LittleEndian.Uint64(data[i:]),
+			Height:  binary.LittleEndian.Uint64(data[i+8:]),
+		})
+	}
+	return forks
+}
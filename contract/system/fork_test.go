@@ -0,0 +1,51 @@
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleForks(t *testing.T) {
+	scs, _, _ := initTest(t)
+	defer deinitTest()
+	defer types.SetForkSchedule(nil)
+
+	scheduled, err := ScheduleForks(scs, 10)
+	assert.NoError(t, err)
+	assert.False(t, scheduled, "no version voted")
+
+	p := params[types.VoteForkVersion]
+	assert.NoError(t, setParamState(scs, p, &paramState{active: new(big.Int).SetUint64(types.ForkVersion1)}))
+	scheduled, err = ScheduleForks(scs, 10)
+	assert.NoError(t, err)
+	assert.True(t, scheduled, "version 1 voted")
+	forks, err := GetVotedForks(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ForkSchedule{{Version: types.ForkVersion1, Height: 11}}, forks)
+
+	// the activated version stays
+	scheduled, err = ScheduleForks(scs, 20)
+	assert.NoError(t, err)
+	assert.False(t, scheduled, "version 1 already activated")
+	assert.NoError(t, setParamState(scs, p, &paramState{active: big.NewInt(0)}))
+	scheduled, err = ScheduleForks(scs, 30)
+	assert.NoError(t, err)
+	assert.False(t, scheduled, "lower version voted")
+
+	types.SetForkSchedule(nil)
+	schedule, err := GetForkSchedule(scs, types.ForkSchedule{{Version: types.ForkVersion1, Height: 100}})
+	assert.NoError(t, err)
+	assert.Equal(t, types.ForkSchedule{{Version: types.ForkVersion1, Height: 11}}, schedule)
+	assert.True(t, IsDynamicFeeScheduled(schedule, 11))
+	assert.False(t, IsDynamicFeeEnabled(11), "the schedule of the chain is not changed")
+
+	assert.NoError(t, UpdateForkSchedule(scs, types.ForkSchedule{{Version: types.ForkVersion1, Height: 100}}))
+	assert.True(t, types.IsFeatureActive(types.FeatureDynamicFee, 11), "voted before the genesis schedule")
+	assert.False(t, types.IsFeatureActive(types.FeatureDynamicFee, 10))
+	assert.True(t, IsDynamicFeeEnabled(11))
+
+	assert.Error(t, p.Validate(new(big.Int).SetUint64(types.LatestForkVersion+1)), "unknown version")
+}
@@ -0,0 +1,59 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/state"
+)
+
+var freeTxKey = []byte("freetx")
+
+func freeTxUsageKey(account []byte) []byte {
+	return append(append([]byte{}, freeTxKey...), account...)
+}
+
+// GetFreeTxUsage returns the part of its free-tx quota the account used on
+// the last day it sent a free tx, or nil if it never did.
+func GetFreeTxUsage(scs *state.ContractState, account []byte) (*fee.FreeTxUsage, error) {
+	data, err := scs.GetData(freeTxUsageKey(account))
+	if err != nil || len(data) < 24 {
+		return nil, err
+	}
+	return &fee.FreeTxUsage{
+		Day:   binary.LittleEndian.Uint64(data),
+		Count: binary.LittleEndian.Uint64(data[8:]),
+		Bytes: binary.LittleEndian.Uint64(data[16:]),
+	}, nil
+}
+
+// IsFreeTx reports whether a tx of the account with the payload size sent
+// at the block timestamp is within its free-tx quota.
+func IsFreeTx(scs *state.ContractState, account []byte, payloadSize int, ts int64) (bool, error) {
+	if !fee.IsFreeTxEnabled() {
+		return false, nil
+	}
+	usage, err := GetFreeTxUsage(scs, account)
+	if err != nil {
+		return false, err
+	}
+	return usage.Covers(fee.FreeTxDay(ts), payloadSize), nil
+}
+
+// UseFreeTx counts a free tx of the account against its quota.
+func UseFreeTx(scs *state.ContractState, account []byte, payloadSize int, ts int64) error {
+	usage, err := GetFreeTxUsage(scs, account)
+	if err != nil {
+		return err
+	}
+	usage = usage.Add(fee.FreeTxDay(ts), payloadSize)
+	data := make([]byte, 24)
+	binary.LittleEndian.PutUint64(data, usage.Day)
+	binary.LittleEndian.PutUint64(data[8:], usage.Count)
+	binary.LittleEndian.PutUint64(data[16:], usage.Bytes)
+	return scs.SetData(freeTxUsageKey(account), data)
+}
@@ -0,0 +1,51 @@
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeTxQuota(t *testing.T) {
+	scs, sender, _ := initTest(t)
+	defer deinitTest()
+	defer fee.SetFreeTxQuota(0, 0)
+
+	ts := int64(100 * 24 * time.Hour)
+	free, err := IsFreeTx(scs, sender.ID(), 10, ts)
+	assert.NoError(t, err, "could not check free tx")
+	assert.False(t, free, "no free tx without a quota")
+
+	fee.SetFreeTxQuota(2, 100)
+	for i := 0; i < 2; i++ {
+		free, err = IsFreeTx(scs, sender.ID(), 10, ts)
+		assert.NoError(t, err, "could not check free tx")
+		assert.True(t, free, "free tx within the quota")
+		assert.NoError(t, UseFreeTx(scs, sender.ID(), 10, ts), "could not use free tx")
+	}
+	free, err = IsFreeTx(scs, sender.ID(), 10, ts)
+	assert.NoError(t, err, "could not check free tx")
+	assert.False(t, free, "tx count over the quota")
+
+	usage, err := GetFreeTxUsage(scs, sender.ID())
+	assert.NoError(t, err, "could not get free tx usage")
+	assert.Equal(t, &fee.FreeTxUsage{Day: fee.FreeTxDay(ts), Count: 2, Bytes: 20}, usage, "usage of the day")
+
+	// the quota is renewed the next day
+	ts += int64(24 * time.Hour)
+	free, err = IsFreeTx(scs, sender.ID(), 100, ts)
+	assert.NoError(t, err, "could not check free tx")
+	assert.True(t, free, "quota of the next day")
+	free, err = IsFreeTx(scs, sender.ID(), 101, ts)
+	assert.NoError(t, err, "could not check free tx")
+	assert.False(t, free, "payload bytes over the quota")
+
+	fee.SetFreeTxQuota(0, 100)
+	assert.NoError(t, UseFreeTx(scs, sender.ID(), 60, ts), "could not use free tx")
+	assert.NoError(t, UseFreeTx(scs, sender.ID(), 40, ts), "could not use free tx")
+	free, err = IsFreeTx(scs, sender.ID(), 1, ts)
+	assert.NoError(t, err, "could not check free tx")
+	assert.False(t, free, "payload bytes used up")
+}
@@ -0,0 +1,245 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/minio/sha256-simd"
+	"github.com/mr-tron/base58"
+)
+
+// multisigAddressPrefix is the first byte of the address of a multisig
+// account, which is distinguished from the compressed public key of an
+// ordinary account (0x02 or 0x03).
+const multisigAddressPrefix = 0x4d
+
+var multisigKey = []byte("multisig")
+
+// Multisig is an m-of-n account whose system txs are authorized by the
+// signatures of Threshold of its Signers. A system tx on behalf of the account
+// is sent by one of the signers with the signatures of the others, and Nonce
+// is increased by each of them so that the signatures can not be replayed.
+type Multisig struct {
+	Address   []byte
+	Threshold int
+	Signers   [][]byte
+	Nonce     uint64
+}
+
+func (m *Multisig) isSigner(account []byte) bool {
+	for _, s := range m.Signers {
+		if bytes.Equal(s, account) {
+			return true
+		}
+	}
+	return false
+}
+
+// MultisigAddress returns the address of the multisig account created by the
+// tx of the creator with the nonce.
+func MultisigAddress(creator []byte, nonce uint64) []byte {
+	h := sha256.New()
+	h.Write(creator)
+	binary.Write(h, binary.LittleEndian, nonce)
+	return append([]byte{multisigAddressPrefix}, h.Sum(nil)...)
+}
+
+// MultisigCallHash returns the hash which the signers sign to authorize the
+// system tx payload with the amount on behalf of the multisig account.
+func MultisigCallHash(account []byte, nonce uint64, chainIDHash, amount, payload []byte) []byte {
+	h := sha256.New()
+	h.Write(account)
+	binary.Write(h, binary.LittleEndian, nonce)
+	h.Write(chainIDHash)
+	h.Write(amount)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+func creatingMultisig(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	multisig := context.Multisig
+	if err := setMultisig(scs, multisig); err != nil {
+		return nil, err
+	}
+	var signers []string
+	for _, s := range multisig.Signers {
+		signers = append(signers, types.EncodeAddress(s))
+	}
+	encoded, err := json.Marshal(signers)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "createMultisig",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "address":"` + types.EncodeAddress(multisig.Address) +
+			`", "threshold":` + strconv.Itoa(multisig.Threshold) +
+			`, "signers":` + string(encoded) + `}`,
+	}, nil
+}
+
+func validateForCreateMultisig(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	ci *types.CallInfo) (*Multisig, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	if len(ci.Args) < 2 || len(ci.Args) > 1+types.MaxMultisigSigners {
+		return nil, types.ErrTxInvalidPayload
+	}
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, types.ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	threshold, err := strconv.Atoi(args[0])
+	if err != nil || threshold <= 0 || threshold > len(args)-1 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	multisig := &Multisig{
+		Address:   MultisigAddress(account, txBody.GetNonce()),
+		Threshold: threshold,
+	}
+	for _, v := range args[1:] {
+		signer, err := types.DecodeAddress(v)
+		if err != nil || len(signer) != types.AddressLength || multisig.isSigner(signer) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		multisig.Signers = append(multisig.Signers, signer)
+	}
+	existing, err := getMultisig(scs, multisig.Address)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, types.ErrMultisigExists
+	}
+	return multisig, nil
+}
+
+// validateForMultisigCall checks that the sender is a signer of the multisig
+// account and that the system tx is signed by enough signers. It returns the
+// account and the system tx to execute on behalf of it.
+func validateForMultisigCall(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	ci *types.CallInfo) (*Multisig, *types.CallInfo, error) {
+	if len(ci.Args) < 2 {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, nil, types.ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	address, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	multisig, err := getMultisig(scs, address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if multisig == nil {
+		return nil, nil, types.ErrMultisigNotFound
+	}
+	if !multisig.isSigner(account) {
+		return nil, nil, types.ErrNotMultisigSigner
+	}
+	// the sender approves by signing the tx itself
+	approved := map[string]bool{string(account): true}
+	hash := MultisigCallHash(multisig.Address, multisig.Nonce, txBody.GetChainIdHash(),
+		txBody.GetAmount(), []byte(args[1]))
+	for _, encoded := range args[2:] {
+		raw, err := base58.Decode(encoded)
+		if err != nil {
+			return nil, nil, types.ErrTxInvalidPayload
+		}
+		sign, err := btcec.ParseSignature(raw, btcec.S256())
+		if err != nil {
+			return nil, nil, types.ErrTxInvalidPayload
+		}
+		for _, signer := range multisig.Signers {
+			if approved[string(signer)] {
+				continue
+			}
+			pubkey, err := btcec.ParsePubKey(signer, btcec.S256())
+			if err != nil {
+				continue
+			}
+			if sign.Verify(hash, pubkey) {
+				approved[string(signer)] = true
+				break
+			}
+		}
+	}
+	if len(approved) < multisig.Threshold {
+		return nil, nil, types.ErrNotEnoughSignatures
+	}
+	var inner types.CallInfo
+	if err := json.Unmarshal([]byte(args[1]), &inner); err != nil {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	if inner.Name == types.CreateMultisig || inner.Name == types.MultisigCall {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	return multisig, &inner, nil
+}
+
+// GetMultisig returns the multisig account of the address or nil if it does
+// not exist.
+func GetMultisig(scs *state.ContractState, address []byte) (*Multisig, error) {
+	return getMultisig(scs, address)
+}
+
+func getMultisig(scs *state.ContractState, address []byte) (*Multisig, error) {
+	data, err := scs.GetData(append(append([]byte{}, multisigKey...), address...))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return deserializeMultisig(address, data), nil
+}
+
+func setMultisig(scs *state.ContractState, multisig *Multisig) error {
+	return scs.SetData(append(append([]byte{}, multisigKey...), multisig.Address...), serializeMultisig(multisig))
+}
+
+// serializeMultisig encodes the threshold (1 byte), the nonce (8 bytes) and
+// the signers.
+func serializeMultisig(multisig *Multisig) []byte {
+	data := make([]byte, 9)
+	data[0] = byte(multisig.Threshold)
+	binary.LittleEndian.PutUint64(data[1:], multisig.Nonce)
+	for _, s := range multisig.Signers {
+		data = append(data, s...)
+	}
+	return data
+}
+
+func deserializeMultisig(address, data []byte) *Multisig {
+	multisig := &Multisig{
+		Address:   address,
+		Threshold: int(data[0]),
+		Nonce:     binary.LittleEndian.Uint64(data[1:9]),
+	}
+	for offset := 9; offset+types.AddressLength <= len(data); offset += types.AddressLength {
+		multisig.Signers = append(multisig.Signers, data[offset:offset+types.AddressLength])
+	}
+	return multisig
+}
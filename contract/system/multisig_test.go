@@ -0,0 +1,108 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultisig(t *testing.T) {
+	scs, _, receiver := initTest(t)
+	defer deinitTest()
+
+	var keys []*btcec.PrivateKey
+	var signers []string
+	for i := 0; i < 3; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err, "could not generate key")
+		keys = append(keys, key)
+		signers = append(signers, types.EncodeAddress(key.PubKey().SerializeCompressed()))
+	}
+	creator, err := sdb.GetAccountStateV(keys[0].PubKey().SerializeCompressed())
+	assert.NoError(t, err, "could not get test address state")
+
+	args, _ := json.Marshal(append([]string{"2"}, signers...))
+	tx := &types.TxBody{Account: creator.ID(), Nonce: 1, Payload: []byte(`{"Name":"v1createMultisig","Args":` + string(args) + `}`)}
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	events, err := ExecuteSystemTx(scs, tx, creator, receiver, 0)
+	assert.NoError(t, err, "create multisig failed")
+	assert.Equal(t, "createMultisig", events[0].EventName, "event name")
+	_, err = ExecuteSystemTx(scs, tx, creator, receiver, 0)
+	assert.Equal(t, types.ErrMultisigExists, err, "create the same multisig")
+
+	address := MultisigAddress(creator.ID(), 1)
+	multisig, err := GetMultisig(scs, address)
+	assert.NoError(t, err, "could not get multisig")
+	assert.Equal(t, 2, multisig.Threshold, "threshold")
+	assert.Len(t, multisig.Signers, 3, "signers")
+
+	account, err := sdb.GetAccountStateV(address)
+	assert.NoError(t, err, "could not get multisig address state")
+	account.AddBalance(types.StakingMinimum)
+
+	inner := `{"Name":"v1stake"}`
+	call := func(sigs ...string) []byte {
+		args, _ := json.Marshal(append([]string{types.EncodeAddress(address), inner}, sigs...))
+		return []byte(`{"Name":"v1multisigCall","Args":` + string(args) + `}`)
+	}
+	sign := func(key *btcec.PrivateKey, nonce uint64) string {
+		hash := MultisigCallHash(address, nonce, nil, types.StakingMinimum.Bytes(), []byte(inner))
+		sig, err := key.Sign(hash)
+		assert.NoError(t, err, "could not sign")
+		return base58.Encode(sig.Serialize())
+	}
+	tx = &types.TxBody{Account: creator.ID(), Amount: types.StakingMinimum.Bytes(), Payload: call()}
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteMultisigTx(scs, tx, creator, account, receiver, 1)
+	assert.Equal(t, types.ErrNotEnoughSignatures, err, "only the sender signs")
+	tx.Payload = call(sign(keys[0], 0))
+	_, err = ExecuteMultisigTx(scs, tx, creator, account, receiver, 1)
+	assert.Equal(t, types.ErrNotEnoughSignatures, err, "the sender signs twice")
+
+	tx.Payload = call(sign(keys[2], 0))
+	_, err = ExecuteSystemTx(scs, tx, creator, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "execute without the multisig account state")
+	events, err = ExecuteMultisigTx(scs, tx, creator, account, receiver, 1)
+	assert.NoError(t, err, "stake on behalf of multisig failed")
+	assert.Equal(t, "stake", events[0].EventName, "event name")
+	staking, err := getStaking(scs, address)
+	assert.NoError(t, err, "could not get staking")
+	assert.Equal(t, types.StakingMinimum, staking.GetAmountBigInt(), "staked by multisig")
+	assert.Equal(t, 0, account.Balance().Sign(), "balance of multisig")
+
+	account.AddBalance(types.StakingMinimum)
+	_, err = ExecuteMultisigTx(scs, tx, creator, account, receiver, 2)
+	assert.Equal(t, types.ErrNotEnoughSignatures, err, "replay the signatures")
+
+	outsider, _ := btcec.NewPrivateKey(btcec.S256())
+	other, err := sdb.GetAccountStateV(outsider.PubKey().SerializeCompressed())
+	assert.NoError(t, err, "could not get test address state")
+	tx = &types.TxBody{Account: other.ID(), Amount: types.StakingMinimum.Bytes(), Payload: call(sign(keys[1], 1), sign(keys[2], 1))}
+	_, err = ExecuteMultisigTx(scs, tx, other, account, receiver, 2)
+	assert.Equal(t, types.ErrNotMultisigSigner, err, "sent by a non-signer")
+
+	// a call failed in the execution leaves the nonce and the balances
+	balance := account.Balance()
+	errFailed := errors.New("failed")
+	_, err = atomically(scs, account, receiver, func() ([]*types.Event, error) {
+		multisig.Nonce++
+		assert.NoError(t, setMultisig(scs, multisig))
+		account.SubBalance(balance)
+		return nil, errFailed
+	})
+	assert.Equal(t, errFailed, err)
+	multisig, err = GetMultisig(scs, address)
+	assert.NoError(t, err, "could not get multisig")
+	assert.Equal(t, uint64(1), multisig.Nonce, "nonce after the failed call")
+	assert.Equal(t, balance, account.Balance(), "balance after the failed call")
+}
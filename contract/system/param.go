@@ -0,0 +1,516 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// ParamActivationDelay is the number of blocks between the tally which
+// changes the value of a parameter and the activation of the value.
+const ParamActivationDelay = 60 * 60 * 24 //block interval
+
+// MinStakingActivationDelay is the activation delay of the minimum staking. It
+// exceeds StakingDelay so that every staker can add to the stake before a
+// raised minimum is activated.
+const MinStakingActivationDelay = ParamActivationDelay + StakingDelay
+
+// DefaultParamQuorum is the percentage of the total staking which must vote
+// on a parameter for its value to be activated until a quorum is voted.
+const DefaultParamQuorum = 0
+
+var paramKey = []byte("param")
+
+// Parameter is a value of the chain decided by the votes of the stakers.
+//
+// A vote for a value which is not voted yet proposes it. The votes are
+// tallied whenever they change and the value with the most votes is
+// activated ParamActivationDelay blocks after it becomes the top, provided
+// that the staking voting on the parameter then exceeds the quorum.
+type Parameter struct {
+	// Vote is the name of the system transaction voting for the parameter.
+	Vote string
+	// Default returns the value used until a value is activated.
+	Default func() *big.Int
+	// Min and Max are the bounds of the proposed values. nil means no bound.
+	Min *big.Int
+	Max *big.Int
+	// Delay is the number of blocks between the tally and the activation.
+	// 0 means ParamActivationDelay.
+	Delay types.BlockNo
+	// Check validates the proposed values beyond the bounds. nil means no
+	// check.
+	Check func(value *big.Int) error
+}
+
+func (p *Parameter) key() []byte {
+	return []byte(p.Vote)[2:]
+}
+
+func (p *Parameter) activationDelay() types.BlockNo {
+	if p.Delay == 0 {
+		return ParamActivationDelay
+	}
+	return p.Delay
+}
+
+// Validate checks if the value can be proposed for the parameter.
+func (p *Parameter) Validate(value *big.Int) error {
+	if value.Sign() < 0 ||
+		(p.Min != nil && value.Cmp(p.Min) < 0) ||
+		(p.Max != nil && value.Cmp(p.Max) > 0) {
+		return types.ErrTxInvalidPayload
+	}
+	if p.Check != nil {
+		return p.Check(value)
+	}
+	return nil
+}
+
+func constant(v int64) func() *big.Int {
+	return func() *big.Int { return big.NewInt(v) }
+}
+
+var params = map[string]*Parameter{}
+
+func registerParam(p *Parameter) {
+	params[p.Vote] = p
+}
+
+func init() {
+	registerParam(&Parameter{
+		Vote:    types.VoteNamePrice,
+		Default: func() *big.Int { return types.NamePrice },
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteMinStaking,
+		Default: func() *big.Int { return types.StakingMinimum },
+		Min:     big.NewInt(1),
+		Delay:   MinStakingActivationDelay,
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteNumBP,
+		Default: func() *big.Int { return big.NewInt(int64(getDefaultBpCount())) },
+		Min:     big.NewInt(1),
+		Max:     big.NewInt(maxBpCount),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteGasPrice,
+		Default: fee.DefaultAerPerByte,
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteMaxBlockSize,
+		Default: constant(types.DefaultMaxBlockSize),
+		Min:     big.NewInt(1 << 10),
+		Max:     big.NewInt(1 << 30),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteBPExpiry,
+		Default: constant(0),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteVoterRewardRate,
+		Default: constant(DefaultVoterRewardRate),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteSlashDoubleSign,
+		Default: constant(DefaultDoubleSignSlashRate),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteSlashDowntime,
+		Default: constant(DefaultDowntimeSlashRate),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteParamQuorum,
+		Default: constant(DefaultParamQuorum),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteAerPerByte,
+		Default: fee.DefaultAerPerByte,
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteBaseTxFee,
+		Default: fee.DefaultBaseTxFee,
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteFeeBurnRate,
+		Default: constant(0),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteFeeTreasuryRate,
+		Default: constant(0),
+		Max:     big.NewInt(100),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteForkVersion,
+		Default: constant(0),
+		Max:     new(big.Int).SetUint64(types.LatestForkVersion),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteVotingReward,
+		Default: constant(0),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteStorageQuota,
+		Default: constant(0),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VoteMaxPayloadSize,
+		Default: constant(fee.DefaultMaxPayloadSize),
+		Min:     big.NewInt(minMaxPayloadSize),
+		Max:     big.NewInt(types.TxMaxSize),
+	})
+	registerParam(&Parameter{
+		Vote:    types.VotePayloadTypes,
+		Default: constant(int64(types.AllPayloadTypes)),
+		Max:     big.NewInt(int64(types.AllPayloadTypes)),
+		Check:   allowsGovernance,
+	})
+}
+
+// InitParam sets the active value of the parameter at the genesis.
+func InitParam(scs *state.ContractState, vote string, value *big.Int) error {
+	p, ok := params[vote]
+	if !ok {
+		return types.ErrTxInvalidPayload
+	}
+	if err := p.Validate(value); err != nil {
+		return err
+	}
+	return setParamState(scs, p, &paramState{active: value})
+}
+
+// GetParameter returns the registered parameter of the vote.
+func GetParameter(vote string) (*Parameter, bool) {
+	p, ok := params[vote]
+	return p, ok
+}
+
+// paramState is the stored state of a parameter. The pending value becomes
+// active at the activation block.
+type paramState struct {
+	active     *big.Int
+	pending    *big.Int
+	activation types.BlockNo
+}
+
+// GetParam returns the active value of the parameter.
+func GetParam(scs *state.ContractState, vote string) (*big.Int, error) {
+	p, ok := params[vote]
+	if !ok {
+		return nil, types.ErrTxInvalidPayload
+	}
+	ps, err := getParamState(scs, p)
+	if err != nil {
+		return nil, err
+	}
+	return ps.active, nil
+}
+
+// GetPendingParam returns the value of the parameter waiting for activation
+// and its activation block. The value is nil if nothing is pending.
+func GetPendingParam(scs *state.ContractState, vote string) (*big.Int, types.BlockNo, error) {
+	p, ok := params[vote]
+	if !ok {
+		return nil, 0, types.ErrTxInvalidPayload
+	}
+	ps, err := getParamState(scs, p)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ps.pending, ps.activation, nil
+}
+
+func proposedValue(ci *types.CallInfo) (*big.Int, error) {
+	if len(ci.Args) != 1 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	arg, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, types.ErrTxInvalidPayload
+	}
+	value, ok := new(big.Int).SetString(arg, 10)
+	if !ok {
+		return nil, types.ErrTxInvalidPayload
+	}
+	return value, nil
+}
+
+// validateForParam checks the proposed value of the vote against the bounds
+// of the parameter.
+func validateForParam(ci *types.CallInfo) error {
+	p, ok := params[ci.Name]
+	if !ok {
+		return types.ErrTxInvalidPayload
+	}
+	value, err := proposedValue(ci)
+	if err != nil {
+		return err
+	}
+	return p.Validate(value)
+}
+
+// tallyParam schedules the activation of the value with the most votes if
+// it differs from the current one. The state of a parameter moves as follows:
+//
+//	active            -> active, pending  when another value becomes the top
+//	active, pending   -> active, pending' when a third value becomes the top,
+//	                                      which restarts the delay
+//	active, pending   -> active           when the active value becomes the top
+//	                                      again or no value has votes
+//	active, pending   -> pending          at the activation block, where the
+//	                                      pending value becomes the active
+//	                                      one (see ActivateParams)
+func tallyParam(scs *state.ContractState, p *Parameter, blockNo types.BlockNo) error {
+	votelist, err := getVoteResult(scs, p.key(), 1)
+	if err != nil {
+		return err
+	}
+	ps, err := getParamState(scs, p)
+	if err != nil {
+		return err
+	}
+	var top *big.Int
+	if len(votelist.Votes) != 0 && votelist.Votes[0].GetAmountBigInt().Sign() > 0 {
+		top, _ = new(big.Int).SetString(string(votelist.Votes[0].GetCandidate()), 10)
+	}
+	// The top value is active at once before the feature, except the fork
+	// version which activates the feature itself.
+	if p.Vote != types.VoteForkVersion && !types.IsFeatureActive(types.FeatureParamDelay, blockNo) {
+		if top == nil || (top.Cmp(ps.active) == 0 && ps.pending == nil) {
+			return nil
+		}
+		ps.active, ps.pending = top, nil
+		return setParamState(scs, p, ps)
+	}
+	switch {
+	case top == nil || top.Cmp(ps.active) == 0:
+		if ps.pending == nil {
+			return nil
+		}
+		ps.pending = nil
+	case ps.pending != nil && top.Cmp(ps.pending) == 0:
+		return nil
+	default:
+		ps.pending = top
+		ps.activation = blockNo + p.activationDelay()
+	}
+	return setParamState(scs, p, ps)
+}
+
+// ActivateParams activates the pending values of the parameters whose
+// activation block is reached and reports whether any parameter changed. A
+// pending value is dropped instead if the votes on the parameter do not reach
+// the quorum, so that the active value persists.
+func ActivateParams(scs *state.ContractState, blockNo types.BlockNo) (bool, error) {
+	changed := false
+	for _, vote := range types.ParamVotes {
+		p := params[vote]
+		ps, err := getParamState(scs, p)
+		if err != nil {
+			return false, err
+		}
+		if ps.pending == nil || ps.activation > blockNo {
+			continue
+		}
+		quorum, err := getQuorumStatus(scs, p)
+		if err != nil {
+			return false, err
+		}
+		if quorum.GetReached() {
+			ps.active = ps.pending
+		}
+		ps.pending = nil
+		if err = setParamState(scs, p, ps); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// GetQuorumStatus returns the turnout of the votes on each parameter against
+// the quorum.
+func GetQuorumStatus(scs *state.ContractState) ([]*types.QuorumStatus, error) {
+	var list []*types.QuorumStatus
+	for _, vote := range types.ParamVotes {
+		quorum, err := getQuorumStatus(scs, params[vote])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, quorum)
+	}
+	return list, nil
+}
+
+// getQuorumStatus compares the staking voting on the parameter with the
+// quorum percentage of the total staking.
+func getQuorumStatus(scs *state.ContractState, p *Parameter) (*types.QuorumStatus, error) {
+	voteResult, err := loadVoteResult(scs, p.key())
+	if err != nil {
+		return nil, err
+	}
+	// each voter votes for a single value of the parameter
+	turnout := new(big.Int)
+	for _, amount := range voteResult.rmap {
+		turnout.Add(turnout, amount)
+	}
+	total, err := GetStakingTotal(scs)
+	if err != nil {
+		return nil, err
+	}
+	quorum, err := GetParam(scs, types.VoteParamQuorum)
+	if err != nil {
+		return nil, err
+	}
+	required := new(big.Int).Div(new(big.Int).Mul(total, quorum), big.NewInt(100))
+	ps, err := getParamState(scs, p)
+	if err != nil {
+		return nil, err
+	}
+	status := &types.QuorumStatus{
+		Param:        p.Vote[2:],
+		Turnout:      turnout.Bytes(),
+		TotalStaking: total.Bytes(),
+		Quorum:       quorum.Uint64(),
+		Reached:      turnout.Sign() > 0 && turnout.Cmp(required) > 0,
+	}
+	if ps.pending != nil {
+		status.Pending = ps.pending.Bytes()
+		status.Activation = ps.activation
+	}
+	return status, nil
+}
+
+// feeParams supplies the fee package with the fee parameters active in the
+// system contract.
+type feeParams struct {
+	aerPerByte     *big.Int
+	baseTxFee      *big.Int
+	gasPrice       *big.Int
+	maxPayloadSize int64
+}
+
+func (p *feeParams) AerPerByte() *big.Int {
+	return new(big.Int).Set(p.aerPerByte)
+}
+
+func (p *feeParams) BaseTxFee() *big.Int {
+	return new(big.Int).Set(p.baseTxFee)
+}
+
+func (p *feeParams) GasPrice() *big.Int {
+	return new(big.Int).Set(p.gasPrice)
+}
+
+func (p *feeParams) MaxPayloadSize() int64 {
+	return p.maxPayloadSize
+}
+
+// UpdateFeeParams makes the fee package charge the fee parameters active in
+// the system contract to the txs of the block. The fee per byte is the
+// adjusted one if the dynamic fee is enabled at the block.
+func UpdateFeeParams(scs *state.ContractState, blockNo types.BlockNo) error {
+	p, err := loadFeeParams(scs, GetParam)
+	if err != nil {
+		return err
+	}
+	if IsDynamicFeeEnabled(blockNo) {
+		if p.aerPerByte, err = GetBaseFee(scs); err != nil {
+			return err
+		}
+	}
+	fee.SetParamProvider(p)
+	return nil
+}
+
+// GetPendingFeeParams returns the fee parameters which will be active once
+// the pending values are activated, and the block of the last activation. It
+// returns nil if no fee parameter is pending.
+func GetPendingFeeParams(scs *state.ContractState) (fee.ParamProvider, types.BlockNo, error) {
+	var activation types.BlockNo
+	p, err := loadFeeParams(scs, func(scs *state.ContractState, vote string) (*big.Int, error) {
+		pending, at, err := GetPendingParam(scs, vote)
+		if err != nil || pending == nil {
+			return GetParam(scs, vote)
+		}
+		if at > activation {
+			activation = at
+		}
+		return pending, nil
+	})
+	if err != nil || activation == 0 {
+		return nil, 0, err
+	}
+	return p, activation, nil
+}
+
+func loadFeeParams(scs *state.ContractState,
+	get func(*state.ContractState, string) (*big.Int, error)) (*feeParams, error) {
+	var values [4]*big.Int
+	for i, vote := range []string{types.VoteAerPerByte, types.VoteBaseTxFee, types.VoteGasPrice,
+		types.VoteMaxPayloadSize} {
+		value, err := get(scs, vote)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return &feeParams{aerPerByte: values[0], baseTxFee: values[1], gasPrice: values[2],
+		maxPayloadSize: values[3].Int64()}, nil
+}
+
+func getParamState(scs *state.ContractState, p *Parameter) (*paramState, error) {
+	data, err := scs.GetData(append(append([]byte{}, paramKey...), p.key()...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &paramState{active: p.Default()}, nil
+	}
+	return deserializeParamState(data), nil
+}
+
+func setParamState(scs *state.ContractState, p *Parameter, ps *paramState) error {
+	return scs.SetData(append(append([]byte{}, paramKey...), p.key()...), serializeParamState(ps))
+}
+
+// serializeParamState encodes the activation block (8 bytes), the length of
+// the active value (1 byte), the active value and the pending value.
+func serializeParamState(ps *paramState) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, ps.activation)
+	active := ps.active.Bytes()
+	data = append(data, byte(len(active)))
+	data = append(data, active...)
+	if ps.pending != nil {
+		// a pending value is distinguished from none by a marker byte
+		data = append(data, 1)
+		data = append(data, ps.pending.Bytes()...)
+	}
+	return data
+}
+
+func deserializeParamState(data []byte) *paramState {
+	size := int(data[8])
+	ps := &paramState{
+		activation: binary.LittleEndian.Uint64(data[:8]),
+		active:     new(big.Int).SetBytes(data[9 : 9+size]),
+	}
+	if len(data) > 9+size {
+		ps.pending = new(big.Int).SetBytes(data[10+size:])
+	}
+	return ps
+}
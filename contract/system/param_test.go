@@ -0,0 +1,315 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamLifecycle(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	for _, vote := range types.ParamVotes {
+		p, ok := GetParameter(vote)
+		assert.True(t, ok, "parameter should be registered: %s", vote)
+		value, err := GetParam(scs, vote)
+		assert.NoError(t, err, "could not get parameter")
+		assert.Equal(t, p.Default(), value, "default value of %s", vote)
+	}
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["0"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "value below the minimum")
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["-1"]}`)
+	assert.Equal(t, types.ErrTxInvalidPayload, types.ValidateSystemTx(tx), "negative value")
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+
+	pending, activation, err := GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Equal(t, big.NewInt(7), pending, "pending value")
+	assert.Equal(t, uint64(VotingDelay+ParamActivationDelay), activation, "activation block")
+
+	activated, err := ActivateParams(scs, activation-1)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.False(t, activated, "nothing is activated before the activation block")
+	activated, err = ActivateParams(scs, activation)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.True(t, activated, "pending value should be activated")
+	value, err := GetParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get parameter")
+	assert.Equal(t, big.NewInt(7), value, "active value")
+	pending, _, err = GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Nil(t, pending, "nothing is pending after the activation")
+
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["9"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2*VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	pending, _, err = GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Equal(t, big.NewInt(9), pending, "pending value")
+
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3*VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	pending, _, err = GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Nil(t, pending, "voting for the active value cancels the pending one")
+}
+
+func TestParamBeforeDelay(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	// the top value is active as soon as it is voted
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	value, err := GetParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get parameter")
+	assert.Equal(t, big.NewInt(7), value, "active value")
+	pending, _, err := GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Nil(t, pending, "nothing is pending")
+
+	// but the fork version still waits for the activation delay
+	tx.Payload = []byte(`{"Name":"v1voteForkVersion","Args":["1"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	pending, _, err = GetPendingParam(scs, types.VoteForkVersion)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Equal(t, big.NewInt(1), pending, "pending fork version")
+}
+
+func TestParamUnstakeRetally(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteNamePrice","Args":["1000"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	pending, _, err := GetPendingParam(scs, types.VoteNamePrice)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Equal(t, big.NewInt(1000), pending, "pending value")
+
+	tx.Amount = types.StakingMinimum.Bytes()
+	tx.Payload = buildStakingPayload(false)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay+1)
+	assert.NoError(t, err, "unstaking failed")
+	pending, _, err = GetPendingParam(scs, types.VoteNamePrice)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Nil(t, pending, "the value has no votes after unstaking")
+	assert.Equal(t, types.NamePrice, GetNamePrice(scs), "name price")
+}
+
+func TestMinStakingActivation(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	raised := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(new(big.Int).Mul(raised, big.NewInt(2)))
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteMinStaking","Args":["` + raised.String() + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	_, activation, err := GetPendingParam(scs, types.VoteMinStaking)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Equal(t, uint64(VotingDelay+MinStakingActivationDelay), activation, "activation block")
+
+	newcomer, err := sdb.GetAccountStateV(types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"))
+	assert.NoError(t, err, "could not get test address state")
+	newcomer.AddBalance(raised)
+	stake := &types.TxBody{Account: newcomer.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	activated, err := ActivateParams(scs, activation-1)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.False(t, activated, "nothing is activated before the activation block")
+	_, err = ExecuteSystemTx(scs, stake, newcomer, receiver, activation-1)
+	assert.NoError(t, err, "the old minimum applies before the activation")
+
+	activated, err = ActivateParams(scs, activation)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.True(t, activated, "the minimum should be activated")
+	assert.Equal(t, raised, GetMinimumStaking(scs), "raised minimum")
+
+	other, err := sdb.GetAccountStateV(types.ToAddress("AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As"))
+	assert.NoError(t, err, "could not get test address state")
+	other.AddBalance(raised)
+	stake.Account = other.ID()
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, activation)
+	assert.Equal(t, types.ErrTooSmallAmount, err, "new stake below the raised minimum")
+
+	staked, err := getStaking(scs, sender.ID())
+	assert.NoError(t, err, "could not get staking")
+	assert.Equal(t, types.StakingMinimum, staked.GetAmountBigInt(), "existing stake is kept")
+	tx.Amount = big.NewInt(1).Bytes()
+	tx.Payload = buildStakingPayload(true)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, activation)
+	assert.NoError(t, err, "add to the stake below the raised minimum")
+	tx.Payload = buildStakingPayload(false)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, activation)
+	assert.Equal(t, types.ErrTooSmallAmount, err, "unstake leaving less than the raised minimum")
+	tx.Amount = new(big.Int).Add(types.StakingMinimum, big.NewInt(1)).Bytes()
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, activation)
+	assert.NoError(t, err, "unstake all")
+}
+
+func TestFeeParams(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+	defer fee.SetParamProvider(nil)
+
+	assert.NoError(t, UpdateFeeParams(scs, 0), "could not update fee parameters")
+	assert.Equal(t, fee.DefaultAerPerByte(), fee.AerPerByte(), "default fee per byte")
+	assert.Equal(t, fee.DefaultBaseTxFee(), fee.BaseTxFee(), "default base tx fee")
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteAerPerByte","Args":["10"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	tx.Payload = []byte(`{"Name":"v1voteBaseTxFee","Args":["1000"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+
+	_, err = ActivateParams(scs, VotingDelay+ParamActivationDelay-1)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.NoError(t, UpdateFeeParams(scs, 0), "could not update fee parameters")
+	assert.Equal(t, fee.DefaultAerPerByte(), fee.AerPerByte(), "not activated yet")
+	pending, activation, err := GetPendingFeeParams(scs)
+	assert.NoError(t, err, "could not get pending fee parameters")
+	if assert.NotNil(t, pending, "pending fee parameters") {
+		assert.Equal(t, types.BlockNo(VotingDelay+ParamActivationDelay), activation, "activation of the pending parameters")
+		assert.Equal(t, big.NewInt(10), pending.AerPerByte(), "pending fee per byte")
+		assert.Equal(t, big.NewInt(1000), pending.BaseTxFee(), "pending base tx fee")
+		assert.Equal(t, fee.DefaultAerPerByte(), pending.GasPrice(), "gas price is not pending")
+	}
+
+	_, err = ActivateParams(scs, VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.NoError(t, UpdateFeeParams(scs, 0), "could not update fee parameters")
+	assert.Equal(t, big.NewInt(10), fee.AerPerByte(), "voted fee per byte")
+	assert.Equal(t, big.NewInt(1000), fee.BaseTxFee(), "voted base tx fee")
+	assert.Equal(t, big.NewInt(1000+10*100), fee.PayloadTxFee(300), "payload fee by the voted parameters")
+	pending, _, err = GetPendingFeeParams(scs)
+	assert.NoError(t, err, "could not get pending fee parameters")
+	assert.Nil(t, pending, "no pending fee parameters after the activation")
+}
+
+func TestParamQuorum(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	other, err := sdb.GetAccountStateV(types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay"))
+	assert.NoError(t, err, "could not get test address state")
+	other.AddBalance(types.StakingMinimum)
+	stake := &types.TxBody{Account: other.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	// half of the staking votes for the quorum of 50%, which does not exceed it
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteParamQuorum","Args":["50"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	status, err := GetQuorumStatus(scs)
+	assert.NoError(t, err, "could not get quorum status")
+	assert.Len(t, status, len(types.ParamVotes))
+	var quorum *types.QuorumStatus
+	for _, s := range status {
+		if s.GetParam() == types.VoteParamQuorum[2:] {
+			quorum = s
+		}
+	}
+	assert.Equal(t, types.StakingMinimum.Bytes(), quorum.GetTurnout(), "turnout")
+	assert.True(t, quorum.GetReached(), "no quorum is required by default")
+	activated, err := ActivateParams(scs, VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.True(t, activated, "the quorum should be activated")
+	value, err := GetParam(scs, types.VoteParamQuorum)
+	assert.NoError(t, err, "could not get parameter")
+	assert.Equal(t, big.NewInt(50), value, "active quorum")
+
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2*VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	status, err = GetQuorumStatus(scs)
+	assert.NoError(t, err, "could not get quorum status")
+	for _, s := range status {
+		if s.GetParam() == types.VoteNumBP[2:] {
+			quorum = s
+		}
+	}
+	assert.False(t, quorum.GetReached(), "half of the staking does not exceed the quorum")
+	assert.Equal(t, big.NewInt(7).Bytes(), quorum.GetPending(), "pending value")
+	activated, err = ActivateParams(scs, 2*VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.True(t, activated, "the pending value should be dropped")
+	value, err = GetParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get parameter")
+	assert.Equal(t, params[types.VoteNumBP].Default(), value, "the previous value persists")
+	pending, _, err := GetPendingParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get pending parameter")
+	assert.Nil(t, pending, "nothing is pending after the activation block")
+
+	stake.Amount = nil
+	stake.Payload = tx.Payload
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, 3*VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	activated, err = ActivateParams(scs, 3*VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	assert.True(t, activated, "the quorum is reached")
+	value, err = GetParam(scs, types.VoteNumBP)
+	assert.NoError(t, err, "could not get parameter")
+	assert.Equal(t, big.NewInt(7), value, "active value")
+}
@@ -0,0 +1,565 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+)
+
+// MinProposalPeriod and MaxProposalPeriod are the bounds of the voting period
+// of a proposal. The voting period is not longer than the staking delay so
+// that an unstaked amount can not be staked by another account and vote for
+// the same proposal again.
+const MinProposalPeriod = 60 * 60 //block interval
+const MaxProposalPeriod = StakingDelay
+
+var proposalKey = []byte("proposal")
+var proposalEndKey = []byte("pollend")
+var proposalVoteKey = []byte("pollvote")
+var proposalVotedKey = []byte("pollvoted")
+
+// proposalVoteVersion is the first byte of a stored ProposalVote. The votes
+// recorded before the snapshot are stored as the plain votes under the vote
+// key of the proposal and are migrated when they are read.
+const proposalVoteVersion = 1
+
+// Proposal is a stake weighted poll on arbitrary options. The description of
+// the proposal is kept off-chain and only its hash is recorded.
+type Proposal struct {
+	ID              string
+	Proposer        []byte
+	DescriptionHash []byte
+	Options         []string
+	// votes are accepted from the Start block to the End block
+	Start types.BlockNo
+	End   types.BlockNo
+	// Finalized is set after the End block with the option of the most votes.
+	// Winner is empty if nobody voted.
+	Finalized bool
+	Winner    string
+}
+
+// ProposalVote is the vote of an account for a proposal. The staking and the
+// voting power of the account are snapshotted at the vote, so that a later
+// increase of the staking does not alter the tally of the proposal. The
+// account votes again to refresh them. A decrease of the voting power by an
+// unstaking or a slashing reduces the counted power at once, so that the
+// unstaked amount does not count again when it is staked by another account.
+type ProposalVote struct {
+	Choices []string
+	Staked  *big.Int
+	Power   *big.Int
+	// BlockNo is the block of the snapshot. It is 0 for a migrated vote.
+	BlockNo types.BlockNo
+}
+
+// tally returns the vote counted in the vote result of the proposal.
+func (v *ProposalVote) tally() (*types.Vote, error) {
+	if v == nil {
+		return &types.Vote{}, nil
+	}
+	choices, err := json.Marshal(v.Choices)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Vote{Candidate: choices, Amount: v.Power.Bytes()}, nil
+}
+
+func (p *Proposal) hasOption(option string) bool {
+	for _, o := range p.Options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Proposal) voteKey() []byte {
+	return append(append([]byte{}, proposalKey...), p.ID...)
+}
+
+func proposing(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	proposal := context.Proposal
+	if err := setProposal(scs, proposal); err != nil {
+		return nil, err
+	}
+	ids, err := getProposalsEndAt(scs, proposal.End)
+	if err != nil {
+		return nil, err
+	}
+	if err = setProposalsEndAt(scs, proposal.End, append(ids, proposal.ID)); err != nil {
+		return nil, err
+	}
+	options, err := json.Marshal(proposal.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "propose",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "id":"` + proposal.ID +
+			`", "end":` + strconv.FormatUint(proposal.End, 10) +
+			`, "options":` + string(options) + `}`,
+	}, nil
+}
+
+func votingProposal(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	proposal := context.Proposal
+	voteResult, err := loadVoteResult(scs, proposal.voteKey())
+	if err != nil {
+		return nil, err
+	}
+	// a new vote replaces the previous one of the account
+	if err = voteResult.SubVote(context.Vote); err != nil {
+		return nil, err
+	}
+	power, err := votingPower(scs, sender.ID(), context.Staked)
+	if err != nil {
+		return nil, err
+	}
+	vote := &ProposalVote{
+		Staked:  context.Staked.GetAmountBigInt(),
+		Power:   power,
+		BlockNo: blockNo,
+	}
+	for _, v := range context.Call.Args[1:] {
+		vote.Choices = append(vote.Choices, v.(string))
+	}
+	if err = setProposalVote(scs, proposal, sender.ID(), vote); err != nil {
+		return nil, err
+	}
+	if err = addVotedProposal(scs, sender.ID(), proposal.ID); err != nil {
+		return nil, err
+	}
+	tally, err := vote.tally()
+	if err != nil {
+		return nil, err
+	}
+	if err = voteResult.AddVote(tally); err != nil {
+		return nil, err
+	}
+	if err = voteResult.Sync(scs); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "voteProposal",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "id":"` + proposal.ID +
+			`", "vote":` + string(tally.Candidate) +
+			`, "staked":"` + vote.Staked.String() +
+			`", "power":"` + power.String() + `"}`,
+	}, nil
+}
+
+func validateForPropose(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	blockNo types.BlockNo, ci *types.CallInfo) (*Proposal, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	if len(ci.Args) < 5 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, types.ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return nil, types.ErrMustStakeBeforePropose
+	}
+	proposal, err := getProposal(scs, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if proposal != nil {
+		return nil, types.ErrProposalExists
+	}
+	period, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil || period < MinProposalPeriod || period > MaxProposalPeriod {
+		return nil, types.ErrTxInvalidPayload
+	}
+	hash, err := base58.Decode(args[1])
+	if err != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	proposal = &Proposal{
+		ID:              args[0],
+		Proposer:        account,
+		DescriptionHash: hash,
+		Options:         args[3:],
+		Start:           blockNo,
+		End:             blockNo + period,
+	}
+	if err = validateDeployProposal(proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func validateForVoteProposal(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	blockNo types.BlockNo, ci *types.CallInfo) (*Proposal, *types.Staking, *types.Vote, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, nil, nil, types.ErrTxInvalidAmount
+	}
+	if len(ci.Args) < 2 {
+		return nil, nil, nil, types.ErrTxInvalidPayload
+	}
+	id, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, nil, nil, types.ErrTxInvalidPayload
+	}
+	proposal, err := getProposal(scs, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if proposal == nil {
+		return nil, nil, nil, types.ErrProposalNotFound
+	}
+	if proposal.Finalized || blockNo > proposal.End {
+		return nil, nil, nil, types.ErrProposalClosed
+	}
+	for _, v := range ci.Args[1:] {
+		choice, ok := v.(string)
+		if !ok || !proposal.hasOption(choice) {
+			return nil, nil, nil, types.ErrTxInvalidPayload
+		}
+	}
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return nil, nil, nil, types.ErrMustStakeBeforeVote
+	}
+	oldvote, err := getProposalVote(scs, proposal, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	tally, err := oldvote.tally()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return proposal, staked, tally, nil
+}
+
+// FinalizeProposals records the results of the proposals whose voting period
+// ends at the block and reports whether any was finalized. It applies the
+// approved changes of the deploy allow list and returns their events.
+func FinalizeProposals(scs *state.ContractState, blockNo types.BlockNo) (bool, []*types.Event, error) {
+	ids, err := getProposalsEndAt(scs, blockNo)
+	if err != nil || len(ids) == 0 {
+		return false, nil, err
+	}
+	var events []*types.Event
+	for _, id := range ids {
+		proposal, err := getProposal(scs, id)
+		if err != nil {
+			return false, nil, err
+		}
+		result, err := getVoteResult(scs, proposal.voteKey(), 1)
+		if err != nil {
+			return false, nil, err
+		}
+		if len(result.Votes) != 0 && result.Votes[0].GetAmountBigInt().Sign() > 0 {
+			proposal.Winner = string(result.Votes[0].GetCandidate())
+		}
+		proposal.Finalized = true
+		if err = setProposal(scs, proposal); err != nil {
+			return false, nil, err
+		}
+		event, err := applyDeployProposal(scs, proposal)
+		if err != nil {
+			return false, nil, err
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	return true, events, setProposalsEndAt(scs, blockNo, nil)
+}
+
+// GetProposal returns the proposal of the id or nil if it does not exist.
+func GetProposal(scs *state.ContractState, id string) (*Proposal, error) {
+	return getProposal(scs, id)
+}
+
+// GetProposalResult returns the votes of the options of the proposal in
+// descending order.
+func GetProposalResult(scs *state.ContractState, id string) (*types.VoteList, error) {
+	proposal, err := getProposal(scs, id)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, types.ErrProposalNotFound
+	}
+	return getVoteResult(scs, proposal.voteKey(), len(proposal.Options))
+}
+
+// GetProposalVote returns the vote of the account for the proposal or nil if
+// the account has not voted.
+func GetProposalVote(scs *state.ContractState, id string, account []byte) (*ProposalVote, error) {
+	proposal, err := getProposal(scs, id)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, types.ErrProposalNotFound
+	}
+	return getProposalVote(scs, proposal, account)
+}
+
+func proposalVoteKeyOf(proposal *Proposal, account []byte) []byte {
+	key := append(append([]byte{}, proposalVoteKey...), proposal.ID...)
+	return append(key, account...)
+}
+
+func getProposalVote(scs *state.ContractState, proposal *Proposal, account []byte) (*ProposalVote, error) {
+	data, err := scs.GetData(proposalVoteKeyOf(proposal, account))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 0 {
+		return deserializeProposalVote(data)
+	}
+	return migrateProposalVote(scs, proposal, account)
+}
+
+// migrateProposalVote converts the vote recorded before the snapshot. Its
+// amount is the voting power at the vote, which is also taken as the staking
+// since the delegation at the vote is not recorded.
+func migrateProposalVote(scs *state.ContractState, proposal *Proposal, account []byte) (*ProposalVote, error) {
+	legacy, err := getVote(scs, proposal.voteKey(), account)
+	if err != nil || legacy.Amount == nil {
+		return nil, err
+	}
+	vote := &ProposalVote{
+		Staked: legacy.GetAmountBigInt(),
+		Power:  legacy.GetAmountBigInt(),
+	}
+	if err = json.Unmarshal(legacy.Candidate, &vote.Choices); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// setProposalVote stores the vote in the snapshot layout and removes the
+// vote recorded before the snapshot if any.
+func setProposalVote(scs *state.ContractState, proposal *Proposal, account []byte, vote *ProposalVote) error {
+	data, err := serializeProposalVote(vote)
+	if err != nil {
+		return err
+	}
+	if err = scs.SetData(proposalVoteKeyOf(proposal, account), data); err != nil {
+		return err
+	}
+	return scs.DeleteData(append(append(append([]byte{}, voteKey...), proposal.voteKey()...), account...))
+}
+
+// serializeProposalVote encodes the version (1 byte), the block of the
+// snapshot (8 bytes), the length of the staking (1 byte), the staking, the
+// length of the voting power (1 byte), the voting power and the choices.
+func serializeProposalVote(vote *ProposalVote) ([]byte, error) {
+	choices, err := json.Marshal(vote.Choices)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 9)
+	data[0] = proposalVoteVersion
+	binary.LittleEndian.PutUint64(data[1:], vote.BlockNo)
+	staked := vote.Staked.Bytes()
+	data = append(data, byte(len(staked)))
+	data = append(data, staked...)
+	power := vote.Power.Bytes()
+	data = append(data, byte(len(power)))
+	data = append(data, power...)
+	return append(data, choices...), nil
+}
+
+func deserializeProposalVote(data []byte) (*ProposalVote, error) {
+	if data[0] != proposalVoteVersion {
+		return nil, errors.New("unknown version of proposal vote")
+	}
+	vote := &ProposalVote{BlockNo: binary.LittleEndian.Uint64(data[1:9])}
+	offset := 9
+	size := int(data[offset])
+	vote.Staked = new(big.Int).SetBytes(data[offset+1 : offset+1+size])
+	offset += 1 + size
+	size = int(data[offset])
+	vote.Power = new(big.Int).SetBytes(data[offset+1 : offset+1+size])
+	offset += 1 + size
+	if err := json.Unmarshal(data[offset:], &vote.Choices); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// refreshProposalVotes reduces the counted power of the votes of the account
+// for the open proposals to its voting power, and forgets the closed ones.
+func refreshProposalVotes(scs *state.ContractState, account []byte, power *big.Int, blockNo types.BlockNo) error {
+	ids, err := getVotedProposals(scs, account)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	var open []string
+	for _, id := range ids {
+		proposal, err := getProposal(scs, id)
+		if err != nil {
+			return err
+		}
+		if proposal == nil || proposal.Finalized || blockNo > proposal.End {
+			continue
+		}
+		open = append(open, id)
+		vote, err := getProposalVote(scs, proposal, account)
+		if err != nil {
+			return err
+		}
+		if vote == nil || vote.Power.Cmp(power) <= 0 {
+			continue
+		}
+		voteResult, err := loadVoteResult(scs, proposal.voteKey())
+		if err != nil {
+			return err
+		}
+		tally, err := vote.tally()
+		if err != nil {
+			return err
+		}
+		if err = voteResult.SubVote(tally); err != nil {
+			return err
+		}
+		vote.Power = new(big.Int).Set(power)
+		if err = setProposalVote(scs, proposal, account, vote); err != nil {
+			return err
+		}
+		if tally, err = vote.tally(); err != nil {
+			return err
+		}
+		if err = voteResult.AddVote(tally); err != nil {
+			return err
+		}
+		if err = voteResult.Sync(scs); err != nil {
+			return err
+		}
+	}
+	if len(open) == len(ids) {
+		return nil
+	}
+	return setVotedProposals(scs, account, open)
+}
+
+// addVotedProposal records the proposal among the ones the account voted for.
+func addVotedProposal(scs *state.ContractState, account []byte, id string) error {
+	ids, err := getVotedProposals(scs, account)
+	if err != nil {
+		return err
+	}
+	for _, voted := range ids {
+		if voted == id {
+			return nil
+		}
+	}
+	return setVotedProposals(scs, account, append(ids, id))
+}
+
+func setVotedProposals(scs *state.ContractState, account []byte, ids []string) error {
+	key := append(append([]byte{}, proposalVotedKey...), account...)
+	if len(ids) == 0 {
+		return scs.DeleteData(key)
+	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(key, data)
+}
+
+func getVotedProposals(scs *state.ContractState, account []byte) ([]string, error) {
+	data, err := scs.GetData(append(append([]byte{}, proposalVotedKey...), account...))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	var ids []string
+	if err = json.Unmarshal(data, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func setProposal(scs *state.ContractState, proposal *Proposal) error {
+	data, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(append(append([]byte{}, proposalKey...), proposal.ID...), data)
+}
+
+func getProposal(scs *state.ContractState, id string) (*Proposal, error) {
+	data, err := scs.GetData(append(append([]byte{}, proposalKey...), id...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var proposal Proposal
+	if err = json.Unmarshal(data, &proposal); err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func proposalEndKeyOf(blockNo types.BlockNo) []byte {
+	no := make([]byte, 8)
+	binary.BigEndian.PutUint64(no, blockNo)
+	return append(append([]byte{}, proposalEndKey...), no...)
+}
+
+func setProposalsEndAt(scs *state.ContractState, blockNo types.BlockNo, ids []string) error {
+	if len(ids) == 0 {
+		return scs.DeleteData(proposalEndKeyOf(blockNo))
+	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(proposalEndKeyOf(blockNo), data)
+}
+
+func getProposalsEndAt(scs *state.ContractState, blockNo types.BlockNo) ([]string, error) {
+	data, err := scs.GetData(proposalEndKeyOf(blockNo))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	var ids []string
+	if err = json.Unmarshal(data, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,215 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposal(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+
+	hash := base58.Encode(make([]byte, types.HashIDLength))
+	payload := []byte(`{"Name":"v1propose","Args":["p1","` + hash + `","3600","yes","no","abstain"]}`)
+	tx := &types.TxBody{Account: sender.ID(), Payload: payload}
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.Equal(t, types.ErrMustStakeBeforePropose, err, "propose without staking")
+
+	sender.AddBalance(types.StakingMinimum)
+	stakeTx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stakeTx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	balance2 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	other.AddBalance(balance2)
+	stakeTx = &types.TxBody{Account: other.ID(), Amount: balance2.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stakeTx, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["p1","` + hash + `","10","yes","no"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "too short voting period")
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["p1","` + hash + `","3600","yes","yes"]}`)
+	assert.Equal(t, types.ErrTxInvalidPayload, types.ValidateSystemTx(tx), "duplicated options")
+
+	tx.Payload = payload
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "propose failed")
+	assert.Equal(t, "propose", events[0].EventName, "event name")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.Equal(t, types.ErrProposalExists, err, "propose the same id")
+
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","maybe"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "vote for an unknown option")
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p2","yes"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.Equal(t, types.ErrProposalNotFound, err, "vote for an unknown proposal")
+
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","yes","abstain"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "vote failed")
+	otherTx := &types.TxBody{Account: other.ID(), Payload: []byte(`{"Name":"v1voteProposal","Args":["p1","yes"]}`)}
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 3)
+	assert.NoError(t, err, "vote failed")
+	otherTx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","no"]}`)
+	events, err = ExecuteSystemTx(scs, otherTx, other, receiver, 4)
+	assert.NoError(t, err, "vote again failed")
+	assert.Equal(t, "voteProposal", events[0].EventName, "event name")
+
+	result, err := GetProposalResult(scs, "p1")
+	assert.NoError(t, err, "could not get proposal result")
+	votes := map[string]*big.Int{}
+	for _, v := range result.GetVotes() {
+		votes[string(v.GetCandidate())] = v.GetAmountBigInt()
+	}
+	assert.Equal(t, balance2, votes["no"], "the second vote replaces the first one")
+	assert.Equal(t, types.StakingMinimum, votes["yes"], "votes of yes")
+	assert.Equal(t, types.StakingMinimum, votes["abstain"], "votes of abstain")
+
+	finalized, _, err := FinalizeProposals(scs, 1+MinProposalPeriod-1)
+	assert.NoError(t, err, "could not finalize proposals")
+	assert.False(t, finalized, "the voting period is not over")
+	finalized, _, err = FinalizeProposals(scs, 1+MinProposalPeriod)
+	assert.NoError(t, err, "could not finalize proposals")
+	assert.True(t, finalized, "the voting period is over")
+	proposal, err := GetProposal(scs, "p1")
+	assert.NoError(t, err, "could not get proposal")
+	assert.True(t, proposal.Finalized, "proposal should be finalized")
+	assert.Equal(t, "no", proposal.Winner, "winner")
+
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2+MinProposalPeriod)
+	assert.Equal(t, types.ErrProposalClosed, err, "vote after the voting period")
+}
+
+func TestProposalVoteSnapshot(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	staked := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(staked)
+	tx := &types.TxBody{Account: sender.ID(), Amount: staked.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	hash := base58.Encode(make([]byte, types.HashIDLength))
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["p1","` + hash + `","3600","yes","no"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "propose failed")
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","yes"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "voting failed")
+	vote, err := GetProposalVote(scs, "p1", sender.ID())
+	assert.NoError(t, err, "could not get proposal vote")
+	assert.Equal(t, []string{"yes"}, vote.Choices, "choices")
+	assert.Equal(t, staked, vote.Staked, "snapshotted staking")
+	assert.Equal(t, uint64(2), vote.BlockNo, "block of the snapshot")
+
+	tx.Amount = types.StakingMinimum.Bytes()
+	tx.Payload = buildStakingPayload(false)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3)
+	assert.NoError(t, err, "unstaking failed")
+	result, err := GetProposalResult(scs, "p1")
+	assert.NoError(t, err, "could not get proposal result")
+	assert.Equal(t, types.StakingMinimum, result.Votes[0].GetAmountBigInt(), "the tally is reduced by unstaking")
+
+	vote, err = GetProposalVote(scs, "p1", sender.ID())
+	assert.NoError(t, err, "could not get proposal vote")
+	assert.Equal(t, staked, vote.Staked, "the snapshot is kept after unstaking")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","yes"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 4)
+	assert.NoError(t, err, "voting failed")
+	result, err = GetProposalResult(scs, "p1")
+	assert.NoError(t, err, "could not get proposal result")
+	assert.Equal(t, types.StakingMinimum, result.Votes[0].GetAmountBigInt(), "the tally is refreshed by voting again")
+
+	// a vote recorded before the snapshot is migrated
+	other := append([]byte{}, sender.ID()...)
+	other[len(other)-1]++
+	proposal, _ := GetProposal(scs, "p1")
+	legacy := &types.Vote{Candidate: []byte(`["no"]`), Amount: types.StakingMinimum.Bytes()}
+	assert.NoError(t, setVote(scs, proposal.voteKey(), other, legacy), "could not set vote")
+	vote, err = GetProposalVote(scs, "p1", other)
+	assert.NoError(t, err, "could not get proposal vote")
+	assert.Equal(t, []string{"no"}, vote.Choices, "migrated choices")
+	assert.Equal(t, types.StakingMinimum, vote.Power, "migrated voting power")
+	assert.Equal(t, uint64(0), vote.BlockNo, "migrated vote has no snapshot block")
+
+	assert.NoError(t, setProposalVote(scs, proposal, other, vote), "could not set proposal vote")
+	legacy, err = getVote(scs, proposal.voteKey(), other)
+	assert.NoError(t, err, "could not get vote")
+	assert.Nil(t, legacy.Amount, "the legacy vote is removed")
+	vote, err = GetProposalVote(scs, "p1", other)
+	assert.NoError(t, err, "could not get proposal vote")
+	assert.Equal(t, types.StakingMinimum, vote.Staked, "stored in the snapshot layout")
+}
+
+func TestProposalVoteUnstakeRevote(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+
+	staked := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(staked)
+	tx := &types.TxBody{Account: sender.ID(), Amount: staked.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	hash := base58.Encode(make([]byte, types.HashIDLength))
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1propose","Args":["p1","` + hash + `","3600","yes","no"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "propose failed")
+	tx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","yes"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "voting failed")
+
+	// the unstaked amount staked by another account counts only once
+	tx.Amount = staked.Bytes()
+	tx.Payload = buildStakingPayload(false)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3)
+	assert.NoError(t, err, "unstaking failed")
+	vote, err := GetProposalVote(scs, "p1", sender.ID())
+	assert.NoError(t, err, "could not get proposal vote")
+	assert.Equal(t, big.NewInt(0), vote.Power, "counted power of the unstaked account")
+	assert.Equal(t, staked, vote.Staked, "snapshotted staking")
+
+	other.AddBalance(staked)
+	otherTx := &types.TxBody{Account: other.ID(), Amount: staked.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 4)
+	assert.NoError(t, err, "staking failed")
+	otherTx.Amount = nil
+	otherTx.Payload = []byte(`{"Name":"v1voteProposal","Args":["p1","yes"]}`)
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 5)
+	assert.NoError(t, err, "voting failed")
+
+	result, err := GetProposalResult(scs, "p1")
+	assert.NoError(t, err, "could not get proposal result")
+	assert.Equal(t, staked, result.Votes[0].GetAmountBigInt(), "the tally of the option")
+}
@@ -0,0 +1,304 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+)
+
+// DefaultVoterRewardRate is the percentage of the block reward shared by the
+// voters of the block producer until a rate is voted.
+const DefaultVoterRewardRate = 30
+
+// rewardPrecision scales the reward index so that the share of a small vote
+// is not truncated.
+var rewardPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(27), nil)
+
+var rewardIndexKey = []byte("rewardindex")
+var rewardSnapKey = []byte("rewardsnap")
+var claimableKey = []byte("claimable")
+
+// The reward of the voters of a candidate is accounted by a reward index:
+// the cumulative reward per vote (scaled by rewardPrecision) of the
+// candidate. A voter remembers the index of each of its candidates at the
+// last settlement, and the reward accrued since then is its weight for the
+// candidate multiplied by the increase of the index.
+
+// AccrueReward shares the reward of a block produced by the candidate
+// between the candidate and its voters. The share of the candidate is
+// credited to the coinbase account, and nothing is credited if the coinbase
+// is nil. It returns the total amount credited, which the caller must add to
+// the balance of the system account, and false if the candidate has no
+// votes, in which case nothing is accrued.
+func AccrueReward(scs *state.ContractState, candidate, coinbase []byte, reward *big.Int) (*big.Int, bool, error) {
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return nil, false, err
+	}
+	votes := voteResult.rmap[base58.Encode(candidate)]
+	if votes == nil || votes.Sign() <= 0 {
+		return nil, false, nil
+	}
+	rate, err := GetParam(scs, types.VoteVoterRewardRate)
+	if err != nil {
+		return nil, false, err
+	}
+	voterShare := new(big.Int).Mul(reward, rate)
+	voterShare.Div(voterShare, big.NewInt(100))
+	index, err := getRewardIndex(scs, candidate)
+	if err != nil {
+		return nil, false, err
+	}
+	delta := new(big.Int).Mul(voterShare, rewardPrecision)
+	delta.Div(delta, votes)
+	if err = setRewardIndex(scs, candidate, index.Add(index, delta)); err != nil {
+		return nil, false, err
+	}
+	accrued := new(big.Int).Set(voterShare)
+	if coinbase != nil {
+		bpShare := new(big.Int).Sub(reward, voterShare)
+		if err = addClaimable(scs, coinbase, bpShare); err != nil {
+			return nil, false, err
+		}
+		accrued.Add(accrued, bpShare)
+	}
+	return accrued, true, nil
+}
+
+func claimingReward(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	amount, err := getClaimable(scs, sender.ID())
+	if err != nil {
+		return nil, err
+	}
+	if err = scs.DeleteData(append(append([]byte{}, claimableKey...), sender.ID()...)); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(amount)
+	receiver.SubBalance(amount)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "claimReward",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func validateForClaimReward(account []byte, txBody *types.TxBody, scs *state.ContractState) error {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return types.ErrTxInvalidAmount
+	}
+	reward, err := GetReward(scs, account)
+	if err != nil {
+		return err
+	}
+	if reward.Sign() == 0 {
+		return types.ErrNothingToClaim
+	}
+	return nil
+}
+
+// GetReward returns the reward of the account which can be claimed.
+func GetReward(scs *state.ContractState, account []byte) (*big.Int, error) {
+	claimable, err := getClaimable(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	weights, err := rewardWeights(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	pending, _, err := pendingReward(scs, account, weights)
+	if err != nil {
+		return nil, err
+	}
+	return claimable.Add(claimable, pending), nil
+}
+
+// settleReward credits the reward accrued to the voter since the last
+// settlement, including the voting reward, and records the current reward
+// indexes of its candidates. It
+// must be called before any change of the votes or the delegation of the
+// voter, and syncRewardSnapshot after the change. Nothing is recorded before
+// the voter reward feature is active, which keeps the system state of the
+// blocks before the fork.
+func settleReward(scs *state.ContractState, voter []byte, blockNo types.BlockNo) error {
+	if !types.IsFeatureActive(types.FeatureVoterReward, blockNo) {
+		return nil
+	}
+	weights, err := rewardWeights(scs, voter)
+	if err != nil {
+		return err
+	}
+	pending, indexes, err := pendingReward(scs, voter, weights)
+	if err != nil {
+		return err
+	}
+	if err = addClaimable(scs, voter, pending); err != nil {
+		return err
+	}
+	if err = settleVotingReward(scs, voter); err != nil {
+		return err
+	}
+	return setRewardSnapshot(scs, voter, indexes)
+}
+
+// syncRewardSnapshot records the current reward indexes of the candidates of
+// the voter without crediting anything, so that a new candidate accrues
+// reward only from now on. Like settleReward, it records nothing before the
+// voter reward feature is active.
+func syncRewardSnapshot(scs *state.ContractState, voter []byte, blockNo types.BlockNo) error {
+	if !types.IsFeatureActive(types.FeatureVoterReward, blockNo) {
+		return nil
+	}
+	weights, err := rewardWeights(scs, voter)
+	if err != nil {
+		return err
+	}
+	indexes := make(map[string]*big.Int, len(weights))
+	for candidate := range weights {
+		index, err := getRewardIndex(scs, []byte(candidate))
+		if err != nil {
+			return err
+		}
+		indexes[candidate] = index
+	}
+	if err = syncVotingSnapshot(scs, voter); err != nil {
+		return err
+	}
+	return setRewardSnapshot(scs, voter, indexes)
+}
+
+// rewardWeights returns the weight of the voter for each of its candidates:
+// the amount of its BP vote and its delegation.
+func rewardWeights(scs *state.ContractState, voter []byte) (map[string]*big.Int, error) {
+	weights := map[string]*big.Int{}
+	vote, err := getVote(scs, defaultVoteKey, voter)
+	if err != nil {
+		return nil, err
+	}
+	for offset := 0; offset+PeerIDLength <= len(vote.Candidate); offset += PeerIDLength {
+		weights[string(vote.Candidate[offset:offset+PeerIDLength])] = vote.GetAmountBigInt()
+	}
+	delegation, err := getDelegation(scs, voter)
+	if err != nil {
+		return nil, err
+	}
+	if delegation.GetAmountBigInt().Sign() > 0 {
+		candidate := string(delegation.Candidate)
+		if w, exist := weights[candidate]; exist {
+			weights[candidate] = new(big.Int).Add(w, delegation.GetAmountBigInt())
+		} else {
+			weights[candidate] = delegation.GetAmountBigInt()
+		}
+	}
+	return weights, nil
+}
+
+// pendingReward returns the reward accrued to the weights since the last
+// settlement and the current reward indexes of the candidates. A candidate
+// missing in the snapshot has accrued since the beginning of the index,
+// which is the case of a vote cast before the rewards are accounted.
+func pendingReward(scs *state.ContractState, voter []byte, weights map[string]*big.Int) (*big.Int, map[string]*big.Int, error) {
+	snapshot, err := getRewardSnapshot(scs, voter)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := new(big.Int)
+	indexes := make(map[string]*big.Int, len(weights))
+	for candidate, weight := range weights {
+		index, err := getRewardIndex(scs, []byte(candidate))
+		if err != nil {
+			return nil, nil, err
+		}
+		indexes[candidate] = index
+		last, exist := snapshot[candidate]
+		if !exist {
+			last = new(big.Int)
+		}
+		sum.Add(sum, new(big.Int).Mul(weight, new(big.Int).Sub(index, last)))
+	}
+	return sum.Div(sum, rewardPrecision), indexes, nil
+}
+
+func getRewardIndex(scs *state.ContractState, candidate []byte) (*big.Int, error) {
+	data, err := scs.GetData(append(append([]byte{}, rewardIndexKey...), candidate...))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func setRewardIndex(scs *state.ContractState, candidate []byte, index *big.Int) error {
+	return scs.SetData(append(append([]byte{}, rewardIndexKey...), candidate...), index.Bytes())
+}
+
+func getClaimable(scs *state.ContractState, account []byte) (*big.Int, error) {
+	data, err := scs.GetData(append(append([]byte{}, claimableKey...), account...))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func addClaimable(scs *state.ContractState, account []byte, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	claimable, err := getClaimable(scs, account)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(append(append([]byte{}, claimableKey...), account...), claimable.Add(claimable, amount).Bytes())
+}
+
+// setRewardSnapshot encodes each candidate (PeerIDLength bytes), the length
+// of its reward index (1 byte) and the index.
+func setRewardSnapshot(scs *state.ContractState, voter []byte, indexes map[string]*big.Int) error {
+	key := append(append([]byte{}, rewardSnapKey...), voter...)
+	if len(indexes) == 0 {
+		return scs.DeleteData(key)
+	}
+	var candidates [][]byte
+	for candidate := range indexes {
+		candidates = append(candidates, []byte(candidate))
+	}
+	// the order of a map is random but the state must be deterministic
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i], candidates[j]) < 0
+	})
+	var data []byte
+	for _, candidate := range candidates {
+		index := indexes[string(candidate)].Bytes()
+		data = append(data, candidate...)
+		data = append(data, byte(len(index)))
+		data = append(data, index...)
+	}
+	return scs.SetData(key, data)
+}
+
+func getRewardSnapshot(scs *state.ContractState, voter []byte) (map[string]*big.Int, error) {
+	data, err := scs.GetData(append(append([]byte{}, rewardSnapKey...), voter...))
+	if err != nil {
+		return nil, err
+	}
+	snapshot := map[string]*big.Int{}
+	for offset := 0; offset+PeerIDLength+1 <= len(data); {
+		candidate := string(data[offset : offset+PeerIDLength])
+		size := int(data[offset+PeerIDLength])
+		offset += PeerIDLength + 1
+		snapshot[candidate] = new(big.Int).SetBytes(data[offset : offset+size])
+		offset += size
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,151 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+// accrueTo does what the chain does at the end of a block produced by the
+// candidate: it accrues the reward and moves it to the system account.
+func accrueTo(t *testing.T, scs *state.ContractState, receiver *state.V, candidate, coinbase []byte, reward int64) {
+	accrued, ok, err := AccrueReward(scs, candidate, coinbase, big.NewInt(reward))
+	assert.NoError(t, err, "could not accrue reward")
+	assert.True(t, ok, "reward should be accrued")
+	receiver.AddBalance(accrued)
+}
+
+func TestRewardDistribution(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+	coinbase := append([]byte{}, sender.ID()...)
+	coinbase[len(coinbase)-1] += 2
+
+	encoded := newTestCandidate(t)
+	candidate, _ := base58.Decode(encoded)
+
+	_, ok, err := AccrueReward(scs, candidate, coinbase, big.NewInt(1000))
+	assert.NoError(t, err, "could not accrue reward")
+	assert.False(t, ok, "the reward of a candidate without votes is not accrued")
+
+	// the sender votes for the candidate with 3 and the other delegates 1
+	balance3 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	sender.AddBalance(balance3)
+	tx := &types.TxBody{Account: sender.ID(), Amount: balance3.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteBP","Args":["` + encoded + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+
+	balance2 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	other.AddBalance(balance2)
+	otherTx := &types.TxBody{Account: other.ID(), Amount: balance2.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	otherTx.Amount = types.StakingMinimum.Bytes()
+	otherTx.Payload = []byte(`{"Name":"v1delegate","Args":["` + encoded + `"]}`)
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+
+	// 300 of 1000 is shared by the votes of 4: 225 and 75
+	accrueTo(t, scs, receiver, candidate, coinbase, 1000)
+	reward, err := GetReward(scs, sender.ID())
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(225), reward, "reward of the voter")
+	reward, err = GetReward(scs, other.ID())
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(75), reward, "reward of the delegator")
+	reward, err = GetReward(scs, coinbase)
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(700), reward, "reward of the BP")
+
+	// the delegation grows to 2 and the votes to 5: 180 and 120
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 1+StakingDelay)
+	assert.NoError(t, err, "delegation failed")
+	reward, err = GetReward(scs, other.ID())
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(75), reward, "the reward is settled before the delegation")
+	accrueTo(t, scs, receiver, candidate, coinbase, 1000)
+
+	reward, err = GetReward(scs, sender.ID())
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(405), reward, "reward of the voter")
+	reward, err = GetReward(scs, other.ID())
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(195), reward, "reward of the delegator")
+	reward, err = GetReward(scs, coinbase)
+	assert.NoError(t, err, "could not get reward")
+	assert.Equal(t, big.NewInt(1400), reward, "reward of the BP")
+	assert.Equal(t, new(big.Int).Add(new(big.Int).Add(balance3, balance2), big.NewInt(2000)), receiver.Balance(),
+		"the system account keeps the staking and the rewards")
+
+	tx.Payload = []byte(`{"Name":"v1claimReward"}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 2+StakingDelay)
+	assert.NoError(t, err, "claim failed")
+	assert.Equal(t, "claimReward", events[0].EventName, "event name")
+	assert.Equal(t, big.NewInt(405), sender.Balance(), "claimed reward")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3+StakingDelay)
+	assert.Equal(t, types.ErrNothingToClaim, err, "claim twice")
+
+	otherTx.Amount = nil
+	otherTx.Payload = tx.Payload
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 3+StakingDelay)
+	assert.NoError(t, err, "claim failed")
+	assert.Equal(t, big.NewInt(195), other.Balance(), "claimed reward")
+	assert.Equal(t, new(big.Int).Add(new(big.Int).Add(balance3, balance2), big.NewInt(1400)), receiver.Balance(),
+		"the reward of the BP is not claimed yet")
+}
+
+// preRewardRoot is the storage root of the system contract after the txs of
+// TestReplayBeforeVoterReward, as computed by the nodes without the voter
+// reward.
+const preRewardRoot = "6kLU26gdjBRwYEefWVz69jgC2gj3YvppFcKU6VF3KVjm"
+
+func TestReplayBeforeVoterReward(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	sender.AddBalance(types.MaxAER)
+	body := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes()}
+	for _, step := range []struct {
+		payload []byte
+		blockNo types.BlockNo
+	}{
+		{buildStakingPayload(true), 1},
+		{buildVotingPayload(2), 1 + VotingDelay},
+		{buildStakingPayload(true), 1 + VotingDelay + StakingDelay},
+		{buildVotingPayload(1), 1 + 2*VotingDelay + StakingDelay},
+		{buildStakingPayload(false), 1 + 2*VotingDelay + 2*StakingDelay},
+	} {
+		body.Payload = step.payload
+		_, err := ExecuteSystemTx(scs, body, sender, receiver, step.blockNo)
+		assert.NoError(t, err, string(step.payload))
+	}
+
+	states := cdb.GetStateDB()
+	assert.NoError(t, states.StageContractState(scs))
+	assert.NoError(t, states.Update())
+	st, err := states.GetAccountState(types.ToAccountID([]byte(types.AergoSystem)))
+	assert.NoError(t, err)
+	assert.Equal(t, preRewardRoot, enc.ToString(st.StorageRoot), "system root of the replayed txs")
+}
@@ -0,0 +1,345 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/mr-tron/base58"
+)
+
+// DefaultDoubleSignSlashRate is the percentage of the delegated staking
+// slashed for a double sign until a rate is voted.
+const DefaultDoubleSignSlashRate = 5
+
+// DefaultDowntimeSlashRate is the percentage of the delegated staking
+// slashed for a prolonged downtime until a rate is voted.
+const DefaultDowntimeSlashRate = 1
+
+// DowntimeAttestationPeriod is the number of blocks in which the attestations
+// for a downtime of a candidate are accumulated.
+const DowntimeAttestationPeriod = 60 * 60 //block interval
+
+var evidenceKey = []byte("evidence")
+var downtimeKey = []byte("downtime")
+var slashedTotalKey = []byte("slashedtotal")
+
+// Evidence is a validated proof of a misbehaviour of a BP candidate.
+type Evidence struct {
+	Kind      string
+	Candidate []byte
+	// BlockNo is the height of the double signed blocks
+	BlockNo uint64
+}
+
+func slashing(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	evidence := context.Evidence
+	var rate uint64
+	var err error
+	switch evidence.Kind {
+	case types.SlashDoubleSign:
+		if err = scs.SetData(evidenceKeyOf(evidence), []byte{1}); err != nil {
+			return nil, err
+		}
+		if rate, err = GetSlashRate(scs, types.VoteSlashDoubleSign); err != nil {
+			return nil, err
+		}
+	case types.SlashDowntime:
+		attested, err := attestDowntime(scs, evidence.Candidate, sender.ID(), blockNo)
+		if err != nil {
+			return nil, err
+		}
+		if !attested {
+			return &types.Event{
+				ContractAddress: receiver.ID(),
+				EventIdx:        0,
+				EventName:       "attest",
+				JsonArgs: `{"who":"` +
+					types.EncodeAddress(sender.ID()) +
+					`", "candidate":"` + base58.Encode(evidence.Candidate) + `"}`,
+			}, nil
+		}
+		if rate, err = GetSlashRate(scs, types.VoteSlashDowntime); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, types.ErrTxInvalidPayload
+	}
+	slashed, err := slashCandidate(scs, evidence.Candidate, rate, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "slash",
+		JsonArgs: `{"candidate":"` + base58.Encode(evidence.Candidate) +
+			`", "reason":"` + evidence.Kind +
+			`", "rate":` + strconv.FormatUint(rate, 10) +
+			`, "amount":"` + slashed.String() + `"}`,
+	}, nil
+}
+
+func validateForSlash(account []byte, txBody *types.TxBody, scs *state.ContractState,
+	blockNo uint64, ci *types.CallInfo) (*Evidence, error) {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return nil, types.ErrTxInvalidAmount
+	}
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, types.ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	if len(args) == 0 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	switch args[0] {
+	case types.SlashDoubleSign:
+		if len(args) != 3 {
+			return nil, types.ErrTxInvalidPayload
+		}
+		evidence, err := verifyDoubleSign(types.DecodeB64(args[1]), types.DecodeB64(args[2]))
+		if err != nil {
+			return nil, err
+		}
+		used, err := scs.GetData(evidenceKeyOf(evidence))
+		if err != nil {
+			return nil, err
+		}
+		if len(used) != 0 {
+			return nil, types.ErrEvidenceAlreadyUsed
+		}
+		return evidence, nil
+	case types.SlashDowntime:
+		if len(args) != 2 {
+			return nil, types.ErrTxInvalidPayload
+		}
+		candidate, err := base58.Decode(args[1])
+		if err != nil || len(candidate) != PeerIDLength {
+			return nil, types.ErrTxInvalidPayload
+		}
+		staked, err := getStaking(scs, account)
+		if err != nil {
+			return nil, err
+		}
+		if staked.GetAmountBigInt().Sign() == 0 {
+			return nil, types.ErrMustStakeBeforeVote
+		}
+		start, attesters, err := getDowntime(scs, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if start+DowntimeAttestationPeriod > blockNo {
+			for _, attester := range attesters {
+				if bytes.Equal(attester, account) {
+					return nil, types.ErrAlreadyAttested
+				}
+			}
+		}
+		return &Evidence{Kind: types.SlashDowntime, Candidate: candidate}, nil
+	}
+	return nil, types.ErrTxInvalidPayload
+}
+
+// verifyDoubleSign checks that the two block headers are different blocks of
+// the same height signed by the same block producer.
+func verifyDoubleSign(raw1, raw2 []byte) (*Evidence, error) {
+	var blocks [2]*types.Block
+	for i, raw := range [][]byte{raw1, raw2} {
+		var header types.BlockHeader
+		if err := proto.Unmarshal(raw, &header); err != nil {
+			return nil, types.ErrInvalidEvidence
+		}
+		block := &types.Block{Header: &header}
+		if valid, err := block.VerifySign(); err != nil || !valid {
+			return nil, types.ErrInvalidEvidence
+		}
+		blocks[i] = block
+	}
+	if blocks[0].BlockNo() != blocks[1].BlockNo() ||
+		!bytes.Equal(blocks[0].GetHeader().GetChainID(), blocks[1].GetHeader().GetChainID()) ||
+		!bytes.Equal(blocks[0].GetHeader().GetPubKey(), blocks[1].GetHeader().GetPubKey()) ||
+		bytes.Equal(blocks[0].BlockHash(), blocks[1].BlockHash()) {
+		return nil, types.ErrInvalidEvidence
+	}
+	candidate, err := blocks[0].BPID()
+	if err != nil || len(candidate) != PeerIDLength {
+		return nil, types.ErrInvalidEvidence
+	}
+	return &Evidence{
+		Kind:      types.SlashDoubleSign,
+		Candidate: []byte(candidate),
+		BlockNo:   blocks[0].BlockNo(),
+	}, nil
+}
+
+// attestDowntime records the attestation of the account and reports whether
+// the attested staking exceeds two thirds of the total staking.
+func attestDowntime(scs *state.ContractState, candidate, account []byte, blockNo uint64) (bool, error) {
+	start, attesters, err := getDowntime(scs, candidate)
+	if err != nil {
+		return false, err
+	}
+	if start+DowntimeAttestationPeriod <= blockNo {
+		// the previous attestations are expired
+		start, attesters = blockNo, nil
+	}
+	attesters = append(attesters, account)
+
+	attested := new(big.Int)
+	for _, attester := range attesters {
+		staked, err := getStaking(scs, attester)
+		if err != nil {
+			return false, err
+		}
+		attested.Add(attested, staked.GetAmountBigInt())
+	}
+	total, err := GetStakingTotal(scs)
+	if err != nil {
+		return false, err
+	}
+	if new(big.Int).Mul(attested, big.NewInt(3)).Cmp(new(big.Int).Mul(total, big.NewInt(2))) > 0 {
+		return true, setDowntime(scs, candidate, 0, nil)
+	}
+	return false, setDowntime(scs, candidate, start, attesters)
+}
+
+// slashCandidate takes the rate percentage of the staking delegated to the
+// candidate and returns the slashed amount. The slashed amount is kept by the
+// system account and is not staked anymore. The other votes of the delegators
+// are capped to their reduced staking.
+func slashCandidate(scs *state.ContractState, candidate []byte, rate uint64, blockNo types.BlockNo) (*big.Int, error) {
+	delegators, err := GetDelegators(scs, candidate)
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	var remain [][]byte
+	for _, delegator := range delegators {
+		delegation, err := getDelegation(scs, delegator)
+		if err != nil {
+			return nil, err
+		}
+		slashed := new(big.Int).Mul(delegation.GetAmountBigInt(), new(big.Int).SetUint64(rate))
+		slashed.Div(slashed, big.NewInt(100))
+		if slashed.Sign() == 0 {
+			remain = append(remain, delegator)
+			continue
+		}
+		if err = settleReward(scs, delegator, blockNo); err != nil {
+			return nil, err
+		}
+		staked, err := getStaking(scs, delegator)
+		if err != nil {
+			return nil, err
+		}
+		staked.Amount = new(big.Int).Sub(staked.GetAmountBigInt(), slashed).Bytes()
+		if err = setStaking(scs, delegator, staked); err != nil {
+			return nil, err
+		}
+		delegation.Amount = new(big.Int).Sub(delegation.GetAmountBigInt(), slashed).Bytes()
+		if err = setDelegation(scs, delegator, delegation); err != nil {
+			return nil, err
+		}
+		if err = refreshVotes(scs, delegator, staked, blockNo); err != nil {
+			return nil, err
+		}
+		if err = syncRewardSnapshot(scs, delegator, blockNo); err != nil {
+			return nil, err
+		}
+		if len(delegation.Amount) != 0 {
+			remain = append(remain, delegator)
+		}
+		total.Add(total, slashed)
+	}
+	if total.Sign() == 0 {
+		return total, nil
+	}
+	if len(remain) != len(delegators) {
+		if err = setDelegators(scs, candidate, remain); err != nil {
+			return nil, err
+		}
+	}
+	voteResult, err := loadVoteResult(scs, defaultVoteKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = voteResult.SubVote(&types.Vote{Candidate: candidate, Amount: total.Bytes()}); err != nil {
+		return nil, err
+	}
+	if err = voteResult.Sync(scs); err != nil {
+		return nil, err
+	}
+	if err = subTotal(scs, total); err != nil {
+		return nil, err
+	}
+	slashedTotal, err := GetSlashedTotal(scs)
+	if err != nil {
+		return nil, err
+	}
+	if err = scs.SetData(slashedTotalKey, new(big.Int).Add(slashedTotal, total).Bytes()); err != nil {
+		return nil, err
+	}
+	return total, nil
+}
+
+// GetSlashRate returns the active slash rate (percentage) of the vote.
+func GetSlashRate(scs *state.ContractState, vote string) (uint64, error) {
+	rate, err := GetParam(scs, vote)
+	if err != nil {
+		return 0, err
+	}
+	return rate.Uint64(), nil
+}
+
+// GetSlashedTotal returns the total amount slashed from the staking.
+func GetSlashedTotal(scs *state.ContractState) (*big.Int, error) {
+	data, err := scs.GetData(slashedTotalKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func evidenceKeyOf(evidence *Evidence) []byte {
+	key := append(append([]byte{}, evidenceKey...), evidence.Candidate...)
+	return append(key, types.BlockNoToBytes(evidence.BlockNo)...)
+}
+
+func setDowntime(scs *state.ContractState, candidate []byte, start uint64, attesters [][]byte) error {
+	key := append(append([]byte{}, downtimeKey...), candidate...)
+	if len(attesters) == 0 {
+		return scs.DeleteData(key)
+	}
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, start)
+	return scs.SetData(key, append(data, bytes.Join(attesters, nil)...))
+}
+
+func getDowntime(scs *state.ContractState, candidate []byte) (uint64, [][]byte, error) {
+	data, err := scs.GetData(append(append([]byte{}, downtimeKey...), candidate...))
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < 8 {
+		return 0, nil, nil
+	}
+	start := binary.LittleEndian.Uint64(data[:8])
+	var attesters [][]byte
+	for offset := 8; offset+types.AddressLength <= len(data); offset += types.AddressLength {
+		attesters = append(attesters, data[offset:offset+types.AddressLength])
+	}
+	return start, attesters, nil
+}
@@ -0,0 +1,217 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-crypto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func signedHeader(t *testing.T, priv crypto.PrivKey, blockNo uint64, ts int64) string {
+	block := &types.Block{Header: &types.BlockHeader{ChainID: []byte("test"), BlockNo: blockNo, Timestamp: ts}}
+	assert.NoError(t, block.Sign(priv), "could not sign block")
+	raw, err := proto.Marshal(block.GetHeader())
+	assert.NoError(t, err, "could not marshal block header")
+	return types.EncodeB64(raw)
+}
+
+func TestSlashDoubleSign(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	priv, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	pid, _ := peer.IDFromPublicKey(pub)
+	candidate := base58.Encode([]byte(pid))
+
+	balance := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(balance)
+	tx := &types.TxBody{Account: sender.ID(), Amount: balance.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = types.StakingMinimum.Bytes()
+	tx.Payload = []byte(`{"Name":"v1delegate","Args":["` + candidate + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+
+	header1 := signedHeader(t, priv, 10, 1)
+	header2 := signedHeader(t, priv, 10, 2)
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1slash","Args":["doublesign","` + header1 + `","` + header1 + `"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.Equal(t, types.ErrInvalidEvidence, err, "same block is not a double sign")
+
+	tx.Payload = []byte(`{"Name":"v1slash","Args":["doublesign","` + header1 + `","` +
+		signedHeader(t, priv, 11, 2) + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.Equal(t, types.ErrInvalidEvidence, err, "blocks of different heights are not a double sign")
+
+	tx.Payload = []byte(`{"Name":"v1slash","Args":["doublesign","` + header1 + `","` + header2 + `"]}`)
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "slashing failed")
+	assert.Equal(t, "slash", events[0].EventName, "event name")
+
+	slashed := new(big.Int).Div(new(big.Int).Mul(types.StakingMinimum, big.NewInt(DefaultDoubleSignSlashRate)), big.NewInt(100))
+	staked, err := getStaking(scs, sender.ID())
+	assert.NoError(t, err, "could not get staking")
+	assert.Equal(t, new(big.Int).Sub(balance, slashed), staked.GetAmountBigInt(), "staking should be slashed")
+	delegation, err := getDelegation(scs, sender.ID())
+	assert.NoError(t, err, "could not get delegation")
+	assert.Equal(t, new(big.Int).Sub(types.StakingMinimum, slashed), delegation.GetAmountBigInt(), "delegation should be slashed")
+	total, err := GetStakingTotal(scs)
+	assert.NoError(t, err, "could not get staking total")
+	assert.Equal(t, new(big.Int).Sub(balance, slashed), total, "staking total should be slashed")
+	slashedTotal, err := GetSlashedTotal(scs)
+	assert.NoError(t, err, "could not get slashed total")
+	assert.Equal(t, slashed, slashedTotal, "slashed total")
+	result, err := getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err, "could not get vote result")
+	assert.Equal(t, delegation.GetAmountBigInt(), result.GetVotes()[0].GetAmountBigInt(), "votes of the candidate")
+
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3)
+	assert.Equal(t, types.ErrEvidenceAlreadyUsed, err, "evidence should be used once")
+}
+
+func TestSlashRefreshVotes(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	priv, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	pid, _ := peer.IDFromPublicKey(pub)
+	candidate := base58.Encode([]byte(pid))
+
+	balance := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	sender.AddBalance(balance)
+	tx := &types.TxBody{Account: sender.ID(), Amount: balance.Bytes(), Payload: buildStakingPayload(true)}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "voting failed")
+	tx.Amount = types.StakingMinimum.Bytes()
+	tx.Payload = []byte(`{"Name":"v1delegate","Args":["` + candidate + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1slash","Args":["doublesign","` + signedHeader(t, priv, 10, 1) + `","` +
+		signedHeader(t, priv, 10, 2) + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "slashing failed")
+
+	// the weight of the vote of the slashed delegator follows its voting power
+	staked, err := getStaking(scs, sender.ID())
+	assert.NoError(t, err, "could not get staking")
+	assert.True(t, staked.GetAmountBigInt().Cmp(balance) < 0, "staking should be slashed")
+	power, err := votingPower(scs, sender.ID(), staked)
+	assert.NoError(t, err, "could not get voting power")
+	vote, err := GetVote(scs, sender.ID(), []byte(types.VoteNumBP[2:]))
+	assert.NoError(t, err, "could not get vote")
+	assert.Equal(t, power, vote.GetAmountBigInt(), "weight of the vote")
+	result, err := getVoteResult(scs, []byte(types.VoteNumBP[2:]), 1)
+	assert.NoError(t, err, "could not get vote result")
+	assert.Equal(t, power, result.GetVotes()[0].GetAmountBigInt(), "tally of the vote")
+}
+
+func TestSlashDowntime(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	balance2 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	other.AddBalance(balance2)
+	otherTx := &types.TxBody{Account: other.ID(), Amount: balance2.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	_, pub, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	pid, _ := peer.IDFromPublicKey(pub)
+	candidate := base58.Encode([]byte(pid))
+	otherTx.Amount = balance2.Bytes()
+	otherTx.Payload = []byte(`{"Name":"v1delegate","Args":["` + candidate + `"]}`)
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1slash","Args":["downtime","` + candidate + `"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "attestation failed")
+	assert.Equal(t, "attest", events[0].EventName, "one third of the staking is not enough")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3)
+	assert.Equal(t, types.ErrAlreadyAttested, err, "attest twice")
+
+	otherTx.Amount = nil
+	otherTx.Payload = tx.Payload
+	events, err = ExecuteSystemTx(scs, otherTx, other, receiver, 4)
+	assert.NoError(t, err, "attestation failed")
+	assert.Equal(t, "slash", events[0].EventName, "all the staking attested")
+
+	slashed := new(big.Int).Div(new(big.Int).Mul(balance2, big.NewInt(DefaultDowntimeSlashRate)), big.NewInt(100))
+	staked, err := getStaking(scs, other.ID())
+	assert.NoError(t, err, "could not get staking")
+	assert.Equal(t, new(big.Int).Sub(balance2, slashed), staked.GetAmountBigInt(), "staking should be slashed")
+	staked, err = getStaking(scs, sender.ID())
+	assert.NoError(t, err, "could not get staking")
+	assert.Equal(t, types.StakingMinimum, staked.GetAmountBigInt(), "staking without delegation is not slashed")
+
+	events, err = ExecuteSystemTx(scs, tx, sender, receiver, 5)
+	assert.NoError(t, err, "attestations should be cleared after slashing")
+	assert.Equal(t, "attest", events[0].EventName, "new attestation")
+}
+
+func TestSlashRateVote(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	rate, err := GetSlashRate(scs, types.VoteSlashDoubleSign)
+	assert.NoError(t, err, "could not get slash rate")
+	assert.Equal(t, uint64(DefaultDoubleSignSlashRate), rate, "default rate")
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteSlashDoubleSign","Args":["101"]}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "rate should be a percentage")
+	tx.Payload = []byte(`{"Name":"v1voteSlashDoubleSign","Args":["10"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+
+	rate, err = GetSlashRate(scs, types.VoteSlashDoubleSign)
+	assert.NoError(t, err, "could not get slash rate")
+	assert.Equal(t, uint64(DefaultDoubleSignSlashRate), rate, "voted rate is not active yet")
+	_, err = ActivateParams(scs, VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	rate, err = GetSlashRate(scs, types.VoteSlashDoubleSign)
+	assert.NoError(t, err, "could not get slash rate")
+	assert.Equal(t, uint64(10), rate, "voted rate")
+	rate, err = GetSlashRate(scs, types.VoteSlashDowntime)
+	assert.NoError(t, err, "could not get slash rate")
+	assert.Equal(t, uint64(DefaultDowntimeSlashRate), rate, "default rate")
+}
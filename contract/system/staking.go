@@ -8,6 +8,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"math/big"
+	"strconv"
 
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -16,7 +17,7 @@ import (
 var stakingKey = []byte("staking")
 var stakingTotalKey = []byte("stakingtotal")
 
-const StakingDelay = 60 * 60 * 24 //block interval
+const StakingDelay = types.StakingDelay //block interval
 //const StakingDelay = 5
 
 func staking(txBody *types.TxBody, sender, receiver *state.V,
@@ -69,15 +70,31 @@ func unstaking(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contr
 	if err := subTotal(scs, backToBalance); err != nil {
 		return nil, err
 	}
-	sender.AddBalance(backToBalance)
-	receiver.SubBalance(backToBalance)
+	if !types.IsFeatureActive(types.FeatureWithdrawalQueue, blockNo) {
+		sender.AddBalance(backToBalance)
+		receiver.SubBalance(backToBalance)
+		return &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "unstake",
+			JsonArgs: `{"who":"` +
+				types.EncodeAddress(sender.ID()) +
+				`", "amount":"` + txBody.GetAmountBigInt().String() + `"}`,
+		}, nil
+	}
+	// the unstaked amount stays in the system account until it is released
+	release := blockNo + StakingDelay
+	if err := addWithdrawal(scs, sender.ID(), backToBalance, release); err != nil {
+		return nil, err
+	}
 	return &types.Event{
 		ContractAddress: receiver.ID(),
 		EventIdx:        0,
 		EventName:       "unstake",
 		JsonArgs: `{"who":"` +
 			types.EncodeAddress(sender.ID()) +
-			`", "amount":"` + txBody.GetAmountBigInt().String() + `"}`,
+			`", "amount":"` + txBody.GetAmountBigInt().String() +
+			`", "release":` + strconv.FormatUint(release, 10) + `}`,
 	}, nil
 }
 
@@ -16,6 +16,8 @@ import (
 func TestBasicStakingUnstaking(t *testing.T) {
 	scs, sender, receiver := initTest(t)
 	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
 
 	tx := &types.Tx{
 		Body: &types.TxBody{
@@ -37,13 +39,12 @@ func TestBasicStakingUnstaking(t *testing.T) {
 	assert.Equal(t, types.StakingMinimum, total, "total value")
 
 	tx.Body.Payload = []byte(`{"Name":"v1unstake"}`)
-	ci, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay-1)
-	assert.Equal(t, err, types.ErrLessTimeHasPassed, "should be return ErrLessTimeHasPassed")
-
 	ci, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay)
 	assert.NoError(t, err, "should be success")
 	_, err = unstaking(tx.Body, sender, receiver, scs, StakingDelay, ci)
 	assert.NoError(t, err, "should be success")
+	assert.Equal(t, sender.Balance(), types.StakingMinimum, "unstaked amount should be pending")
+	releaseTo(t, scs, sender, receiver, 2*StakingDelay)
 	assert.Equal(t, sender.Balance(), minplusmin, "sender.Balance() cacluation failed")
 	saved, err = getStaking(scs, tx.Body.Account)
 	assert.Equal(t, new(big.Int).SetUint64(0).Bytes(), saved.Amount, "saved staking value")
@@ -72,9 +73,6 @@ func TestStaking1Unstaking2(t *testing.T) {
 		"sender.Balance() should be 'MaxAER - StakingMin' after staking")
 
 	tx.Body.Payload = []byte(`{"Name":"v1unstake"}`)
-	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay-1)
-	assert.Equal(t, err, types.ErrLessTimeHasPassed, "should be return ErrLessTimeHasPassed")
-
 	tx.Body.Amount = new(big.Int).Add(types.StakingMinimum, types.StakingMinimum).Bytes()
 	_, err = ValidateSystemTx(sender.ID(), tx.GetBody(), sender, scs, StakingDelay)
 	assert.Error(t, err, "should return exceed error")
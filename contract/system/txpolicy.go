@@ -0,0 +1,54 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// minMaxPayloadSize is the least maximum payload size, which leaves room for
+// the governance txs.
+const minMaxPayloadSize = 1 << 10
+
+// allowsGovernance rejects the payload types without the governance txs,
+// which would leave the stakers no way to change the policy.
+func allowsGovernance(value *big.Int) error {
+	if value.Uint64()&types.PayloadGovernance == 0 {
+		return types.ErrTxInvalidPayload
+	}
+	return nil
+}
+
+// InitTxPolicy sets the payload policy of the genesis.
+func InitTxPolicy(scs *state.ContractState, policy *types.TxPolicy) error {
+	if policy.MaxPayloadSize != 0 {
+		size := new(big.Int).SetUint64(policy.MaxPayloadSize)
+		if err := InitParam(scs, types.VoteMaxPayloadSize, size); err != nil {
+			return err
+		}
+	}
+	mask, err := policy.Mask()
+	if err != nil {
+		return err
+	}
+	return InitParam(scs, types.VotePayloadTypes, new(big.Int).SetUint64(mask))
+}
+
+// ValidateTxPolicy checks the payload of the tx against the policy active in
+// the system contract.
+func ValidateTxPolicy(scs *state.ContractState, txBody *types.TxBody) error {
+	maxSize, err := GetParam(scs, types.VoteMaxPayloadSize)
+	if err != nil {
+		return err
+	}
+	payloadTypes, err := GetParam(scs, types.VotePayloadTypes)
+	if err != nil {
+		return err
+	}
+	return types.ValidateWithPolicy(txBody, maxSize.Uint64(), payloadTypes.Uint64())
+}
@@ -0,0 +1,55 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxPolicy(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	defer fee.SetParamProvider(nil)
+
+	deploy := &types.TxBody{Type: types.TxType_NORMAL, Payload: make([]byte, 2048)}
+	assert.NoError(t, ValidateTxPolicy(scs, deploy), "allowed by default")
+
+	policy := &types.TxPolicy{MaxPayloadSize: 10, PayloadTypes: []string{"transfer"}}
+	assert.Error(t, InitTxPolicy(scs, policy), "too small maximum payload size")
+	policy.MaxPayloadSize = 1024
+	assert.NoError(t, InitTxPolicy(scs, policy), "could not init tx policy")
+	assert.Equal(t, types.ErrTxPayloadTooLarge, ValidateTxPolicy(scs, deploy), "too large payload")
+	deploy.Payload = deploy.Payload[:1024]
+	assert.Equal(t, types.ErrTxPayloadTypeNotAllowed, ValidateTxPolicy(scs, deploy), "deploy not allowed")
+
+	assert.NoError(t, UpdateFeeParams(scs, 0), "could not update fee parameters")
+	assert.Equal(t, fee.PayloadTxFee(1024+200), fee.PayloadTxFee(4096), "payload charged up to the maximum size")
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{Account: sender.ID(), Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	assert.NoError(t, ValidateTxPolicy(scs, &types.TxBody{Type: types.TxType_GOVERNANCE, Payload: tx.Payload}),
+		"governance allowed")
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1votePayloadTypes","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "governance disallowed")
+	tx.Payload = []byte(`{"Name":"v1votePayloadTypes","Args":["15"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	_, err = ActivateParams(scs, VotingDelay+ParamActivationDelay)
+	assert.NoError(t, err, "could not activate parameters")
+	payloadTypes, err := GetParam(scs, types.VotePayloadTypes)
+	assert.NoError(t, err, "could not get payload types")
+	assert.Equal(t, big.NewInt(15), payloadTypes, "voted payload types")
+	assert.NoError(t, ValidateTxPolicy(scs, deploy), "deploy allowed by the vote")
+}
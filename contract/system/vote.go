@@ -19,12 +19,15 @@ import (
 
 var defaultBpCount int
 
+// maxBpCount is the maximum number of the BPs which can be voted.
+const maxBpCount = 100
+
 var voteKey = []byte("vote")
 var sortKey = []byte("sort")
 
 const PeerIDLength = 39
 
-const VotingDelay = 60 * 60 * 24 //block interval
+const VotingDelay = types.VotingDelay //block interval
 //const VotingDelay = 5
 
 var defaultVoteKey = []byte(types.VoteBP)[2:]
@@ -54,7 +57,11 @@ func voting(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contract
 	if staked.GetAmountBigInt().Cmp(new(big.Int).SetUint64(0)) == 0 {
 		return nil, types.ErrMustStakeBeforeVote
 	}
-	vote := &types.Vote{Amount: staked.GetAmount()}
+	power, err := votingPower(scs, sender.ID(), staked)
+	if err != nil {
+		return nil, err
+	}
+	vote := &types.Vote{Amount: power.Bytes()}
 	args, err := json.Marshal(context.Call.Args)
 	if err != nil {
 		return nil, err
@@ -83,21 +90,33 @@ func voting(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contract
 	if err != nil {
 		return nil, err
 	}
+	if bytes.Equal(key, defaultVoteKey) {
+		if err = setVoteExpiry(scs, sender.ID(), blockNo); err != nil {
+			return nil, err
+		}
+	}
 	return &types.Event{
 		ContractAddress: receiver.ID(),
 		EventIdx:        0,
 		EventName:       context.Call.Name[2:],
 		JsonArgs: `{"who":"` +
-			types.EncodeAddress(txBody.Account) +
+			types.EncodeAddress(sender.ID()) +
 			`", "vote":` + string(args) + `}`,
 	}, nil
 }
 
 func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 	context *SystemContext) error {
-	account := context.Sender.ID()
-	staked := context.Staked
-	stakedAmount := new(big.Int).SetBytes(staked.Amount)
+	return refreshVotes(scs, context.Sender.ID(), context.Staked, context.BlockNo)
+}
+
+// refreshVotes caps the votes of the account to its voting power with the
+// staking, which is reduced by an unstaking, a delegation or a slashing.
+func refreshVotes(scs *state.ContractState, account []byte, staked *types.Staking, blockNo types.BlockNo) error {
+	power, err := votingPower(scs, account, staked)
+	if err != nil {
+		return err
+	}
 	for _, keystr := range types.AllVotes {
 		key := []byte(keystr[2:])
 		oldvote, err := getVote(scs, key, account)
@@ -105,7 +124,7 @@ func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 			return err
 		}
 		if oldvote.Amount == nil ||
-			new(big.Int).SetBytes(oldvote.Amount).Cmp(stakedAmount) <= 0 {
+			new(big.Int).SetBytes(oldvote.Amount).Cmp(power) <= 0 {
 			continue
 		}
 		voteResult, err := loadVoteResult(scs, key)
@@ -115,7 +134,7 @@ func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 		if err = voteResult.SubVote(oldvote); err != nil {
 			return err
 		}
-		oldvote.Amount = staked.GetAmount()
+		oldvote.Amount = power.Bytes()
 		if err = setVote(scs, key, account, oldvote); err != nil {
 			return err
 		}
@@ -125,8 +144,13 @@ func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 		if err = voteResult.Sync(scs); err != nil {
 			return err
 		}
+		if p, ok := params[keystr]; ok {
+			if err = tallyParam(scs, p, blockNo); err != nil {
+				return err
+			}
+		}
 	}
-	return nil
+	return refreshProposalVotes(scs, account, power, blockNo)
 }
 
 //GetVote return amount, to, err
@@ -201,15 +225,46 @@ func InitDefaultBpCount(bpCount int) {
 	defaultBpCount = bpCount
 }
 
+// getDefaultBpCount returns the number of the BPs until VoteNumBP is voted:
+// the number of the genesis BPs, or maxBpCount if the genesis has none.
 func getDefaultBpCount() int {
+	if defaultBpCount == 0 {
+		return maxBpCount
+	}
 	return defaultBpCount
 }
 
-// GetRankers returns the IDs of the top n rankers.
+// GetBpCount returns the number of the BPs, which is the activated value of
+// VoteNumBP.
+func GetBpCount(ar AccountStateReader) (int, error) {
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return 0, err
+	}
+	return getBpCount(scs)
+}
+
+func getBpCount(scs *state.ContractState) (int, error) {
+	n, err := GetParam(scs, types.VoteNumBP)
+	if err != nil {
+		return 0, err
+	}
+	return int(n.Int64()), nil
+}
+
+// GetRankers returns the IDs of the top n rankers, where n is the number of
+// the BPs.
 func GetRankers(ar AccountStateReader) ([]string, error) {
-	n := getDefaultBpCount()
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	n, err := getBpCount(scs)
+	if err != nil {
+		return nil, err
+	}
 
-	vl, err := GetVoteResult(ar, defaultVoteKey, n)
+	vl, err := getVoteResult(scs, defaultVoteKey, n)
 	if err != nil {
 		return nil, err
 	}
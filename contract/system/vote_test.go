@@ -145,9 +145,6 @@ func TestBasicStakingVotingUnstaking(t *testing.T) {
 	assert.Equal(t, types.StakingMinimum.Bytes(), result.GetVotes()[0].Amount, "invalid amount in voting result")
 
 	tx.Body.Payload = buildStakingPayload(false)
-	_, err = ExecuteSystemTx(scs, tx.Body, sender, receiver, VotingDelay)
-	assert.EqualError(t, err, types.ErrLessTimeHasPassed.Error(), "unstaking failed")
-
 	context, err = ValidateSystemTx(tx.Body.Account, tx.Body, sender, scs, VotingDelay+StakingDelay)
 	assert.NoError(t, err, "unstaking failed")
 	_, err = unstaking(tx.Body, sender, receiver, scs, VotingDelay+StakingDelay, context)
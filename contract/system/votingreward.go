@@ -0,0 +1,246 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// The voting reward is paid from the pool account (aergo.vault) to all the
+// voters in proportion to their voting power: the amount of the BP vote and
+// the delegation. The voted amount per block is moved from the pool at every
+// block and accounted by a single reward index, the cumulative reward per
+// voting power. A voter takes a snapshot of its voting power and the index
+// whenever its votes are synchronized, and its reward since then is the
+// voting power of the snapshot multiplied by the increase of the index. A
+// voter joins the distribution at its first system tx after the votes.
+
+var votingIndexKey = []byte("vrindex")
+var votingPowerKey = []byte("vrpower")
+var votingSnapKey = []byte("vrsnap")
+var votingClaimableKey = []byte("vrclaimable")
+
+// DistributeVotingReward distributes the voted reward of a block out of the
+// balance of the pool to the voting power of the snapshots. It returns the
+// amount distributed, which the caller must move from the pool to the system
+// account, and nil if nothing is distributed.
+func DistributeVotingReward(scs *state.ContractState, pool *big.Int) (*big.Int, error) {
+	amount, err := GetParam(scs, types.VoteVotingReward)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Cmp(pool) > 0 {
+		amount = new(big.Int).Set(pool)
+	}
+	if amount.Sign() <= 0 {
+		return nil, nil
+	}
+	total, err := getVotingPowerTotal(scs)
+	if err != nil {
+		return nil, err
+	}
+	if total.Sign() == 0 {
+		return nil, nil
+	}
+	index, err := getVotingIndex(scs)
+	if err != nil {
+		return nil, err
+	}
+	delta := new(big.Int).Mul(amount, rewardPrecision)
+	delta.Div(delta, total)
+	if err = scs.SetData(votingIndexKey, index.Add(index, delta).Bytes()); err != nil {
+		return nil, err
+	}
+	return amount, nil
+}
+
+// VotingRewardEvent returns the event of the reward distributed at a block.
+func VotingRewardEvent(amount *big.Int) *types.Event {
+	return &types.Event{
+		ContractAddress: types.AddressPadding([]byte(types.AergoSystem)),
+		EventIdx:        0,
+		EventName:       "votingReward",
+		JsonArgs: `{"pool":"` + types.AergoVault +
+			`", "amount":"` + amount.String() + `"}`,
+	}
+}
+
+func claiming(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	amount, err := getVotingClaimable(scs, sender.ID())
+	if err != nil {
+		return nil, err
+	}
+	if err = scs.DeleteData(append(append([]byte{}, votingClaimableKey...), sender.ID()...)); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(amount)
+	receiver.SubBalance(amount)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "claim",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "amount":"` + amount.String() + `"}`,
+	}, nil
+}
+
+func validateForClaim(account []byte, txBody *types.TxBody, scs *state.ContractState) error {
+	if txBody.GetAmountBigInt().Sign() != 0 {
+		return types.ErrTxInvalidAmount
+	}
+	reward, err := GetVotingReward(scs, account)
+	if err != nil {
+		return err
+	}
+	if reward.Sign() == 0 {
+		return types.ErrNothingToClaim
+	}
+	return nil
+}
+
+// GetVotingReward returns the voting reward of the account which can be
+// claimed.
+func GetVotingReward(scs *state.ContractState, account []byte) (*big.Int, error) {
+	claimable, err := getVotingClaimable(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := pendingVotingReward(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	return claimable.Add(claimable, pending), nil
+}
+
+// settleVotingReward credits the voting reward accrued to the snapshot of
+// the voter.
+func settleVotingReward(scs *state.ContractState, voter []byte) error {
+	pending, err := pendingVotingReward(scs, voter)
+	if err != nil || pending.Sign() == 0 {
+		return err
+	}
+	claimable, err := getVotingClaimable(scs, voter)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(append(append([]byte{}, votingClaimableKey...), voter...), claimable.Add(claimable, pending).Bytes())
+}
+
+// syncVotingSnapshot takes a snapshot of the current voting power of the
+// voter and the index, and updates the total voting power by the change. It
+// must follow settleVotingReward, whose credit is based on the old snapshot.
+func syncVotingSnapshot(scs *state.ContractState, voter []byte) error {
+	power, err := rewardPower(scs, voter)
+	if err != nil {
+		return err
+	}
+	old, _, err := getVotingSnapshot(scs, voter)
+	if err != nil {
+		return err
+	}
+	if power.Sign() == 0 && old.Sign() == 0 {
+		return nil
+	}
+	total, err := getVotingPowerTotal(scs)
+	if err != nil {
+		return err
+	}
+	total.Add(total, power).Sub(total, old)
+	if err = scs.SetData(votingPowerKey, total.Bytes()); err != nil {
+		return err
+	}
+	key := append(append([]byte{}, votingSnapKey...), voter...)
+	if power.Sign() == 0 {
+		return scs.DeleteData(key)
+	}
+	index, err := getVotingIndex(scs)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(key, encodeVotingSnapshot(power, index))
+}
+
+// rewardPower returns the amount of the BP vote and the delegation of the
+// voter.
+func rewardPower(scs *state.ContractState, voter []byte) (*big.Int, error) {
+	power := new(big.Int)
+	vote, err := getVote(scs, defaultVoteKey, voter)
+	if err != nil {
+		return nil, err
+	}
+	if len(vote.Candidate) != 0 {
+		power.Add(power, vote.GetAmountBigInt())
+	}
+	delegation, err := getDelegation(scs, voter)
+	if err != nil {
+		return nil, err
+	}
+	return power.Add(power, delegation.GetAmountBigInt()), nil
+}
+
+func pendingVotingReward(scs *state.ContractState, voter []byte) (*big.Int, error) {
+	power, last, err := getVotingSnapshot(scs, voter)
+	if err != nil {
+		return nil, err
+	}
+	if power.Sign() == 0 {
+		return power, nil
+	}
+	index, err := getVotingIndex(scs)
+	if err != nil {
+		return nil, err
+	}
+	pending := new(big.Int).Mul(power, index.Sub(index, last))
+	return pending.Div(pending, rewardPrecision), nil
+}
+
+func getVotingIndex(scs *state.ContractState) (*big.Int, error) {
+	data, err := scs.GetData(votingIndexKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func getVotingPowerTotal(scs *state.ContractState) (*big.Int, error) {
+	data, err := scs.GetData(votingPowerKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func getVotingClaimable(scs *state.ContractState, account []byte) (*big.Int, error) {
+	data, err := scs.GetData(append(append([]byte{}, votingClaimableKey...), account...))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// encodeVotingSnapshot encodes the length of the voting power (1 byte), the
+// voting power and the index.
+func encodeVotingSnapshot(power, index *big.Int) []byte {
+	p := power.Bytes()
+	data := append([]byte{byte(len(p))}, p...)
+	return append(data, index.Bytes()...)
+}
+
+func getVotingSnapshot(scs *state.ContractState, voter []byte) (*big.Int, *big.Int, error) {
+	data, err := scs.GetData(append(append([]byte{}, votingSnapKey...), voter...))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return new(big.Int), new(big.Int), nil
+	}
+	size := 1 + int(data[0])
+	return new(big.Int).SetBytes(data[1:size]), new(big.Int).SetBytes(data[size:]), nil
+}
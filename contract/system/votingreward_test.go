@@ -0,0 +1,104 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// distributeTo does what the chain does at every block: it distributes the
+// voting reward out of the pool and moves it to the system account.
+func distributeTo(t *testing.T, scs *state.ContractState, receiver *state.V, pool int64, expected int64) {
+	amount, err := DistributeVotingReward(scs, big.NewInt(pool))
+	assert.NoError(t, err, "could not distribute voting reward")
+	assert.Equal(t, big.NewInt(expected), amount, "distributed amount")
+	receiver.AddBalance(amount)
+}
+
+func TestVotingRewardDistribution(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	assert.NoError(t, setParamState(scs, params[types.VoteVotingReward], &paramState{active: big.NewInt(100)}))
+
+	otherID := append([]byte{}, sender.ID()...)
+	otherID[len(otherID)-1]++
+	other, err := sdb.GetAccountStateV(otherID)
+	assert.NoError(t, err, "could not get test address state")
+
+	amount, err := DistributeVotingReward(scs, big.NewInt(1000))
+	assert.NoError(t, err, "could not distribute voting reward")
+	assert.Nil(t, amount, "nothing is distributed without votes")
+
+	// the sender votes with 3 and the other delegates 1
+	encoded := newTestCandidate(t)
+	balance3 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	sender.AddBalance(balance3)
+	tx := &types.TxBody{Account: sender.ID(), Amount: balance3.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	tx.Amount = nil
+	tx.Payload = []byte(`{"Name":"v1voteBP","Args":["` + encoded + `"]}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, VotingDelay)
+	assert.NoError(t, err, "voting failed")
+
+	balance2 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	other.AddBalance(balance2)
+	otherTx := &types.TxBody{Account: other.ID(), Amount: balance2.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	otherTx.Amount = types.StakingMinimum.Bytes()
+	otherTx.Payload = []byte(`{"Name":"v1delegate","Args":["` + encoded + `"]}`)
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+
+	// 100 is shared by the voting power of 4: 75 and 25
+	distributeTo(t, scs, receiver, 1000, 100)
+	reward, err := GetVotingReward(scs, sender.ID())
+	assert.NoError(t, err, "could not get voting reward")
+	assert.Equal(t, big.NewInt(75), reward, "voting reward of the voter")
+	reward, err = GetVotingReward(scs, other.ID())
+	assert.NoError(t, err, "could not get voting reward")
+	assert.Equal(t, big.NewInt(25), reward, "voting reward of the delegator")
+
+	// the delegation grows to 2 and the voting power to 5: 60 and 40
+	_, err = ExecuteSystemTx(scs, otherTx, other, receiver, 1+StakingDelay)
+	assert.NoError(t, err, "delegation failed")
+	distributeTo(t, scs, receiver, 1000, 100)
+
+	// the pool runs short: 18 and 12 of 30
+	distributeTo(t, scs, receiver, 30, 30)
+	reward, err = GetVotingReward(scs, sender.ID())
+	assert.NoError(t, err, "could not get voting reward")
+	assert.Equal(t, big.NewInt(153), reward, "voting reward of the voter")
+	reward, err = GetVotingReward(scs, other.ID())
+	assert.NoError(t, err, "could not get voting reward")
+	assert.Equal(t, big.NewInt(77), reward, "voting reward of the delegator")
+
+	// the voting reward is claimed apart from the reward of the BP
+	tx.Payload = []byte(`{"Name":"v1claimReward"}`)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2+StakingDelay)
+	assert.Equal(t, types.ErrNothingToClaim, err, "no reward of the BP")
+
+	tx.Payload = []byte(`{"Name":"v1claim"}`)
+	assert.NoError(t, types.ValidateSystemTx(tx), "payload should be valid")
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 2+StakingDelay)
+	assert.NoError(t, err, "claim failed")
+	assert.Equal(t, "claim", events[0].EventName, "event name")
+	assert.Equal(t, big.NewInt(153), sender.Balance(), "claimed voting reward")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 3+StakingDelay)
+	assert.Equal(t, types.ErrNothingToClaim, err, "claim twice")
+
+	assert.Equal(t, new(big.Int).Add(new(big.Int).Add(balance3, balance2), big.NewInt(77)), receiver.Balance(),
+		"the system account keeps the unclaimed voting reward")
+}
@@ -0,0 +1,171 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var withdrawalKey = []byte("withdrawal")
+var releaseKey = []byte("release")
+
+// Release is an unstaked amount which is returned to the balance of the
+// account.
+type Release struct {
+	Account []byte
+	Amount  *big.Int
+}
+
+// addWithdrawal schedules the amount to be returned to the account at the
+// release block.
+func addWithdrawal(scs *state.ContractState, who []byte, amount *big.Int, release types.BlockNo) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	withdrawals, err := getWithdrawals(scs, who)
+	if err != nil {
+		return err
+	}
+	withdrawals.Withdrawals = append(withdrawals.Withdrawals,
+		&types.Withdrawal{Amount: amount.Bytes(), Release: release})
+	if err = setWithdrawals(scs, who, withdrawals); err != nil {
+		return err
+	}
+
+	accounts, err := getReleaseAccounts(scs, release)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if bytes.Equal(account, who) {
+			return nil
+		}
+	}
+	return setReleaseAccounts(scs, release, append(accounts, who))
+}
+
+// ReleaseWithdrawals removes the withdrawals which are released at the block
+// and returns the amounts to be added to the balances. The caller must move
+// the total of the amounts from the system account to the accounts.
+func ReleaseWithdrawals(scs *state.ContractState, blockNo types.BlockNo) ([]*Release, error) {
+	accounts, err := getReleaseAccounts(scs, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+	var releases []*Release
+	for _, account := range accounts {
+		withdrawals, err := getWithdrawals(scs, account)
+		if err != nil {
+			return nil, err
+		}
+		amount := new(big.Int)
+		var pending []*types.Withdrawal
+		for _, w := range withdrawals.GetWithdrawals() {
+			if w.GetRelease() <= blockNo {
+				amount.Add(amount, new(big.Int).SetBytes(w.GetAmount()))
+			} else {
+				pending = append(pending, w)
+			}
+		}
+		withdrawals.Withdrawals = pending
+		if err = setWithdrawals(scs, account, withdrawals); err != nil {
+			return nil, err
+		}
+		if amount.Sign() > 0 {
+			releases = append(releases, &Release{Account: account, Amount: amount})
+		}
+	}
+	if err = setReleaseAccounts(scs, blockNo, nil); err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+// GetWithdrawals returns the unstaked amounts of the account which are not
+// released yet.
+func GetWithdrawals(scs *state.ContractState, address []byte) (*types.WithdrawalList, error) {
+	if address != nil {
+		return getWithdrawals(scs, address)
+	}
+	return nil, errors.New("invalid argument: address should not be nil")
+}
+
+func setWithdrawals(scs *state.ContractState, who []byte, withdrawals *types.WithdrawalList) error {
+	key := append(append([]byte{}, withdrawalKey...), who...)
+	if len(withdrawals.GetWithdrawals()) == 0 {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, serializeWithdrawals(withdrawals))
+}
+
+func getWithdrawals(scs *state.ContractState, who []byte) (*types.WithdrawalList, error) {
+	key := append(append([]byte{}, withdrawalKey...), who...)
+	data, err := scs.GetData(key)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeWithdrawals(data), nil
+}
+
+func releaseKeyOf(blockNo types.BlockNo) []byte {
+	no := make([]byte, 8)
+	binary.BigEndian.PutUint64(no, blockNo)
+	return append(append([]byte{}, releaseKey...), no...)
+}
+
+func setReleaseAccounts(scs *state.ContractState, blockNo types.BlockNo, accounts [][]byte) error {
+	if len(accounts) == 0 {
+		return scs.DeleteData(releaseKeyOf(blockNo))
+	}
+	return scs.SetData(releaseKeyOf(blockNo), bytes.Join(accounts, nil))
+}
+
+func getReleaseAccounts(scs *state.ContractState, blockNo types.BlockNo) ([][]byte, error) {
+	data, err := scs.GetData(releaseKeyOf(blockNo))
+	if err != nil {
+		return nil, err
+	}
+	var accounts [][]byte
+	for offset := 0; offset+types.AddressLength <= len(data); offset += types.AddressLength {
+		accounts = append(accounts, data[offset:offset+types.AddressLength])
+	}
+	return accounts, nil
+}
+
+// serializeWithdrawals encodes each withdrawal as the release block (8
+// bytes), the length of the amount (1 byte) and the amount.
+func serializeWithdrawals(withdrawals *types.WithdrawalList) []byte {
+	var ret []byte
+	for _, w := range withdrawals.GetWithdrawals() {
+		release := make([]byte, 8)
+		binary.LittleEndian.PutUint64(release, w.GetRelease())
+		ret = append(ret, release...)
+		ret = append(ret, byte(len(w.GetAmount())))
+		ret = append(ret, w.GetAmount()...)
+	}
+	return ret
+}
+
+func deserializeWithdrawals(data []byte) *types.WithdrawalList {
+	var withdrawals types.WithdrawalList
+	for offset := 0; offset+9 <= len(data); {
+		release := binary.LittleEndian.Uint64(data[offset : offset+8])
+		size := int(data[offset+8])
+		offset += 9
+		withdrawals.Withdrawals = append(withdrawals.Withdrawals,
+			&types.Withdrawal{Amount: data[offset : offset+size], Release: release})
+		offset += size
+	}
+	return &withdrawals
+}
@@ -0,0 +1,102 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// releaseTo does what the chain does at the block: it returns the released
+// withdrawals from the system account to the sender.
+func releaseTo(t *testing.T, scs *state.ContractState, sender, receiver *state.V, blockNo uint64) {
+	releases, err := ReleaseWithdrawals(scs, blockNo)
+	assert.NoError(t, err, "could not release withdrawals")
+	for _, r := range releases {
+		assert.Equal(t, sender.ID(), r.Account, "released account")
+		sender.AddBalance(r.Amount)
+		receiver.SubBalance(r.Amount)
+	}
+}
+
+func TestUnstakingWithdrawal(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	balance2 := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(balance2)
+	tx := &types.TxBody{
+		Account: sender.ID(),
+		Amount:  balance2.Bytes(),
+		Payload: buildStakingPayload(true),
+	}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	tx.Payload = buildStakingPayload(false)
+	tx.Amount = types.StakingMinimum.Bytes()
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, 1)
+	assert.NoError(t, err, "unstaking right after staking")
+	assert.Contains(t, events[0].JsonArgs, `"release":86401`, "release block in event")
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, 2)
+	assert.NoError(t, err, "second unstaking")
+
+	assert.Equal(t, big.NewInt(0), sender.Balance(), "unstaked amount should not be returned yet")
+	withdrawals, err := GetWithdrawals(scs, sender.ID())
+	assert.NoError(t, err, "could not get withdrawals")
+	assert.Len(t, withdrawals.GetWithdrawals(), 2, "pending withdrawals")
+	assert.Equal(t, uint64(1+StakingDelay), withdrawals.GetWithdrawals()[0].GetRelease(), "release block")
+	assert.Equal(t, types.StakingMinimum.Bytes(), withdrawals.GetWithdrawals()[1].GetAmount(), "pending amount")
+
+	releases, err := ReleaseWithdrawals(scs, StakingDelay)
+	assert.NoError(t, err, "could not release withdrawals")
+	assert.Empty(t, releases, "nothing is released before the release block")
+
+	releaseTo(t, scs, sender, receiver, 1+StakingDelay)
+	assert.Equal(t, types.StakingMinimum, sender.Balance(), "first withdrawal should be released")
+	withdrawals, err = GetWithdrawals(scs, sender.ID())
+	assert.NoError(t, err, "could not get withdrawals")
+	assert.Len(t, withdrawals.GetWithdrawals(), 1, "pending withdrawals")
+
+	releaseTo(t, scs, sender, receiver, 2+StakingDelay)
+	assert.Equal(t, balance2, sender.Balance(), "second withdrawal should be released")
+	assert.Equal(t, big.NewInt(0), receiver.Balance(), "system account should be empty")
+	withdrawals, err = GetWithdrawals(scs, sender.ID())
+	assert.NoError(t, err, "could not get withdrawals")
+	assert.Empty(t, withdrawals.GetWithdrawals(), "pending withdrawals")
+}
+
+func TestUnstakingBeforeWithdrawalQueue(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	sender.AddBalance(types.StakingMinimum)
+	tx := &types.TxBody{
+		Account: sender.ID(),
+		Amount:  types.StakingMinimum.Bytes(),
+		Payload: buildStakingPayload(true),
+	}
+	_, err := ExecuteSystemTx(scs, tx, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	// the unstaking waits for the staking delay and returns the amount at once
+	tx.Payload = buildStakingPayload(false)
+	_, err = ExecuteSystemTx(scs, tx, sender, receiver, StakingDelay-1)
+	assert.Equal(t, types.ErrLessTimeHasPassed, err, "unstaking before the staking delay")
+	events, err := ExecuteSystemTx(scs, tx, sender, receiver, StakingDelay)
+	assert.NoError(t, err, "unstaking failed")
+	assert.NotContains(t, events[0].JsonArgs, "release", "release block in event")
+	assert.Equal(t, types.StakingMinimum, sender.Balance(), "unstaked amount should be returned")
+	withdrawals, err := GetWithdrawals(scs, sender.ID())
+	assert.NoError(t, err, "could not get withdrawals")
+	assert.Empty(t, withdrawals.GetWithdrawals(), "pending withdrawals")
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
@@ -44,8 +45,11 @@ const (
 	maxStateSet       = 20
 	callMaxInstLimit  = C.int(5000000)
 	queryMaxInstLimit = callMaxInstLimit * C.int(10)
-	dbUpdateMaxLimit  = fee.StateDbMaxUpdateSize
-	maxCallDepth      = 5
+	// the check of an authorization contract runs for every tx of the
+	// accounts designating it before the tx pays any fee
+	authCheckMaxInstLimit = callMaxInstLimit / C.int(10)
+	dbUpdateMaxLimit      = fee.StateDbMaxUpdateSize
+	maxCallDepth          = 5
 )
 
 var (
@@ -92,10 +96,15 @@ type StateSet struct {
 	callState         map[types.AccountID]*CallState
 	lastRecoveryEntry *recoveryEntry
 	dbUpdateTotalSize int64
+	gasLimit          uint64
+	gasUsed           uint64
 	seed              *rand.Rand
 	events            []*types.Event
 	eventCount        int32
 	callDepth         int32
+	storageQuota      *big.Int        // the storage quota parameter, read on the first use
+	internalOps       *internalOps    // the operations made by the contracts, nil unless traced
+	indexedEvents     map[int32]int32 // the number of the indexed args by the event index
 }
 
 type recoveryEntry struct {
@@ -123,7 +132,7 @@ type Executor struct {
 }
 
 func init() {
-	ctrLog = log.NewLogger("contract")
+	ctrLog = logctl.NewLogger("contract")
 	lastQueryIndex = ChainService
 	zeroFee = big.NewInt(0)
 }
@@ -194,7 +203,16 @@ func (s *StateSet) usedFee() *big.Int {
 		return zeroFee
 	}
 	size := fee.PaymentDataSize(s.dbUpdateTotalSize)
-	return new(big.Int).Mul(big.NewInt(size), fee.AerPerByte)
+	return new(big.Int).Mul(big.NewInt(size), fee.AerPerByte())
+}
+
+// addIndexedEvents adds the number of the indexed args of the events emitted
+// during the tx to the block state.
+func (s *StateSet) addIndexedEvents() {
+	if len(s.indexedEvents) == 0 {
+		return
+	}
+	s.bs.AddIndexedEvents(s.txHash, s.indexedEvents)
 }
 
 func NewLState() *LState {
@@ -601,9 +619,11 @@ func Call(contractState *state.ContractState, code, contractAddress []byte,
 	curStateSet[stateSet.service] = stateSet
 	ce := newExecutor(contract, contractAddress, stateSet, &ci, stateSet.curContract.amount, false, contractState)
 	defer ce.close()
-	ce.setCountHook(callMaxInstLimit)
+	instLimit := stateSet.instLimit()
+	ce.setCountHook(instLimit)
 
 	ce.call(nil)
+	ce.setGasUsed(instLimit)
 	err = ce.err
 	if err != nil {
 		if dbErr := ce.rollbackToSavepoint(); dbErr != nil {
@@ -652,7 +672,10 @@ func PreCall(ce *Executor, bs *state.BlockState, sender *state.V, contractState
 	stateSet.prevBlockHash = prevBlockHash
 
 	curStateSet[stateSet.service] = stateSet
+	instLimit := stateSet.instLimit()
+	ce.setCountHook(instLimit)
 	ce.call(nil)
+	ce.setGasUsed(instLimit)
 	err = ce.err
 	if err == nil {
 		err = ce.commitCalledContract()
@@ -784,9 +807,11 @@ func Create(contractState *state.ContractState, code, contractAddress []byte,
 		return "", nil, stateSet.usedFee(), nil
 	}
 	defer ce.close()
-	ce.setCountHook(callMaxInstLimit)
+	instLimit := stateSet.instLimit()
+	ce.setCountHook(instLimit)
 
 	ce.call(nil)
+	ce.setGasUsed(instLimit)
 	err = ce.err
 	if err != nil {
 		logger.Warn().Msg("constructor is failed")
@@ -63,7 +63,11 @@ func LuaSetDB(L *LState, service *C.int, key *C.char, value *C.char) *C.char {
 		return C.CString("[System.LuaSetDB] set not permitted in query")
 	}
 	val := []byte(C.GoString(value))
-	if err := stateSet.curContract.callState.ctrState.SetData([]byte(C.GoString(key)), val); err != nil {
+	useGas(L, gasDbSet+C.int(len(val))*gasStatePerByte)
+	if err := setContractData(stateSet, []byte(C.GoString(key)), val); err != nil {
+		if err == types.ErrStorageQuotaExceeded {
+			C.luaL_setuncatchablerror(L)
+		}
 		return C.CString(err.Error())
 	}
 	if err := addUpdateSize(stateSet, int64(types.HashIDLength+len(val))); err != nil {
@@ -79,6 +83,7 @@ func LuaGetDB(L *LState, service *C.int, key *C.char, blkno *C.char) (*C.char, *
 	if stateSet == nil {
 		return nil, C.CString("[System.LuaGetDB] contract state not found")
 	}
+	useGas(L, gasDbGet)
 	if blkno != nil {
 		bigNo, _ := new(big.Int).SetString(strings.TrimSpace(C.GoString(blkno)), 10)
 		if bigNo == nil || bigNo.Sign() < 0 {
@@ -88,6 +93,9 @@ func LuaGetDB(L *LState, service *C.int, key *C.char, blkno *C.char) (*C.char, *
 
 		chainBlockHeight := stateSet.blockHeight
 		if chainBlockHeight == 0 {
+			if stateSet.cdb == nil {
+				return nil, C.CString("[System.LuaGetDB] no chain to get the block")
+			}
 			bestBlock, err := stateSet.cdb.GetBestBlock()
 			if err != nil {
 				return nil, C.CString("[System.LuaGetDB] get best block error")
@@ -139,7 +147,8 @@ func LuaDelDB(L *LState, service *C.int, key *C.char) *C.char {
 	if stateSet.isQuery {
 		return C.CString("[System.LuaDelDB] delete not permitted in query")
 	}
-	if err := stateSet.curContract.callState.ctrState.DeleteData([]byte(C.GoString(key))); err != nil {
+	useGas(L, gasDbDel)
+	if err := deleteContractData(stateSet, []byte(C.GoString(key))); err != nil {
 		return C.CString(err.Error())
 	}
 	if err := addUpdateSize(stateSet, int64(32)); err != nil {
@@ -205,7 +214,7 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 		return -1, C.CString("[Contract.LuaCallContract] contract state not found")
 	}
 	contractAddress := C.GoString(contractId)
-	cid, err := getAddressNameResolved(contractAddress, stateSet.bs)
+	cid, err := getAddressNameResolved(contractAddress, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaCallContract] invalid contractId: " + err.Error())
 	}
@@ -257,6 +266,7 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 			return -1, C.CString("[System.LuaCallContract] database error: " + err.Error())
 		}
 	}
+	stateSet.beginInternalOp(internalOpCall, prevContractInfo.contractId, cid, fnameStr, argsStr, amountBig)
 	stateSet.curContract = newContractInfo(callState, prevContractInfo.contractId, cid,
 		callState.curState.SqlRecoveryPoint, amountBig)
 
@@ -264,6 +274,7 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 	defer setInstCount(L, ce.L)
 
 	ret := ce.call(L)
+	stateSet.endInternalOp(ce.err)
 	if ce.err != nil {
 		stateSet.curContract = prevContractInfo
 		return -1, C.CString("[Contract.LuaCallContract] call err: " + ce.err.Error())
@@ -291,7 +302,7 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs)
+	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid contractId: " + err.Error())
 	}
@@ -331,19 +342,21 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
 	defer setInstCount(L, ce.L)
 
+	stateSet.beginInternalOp(internalOpDelegateCall, stateSet.curContract.contractId, cid, fnameStr, argsStr, nil)
 	ret := ce.call(L)
+	stateSet.endInternalOp(ce.err)
 	if ce.err != nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] call error: " + ce.err.Error())
 	}
 	return ret, nil
 }
 
-func getAddressNameResolved(account string, bs *state.BlockState) ([]byte, error) {
+func getAddressNameResolved(account string, bs *state.BlockState, blockNo types.BlockNo) ([]byte, error) {
 	accountLen := len(account)
 	if accountLen == types.EncodedAddressLength {
 		return types.DecodeAddress(account)
 	} else if accountLen == types.NameLength {
-		cid := name.Resolve(bs, []byte(account))
+		cid := name.Resolve(bs, []byte(account), blockNo)
 		if cid == nil {
 			return nil, errors.New("name not founded :" + account)
 		}
@@ -365,7 +378,7 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 	if stateSet.isQuery == true && amountBig.Cmp(zeroBig) > 0 {
 		return C.CString("[Contract.LuaSendAmount] send not permitted in query")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return C.CString("[Contract.LuaSendAmount] invalid contractId: " + err.Error())
 	}
@@ -410,6 +423,7 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 			}
 		}
 		prevContractInfo := stateSet.curContract
+		stateSet.beginInternalOp(internalOpSend, prevContractInfo.contractId, cid, ci.Name, "", amountBig)
 		stateSet.curContract = newContractInfo(callState, prevContractInfo.contractId, cid,
 			callState.curState.SqlRecoveryPoint, amountBig)
 
@@ -417,6 +431,7 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 		defer setInstCount(L, ce.L)
 
 		ce.call(L)
+		stateSet.endInternalOp(ce.err)
 		if ce.err != nil {
 			stateSet.curContract = prevContractInfo
 			return C.CString("[Contract.LuaSendAmount] call err: " + ce.err.Error())
@@ -434,6 +449,8 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 	if stateSet.lastRecoveryEntry != nil {
 		_ = setRecoveryPoint(aid, stateSet, senderState, callState, amountBig, true)
 	}
+	stateSet.beginInternalOp(internalOpSend, stateSet.curContract.contractId, cid, "", "", amountBig)
+	stateSet.endInternalOp(nil)
 	return nil
 }
 
@@ -548,7 +565,7 @@ func LuaGetBalance(L *LState, service *C.int, contractId *C.char) (*C.char, *C.c
 	if contractId == nil {
 		return C.CString(stateSet.curContract.callState.ctrState.GetBalanceBigInt().String()), nil
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return nil, C.CString("[Contract.LuaGetBalance] invalid contractId: " + err.Error())
 	}
@@ -659,6 +676,7 @@ func checkHexString(data string) bool {
 //export LuaCryptoSha256
 func LuaCryptoSha256(L *LState, arg unsafe.Pointer, argLen C.int) (*C.char, *C.char) {
 	data := C.GoBytes(arg, argLen)
+	useGas(L, gasCrypto)
 	if checkHexString(string(data)) {
 		dataStr := data[2:]
 		var err error
@@ -845,7 +863,7 @@ func LuaDeployContract(
 	// get code
 	var code []byte
 
-	cid, err := getAddressNameResolved(contractStr, bs)
+	cid, err := getAddressNameResolved(contractStr, bs, stateSet.blockHeight)
 	if err == nil {
 		aid := types.ToAccountID(cid)
 		contractState, err := getOnlyContractState(stateSet, aid)
@@ -876,6 +894,7 @@ func LuaDeployContract(
 	if err != nil {
 		return -1, C.CString("[Contract.LuaDeployContract]:" + err.Error())
 	}
+	useGas(L, C.int(len(code))*gasStatePerByte)
 
 	// create account
 	prevContractInfo := stateSet.curContract
@@ -947,15 +966,19 @@ func LuaDeployContract(
 
 	addr := C.CString(types.EncodeAddress(newContract.ID()))
 	ret := C.int(1)
+	stateSet.beginInternalOp(internalOpDeploy, prevContractInfo.contractId, newContract.ID(), "constructor", argsStr, amountBig)
 	if ce != nil {
 		ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
 		defer setInstCount(L, ce.L)
 
 		ret += ce.call(L)
+		stateSet.endInternalOp(ce.err)
 		if ce.err != nil {
 			stateSet.curContract = prevContractInfo
 			return -1, C.CString("[Contract.LuaDeployContract] call err:" + ce.err.Error())
 		}
+	} else {
+		stateSet.endInternalOp(nil)
 	}
 	stateSet.curContract = prevContractInfo
 	return ret, addr
@@ -980,7 +1003,7 @@ func LuaRandomInt(min, max, service C.int) C.int {
 }
 
 //export LuaEvent
-func LuaEvent(L *LState, service *C.int, eventName *C.char, args *C.char) *C.char {
+func LuaEvent(L *LState, service *C.int, eventName *C.char, args *C.char, indexed C.int) *C.char {
 	stateSet := curStateSet[*service]
 	if stateSet.isQuery == true {
 		return C.CString("[Contract.Event] event not permitted in query")
@@ -994,6 +1017,16 @@ func LuaEvent(L *LState, service *C.int, eventName *C.char, args *C.char) *C.cha
 	if len(C.GoString(args)) > maxEventArgSize {
 		return C.CString(fmt.Sprintf("[Contract.Event] exceeded the maximum length of event args(%d)", maxEventArgSize))
 	}
+	if indexed < 0 || indexed > types.MaxIndexedEventArgs {
+		return C.CString(fmt.Sprintf("[Contract.Event] exceeded the maximum number of indexed args(%d)", types.MaxIndexedEventArgs))
+	}
+	useGas(L, gasEvent+indexed*gasEventIndex)
+	if indexed > 0 {
+		if stateSet.indexedEvents == nil {
+			stateSet.indexedEvents = make(map[int32]int32)
+		}
+		stateSet.indexedEvents[stateSet.eventCount] = int32(indexed)
+	}
 	stateSet.events = append(
 		stateSet.events,
 		&types.Event{
@@ -1013,7 +1046,7 @@ func LuaIsContract(L *LState, service *C.int, contractId *C.char) (C.int, *C.cha
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaIsContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaIsContract] invalid contractId: " + err.Error())
 	}
@@ -1032,6 +1065,7 @@ func LuaGovernance(L *LState, service *C.int, gType C.char, arg *C.char) *C.char
 	if stateSet == nil {
 		return C.CString("[Contract.LuaGovernance] contract state not found")
 	}
+	useGas(L, gasGovernance)
 	var amountBig *big.Int
 	var payload []byte
 
@@ -1087,11 +1121,41 @@ func LuaGovernance(L *LState, service *C.int, gType C.char, arg *C.char) *C.char
 	stateSet.events = append(stateSet.events, evs...)
 
 	if stateSet.lastRecoveryEntry != nil {
+		// the unstaked amount is not moved until it is released, unless the
+		// withdrawal queue is not active yet
 		if gType == 'S' {
 			_ = setRecoveryPoint(aid, stateSet, senderState, scsState, amountBig, true)
-		} else if gType == 'U' {
+		} else if gType == 'U' && !types.IsFeatureActive(types.FeatureWithdrawalQueue, stateSet.blockHeight) {
 			_ = setRecoveryPoint(aid, stateSet, scsState.curState, stateSet.curContract.callState, amountBig, true)
 		}
 	}
 	return nil
 }
+
+//export LuaFeeDelegation
+func LuaFeeDelegation(L *LState, service *C.int, perAccount *C.char, perBlock *C.char) *C.char {
+	stateSet := curStateSet[*service]
+	if stateSet == nil {
+		return C.CString("[Contract.LuaFeeDelegation] contract state not found")
+	}
+	if stateSet.isQuery == true {
+		return C.CString("[Contract.LuaFeeDelegation] fee delegation not permitted in query")
+	}
+	if !types.IsFeatureActive(types.FeatureFeeDelegation, stateSet.blockHeight) {
+		return C.CString("[Contract.LuaFeeDelegation] " + types.ErrTxFeeDelegationNotActive.Error())
+	}
+	perAccountBig, err := transformAmount(C.GoString(perAccount))
+	if err != nil {
+		return C.CString("[Contract.LuaFeeDelegation] invalid amount: " + err.Error())
+	}
+	perBlockBig, err := transformAmount(C.GoString(perBlock))
+	if err != nil {
+		return C.CString("[Contract.LuaFeeDelegation] invalid amount: " + err.Error())
+	}
+	useGas(L, gasDbSet)
+	fd := &FeeDelegation{PerAccount: perAccountBig, PerBlock: perBlockBig}
+	if err := setFeeDelegation(stateSet.curContract.callState.ctrState, fd); err != nil {
+		return C.CString("[Contract.LuaFeeDelegation] error: " + err.Error())
+	}
+	return nil
+}
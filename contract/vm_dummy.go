@@ -30,6 +30,8 @@ type DummyChain struct {
 	blockIds      []types.BlockID
 	blocks        []*types.Block
 	testReceiptDB db.DB
+	internalOps   []*types.InternalOperations // of the txs of the last block
+	lastState     *state.BlockState
 }
 
 var addressRegexp *regexp.Regexp
@@ -434,7 +436,9 @@ func (l *luaTxCall) run(bs *state.BlockState, bc *DummyChain, blockNo uint64, ts
 			stateSet := NewContext(bs, bc, sender, contract, eContractState, sender.ID(),
 				l.hash(), blockNo, ts, prevBlockHash, "", true,
 				false, contract.State().SqlRecoveryPoint, ChainService, l.luaTxCommon.amount)
+			stateSet.traceInternalOps()
 			rv, evs, _, err := Call(eContractState, l.code, l.contract, stateSet)
+			stateSet.addInternalOps()
 			if err != nil {
 				r := types.NewReceipt(l.contract, err.Error(), "")
 				r.TxHash = l.hash()
@@ -442,6 +446,7 @@ func (l *luaTxCall) run(bs *state.BlockState, bc *DummyChain, blockNo uint64, ts
 				receiptTx.Set(l.hash(), b)
 				return err
 			}
+			stateSet.addIndexedEvents()
 			_ = bs.StageContractState(eContractState)
 			r := types.NewReceipt(l.contract, "SUCCESS", rv)
 			r.Events = evs
@@ -487,6 +492,8 @@ func (bc *DummyChain) ConnectBlock(txs ...luaTx) error {
 	if err != nil {
 		return err
 	}
+	bc.internalOps = blockState.InternalOperations()
+	bc.lastState = blockState
 	//FIXME newblock must be created after sdb.apply()
 	bc.cBlock.SetBlocksRootHash(bc.sdb.GetRoot())
 	bc.bestBlockNo = bc.bestBlockNo + 1
@@ -1592,6 +1592,141 @@ func TestContractCall(t *testing.T) {
 	}
 }
 
+func TestInternalOperations(t *testing.T) {
+	callee := `
+	function inc()
+		system.setItem("count", (system.getItem("count") or 0) + 1)
+	end
+	function fail()
+		error("failed")
+	end
+	abi.register(inc, fail)
+	abi.payable(inc)
+	`
+	caller := `
+	function call(addr)
+		contract.call.value(10)(addr, "inc")
+		pcall(contract.call, addr, "fail")
+		contract.send(system.getSender(), 1)
+	end
+	abi.register(call)
+	abi.payable(call)
+	`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "callee", 0, callee),
+		NewLuaTxDef("ktlee", "caller", 0, caller),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	tx := NewLuaTxCall("ktlee", "caller", 20,
+		fmt.Sprintf(`{"Name":"call", "Args":["%s"]}`, types.EncodeAddress(strHash("callee"))))
+	if err = bc.ConnectBlock(tx); err != nil {
+		t.Fatal(err)
+	}
+	if len(bc.internalOps) != 1 || !bytes.Equal(bc.internalOps[0].TxHash, tx.hash()) {
+		t.Fatalf("internal operations of the tx not recorded: %v", bc.internalOps)
+	}
+	ops := bc.internalOps[0].Operations
+	if len(ops) != 3 {
+		t.Fatalf("expected 3 internal operations, got %d", len(ops))
+	}
+	if ops[0].Op != "call" || ops[0].Function != "inc" || !bytes.Equal(ops[0].Caller, strHash("caller")) ||
+		!bytes.Equal(ops[0].Callee, strHash("callee")) || new(big.Int).SetBytes(ops[0].Amount).Int64() != 10 ||
+		ops[0].Status != "SUCCESS" {
+		t.Errorf("unexpected call: %v", ops[0])
+	}
+	if ops[1].Function != "fail" || ops[1].Status != "ERROR" || !strings.Contains(ops[1].Error, "failed") {
+		t.Errorf("unexpected failed call: %v", ops[1])
+	}
+	if ops[2].Op != "send" || !bytes.Equal(ops[2].Callee, strHash("ktlee")) || ops[2].Status != "SUCCESS" {
+		t.Errorf("unexpected send: %v", ops[2])
+	}
+}
+
+func TestIndexedEvent(t *testing.T) {
+	code := `
+	function transfer(to, amount)
+		contract.event("plain", to, amount)
+		contract.indexed_event("transfer", 2, to, amount, "memo")
+	end
+	function tooMany()
+		contract.indexed_event("transfer", 4, 1, 2, 3, 4)
+	end
+	abi.register(transfer, tooMany)
+	`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "event", 0, code),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	tx := NewLuaTxCall("ktlee", "event", 0, `{"Name":"transfer", "Args":["bob", 10]}`)
+	if err = bc.ConnectBlock(tx); err != nil {
+		t.Fatal(err)
+	}
+	if n := bc.lastState.IndexedEventArgs(tx.hash(), 0); n != 0 {
+		t.Errorf("plain event indexed: %d", n)
+	}
+	if n := bc.lastState.IndexedEventArgs(tx.hash(), 1); n != 2 {
+		t.Errorf("expected 2 indexed args, got %d", n)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "event", 0, `{"Name":"tooMany"}`).Fail("exceeded the maximum number of indexed args"),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	code := `
+	function check_authorization(account, hash, sign)
+		return sign == "0x0102"
+	end
+	abi.register_view(check_authorization)
+	`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "auth", 0, code),
+		NewLuaTxDef("ktlee", "loop", 0, `function check_authorization() while true do end end abi.register(check_authorization)`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	account := strHash("ktlee")
+	checked, err := checkAuthorization(strHash("auth"), bc.newBState(), bc, account, []byte{0}, []byte{1, 2})
+	if !checked || err != nil {
+		t.Errorf("not authorized: %v", err)
+	}
+	checked, err = checkAuthorization(strHash("auth"), bc.newBState(), bc, account, []byte{0}, []byte{1, 3})
+	if !checked || err != types.ErrTxNotAuthorized {
+		t.Errorf("authorized with another sign: %v", err)
+	}
+	checked, err = checkAuthorization(strHash("loop"), bc.newBState(), bc, account, []byte{0}, []byte{1, 2})
+	if !checked || err == nil {
+		t.Error("expected the instruction limit exceeded")
+	}
+	checked, err = checkAuthorization(strHash("nocontract"), bc.newBState(), bc, account, []byte{0}, []byte{1, 2})
+	if checked || err != nil {
+		t.Errorf("checked without a contract: %v", err)
+	}
+}
+
 func TestSparseTable(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
@@ -4152,4 +4287,69 @@ func TestSnapshot(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestFeeDelegation(t *testing.T) {
+	src := `
+function constructor()
+	contract.fee_delegation(40, 60)
+end
+
+function stop()
+	contract.fee_delegation(0, 0)
+end
+
+abi.payable(constructor)
+abi.register(stop)
+`
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "fd", 50, src),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+
+	ktlee, other := strHash("ktlee"), strHash("other")
+	blockNo := bc.BestBlockNo() + 1
+	cState, err := bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash("fd")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := func(account []byte, maxFee int64, blockNo types.BlockNo, expected error) {
+		if err := CheckFeeDelegation(cState, account, big.NewInt(maxFee), blockNo); err != expected {
+			t.Errorf("check fee delegation of %d: expected %v, got %v", maxFee, expected, err)
+		}
+	}
+	check(ktlee, 51, blockNo, types.ErrInsufficientBalance)
+	check(ktlee, 41, blockNo, types.ErrFeeDelegationBudget)
+	check(ktlee, 40, blockNo, nil)
+
+	if err := ChargeFeeDelegation(cState, ktlee, big.NewInt(30), blockNo); err != nil {
+		t.Fatal(err)
+	}
+	check(ktlee, 11, blockNo, types.ErrFeeDelegationBudget)
+	check(other, 31, blockNo, types.ErrFeeDelegationBudget)
+	check(other, 30, blockNo, nil)
+	check(ktlee, 40, blockNo+1, nil)
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "fd", 0, `{"Name":"stop"}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	cState, err = bc.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(strHash("fd")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(ktlee, 1, blockNo, types.ErrNotAllowedFeeDelegation)
+}
+
 // end of test-cases
@@ -0,0 +1,60 @@
+package fee
+
+import (
+	"math/big"
+)
+
+// baseFeeChangeDenominator bounds the change of the fee per byte between two
+// blocks to 1/8 of it.
+const baseFeeChangeDenominator = 8
+
+var (
+	dynamicFee bool
+)
+
+func EnableDynamicFee() {
+	dynamicFee = true
+}
+
+// IsDynamicFeeEnabled reports whether the fee per byte adjusts every block
+// to how full the parent block is.
+func IsDynamicFeeEnabled() bool {
+	return dynamicFee
+}
+
+// NextAerPerByte returns the fee per byte of the block following a block of
+// the size charged by the parent fee per byte. The fee rises when the block
+// is larger than the target size and falls when it is smaller, by up to
+// 1/baseFeeChangeDenominator of the parent fee, but never below the floor.
+func NextAerPerByte(parent, floor *big.Int, size, targetSize uint64) *big.Int {
+	next := new(big.Int).Set(parent)
+	if targetSize == 0 || size == targetSize {
+		return maxBig(next, floor)
+	}
+	var diff uint64
+	if size > targetSize {
+		diff = size - targetSize
+	} else {
+		diff = targetSize - size
+	}
+	delta := new(big.Int).Mul(parent, new(big.Int).SetUint64(diff))
+	delta.Div(delta, new(big.Int).SetUint64(targetSize))
+	delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+	if size > targetSize {
+		// a full block raises the fee even if the fee is too small to move
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		next.Add(next, delta)
+	} else {
+		next.Sub(next, delta)
+	}
+	return maxBig(next, floor)
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return new(big.Int).Set(b)
+	}
+	return a
+}
@@ -0,0 +1,75 @@
+package fee
+
+import (
+	"math/big"
+)
+
+// TxKind classifies a tx by the way its fee is charged.
+type TxKind int
+
+const (
+	TxTransfer TxKind = iota
+	TxCall
+	TxDeploy
+	TxGovernance
+)
+
+var txKindNames = [...]string{"transfer", "call", "deploy", "governance"}
+
+func (k TxKind) String() string {
+	if k < 0 || int(k) >= len(txKindNames) {
+		return "unknown"
+	}
+	return txKindNames[k]
+}
+
+// TxBody is the part of a tx body which decides its fee.
+type TxBody interface {
+	GetPayload() []byte
+	GetGasLimit() uint64
+	GetGasPrice() []byte
+	FeeKind() TxKind
+}
+
+// Estimation is the range of the fee charged to a tx. MinFee is charged when
+// the tx updates no state, which is the exact fee of a transfer. MaxFee is
+// the fee the sender must afford for the tx to be accepted.
+type Estimation struct {
+	Kind   TxKind
+	MinFee *big.Int
+	MaxFee *big.Int
+	// IntrinsicGas, GasLimit and GasPrice are set when the fee is metered by
+	// gas. A zero gas price of the tx is estimated at the minimum gas price.
+	IntrinsicGas uint64
+	GasLimit     uint64
+	GasPrice     *big.Int
+}
+
+// Estimate returns the fee range of the tx under the active fee parameters.
+func Estimate(body TxBody) *Estimation {
+	return EstimateWith(getParams(), body)
+}
+
+// EstimateWith returns the fee range of the tx under the given fee
+// parameters.
+func EstimateWith(p ParamProvider, body TxBody) *Estimation {
+	e := &Estimation{Kind: body.FeeKind(), MinFee: zero, MaxFee: zero}
+	if e.Kind == TxGovernance || IsZeroFee() {
+		return e
+	}
+	payloadSize := len(body.GetPayload())
+	if IsGasFeeEnabled() {
+		e.GasPrice = new(big.Int).SetBytes(body.GetGasPrice())
+		if e.GasPrice.Sign() == 0 {
+			e.GasPrice = p.GasPrice()
+		}
+		e.IntrinsicGas = IntrinsicGas(payloadSize)
+		e.GasLimit = GasLimit(body.GetGasLimit())
+		e.MinFee = GasFee(e.IntrinsicGas, e.GasPrice)
+		e.MaxFee = GasFee(e.GasLimit, e.GasPrice)
+		return e
+	}
+	e.MinFee = payloadTxFee(p, payloadSize)
+	e.MaxFee = maxPayloadTxFee(p, payloadSize)
+	return e
+}
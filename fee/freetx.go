@@ -0,0 +1,68 @@
+package fee
+
+import (
+	"time"
+)
+
+const freeTxPeriod = int64(24 * time.Hour)
+
+var (
+	freeTxCount uint64
+	freeTxBytes uint64
+)
+
+// SetFreeTxQuota lets every account send up to count txs and bytes payload
+// bytes a day without fee. A zero limit leaves that measure unlimited, and
+// both zero disable the free tier.
+func SetFreeTxQuota(count, bytes uint64) {
+	freeTxCount = count
+	freeTxBytes = bytes
+}
+
+// IsFreeTxEnabled reports whether accounts have a free-tx quota.
+func IsFreeTxEnabled() bool {
+	return !IsZeroFee() && (freeTxCount != 0 || freeTxBytes != 0)
+}
+
+// FreeTxDay returns the day of the block timestamp (in nanoseconds), over
+// which the free-tx quota is counted.
+func FreeTxDay(ts int64) uint64 {
+	return uint64(ts / freeTxPeriod)
+}
+
+// FreeTxUsage is the part of its free-tx quota an account used in a day.
+type FreeTxUsage struct {
+	Day   uint64
+	Count uint64
+	Bytes uint64
+}
+
+// Covers reports whether a tx with the payload size sent in the day is
+// within the free-tx quota.
+func (u *FreeTxUsage) Covers(day uint64, payloadSize int) bool {
+	if !IsFreeTxEnabled() {
+		return false
+	}
+	used := u.at(day)
+	if freeTxCount != 0 && used.Count+1 > freeTxCount {
+		return false
+	}
+	if freeTxBytes != 0 && used.Bytes+uint64(payloadSize) > freeTxBytes {
+		return false
+	}
+	return true
+}
+
+// Add returns the usage after a free tx with the payload size is sent in
+// the day.
+func (u *FreeTxUsage) Add(day uint64, payloadSize int) *FreeTxUsage {
+	used := u.at(day)
+	return &FreeTxUsage{Day: day, Count: used.Count + 1, Bytes: used.Bytes + uint64(payloadSize)}
+}
+
+func (u *FreeTxUsage) at(day uint64) FreeTxUsage {
+	if u == nil || u.Day != day {
+		return FreeTxUsage{Day: day}
+	}
+	return *u
+}
@@ -0,0 +1,62 @@
+package fee
+
+import (
+	"math/big"
+)
+
+const (
+	// TxGas is the intrinsic gas charged to every contract tx. At the default
+	// gas price it equals the base tx fee of the byte-size fee model.
+	TxGas             = 400
+	payloadGasPerByte = 1
+	// MaxGasLimit is the largest gas limit a tx may request. A zero gas limit
+	// stands for MaxGasLimit.
+	MaxGasLimit = 50000000
+)
+
+var (
+	gasFee bool
+)
+
+func EnableGasFee() {
+	gasFee = true
+}
+
+// IsGasFeeEnabled reports whether contract txs are charged by the gas they
+// use instead of by the size of their payload and state updates.
+func IsGasFeeEnabled() bool {
+	return gasFee
+}
+
+// IntrinsicGas returns the gas charged to a tx before any contract code runs.
+func IntrinsicGas(payloadSize int) uint64 {
+	return TxGas + uint64(PaymentDataSize(int64(payloadSize)))*payloadGasPerByte
+}
+
+// GasLimit returns the gas limit in effect for the requested one.
+func GasLimit(limit uint64) uint64 {
+	if limit == 0 || limit > MaxGasLimit {
+		return MaxGasLimit
+	}
+	return limit
+}
+
+// ValidateGasLimit checks that the requested gas limit covers the intrinsic
+// gas of a tx with the given payload size.
+func ValidateGasLimit(limit uint64, payloadSize int) bool {
+	if limit > MaxGasLimit {
+		return false
+	}
+	return IntrinsicGas(payloadSize) <= GasLimit(limit)
+}
+
+func GasFee(gasUsed uint64, gasPrice *big.Int) *big.Int {
+	if IsZeroFee() {
+		return zero
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice)
+}
+
+func MaxGasFee(limit uint64, gasPrice *big.Int) *big.Int {
+	return GasFee(GasLimit(limit), gasPrice)
+}
@@ -0,0 +1,80 @@
+package fee
+
+import (
+	"math/big"
+	"sync"
+)
+
+// ParamProvider supplies the fee parameters in effect. The parameters are
+// decided by the governance votes of the chain.
+type ParamProvider interface {
+	// AerPerByte returns the fee per byte of payload and state updates.
+	AerPerByte() *big.Int
+	// BaseTxFee returns the fee charged to every tx.
+	BaseTxFee() *big.Int
+	// GasPrice returns the minimum price of gas.
+	GasPrice() *big.Int
+	// MaxPayloadSize returns the maximum size of a payload, beyond which
+	// the payload is not charged.
+	MaxPayloadSize() int64
+}
+
+type defaultParams struct{}
+
+func (defaultParams) AerPerByte() *big.Int {
+	return DefaultAerPerByte()
+}
+
+func (defaultParams) BaseTxFee() *big.Int {
+	return DefaultBaseTxFee()
+}
+
+func (defaultParams) GasPrice() *big.Int {
+	return DefaultAerPerByte()
+}
+
+func (defaultParams) MaxPayloadSize() int64 {
+	return DefaultMaxPayloadSize
+}
+
+var (
+	paramLock sync.RWMutex
+	params    ParamProvider = defaultParams{}
+)
+
+// SetParamProvider replaces the source of the fee parameters. nil restores
+// the default parameters.
+func SetParamProvider(p ParamProvider) {
+	paramLock.Lock()
+	defer paramLock.Unlock()
+	if p == nil {
+		p = defaultParams{}
+	}
+	params = p
+}
+
+func getParams() ParamProvider {
+	paramLock.RLock()
+	defer paramLock.RUnlock()
+	return params
+}
+
+func DefaultAerPerByte() *big.Int {
+	return big.NewInt(aerPerByte)
+}
+
+func DefaultBaseTxFee() *big.Int {
+	return new(big.Int).Set(baseTxAergo)
+}
+
+func AerPerByte() *big.Int {
+	return getParams().AerPerByte()
+}
+
+func BaseTxFee() *big.Int {
+	return getParams().BaseTxFee()
+}
+
+func GasPrice() *big.Int {
+	return getParams().GasPrice()
+}
@@ -7,24 +7,23 @@ import (
 const (
 	baseTxFee            = "2000000000000000" // 0.002 AERGO
 	aerPerByte           = 5000000000000      // 5,000 GAER, feePerBytes * PayloadMaxBytes = 1 AERGO
-	payloadMaxSize       = 200 * 1024
-	StateDbMaxUpdateSize = payloadMaxSize
+	StateDbMaxUpdateSize = 200 * 1024
 	freeByteSize         = 200
+
+	// DefaultMaxPayloadSize is the maximum size of a payload until the
+	// chain sets another one.
+	DefaultMaxPayloadSize = 200 * 1024
 )
 
 var (
-	baseTxAergo   *big.Int
-	zeroFee       bool
-	stateDbMaxFee *big.Int
-	zero          *big.Int
-	AerPerByte    *big.Int
+	baseTxAergo *big.Int
+	zeroFee     bool
+	zero        *big.Int
 )
 
 func init() {
 	baseTxAergo, _ = new(big.Int).SetString(baseTxFee, 10)
 	zeroFee = false
-	AerPerByte = big.NewInt(aerPerByte)
-	stateDbMaxFee = new(big.Int).Mul(AerPerByte, big.NewInt(StateDbMaxUpdateSize-freeByteSize))
 	zero = big.NewInt(0)
 }
 
@@ -37,30 +36,42 @@ func IsZeroFee() bool {
 }
 
 func PayloadTxFee(payloadSize int) *big.Int {
+	return payloadTxFee(getParams(), payloadSize)
+}
+
+func payloadTxFee(p ParamProvider, payloadSize int) *big.Int {
 	if IsZeroFee() {
 		return zero
 	}
 	size := PaymentDataSize(int64(payloadSize))
-	if size > payloadMaxSize {
-		size = payloadMaxSize
+	if maxSize := p.MaxPayloadSize(); size > maxSize {
+		size = maxSize
 	}
 	return new(big.Int).Add(
-		baseTxAergo,
+		p.BaseTxFee(),
 		new(big.Int).Mul(
-			AerPerByte,
+			p.AerPerByte(),
 			big.NewInt(size),
 		),
 	)
 }
 
 func MaxPayloadTxFee(payloadSize int) *big.Int {
+	return maxPayloadTxFee(getParams(), payloadSize)
+}
+
+func maxPayloadTxFee(p ParamProvider, payloadSize int) *big.Int {
 	if IsZeroFee() {
 		return zero
 	}
 	if payloadSize == 0 {
-		return baseTxAergo
+		return p.BaseTxFee()
 	}
-	return new(big.Int).Add(PayloadTxFee(payloadSize), stateDbMaxFee)
+	return new(big.Int).Add(payloadTxFee(p, payloadSize), stateDbMaxFee(p))
+}
+
+func stateDbMaxFee(p ParamProvider) *big.Int {
+	return new(big.Int).Mul(p.AerPerByte(), big.NewInt(StateDbMaxUpdateSize-freeByteSize))
 }
 
 func PaymentDataSize(dataSize int64) int64 {
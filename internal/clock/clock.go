@@ -0,0 +1,103 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package clock is the source of the time for the timers of the consensus
+// and the mempool. It is the system clock by default, and replaced by a
+// virtual clock in the simulation mode, so that the scenarios taking hours of
+// block intervals run in seconds.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time and makes the timers.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers the ticks of a clock at the intervals.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+// Real is the clock of the system.
+var Real Clock = realClock{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t realTicker) Stop() {
+	t.ticker.Stop()
+}
+
+var (
+	clockLock sync.RWMutex
+	clock     = Real
+)
+
+// Set replaces the clock of the node. It must be set before the services
+// start their timers.
+func Set(c Clock) {
+	clockLock.Lock()
+	defer clockLock.Unlock()
+	clock = c
+}
+
+// Get returns the clock of the node.
+func Get() Clock {
+	clockLock.RLock()
+	defer clockLock.RUnlock()
+	return clock
+}
+
+// Now returns the current time of the clock of the node.
+func Now() time.Time {
+	return Get().Now()
+}
+
+// Since returns the time elapsed since t by the clock of the node.
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// After waits for d to elapse by the clock of the node and then sends the
+// time on the returned channel.
+func After(d time.Duration) <-chan time.Time {
+	return Get().After(d)
+}
+
+// Sleep pauses until d elapses by the clock of the node.
+func Sleep(d time.Duration) {
+	<-After(d)
+}
+
+// NewTicker returns a ticker of the clock of the node.
+func NewTicker(d time.Duration) Ticker {
+	return Get().NewTicker(d)
+}
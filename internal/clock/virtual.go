@@ -0,0 +1,202 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package clock
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Virtual is a clock whose time moves only by Advance or AdvanceToNext. The
+// timers passed by a move fire in the order of their deadlines, each with
+// the time set to its deadline.
+type Virtual struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers timerHeap
+	seq    uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+type virtualTimer struct {
+	deadline time.Time
+	period   time.Duration // 0 for a one-shot timer
+	seq      uint64        // orders the timers of the same deadline
+	index    int           // in the heap, -1 if not scheduled
+	c        chan time.Time
+}
+
+type timerHeap []*virtualTimer
+
+func (h timerHeap) Len() int { return len(h) }
+
+func (h timerHeap) Less(i, j int) bool {
+	if h[i].deadline.Equal(h[j].deadline) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].deadline.Before(h[j].deadline)
+}
+
+func (h timerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *timerHeap) Push(x interface{}) {
+	t := x.(*virtualTimer)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *timerHeap) Pop() interface{} {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	t.index = -1
+	*h = old[:len(old)-1]
+	return t
+}
+
+// NewVirtual returns a virtual clock starting at start.
+func NewVirtual(start time.Time) *Virtual {
+	return &Virtual{now: start, quit: make(chan struct{})}
+}
+
+// Now returns the current virtual time.
+func (v *Virtual) Now() time.Time {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	return v.now
+}
+
+// After returns a channel receiving the time when d elapses.
+func (v *Virtual) After(d time.Duration) <-chan time.Time {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if d <= 0 {
+		c := make(chan time.Time, 1)
+		c <- v.now
+		return c
+	}
+	return v.schedule(d, 0).c
+}
+
+// NewTicker returns a ticker of the period d. Like time.Ticker, the ticks
+// are dropped for a slow receiver.
+func (v *Virtual) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	return &virtualTicker{clock: v, timer: v.schedule(d, d)}
+}
+
+func (v *Virtual) schedule(d time.Duration, period time.Duration) *virtualTimer {
+	v.seq++
+	t := &virtualTimer{
+		deadline: v.now.Add(d),
+		period:   period,
+		seq:      v.seq,
+		c:        make(chan time.Time, 1),
+	}
+	heap.Push(&v.timers, t)
+	return t
+}
+
+// Advance moves the time forward by d, firing the timers passed.
+func (v *Virtual) Advance(d time.Duration) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.advanceTo(v.now.Add(d))
+}
+
+// AdvanceToNext moves the time to the earliest deadline of the timers, and
+// reports false if there is no timer.
+func (v *Virtual) AdvanceToNext() bool {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if len(v.timers) == 0 {
+		return false
+	}
+	v.advanceTo(v.timers[0].deadline)
+	return true
+}
+
+func (v *Virtual) advanceTo(target time.Time) {
+	for len(v.timers) > 0 && !v.timers[0].deadline.After(target) {
+		t := heap.Pop(&v.timers).(*virtualTimer)
+		v.now = t.deadline
+
+		select {
+		case t.c <- v.now:
+		default:
+		}
+
+		if t.period > 0 {
+			v.seq++
+			t.deadline = t.deadline.Add(t.period)
+			t.seq = v.seq
+			heap.Push(&v.timers, t)
+		}
+	}
+	if target.After(v.now) {
+		v.now = target
+	}
+}
+
+// Run moves the time to the next deadline every step of the system clock
+// until Stop. The step gives the goroutines woken by a timer the time to
+// react before the next one fires.
+func (v *Virtual) Run(step time.Duration) {
+	v.wg.Add(1)
+	go func() {
+		defer v.wg.Done()
+		ticker := time.NewTicker(step)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				v.AdvanceToNext()
+			case <-v.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops moving the time by Run.
+func (v *Virtual) Stop() {
+	close(v.quit)
+	v.wg.Wait()
+}
+
+type virtualTicker struct {
+	clock *Virtual
+	timer *virtualTimer
+}
+
+func (t *virtualTicker) C() <-chan time.Time {
+	return t.timer.c
+}
+
+func (t *virtualTicker) Stop() {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+
+	if t.timer.index >= 0 {
+		heap.Remove(&t.clock.timers, t.timer.index)
+	}
+}
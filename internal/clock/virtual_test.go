@@ -0,0 +1,96 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Unix(1000, 0)
+
+func received(c <-chan time.Time) (time.Time, bool) {
+	select {
+	case t := <-c:
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestVirtualAfter(t *testing.T) {
+	v := NewVirtual(start)
+
+	c := v.After(time.Second)
+	v.Advance(999 * time.Millisecond)
+	_, ok := received(c)
+	assert.False(t, ok, "fired early")
+
+	v.Advance(time.Second)
+	at, ok := received(c)
+	assert.True(t, ok)
+	assert.Equal(t, start.Add(time.Second), at, "fired at the deadline")
+	assert.Equal(t, start.Add(1999*time.Millisecond), v.Now())
+
+	at, ok = received(v.After(0))
+	assert.True(t, ok, "non-positive duration fires at once")
+	assert.Equal(t, v.Now(), at)
+}
+
+func TestVirtualTicker(t *testing.T) {
+	v := NewVirtual(start)
+	ticker := v.NewTicker(time.Second)
+
+	for i := 1; i <= 3; i++ {
+		assert.True(t, v.AdvanceToNext())
+		at, ok := received(ticker.C())
+		assert.True(t, ok)
+		assert.Equal(t, start.Add(time.Duration(i)*time.Second), at)
+	}
+
+	// ticks are dropped for a slow receiver
+	v.Advance(10 * time.Second)
+	_, ok := received(ticker.C())
+	assert.True(t, ok)
+	_, ok = received(ticker.C())
+	assert.False(t, ok)
+
+	ticker.Stop()
+	assert.False(t, v.AdvanceToNext(), "stopped ticker")
+}
+
+func TestVirtualOrder(t *testing.T) {
+	v := NewVirtual(start)
+	late := v.After(2 * time.Hour)
+	early := v.After(time.Hour)
+
+	assert.True(t, v.AdvanceToNext())
+	_, ok := received(early)
+	assert.True(t, ok)
+	_, ok = received(late)
+	assert.False(t, ok)
+	assert.Equal(t, start.Add(time.Hour), v.Now())
+}
+
+func TestVirtualRun(t *testing.T) {
+	v := NewVirtual(start)
+	defer Set(Real)
+	Set(v)
+
+	v.Run(time.Millisecond)
+	defer v.Stop()
+
+	// a day of the virtual time passes at once
+	done := make(chan struct{})
+	go func() {
+		Sleep(24 * time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("virtual sleep didn't end")
+	}
+	assert.True(t, Since(start) >= 24*time.Hour)
+}
@@ -0,0 +1,183 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package diskmon monitors the free space of the data directory, so that the
+// node cuts down its writes as the space runs short and stops accepting new
+// blocks before the db fails to write them.
+package diskmon
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/internal/nodeevent"
+)
+
+// Level is the shortage of the disk space.
+type Level int32
+
+// The levels of the shortage, each of which includes the actions of the lower
+// ones
+const (
+	// LevelNormal is enough space
+	LevelNormal Level = iota
+	// LevelWarn only warns
+	LevelWarn
+	// LevelPause pauses the writes not essential to the chain, such as the
+	// indexes
+	LevelPause
+	// LevelStop stops accepting new blocks
+	LevelStop
+)
+
+var levelNames = [...]string{"normal", "warn", "pause", "stop"}
+
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return "unknown"
+	}
+	return levelNames[l]
+}
+
+// Thresholds are the free bytes below which the levels are reached. A zero
+// threshold disables its level.
+type Thresholds struct {
+	Warn  uint64
+	Pause uint64
+	Stop  uint64
+}
+
+// Enabled returns whether any level is enabled.
+func (t Thresholds) Enabled() bool {
+	return t.Warn != 0 || t.Pause != 0 || t.Stop != 0
+}
+
+func (t Thresholds) levelOf(free uint64) Level {
+	switch {
+	case free < t.Stop:
+		return LevelStop
+	case free < t.Pause:
+		return LevelPause
+	case free < t.Warn:
+		return LevelWarn
+	default:
+		return LevelNormal
+	}
+}
+
+var logger = logctl.NewLogger("diskmon")
+
+// Monitor checks the free space of a directory periodically.
+type Monitor struct {
+	// free is the first for the 64-bit alignment of its atomic accesses
+	free  uint64
+	level int32
+
+	path       string
+	thresholds Thresholds
+	usage      func(path string) (total uint64, free uint64, err error)
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewMonitor returns a monitor of the file system containing path.
+func NewMonitor(path string, thresholds Thresholds) *Monitor {
+	return &Monitor{
+		path:       path,
+		thresholds: thresholds,
+		usage:      Usage,
+		quit:       make(chan struct{}),
+	}
+}
+
+// Level returns the level by the last check. A nil monitor is always at
+// LevelNormal.
+func (m *Monitor) Level() Level {
+	if m == nil {
+		return LevelNormal
+	}
+	return Level(atomic.LoadInt32(&m.level))
+}
+
+// Free returns the free bytes by the last check.
+func (m *Monitor) Free() uint64 {
+	if m == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&m.free)
+}
+
+// Check updates the level by the current free space, and reports the change
+// of the level. The level is kept if the free space is not read.
+func (m *Monitor) Check() Level {
+	_, free, err := m.usage(m.path)
+	if err != nil {
+		logger.Debug().Err(err).Str("path", m.path).Msg("failed to get the disk usage")
+		return m.Level()
+	}
+	atomic.StoreUint64(&m.free, free)
+
+	level := m.thresholds.levelOf(free)
+	old := Level(atomic.SwapInt32(&m.level, int32(level)))
+	if level != old {
+		m.report(old, level, free)
+	}
+	return level
+}
+
+func (m *Monitor) report(old Level, level Level, free uint64) {
+	var msg string
+	switch level {
+	case LevelNormal:
+		msg = "disk space recovered"
+	case LevelWarn:
+		msg = "disk space is running short"
+	case LevelPause:
+		msg = "disk space is short, the indexing is paused"
+	case LevelStop:
+		msg = "disk space is exhausted, new blocks are not accepted"
+	}
+	ev := logger.Warn()
+	if level < old {
+		ev = logger.Info()
+	}
+	ev.Str("path", m.path).Uint64("free", free).Str("level", level.String()).Msg(msg)
+
+	nodeevent.Publish(nodeevent.CategoryDisk, "DISK_"+strings.ToUpper(level.String()), msg, map[string]interface{}{
+		"path":      m.path,
+		"free":      free,
+		"level":     level.String(),
+		"prevLevel": old.String(),
+	})
+}
+
+// Start checks the free space now and then every interval until Stop.
+func (m *Monitor) Start(interval time.Duration) {
+	m.Check()
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.Check()
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic checks.
+func (m *Monitor) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
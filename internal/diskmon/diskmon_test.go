@@ -0,0 +1,60 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package diskmon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitorLevels(t *testing.T) {
+	var free uint64
+	var usageErr error
+	m := NewMonitor("data", Thresholds{Warn: 300, Pause: 200, Stop: 100})
+	m.usage = func(string) (uint64, uint64, error) {
+		return 1000, free, usageErr
+	}
+
+	for _, tc := range []struct {
+		free  uint64
+		level Level
+	}{
+		{500, LevelNormal},
+		{300, LevelNormal},
+		{299, LevelWarn},
+		{150, LevelPause},
+		{99, LevelStop},
+		{0, LevelStop},
+		{250, LevelWarn},
+		{1000, LevelNormal},
+	} {
+		free = tc.free
+		assert.Equal(t, tc.level, m.Check(), "free %d", tc.free)
+		assert.Equal(t, tc.level, m.Level())
+		assert.Equal(t, tc.free, m.Free())
+	}
+
+	// the level is kept while the usage is not read
+	free = 10
+	m.Check()
+	usageErr = errors.New("statfs failed")
+	free = 1000
+	assert.Equal(t, LevelStop, m.Check())
+}
+
+func TestMonitorDisabledLevels(t *testing.T) {
+	m := NewMonitor("data", Thresholds{Stop: 100})
+	m.usage = func(string) (uint64, uint64, error) {
+		return 1000, 150, nil
+	}
+	assert.Equal(t, LevelNormal, m.Check())
+	assert.False(t, Thresholds{}.Enabled())
+
+	var nilMon *Monitor
+	assert.Equal(t, LevelNormal, nilMon.Level())
+}
@@ -0,0 +1,17 @@
+// @copyright defined in aergo/LICENSE.txt
+
+// +build !windows
+
+package diskmon
+
+import "syscall"
+
+// Usage returns the total and the available bytes of the file system
+// containing path.
+func Usage(path string) (total uint64, free uint64, err error) {
+	var st syscall.Statfs_t
+	if err = syscall.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+	return uint64(st.Blocks) * uint64(st.Bsize), uint64(st.Bavail) * uint64(st.Bsize), nil
+}
@@ -0,0 +1,9 @@
+// @copyright defined in aergo/LICENSE.txt
+
+package diskmon
+
+import "errors"
+
+func Usage(path string) (total uint64, free uint64, err error) {
+	return 0, 0, errors.New("disk usage is not supported on windows")
+}
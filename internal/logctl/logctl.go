@@ -0,0 +1,102 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package logctl keeps the loggers of the modules to change their levels at
+// runtime.
+package logctl
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aergoio/aergo-lib/log"
+	"github.com/rs/zerolog"
+)
+
+var (
+	lock    sync.RWMutex
+	modules = make(map[string]*levelSampler)
+)
+
+// levelSampler drops the events below its level. The level of the zerolog
+// logger can't be changed without racing with the writers of the events, so
+// the registered loggers log at every level and filter the events by the
+// sampler.
+type levelSampler struct {
+	level int32
+}
+
+func (s *levelSampler) Sample(lvl zerolog.Level) bool {
+	return lvl >= zerolog.Level(atomic.LoadInt32(&s.level))
+}
+
+// NewLogger returns a logger of the module like log.NewLogger, of which the
+// level can be changed by SetLevel. The loggers of a module share the level.
+// IsDebugEnabled of the logger keeps reporting the configured level.
+func NewLogger(module string) *log.Logger {
+	logger := log.NewLogger(module)
+	level, err := zerolog.ParseLevel(logger.Level())
+	if err != nil {
+		level = zerolog.InfoLevel
+	}
+
+	lock.Lock()
+	s, exists := modules[module]
+	if !exists {
+		s = &levelSampler{level: int32(level)}
+		modules[module] = s
+	}
+	lock.Unlock()
+
+	zLogger := logger.Logger.Level(zerolog.DebugLevel).Sample(s)
+	logger.Logger = &zLogger
+	return logger
+}
+
+// SetLevel sets the level of the loggers of the module, or of all the modules
+// if module is empty.
+func SetLevel(module, level string) error {
+	zLevel, err := zerolog.ParseLevel(level)
+	if err != nil || level == "" {
+		return fmt.Errorf("invalid log level: %s", level)
+	}
+	lock.RLock()
+	defer lock.RUnlock()
+	if module == "" {
+		for _, s := range modules {
+			atomic.StoreInt32(&s.level, int32(zLevel))
+		}
+		return nil
+	}
+	s, exists := modules[module]
+	if !exists {
+		return fmt.Errorf("unknown logger: %s", module)
+	}
+	atomic.StoreInt32(&s.level, int32(zLevel))
+	return nil
+}
+
+// Level is the level of the loggers of a module.
+type Level struct {
+	Module string
+	Level  string
+}
+
+// Levels returns the levels of the modules sorted by their names.
+func Levels() []Level {
+	lock.RLock()
+	defer lock.RUnlock()
+	levels := make([]Level, 0, len(modules))
+	for module, s := range modules {
+		levels = append(levels, Level{
+			Module: module,
+			Level:  zerolog.Level(atomic.LoadInt32(&s.level)).String(),
+		})
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Module < levels[j].Module })
+	return levels
+}
@@ -0,0 +1,30 @@
+package logctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLevel(t *testing.T) {
+	logger := NewLogger("logctltest")
+	other := NewLogger("logctltest")
+	assert.True(t, logger.Info().Enabled())
+	assert.False(t, logger.Debug().Enabled())
+
+	assert.NoError(t, SetLevel("logctltest", "debug"))
+	assert.True(t, logger.Debug().Enabled())
+	assert.True(t, other.Debug().Enabled(), "the loggers of a module share the level")
+	child := logger.With().Str("key", "value").Logger()
+	assert.True(t, child.Debug().Enabled())
+
+	assert.NoError(t, SetLevel("", "error"))
+	assert.False(t, logger.Warn().Enabled())
+	assert.True(t, logger.Error().Enabled())
+	assert.False(t, child.Warn().Enabled())
+	assert.Contains(t, Levels(), Level{Module: "logctltest", Level: "error"})
+
+	assert.Error(t, SetLevel("logctltest", "verbose"))
+	assert.Error(t, SetLevel("logctltest", ""))
+	assert.Error(t, SetLevel("unknown", "info"))
+}
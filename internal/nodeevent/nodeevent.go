@@ -0,0 +1,171 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package nodeevent delivers the significant events of the node, such as
+// reorgs, membership changes, governance activations and the shortage of the
+// disk space, to the sinks configured for their categories.
+package nodeevent
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/logctl"
+)
+
+// Categories of the node events
+const (
+	CategoryReorg      = "reorg"
+	CategoryMembership = "membership"
+	CategoryGovernance = "governance"
+	CategoryDisk       = "disk"
+)
+
+// sinkQueueSize is the number of the events waiting for a sink, beyond which
+// the events are dropped not to block the publishers.
+const sinkQueueSize = 256
+
+var logger = logctl.NewLogger("nodeevent")
+
+// Event is a significant event of the node.
+type Event struct {
+	Time     time.Time              `json:"time"`
+	Category string                 `json:"category"`
+	Type     string                 `json:"type"`
+	Message  string                 `json:"message"`
+	Fields   map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Sink writes the events somewhere out of the node.
+type Sink interface {
+	Write(ev *Event) error
+	Close() error
+}
+
+// sinkQueue passes the events of its categories to the sink in its own
+// goroutine, so that a slow sink does not delay the others.
+type sinkQueue struct {
+	name       string
+	sink       Sink
+	categories map[string]bool
+	events     chan *Event
+	done       chan struct{}
+}
+
+func (q *sinkQueue) accepts(category string) bool {
+	return len(q.categories) == 0 || q.categories[category]
+}
+
+func (q *sinkQueue) loop() {
+	defer close(q.done)
+	for ev := range q.events {
+		if err := q.sink.Write(ev); err != nil {
+			logger.Warn().Err(err).Str("sink", q.name).Str("type", ev.Type).Msg("failed to write the node event")
+		}
+	}
+	if err := q.sink.Close(); err != nil {
+		logger.Warn().Err(err).Str("sink", q.name).Msg("failed to close the sink of the node events")
+	}
+}
+
+// Bus publishes the events to the sinks.
+type Bus struct {
+	mutex  sync.RWMutex
+	queues []*sinkQueue
+}
+
+// NewBus returns a bus without sinks.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// AddSink adds the sink of the events of the categories, or of all the events
+// if categories is empty.
+func (b *Bus) AddSink(name string, sink Sink, categories []string) {
+	q := &sinkQueue{
+		name:       name,
+		sink:       sink,
+		categories: make(map[string]bool),
+		events:     make(chan *Event, sinkQueueSize),
+		done:       make(chan struct{}),
+	}
+	for _, c := range categories {
+		q.categories[c] = true
+	}
+	go q.loop()
+
+	b.mutex.Lock()
+	b.queues = append(b.queues, q)
+	b.mutex.Unlock()
+}
+
+// Publish passes the event to the sinks of its category.
+func (b *Bus) Publish(ev *Event) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for _, q := range b.queues {
+		if !q.accepts(ev.Category) {
+			continue
+		}
+		select {
+		case q.events <- ev:
+		default:
+			logger.Warn().Str("sink", q.name).Str("type", ev.Type).Msg("node event dropped, the sink is too slow")
+		}
+	}
+}
+
+// Close writes the events queued and closes the sinks.
+func (b *Bus) Close() {
+	b.mutex.Lock()
+	queues := b.queues
+	b.queues = nil
+	b.mutex.Unlock()
+	for _, q := range queues {
+		close(q.events)
+		<-q.done
+	}
+}
+
+var defaultBus = NewBus()
+
+// Init adds the sinks configured to the bus of the node.
+func Init(conf *config.MonitorConfig) error {
+	if conf.EventFile != "" {
+		sink, err := NewFileSink(conf.EventFile)
+		if err != nil {
+			return err
+		}
+		defaultBus.AddSink("file", sink, conf.EventFileCategories)
+	}
+	if conf.EventSyslog {
+		sink, err := NewSyslogSink("aergosvr")
+		if err != nil {
+			return err
+		}
+		defaultBus.AddSink("syslog", sink, conf.EventSyslogCategories)
+	}
+	if conf.EventWebhook != "" {
+		defaultBus.AddSink("webhook", NewWebhookSink(conf.EventWebhook), conf.EventWebhookCategories)
+	}
+	return nil
+}
+
+// Close closes the sinks of the bus of the node.
+func Close() {
+	defaultBus.Close()
+}
+
+// Publish publishes the event to the bus of the node.
+func Publish(category string, typ string, message string, fields map[string]interface{}) {
+	defaultBus.Publish(&Event{
+		Time:     time.Now().UTC(),
+		Category: category,
+		Type:     typ,
+		Message:  message,
+		Fields:   fields,
+	})
+}
@@ -0,0 +1,62 @@
+package nodeevent
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nodeevent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	posted := make(chan *Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&ev))
+		posted <- &ev
+	}))
+	defer server.Close()
+
+	path := filepath.Join(dir, "events.log")
+	fileSink, err := NewFileSink(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	bus := NewBus()
+	bus.AddSink("file", fileSink, nil)
+	bus.AddSink("webhook", NewWebhookSink(server.URL), []string{CategoryReorg})
+
+	bus.Publish(&Event{Category: CategoryMembership, Type: "MEMBER_ADDED", Message: "member added"})
+	bus.Publish(&Event{Category: CategoryReorg, Type: "REORG", Message: "reorg", Fields: map[string]interface{}{"from": 10}})
+	bus.Close()
+
+	file, err := os.Open(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer file.Close()
+	var types []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var ev Event
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &ev))
+		types = append(types, ev.Type)
+	}
+	assert.Equal(t, []string{"MEMBER_ADDED", "REORG"}, types, "all the categories")
+
+	assert.Len(t, posted, 1, "only the reorg")
+	ev := <-posted
+	assert.Equal(t, "REORG", ev.Type)
+	assert.Equal(t, float64(10), ev.Fields["from"])
+}
@@ -0,0 +1,37 @@
+// @copyright defined in aergo/LICENSE.txt
+
+// +build !windows
+
+package nodeevent
+
+import (
+	"encoding/json"
+	"log/syslog"
+)
+
+// syslogSink sends the events to the local syslog in JSON.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink returns a sink sending the events to the local syslog with
+// the tag.
+func NewSyslogSink(tag string) (Sink, error) {
+	writer, err := syslog.New(syslog.LOG_NOTICE|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{writer: writer}, nil
+}
+
+func (s *syslogSink) Write(ev *Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return s.writer.Notice(string(data))
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,10 @@
+// @copyright defined in aergo/LICENSE.txt
+
+package nodeevent
+
+import "errors"
+
+// NewSyslogSink returns an error since there is no syslog on windows.
+func NewSyslogSink(tag string) (Sink, error) {
+	return nil, errors.New("syslog is not supported on windows")
+}
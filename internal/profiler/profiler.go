@@ -0,0 +1,137 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package profiler serves the pprof endpoints of the process and writes its
+// profiles to files on demand.
+package profiler
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	rpprof "runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MaxDumps is the number of the profile dumps kept in a directory. The
+// oldest ones are removed when a new profile is dumped.
+const MaxDumps = 20
+
+var (
+	ErrRunning = errors.New("profiling server is already running")
+
+	lock   sync.Mutex
+	server *http.Server
+	addr   string
+)
+
+// Start serves the pprof endpoints at the address.
+func Start(address string) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if server != nil {
+		return ErrRunning
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	server = &http.Server{Handler: mux}
+	addr = l.Addr().String()
+	go server.Serve(l)
+	return nil
+}
+
+// Stop stops serving the pprof endpoints. It does nothing if they are not
+// served.
+func Stop() error {
+	lock.Lock()
+	defer lock.Unlock()
+	if server == nil {
+		return nil
+	}
+	err := server.Close()
+	server, addr = nil, ""
+	return err
+}
+
+// Address returns the address of the pprof endpoints, or an empty string if
+// they are not served.
+func Address() string {
+	lock.Lock()
+	defer lock.Unlock()
+	return addr
+}
+
+// Dump writes the profile of the name, like goroutine or heap, to a new file
+// in the directory and returns the path of the file. The goroutines are
+// written as their stack traces, and the other profiles in the pprof format.
+func Dump(dir, name string) (string, error) {
+	profile := rpprof.Lookup(name)
+	if profile == nil {
+		return "", fmt.Errorf("unknown profile: %s", name)
+	}
+	debug, ext := 0, "pprof"
+	if name == "goroutine" {
+		debug, ext = 2, "txt"
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	if err := prune(dir, MaxDumps-1); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102-150405.000"), ext))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+	if err = profile.WriteTo(f, debug); err != nil {
+		f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// prune removes the oldest dumps in the directory so that at most keep dumps
+// remain.
+func prune(dir string, keep int) error {
+	var dumps []os.FileInfo
+	for _, pattern := range []string{"*.pprof", "*.txt"} {
+		paths, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if fi, err := os.Lstat(path); err == nil && fi.Mode().IsRegular() {
+				dumps = append(dumps, fi)
+			}
+		}
+	}
+	if len(dumps) <= keep {
+		return nil
+	}
+	sort.Slice(dumps, func(i, j int) bool {
+		return dumps[i].ModTime().Before(dumps[j].ModTime())
+	})
+	for _, fi := range dumps[:len(dumps)-keep] {
+		if err := os.Remove(filepath.Join(dir, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package profiler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartStop(t *testing.T) {
+	assert.NoError(t, Start("127.0.0.1:0"))
+	defer Stop()
+	assert.Equal(t, ErrRunning, Start("127.0.0.1:0"))
+
+	rsp, err := http.Get("http://" + Address() + "/debug/pprof/cmdline")
+	if assert.NoError(t, err) {
+		rsp.Body.Close()
+		assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	}
+
+	assert.NoError(t, Stop())
+	assert.Equal(t, "", Address())
+	assert.NoError(t, Stop(), "stopping twice")
+}
+
+func TestDump(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiler")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path, err := Dump(dir, "goroutine")
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasSuffix(path, ".txt"))
+		dump, _ := ioutil.ReadFile(path)
+		assert.Contains(t, string(dump), "TestDump")
+	}
+	path, err = Dump(dir, "heap")
+	if assert.NoError(t, err) {
+		assert.True(t, strings.HasSuffix(path, ".pprof"))
+	}
+	_, err = Dump(dir, "unknown")
+	assert.Error(t, err)
+}
+
+func TestDumpPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiler")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// older dumps and a file which is not a dump
+	old := time.Now().Add(-time.Hour)
+	for i := 0; i < MaxDumps+5; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("heap-old%02d.pprof", i))
+		assert.NoError(t, ioutil.WriteFile(path, nil, 0600))
+		assert.NoError(t, os.Chtimes(path, old, old.Add(time.Duration(i)*time.Second)))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "notes"), nil, 0600))
+
+	path, err := Dump(dir, "heap")
+	if !assert.NoError(t, err) {
+		return
+	}
+	dumps, _ := filepath.Glob(filepath.Join(dir, "*.pprof"))
+	assert.Len(t, dumps, MaxDumps)
+	assert.Contains(t, dumps, path)
+	assert.NotContains(t, dumps, filepath.Join(dir, "heap-old05.pprof"))
+	assert.Contains(t, dumps, filepath.Join(dir, "heap-old06.pprof"))
+	_, err = os.Stat(filepath.Join(dir, "notes"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,309 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/openzipkin/zipkin-go-opentracing/thrift/gen-go/zipkincore"
+)
+
+const (
+	otlpTimeout       = 5 * time.Second
+	otlpBatchInterval = time.Second
+	otlpBatchSize     = 100
+	otlpMaxBacklog    = 1000
+)
+
+// The kinds and the status codes of the spans in OTLP
+const (
+	otlpKindInternal = 1
+	otlpKindServer   = 2
+	otlpKindClient   = 3
+	otlpStatusError  = 2
+)
+
+var logger = logctl.NewLogger("tracing")
+
+var errOTLPBacklogFull = errors.New("backlog of the spans to export is full")
+
+// OTLPCollector implements the collector of the zipkin tracer, which exports
+// the spans to an OpenTelemetry collector by OTLP over HTTP in the JSON
+// encoding.
+type OTLPCollector struct {
+	url     string
+	service string
+	client  *http.Client
+
+	spanc    chan *zipkincore.Span
+	quit     chan struct{}
+	shutdown chan error
+}
+
+// NewOTLPCollector returns a collector posting the spans of the service to the
+// url, such as http://localhost:4318/v1/traces.
+func NewOTLPCollector(url string, service string) *OTLPCollector {
+	c := &OTLPCollector{
+		url:      url,
+		service:  service,
+		client:   &http.Client{Timeout: otlpTimeout},
+		spanc:    make(chan *zipkincore.Span, otlpMaxBacklog),
+		quit:     make(chan struct{}),
+		shutdown: make(chan error, 1),
+	}
+	go c.loop()
+	return c
+}
+
+// Collect queues the span to export. The span is dropped if the backlog is
+// full, not to block the traced components.
+func (c *OTLPCollector) Collect(s *zipkincore.Span) error {
+	select {
+	case c.spanc <- s:
+		return nil
+	default:
+		return errOTLPBacklogFull
+	}
+}
+
+// Close exports the queued spans and stops the collector.
+func (c *OTLPCollector) Close() error {
+	close(c.quit)
+	return <-c.shutdown
+}
+
+func (c *OTLPCollector) loop() {
+	ticker := time.NewTicker(otlpBatchInterval)
+	defer ticker.Stop()
+
+	var batch []*zipkincore.Span
+	for {
+		select {
+		case span := <-c.spanc:
+			batch = append(batch, span)
+			if len(batch) >= otlpBatchSize {
+				c.send(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) != 0 {
+				c.send(batch)
+				batch = nil
+			}
+		case <-c.quit:
+			for len(c.spanc) != 0 {
+				batch = append(batch, <-c.spanc)
+			}
+			var err error
+			if len(batch) != 0 {
+				err = c.send(batch)
+			}
+			c.shutdown <- err
+			return
+		}
+	}
+}
+
+func (c *OTLPCollector) send(spans []*zipkincore.Span) error {
+	body, err := json.Marshal(toOTLPRequest(c.service, spans))
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to encode the spans to export")
+		return err
+	}
+	resp, err := c.client.Post(c.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		logger.Warn().Err(err).Str("url", c.url).Msg("failed to export the spans")
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Warn().Str("url", c.url).Str("status", resp.Status).Msg("failed to export the spans")
+		return fmt.Errorf("export spans: %s", resp.Status)
+	}
+	return nil
+}
+
+// The JSON encoding of the ExportTraceServiceRequest of OTLP
+
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string           `json:"traceId"`
+	SpanID            string           `json:"spanId"`
+	ParentSpanID      string           `json:"parentSpanId,omitempty"`
+	Name              string           `json:"name"`
+	Kind              int              `json:"kind"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string           `json:"endTimeUnixNano"`
+	Attributes        []*otlpAttribute `json:"attributes,omitempty"`
+	Events            []*otlpEvent     `json:"events,omitempty"`
+	Status            *otlpStatus      `json:"status,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string `json:"timeUnixNano"`
+	Name         string `json:"name"`
+}
+
+type otlpStatus struct {
+	Code int `json:"code"`
+}
+
+func toOTLPRequest(service string, spans []*zipkincore.Span) *otlpRequest {
+	scope := &otlpScopeSpans{Scope: otlpScope{Name: "aergo"}}
+	for _, s := range spans {
+		scope.Spans = append(scope.Spans, toOTLPSpan(s))
+	}
+	return &otlpRequest{ResourceSpans: []*otlpResourceSpans{{
+		Resource:   otlpResource{Attributes: []*otlpAttribute{stringAttribute("service.name", service)}},
+		ScopeSpans: []*otlpScopeSpans{scope},
+	}}}
+}
+
+func toOTLPSpan(s *zipkincore.Span) *otlpSpan {
+	var traceID [16]byte
+	if s.TraceIDHigh != nil {
+		binary.BigEndian.PutUint64(traceID[:8], uint64(*s.TraceIDHigh))
+	}
+	binary.BigEndian.PutUint64(traceID[8:], uint64(s.TraceID))
+
+	// the times of zipkin are in microseconds
+	var start, end int64
+	if s.Timestamp != nil {
+		start = *s.Timestamp * 1000
+		end = start
+		if s.Duration != nil {
+			end += *s.Duration * 1000
+		}
+	}
+
+	span := &otlpSpan{
+		TraceID:           hex.EncodeToString(traceID[:]),
+		SpanID:            spanIDString(s.ID),
+		Name:              s.Name,
+		Kind:              otlpKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(start, 10),
+		EndTimeUnixNano:   strconv.FormatInt(end, 10),
+	}
+	if s.ParentID != nil && *s.ParentID != 0 {
+		span.ParentSpanID = spanIDString(*s.ParentID)
+	}
+	for _, a := range s.Annotations {
+		switch a.Value {
+		case zipkincore.SERVER_RECV, zipkincore.SERVER_SEND:
+			span.Kind = otlpKindServer
+		case zipkincore.CLIENT_SEND, zipkincore.CLIENT_RECV:
+			span.Kind = otlpKindClient
+		default:
+			span.Events = append(span.Events, &otlpEvent{
+				TimeUnixNano: strconv.FormatInt(a.Timestamp*1000, 10),
+				Name:         a.Value,
+			})
+		}
+	}
+	for _, a := range s.BinaryAnnotations {
+		attr := toOTLPAttribute(a)
+		if attr == nil {
+			continue
+		}
+		if a.Key == "error" {
+			span.Status = &otlpStatus{Code: otlpStatusError}
+		}
+		span.Attributes = append(span.Attributes, attr)
+	}
+	return span
+}
+
+func spanIDString(id int64) string {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(id))
+	return hex.EncodeToString(b[:])
+}
+
+func stringAttribute(key string, value string) *otlpAttribute {
+	return &otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func toOTLPAttribute(a *zipkincore.BinaryAnnotation) *otlpAttribute {
+	attr := &otlpAttribute{Key: a.Key}
+	switch a.AnnotationType {
+	case zipkincore.AnnotationType_BOOL:
+		if len(a.Value) != 1 {
+			return nil
+		}
+		b := a.Value[0] == 1
+		attr.Value.BoolValue = &b
+	case zipkincore.AnnotationType_I16, zipkincore.AnnotationType_I32, zipkincore.AnnotationType_I64:
+		var n int64
+		switch len(a.Value) {
+		case 2:
+			n = int64(int16(binary.BigEndian.Uint16(a.Value)))
+		case 4:
+			n = int64(int32(binary.BigEndian.Uint32(a.Value)))
+		case 8:
+			n = int64(binary.BigEndian.Uint64(a.Value))
+		default:
+			return nil
+		}
+		i := strconv.FormatInt(n, 10)
+		attr.Value.IntValue = &i
+	case zipkincore.AnnotationType_DOUBLE:
+		if len(a.Value) != 8 {
+			return nil
+		}
+		f := math.Float64frombits(binary.BigEndian.Uint64(a.Value))
+		attr.Value.DoubleValue = &f
+	case zipkincore.AnnotationType_STRING:
+		s := string(a.Value)
+		attr.Value.StringValue = &s
+	default:
+		s := hex.EncodeToString(a.Value)
+		attr.Value.StringValue = &s
+	}
+	return attr
+}
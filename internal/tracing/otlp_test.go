@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openzipkin/zipkin-go-opentracing/thrift/gen-go/zipkincore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLPCollector(t *testing.T) {
+	received := make(chan *otlpRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		var req otlpRequest
+		assert.NoError(t, json.Unmarshal(body, &req))
+		received <- &req
+	}))
+	defer server.Close()
+
+	parent, ts, duration := int64(0x0102), int64(1500000000000000), int64(250000)
+	c := NewOTLPCollector(server.URL+"/v1/traces", "aergosvr")
+	assert.NoError(t, c.Collect(&zipkincore.Span{
+		TraceID:     1,
+		ID:          0x0a0b,
+		ParentID:    &parent,
+		Name:        "MemPoolSvc",
+		Timestamp:   &ts,
+		Duration:    &duration,
+		Annotations: []*zipkincore.Annotation{{Timestamp: ts, Value: zipkincore.SERVER_RECV}},
+		BinaryAnnotations: []*zipkincore.BinaryAnnotation{
+			{Key: "tx.hash", Value: []byte("abc"), AnnotationType: zipkincore.AnnotationType_STRING},
+			{Key: "error", Value: []byte{1}, AnnotationType: zipkincore.AnnotationType_BOOL},
+		},
+	}))
+	assert.NoError(t, c.Close())
+
+	req := <-received
+	if !assert.Len(t, req.ResourceSpans, 1) || !assert.Len(t, req.ResourceSpans[0].ScopeSpans, 1) {
+		return
+	}
+	assert.Equal(t, "aergosvr", *req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	if assert.Len(t, spans, 1) {
+		span := spans[0]
+		assert.Equal(t, "00000000000000000000000000000001", span.TraceID)
+		assert.Equal(t, "0000000000000a0b", span.SpanID)
+		assert.Equal(t, "0000000000000102", span.ParentSpanID)
+		assert.Equal(t, otlpKindServer, span.Kind)
+		assert.Equal(t, "1500000000000000000", span.StartTimeUnixNano)
+		assert.Equal(t, "1500000000250000000", span.EndTimeUnixNano)
+		assert.Equal(t, "abc", *span.Attributes[0].Value.StringValue)
+		assert.Equal(t, true, *span.Attributes[1].Value.BoolValue)
+		assert.Equal(t, otlpStatusError, span.Status.Code)
+	}
+}
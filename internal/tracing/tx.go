@@ -0,0 +1,178 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/types"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// The stages of a tx are not the requests of one another, such as the block
+// building after the put to the mempool. So the span of the lifecycle of a tx
+// is kept by its hash from the receipt by the RPC to the connection of its
+// block, and the stages are recorded as its children.
+
+const (
+	// maxTxTraces is the number of the txs traced at the same time
+	maxTxTraces = 10000
+	// txTraceTTL is the time after which the span of a tx not connected yet
+	// is finished to be evicted
+	txTraceTTL = 10 * time.Minute
+)
+
+// The stages of the lifecycle of a tx
+const (
+	StageBlockBuild = "block.build"
+	StageConsensus  = "raft.consensus"
+	StageConnect    = "chain.connect"
+)
+
+type txTrace struct {
+	span    opentracing.Span
+	startAt time.Time
+	// stages is the beginnings of the stages begun by BeginTxsStage
+	stages map[string]time.Time
+}
+
+var (
+	txTracesLock sync.Mutex
+	txTraces     = make(map[types.TxID]*txTrace)
+)
+
+// Enabled returns whether a tracer is registered to trace the txs.
+func Enabled() bool {
+	return opentracing.IsGlobalTracerRegistered()
+}
+
+// StartTx starts the span of the lifecycle of the tx as a child of the span
+// in ctx if any, and returns it. It returns nil if the tx is not traced.
+func StartTx(ctx context.Context, hash []byte) opentracing.Span {
+	if !Enabled() || len(hash) == 0 {
+		return nil
+	}
+	txTracesLock.Lock()
+	defer txTracesLock.Unlock()
+	if len(txTraces) >= maxTxTraces {
+		evictTxTraces(time.Now().Add(-txTraceTTL))
+		if len(txTraces) >= maxTxTraces {
+			return nil
+		}
+	}
+	id := types.ToTxID(hash)
+	if _, exist := txTraces[id]; exist {
+		return nil
+	}
+
+	opts := []opentracing.StartSpanOption{opentracing.Tag{Key: "tx.hash", Value: enc.ToString(hash)}}
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		opts = append(opts, opentracing.ChildOf(parent.Context()))
+	}
+	span := opentracing.StartSpan("tx", opts...)
+	txTraces[id] = &txTrace{span: span, startAt: time.Now(), stages: make(map[string]time.Time)}
+	return span
+}
+
+func evictTxTraces(before time.Time) {
+	for id, trace := range txTraces {
+		if trace.startAt.Before(before) {
+			trace.span.SetTag("evicted", true)
+			trace.span.Finish()
+			delete(txTraces, id)
+		}
+	}
+}
+
+// AbortTx finishes the span of the tx failed before being included in a
+// block, such as rejected by the mempool.
+func AbortTx(hash []byte, err error) {
+	txTracesLock.Lock()
+	defer txTracesLock.Unlock()
+	id := types.ToTxID(hash)
+	if trace, exist := txTraces[id]; exist {
+		ext.Error.Set(trace.span, true)
+		trace.span.LogKV("error", err.Error())
+		trace.span.Finish()
+		delete(txTraces, id)
+	}
+}
+
+// forEachTrace calls fn with the traces of the txs traced by this node.
+func forEachTrace(txs []*types.Tx, fn func(id types.TxID, trace *txTrace)) {
+	txTracesLock.Lock()
+	defer txTracesLock.Unlock()
+	if len(txTraces) == 0 {
+		return
+	}
+	for _, tx := range txs {
+		id := types.ToTxID(tx.GetHash())
+		if trace, exist := txTraces[id]; exist {
+			fn(id, trace)
+		}
+	}
+}
+
+// BeginTxsStage marks the beginning of the stage of the txs, which is recorded
+// by EndTxsStage.
+func BeginTxsStage(stage string, txs []*types.Tx) {
+	now := time.Now()
+	forEachTrace(txs, func(_ types.TxID, trace *txTrace) {
+		trace.stages[stage] = now
+	})
+}
+
+// EndTxsStage records the spans of the stage of the txs from the beginning
+// marked by BeginTxsStage. The txs whose stage is not begun are skipped.
+func EndTxsStage(stage string, txs []*types.Tx, tags opentracing.Tags) {
+	forEachTrace(txs, func(_ types.TxID, trace *txTrace) {
+		start, exist := trace.stages[stage]
+		if !exist {
+			return
+		}
+		delete(trace.stages, stage)
+		recordStage(trace, stage, start, tags)
+	})
+}
+
+// RecordTxsStage records the spans of the stage of the txs from start to now.
+func RecordTxsStage(stage string, txs []*types.Tx, start time.Time, tags opentracing.Tags) {
+	forEachTrace(txs, func(_ types.TxID, trace *txTrace) {
+		recordStage(trace, stage, start, tags)
+	})
+}
+
+func recordStage(trace *txTrace, stage string, start time.Time, tags opentracing.Tags) {
+	opentracing.StartSpan(stage,
+		opentracing.ChildOf(trace.span.Context()),
+		opentracing.StartTime(start),
+		tags,
+	).Finish()
+}
+
+// FinishTxs finishes the spans of the lifecycle of the txs, whose block is
+// connected to the chain.
+func FinishTxs(txs []*types.Tx, tags opentracing.Tags) {
+	forEachTrace(txs, func(id types.TxID, trace *txTrace) {
+		for k, v := range tags {
+			trace.span.SetTag(k, v)
+		}
+		trace.span.Finish()
+		delete(txTraces, id)
+	})
+}
+
+// BlockTags returns the tags of the block for the spans of its txs.
+func BlockTags(block *types.Block) opentracing.Tags {
+	return opentracing.Tags{
+		"block.no":   block.BlockNo(),
+		"block.hash": block.ID(),
+	}
+}
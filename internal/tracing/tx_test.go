@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxLifecycle(t *testing.T) {
+	assert.Nil(t, StartTx(context.Background(), []byte{1}), "no tracer")
+	tracer := mocktracer.New()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+	txs := []*types.Tx{{Hash: []byte{1}}, {Hash: []byte{2}}, {Hash: []byte{3}}}
+	rpcSpan := tracer.StartSpan("rpc")
+	ctx := opentracing.ContextWithSpan(context.Background(), rpcSpan)
+	assert.NotNil(t, StartTx(ctx, txs[0].Hash))
+	assert.Nil(t, StartTx(ctx, txs[0].Hash), "already traced")
+	assert.NotNil(t, StartTx(ctx, txs[1].Hash))
+	AbortTx(txs[1].Hash, errors.New("rejected"))
+
+	tags := opentracing.Tags{"block.no": uint64(1)}
+	RecordTxsStage(StageBlockBuild, txs, time.Now(), tags)
+	BeginTxsStage(StageConsensus, txs)
+	EndTxsStage(StageConsensus, txs, tags)
+	FinishTxs(txs, tags)
+	assert.Empty(t, txTraces)
+
+	spans := tracer.FinishedSpans()
+	if !assert.Len(t, spans, 4) {
+		return
+	}
+	aborted, build, consensus, tx := spans[0], spans[1], spans[2], spans[3]
+	assert.Equal(t, true, aborted.Tag("error"))
+	assert.Equal(t, "tx", tx.OperationName)
+	assert.Equal(t, rpcSpan.Context().(mocktracer.MockSpanContext).SpanID, tx.ParentID)
+	assert.Equal(t, uint64(1), tx.Tag("block.no"))
+	assert.Equal(t, StageBlockBuild, build.OperationName)
+	assert.Equal(t, tx.SpanContext.SpanID, build.ParentID)
+	assert.Equal(t, StageConsensus, consensus.OperationName)
+	assert.Equal(t, tx.SpanContext.SpanID, consensus.ParentID)
+}
@@ -18,15 +18,19 @@ import (
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo-actor/router"
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/chain"
 	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/consensus/txorder"
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/contract/bridge"
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
@@ -60,6 +64,9 @@ type MemPool struct {
 	verifier    *actor.PID
 	orphan      int
 	cache       map[types.TxID]types.Transaction
+	arrival     map[types.TxID]uint64        // sequence of the txs in cache arriving
+	scheduled   map[types.TxID]types.BlockNo // the txs waiting for the blocks they are scheduled at
+	seq         uint64
 	pool        map[types.AccountID]*TxList
 	dumpPath    string
 	status      int32
@@ -84,16 +91,18 @@ func NewMemPoolService(cfg *cfg.Config, cs *chain.ChainService) *MemPool {
 	}
 
 	actor := &MemPool{
-		cfg:      cfg,
-		sdb:      sdb,
-		cache:    map[types.TxID]types.Transaction{},
-		pool:     map[types.AccountID]*TxList{},
-		dumpPath: cfg.Mempool.DumpFilePath,
-		status:   initial,
-		verifier: nil,
-		quit:     make(chan bool),
-	}
-	actor.BaseComponent = component.NewBaseComponent(message.MemPoolSvc, actor, log.NewLogger("mempool"))
+		cfg:       cfg,
+		sdb:       sdb,
+		cache:     map[types.TxID]types.Transaction{},
+		arrival:   map[types.TxID]uint64{},
+		scheduled: map[types.TxID]types.BlockNo{},
+		pool:      map[types.AccountID]*TxList{},
+		dumpPath:  cfg.Mempool.DumpFilePath,
+		status:    initial,
+		verifier:  nil,
+		quit:      make(chan bool),
+	}
+	actor.BaseComponent = component.NewBaseComponent(message.MemPoolSvc, actor, logctl.NewLogger("mempool"))
 
 	if cfg.Mempool.FadeoutPeriod > 0 {
 		evictPeriod = time.Duration(cfg.Mempool.FadeoutPeriod) * time.Hour
@@ -146,7 +155,7 @@ func (mp *MemPool) BeforeStop() {
 func (mp *MemPool) monitor() {
 	defer mp.wg.Done()
 
-	evict := time.NewTicker(evictInterval)
+	evict := clock.NewTicker(evictInterval)
 	defer evict.Stop()
 
 	showmetric := time.NewTicker(metricInterval)
@@ -161,7 +170,7 @@ func (mp *MemPool) monitor() {
 				mp.Info().Int("len", l).Int("orphan", o).Int("acc", len(mp.pool)).Msg("mempool metrics")
 			}
 			// Evict old enough transactions
-		case <-evict.C:
+		case <-evict.C():
 			if mp.cfg.Mempool.EnableFadeout {
 				mp.evictTransactions()
 			}
@@ -180,7 +189,7 @@ func (mp *MemPool) evictTransactions() {
 
 	total := 0
 	for acc, list := range mp.pool {
-		if time.Since(list.GetLastModifiedTime()) < evictPeriod {
+		if clock.Since(list.GetLastModifiedTime()) < evictPeriod {
 			continue
 		}
 		txs := list.GetAll()
@@ -188,7 +197,7 @@ func (mp *MemPool) evictTransactions() {
 		orphan := len(txs) - list.Len()
 
 		for _, tx := range txs {
-			delete(mp.cache, types.ToTxID(tx.GetHash())) // need lock
+			mp.forget(tx)
 		}
 		mp.orphan -= orphan
 		delete(mp.pool, acc)
@@ -212,8 +221,10 @@ func (mp *MemPool) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *message.MemPoolPut:
 		mp.verifier.Request(msg.Tx, context.Sender())
+	case *message.MemPoolPutTxs:
+		mp.verifier.Request(msg, context.Sender())
 	case *message.MemPoolGet:
-		txs, err := mp.get(msg.MaxBlockBodySize)
+		txs, err := mp.get(msg.MaxBlockBodySize, msg.Order)
 		context.Respond(&message.MemPoolGetRsp{
 			Txs: txs,
 			Err: err,
@@ -252,25 +263,46 @@ func (mp *MemPool) Statistics() *map[string]interface{} {
 	}
 }
 
-func (mp *MemPool) get(maxBlockBodySize uint32) ([]types.Transaction, error) {
+// get returns the processible txs in the order of the policy up to the
+// size, where the default policy is used if order is nil.
+func (mp *MemPool) get(maxBlockBodySize uint32, order txorder.Policy) ([]types.Transaction, error) {
 	start := time.Now()
 	mp.RLock()
 	defer mp.RUnlock()
-	count := 0
-	size := 0
-	txs := make([]types.Transaction, 0)
-Gather:
+	if order == nil {
+		order = txorder.Default
+	}
+	queues := make([]*txorder.Queue, 0, len(mp.pool))
 	for _, list := range mp.pool {
-		for _, tx := range list.Get() {
-			if size += proto.Size(tx.GetTx()); uint32(size) > maxBlockBodySize {
-				break Gather
+		ready := list.Get()
+		// a scheduled tx holds back the later txs of the account as well
+		for i, tx := range ready {
+			if tx.GetBody().IsPremature(mp.bestBlockNo + 1) {
+				ready = ready[:i]
+				break
 			}
-			txs = append(txs, tx)
-			count++
 		}
+		if len(ready) == 0 {
+			continue
+		}
+		q := &txorder.Queue{Account: list.GetAccount(), Txs: ready, Arrivals: make([]uint64, len(ready))}
+		for i, tx := range ready {
+			q.Arrivals[i] = mp.arrival[types.ToTxID(tx.GetHash())]
+		}
+		queues = append(queues, q)
 	}
+
+	size := 0
+	txs := make([]types.Transaction, 0)
+	txorder.Select(order, queues, func(tx types.Transaction) bool {
+		if size += proto.Size(tx.GetTx()); uint32(size) > maxBlockBodySize {
+			return false
+		}
+		txs = append(txs, tx)
+		return true
+	})
 	elapsed := time.Since(start)
-	mp.Debug().Str("elapsed", elapsed.String()).Int("len", len(mp.cache)).Int("orphan", mp.orphan).Int("count", count).Msg("total tx returned")
+	mp.Debug().Str("elapsed", elapsed.String()).Str("order", order.Name()).Int("len", len(mp.cache)).Int("orphan", mp.orphan).Int("count", len(txs)).Msg("total tx returned")
 	return txs, nil
 }
 
@@ -313,6 +345,11 @@ func (mp *MemPool) put(tx types.Transaction) error {
 
 	mp.orphan -= diff
 	mp.cache[id] = tx
+	mp.seq++
+	mp.arrival[id] = mp.seq
+	if tx.GetBody().IsPremature(mp.bestBlockNo + 1) {
+		mp.scheduled[id] = tx.GetBody().GetNotBefore()
+	}
 	mp.Debug().Str("tx_hash", enc.ToString(tx.GetHash())).Msgf("tx add-ed size(%d, %d)", len(mp.cache), mp.orphan)
 
 	if !mp.testConfig {
@@ -328,6 +365,34 @@ func (mp *MemPool) puts(txs ...types.Transaction) []error {
 	return errs
 }
 
+// putTxs verifies the txs and puts them, where the signatures of the txs by
+// the addresses are verified in a batch ahead.
+func (mp *MemPool) putTxs(txs []*types.Tx) []error {
+	errs := make([]error, len(txs))
+	batch := make([]*types.Tx, len(txs))
+	for i, tx := range txs {
+		if mp.exist(tx.GetHash()) != nil {
+			errs[i] = types.ErrTxAlreadyInMempool
+			continue
+		}
+		batch[i] = tx
+	}
+	// the txs failed here are verified again to report the error
+	signed := key.VerifyAddressTxs(batch, 0)
+
+	authState := mp.authState()
+	for i, tx := range txs {
+		if errs[i] != nil {
+			continue
+		}
+		t := types.NewTransaction(tx)
+		if errs[i] = mp.verifyTx(t, signed[i], authState); errs[i] == nil {
+			errs[i] = mp.put(t)
+		}
+	}
+	return errs
+}
+
 func (mp *MemPool) setStateDB(block *types.Block) bool {
 	if mp.testConfig {
 		return true
@@ -405,11 +470,13 @@ func (mp *MemPool) removeOnBlockArrival(block *types.Block) error {
 		diff, delTxs := list.FilterByState(ns)
 		mp.orphan -= diff
 		for _, tx := range delTxs {
-			delete(mp.cache, types.ToTxID(tx.GetHash())) // need lock
+			mp.forget(tx)
 		}
 		mp.releaseMemPoolList(list)
 		check++
 	}
+	mp.removeExpired(block.BlockNo()+1, block.GetHeader().GetTimestamp())
+	mp.releaseScheduled(block.BlockNo() + 1)
 
 	//FOR TEST
 	for _, tx := range block.GetBody().GetTxs() {
@@ -430,14 +497,84 @@ func (mp *MemPool) removeOnBlockArrival(block *types.Block) error {
 	return nil
 }
 
-// signiture verification
-func (mp *MemPool) verifyTx(tx types.Transaction) error {
+// removeExpired drops the txs expired at the block of the number and the
+// timestamp. The later txs of the same accounts are left as orphans.
+func (mp *MemPool) removeExpired(blockNo types.BlockNo, ts int64) {
+	total := 0
+	for _, list := range mp.pool {
+		diff, delTxs := list.FilterByExpiry(blockNo, ts)
+		if len(delTxs) == 0 {
+			continue
+		}
+		mp.orphan -= diff
+		for _, tx := range delTxs {
+			mp.forget(tx)
+		}
+		total += len(delTxs)
+		mp.releaseMemPoolList(list)
+	}
+	if total > 0 {
+		mp.Info().Int("num", total).Msg("remove expired transactions")
+	}
+}
+
+// releaseScheduled stops counting the scheduled txs which can be executed at
+// the block of the number.
+func (mp *MemPool) releaseScheduled(blockNo types.BlockNo) {
+	for id, notBefore := range mp.scheduled {
+		if notBefore <= blockNo {
+			delete(mp.scheduled, id)
+		}
+	}
+}
+
+// checkSchedule checks the limits of the txs held until the blocks they are
+// scheduled at.
+func (mp *MemPool) checkSchedule(notBefore types.BlockNo) error {
+	if notBefore-mp.bestBlockNo > mp.cfg.Mempool.ScheduleDistance {
+		return types.ErrTxScheduleTooFar
+	}
+	if len(mp.scheduled) >= mp.cfg.Mempool.MaxScheduledTxs {
+		return types.ErrTxScheduleFull
+	}
+	return nil
+}
+
+// forget drops the tx removed from its list out of the cache. It needs the
+// lock.
+func (mp *MemPool) forget(tx types.Transaction) {
+	id := types.ToTxID(tx.GetHash())
+	delete(mp.cache, id)
+	delete(mp.arrival, id)
+	delete(mp.scheduled, id)
+}
+
+// checkChainID rejects the tx signed for another chain, as well as every tx
+// before the chain of the node is known.
+func (mp *MemPool) checkChainID(tx types.Transaction) error {
+	given := tx.GetBody().GetChainIdHash()
+	if len(mp.chainIdHash) == 0 || !bytes.Equal(mp.chainIdHash, given) {
+		return &types.ChainMismatchError{
+			Subject:  "tx",
+			Expected: "chain id hash " + enc.ToString(mp.chainIdHash),
+			Actual:   enc.ToString(given),
+		}
+	}
+	return nil
+}
+
+// signiture verification, where signed reports the signature by the address
+// is verified already
+func (mp *MemPool) verifyTx(tx types.Transaction, signed bool, authState *state.BlockState) error {
+	if err := mp.checkChainID(tx); err != nil {
+		return err
+	}
 	err := tx.Validate(mp.chainIdHash)
 	if err != nil {
 		return err
 	}
 	if !tx.GetTx().NeedNameVerify() {
-		err = key.VerifyTx(tx.GetTx())
+		err = contract.VerifyTxSign(authState, tx.GetTx(), tx.GetBody().GetAccount(), signed)
 		if err != nil {
 			return err
 		}
@@ -445,7 +582,7 @@ func (mp *MemPool) verifyTx(tx types.Transaction) error {
 		mp.RLock()
 		account := mp.getAddress(tx.GetBody().GetAccount())
 		mp.RUnlock()
-		err = key.VerifyTxWithAddress(tx.GetTx(), account)
+		err = contract.VerifyTxSign(authState, tx.GetTx(), account, false)
 		if err != nil {
 			return err
 		}
@@ -455,6 +592,21 @@ func (mp *MemPool) verifyTx(tx types.Transaction) error {
 	}
 	return nil
 }
+
+// authState returns the state the authorization contracts are called on for
+// the txs put together, or nil unless the feature is active at the next block.
+func (mp *MemPool) authState() *state.BlockState {
+	if mp.testConfig {
+		return nil
+	}
+	mp.RLock()
+	defer mp.RUnlock()
+	if mp.stateDB == nil || !types.IsFeatureActive(types.FeatureAuthContract, mp.bestBlockNo+1) {
+		return nil
+	}
+	return state.NewBlockState(mp.sdb.OpenNewStateDB(mp.stateDB.GetRoot()))
+}
+
 func (mp *MemPool) getAddress(account []byte) []byte {
 	if mp.testConfig {
 		return account
@@ -470,7 +622,19 @@ func (mp *MemPool) getAddress(account []byte) []byte {
 		mp.Error().Str("for name", string(account)).Msgf("failed to open contract %s", types.AergoName)
 		return nil
 	}
-	return name.GetOwner(scs, account)
+	return name.GetOwner(scs, account, mp.bestBlockNo+1)
+}
+
+// checkTxPolicy checks the payload of the tx against the policy of the chain.
+func (mp *MemPool) checkTxPolicy(txBody *types.TxBody) error {
+	if mp.testConfig {
+		return nil
+	}
+	scs, err := mp.stateDB.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	return system.ValidateTxPolicy(scs, txBody)
 }
 
 // check tx sanity
@@ -483,6 +647,34 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 	if err != nil {
 		return err
 	}
+	if tx.GetBody().GetType() == types.TxType_NORMAL && fee.IsFreeTxEnabled() {
+		scs, err := mp.stateDB.GetSystemAccountState()
+		if err != nil {
+			return err
+		}
+		// the quota is decided again when the tx is executed, which charges
+		// the fee if the pending txs of the account exceed it
+		free, err := system.IsFreeTx(scs, account, len(tx.GetBody().GetPayload()), clock.Now().UnixNano())
+		if err != nil {
+			return err
+		}
+		tx.SetFreeTx(free)
+	}
+	// the tx is executed in the next block at the earliest
+	if err = types.ValidateWithFeatures(tx.GetBody(), mp.bestBlockNo+1); err != nil {
+		return err
+	}
+	if err = mp.checkTxPolicy(tx.GetBody()); err != nil {
+		return err
+	}
+	if tx.GetBody().IsExpired(mp.bestBlockNo+1, clock.Now().UnixNano()) {
+		return types.ErrTxExpired
+	}
+	if tx.GetBody().IsPremature(mp.bestBlockNo + 1) {
+		if err = mp.checkSchedule(tx.GetBody().GetNotBefore()); err != nil {
+			return err
+		}
+	}
 	err = tx.ValidateWithSenderState(ns)
 	if err != nil && err != types.ErrTxNonceToohigh {
 		return err
@@ -494,6 +686,19 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 
 	switch tx.GetBody().GetType() {
 	case types.TxType_NORMAL:
+		if len(tx.GetBody().GetRecipient()) == 0 {
+			scs, err := mp.stateDB.GetSystemAccountState()
+			if err != nil {
+				return err
+			}
+			allowed, err := system.IsDeployAllowed(scs, account)
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				return types.ErrDeployNotAllowed
+			}
+		}
 		if tx.GetTx().HasNameRecipient() {
 			recipient := tx.GetBody().GetRecipient()
 			recipientAddr := mp.getAddress(recipient)
@@ -501,6 +706,26 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 				return types.ErrTxInvalidRecipient
 			}
 		}
+	case types.TxType_FEEDELEGATION:
+		recipient := tx.GetBody().GetRecipient()
+		if tx.GetTx().HasNameRecipient() {
+			recipient = mp.getAddress(recipient)
+			if recipient == nil {
+				return types.ErrTxInvalidRecipient
+			}
+		}
+		contractState, err := mp.getAccountState(recipient)
+		if err != nil {
+			return err
+		}
+		scs, err := mp.stateDB.OpenContractState(types.ToAccountID(recipient), contractState)
+		if err != nil {
+			return err
+		}
+		if err := contract.CheckFeeDelegation(scs, account, tx.GetMaxFee(),
+			mp.bestBlockNo+1); err != nil {
+			return err
+		}
 	case types.TxType_GOVERNANCE:
 		aergoState, err := mp.getAccountState(tx.GetBody().GetRecipient())
 		if err != nil {
@@ -530,7 +755,19 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			if err != nil {
 				return err
 			}
-			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs); err != nil {
+			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs, mp.bestBlockNo+1); err != nil {
+				return err
+			}
+		case types.AergoBridge:
+			sender, err := mp.stateDB.GetAccountStateV(account)
+			if err != nil {
+				return err
+			}
+			bridgeState, err := mp.stateDB.GetAccountStateV([]byte(types.AergoBridge))
+			if err != nil {
+				return err
+			}
+			if _, err := bridge.ValidateBridgeTx(tx.GetBody(), sender, bridgeState, scs, chain.BridgeConfig()); err != nil {
 				return err
 			}
 		}
@@ -271,7 +271,7 @@ func TestBasics2(t *testing.T) {
 		assert.NoError(t, err, "tx should be accepted")
 	}
 
-	txsMempool, err := pool.get(maxBlockBodySize * 10)
+	txsMempool, err := pool.get(maxBlockBodySize*10, nil)
 	assert.NoError(t, err, "get failed")
 	assert.Equal(t, len(txsMempool), len(txs))
 }
@@ -306,11 +306,40 @@ func TestBasics(t *testing.T) {
 		assert.NoError(t, errs[i], "%dth tx failed", i)
 	}
 
-	txsMempool, err := pool.get(maxBlockBodySize)
+	txsMempool, err := pool.get(maxBlockBodySize, nil)
 	assert.NoError(t, err, "get failed")
 	assert.Equal(t, len(txsMempool), len(txs))
 }
 
+func TestPutTxs(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	pool.chainIdHash = []byte{0x01}
+
+	txs := make([]*types.Tx, 0)
+	for i := 0; i < 3; i++ {
+		tx := genTx(0, 0, uint64(i+1), 1).GetTx()
+		tx.Body.ChainIdHash = pool.chainIdHash
+		assert.NoError(t, key.SignTx(tx, sign[0]))
+		txs = append(txs, tx)
+	}
+	// the signature doesn't match the body
+	txs[2].Body.Amount = new(big.Int).SetUint64(2).Bytes()
+	txs[2].Hash = txs[2].CalculateTxHash()
+	txs = append(txs, txs[0])
+
+	errs := pool.putTxs(txs)
+	assert.Len(t, errs, len(txs))
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.Equal(t, types.ErrSignNotMatch, errs[2])
+	assert.Equal(t, types.ErrTxAlreadyInMempool, errs[3])
+
+	total, _ := pool.Size()
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []error{types.ErrTxAlreadyInMempool}, pool.putTxs(txs[:1]))
+}
+
 func TestDeleteOTxs(t *testing.T) {
 	initTest(t)
 	defer deinitTest()
@@ -369,7 +398,7 @@ func TestBasicDeleteOnBlockConnect(t *testing.T) {
 		txs = txs[10:]
 	}
 
-	l, e := pool.get(maxBlockBodySize)
+	l, e := pool.get(maxBlockBodySize, nil)
 	assert.NoError(t, e, "get should succeed")
 	assert.Equalf(t, len(l), 0, "leftover found")
 }
@@ -602,3 +631,33 @@ func TestDeleteInvokePriceFilterOut(t *testing.T) {
 	simulateBlockGen(txs[1:2]...)
 	checkRemainder(0, 0)
 }
+
+func TestScheduledTransaction(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	types.SetForkSchedule(types.ForkSchedule{{Version: types.ForkVersion1, Height: 1}})
+	defer types.SetForkSchedule(nil)
+
+	scheduled := genTx(0, 1, 1, 1)
+	scheduled.GetBody().NotBefore = 3
+	scheduled.GetTx().Hash = scheduled.CalculateTxHash()
+	assert.NoError(t, pool.put(scheduled), "tx should be accepted")
+	assert.NoError(t, pool.put(genTx(0, 1, 2, 1)), "tx should be accepted")
+	assert.NoError(t, pool.put(genTx(1, 1, 1, 1)), "tx should be accepted")
+
+	txs, _ := pool.get(maxBlockBodySize, nil)
+	assert.Len(t, txs, 1, "the scheduled tx holds back the later one")
+	pool.bestBlockNo = 2
+	txs, _ = pool.get(maxBlockBodySize, nil)
+	assert.Len(t, txs, 3, "all executable at the scheduled block")
+
+	far := genTx(2, 1, 1, 1)
+	far.GetBody().NotBefore = pool.bestBlockNo + pool.cfg.Mempool.ScheduleDistance + 1
+	far.GetTx().Hash = far.CalculateTxHash()
+	assert.EqualError(t, pool.put(far), types.ErrTxScheduleTooFar.Error(), "tx should be denied")
+
+	pool.cfg.Mempool.MaxScheduledTxs = len(pool.scheduled)
+	far.GetBody().NotBefore = pool.bestBlockNo + 2
+	far.GetTx().Hash = far.CalculateTxHash()
+	assert.EqualError(t, pool.put(far), types.ErrTxScheduleFull.Error(), "tx should be denied")
+}
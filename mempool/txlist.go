@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/types"
 )
 
@@ -117,7 +118,7 @@ func (tl *TxList) Put(tx types.Transaction) (int, error) {
 	}
 	newCnt := len(tl.list) - tl.ready
 
-	tl.lastTime = time.Now()
+	tl.lastTime = clock.Now()
 	return oldCnt - newCnt, nil
 }
 
@@ -165,7 +166,42 @@ func (tl *TxList) FilterByState(st *types.State) (int, []types.Transaction) {
 	}
 	newCnt := len(tl.list) - tl.ready
 
-	tl.lastTime = time.Now()
+	tl.lastTime = clock.Now()
+	return oldCnt - newCnt, removed
+}
+
+// FilterByExpiry removes the transactions expired at the block of the number
+// and the timestamp. The transactions following a removed one lose their
+// continuity and become orphans.
+func (tl *TxList) FilterByExpiry(blockNo types.BlockNo, ts int64) (int, []types.Transaction) {
+	tl.Lock()
+	defer tl.Unlock()
+
+	var removed []types.Transaction
+	left := tl.list[:0]
+	for _, x := range tl.list {
+		if x.GetBody().IsExpired(blockNo, ts) {
+			removed = append(removed, x)
+		} else {
+			left = append(left, x)
+		}
+	}
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	oldCnt := len(tl.list) - tl.ready
+	tl.list = left
+	tl.ready = 0
+	for i := 0; i < len(tl.list); i++ {
+		if !tl.continuous(i) {
+			break
+		}
+		tl.ready++
+	}
+	newCnt := len(tl.list) - tl.ready
+
+	tl.lastTime = clock.Now()
 	return oldCnt - newCnt, removed
 }
 
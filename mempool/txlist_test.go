@@ -166,6 +166,27 @@ func TestListDelMiddle(t *testing.T) {
 
 }
 
+func TestListFilterByExpiry(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	mpl := NewTxList(nil, NewState(3, 0))
+
+	expiring := genTx(0, 0, uint64(5), 0)
+	expiring.GetBody().Expiry = 10
+	mpl.Put(genTx(0, 0, uint64(4), 0))
+	mpl.Put(expiring)
+	mpl.Put(genTx(0, 0, uint64(6), 0))
+
+	ret, txs := mpl.FilterByExpiry(10, 0)
+	if ret != 0 || mpl.Len() != 3 || len(txs) != 0 {
+		t.Error(ret, mpl.Len(), len(txs))
+	}
+	ret, txs = mpl.FilterByExpiry(11, 0)
+	if ret != -1 || mpl.Len() != 1 || len(txs) != 1 || txs[0] != expiring {
+		t.Error(ret, mpl.Len(), len(txs))
+	}
+}
+
 func TestListPutRandom(t *testing.T) {
 	initTest(t)
 	defer deinitTest()
@@ -23,11 +23,13 @@ func (s *TxVerifier) Receive(context actor.Context) {
 			err = types.ErrTxAlreadyInMempool
 		} else {
 			tx := types.NewTransaction(msg)
-			err = s.mp.verifyTx(tx)
+			err = s.mp.verifyTx(tx, false, s.mp.authState())
 			if err == nil {
 				err = s.mp.put(tx)
 			}
 		}
 		context.Respond(&message.MemPoolPutRsp{Err: err})
+	case *message.MemPoolPutTxs:
+		context.Respond(&message.MemPoolPutTxsRsp{Errs: s.mp.putTxs(msg.Txs)})
 	}
 }
@@ -1,6 +1,8 @@
 package message
 
 import (
+	"time"
+
 	"github.com/aergoio/aergo/types"
 )
 
@@ -22,6 +24,7 @@ type LockAccount struct {
 type UnlockAccount struct {
 	Account    *types.Account
 	Passphrase string
+	Timeout    time.Duration // the default timeout is used if 0
 }
 
 type AccountRsp struct {
@@ -45,12 +48,23 @@ type UnlockAccountRsp struct {
 type SignTx struct {
 	Tx        *types.Tx
 	Requester []byte
+	// the client and its address requesting the signing, for the audit log
+	Client string
+	Peer   string
 }
 type SignTxRsp struct {
 	Tx  *types.Tx
 	Err error
 }
 
+type GetSignAudits struct {
+	Query *types.SignAuditQuery
+}
+type SignAuditsRsp struct {
+	Audits *types.SignAuditList
+	Err    error
+}
+
 type VerifyTx struct {
 	Tx *types.Tx
 }
@@ -64,10 +78,19 @@ type GetAccountsRsp struct {
 	Accounts *types.AccountList
 }
 
+type GetUnlockStatus struct {
+	Account *types.Account
+}
+type GetUnlockStatusRsp struct {
+	Status *types.UnlockStatus
+	Err    error
+}
+
 type ImportAccount struct {
-	Wif     []byte
-	OldPass string
-	NewPass string
+	Wif      []byte
+	OldPass  string
+	NewPass  string
+	Keystore bool // Wif is in keystore format
 }
 type ImportAccountRsp struct {
 	Account *types.Account
@@ -77,9 +100,72 @@ type ImportAccountRsp struct {
 type ExportAccount struct {
 	Account *types.Account
 	Pass    string
+	Kdf     string // exports in keystore format with the kdf unless empty
 }
 
 type ExportAccountRsp struct {
 	Wif []byte
 	Err error
 }
+
+type CreateHDWallet struct {
+	Mnemonic string // a new mnemonic of the words is made if empty
+	SeedPass string
+	Words    int
+	Pass     string
+}
+
+type HDWalletRsp struct {
+	Mnemonic string
+	Err      error
+}
+
+type ExportMnemonic struct {
+	Pass string
+}
+
+type DeriveAccounts struct {
+	Pass    string
+	Account uint32
+	Index   uint32
+	Count   uint32
+}
+
+type GetHDAccounts struct{}
+
+type HDAccountsRsp struct {
+	Accounts *types.HDAccountList
+	Err      error
+}
+
+type SetAlias struct {
+	Alias *types.Alias
+}
+type DeleteAlias struct {
+	Label string
+}
+type AliasRsp struct {
+	Alias *types.Alias
+	Err   error
+}
+type GetAliases struct{}
+type AliasesRsp struct {
+	Aliases *types.AliasList
+	Err     error
+}
+
+type AddWatchAccount struct {
+	Address []byte
+}
+type RemoveWatchAccount struct {
+	Address []byte
+}
+type WatchAccountRsp struct {
+	Account *types.WatchAccount
+	Err     error
+}
+type GetWatchAccounts struct{}
+type WatchAccountsRsp struct {
+	Accounts *types.WatchAccountList
+	Err      error
+}
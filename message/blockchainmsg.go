@@ -45,6 +45,12 @@ type AddBlockRsp struct {
 	BlockHash []byte
 	Err       error
 }
+
+// PreVerifyBlocks requests the chain to verify the blocks to be added next,
+// while the current block is added. It has no response.
+type PreVerifyBlocks struct {
+	Blocks []*types.Block
+}
 type GetState struct {
 	Account []byte
 }
@@ -79,12 +85,31 @@ type GetReceiptRsp struct {
 	Err     error
 }
 
+// GetBlockReceipts is request to get the receipts of all the txs of a block
+type GetBlockReceipts struct {
+	Block *types.Block
+}
+type GetBlockReceiptsRsp struct {
+	Receipts []*types.Receipt
+	Err      error
+}
+
+// NotifyBlock notifies a block connected to the chain with the receipts of its
+// txs
+type NotifyBlock struct {
+	Block    *types.Block
+	Receipts []*types.Receipt
+}
+
 type GetABI struct {
 	Contract []byte
 }
 type GetABIRsp struct {
 	ABI *types.ABI
-	Err error
+	// Address is the address the name of the contract is resolved to
+	Address  []byte
+	CodeHash []byte
+	Err      error
 }
 
 type GetQuery struct {
@@ -143,6 +168,65 @@ type GetStakingRsp struct {
 	Err     error
 }
 
+// SimulateSystemTx runs the system tx against the best state without
+// committing it.
+type SimulateSystemTx struct {
+	Tx *types.Tx
+}
+
+type SimulateSystemTxRsp struct {
+	Result *types.SystemTxSimulation
+	Err    error
+}
+
+type GetWithdrawals struct {
+	Addr []byte
+}
+
+type GetWithdrawalsRsp struct {
+	Withdrawals *types.WithdrawalList
+	Err         error
+}
+
+type GetSystemAccount struct {
+	Addr []byte
+}
+
+type GetSystemAccountRsp struct {
+	Info *types.SystemAccountInfo
+	Err  error
+}
+
+type GetElectionTally struct{}
+
+type GetElectionTallyRsp struct {
+	Tally *types.ElectionTally
+	Err   error
+}
+
+type GetQuorumStatus struct{}
+
+type GetQuorumStatusRsp struct {
+	Params []*types.QuorumStatus
+	Err    error
+}
+
+type GetFeeEstimate struct {
+	TxBody *types.TxBody
+}
+
+type GetFeeEstimateRsp struct {
+	Estimate *types.FeeEstimate
+	Err      error
+}
+
+type GetBaseFee struct{}
+
+type GetBaseFeeRsp struct {
+	BaseFee *types.BaseFee
+	Err     error
+}
+
 type GetNameInfo struct {
 	Name    string
 	BlockNo types.BlockNo
@@ -153,6 +237,13 @@ type GetNameInfoRsp struct {
 	Err   error
 }
 
+type ListNameOffers struct{}
+
+type ListNameOffersRsp struct {
+	Names []*types.NameInfo
+	Err   error
+}
+
 type GetAnchors struct {
 	Seq uint64
 }
@@ -185,3 +276,80 @@ type ListEventsRsp struct {
 	Events []*types.Event
 	Err    error
 }
+
+// GetStateDiff is request to get a page of the accounts changed between the
+// states of two blocks. The page starts right after the account id Cursor.
+type GetStateDiff struct {
+	FromBlockHash []byte
+	ToBlockHash   []byte
+	Cursor        []byte
+}
+
+type GetStateDiffRsp struct {
+	Diffs      []*types.AccountDiff
+	NextCursor []byte
+	Err        error
+}
+
+// ListEventPage is request to get a page of the events matching a filter
+type ListEventPage struct {
+	Params *types.EventListParams
+}
+
+type ListEventPageRsp struct {
+	Page *types.EventPage
+	Err  error
+}
+
+// GetAccountTxHistory is request to get a page of the txs of an account
+type GetAccountTxHistory struct {
+	Params *types.AccountTxHistoryParams
+}
+
+type GetAccountTxHistoryRsp struct {
+	History *types.AccountTxHistory
+	Err     error
+}
+
+// ListContractStorage is request to get a page of the storage of a contract
+type ListContractStorage struct {
+	Params *types.StorageListParams
+}
+
+type ListContractStorageRsp struct {
+	List *types.StorageList
+	Err  error
+}
+
+// GetContractStorageUsage is request to get the storage bytes used by a
+// contract
+type GetContractStorageUsage struct {
+	Contract []byte
+}
+
+type GetContractStorageUsageRsp struct {
+	Usage *types.ContractStorageUsage
+	Err   error
+}
+
+// GetInternalOperations is request to get the operations made by the
+// contracts during a tx
+type GetInternalOperations struct {
+	TxHash []byte
+}
+
+type GetInternalOperationsRsp struct {
+	Operations *types.InternalOperations
+	Err        error
+}
+
+// ListIndexedEvents is request to get a page of the events looked up in the
+// index of the event arguments
+type ListIndexedEvents struct {
+	Params *types.EventListParams
+}
+
+type ListIndexedEventsRsp struct {
+	Page *types.EventPage
+	Err  error
+}
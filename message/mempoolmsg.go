@@ -6,6 +6,7 @@
 package message
 
 import (
+	"github.com/aergoio/aergo/consensus/txorder"
 	"github.com/aergoio/aergo/types"
 )
 
@@ -22,9 +23,22 @@ type MemPoolPutRsp struct {
 	Err error
 }
 
+// MemPoolPutTxs is interface of MemPool service for inserting transactions in
+// bulk, whose signatures are verified in a batch
+type MemPoolPutTxs struct {
+	Txs []*types.Tx
+}
+
+// MemPoolPutTxsRsp defines struct of result for MemPoolPutTxs, which has the
+// error of each transaction
+type MemPoolPutTxsRsp struct {
+	Errs []error
+}
+
 // MemPoolGet is interface of MemPool service for retrieving transactions
 type MemPoolGet struct {
 	MaxBlockBodySize uint32
+	Order            txorder.Policy // txorder.Default if nil
 }
 
 // MemPoolGetRsp defines struct of result for MemPoolGet
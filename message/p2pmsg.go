@@ -23,6 +23,8 @@ var (
 	TooFewBlocksError    = fmt.Errorf("too few blocks received that expected")
 	TooManyBlocksError   = fmt.Errorf("too many blocks received that expected")
 	TooBigBlockError     = fmt.Errorf("block size limit exceeded")
+	MalformedBlockError  = fmt.Errorf("malformed block received")
+	PeerNotBannedError   = fmt.Errorf("peer is not banned")
 )
 
 // PingMsg send types.Ping to each peer.
@@ -118,8 +120,9 @@ type GetBlockChunksRsp struct {
 // GetPeers requests p2p actor to get remote peers that is connected.
 // The actor returns *GetPeersRsp
 type GetPeers struct {
-	NoHidden bool
-	ShowSelf bool
+	NoHidden  bool
+	ShowSelf  bool
+	WithStats bool
 }
 
 type PeerInfo struct {
@@ -131,6 +134,8 @@ type PeerInfo struct {
 	LastBlockNumber uint64
 	State           types.PeerState
 	Self            bool
+	// ProtocolStats is filled only if requested
+	ProtocolStats []*types.PeerProtocolStat
 }
 
 // GetPeersRsp contains peer meta information and current states.
@@ -181,6 +186,22 @@ type GetHashByNoRsp struct {
 	Err       error
 }
 
+// GetSkeleton is sent from Syncer, send types.GetSkeletonRequest to dest peer.
+type GetSkeleton struct {
+	Seq    uint64
+	ToWhom peer.ID
+	Top    types.BlockNo
+	Bottom types.BlockNo
+}
+
+// GetSkeletonRsp is the hashes of the skeleton from other peer, in the order
+// of types.SkeletonNos.
+type GetSkeletonRsp struct {
+	Seq    uint64
+	Hashes [][]byte
+	Err    error
+}
+
 type GetSelf struct {
 }
 
@@ -193,3 +214,28 @@ type GetClusterRsp struct {
 	Members []*types.MemberAttr
 	Err     error
 }
+
+// BanPeer requests p2p actor to ban the peer until the time, or for ever if
+// the time is zero, and to disconnect it. The actor returns *BannedPeersRsp
+type BanPeer struct {
+	PeerID peer.ID
+	Until  time.Time
+	Reason string
+}
+
+// UnbanPeer requests p2p actor to lift the ban of the peer.
+// The actor returns *BannedPeersRsp
+type UnbanPeer struct {
+	PeerID peer.ID
+}
+
+// GetBannedPeers requests p2p actor to get the banned peers.
+// The actor returns *BannedPeersRsp
+type GetBannedPeers struct {
+}
+
+// BannedPeersRsp contains the peers banned by the operator.
+type BannedPeersRsp struct {
+	Peers []*types.BannedPeer
+	Err   error
+}
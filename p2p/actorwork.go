@@ -121,6 +121,19 @@ func (p2ps *P2P) GetBlockHashByNo(context actor.Context, msg *message.GetHashByN
 	receiver.StartGet()
 }
 
+// GetSkeleton send request message to peer and make response message for the hashes of the skeleton
+func (p2ps *P2P) GetSkeleton(context actor.Context, msg *message.GetSkeleton) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, subproto.GetSkeletonRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetSkeletonRsp{Seq: msg.Seq, Err: message.PeerNotFoundError})
+		return
+	}
+	receiver := NewSkeletonReceiver(p2ps, remotePeer, msg.Seq, msg.Top, msg.Bottom, fetchTimeOut)
+	receiver.StartGet()
+}
+
 // NotifyNewBlock send notice message of new block to a peer
 func (p2ps *P2P) NotifyNewBlock(newBlock message.NotifyNewBlock) bool {
 	req := &types.NewBlockNotice{
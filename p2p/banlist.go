@@ -0,0 +1,165 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-peer"
+)
+
+// banListFile is the file of the data directory the banned peers are kept in
+// across restarts.
+const banListFile = "peerbans.json"
+
+// banList is the peers banned by the operator. A banned peer is neither
+// connected to nor accepted until its ban expires or is lifted.
+type banList struct {
+	mutex sync.Mutex
+	path  string
+	bans  map[peer.ID]*types.BannedPeer
+}
+
+// newBanList loads the banned peers from the file at path, which is created
+// on the first ban if it doesn't exist.
+func newBanList(path string) (*banList, error) {
+	bl := &banList{path: path, bans: make(map[peer.ID]*types.BannedPeer)}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return bl, nil
+	} else if err != nil {
+		return bl, err
+	}
+	var bans []*types.BannedPeer
+	if err := json.Unmarshal(data, &bans); err != nil {
+		return bl, err
+	}
+	for _, b := range bans {
+		bl.bans[peer.ID(b.PeerID)] = b
+	}
+	return bl, nil
+}
+
+// save writes the banned peers to the file, dropping the expired bans.
+func (bl *banList) save(now time.Time) error {
+	bans := bl.list(now)
+	for id, b := range bl.bans {
+		if expired(b, now) {
+			delete(bl.bans, id)
+		}
+	}
+	data, err := json.MarshalIndent(bans, "", " ")
+	if err != nil {
+		return err
+	}
+	// the file is replaced at once not to leave a partial one
+	if err := ioutil.WriteFile(bl.path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(bl.path+".tmp", bl.path)
+}
+
+func expired(b *types.BannedPeer, now time.Time) bool {
+	return b.Until != 0 && b.Until <= now.UnixNano()
+}
+
+// ban bans the peer until the time, or for ever if it is zero, replacing
+// its ban if any.
+func (bl *banList) ban(id peer.ID, until time.Time, reason string) error {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+
+	now := time.Now()
+	b := &types.BannedPeer{PeerID: []byte(id), Since: now.UnixNano(), Reason: reason}
+	if !until.IsZero() {
+		b.Until = until.UnixNano()
+	}
+	bl.bans[id] = b
+	return bl.save(now)
+}
+
+// unban lifts the ban of the peer.
+func (bl *banList) unban(id peer.ID) error {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+
+	now := time.Now()
+	if b, ok := bl.bans[id]; !ok || expired(b, now) {
+		return message.PeerNotBannedError
+	}
+	delete(bl.bans, id)
+	return bl.save(now)
+}
+
+// isBanned reports whether the peer is banned now. A nil list bans none.
+func (bl *banList) isBanned(id peer.ID) bool {
+	if bl == nil {
+		return false
+	}
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+
+	b, ok := bl.bans[id]
+	return ok && !expired(b, time.Now())
+}
+
+// banned returns the peers banned now in the order of their bans.
+func (bl *banList) banned() []*types.BannedPeer {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+
+	return bl.list(time.Now())
+}
+
+func (bl *banList) list(now time.Time) []*types.BannedPeer {
+	bans := make([]*types.BannedPeer, 0, len(bl.bans))
+	for _, b := range bl.bans {
+		if !expired(b, now) {
+			bans = append(bans, b)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Since < bans[j].Since
+	})
+	return bans
+}
+
+// BanPeer bans the peer until the time, or for ever if it is zero, and
+// disconnects it if connected.
+func (pm *peerManager) BanPeer(id peer.ID, until time.Time, reason string) error {
+	if err := pm.bans.ban(id, until, reason); err != nil {
+		return err
+	}
+	pm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(id)).Time("until", until).Str("reason", reason).Msg("peer is banned")
+	for _, p := range pm.GetPeers() {
+		if p.ID() == id {
+			p.Stop()
+		}
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (pm *peerManager) UnbanPeer(id peer.ID) error {
+	if err := pm.bans.unban(id); err != nil {
+		return err
+	}
+	pm.logger.Info().Str(p2putil.LogPeerID, p2putil.ShortForm(id)).Msg("ban of peer is lifted")
+	return nil
+}
+
+// BannedPeers returns the peers banned now.
+func (pm *peerManager) BannedPeers() []*types.BannedPeer {
+	return pm.bans.banned()
+}
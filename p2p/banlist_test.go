@@ -0,0 +1,53 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBanList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "banlist")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, banListFile)
+
+	var nilList *banList
+	assert.False(t, nilList.isBanned(peer.ID("any")))
+
+	bl, err := newBanList(path)
+	assert.NoError(t, err)
+	forever, expiring, expired := peer.ID("forever"), peer.ID("expiring"), peer.ID("expired")
+	assert.NoError(t, bl.ban(forever, time.Time{}, "spam"))
+	assert.NoError(t, bl.ban(expiring, time.Now().Add(time.Hour), ""))
+	assert.NoError(t, bl.ban(expired, time.Now().Add(-time.Second), ""))
+	assert.True(t, bl.isBanned(forever))
+	assert.True(t, bl.isBanned(expiring))
+	assert.False(t, bl.isBanned(expired))
+	assert.Equal(t, message.PeerNotBannedError, bl.unban(expired))
+
+	// the bans are kept across restarts
+	bl, err = newBanList(path)
+	assert.NoError(t, err)
+	if bans := bl.banned(); assert.Len(t, bans, 2) {
+		assert.Equal(t, []byte(forever), bans[0].PeerID)
+		assert.Equal(t, "spam", bans[0].Reason)
+		assert.Equal(t, int64(0), bans[0].Until)
+	}
+	assert.NoError(t, bl.unban(forever))
+	bl, err = newBanList(path)
+	assert.NoError(t, err)
+	assert.False(t, bl.isBanned(forever))
+	assert.True(t, bl.isBanned(expiring))
+}
@@ -32,7 +32,9 @@ type BlocksChunkReceiver struct {
 	finished    bool
 	status      receiverStatus
 
-	got            []*types.Block
+	// got is decoded lazily, not to decode the bodies of the blocks which
+	// turn out to be wrong
+	got            []*types.LazyBlock
 	offset         int
 	senderFinished chan interface{}
 }
@@ -47,7 +49,7 @@ const (
 
 func NewBlockReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, seq uint64, blockHashes []message.BlockHash, ttl time.Duration) *BlocksChunkReceiver {
 	timeout := time.Now().Add(ttl)
-	return &BlocksChunkReceiver{syncerSeq: seq, actor: actor, peer: peer, blockHashes: blockHashes, timeout: timeout, got: make([]*types.Block, len(blockHashes))}
+	return &BlocksChunkReceiver{syncerSeq: seq, actor: actor, peer: peer, blockHashes: blockHashes, timeout: timeout, got: make([]*types.LazyBlock, len(blockHashes))}
 }
 
 func (br *BlocksChunkReceiver) StartGet() {
@@ -100,7 +102,7 @@ func (br *BlocksChunkReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2
 		return
 	}
 	// remote peer response malformed data.
-	body, ok := msgBody.(*types.GetBlockResponse)
+	body, ok := msgBody.(*types.LazyBlockResponse)
 	if !ok || len(body.Blocks) == 0 {
 		br.cancelReceiving(message.MissingHashError, false)
 		return
@@ -130,10 +132,19 @@ func (br *BlocksChunkReceiver) handleInWaiting(msg p2pcommon.Message, msgBody p2
 		if br.offset < len(br.got) {
 			// not all blocks were filled. this is error
 			br.cancelReceiving(message.TooFewBlocksError, body.HasNext)
-		} else {
-			br.actor.TellRequest(message.SyncerSvc, &message.GetBlockChunksRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Blocks: br.got, Err: nil})
-			br.finishReceiver()
+			return
+		}
+		blocks := make([]*types.Block, len(br.got))
+		for i, lazy := range br.got {
+			block, err := lazy.Block()
+			if err != nil {
+				br.cancelReceiving(message.MalformedBlockError, body.HasNext)
+				return
+			}
+			blocks[i] = block
 		}
+		br.actor.TellRequest(message.SyncerSvc, &message.GetBlockChunksRsp{Seq: br.syncerSeq, ToWhom: br.peer.ID(), Blocks: blocks, Err: nil})
+		br.finishReceiver()
 	}
 	return
 }
@@ -174,7 +185,7 @@ func (br *BlocksChunkReceiver) finishReceiver() {
 
 // ignoreMsg is silently ignore following responses, which is not useless anymore.
 func (br *BlocksChunkReceiver) ignoreMsg(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
-	body, ok := msgBody.(*types.GetBlockResponse)
+	body, ok := msgBody.(*types.LazyBlockResponse)
 	if !ok {
 		return
 	}
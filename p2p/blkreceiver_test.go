@@ -137,7 +137,11 @@ func TestBlocksChunkReceiver_ReceiveResp(t *testing.T) {
 				if test.blkInterval > 0 {
 					time.Sleep(test.blkInterval)
 				}
-				body := &types.GetBlockResponse{Blocks: blks, HasNext: i < len(test.blkInput)-1}
+				lazyBlks := make([]*types.LazyBlock, len(blks))
+				for j, blk := range blks {
+					lazyBlks[j], _ = types.NewLazyBlock(blk)
+				}
+				body := &types.LazyBlockResponse{Blocks: lazyBlks, HasNext: i < len(test.blkInput)-1}
 				br.ReceiveResp(msg, body)
 				if br.status == receiverStatusFinished {
 					break
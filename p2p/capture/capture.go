@@ -0,0 +1,223 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package capture records the p2p messages exchanged with the peers for
+// debugging, like the compatibility of the nodes of different versions.
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/libp2p/go-libp2p-peer"
+)
+
+const fileName = "p2p.capture"
+
+// Capture appends the messages of the selected subprotocols to a file in
+// JSON lines. The file is rotated to p2p.capture.1, p2p.capture.2, ... when
+// it exceeds maxSize if not 0, and the oldest one beyond maxFiles is removed.
+type Capture struct {
+	dir       string
+	maxSize   int64
+	maxFiles  int
+	sample    int
+	protocols map[p2pcommon.SubProtocol]bool
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+// Record is a captured message. Payload holds up to the sample size of the
+// leading bytes of the payload, which is complete if its length is Length.
+type Record struct {
+	Time       time.Time `json:"time"`
+	Peer       string    `json:"peer"`
+	Inbound    bool      `json:"inbound"`
+	Protocol   uint32    `json:"protocol"`
+	Name       string    `json:"name"`
+	ID         string    `json:"id"`
+	OriginalID string    `json:"originalId,omitempty"`
+	Timestamp  int64     `json:"timestamp"`
+	Length     uint32    `json:"length"`
+	Payload    []byte    `json:"payload,omitempty"`
+}
+
+// New returns a capture to the directory of the subprotocols of the names,
+// or of all the subprotocols if none, with up to sample bytes of the
+// payloads.
+func New(dir string, protocols []string, sample int, maxSize int64, maxFiles int) (*Capture, error) {
+	c := &Capture{dir: dir, maxSize: maxSize, maxFiles: maxFiles, sample: sample}
+	if len(protocols) != 0 {
+		c.protocols = make(map[p2pcommon.SubProtocol]bool, len(protocols))
+		for _, name := range protocols {
+			p, ok := ProtocolByName(name)
+			if !ok {
+				return nil, fmt.Errorf("unknown subprotocol %s", name)
+			}
+			c.protocols[p] = true
+		}
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := c.open(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Path returns the path of the capture file of the directory, which is the
+// current one if n is 0 or the rotated one of the number.
+func Path(dir string, n int) string {
+	if n == 0 {
+		return filepath.Join(dir, fileName)
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s.%d", fileName, n))
+}
+
+func (c *Capture) open() error {
+	file, err := os.OpenFile(Path(c.dir, 0), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	c.file, c.size = file, info.Size()
+	return nil
+}
+
+// Record appends the message sent to or received from the peer if its
+// subprotocol is selected. The failures are ignored not to affect the
+// exchange of the message.
+func (c *Capture) Record(peerID peer.ID, inbound bool, msg p2pcommon.Message) {
+	if c.protocols != nil && !c.protocols[msg.Subprotocol()] {
+		return
+	}
+	r := &Record{
+		Time:      time.Now().UTC(),
+		Peer:      peerID.Pretty(),
+		Inbound:   inbound,
+		Protocol:  msg.Subprotocol().Uint32(),
+		Name:      ProtocolName(msg.Subprotocol()),
+		ID:        msg.ID().String(),
+		Timestamp: msg.Timestamp(),
+		Length:    msg.Length(),
+	}
+	if orgID := msg.OriginalID(); orgID != p2pcommon.EmptyID {
+		r.OriginalID = orgID.String()
+	}
+	if payload := msg.Payload(); c.sample > 0 && len(payload) != 0 {
+		if len(payload) > c.sample {
+			payload = payload[:c.sample]
+		}
+		r.Payload = payload
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.file == nil {
+		return
+	}
+	if c.maxSize > 0 && c.size > 0 && c.size+int64(len(data)) > c.maxSize {
+		if err := c.rotate(); err != nil {
+			return
+		}
+	}
+	n, _ := c.file.Write(data)
+	c.size += int64(n)
+}
+
+func (c *Capture) rotate() error {
+	if err := c.file.Close(); err != nil {
+		return err
+	}
+	c.file = nil
+	os.Remove(Path(c.dir, c.maxFiles))
+	for n := c.maxFiles - 1; n >= 0; n-- {
+		if err := os.Rename(Path(c.dir, n), Path(c.dir, n+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return c.open()
+}
+
+// Close closes the capture file.
+func (c *Capture) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// Wrap returns the reader and writer of the messages of the peer which
+// records them to the capture.
+func (c *Capture) Wrap(rw p2pcommon.MsgReadWriter, peerID peer.ID) p2pcommon.MsgReadWriter {
+	return &captureRW{MsgReadWriter: rw, c: c, peerID: peerID}
+}
+
+type captureRW struct {
+	p2pcommon.MsgReadWriter
+	c      *Capture
+	peerID peer.ID
+}
+
+func (rw *captureRW) ReadMsg() (p2pcommon.Message, error) {
+	msg, err := rw.MsgReadWriter.ReadMsg()
+	if err == nil {
+		rw.c.Record(rw.peerID, true, msg)
+	}
+	return msg, err
+}
+
+func (rw *captureRW) WriteMsg(msg p2pcommon.Message) error {
+	err := rw.MsgReadWriter.WriteMsg(msg)
+	if err == nil {
+		rw.c.Record(rw.peerID, false, msg)
+	}
+	return err
+}
+
+// ReadFile calls fn with the records of the capture file in order until fn
+// returns an error.
+func ReadFile(path string, fn func(*Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), int(p2pcommon.MaxPayloadLength)*2)
+	for line := 1; scanner.Scan(); line++ {
+		r := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), r); err != nil {
+			return fmt.Errorf("invalid record at line %d: %s", line, err.Error())
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,108 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMsg struct {
+	protocol p2pcommon.SubProtocol
+	id       p2pcommon.MsgID
+	payload  []byte
+}
+
+func (m *testMsg) Subprotocol() p2pcommon.SubProtocol { return m.protocol }
+func (m *testMsg) Length() uint32                     { return uint32(len(m.payload)) }
+func (m *testMsg) Timestamp() int64                   { return 1 }
+func (m *testMsg) ID() p2pcommon.MsgID                { return m.id }
+func (m *testMsg) OriginalID() p2pcommon.MsgID        { return p2pcommon.EmptyID }
+func (m *testMsg) Payload() []byte                    { return m.payload }
+
+type testRW struct {
+	in []p2pcommon.Message
+}
+
+func (rw *testRW) ReadMsg() (p2pcommon.Message, error) {
+	msg := rw.in[0]
+	rw.in = rw.in[1:]
+	return msg, nil
+}
+
+func (rw *testRW) WriteMsg(msg p2pcommon.Message) error { return nil }
+
+func TestCapture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = New(dir, []string{"NoSuchNotice"}, 0, 0, 0)
+	assert.Error(t, err, "unknown subprotocol")
+
+	notice, _ := proto.Marshal(&types.NewBlockNotice{BlockHash: []byte("block hash of 32 bytes as usual."), BlockNo: 7})
+	ping, _ := proto.Marshal(&types.Ping{BestHeight: 7})
+	c, err := New(dir, []string{"NewBlockNotice", "PingRequest"}, 8, 0, 0)
+	assert.NoError(t, err)
+	rw := c.Wrap(&testRW{in: []p2pcommon.Message{
+		&testMsg{protocol: subproto.NewBlockNotice, id: p2pcommon.NewMsgID(), payload: notice},
+		&testMsg{protocol: subproto.NewTxNotice, id: p2pcommon.NewMsgID(), payload: []byte{1}},
+	}}, peer.ID("peer"))
+	_, err = rw.ReadMsg()
+	assert.NoError(t, err)
+	_, err = rw.ReadMsg()
+	assert.NoError(t, err)
+	assert.NoError(t, rw.WriteMsg(&testMsg{protocol: subproto.PingRequest, id: p2pcommon.NewMsgID(), payload: ping}))
+	assert.NoError(t, c.Close())
+
+	var records []*Record
+	assert.NoError(t, ReadFile(Path(dir, 0), func(r *Record) error {
+		records = append(records, r)
+		return nil
+	}))
+	// the tx notice is not selected
+	if assert.Len(t, records, 2) {
+		assert.True(t, records[0].Inbound)
+		assert.Equal(t, "NewBlockNotice", records[0].Name)
+		assert.Equal(t, notice[:8], records[0].Payload)
+		_, err = Decode(records[0])
+		assert.Error(t, err, "payload sampled")
+
+		assert.False(t, records[1].Inbound)
+		assert.Equal(t, peer.ID("peer").Pretty(), records[1].Peer)
+		body, err := Decode(records[1])
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), body.(*types.Ping).BestHeight)
+	}
+}
+
+func TestCaptureRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c, err := New(dir, nil, 0, 200, 2)
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		c.Record(peer.ID("peer"), true, &testMsg{protocol: subproto.PingRequest, id: p2pcommon.NewMsgID()})
+	}
+	assert.NoError(t, c.Close())
+
+	for n := 0; n <= 2; n++ {
+		_, err := os.Stat(Path(dir, n))
+		assert.NoError(t, err)
+	}
+	_, err = os.Stat(Path(dir, 3))
+	assert.True(t, os.IsNotExist(err), "oldest file removed")
+}
@@ -0,0 +1,87 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package capture
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+type protocol struct {
+	name    string
+	newBody func() proto.Message
+}
+
+// protocols are the subprotocols of this version with the message bodies
+// their payloads are decoded to.
+var protocols = map[p2pcommon.SubProtocol]protocol{
+	subproto.StatusRequest:           {"StatusRequest", func() proto.Message { return &types.Status{} }},
+	subproto.PingRequest:             {"PingRequest", func() proto.Message { return &types.Ping{} }},
+	subproto.PingResponse:            {"PingResponse", func() proto.Message { return &types.Pong{} }},
+	subproto.GoAway:                  {"GoAway", func() proto.Message { return &types.GoAwayNotice{} }},
+	subproto.AddressesRequest:        {"AddressesRequest", func() proto.Message { return &types.AddressesRequest{} }},
+	subproto.AddressesResponse:       {"AddressesResponse", func() proto.Message { return &types.AddressesResponse{} }},
+	subproto.GetBlocksRequest:        {"GetBlocksRequest", func() proto.Message { return &types.GetBlockRequest{} }},
+	subproto.GetBlocksResponse:       {"GetBlocksResponse", func() proto.Message { return &types.GetBlockResponse{} }},
+	subproto.GetBlockHeadersRequest:  {"GetBlockHeadersRequest", func() proto.Message { return &types.GetBlockHeadersRequest{} }},
+	subproto.GetBlockHeadersResponse: {"GetBlockHeadersResponse", func() proto.Message { return &types.GetBlockHeadersResponse{} }},
+	subproto.NewBlockNotice:          {"NewBlockNotice", func() proto.Message { return &types.NewBlockNotice{} }},
+	subproto.GetAncestorRequest:      {"GetAncestorRequest", func() proto.Message { return &types.GetAncestorRequest{} }},
+	subproto.GetAncestorResponse:     {"GetAncestorResponse", func() proto.Message { return &types.GetAncestorResponse{} }},
+	subproto.GetHashesRequest:        {"GetHashesRequest", func() proto.Message { return &types.GetHashesRequest{} }},
+	subproto.GetHashesResponse:       {"GetHashesResponse", func() proto.Message { return &types.GetHashesResponse{} }},
+	subproto.GetHashByNoRequest:      {"GetHashByNoRequest", func() proto.Message { return &types.GetHashByNo{} }},
+	subproto.GetHashByNoResponse:     {"GetHashByNoResponse", func() proto.Message { return &types.GetHashByNoResponse{} }},
+	subproto.GetSkeletonRequest:      {"GetSkeletonRequest", func() proto.Message { return &types.GetSkeletonRequest{} }},
+	subproto.GetSkeletonResponse:     {"GetSkeletonResponse", func() proto.Message { return &types.GetSkeletonResponse{} }},
+	subproto.GetTXsRequest:           {"GetTXsRequest", func() proto.Message { return &types.GetTransactionsRequest{} }},
+	subproto.GetTXsResponse:          {"GetTXsResponse", func() proto.Message { return &types.GetTransactionsResponse{} }},
+	subproto.NewTxNotice:             {"NewTxNotice", func() proto.Message { return &types.NewTransactionsNotice{} }},
+	subproto.BlockProducedNotice:     {"BlockProducedNotice", func() proto.Message { return &types.BlockProducedNotice{} }},
+	subproto.GetClusterRequest:       {"GetClusterRequest", func() proto.Message { return &types.GetClusterInfoRequest{} }},
+	subproto.GetClusterResponse:      {"GetClusterResponse", func() proto.Message { return &types.GetClusterInfoResponse{} }},
+}
+
+// ProtocolName returns the name of the subprotocol, or its number if it is
+// not of this version.
+func ProtocolName(p p2pcommon.SubProtocol) string {
+	if sp, ok := protocols[p]; ok {
+		return sp.name
+	}
+	return fmt.Sprintf("0x%x", p.Uint32())
+}
+
+// ProtocolByName returns the subprotocol of the name.
+func ProtocolByName(name string) (p2pcommon.SubProtocol, bool) {
+	for p, sp := range protocols {
+		if sp.name == name {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+// Decode decodes the payload of the record by this version. It fails for a
+// subprotocol unknown to this version, a payload not captured in full or a
+// payload this version can't parse.
+func Decode(r *Record) (proto.Message, error) {
+	p, ok := protocols[p2pcommon.SubProtocol(r.Protocol)]
+	if !ok {
+		return nil, fmt.Errorf("unknown subprotocol 0x%x", r.Protocol)
+	}
+	if uint32(len(r.Payload)) != r.Length {
+		return nil, fmt.Errorf("payload captured %d of %d bytes", len(r.Payload), r.Length)
+	}
+	body := p.newBody()
+	if err := proto.Unmarshal(r.Payload, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -48,6 +48,9 @@ const (
 
 	DefaultGlobalTxCacheSize = 50000
 	DefaultPeerTxCacheSize   = 10000
+	// DefaultTxCacheFPRate is the false positive rate of the tx caches, which
+	// are rolling bloom filters remembering at least the cache size of hashes
+	DefaultTxCacheFPRate = 0.000001
 	// DefaultPeerTxQueueSize is maximum size of hashes in a single tx notice message
 	DefaultPeerTxQueueSize = 2000
 	// value to sent to cache, since block and tx cache need only hash itself (stored as key of map)
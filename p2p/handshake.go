@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/p2p/capture"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/types"
 	"github.com/libp2p/go-libp2p-peer"
@@ -49,6 +50,8 @@ type PeerHandshaker struct {
 	peerID    peer.ID
 	// check if is it adhoc
 	localChainID *types.ChainID
+	// capture records the messages of the peer if not nil
+	capture *capture.Capture
 
 	remoteStatus *types.Status
 }
@@ -73,7 +76,7 @@ func newHandshaker(pm p2pcommon.PeerManager, actor p2pcommon.ActorService, log *
 func (h *PeerHandshaker) handshakeOutboundPeer(ctx context.Context, r io.Reader, w io.Writer) (p2pcommon.MsgReadWriter, *types.Status, error) {
 	bufReader, bufWriter := bufio.NewReader(r), bufio.NewWriter(w)
 	// send initial hsmessage
-	hsHeader := HSHeader{Magic: p2pcommon.MAGICTest, Version: p2pcommon.P2PVersion030}
+	hsHeader := HSHeader{Magic: h.magic(), Version: p2pcommon.P2PVersion030}
 	sent, err := bufWriter.Write(hsHeader.Marshal())
 	if err != nil {
 		return nil, nil, err
@@ -116,6 +119,9 @@ func (h *PeerHandshaker) handshakeInboundPeer(ctx context.Context, r io.Reader,
 		return nil, nil, fmt.Errorf("transport error")
 	}
 	hsHeader.Unmarshal(headBuf)
+	if err = h.checkMagic(hsHeader.Magic); err != nil {
+		return nil, nil, err
+	}
 
 	// continue to handshake with innerHandshaker
 	innerHS, err := h.selectProtocolVersion(hsHeader, bufReader, bufWriter)
@@ -170,12 +176,38 @@ func (h *PeerHandshaker) selectProtocolVersion(head HSHeader, r *bufio.Reader, w
 	switch head.Version {
 	case p2pcommon.P2PVersion030:
 		v030 := newV030StateHS(h.pm, h.actorServ, h.logger, h.localChainID, h.peerID, r, w)
+		if h.capture != nil {
+			v030.msgRW = h.capture.Wrap(v030.msgRW, h.peerID)
+		}
 		return v030, nil
 	default:
 		return nil, fmt.Errorf("not supported version")
 	}
 }
 
+// magic returns the magic of the network of the node.
+func (h *PeerHandshaker) magic() uint32 {
+	if h.localChainID != nil && h.localChainID.MainNet {
+		return p2pcommon.MAGICMain
+	}
+	return p2pcommon.MAGICTest
+}
+
+// checkMagic rejects the peer of another network before the chain id is
+// exchanged. A node of the mainnet accepts MAGICTest too, which the older
+// versions sent regardless of the network.
+func (h *PeerHandshaker) checkMagic(magic uint32) error {
+	switch magic {
+	case h.magic():
+		return nil
+	case p2pcommon.MAGICTest:
+		if h.magic() == p2pcommon.MAGICMain {
+			return nil
+		}
+	}
+	return fmt.Errorf("network magic mismatch: expected %#08x, but %#08x", h.magic(), magic)
+}
+
 func (h *PeerHandshaker) checkProtocolVersion(versionStr string) error {
 	// TODO modify interface and put check code here
 	return nil
@@ -129,6 +129,31 @@ func TestPeerHandshaker_Select(t *testing.T) {
 	}
 }
 
+func TestPeerHandshaker_checkMagic(t *testing.T) {
+	mainChainID := types.NewChainID()
+	mainChainID.MainNet = true
+	testChainID := types.NewChainID()
+
+	tests := []struct {
+		name    string
+		chainID *types.ChainID
+		magic   uint32
+		wantErr bool
+	}{
+		{"TMainMain", mainChainID, p2pcommon.MAGICMain, false},
+		{"TMainOlder", mainChainID, p2pcommon.MAGICTest, false},
+		{"TTestTest", testChainID, p2pcommon.MAGICTest, false},
+		{"TTestMain", testChainID, p2pcommon.MAGICMain, true},
+		{"TUnknown", mainChainID, 0x12345678, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newHandshaker(nil, nil, logger, test.chainID, samplePeerID)
+			assert.Equal(t, test.wantErr, h.checkMagic(test.magic) != nil)
+		})
+	}
+}
+
 func TestHSHeader_Marshal(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"github.com/libp2p/go-libp2p-peer"
 	"sync"
@@ -45,7 +46,7 @@ type metricsManager struct {
 }
 
 func NewMetricManager(interval int) *metricsManager {
-	mm := &metricsManager{logger:log.NewLogger("p2p"), metricsMap:make(map[peer.ID]*PeerMetric), interval:interval, startTime:time.Now()}
+	mm := &metricsManager{logger:logctl.NewLogger("p2p"), metricsMap:make(map[peer.ID]*PeerMetric), interval:interval, startTime:time.Now()}
 
 	return mm
 }
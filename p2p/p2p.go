@@ -6,6 +6,7 @@
 package p2p
 
 import (
+	"github.com/aergoio/aergo/p2p/capture"
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"github.com/aergoio/aergo/p2p/raftsupport"
 	"github.com/aergoio/aergo/p2p/transport"
@@ -22,6 +23,7 @@ import (
 	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/types"
@@ -42,6 +44,8 @@ type P2P struct {
 	signer  p2pcommon.MsgSigner
 	ca      types.ChainAccessor
 	consacc consensus.ConsensusAccessor
+	// capture records the messages of the peers if enabled
+	capture *capture.Capture
 
 	mutex sync.Mutex
 }
@@ -54,7 +58,7 @@ var (
 // NewP2P create a new ActorService for p2p
 func NewP2P(cfg *config.Config, chainsvc *chain.ChainService) *P2P {
 	p2psvc := &P2P{}
-	p2psvc.BaseComponent = component.NewBaseComponent(message.P2PSvc, p2psvc, log.NewLogger("p2p"))
+	p2psvc.BaseComponent = component.NewBaseComponent(message.P2PSvc, p2psvc, logctl.NewLogger("p2p"))
 	p2psvc.initP2P(cfg, chainsvc)
 	return p2psvc
 }
@@ -86,12 +90,16 @@ func (p2ps *P2P) BeforeStop() {
 	nt := p2ps.nt
 	p2ps.mutex.Unlock()
 	nt.Stop()
+	if p2ps.capture != nil {
+		p2ps.capture.Close()
+	}
 }
 
 // Statistics show statistic information of p2p module. NOTE: It it not implemented yet
 func (p2ps *P2P) Statistics() *map[string]interface{} {
 	stmap := make(map[string]interface{})
 	stmap["netstat"] = p2ps.mm.Summary()
+	stmap["txdedup"] = txDedup.summary()
 	return &stmap
 }
 
@@ -131,6 +139,17 @@ func (p2ps *P2P) initP2P(cfg *config.Config, chainsvc *chain.ChainService) {
 
 	useRaft := genesis.ConsensusType() == consensus.ConsensusName[consensus.ConsensusRAFT]
 
+	if cfg.P2P.NPCaptureDir != "" {
+		c, err := capture.New(cfg.P2P.NPCaptureDir, cfg.P2P.NPCaptureProtocols, cfg.P2P.NPCapturePayload,
+			int64(cfg.P2P.NPCaptureMaxSize)<<20, int(cfg.P2P.NPCaptureMaxFiles))
+		if err != nil {
+			p2ps.Logger.Error().Err(err).Str("dir", cfg.P2P.NPCaptureDir).Msg("failed to start capture of p2p messages")
+		} else {
+			p2ps.Logger.Warn().Str("dir", cfg.P2P.NPCaptureDir).Msg("p2p messages are captured for debugging")
+			p2ps.capture = c
+		}
+	}
+
 	netTransport := transport.NewNetworkTransport(cfg.P2P, p2ps.Logger)
 	signer := newDefaultMsgSigner(p2pkey.NodePrivKey(), p2pkey.NodePubKey(), p2pkey.NodeID())
 
@@ -172,6 +191,8 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		p2ps.GetBlockHashes(context, msg)
 	case *message.GetHashByNo:
 		p2ps.GetBlockHashByNo(context, msg)
+	case *message.GetSkeleton:
+		p2ps.GetSkeleton(context, msg)
 	case *message.NotifyNewBlock:
 		if msg.Produced {
 			p2ps.NotifyBlockProduced(*msg)
@@ -188,8 +209,16 @@ func (p2ps *P2P) Receive(context actor.Context) {
 	case *message.GetSelf:
 		context.Respond(p2ps.nt.SelfMeta())
 	case *message.GetPeers:
-		peers := p2ps.pm.GetPeerAddresses(msg.NoHidden, msg.ShowSelf)
+		peers := p2ps.pm.GetPeerAddresses(msg.NoHidden, msg.ShowSelf, msg.WithStats)
 		context.Respond(&message.GetPeersRsp{Peers: peers})
+	case *message.BanPeer:
+		err := p2ps.pm.BanPeer(msg.PeerID, msg.Until, msg.Reason)
+		context.Respond(&message.BannedPeersRsp{Peers: p2ps.pm.BannedPeers(), Err: err})
+	case *message.UnbanPeer:
+		err := p2ps.pm.UnbanPeer(msg.PeerID)
+		context.Respond(&message.BannedPeersRsp{Peers: p2ps.pm.BannedPeers(), Err: err})
+	case *message.GetBannedPeers:
+		context.Respond(&message.BannedPeersRsp{Peers: p2ps.pm.BannedPeers()})
 	case *message.GetSyncAncestor:
 		p2ps.GetSyncAncestor(context, msg)
 	case *message.MapQueryMsg:
@@ -292,6 +321,8 @@ func (p2ps *P2P) InsertHandlers(peer p2pcommon.RemotePeer) {
 	peer.AddMessageHandler(subproto.GetHashesResponse, subproto.NewGetHashesRespHandler(p2ps.pm, peer, logger, p2ps))
 	peer.AddMessageHandler(subproto.GetHashByNoRequest, subproto.NewGetHashByNoReqHandler(p2ps.pm, peer, logger, p2ps))
 	peer.AddMessageHandler(subproto.GetHashByNoResponse, subproto.NewGetHashByNoRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(subproto.GetSkeletonRequest, subproto.NewGetSkeletonReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(subproto.GetSkeletonResponse, subproto.NewGetSkeletonRespHandler(p2ps.pm, peer, logger, p2ps))
 
 	// TxHandlers
 	peer.AddMessageHandler(subproto.GetTXsRequest, subproto.NewTxReqHandler(p2ps.pm, peer, logger, p2ps))
@@ -309,6 +340,7 @@ func (p2ps *P2P) InsertHandlers(peer p2pcommon.RemotePeer) {
 
 func (p2ps *P2P) CreateHSHandler(outbound bool, pm p2pcommon.PeerManager, actor p2pcommon.ActorService, log *log.Logger, pid peer.ID) p2pcommon.HSHandler {
 	handshakeHandler := newHandshaker(pm, actor, log, p2ps.chainID, pid)
+	handshakeHandler.capture = p2ps.capture
 	if outbound {
 		return &OutboundHSHandler{PeerHandshaker: handshakeHandler}
 	} else {
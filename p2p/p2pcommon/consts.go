@@ -12,7 +12,8 @@ import (
 
 // constants of p2p protocol since v0.3
 const (
-	// this magic number is useful only in handshaking
+	// this magic number is useful only in handshaking. The nodes of the
+	// mainnet send MAGICMain and the others MAGICTest.
 	MAGICMain uint32 = 0x47416841
 	MAGICTest uint32 = 0x2e415429
 
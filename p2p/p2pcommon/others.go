@@ -59,9 +59,14 @@ type PeerManager interface {
 	// GetPeer return registered(handshaked) remote peer object
 	GetPeer(ID peer.ID) (RemotePeer, bool)
 	GetPeers() []RemotePeer
-	GetPeerAddresses(noHidden bool, showSelf bool) []*message.PeerInfo
+	GetPeerAddresses(noHidden bool, showSelf bool, withStats bool) []*message.PeerInfo
 
 	GetPeerBlockInfos() []types.PeerBlockInfo
+
+	// BanPeer bans the peer until the time, or for ever if it is zero, and disconnects it
+	BanPeer(ID peer.ID, until time.Time, reason string) error
+	UnbanPeer(ID peer.ID) error
+	BannedPeers() []*types.BannedPeer
 }
 type SyncManager interface {
 	// handle notice from bp
@@ -43,6 +43,9 @@ type RemotePeer interface {
 	// updateLastNotice change estimate of the last status of remote peer
 	UpdateLastNotice(blkHash []byte, blkNumber uint64)
 
+	// ProtocolStats returns the statistics of the messages exchanged with the remote peer, by subprotocol
+	ProtocolStats() []*types.PeerProtocolStat
+
 	// TODO
 	MF() MoFactory
 }
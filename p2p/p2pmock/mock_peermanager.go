@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	go_libp2p_peer "github.com/libp2p/go-libp2p-peer"
 	reflect "reflect"
+	time "time"
 )
 
 // MockPeerManager is a mock of PeerManager interface
@@ -48,6 +49,34 @@ func (mr *MockPeerManagerMockRecorder) AddNewPeer(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNewPeer", reflect.TypeOf((*MockPeerManager)(nil).AddNewPeer), arg0)
 }
 
+// BanPeer mocks base method
+func (m *MockPeerManager) BanPeer(arg0 go_libp2p_peer.ID, arg1 time.Time, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanPeer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanPeer indicates an expected call of BanPeer
+func (mr *MockPeerManagerMockRecorder) BanPeer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockPeerManager)(nil).BanPeer), arg0, arg1, arg2)
+}
+
+// BannedPeers mocks base method
+func (m *MockPeerManager) BannedPeers() []*types.BannedPeer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BannedPeers")
+	ret0, _ := ret[0].([]*types.BannedPeer)
+	return ret0
+}
+
+// BannedPeers indicates an expected call of BannedPeers
+func (mr *MockPeerManagerMockRecorder) BannedPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BannedPeers", reflect.TypeOf((*MockPeerManager)(nil).BannedPeers))
+}
+
 // GetPeer mocks base method
 func (m *MockPeerManager) GetPeer(arg0 go_libp2p_peer.ID) (p2pcommon.RemotePeer, bool) {
 	m.ctrl.T.Helper()
@@ -64,17 +93,17 @@ func (mr *MockPeerManagerMockRecorder) GetPeer(arg0 interface{}) *gomock.Call {
 }
 
 // GetPeerAddresses mocks base method
-func (m *MockPeerManager) GetPeerAddresses(arg0, arg1 bool) []*message.PeerInfo {
+func (m *MockPeerManager) GetPeerAddresses(arg0, arg1, arg2 bool) []*message.PeerInfo {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPeerAddresses", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetPeerAddresses", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*message.PeerInfo)
 	return ret0
 }
 
 // GetPeerAddresses indicates an expected call of GetPeerAddresses
-func (mr *MockPeerManagerMockRecorder) GetPeerAddresses(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPeerManagerMockRecorder) GetPeerAddresses(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerAddresses", reflect.TypeOf((*MockPeerManager)(nil).GetPeerAddresses), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerAddresses", reflect.TypeOf((*MockPeerManager)(nil).GetPeerAddresses), arg0, arg1, arg2)
 }
 
 // GetPeerBlockInfos mocks base method
@@ -196,3 +225,17 @@ func (mr *MockPeerManagerMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockPeerManager)(nil).Stop))
 }
+
+// UnbanPeer mocks base method
+func (m *MockPeerManager) UnbanPeer(arg0 go_libp2p_peer.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanPeer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanPeer indicates an expected call of UnbanPeer
+func (mr *MockPeerManagerMockRecorder) UnbanPeer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanPeer", reflect.TypeOf((*MockPeerManager)(nil).UnbanPeer), arg0)
+}
@@ -238,6 +238,18 @@ func (mr *MockRemotePeerMockRecorder) UpdateLastNotice(blkHash, blkNumber interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastNotice", reflect.TypeOf((*MockRemotePeer)(nil).UpdateLastNotice), blkHash, blkNumber)
 }
 
+// ProtocolStats mocks base method
+func (m *MockRemotePeer) ProtocolStats() []*types.PeerProtocolStat {
+	ret := m.ctrl.Call(m, "ProtocolStats")
+	ret0, _ := ret[0].([]*types.PeerProtocolStat)
+	return ret0
+}
+
+// ProtocolStats indicates an expected call of ProtocolStats
+func (mr *MockRemotePeerMockRecorder) ProtocolStats() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProtocolStats", reflect.TypeOf((*MockRemotePeer)(nil).ProtocolStats))
+}
+
 // MF mocks base method
 func (m *MockRemotePeer) MF() p2pcommon.MoFactory {
 	ret := m.ctrl.Call(m, "MF")
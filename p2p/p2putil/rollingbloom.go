@@ -0,0 +1,113 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"sync"
+)
+
+// RollingBloom is a threadsafe bloom filter of the recently added keys. It
+// keeps two generations of up to capacity keys; when the current one is full,
+// the older one is dropped and a new one is started, so that a key is
+// remembered for at least the next capacity adds. A key never added may be
+// reported as contained at about twice the false positive rate.
+type RollingBloom struct {
+	mutex    sync.Mutex
+	capacity int
+	bits     uint64
+	hashes   int
+
+	cur   []uint64
+	prev  []uint64
+	count int
+}
+
+// NewRollingBloom creates a filter of generations of capacity keys at the
+// false positive rate fpRate.
+func NewRollingBloom(capacity int, fpRate float64) *RollingBloom {
+	if capacity < 1 {
+		capacity = 1
+	}
+	bits := uint64(math.Ceil(-float64(capacity) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	bits = (bits + 63) / 64 * 64
+	hashes := int(math.Round(float64(bits) / float64(capacity) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return &RollingBloom{capacity: capacity, bits: bits, hashes: hashes,
+		cur: make([]uint64, bits/64), prev: make([]uint64, bits/64)}
+}
+
+// positions calls fn with the bit positions of the key, derived from two
+// halves of the key by double hashing. Keys shorter than 16 bytes are hashed
+// first, since the keys are usually hashes already.
+func (b *RollingBloom) positions(key []byte, fn func(pos uint64) bool) bool {
+	if len(key) < 16 {
+		sum := sha256.Sum256(key)
+		key = sum[:]
+	}
+	h1 := binary.LittleEndian.Uint64(key[0:8])
+	h2 := binary.LittleEndian.Uint64(key[8:16]) | 1
+	for i := 0; i < b.hashes; i++ {
+		if !fn((h1 + uint64(i)*h2) % b.bits) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *RollingBloom) contains(key []byte) bool {
+	in := func(gen []uint64) bool {
+		return b.positions(key, func(pos uint64) bool {
+			return gen[pos/64]&(1<<(pos%64)) != 0
+		})
+	}
+	return in(b.cur) || in(b.prev)
+}
+
+func (b *RollingBloom) add(key []byte) {
+	if b.count >= b.capacity {
+		b.prev, b.cur = b.cur, b.prev
+		for i := range b.cur {
+			b.cur[i] = 0
+		}
+		b.count = 0
+	}
+	b.positions(key, func(pos uint64) bool {
+		b.cur[pos/64] |= 1 << (pos % 64)
+		return true
+	})
+	b.count++
+}
+
+// Contains returns true if the key was added recently.
+func (b *RollingBloom) Contains(key []byte) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.contains(key)
+}
+
+// Add adds the key.
+func (b *RollingBloom) Add(key []byte) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.add(key)
+}
+
+// ContainsOrAdd returns true if the key was added recently, or adds it and
+// returns false.
+func (b *RollingBloom) ContainsOrAdd(key []byte) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.contains(key) {
+		return true
+	}
+	b.add(key)
+	return false
+}
@@ -0,0 +1,64 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2putil
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleKey(i int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(i))
+	sum := sha256.Sum256(buf)
+	return sum[:]
+}
+
+func TestRollingBloom(t *testing.T) {
+	const capacity = 1000
+	b := NewRollingBloom(capacity, 0.0001)
+
+	for i := 0; i < capacity; i++ {
+		assert.False(t, b.ContainsOrAdd(sampleKey(i)), "key %d", i)
+	}
+	for i := 0; i < capacity; i++ {
+		assert.True(t, b.Contains(sampleKey(i)), "key %d", i)
+	}
+
+	// the keys are remembered for the next capacity adds
+	for i := capacity; i < capacity*2; i++ {
+		b.Add(sampleKey(i))
+	}
+	assert.True(t, b.Contains(sampleKey(0)))
+
+	// and forgotten after two generations
+	for i := capacity * 2; i < capacity*3; i++ {
+		b.Add(sampleKey(i))
+	}
+	forgotten := 0
+	for i := 0; i < capacity; i++ {
+		if !b.Contains(sampleKey(i)) {
+			forgotten++
+		}
+	}
+	assert.True(t, forgotten > capacity*99/100, "forgotten %d", forgotten)
+
+	// the false positives are about the rate
+	falsePositives := 0
+	for i := capacity * 10; i < capacity*110; i++ {
+		if b.Contains(sampleKey(i)) {
+			falsePositives++
+		}
+	}
+	assert.True(t, falsePositives < 50, "false positives %d", falsePositives)
+
+	// short keys are hashed
+	assert.False(t, b.ContainsOrAdd([]byte("short")))
+	assert.True(t, b.Contains([]byte("short")))
+}
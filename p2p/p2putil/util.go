@@ -206,6 +206,19 @@ func bytesArrToStringWithLimit(bbarray [][]byte, limit int) string {
 	return buf.String()
 }
 
+// PrintLazyHashList is PrintHashList of the lazily decoded blocks.
+func PrintLazyHashList(blocks []*types.LazyBlock) string {
+	l := len(blocks)
+	switch l {
+	case 0:
+		return "blk_cnt=0"
+	case 1:
+		return fmt.Sprintf("blk_cnt=1,hash=%s(num %d)", enc.ToString(blocks[0].Hash), blocks[0].BlockNo())
+	default:
+		return fmt.Sprintf("blk_cnt=%d,firstHash=%s(num %d),lastHash=%s(num %d)", l, enc.ToString(blocks[0].Hash), blocks[0].BlockNo(), enc.ToString(blocks[l-1].Hash), blocks[l-1].BlockNo())
+	}
+}
+
 func PrintHashList(blocks []*types.Block) string {
 	l := len(blocks)
 	switch l {
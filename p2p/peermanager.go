@@ -5,6 +5,7 @@ package p2p
 import (
 	"github.com/aergoio/aergo/p2p/p2pkey"
 	"net"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -70,6 +71,8 @@ type peerManager struct {
 
 	//
 	designatedPeers map[peer.ID]p2pcommon.PeerMeta
+	// bans are the peers banned by the operator
+	bans *banList
 
 	logger *log.Logger
 }
@@ -128,6 +131,12 @@ func NewPeerManager(handlerFactory p2pcommon.HandlerFactory, hsFactory p2pcommon
 		finishChannel:     make(chan struct{}),
 	}
 
+	bans, err := newBanList(filepath.Join(cfg.DataDir, banListFile))
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load banned peers")
+	}
+	pm.bans = bans
+
 	// additional initializations
 	pm.init()
 
@@ -399,7 +408,7 @@ func (pm *peerManager) GetPeerBlockInfos() []types.PeerBlockInfo {
 	return infos
 }
 
-func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message.PeerInfo {
+func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool, withStats bool) []*message.PeerInfo {
 	peers := make([]*message.PeerInfo, 0, len(pm.peerCache))
 	if showSelf {
 		meta := pm.SelfMeta()
@@ -409,7 +418,7 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 			return nil
 		}
 		selfpi := &message.PeerInfo{
-			&addr, meta.Version, meta.Hidden, time.Now(), bestBlk.BlockHash(), bestBlk.Header.BlockNo, types.RUNNING, true}
+			&addr, meta.Version, meta.Hidden, time.Now(), bestBlk.BlockHash(), bestBlk.Header.BlockNo, types.RUNNING, true, nil}
 		peers = append(peers, selfpi)
 	}
 	for _, aPeer := range pm.peerCache {
@@ -420,7 +429,10 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 		addr := meta.ToPeerAddress()
 		lastNoti := aPeer.LastStatus()
 		pi := &message.PeerInfo{
-			&addr, meta.Version, meta.Hidden, lastNoti.CheckTime, lastNoti.BlockHash, lastNoti.BlockNumber, aPeer.State(), false}
+			&addr, meta.Version, meta.Hidden, lastNoti.CheckTime, lastNoti.BlockHash, lastNoti.BlockNumber, aPeer.State(), false, nil}
+		if withStats {
+			pi.ProtocolStats = aPeer.ProtocolStats()
+		}
 		peers = append(peers, pi)
 	}
 	return peers
@@ -145,7 +145,7 @@ func TestPeerManager_GetPeerAddresses(t *testing.T) {
 			}
 			pm.updatePeerCache()
 
-			actPeers := pm.GetPeerAddresses(false, false)
+			actPeers := pm.GetPeerAddresses(false, false, false)
 			assert.Equal(t, peersLen, len(actPeers))
 		})
 	}
@@ -0,0 +1,130 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+// protocolStats counts the messages of each subprotocol exchanged with a
+// remote peer, for troubleshooting the network.
+type protocolStats struct {
+	mutex sync.Mutex
+	stats map[p2pcommon.SubProtocol]*protocolStat
+}
+
+type protocolStat struct {
+	sent          uint64
+	sentBytes     uint64
+	received      uint64
+	receivedBytes uint64
+	errors        uint64
+	responses     uint64
+	// latency is the sum of the response latencies
+	latency time.Duration
+}
+
+func newProtocolStats() *protocolStats {
+	return &protocolStats{stats: make(map[p2pcommon.SubProtocol]*protocolStat)}
+}
+
+// stat must be called in mutex
+func (ps *protocolStats) stat(protocol p2pcommon.SubProtocol) *protocolStat {
+	s, found := ps.stats[protocol]
+	if !found {
+		s = &protocolStat{}
+		ps.stats[protocol] = s
+	}
+	return s
+}
+
+// sent records the message written to the peer.
+func (ps *protocolStats) sent(msg p2pcommon.Message) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	s := ps.stat(msg.Subprotocol())
+	s.sent++
+	s.sentBytes += uint64(msg.Length())
+}
+
+// received records the message read from the peer.
+func (ps *protocolStats) received(msg p2pcommon.Message) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	s := ps.stat(msg.Subprotocol())
+	s.received++
+	s.receivedBytes += uint64(msg.Length())
+}
+
+// failed records a message of the protocol failed to be sent or handled, or
+// a request of it got no response.
+func (ps *protocolStats) failed(protocol p2pcommon.SubProtocol) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	ps.stat(protocol).errors++
+}
+
+// responded records a response to the request of the protocol, which came
+// latency after the request was sent.
+func (ps *protocolStats) responded(protocol p2pcommon.SubProtocol, latency time.Duration) {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	s := ps.stat(protocol)
+	s.responses++
+	s.latency += latency
+}
+
+// snapshot returns the statistics ordered by the protocol.
+func (ps *protocolStats) snapshot() []*types.PeerProtocolStat {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+	protocols := make([]p2pcommon.SubProtocol, 0, len(ps.stats))
+	for protocol := range ps.stats {
+		protocols = append(protocols, protocol)
+	}
+	sort.Slice(protocols, func(i, j int) bool { return protocols[i] < protocols[j] })
+
+	ret := make([]*types.PeerProtocolStat, len(protocols))
+	for i, protocol := range protocols {
+		s := ps.stats[protocol]
+		ret[i] = &types.PeerProtocolStat{Protocol: protocol.String(), Sent: s.sent, SentBytes: s.sentBytes,
+			Received: s.received, ReceivedBytes: s.receivedBytes, Errors: s.errors, Responses: s.responses}
+		if s.responses > 0 {
+			ret[i].AvgLatency = int64(s.latency) / int64(s.responses)
+		}
+	}
+	return ret
+}
+
+// statsReadWriter counts the messages read and written by the wrapped
+// MsgReadWriter.
+type statsReadWriter struct {
+	p2pcommon.MsgReadWriter
+	stats *protocolStats
+}
+
+func (rw *statsReadWriter) ReadMsg() (p2pcommon.Message, error) {
+	msg, err := rw.MsgReadWriter.ReadMsg()
+	if err == nil {
+		rw.stats.received(msg)
+	}
+	return msg, err
+}
+
+func (rw *statsReadWriter) WriteMsg(msg p2pcommon.Message) error {
+	err := rw.MsgReadWriter.WriteMsg(msg)
+	if err != nil {
+		rw.stats.failed(msg.Subprotocol())
+	} else {
+		rw.stats.sent(msg)
+	}
+	return err
+}
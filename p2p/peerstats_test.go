@@ -0,0 +1,58 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stats := newProtocolStats()
+	mockRW := p2pmock.NewMockMsgReadWriter(ctrl)
+	rw := &statsReadWriter{MsgReadWriter: mockRW, stats: stats}
+
+	req := NewV030Message(p2pcommon.NewMsgID(), p2pcommon.EmptyID, 0, subproto.GetBlocksRequest, make([]byte, 10))
+	resp := NewV030Message(p2pcommon.NewMsgID(), req.ID(), 0, subproto.GetBlocksResponse, make([]byte, 100))
+	notice := NewV030Message(p2pcommon.NewMsgID(), p2pcommon.EmptyID, 0, subproto.NewTxNotice, make([]byte, 30))
+
+	mockRW.EXPECT().WriteMsg(req).Return(nil).Times(2)
+	mockRW.EXPECT().WriteMsg(notice).Return(errors.New("write failed")).Times(1)
+	mockRW.EXPECT().ReadMsg().Return(resp, nil).Times(1)
+	mockRW.EXPECT().ReadMsg().Return(nil, errors.New("read failed")).Times(1)
+
+	assert.NoError(t, rw.WriteMsg(req))
+	assert.NoError(t, rw.WriteMsg(req))
+	assert.Error(t, rw.WriteMsg(notice))
+	msg, err := rw.ReadMsg()
+	assert.NoError(t, err)
+	assert.Equal(t, resp, msg)
+	_, err = rw.ReadMsg()
+	assert.Error(t, err)
+
+	stats.responded(subproto.GetBlocksRequest, time.Millisecond*100)
+	stats.responded(subproto.GetBlocksRequest, time.Millisecond*300)
+	stats.failed(subproto.GetBlocksRequest)
+
+	expected := []*types.PeerProtocolStat{
+		{Protocol: subproto.GetBlocksRequest.String(), Sent: 2, SentBytes: 20, Errors: 1, Responses: 2,
+			AvgLatency: int64(time.Millisecond * 200)},
+		{Protocol: subproto.GetBlocksResponse.String(), Received: 1, ReceivedBytes: 100},
+		{Protocol: subproto.NewTxNotice.String(), Errors: 1},
+	}
+	assert.Equal(t, expected, stats.snapshot())
+}
@@ -348,7 +348,7 @@ func Test_pbTxNoticeOrder_SendTo(t *testing.T) {
 			var hashKey types.TxID
 			for i := 0; i < tt.keyExist; i++ {
 				hashKey = types.ToTxID(sampleHashes[i])
-				peer.txHashCache.Add(hashKey, true)
+				peer.txHashCache.Add(hashKey[:])
 			}
 
 			if err := pr.SendTo(peer); (err != nil) != tt.wantErr {
@@ -49,6 +49,7 @@ type remotePeerImpl struct {
 	mf        p2pcommon.MoFactory
 	signer    p2pcommon.MsgSigner
 	metric    *metric.PeerMetric
+	stats     *protocolStats
 
 	stopChan chan struct{}
 
@@ -64,7 +65,7 @@ type remotePeerImpl struct {
 
 	// TODO make automatic disconnect if remote peer cause too many wrong message
 	blkHashCache *lru.Cache
-	txHashCache  *lru.Cache
+	txHashCache  *p2putil.RollingBloom
 	lastStatus   *types.LastBlockStatus
 	// lastBlkNoticeTime is time that local peer sent NewBlockNotice to this remote peer
 	lastBlkNoticeTime time.Time
@@ -85,7 +86,7 @@ func newRemotePeer(meta p2pcommon.PeerMeta, manageNum uint32, pm p2pcommon.PeerM
 	rPeer := &remotePeerImpl{
 		meta: meta, manageNum: manageNum, pm: pm,
 		name:      fmt.Sprintf("%s#%d", p2putil.ShortForm(meta.ID), manageNum),
-		actorServ: actor, logger: log, mf: mf, signer: signer, s: s, rw: rw,
+		actorServ: actor, logger: log, mf: mf, signer: signer, s: s,
 		pingDuration: defaultPingInterval,
 		state:        types.STARTING,
 
@@ -102,6 +103,8 @@ func newRemotePeer(meta p2pcommon.PeerMeta, manageNum uint32, pm p2pcommon.PeerM
 		txNoticeQueue:       p2putil.NewPressableQueue(DefaultPeerTxQueueSize),
 		maxTxNoticeHashSize: DefaultPeerTxQueueSize,
 	}
+	rPeer.stats = newProtocolStats()
+	rPeer.rw = &statsReadWriter{MsgReadWriter: rw, stats: rPeer.stats}
 	//rPeer.write =make(chan msgp2putil.NewDefaultChannelPipe(20, newHangresolver(rPeer, log))
 	rPeer.dWrite = make(chan p2pcommon.MsgOrder, writeMsgBufferSize)
 
@@ -110,10 +113,7 @@ func newRemotePeer(meta p2pcommon.PeerMeta, manageNum uint32, pm p2pcommon.PeerM
 	if err != nil {
 		panic("Failed to create remotepeer " + err.Error())
 	}
-	rPeer.txHashCache, err = lru.New(DefaultPeerTxCacheSize)
-	if err != nil {
-		panic("Failed to create remotepeer " + err.Error())
-	}
+	rPeer.txHashCache = p2putil.NewRollingBloom(DefaultPeerTxCacheSize, DefaultTxCacheFPRate)
 
 	return rPeer
 }
@@ -245,7 +245,9 @@ func (p *remotePeerImpl) runRead() {
 			p.Stop()
 			return
 		}
+		p.recordLatency(msg)
 		if err = p.handleMsg(msg); err != nil {
+			p.stats.failed(msg.Subprotocol())
 			// TODO set different log level by case (i.e. it can be expected if peer is disconnecting )
 			p.logger.Warn().Str(p2putil.LogPeerName, p.Name()).Err(err).Msg("Failed to handle message")
 			p.Stop()
@@ -299,6 +301,27 @@ func (p *remotePeerImpl) handleMsg(msg p2pcommon.Message) error {
 	return nil
 }
 
+// recordLatency records the time since the request of the response msg was
+// sent. It must be called before the response is handled, which consumes the
+// request.
+func (p *remotePeerImpl) recordLatency(msg p2pcommon.Message) {
+	if msg.OriginalID() == p2pcommon.EmptyID {
+		return
+	}
+	p.reqMutex.Lock()
+	req, found := p.requests[msg.OriginalID()]
+	p.reqMutex.Unlock()
+	if found {
+		p.stats.responded(req.reqMO.GetProtocolID(), time.Since(req.cTime))
+	}
+}
+
+// ProtocolStats returns the statistics of the messages exchanged with the
+// peer, by subprotocol.
+func (p *remotePeerImpl) ProtocolStats() []*types.PeerProtocolStat {
+	return p.stats.snapshot()
+}
+
 // Stop stops aPeer works
 func (p *remotePeerImpl) Stop() {
 	prevState := p.state.SetAndGet(types.STOPPING)
@@ -410,12 +433,13 @@ func (p *remotePeerImpl) sendTxNotices() {
 		idx := 0
 		for element := p.txNoticeQueue.Poll(); element != nil; element = p.txNoticeQueue.Poll() {
 			hash := element.(types.TxID)
-			if p.txHashCache.Contains(hash) {
+			// the peer already announced or was sent the tx
+			if p.txHashCache.ContainsOrAdd(hash[:]) {
+				txDedup.skipped(1)
 				continue
 			}
 			hashes = append(hashes, hash[:])
 			idx++
-			p.txHashCache.Add(hash, cachePlaceHolder)
 			//if idx >= p.maxTxNoticeHashSize {
 			//	break
 			//}
@@ -461,6 +485,7 @@ func (p *remotePeerImpl) pruneRequests() {
 	for key, m := range p.requests {
 		if m.cTime.Before(expireTime) {
 			delete(p.requests, key)
+			p.stats.failed(m.reqMO.GetProtocolID())
 			if debugLog {
 				deletedReqs = append(deletedReqs, m.reqMO.GetProtocolID().String()+"/"+key.String()+m.cTime.String())
 			}
@@ -484,13 +509,15 @@ func (p *remotePeerImpl) UpdateBlkCache(blkHash []byte, blkNumber uint64) bool {
 }
 
 func (p *remotePeerImpl) UpdateTxCache(hashes []types.TxID) []types.TxID {
-	// lru cache can accept hashable key
 	added := make([]types.TxID, 0, len(hashes))
 	for _, hash := range hashes {
-		if found, _ := p.txHashCache.ContainsOrAdd(hash, true); !found {
+		if !p.txHashCache.ContainsOrAdd(hash[:]) {
 			added = append(added, hash)
 		}
 	}
+	if dup := len(hashes) - len(added); dup > 0 {
+		txDedup.duplicated(dup)
+	}
 	return added
 }
 
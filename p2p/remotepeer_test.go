@@ -369,7 +369,7 @@ func TestRemotePeerImpl_UpdateTxCache(t *testing.T) {
 
 			target := newRemotePeer(sampleMeta, 0, mockPeerManager, mockActorServ, logger, mockMF, mockSigner, nil, nil)
 			for _, hash := range test.inCache {
-				target.txHashCache.Add(hash, true)
+				target.txHashCache.Add(hash[:])
 			}
 			actual := target.UpdateTxCache(test.hashes)
 
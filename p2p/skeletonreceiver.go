@@ -0,0 +1,67 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+)
+
+// SkeletonReceiver sends p2p GetSkeletonRequest to target peer and receives the hashes of the skeleton.
+// It will send response actor message if the hashes are received or failed to receive, but not send response if timeout expired.
+type SkeletonReceiver struct {
+	syncerSeq uint64
+	requestID p2pcommon.MsgID
+
+	peer  p2pcommon.RemotePeer
+	actor p2pcommon.ActorService
+
+	top      types.BlockNo
+	bottom   types.BlockNo
+	timeout  time.Time
+	finished bool
+}
+
+func NewSkeletonReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, seq uint64, top, bottom types.BlockNo, ttl time.Duration) *SkeletonReceiver {
+	timeout := time.Now().Add(ttl)
+	return &SkeletonReceiver{syncerSeq: seq, actor: actor, peer: peer, top: top, bottom: bottom, timeout: timeout}
+}
+
+func (br *SkeletonReceiver) StartGet() {
+	// create message data
+	req := &types.GetSkeletonRequest{Top: br.top, Bottom: br.bottom}
+	mo := br.peer.MF().NewMsgBlockRequestOrder(br.ReceiveResp, subproto.GetSkeletonRequest, req)
+	br.requestID = mo.GetMsgID()
+	br.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (br *SkeletonReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	// timeout
+	if br.finished || br.timeout.Before(time.Now()) {
+		// silently ignore already finished job
+		br.finished = true
+		br.peer.ConsumeRequest(br.requestID)
+		return
+	}
+	// remote peer response failure or malformed response
+	body := msgBody.(*types.GetSkeletonResponse)
+	if body.Status != types.ResultStatus_OK || len(body.Hashes) != len(types.SkeletonNos(br.top, br.bottom)) {
+		br.actor.TellRequest(message.SyncerSvc, &message.GetSkeletonRsp{Seq: br.syncerSeq, Err: message.RemotePeerFailError})
+		br.finished = true
+		br.peer.ConsumeRequest(br.requestID)
+		return
+	}
+	br.actor.TellRequest(message.SyncerSvc, &message.GetSkeletonRsp{Seq: br.syncerSeq, Hashes: body.Hashes})
+	br.finished = true
+	br.peer.ConsumeRequest(br.requestID)
+	return
+}
@@ -0,0 +1,81 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pmock"
+	"github.com/aergoio/aergo/p2p/subproto"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSkeletonReceiver_ReceiveResp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	seqNo := uint64(33)
+	top, bottom := types.BlockNo(100), types.BlockNo(0)
+	hashes := make([][]byte, len(types.SkeletonNos(top, bottom)))
+	for i := range hashes {
+		hashes[i] = dummyBlockHash
+	}
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		blkInterval time.Duration
+		hashes      [][]byte
+		rspStatus   types.ResultStatus
+
+		// to verify
+		consumed  int
+		sentResp  int
+		respError bool
+	}{
+		{"TSingleResp", time.Minute, 0, hashes, types.ResultStatus_OK, 1, 1, false},
+		// Fail1 remote err
+		{"TRemoteFail", time.Minute, 0, nil, types.ResultStatus_INTERNAL, 1, 1, true},
+		// Fail2 wrong count of hashes
+		{"TWrongCount", time.Minute, 0, hashes[1:], types.ResultStatus_OK, 1, 1, true},
+		// Fail3 response sent after timeout
+		{"TTimeout", time.Millisecond * 10, time.Millisecond * 20, hashes, types.ResultStatus_OK, 1, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockActor := p2pmock.NewMockActorService(ctrl)
+			if test.sentResp > 0 {
+				mockActor.EXPECT().TellRequest(message.SyncerSvc, gomock.Any()).DoAndReturn(func(a string, arg *message.GetSkeletonRsp) {
+					if !((arg.Err != nil) == test.respError) {
+						t.Fatalf("Wrong error (have %v)\n", arg.Err)
+					}
+					if arg.Seq != seqNo {
+						t.Fatalf("Wrong seqNo %d, want %d)\n", arg.Seq, seqNo)
+					}
+				})
+			}
+			mockMF := p2pmock.NewMockMoFactory(ctrl)
+			mockPeer := p2pmock.NewMockRemotePeer(ctrl)
+			mockPeer.EXPECT().MF().Return(mockMF)
+			mockMo := createDummyMo(ctrl)
+			mockPeer.EXPECT().ConsumeRequest(gomock.Any()).Times(test.consumed)
+			mockPeer.EXPECT().SendMessage(gomock.Any())
+			mockMF.EXPECT().NewMsgBlockRequestOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockMo)
+
+			br := NewSkeletonReceiver(mockActor, mockPeer, seqNo, top, bottom, test.ttl)
+			br.StartGet()
+
+			msg := &V030Message{subProtocol: subproto.GetSkeletonResponse, id: sampleMsgID}
+			body := &types.GetSkeletonResponse{Hashes: test.hashes, Status: test.rspStatus}
+			if test.blkInterval > 0 {
+				time.Sleep(test.blkInterval)
+			}
+			br.ReceiveResp(msg, body)
+		})
+	}
+}
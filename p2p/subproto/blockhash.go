@@ -196,3 +196,72 @@ func (bh *getHashByNoResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pc
 	// locate request data and remove it if found
 	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
 }
+
+type getSkeletonRequestHandler struct {
+	BaseMsgHandler
+}
+
+type getSkeletonResponseHandler struct {
+	BaseMsgHandler
+}
+
+// NewGetSkeletonReqHandler creates handler for GetSkeletonRequest
+func NewGetSkeletonReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getSkeletonRequestHandler {
+	bh := &getSkeletonRequestHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetSkeletonRequest, pm: pm, peer: peer, actor: actor, logger: logger}}
+
+	return bh
+}
+
+func (bh *getSkeletonRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetSkeletonRequest{})
+}
+
+func (bh *getSkeletonRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetSkeletonRequest)
+	p2putil.DebugLogReceiveMsg(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	chainAccessor := bh.actor.GetChainAccessor()
+
+	if data.Top < data.Bottom {
+		resp := &types.GetSkeletonResponse{Status: types.ResultStatus_INVALID_ARGUMENT}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), GetSkeletonResponse, resp))
+		return
+	}
+
+	// the hash of a block this node doesn't have is left empty, which the
+	// requester regards as different from its own
+	nos := types.SkeletonNos(data.Top, data.Bottom)
+	hashes := make([][]byte, len(nos))
+	for i, no := range nos {
+		if hash, err := chainAccessor.GetHashByNo(no); err == nil {
+			hashes[i] = hash
+		} else {
+			hashes[i] = []byte{}
+		}
+	}
+
+	resp := &types.GetSkeletonResponse{
+		Status: types.ResultStatus_OK,
+		Hashes: hashes,
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), GetSkeletonResponse, resp))
+}
+
+// NewGetSkeletonRespHandler creates handler for GetSkeletonResponse
+func NewGetSkeletonRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getSkeletonResponseHandler {
+	bh := &getSkeletonResponseHandler{BaseMsgHandler: BaseMsgHandler{protocol: GetSkeletonResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+
+	return bh
+}
+
+func (bh *getSkeletonResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetSkeletonResponse{})
+}
+
+func (bh *getSkeletonResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetSkeletonResponse)
+	p2putil.DebugLogReceiveResponseMsg(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, fmt.Sprintf("%s=%d", "count", len(data.Hashes)))
+
+	// locate request data and remove it if found
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
@@ -131,14 +131,15 @@ func NewBlockRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, lo
 }
 
 func (bh *blockResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
-	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetBlockResponse{})
+	// the bodies of the blocks are decoded when the receiver needs them
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.LazyBlockResponse{})
 }
 
 func (bh *blockResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
 	remotePeer := bh.peer
-	data := msgBody.(*types.GetBlockResponse)
+	data := msgBody.(*types.LazyBlockResponse)
 	if bh.logger.IsDebugEnabled() {
-		additional := fmt.Sprintf("hashNext=%t,%s", data.HasNext, p2putil.PrintHashList(data.Blocks))
+		additional := fmt.Sprintf("hashNext=%t,%s", data.HasNext, p2putil.PrintLazyHashList(data.Blocks))
 		p2putil.DebugLogReceiveResponseMsg(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), remotePeer, additional)
 	}
 
@@ -149,6 +150,11 @@ func (bh *blockResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.
 		if data.Status != types.ResultStatus_OK || len(data.Blocks) == 0 {
 			return
 		}
-		bh.sm.HandleGetBlockResponse(remotePeer, msg, data)
+		resp, err := data.Response()
+		if err != nil {
+			bh.logger.Info().Err(err).Str(p2putil.LogPeerName, remotePeer.Name()).Msg("malformed block response")
+			return
+		}
+		bh.sm.HandleGetBlockResponse(remotePeer, msg, resp)
 	}
 }
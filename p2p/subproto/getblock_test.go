@@ -148,7 +148,7 @@ func TestBlockResponseHandler_handle(t *testing.T) {
 
 			mockPeer.EXPECT().GetReceiver(gomock.AssignableToTypeOf(p2pcommon.MsgID{})).Return(test.receiver)
 			msg := &testMessage{subProtocol: GetBlocksResponse, id: p2pcommon.NewMsgID()}
-			body := &types.GetBlockResponse{Blocks: make([]*types.Block, 2)}
+			body := &types.LazyBlockResponse{Blocks: make([]*types.LazyBlock, 2)}
 			h := NewBlockRespHandler(mockPM, mockPeer, logger, mockActor, mockSM)
 			h.Handle(msg, body)
 		})
@@ -26,6 +26,8 @@ const (
 	GetHashesResponse
 	GetHashByNoRequest
 	GetHashByNoResponse
+	GetSkeletonRequest
+	GetSkeletonResponse
 )
 const (
 	GetTXsRequest p2pcommon.SubProtocol = 0x020 + iota
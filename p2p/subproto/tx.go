@@ -151,10 +151,7 @@ func (th *txResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.Mes
 	// TODO: Is there any better solution than passing everything to mempool service?
 	if len(data.Txs) > 0 {
 		th.logger.Debug().Int(p2putil.LogTxCount, len(data.Txs)).Msg("Request mempool to add txs")
-		//th.actor.SendRequest(message.MemPoolSvc, &message.MemPoolPut{Txs: data.Txs})
-		for _, tx := range data.Txs {
-			th.actor.SendRequest(message.MemPoolSvc, &message.MemPoolPut{Tx: tx})
-		}
+		th.actor.SendRequest(message.MemPoolSvc, &message.MemPoolPutTxs{Txs: data.Txs})
 	}
 }
 
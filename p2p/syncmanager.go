@@ -26,7 +26,7 @@ type syncManager struct {
 	pm     p2pcommon.PeerManager
 
 	blkCache *lru.Cache
-	txCache  *lru.Cache
+	txCache  *p2putil.RollingBloom
 
 	syncLock *sync.Mutex
 	syncing  bool
@@ -40,10 +40,7 @@ func newSyncManager(actor p2pcommon.ActorService, pm p2pcommon.PeerManager, logg
 	if err != nil {
 		panic("Failed to create peermanager " + err.Error())
 	}
-	sm.txCache, err = lru.New(DefaultGlobalTxCacheSize)
-	if err != nil {
-		panic("Failed to create peermanager " + err.Error())
-	}
+	sm.txCache = p2putil.NewRollingBloom(DefaultGlobalTxCacheSize, DefaultTxCacheFPRate)
 
 	return sm
 }
@@ -128,8 +125,8 @@ func (sm *syncManager) HandleNewTxNotice(peer p2pcommon.RemotePeer, hashes []typ
 	// TODO it will cause problem if getTransaction failed. (i.e. remote peer was sent notice, but not response getTransaction)
 	toGet := make([]message.TXHash, 0, len(data.TxHashes))
 	for _, hashArr := range hashes {
-		ok, _ := sm.txCache.ContainsOrAdd(hashArr, cachePlaceHolder)
-		if ok {
+		if sm.txCache.ContainsOrAdd(hashArr[:]) {
+			txDedup.duplicated(1)
 			// Kickout duplicated notice log.
 			// if sm.logger.IsDebugEnabled() {
 			// 	sm.logger.Debug().Str(LogTxHash, enc.ToString(hashArr[:])).Str(LogPeerID, peerID.Pretty()).Msg("Got NewTx notice, but sent already from other peer")
@@ -202,7 +202,7 @@ func TestSyncManager_HandleNewTxNotice(t *testing.T) {
 			target := newSyncManager(mockActor, mockPM, logger)
 			if test.inCache != nil {
 				for _, hash := range test.inCache {
-					target.(*syncManager).txCache.Add(hash, true)
+					target.(*syncManager).txCache.Add(hash[:])
 				}
 			}
 			target.HandleNewTxNotice(mockPeer, txHashes, data)
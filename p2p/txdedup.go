@@ -0,0 +1,33 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import "sync/atomic"
+
+// txDedupStat counts the tx hashes the tx caches kept from the duplicate tx
+// traffic: the ones not announced to a peer which already announced or was
+// sent them, and the ones announced again by any peer and not fetched again.
+type txDedupStat struct {
+	skippedOut   int64
+	duplicatedIn int64
+}
+
+var txDedup = &txDedupStat{}
+
+func (s *txDedupStat) skipped(n int) {
+	atomic.AddInt64(&s.skippedOut, int64(n))
+}
+
+func (s *txDedupStat) duplicated(n int) {
+	atomic.AddInt64(&s.duplicatedIn, int64(n))
+}
+
+func (s *txDedupStat) summary() map[string]interface{} {
+	return map[string]interface{}{
+		"skipped_out":   atomic.LoadInt64(&s.skippedOut),
+		"duplicated_in": atomic.LoadInt64(&s.duplicatedIn),
+	}
+}
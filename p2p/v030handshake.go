@@ -106,14 +106,9 @@ func (h *V030Handshaker) doForOutbound(ctx context.Context) (*types.Status, erro
 	}
 
 	// check if chainID is same or not
-	remoteChainID := types.NewChainID()
-	err = remoteChainID.Read(remotePeerStatus.ChainID)
-	if err != nil {
+	if err = h.checkChainID(remotePeerStatus.ChainID); err != nil {
 		return nil, err
 	}
-	if !h.chainID.Equals(remoteChainID) {
-		return nil, fmt.Errorf("different chainID : %s", remoteChainID.ToJSON())
-	}
 
 	peerAddress := remotePeerStatus.Sender
 	if peerAddress == nil || p2putil.CheckAdddressType(peerAddress.Address) == p2putil.AddressTypeError {
@@ -161,14 +156,9 @@ func (h *V030Handshaker) doForInbound(ctx context.Context) (*types.Status, error
 	}
 
 	// check if chainID is same or not
-	remoteChainID := types.NewChainID()
-	err = remoteChainID.Read(statusMsg.ChainID)
-	if err != nil {
+	if err = h.checkChainID(statusMsg.ChainID); err != nil {
 		return nil, err
 	}
-	if !h.chainID.Equals(remoteChainID) {
-		return nil, fmt.Errorf("different chainID : %s", remoteChainID.ToJSON())
-	}
 
 	peerAddress := statusMsg.Sender
 	if peerAddress == nil || p2putil.CheckAdddressType(peerAddress.Address) == p2putil.AddressTypeError {
@@ -209,3 +199,15 @@ func (h *V030Handshaker) handleGoAway(peerID peer.ID, data p2pcommon.Message) (*
 	}
 	return nil, fmt.Errorf("remote peer refuse handshake: %s", goAway.GetMessage())
 }
+
+// checkChainID rejects the peer of another chain.
+func (h *V030Handshaker) checkChainID(raw []byte) error {
+	remoteChainID := types.NewChainID()
+	if err := remoteChainID.Read(raw); err != nil {
+		return err
+	}
+	if !h.chainID.Equals(remoteChainID) {
+		return &types.ChainMismatchError{Subject: "peer", Expected: h.chainID.ToJSON(), Actual: remoteChainID.ToJSON()}
+	}
+	return nil
+}
@@ -45,6 +45,11 @@ func (dpm *basePeerManager) OnInboundConn(s net.Stream) {
 	addr := s.Conn().RemoteMultiaddr()
 
 	dpm.logger.Debug().Str(p2putil.LogFullID, peerID.Pretty()).Str("multiaddr", addr.String()).Msg("new inbound peer arrived")
+	if dpm.pm.bans.isBanned(peerID) {
+		dpm.logger.Debug().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Msg("inbound peer is banned")
+		s.Close()
+		return
+	}
 	query := inboundConnEvent{meta: tempMeta, p2pVer: p2pcommon.P2PVersion030, foundC: make(chan bool)}
 	dpm.pm.inboundConnChan <- query
 	if exist := <-query.foundC; exist {
@@ -87,6 +92,13 @@ func (dpm *basePeerManager) connectWaitingPeers(maxJob int) {
 		if added >= maxJob {
 			break
 		}
+		if dpm.pm.bans.isBanned(wp.Meta.ID) {
+			// the designated peers wait for the lift of the ban
+			if !wp.Meta.Designated {
+				delete(dpm.pm.waitingPeers, wp.Meta.ID)
+			}
+			continue
+		}
 		if wp.NextTrial.Before(now) {
 			// check if peer is currently working now
 			if _, exist := dpm.workingJobs[wp.Meta.ID]; exist {
@@ -271,8 +283,10 @@ func (dpm *dynamicWPManager) OnDiscoveredPeers(metas []p2pcommon.PeerMeta) int {
 		} else if _, ok := dpm.pm.waitingPeers[meta.ID]; ok {
 			// skip already waiting peer
 			continue
+		} else if dpm.pm.bans.isBanned(meta.ID) {
+			// skip banned peer
+			continue
 		}
-		// TODO check blacklist later.
 		dpm.pm.waitingPeers[meta.ID] = &p2pcommon.WaitingPeer{Meta: meta, NextTrial: time.Now()}
 		addedWP++
 	}
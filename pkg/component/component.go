@@ -76,12 +76,16 @@ func (base *BaseComponent) Start() {
 			parentSpan := base.hub.RestoreSpan(parentSpanId)
 			var span opentracing.Span
 
-			if nil == parentSpan {
-				span = opentracing.StartSpan(base.name)
-			} else {
+			if nil != parentSpan {
 				span = opentracing.StartSpan(
 					base.name,
 					opentracing.ChildOf((*parentSpan).Context()))
+			} else if parent := extractSpanContext(c.MessageHeader()); nil != parent {
+				span = opentracing.StartSpan(
+					base.name,
+					opentracing.ChildOf(parent))
+			} else {
+				span = opentracing.StartSpan(base.name)
 			}
 			spanId := base.hub.SaveSpan(span)
 			defer base.hub.DestroySpan(spanId)
@@ -177,6 +181,22 @@ func (base *BaseComponent) Request(message interface{}, sender *actor.PID) {
 	base.pid.Request(message, sender)
 }
 
+// RequestFutureWithSpan is similar with RequestFuture, but the message carries
+// the context of the span, so that the span of this component handling the
+// message becomes its child.
+func (base *BaseComponent) RequestFutureWithSpan(span opentracing.Span, message interface{}, timeout time.Duration, tip string) *actor.Future {
+	if base.pid == nil || span == nil {
+		return base.RequestFuture(message, timeout, tip)
+	}
+
+	future := actor.NewFuturePrefix(tip, timeout)
+	envelope := &actor.MessageEnvelope{Message: message, Sender: future.PID()}
+	injectSpanContext(span, envelope)
+	base.pid.Tell(envelope)
+
+	return future
+}
+
 // RequestTo passes a given message to a target component
 // And a message sender, this component, will expect to get a response
 // from the target component in form of an actor request
@@ -285,3 +305,28 @@ func (base *BaseComponent) statics(req *CompStatReq) *CompStatRsp {
 		Actor:             base.IActor.Statistics(),
 	}
 }
+
+// injectSpanContext sets the context of the span to the header of the envelope
+// in the text map format of the tracer.
+func injectSpanContext(span opentracing.Span, envelope *actor.MessageEnvelope) {
+	carrier := opentracing.TextMapCarrier{}
+	if err := span.Tracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil {
+		return
+	}
+	for k, v := range carrier {
+		envelope.SetHeader(k, v)
+	}
+}
+
+// extractSpanContext returns the context of the span set to the header by
+// injectSpanContext, or nil if none.
+func extractSpanContext(header actor.ReadonlyMessageHeader) opentracing.SpanContext {
+	if header.Length() == 0 {
+		return nil
+	}
+	spanContext, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, opentracing.TextMapCarrier(header.ToMap()))
+	if err != nil {
+		return nil
+	}
+	return spanContext
+}
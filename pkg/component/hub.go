@@ -11,14 +11,14 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/opentracing/opentracing-go"
 	"github.com/gofrs/uuid"
 )
 
 var (
 	ErrHubUnregistered = errors.New("Unregistered Component")
-	logger             = log.NewLogger("actor")
+	logger             = logctl.NewLogger("actor")
 )
 
 // ICompSyncRequester is the interface that wraps the RequestFuture method.
@@ -213,6 +213,21 @@ func (hub *ComponentHub) RequestFuture(
 	return targetComponent.RequestFuture(message, timeout, tip)
 }
 
+// RequestFutureWithSpan passes a message carrying the context of the span to a
+// component, which has a targetName, and returns a future of its response.
+func (hub *ComponentHub) RequestFutureWithSpan(span opentracing.Span,
+	targetName string, message interface{}, timeout time.Duration, tip string) *actor.Future {
+
+	targetComponent := hub.components[targetName]
+	if targetComponent == nil {
+		err := actor.NewFuture(timeout)
+		err.PID().Tell(ErrHubUnregistered)
+		return err
+	}
+
+	return targetComponent.RequestFutureWithSpan(span, message, timeout, tip)
+}
+
 func (hub *ComponentHub) RequestFutureResult(
 	targetName string, message interface{}, timeout time.Duration, tip string) (interface{}, error) {
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
+	"github.com/opentracing/opentracing-go"
 )
 
 // IComponent provides a common interface for easy management
@@ -26,6 +27,7 @@ type IComponent interface {
 	Tell(message interface{})
 	Request(message interface{}, sender *actor.PID)
 	RequestFuture(message interface{}, timeout time.Duration, tip string) *actor.Future
+	RequestFutureWithSpan(span opentracing.Span, message interface{}, timeout time.Duration, tip string) *actor.Future
 
 	Receive(actor.Context)
 }
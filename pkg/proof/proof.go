@@ -0,0 +1,102 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package proof verifies the merkle proofs of account states and contract
+// storage returned by the GetStateAndProof and QueryContractState APIs.
+// Verification only needs the proofs and a trusted state root, which makes
+// it usable by light clients.
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	ErrNoProof        = errors.New("proof is empty")
+	ErrInvalidProof   = errors.New("proof does not match the root")
+	ErrUnexpectedLeaf = errors.New("proof of exclusion contains the proven key")
+)
+
+// VerifyAccount checks that proof proves the state, or the absence, of the
+// account address in the state trie of the given root.
+func VerifyAccount(root, address []byte, proof *types.AccountProof) error {
+	if proof == nil {
+		return ErrNoProof
+	}
+	id := types.ToAccountID(address)
+	var value []byte
+	if proof.GetInclusion() {
+		raw, err := proto.Marshal(proof.GetState())
+		if err != nil {
+			return err
+		}
+		value = common.Hasher(raw)
+	}
+	return verify(root, id[:], value, proof.GetInclusion(), proof.GetProofKey(), proof.GetProofVal(),
+		proof.GetBitmap(), int(proof.GetHeight()), proof.GetAuditPath())
+}
+
+// VerifyVar checks that proof proves the value, or the absence, of the
+// storage key in the contract storage trie of the given root.
+func VerifyVar(storageRoot []byte, key string, proof *types.ContractVarProof) error {
+	if proof == nil {
+		return ErrNoProof
+	}
+	var value []byte
+	if proof.GetInclusion() {
+		value = common.Hasher(proof.GetValue())
+	}
+	return verify(storageRoot, common.Hasher([]byte(key)), value, proof.GetInclusion(), proof.GetProofKey(), proof.GetProofVal(),
+		proof.GetBitmap(), int(proof.GetHeight()), proof.GetAuditPath())
+}
+
+// VerifyStateQuery checks the proof of a contract account against the state
+// root, then the proofs of its storage variables against the storage root of
+// the contract.
+func VerifyStateQuery(root []byte, query *types.StateQueryProof) error {
+	contractProof := query.GetContractProof()
+	if err := VerifyAccount(root, contractProof.GetKey(), contractProof); err != nil {
+		return fmt.Errorf("contract: %s", err)
+	}
+	storageRoot := contractProof.GetState().GetStorageRoot()
+	for _, varProof := range query.GetVarProofs() {
+		if err := VerifyVar(storageRoot, varProof.GetKey(), varProof); err != nil {
+			return fmt.Errorf("variable %s: %s", varProof.GetKey(), err)
+		}
+	}
+	return nil
+}
+
+func verify(root, key, value []byte, inclusion bool, proofKey, proofVal, bitmap []byte, height int, ap [][]byte) error {
+	t := trie.NewTrie(root, common.Hasher, nil)
+	compressed := len(bitmap) != 0
+	var ok bool
+	switch {
+	case inclusion && compressed:
+		ok = t.VerifyInclusionC(bitmap, key, value, ap, height)
+	case inclusion:
+		ok = t.VerifyInclusion(ap, key, value)
+	default:
+		if len(proofKey) != 0 && bytes.Equal(proofKey, key) {
+			return ErrUnexpectedLeaf
+		}
+		if compressed {
+			ok = t.VerifyNonInclusionC(ap, height, bitmap, key, proofVal, proofKey)
+		} else {
+			ok = t.VerifyNonInclusion(ap, key, proofVal, proofKey)
+		}
+	}
+	if !ok {
+		return ErrInvalidProof
+	}
+	return nil
+}
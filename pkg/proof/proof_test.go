@@ -0,0 +1,89 @@
+package proof
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDir = "test"
+
+func newTestState(t *testing.T) *state.StateDB {
+	sdb := state.NewChainStateDB()
+	err := sdb.Init(string(db.BadgerImpl), testDir, nil, false)
+	assert.NoError(t, err)
+	states := sdb.GetStateDB()
+
+	for _, address := range []string{"account1", "account2", "account3"} {
+		id := types.ToAccountID([]byte(address))
+		err := states.PutState(id, &types.State{Nonce: 1, Balance: []byte{1}})
+		assert.NoError(t, err)
+	}
+	contract, err := states.OpenContractStateAccount(types.ToAccountID([]byte("contract")))
+	assert.NoError(t, err)
+	assert.NoError(t, contract.SetData([]byte("key1"), []byte("value1")))
+	assert.NoError(t, contract.SetData([]byte("key2"), []byte("value2")))
+	assert.NoError(t, states.StageContractState(contract))
+	assert.NoError(t, states.Update())
+	assert.NoError(t, states.Commit())
+	return states
+}
+
+func TestVerifyAccount(t *testing.T) {
+	defer os.RemoveAll(testDir)
+	states := newTestState(t)
+	root := states.GetRoot()
+
+	for _, compressed := range []bool{false, true} {
+		// inclusion
+		address := []byte("account1")
+		id := types.ToAccountID(address)
+		proof, err := states.GetAccountAndProof(id[:], root, compressed)
+		assert.NoError(t, err)
+		assert.True(t, proof.Inclusion)
+		assert.NoError(t, VerifyAccount(root, address, proof))
+
+		// tampered state
+		proof.State.Nonce = 2
+		assert.Equal(t, ErrInvalidProof, VerifyAccount(root, address, proof))
+
+		// exclusion
+		address = []byte("unknown")
+		id = types.ToAccountID(address)
+		proof, err = states.GetAccountAndProof(id[:], root, compressed)
+		assert.NoError(t, err)
+		assert.False(t, proof.Inclusion)
+		assert.NoError(t, VerifyAccount(root, address, proof))
+	}
+}
+
+func TestVerifyVar(t *testing.T) {
+	defer os.RemoveAll(testDir)
+	states := newTestState(t)
+	root := states.GetRoot()
+
+	address := []byte("contract")
+	id := types.ToAccountID(address)
+	contractProof, err := states.GetAccountAndProof(id[:], root, false)
+	assert.NoError(t, err)
+	contractProof.Key = address
+	storageRoot := contractProof.State.StorageRoot
+
+	query := &types.StateQueryProof{ContractProof: contractProof}
+	for _, key := range []string{"key1", "key2", "unknown"} {
+		varProof, err := states.GetVarAndProof(common.Hasher([]byte(key)), storageRoot, true)
+		assert.NoError(t, err)
+		varProof.Key = key
+		assert.NoError(t, VerifyVar(storageRoot, key, varProof))
+		query.VarProofs = append(query.VarProofs, varProof)
+	}
+	assert.NoError(t, VerifyStateQuery(root, query))
+
+	// a proof of a key does not prove another key
+	assert.Error(t, VerifyVar(storageRoot, "key2", query.VarProofs[0]))
+}
@@ -0,0 +1,165 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// The layout of a data directory is the set of its sub-directories and the
+// encoding of their contents: the chain DB, which holds the raft WAL as well,
+// the state DB and the state of the SQL contracts. The layout file records the
+// version of the layout and the backend of the key-value databases, and a node
+// refuses a data directory of another version or backend instead of reading it
+// wrong. An older layout is upgraded by MigrateLayout.
+const (
+	// LayoutVersion is the version of the layout written by this node.
+	LayoutVersion = 1
+
+	layoutFileName = "LAYOUT"
+)
+
+// LayoutDirs lists the sub-directories of a data directory in the layout.
+var LayoutDirs = []string{"chain", "state", "statesql"}
+
+var (
+	// ErrLayoutUnversioned is returned for a data directory written before
+	// the layout was versioned.
+	ErrLayoutUnversioned = errors.New("data directory has no layout version; run the migrate command with --layout")
+	// ErrLayoutOutdated is returned for a data directory of an older layout.
+	ErrLayoutOutdated = errors.New("data directory has an older layout; run the migrate command with --layout")
+	// ErrLayoutTooNew is returned for a data directory of a newer layout.
+	ErrLayoutTooNew = errors.New("data directory has a newer layout than this node supports")
+)
+
+// Layout is the content of the layout file.
+type Layout struct {
+	Version int    `json:"version"`
+	DbType  string `json:"db_type"`
+}
+
+// layoutMigrations upgrade a data directory from the version of the index to
+// the next one.
+var layoutMigrations = []func(dataDir string) error{
+	// 0 -> 1: the layout before the versioning is kept as it is
+	func(string) error { return nil },
+}
+
+// ReadLayout returns the layout of the data directory, or nil if it has no
+// layout file.
+func ReadLayout(dataDir string) (*Layout, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dataDir, layoutFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	layout := &Layout{}
+	if err := json.Unmarshal(data, layout); err != nil {
+		return nil, fmt.Errorf("invalid layout file: %s", err)
+	}
+	return layout, nil
+}
+
+func writeLayout(dataDir string, layout *Layout) error {
+	data, err := json.Marshal(layout)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dataDir, 0711); err != nil {
+		return err
+	}
+	// the file is replaced at once not to leave a partial one
+	path := filepath.Join(dataDir, layoutFileName)
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// isEmptyDataDir reports whether the data directory holds none of the
+// directories of the layout.
+func isEmptyDataDir(dataDir string) bool {
+	for _, dir := range LayoutDirs {
+		if _, err := os.Stat(filepath.Join(dataDir, dir)); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckLayout checks that the data directory has the layout of this node
+// and the backend dbType. The layout file is written to a new data
+// directory.
+func CheckLayout(dataDir, dbType string) error {
+	layout, err := ReadLayout(dataDir)
+	if err != nil {
+		return err
+	}
+	if layout == nil {
+		if !isEmptyDataDir(dataDir) {
+			return ErrLayoutUnversioned
+		}
+		return writeLayout(dataDir, &Layout{Version: LayoutVersion, DbType: dbType})
+	}
+	switch {
+	case layout.Version < LayoutVersion:
+		return ErrLayoutOutdated
+	case layout.Version > LayoutVersion:
+		return ErrLayoutTooNew
+	case layout.DbType != dbType:
+		return fmt.Errorf("data directory uses %s instead of %s; convert it by the migrate command with --to",
+			layout.DbType, dbType)
+	}
+	return nil
+}
+
+// MigrateLayout upgrades the data directory of the backend dbType to the
+// layout of this node. The mempool dump at dumpPath, whose encoding is of the
+// old node, is removed if the layout is upgraded. It returns the version of
+// the upgraded layout.
+func MigrateLayout(dataDir, dbType, dumpPath string) (int, error) {
+	layout, err := ReadLayout(dataDir)
+	if err != nil {
+		return 0, err
+	}
+	if layout == nil {
+		if isEmptyDataDir(dataDir) {
+			return 0, fmt.Errorf("no data directory to migrate: %s", dataDir)
+		}
+		layout = &Layout{DbType: dbType}
+	}
+	if layout.Version > LayoutVersion {
+		return layout.Version, ErrLayoutTooNew
+	}
+	if layout.DbType != dbType {
+		return layout.Version, fmt.Errorf("data directory uses %s instead of %s", layout.DbType, dbType)
+	}
+	if layout.Version == LayoutVersion {
+		return layout.Version, nil
+	}
+	for layout.Version < LayoutVersion {
+		if err := layoutMigrations[layout.Version](dataDir); err != nil {
+			return layout.Version, err
+		}
+		layout.Version++
+		// the progress is recorded at each version to resume a failed one
+		if err := writeLayout(dataDir, layout); err != nil {
+			return layout.Version, err
+		}
+	}
+	if dumpPath != "" {
+		if err := os.Remove(dumpPath); err != nil && !os.IsNotExist(err) {
+			return layout.Version, err
+		}
+	}
+	return layout.Version, nil
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLayout(t *testing.T) {
+	const dataDir = "test_layout"
+	defer os.RemoveAll(dataDir)
+
+	assert.NoError(t, CheckLayout(dataDir, string(db.BadgerImpl)), "new data directory")
+	layout, err := ReadLayout(dataDir)
+	assert.NoError(t, err)
+	assert.Equal(t, &Layout{Version: LayoutVersion, DbType: string(db.BadgerImpl)}, layout)
+	assert.NoError(t, CheckLayout(dataDir, string(db.BadgerImpl)), "same layout")
+	assert.Error(t, CheckLayout(dataDir, string(db.LevelImpl)), "other backend")
+
+	assert.NoError(t, writeLayout(dataDir, &Layout{Version: LayoutVersion + 1, DbType: string(db.BadgerImpl)}))
+	assert.Equal(t, ErrLayoutTooNew, CheckLayout(dataDir, string(db.BadgerImpl)))
+	_, err = MigrateLayout(dataDir, string(db.BadgerImpl), "")
+	assert.Equal(t, ErrLayoutTooNew, err, "downgrade")
+}
+
+func TestMigrateLayout(t *testing.T) {
+	const (
+		dataDir  = "test_layout_old"
+		dumpPath = "test_layout_mempool.dump"
+	)
+	defer os.RemoveAll(dataDir)
+	defer os.Remove(dumpPath)
+
+	_, err := MigrateLayout(dataDir, string(db.BadgerImpl), dumpPath)
+	assert.Error(t, err, "no data directory")
+
+	// a data directory written before the versioning
+	assert.NoError(t, os.MkdirAll(filepath.Join(dataDir, "chain"), 0711))
+	assert.NoError(t, ioutil.WriteFile(dumpPath, []byte("txs"), 0644))
+	assert.Equal(t, ErrLayoutUnversioned, CheckLayout(dataDir, string(db.BadgerImpl)))
+
+	version, err := MigrateLayout(dataDir, string(db.BadgerImpl), dumpPath)
+	assert.NoError(t, err)
+	assert.Equal(t, LayoutVersion, version)
+	assert.NoError(t, CheckLayout(dataDir, string(db.BadgerImpl)), "upgraded")
+	_, err = os.Stat(dumpPath)
+	assert.True(t, os.IsNotExist(err), "mempool dump removed")
+}
@@ -0,0 +1,201 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package storage selects the key-value backends of the chain and state
+// databases and converts data directories between backends.
+package storage
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aergoio/aergo-lib/db"
+)
+
+const (
+	// defaultCopyBatchSize is the number of writes per bulk used by Copy.
+	defaultCopyBatchSize = 10000
+)
+
+// Backend is a persistent key-value backend of the chain and state
+// databases.
+type Backend interface {
+	// Type returns the name of the backend, which is the value of dbtype.
+	Type() string
+	// Open opens or creates the database at dir.
+	Open(dir string) (db.DB, error)
+}
+
+// libBackend is a backend implemented by aergo-lib.
+type libBackend db.ImplType
+
+func (b libBackend) Type() string {
+	return string(b)
+}
+
+func (b libBackend) Open(dir string) (db.DB, error) {
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return nil, err
+	}
+	return db.NewDB(db.ImplType(b), dir), nil
+}
+
+var (
+	// Backends lists the supported key-value backends. The in-memory db of
+	// aergo-lib is not one of them since it persists nothing.
+	Backends = []Backend{libBackend(db.BadgerImpl), libBackend(db.LevelImpl)}
+
+	// KVDirs lists the sub-directories of a data directory which hold
+	// key-value databases. The others are copied as-is by Migrate.
+	KVDirs = []string{"chain", "state"}
+)
+
+// GetBackend returns the backend of type dbType, or nil if it is not
+// supported.
+func GetBackend(dbType string) Backend {
+	for _, b := range Backends {
+		if b.Type() == dbType {
+			return b
+		}
+	}
+	return nil
+}
+
+// IsSupported reports whether dbType is a supported key-value backend.
+func IsSupported(dbType string) bool {
+	return GetBackend(dbType) != nil
+}
+
+// Open opens or creates the database of type dbType at dir.
+func Open(dbType string, dir string) (db.DB, error) {
+	b := GetBackend(dbType)
+	if b == nil {
+		return nil, fmt.Errorf("unsupported db type: %s", dbType)
+	}
+	return b.Open(dir)
+}
+
+// Copy writes every key-value pair of src into dst and returns the number
+// of copied pairs.
+func Copy(src, dst db.DB) int {
+	count := 0
+	bulk := dst.NewBulk()
+	for iter := src.Iterator(nil, nil); iter.Valid(); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		value := append([]byte{}, iter.Value()...)
+		bulk.Set(key, value)
+		count++
+		if count%defaultCopyBatchSize == 0 {
+			bulk.Flush()
+			bulk = dst.NewBulk()
+		}
+	}
+	bulk.Flush()
+	return count
+}
+
+// Migrate converts the data directory srcDir using the backend fromType into
+// dstDir using the backend toType. The key-value databases are copied entry
+// by entry and the other files of the data directory are copied as-is.
+func Migrate(fromType, toType string, srcDir, dstDir string) error {
+	if !IsSupported(fromType) {
+		return fmt.Errorf("unsupported db type: %s", fromType)
+	}
+	if !IsSupported(toType) {
+		return fmt.Errorf("unsupported db type: %s", toType)
+	}
+	if _, err := os.Stat(dstDir); err == nil {
+		return fmt.Errorf("destination already exists: %s", dstDir)
+	}
+	entries, err := ioutil.ReadDir(srcDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		src := filepath.Join(srcDir, entry.Name())
+		dst := filepath.Join(dstDir, entry.Name())
+		if entry.IsDir() && isKVDir(entry.Name()) {
+			if err := migrateDB(fromType, toType, src, dst); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := copyPath(src, dst); err != nil {
+			return err
+		}
+	}
+	// the copied layout records the new backend
+	layout, err := ReadLayout(dstDir)
+	if err != nil || layout == nil {
+		return err
+	}
+	layout.DbType = toType
+	return writeLayout(dstDir, layout)
+}
+
+func isKVDir(name string) bool {
+	for _, dir := range KVDirs {
+		if dir == name {
+			return true
+		}
+	}
+	return false
+}
+
+func migrateDB(fromType, toType string, srcDir, dstDir string) error {
+	src, err := Open(fromType, srcDir)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := Open(toType, dstDir)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	Copy(src, dst)
+	return nil
+}
+
+// copyPath copies a file or a directory recursively.
+func copyPath(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0711); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_RDWR|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo-lib/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported("badgerdb"))
+	assert.True(t, IsSupported("leveldb"))
+	assert.False(t, IsSupported("rocksdb"))
+	assert.False(t, IsSupported("memorydb"), "nothing is persisted")
+
+	_, err := Open("rocksdb", "test")
+	assert.Error(t, err)
+}
+
+func TestMigrate(t *testing.T) {
+	const (
+		srcDir = "test_src"
+		dstDir = "test_dst"
+	)
+	defer os.RemoveAll(srcDir)
+	defer os.RemoveAll(dstDir)
+
+	chain, err := Open(string(db.BadgerImpl), filepath.Join(srcDir, "chain"))
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		chain.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	chain.Close()
+	err = os.MkdirAll(filepath.Join(srcDir, "statesql"), 0711)
+	assert.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(srcDir, "statesql", "contract.db"), []byte("sql"), 0644)
+	assert.NoError(t, err)
+
+	assert.NoError(t, writeLayout(srcDir, &Layout{Version: LayoutVersion, DbType: string(db.BadgerImpl)}))
+
+	err = Migrate(string(db.BadgerImpl), string(db.LevelImpl), srcDir, dstDir)
+	assert.NoError(t, err)
+
+	migrated, err := Open(string(db.LevelImpl), filepath.Join(dstDir, "chain"))
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), migrated.Get([]byte(fmt.Sprintf("key%d", i))))
+	}
+	migrated.Close()
+	sql, err := ioutil.ReadFile(filepath.Join(dstDir, "statesql", "contract.db"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("sql"), sql)
+
+	layout, err := ReadLayout(dstDir)
+	assert.NoError(t, err)
+	assert.Equal(t, string(db.LevelImpl), layout.DbType, "layout of the new backend")
+
+	// the destination must not exist
+	err = Migrate(string(db.BadgerImpl), string(db.LevelImpl), srcDir, dstDir)
+	assert.Error(t, err)
+
+	err = Migrate(string(db.BadgerImpl), string(db.MemoryImpl), srcDir, "test_mem")
+	assert.Error(t, err, "migrate to the in-memory db")
+	_, err = os.Stat("test_mem")
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,163 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package trie
+
+import (
+	"bytes"
+)
+
+// Walk calls fn for every key-value pair stored under the given trie root.
+// Keys are visited in ascending order. Walking stops at the first error
+// returned by fn.
+func (s *Trie) Walk(root []byte, fn func(key, value []byte) error) error {
+	return s.WalkFrom(root, nil, fn)
+}
+
+// WalkFrom is like Walk but skips the keys lower than start, which makes
+// it possible to resume a previous walk.
+func (s *Trie) WalkFrom(root, start []byte, fn func(key, value []byte) error) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if len(start) == 0 {
+		return s.walk(root, nil, 0, s.TrieHeight, fn)
+	}
+	return s.walkFrom(root, start, nil, 0, s.TrieHeight, fn)
+}
+
+// walk visits the leaves of the subtree rooted at root from left to right.
+func (s *Trie) walk(root []byte, batch [][]byte, iBatch, height int, fn func(key, value []byte) error) error {
+	if len(root) == 0 {
+		return nil
+	}
+	batch, iBatch, lnode, rnode, isShortcut, err := s.loadChildren(root, height, iBatch, batch)
+	if err != nil {
+		return err
+	}
+	if isShortcut {
+		return fn(lnode[:HashLength], rnode[:HashLength])
+	}
+	if err := s.walk(lnode, batch, 2*iBatch+1, height-1, fn); err != nil {
+		return err
+	}
+	return s.walk(rnode, batch, 2*iBatch+2, height-1, fn)
+}
+
+// walkFrom visits the leaves of a subtree whose path is a prefix of start.
+// The subtrees located entirely on the left of start are skipped.
+func (s *Trie) walkFrom(root, start []byte, batch [][]byte, iBatch, height int, fn func(key, value []byte) error) error {
+	if len(root) == 0 {
+		return nil
+	}
+	batch, iBatch, lnode, rnode, isShortcut, err := s.loadChildren(root, height, iBatch, batch)
+	if err != nil {
+		return err
+	}
+	if isShortcut {
+		if bytes.Compare(lnode[:HashLength], start) < 0 {
+			return nil
+		}
+		return fn(lnode[:HashLength], rnode[:HashLength])
+	}
+	if bitIsSet(start, s.TrieHeight-height) {
+		return s.walkFrom(rnode, start, batch, 2*iBatch+2, height-1, fn)
+	}
+	if err := s.walkFrom(lnode, start, batch, 2*iBatch+1, height-1, fn); err != nil {
+		return err
+	}
+	return s.walk(rnode, batch, 2*iBatch+2, height-1, fn)
+}
+
+// Diff calls fn for every key whose value differs between the trie roots
+// from and to. A nil oldValue means the key was added and a nil newValue
+// means the key was deleted. Subtrees with identical hashes are skipped, so
+// the cost is proportional to the number of changes.
+func (s *Trie) Diff(from, to []byte, fn func(key, oldValue, newValue []byte) error) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.diff(from, to, nil, nil, 0, 0, s.TrieHeight, fn)
+}
+
+// diff compares the subtrees rooted at a and b which are located at the same
+// position of their tries.
+func (s *Trie) diff(a, b []byte, batchA, batchB [][]byte, iA, iB, height int, fn func(key, oldValue, newValue []byte) error) error {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	if len(a) != 0 && len(b) != 0 && bytes.Equal(a[:HashLength], b[:HashLength]) {
+		return nil
+	}
+	if len(a) != 0 && len(b) != 0 {
+		var (
+			la, ra, lb, rb []byte
+			sa, sb         bool
+			err            error
+		)
+		batchA, iA, la, ra, sa, err = s.loadChildren(a, height, iA, batchA)
+		if err != nil {
+			return err
+		}
+		batchB, iB, lb, rb, sb, err = s.loadChildren(b, height, iB, batchB)
+		if err != nil {
+			return err
+		}
+		if !sa && !sb {
+			if err := s.diff(la, lb, batchA, batchB, 2*iA+1, 2*iB+1, height-1, fn); err != nil {
+				return err
+			}
+			return s.diff(ra, rb, batchA, batchB, 2*iA+2, 2*iB+2, height-1, fn)
+		}
+	}
+	// one side is empty or a shortcut: compare the leaves of both subtrees
+	oldKeys, oldValues, err := s.collect(a, batchA, iA, height)
+	if err != nil {
+		return err
+	}
+	newKeys, newValues, err := s.collect(b, batchB, iB, height)
+	if err != nil {
+		return err
+	}
+	i, j := 0, 0
+	for i < len(oldKeys) || j < len(newKeys) {
+		var cmp int
+		switch {
+		case i == len(oldKeys):
+			cmp = 1
+		case j == len(newKeys):
+			cmp = -1
+		default:
+			cmp = bytes.Compare(oldKeys[i], newKeys[j])
+		}
+		switch {
+		case cmp < 0:
+			err = fn(oldKeys[i], oldValues[i], nil)
+			i++
+		case cmp > 0:
+			err = fn(newKeys[j], nil, newValues[j])
+			j++
+		default:
+			if !bytes.Equal(oldValues[i], newValues[j]) {
+				err = fn(oldKeys[i], oldValues[i], newValues[j])
+			}
+			i++
+			j++
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collect returns the ordered key-value pairs of a subtree.
+func (s *Trie) collect(root []byte, batch [][]byte, iBatch, height int) ([][]byte, [][]byte, error) {
+	var keys, values [][]byte
+	err := s.walk(root, batch, iBatch, height, func(key, value []byte) error {
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	})
+	return keys, values, err
+}
@@ -638,6 +638,148 @@ func TestStash(t *testing.T) {
 	os.RemoveAll(".aergo")
 }
 
+func TestTrieWalk(t *testing.T) {
+	smt := NewTrie(nil, common.Hasher, nil)
+	// Add data to empty trie
+	keys := getFreshData(50, 32)
+	values := getFreshData(50, 32)
+	root, _ := smt.Update(keys, values)
+
+	i := 0
+	err := smt.Walk(root, func(key, value []byte) error {
+		if !bytes.Equal(keys[i], key) || !bytes.Equal(values[i], value) {
+			t.Fatal("walk didnt return the key-value pairs in order")
+		}
+		i++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != len(keys) {
+		t.Fatalf("walk visited %d keys, expected %d", i, len(keys))
+	}
+	if err := smt.Walk(nil, func(key, value []byte) error {
+		t.Fatal("empty trie shouldnt have any key")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// resume walking from an existing key
+	i = 20
+	err = smt.WalkFrom(root, keys[20], func(key, value []byte) error {
+		if !bytes.Equal(keys[i], key) {
+			t.Fatal("walk didnt resume from the start key")
+		}
+		i++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != len(keys) {
+		t.Fatalf("walk visited %d keys, expected %d", i-20, len(keys)-20)
+	}
+
+	// resume walking from a key which is not in the trie
+	start := make([]byte, 32)
+	copy(start, keys[30])
+	start[31]++
+	var visited [][]byte
+	err = smt.WalkFrom(root, start, func(key, value []byte) error {
+		visited = append(visited, key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != len(keys)-31 || !bytes.Equal(visited[0], keys[31]) {
+		t.Fatal("walk didnt skip the keys lower than start")
+	}
+}
+
+func TestTrieDiff(t *testing.T) {
+	dbPath := path.Join(".aergo", "db")
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		_ = os.MkdirAll(dbPath, 0711)
+	}
+	st := db.NewDB(db.BadgerImpl, dbPath)
+	defer os.RemoveAll(".aergo")
+	defer st.Close()
+	smt := NewTrie(nil, common.Hasher, st)
+	// Add data to empty trie
+	keys := getFreshData(30, 32)
+	values := getFreshData(30, 32)
+	from, _ := smt.Update(keys, values)
+	smt.Commit()
+
+	// modify 5 keys, delete 5 keys and add 5 keys
+	newValues := getFreshData(5, 32)
+	modified := [][]byte{}
+	modified = append(modified, keys[:5]...)
+	deleted := keys[10:15]
+	added := getFreshData(5, 32)
+	updKeys := append(append(append([][]byte{}, modified...), deleted...), added...)
+	updValues := append(append(append([][]byte{}, newValues...), DefaultLeaf, DefaultLeaf, DefaultLeaf, DefaultLeaf, DefaultLeaf), getFreshData(5, 32)...)
+	sort.Sort(kvArray{updKeys, updValues})
+	to, _ := smt.Update(updKeys, updValues)
+	smt.Commit()
+
+	changes := make(map[string][2][]byte)
+	err := smt.Diff(from, to, func(key, oldValue, newValue []byte) error {
+		changes[string(key)] = [2][]byte{oldValue, newValue}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 15 {
+		t.Fatalf("expected 15 changed keys, got %d", len(changes))
+	}
+	for i, key := range modified {
+		c, ok := changes[string(key)]
+		if !ok || !bytes.Equal(c[0], values[i]) || !bytes.Equal(c[1], newValues[i]) {
+			t.Fatal("modified key not reported")
+		}
+	}
+	for _, key := range deleted {
+		if c, ok := changes[string(key)]; !ok || c[0] == nil || c[1] != nil {
+			t.Fatal("deleted key not reported")
+		}
+	}
+	for _, key := range added {
+		if c, ok := changes[string(key)]; !ok || c[0] != nil || c[1] == nil {
+			t.Fatal("added key not reported")
+		}
+	}
+
+	// no difference between identical roots
+	err = smt.Diff(to, to, func(key, oldValue, newValue []byte) error {
+		t.Fatal("identical tries shouldnt have differences")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// kvArray sorts keys and their values together
+type kvArray struct {
+	keys, values [][]byte
+}
+
+func (d kvArray) Len() int {
+	return len(d.keys)
+}
+func (d kvArray) Swap(i, j int) {
+	d.keys[i], d.keys[j] = d.keys[j], d.keys[i]
+	d.values[i], d.values[j] = d.values[j], d.values[i]
+}
+func (d kvArray) Less(i, j int) bool {
+	return bytes.Compare(d.keys[i], d.keys[j]) == -1
+}
+
 func benchmark10MAccounts10Ktps(smt *Trie, b *testing.B) {
 	//b.ReportAllocs()
 	keys := getFreshData(100, 32)
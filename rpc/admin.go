@@ -0,0 +1,146 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/internal/profiler"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+	"github.com/libp2p/go-libp2p-peer"
+	"google.golang.org/grpc/codes"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// checkDebugAccess refuses the methods changing the logging or the profiling
+// of the node, unless the clients are authenticated and the admin role is
+// checked for them, or the client is on the local host.
+func (rpc *AergoRPCService) checkDebugAccess(ctx context.Context) error {
+	if rpc.nsAuth {
+		return nil
+	}
+	if p, ok := grpcpeer.FromContext(ctx); ok {
+		if addr, ok := p.Addr.(*net.TCPAddr); ok && addr.IP.IsLoopback() {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "allowed only to the local clients without rpc auth")
+}
+
+func logLevelList() *types.LogLevelList {
+	levels := logctl.Levels()
+	list := &types.LogLevelList{Levels: make([]*types.LogLevel, len(levels))}
+	for i, l := range levels {
+		list.Levels[i] = &types.LogLevel{Module: l.Module, Level: l.Level}
+	}
+	return list
+}
+
+// GetLogLevels handle rpc request of the log levels of the modules
+func (rpc *AergoRPCService) GetLogLevels(ctx context.Context, in *types.Empty) (*types.LogLevelList, error) {
+	return logLevelList(), nil
+}
+
+// SetLogLevel handle rpc request to change the log level of a module
+func (rpc *AergoRPCService) SetLogLevel(ctx context.Context, in *types.LogLevel) (*types.LogLevelList, error) {
+	if err := rpc.checkDebugAccess(ctx); err != nil {
+		return nil, err
+	}
+	if err := logctl.SetLevel(in.Module, in.Level); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger.Info().Str("module", in.Module).Str("level", in.Level).Msg("log level changed")
+	return logLevelList(), nil
+}
+
+// SetProfiling handle rpc request to start or stop serving the pprof endpoints
+func (rpc *AergoRPCService) SetProfiling(ctx context.Context, in *types.Profiling) (*types.Profiling, error) {
+	if err := rpc.checkDebugAccess(ctx); err != nil {
+		return nil, err
+	}
+	if in.Enabled {
+		if err := profiler.Start(rpc.profileAddr); err != nil && err != profiler.ErrRunning {
+			return nil, status.Errorf(codes.Internal, "failed to start profiling: %s", err.Error())
+		}
+	} else if err := profiler.Stop(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to stop profiling: %s", err.Error())
+	}
+	addr := profiler.Address()
+	logger.Info().Str("address", addr).Bool("enabled", addr != "").Msg("profiling changed")
+	return &types.Profiling{Enabled: addr != "", Address: addr}, nil
+}
+
+// DumpProfile handle rpc request to write a profile of the node to a file
+func (rpc *AergoRPCService) DumpProfile(ctx context.Context, in *types.ProfileDump) (*types.ProfileDump, error) {
+	if err := rpc.checkDebugAccess(ctx); err != nil {
+		return nil, err
+	}
+	if in.Kind == "" {
+		return nil, status.Error(codes.InvalidArgument, "no profile kind")
+	}
+	path, err := profiler.Dump(rpc.dumpDir, in.Kind)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger.Info().Str("kind", in.Kind).Str("path", path).Msg("profile dumped")
+	return &types.ProfileDump{Kind: in.Kind, Path: path}, nil
+}
+
+func bannedPeers(result interface{}, err error) (*types.BannedPeerList, error) {
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.BannedPeersRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err == message.PeerNotBannedError {
+		return nil, status.Error(codes.NotFound, rsp.Err.Error())
+	} else if rsp.Err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save banned peers: %s", rsp.Err.Error())
+	}
+	return &types.BannedPeerList{Peers: rsp.Peers}, nil
+}
+
+// BanPeer handle rpc request to ban a peer, which is disconnected and
+// refused until the ban expires or is lifted
+func (rpc *AergoRPCService) BanPeer(ctx context.Context, in *types.BanParams) (*types.BannedPeerList, error) {
+	peerID, err := peer.IDFromBytes(in.PeerID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer id: %s", err.Error())
+	}
+	if in.Duration < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative ban duration")
+	}
+	msg := &message.BanPeer{PeerID: peerID, Reason: in.Reason}
+	if in.Duration != 0 {
+		msg.Until = time.Now().Add(time.Duration(in.Duration))
+	}
+	return bannedPeers(rpc.hub.RequestFuture(message.P2PSvc, msg, defaultActorTimeout,
+		"rpc.(*AergoRPCService).BanPeer").Result())
+}
+
+// UnbanPeer handle rpc request to lift the ban of a peer
+func (rpc *AergoRPCService) UnbanPeer(ctx context.Context, in *types.SingleBytes) (*types.BannedPeerList, error) {
+	peerID, err := peer.IDFromBytes(in.Value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer id: %s", err.Error())
+	}
+	return bannedPeers(rpc.hub.RequestFuture(message.P2PSvc, &message.UnbanPeer{PeerID: peerID}, defaultActorTimeout,
+		"rpc.(*AergoRPCService).UnbanPeer").Result())
+}
+
+// ListBannedPeers handle rpc request of the banned peers
+func (rpc *AergoRPCService) ListBannedPeers(ctx context.Context, in *types.Empty) (*types.BannedPeerList, error) {
+	return bannedPeers(rpc.hub.RequestFuture(message.P2PSvc, &message.GetBannedPeers{}, defaultActorTimeout,
+		"rpc.(*AergoRPCService).ListBannedPeers").Result())
+}
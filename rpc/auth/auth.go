@@ -0,0 +1,207 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package auth authenticates the clients of the rpc service by tokens or by
+// client certificates, and checks their roles against the rpc methods.
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aergoio/aergo/internal/logctl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logger = logctl.NewLogger("rpc")
+
+// Role is the access level of a client. A role allows the methods of the
+// lower roles.
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleReadOnly
+	RoleTxSubmit
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:     "none",
+	RoleReadOnly: "read-only",
+	RoleTxSubmit: "tx-submit",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	return roleNames[r]
+}
+
+// ParseRole returns the role of the name. An empty name is RoleNone.
+func ParseRole(name string) (Role, error) {
+	if name == "" {
+		return RoleNone, nil
+	}
+	for role, n := range roleNames {
+		if n == name {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown role %s", name)
+}
+
+// methodRoles are the roles required by the rpc methods other than the read
+// only ones.
+var methodRoles = map[string]Role{
+	"SendTX":   RoleTxSubmit,
+	"SignTX":   RoleTxSubmit,
+	"CommitTX": RoleTxSubmit,
+
+	"NodeState":             RoleAdmin,
+	"Metric":                RoleAdmin,
+	"GetPeers":              RoleAdmin,
+	"GetServerInfo":         RoleAdmin,
+	"SubmitBlock":           RoleAdmin,
+	"CreateAccount":         RoleAdmin,
+	"GetAccounts":           RoleAdmin,
+	"LockAccount":           RoleAdmin,
+	"UnlockAccount":         RoleAdmin,
+	"GetUnlockStatus":       RoleAdmin,
+	"ListSignAudits":        RoleAdmin,
+	"SetAlias":              RoleAdmin,
+	"DeleteAlias":           RoleAdmin,
+	"AddWatchAccount":       RoleAdmin,
+	"RemoveWatchAccount":    RoleAdmin,
+	"ImportAccount":         RoleAdmin,
+	"ExportAccount":         RoleAdmin,
+	"ImportAccountKeystore": RoleAdmin,
+	"ExportAccountKeystore": RoleAdmin,
+	"CreateHDWallet":        RoleAdmin,
+	"ExportMnemonic":        RoleAdmin,
+	"DeriveAccounts":        RoleAdmin,
+	"ListHDAccounts":        RoleAdmin,
+	"ChangeMembership":      RoleAdmin,
+	"TransferLeader":        RoleAdmin,
+	"CreateClusterSnapshot": RoleAdmin,
+	"GetLogLevels":          RoleAdmin,
+	"SetLogLevel":           RoleAdmin,
+	"SetProfiling":          RoleAdmin,
+	"DumpProfile":           RoleAdmin,
+	"BanPeer":               RoleAdmin,
+	"UnbanPeer":             RoleAdmin,
+	"ListBannedPeers":       RoleAdmin,
+	"ListStateDiffStream":   RoleAdmin,
+}
+
+// publicMethods are the methods of the other grpc services allowed to all
+// the clients, like the probes of the orchestrators. They are given by their
+// full names.
+var publicMethods = map[string]bool{
+	"/grpc.health.v1.Health/Check": true,
+}
+
+// MethodRole returns the role required to call the method, given by its
+// name or by its full name like /types.AergoRPCService/GetBlock.
+func MethodRole(method string) Role {
+	if publicMethods[method] {
+		return RoleNone
+	}
+	if role, ok := methodRoles[method[strings.LastIndex(method, "/")+1:]]; ok {
+		return role
+	}
+	return RoleReadOnly
+}
+
+// Identity is an authenticated client.
+type Identity struct {
+	Name string
+	Role Role
+}
+
+// Authenticator authenticates the clients and checks their roles.
+type Authenticator struct {
+	// tokens are the identities of the sha256 hashes of the tokens
+	tokens    map[[sha256.Size]byte]Identity
+	anonymous Role
+}
+
+// NewAuthenticator returns an authenticator of the tokens given as
+// role:token. The clients without a token or a verified client certificate
+// get the anonymous role.
+func NewAuthenticator(tokens []string, anonymous string) (*Authenticator, error) {
+	a := &Authenticator{tokens: make(map[[sha256.Size]byte]Identity)}
+	var err error
+	if a.anonymous, err = ParseRole(anonymous); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		i := strings.Index(t, ":")
+		if i < 0 || i == len(t)-1 {
+			return nil, fmt.Errorf("auth token is not in the form role:token")
+		}
+		role, err := ParseRole(t[:i])
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256([]byte(t[i+1:]))
+		// the tokens are logged by the prefix of their hashes
+		a.tokens[hash] = Identity{Name: "token:" + hex.EncodeToString(hash[:4]), Role: role}
+	}
+	return a, nil
+}
+
+// Authenticate returns the identity of the client of the token, or of the
+// client certificate verified in the TLS connection. A token takes
+// precedence over a certificate.
+func (a *Authenticator) Authenticate(token string, state *tls.ConnectionState) (Identity, error) {
+	if token != "" {
+		id, ok := a.tokens[sha256.Sum256([]byte(token))]
+		if !ok {
+			return Identity{}, status.Error(codes.Unauthenticated, "invalid auth token")
+		}
+		return id, nil
+	}
+	if state != nil && len(state.VerifiedChains) > 0 {
+		cert := state.VerifiedChains[0][0]
+		id := Identity{Name: "cert:" + cert.Subject.CommonName}
+		// the role is the highest one among the organizational units
+		for _, ou := range cert.Subject.OrganizationalUnit {
+			if role, err := ParseRole(ou); err == nil && role > id.Role {
+				id.Role = role
+			}
+		}
+		return id, nil
+	}
+	return Identity{Name: "anonymous", Role: a.anonymous}, nil
+}
+
+// Authorize checks the role of the client for the method.
+func (a *Authenticator) Authorize(id Identity, method string) error {
+	required := MethodRole(method)
+	if id.Role >= required {
+		return nil
+	}
+	if id.Role == RoleNone {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return status.Errorf(codes.PermissionDenied, "%s role required", required)
+}
+
+// audit writes the call of an admin method with its result to the log.
+func audit(method string, id Identity, peer string, err error) {
+	if MethodRole(method) != RoleAdmin {
+		return
+	}
+	ev := logger.Info()
+	if err != nil {
+		ev = logger.Warn().Err(err)
+	}
+	ev.Str("method", method).Str("client", id.Name).Str("role", id.Role.String()).
+		Str("peer", peer).Msg("audit admin rpc call")
+}
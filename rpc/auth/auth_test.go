@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorize(t *testing.T) {
+	_, err := NewAuthenticator([]string{"root:secret"}, "")
+	assert.Error(t, err, "unknown role")
+	_, err = NewAuthenticator([]string{"admin"}, "")
+	assert.Error(t, err, "no token")
+
+	a, err := NewAuthenticator([]string{"admin:secret", "tx-submit:wallet"}, "read-only")
+	assert.NoError(t, err)
+
+	id, err := a.Authenticate("secret", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, id.Role)
+	assert.NoError(t, a.Authorize(id, "/types.AergoRPCService/ChangeMembership"))
+
+	id, err = a.Authenticate("wallet", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, a.Authorize(id, "CommitTX"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(a.Authorize(id, "UnlockAccount")))
+
+	_, err = a.Authenticate("guess", nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	id, err = a.Authenticate("", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, a.Authorize(id, "GetBlock"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(a.Authorize(id, "SendTX")))
+
+	// the role of a client certificate is its organizational unit
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ops", OrganizationalUnit: []string{"tx-submit", "admin"}}}
+	id, err = a.Authenticate("", &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}})
+	assert.NoError(t, err)
+	assert.Equal(t, Identity{Name: "cert:ops", Role: RoleAdmin}, id)
+
+	// anonymous clients are rejected without an anonymous role
+	a, _ = NewAuthenticator(nil, "")
+	id, _ = a.Authenticate("", nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(a.Authorize(id, "GetBlock")))
+	assert.NoError(t, a.Authorize(id, "/grpc.health.v1.Health/Check"), "health probes")
+}
+
+func TestInterceptors(t *testing.T) {
+	a, _ := NewAuthenticator([]string{"admin:secret"}, "read-only")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		id, _ := FromContext(ctx)
+		return id.Role.String(), nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/types.AergoRPCService/TransferLeader"}
+
+	_, err := a.UnaryInterceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	rsp, err := a.UnaryInterceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", rsp, "identity passed to the handler")
+
+	r := httptest.NewRequest("POST", "/jsonrpc", nil)
+	assert.NoError(t, a.AuthorizeHTTP(r, "GetBlock"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(a.AuthorizeHTTP(r, "CommitTX")))
+	r.Header.Set("Authorization", "bearer secret")
+	assert.NoError(t, a.AuthorizeHTTP(r, "CommitTX"))
+}
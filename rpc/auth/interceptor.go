@@ -0,0 +1,103 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const bearerPrefix = "bearer "
+
+type identityKey struct{}
+
+// NewContext returns a context of ctx with the identity of the client.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity of the client authenticated for ctx.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// UnaryInterceptor checks the role of the client before the unary calls.
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id, addr, err := a.authorizeContext(ctx, info.FullMethod)
+	if err != nil {
+		audit(info.FullMethod, id, addr, err)
+		return nil, err
+	}
+	rsp, err := handler(NewContext(ctx, id), req)
+	audit(info.FullMethod, id, addr, err)
+	return rsp, err
+}
+
+// StreamInterceptor checks the role of the client before the stream calls.
+func (a *Authenticator) StreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	id, addr, err := a.authorizeContext(ss.Context(), info.FullMethod)
+	if err != nil {
+		audit(info.FullMethod, id, addr, err)
+		return err
+	}
+	err = handler(srv, ss)
+	audit(info.FullMethod, id, addr, err)
+	return err
+}
+
+func (a *Authenticator) authorizeContext(ctx context.Context, method string) (Identity, string, error) {
+	var token, addr string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = bearerToken(values[0])
+		}
+	}
+	var id Identity
+	var err error
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			id, err = a.Authenticate(token, &info.State)
+		} else {
+			id, err = a.Authenticate(token, nil)
+		}
+	} else {
+		id, err = a.Authenticate(token, nil)
+	}
+	if err == nil {
+		err = a.Authorize(id, method)
+	}
+	return id, addr, err
+}
+
+// AuthorizeHTTP checks the role of the client of the http request for the
+// method.
+func (a *Authenticator) AuthorizeHTTP(r *http.Request, method string) error {
+	id, err := a.Authenticate(bearerToken(r.Header.Get("Authorization")), r.TLS)
+	if err == nil {
+		err = a.Authorize(id, method)
+	}
+	audit(method, id, r.RemoteAddr, err)
+	return err
+}
+
+// bearerToken returns the token of the authorization header value
+// "Bearer <token>".
+func bearerToken(value string) string {
+	if len(value) < len(bearerPrefix) || strings.ToLower(value[:len(bearerPrefix)]) != bearerPrefix {
+		return ""
+	}
+	return strings.TrimSpace(value[len(bearerPrefix):])
+}
@@ -0,0 +1,171 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// certSettleTime is the time to wait after a change of the certificate files
+// before reloading them, since the certificate and the key are usually
+// replaced one after the other.
+var certSettleTime = time.Second
+
+// certReloader serves the TLS config of the certificate files and reloads
+// them when they are changed or the node gets SIGHUP, so a renewed
+// certificate is used by the new connections without restarting the node. A
+// failed reload keeps the current certificate.
+type certReloader struct {
+	certFile, keyFile, caFile string
+
+	config atomic.Value // *tls.Config
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newCertReloader(certFile, keyFile, caFile string) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) reload() error {
+	config, err := loadTLSConfig(r.certFile, r.keyFile, r.caFile)
+	if err != nil {
+		return err
+	}
+	r.config.Store(config)
+	return nil
+}
+
+// tlsConfig returns the TLS config of the listeners, which takes the current
+// certificate for each handshake.
+func (r *certReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.config.Load().(*tls.Config), nil
+		},
+	}
+}
+
+func (r *certReloader) certificate() *tls.Certificate {
+	return &r.config.Load().(*tls.Config).Certificates[0]
+}
+
+// start watches the certificate files and SIGHUP until stop is called.
+func (r *certReloader) start() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to watch the certificate files, reload them by SIGHUP")
+	} else {
+		// the directories are watched since the files are often replaced by
+		// renaming or by updating symlinks
+		for dir := range r.dirs() {
+			if err := watcher.Add(dir); err != nil {
+				logger.Warn().Err(err).Str("dir", dir).Msg("failed to watch the directory of the certificate files")
+			}
+		}
+		events, errs = watcher.Events, watcher.Errors
+	}
+
+	go func() {
+		defer close(r.done)
+		defer signal.Stop(hup)
+		if watcher != nil {
+			defer watcher.Close()
+		}
+		var settle <-chan time.Time
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-hup:
+				r.reloadByEvent("SIGHUP")
+			case ev, ok := <-events:
+				if !ok {
+					events = nil
+				} else if r.isCertFile(ev.Name) {
+					settle = time.After(certSettleTime)
+				}
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+				} else {
+					logger.Warn().Err(err).Msg("error while watching the certificate files")
+				}
+			case <-settle:
+				settle = nil
+				r.reloadByEvent("file change")
+			}
+		}
+	}()
+}
+
+func (r *certReloader) reloadByEvent(event string) {
+	if err := r.reload(); err != nil {
+		logger.Error().Err(err).Str("event", event).Msg("failed to reload the TLS certificate, keeping the current one")
+		return
+	}
+	l := logger.Info().Str("event", event).Str("cert", r.certFile)
+	if leaf, err := x509.ParseCertificate(r.certificate().Certificate[0]); err == nil {
+		l = l.Time("notAfter", leaf.NotAfter)
+	}
+	l.Msg("reloaded the TLS certificate")
+}
+
+func (r *certReloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+	return files
+}
+
+func (r *certReloader) dirs() map[string]bool {
+	dirs := make(map[string]bool)
+	for _, f := range r.files() {
+		dirs[filepath.Dir(f)] = true
+	}
+	return dirs
+}
+
+func (r *certReloader) isCertFile(name string) bool {
+	name = filepath.Clean(name)
+	for _, f := range r.files() {
+		if filepath.Clean(f) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stops watching the certificate files.
+func (r *certReloader) Stop() {
+	close(r.stop)
+	<-r.done
+}
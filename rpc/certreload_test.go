@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestCert(t *testing.T, certFile, keyFile string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "certreload")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer func(d time.Duration) { certSettleTime = d }(certSettleTime)
+	certSettleTime = 10 * time.Millisecond
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeTestCert(t, certFile, keyFile, 1)
+	r, err := newCertReloader(certFile, keyFile, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	serial := func() int64 {
+		config, err := r.tlsConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+		assert.NoError(t, err)
+		leaf, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		assert.NoError(t, err)
+		return leaf.SerialNumber.Int64()
+	}
+	assert.Equal(t, int64(1), serial())
+
+	// an invalid certificate keeps the current one
+	assert.NoError(t, ioutil.WriteFile(certFile, []byte("invalid"), 0600))
+	assert.Error(t, r.reload())
+	assert.Equal(t, int64(1), serial())
+
+	// the changed files are reloaded
+	r.start()
+	defer r.Stop()
+	writeTestCert(t, certFile, keyFile, 2)
+	for i := 0; i < 300 && serial() != 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int64(2), serial())
+
+	_, err = newCertReloader(filepath.Join(dir, "none.pem"), keyFile, "")
+	assert.Error(t, err)
+}
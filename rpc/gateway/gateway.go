@@ -0,0 +1,385 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package gateway serves the query and tx submission APIs of the rpc service
+// as JSON-RPC 2.0 and REST endpoints over plain HTTP, for the clients which
+// can't use gRPC or gRPC-web, with the subscriptions to the new blocks, txs
+// and events over websocket.
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Methods are the rpc methods served by the gateway. The methods managing the
+// accounts of the node or the cluster are left out.
+var Methods = []string{
+	"Blockchain",
+	"GetChainInfo",
+	"ChainStat",
+	"ListBlockHeaders",
+	"ListBlockMetadata",
+	"GetBlock",
+	"GetBlockMetadata",
+	"GetBlockBody",
+	"GetBlockDetail",
+	"GetBlocksBulk",
+	"GetTX",
+	"GetBlockTX",
+	"GetTxsBulk",
+	"GetReceipt",
+	"GetABI",
+	"GetContractABI",
+	"VerifyTX",
+	"CommitTX",
+	"GetState",
+	"GetStateAndProof",
+	"QueryContract",
+	"EncodeCall",
+	"QueryContractState",
+	"GetBridgeProof",
+	"ListContractStorage",
+	"GetContractStorageUsage",
+	"GetInternalOperations",
+	"GetVotes",
+	"GetAccountVotes",
+	"GetAccountTxHistory",
+	"GetStaking",
+	"GetPendingWithdrawals",
+	"GetSystemAccountInfo",
+	"SimulateSystemTx",
+	"GetBlockTemplate",
+	"GetElectionTally",
+	"GetNameInfo",
+	"ListNameOffers",
+	"ListEvents",
+	"ListEventPage",
+	"ListIndexedEvents",
+	"GetConsensusInfo",
+	"EstimateFee",
+	"GetBaseFee",
+}
+
+const maxBodySize = 1 << 22
+
+// Gateway is the http handler of the gateway.
+type Gateway struct {
+	// Authorize checks the client of the request for the method if it's set.
+	Authorize func(r *http.Request, method string) error
+
+	methods   map[string]reflect.Value
+	routes    []*route
+	allowCORS bool
+	mux       *http.ServeMux
+
+	wsLock  sync.RWMutex
+	wsConns map[*wsConn]struct{}
+}
+
+// New returns a gateway calling the methods of server.
+func New(server types.AergoRPCServiceServer, allowCORS bool) *Gateway {
+	gw := &Gateway{
+		methods:   make(map[string]reflect.Value),
+		routes:    restRoutes,
+		allowCORS: allowCORS,
+		mux:       http.NewServeMux(),
+		wsConns:   make(map[*wsConn]struct{}),
+	}
+	sv := reflect.ValueOf(server)
+	for _, name := range Methods {
+		m := sv.MethodByName(name)
+		if !m.IsValid() || m.Type().NumIn() != 2 || m.Type().NumOut() != 2 {
+			panic("not a unary rpc method: " + name)
+		}
+		gw.methods[name] = m
+	}
+	gw.mux.HandleFunc("/jsonrpc", gw.serveJSONRPC)
+	gw.mux.HandleFunc("/ws", gw.serveWebsocket)
+	gw.mux.HandleFunc("/v1/openapi.json", gw.serveOpenAPI)
+	gw.mux.HandleFunc("/v1/", gw.serveREST)
+	return gw
+}
+
+func (gw *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if gw.allowCORS {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		if r.Method == http.MethodOptions {
+			return
+		}
+	}
+	gw.mux.ServeHTTP(w, r)
+}
+
+// newInput returns a new message of the input type of the method.
+func (gw *Gateway) newInput(name string) (proto.Message, bool) {
+	m, ok := gw.methods[name]
+	if !ok {
+		return nil, false
+	}
+	return reflect.New(m.Type().In(1).Elem()).Interface().(proto.Message), true
+}
+
+func (gw *Gateway) call(r *http.Request, name string, in proto.Message) (proto.Message, error) {
+	if gw.Authorize != nil {
+		if err := gw.Authorize(r, name); err != nil {
+			return nil, err
+		}
+	}
+	out := gw.methods[name].Call([]reflect.Value{reflect.ValueOf(r.Context()), reflect.ValueOf(in)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return nil, err
+	}
+	return out[0].Interface().(proto.Message), nil
+}
+
+var marshaler = &jsonpb.Marshaler{EmitDefaults: true}
+
+func marshal(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshal(data []byte, msg proto.Message) error {
+	if len(bytes.TrimSpace(data)) == 0 || string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(data), msg)
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	return ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+}
+
+// JSON-RPC 2.0
+
+const (
+	errParse          = -32700
+	errInvalidRequest = -32600
+	errMethodNotFound = -32601
+	errInvalidParams  = -32602
+	errInternal       = -32603
+	errServer         = -32000
+)
+
+type rpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+func (gw *Gateway) serveJSONRPC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "JSON-RPC requests are posted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := readBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	body = bytes.TrimSpace(body)
+
+	var out interface{}
+	if len(body) > 0 && body[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			out = errorResponse(nil, errParse, err.Error(), "")
+		} else if len(batch) == 0 {
+			out = errorResponse(nil, errInvalidRequest, "empty batch", "")
+		} else {
+			responses := []*rpcResponse{}
+			for _, req := range batch {
+				if rsp := gw.handleRequest(r, req); rsp != nil {
+					responses = append(responses, rsp)
+				}
+			}
+			if len(responses) > 0 {
+				out = responses
+			}
+		}
+	} else if rsp := gw.handleRequest(r, body); rsp != nil {
+		out = rsp
+	}
+	if out == nil {
+		// only notifications
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(out)
+}
+
+// handleRequest returns the response to the request, or nil for a
+// notification.
+func (gw *Gateway) handleRequest(r *http.Request, data json.RawMessage) *rpcResponse {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errorResponse(nil, errParse, err.Error(), "")
+	}
+	if req.Version != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, errInvalidRequest, "not a JSON-RPC 2.0 request", "")
+	}
+	notification := len(req.ID) == 0
+	rsp := gw.handleMethod(r, req.Method, req.Params)
+	if notification {
+		return nil
+	}
+	rsp.ID = req.ID
+	return rsp
+}
+
+func (gw *Gateway) handleMethod(r *http.Request, method string, params json.RawMessage) *rpcResponse {
+	in, ok := gw.newInput(method)
+	if !ok {
+		return errorResponse(nil, errMethodNotFound, "method not found: "+method, "")
+	}
+	if err := unmarshal(params, in); err != nil {
+		return errorResponse(nil, errInvalidParams, err.Error(), "")
+	}
+	msg, err := gw.call(r, method, in)
+	if err != nil {
+		return errorResponse(nil, errServer, err.Error(), status.Code(err).String())
+	}
+	result, err := marshal(msg)
+	if err != nil {
+		return errorResponse(nil, errInternal, err.Error(), "")
+	}
+	return &rpcResponse{Version: "2.0", Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, message, data string) *rpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &rpcResponse{
+		Version: "2.0",
+		Error:   &rpcError{Code: code, Message: message, Data: data},
+		ID:      id,
+	}
+}
+
+// REST
+
+func (gw *Gateway) serveREST(w http.ResponseWriter, r *http.Request) {
+	rt, vars := matchRoute(gw.routes, r.Method, r.URL.Path)
+	if rt == nil {
+		writeError(w, http.StatusNotFound, "no such endpoint: "+r.Method+" "+r.URL.Path)
+		return
+	}
+	var body []byte
+	if r.Method == http.MethodPost {
+		var err error
+		if body, err = readBody(r); err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+	}
+	in, _ := gw.newInput(rt.rpc)
+	if err := rt.input(in, vars, r, body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	msg, err := gw.call(r, rt.rpc, in)
+	if err != nil {
+		writeError(w, httpStatus(err), err.Error())
+		return
+	}
+	out, err := marshal(msg)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
+
+// matchRoute returns the route of the path with the values of its variables.
+func matchRoute(routes []*route, method, path string) (*route, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, rt := range routes {
+		if rt.method != method {
+			continue
+		}
+		pattern := strings.Split(strings.Trim(rt.path, "/"), "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+		vars := make(map[string]string)
+		for i, p := range pattern {
+			if strings.HasPrefix(p, "{") {
+				vars[strings.Trim(p, "{}")] = segments[i]
+			} else if p != segments[i] {
+				vars = nil
+				break
+			}
+		}
+		if vars != nil {
+			return rt, vars
+		}
+	}
+	return nil, nil
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+func errInvalidVar(name, value string, err error) error {
+	return fmt.Errorf("invalid %s %s: %v", name, value, err)
+}
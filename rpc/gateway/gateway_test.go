@@ -0,0 +1,186 @@
+package gateway
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServer struct {
+	types.AergoRPCServiceServer
+	committed *types.TxList
+}
+
+func (s *testServer) Blockchain(ctx context.Context, in *types.Empty) (*types.BlockchainStatus, error) {
+	return &types.BlockchainStatus{BestBlockHash: []byte{1, 2, 3}, BestHeight: 100}, nil
+}
+
+func (s *testServer) GetBlock(ctx context.Context, in *types.SingleBytes) (*types.Block, error) {
+	if len(in.Value) == 8 {
+		no := binary.LittleEndian.Uint64(in.Value)
+		return &types.Block{Header: &types.BlockHeader{BlockNo: no}}, nil
+	}
+	return nil, status.Error(codes.NotFound, "block not found")
+}
+
+func (s *testServer) GetState(ctx context.Context, in *types.SingleBytes) (*types.State, error) {
+	return &types.State{Nonce: uint64(len(in.Value))}, nil
+}
+
+func (s *testServer) GetAccountTxHistory(ctx context.Context, in *types.AccountTxHistoryParams) (*types.AccountTxHistory, error) {
+	return &types.AccountTxHistory{
+		Txs:        []*types.AccountTx{{BlockNo: uint64(in.Size), Status: "SUCCESS"}},
+		NextCursor: append(in.Cursor, 1),
+	}, nil
+}
+
+func (s *testServer) CommitTX(ctx context.Context, in *types.TxList) (*types.CommitResultList, error) {
+	s.committed = in
+	results := &types.CommitResultList{}
+	for _, tx := range in.Txs {
+		results.Results = append(results.Results, &types.CommitResult{Hash: tx.Hash})
+	}
+	return results, nil
+}
+
+func post(gw *Gateway, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return w
+}
+
+func get(gw *Gateway, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestJSONRPC(t *testing.T) {
+	gw := New(&testServer{}, false)
+
+	w := post(gw, "/jsonrpc", `{"jsonrpc": "2.0", "method": "Blockchain", "id": 1}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var rsp struct {
+		Result *struct {
+			BestHeight string `json:"bestHeight"`
+		} `json:"result"`
+		Error *rpcError       `json:"error"`
+		ID    json.RawMessage `json:"id"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &rsp))
+	assert.Equal(t, "100", rsp.Result.BestHeight)
+	assert.Equal(t, "1", string(rsp.ID))
+
+	// batch with a notification, an unknown method, invalid params and an rpc error
+	w = post(gw, "/jsonrpc", `[
+		{"jsonrpc": "2.0", "method": "Blockchain"},
+		{"jsonrpc": "2.0", "method": "CreateAccount", "id": "a"},
+		{"jsonrpc": "2.0", "method": "GetBlock", "params": {"value": 1}, "id": "b"},
+		{"jsonrpc": "2.0", "method": "GetBlock", "params": {"value": "AQ=="}, "id": "c"},
+		{"jsonrpc": "2.0", "method": "GetBlock", "params": {"value": "AQAAAAAAAAA="}, "id": "d"}
+	]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var batch []rpcResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &batch))
+	if assert.Len(t, batch, 4) {
+		assert.Equal(t, errMethodNotFound, batch[0].Error.Code)
+		assert.Equal(t, `"a"`, string(batch[0].ID))
+		assert.Equal(t, errInvalidParams, batch[1].Error.Code)
+		assert.Equal(t, errServer, batch[2].Error.Code)
+		assert.Equal(t, "NotFound", batch[2].Error.Data)
+		assert.Nil(t, batch[3].Error)
+		assert.Contains(t, string(batch[3].Result), `"blockNo":"1"`)
+	}
+
+	w = post(gw, "/jsonrpc", `{"jsonrpc": "2.0", "method": "Blockchain"}`)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	w = post(gw, "/jsonrpc", `{"jsonrpc": "2.0", "method": `)
+	assert.Contains(t, w.Body.String(), `"code":-32700`)
+
+	w = post(gw, "/jsonrpc", `{"method": "Blockchain", "id": 1}`)
+	assert.Contains(t, w.Body.String(), `"code":-32600`)
+}
+
+func TestREST(t *testing.T) {
+	server := &testServer{}
+	gw := New(server, true)
+
+	w := get(gw, "/v1/blocks/10")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"blockNo":"10"`)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+	w = get(gw, "/v1/blocks/"+base58.Encode([]byte{1, 2, 3}))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), `"error"`)
+
+	w = get(gw, "/v1/blocks/0OIl")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = get(gw, "/v1/accounts/AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"nonce":"33"`)
+
+	w = get(gw, "/v1/accounts/AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ4")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = get(gw, "/v1/accounts/AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3/txs?size=5&cursor=AQI%3D")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"blockNo":"5"`)
+	assert.Contains(t, w.Body.String(), `"nextCursor":"AQIB"`, "the cursor is given back as in the response")
+
+	w = post(gw, "/v1/txs", `{"txs": [{"hash": "AQID", "body": {"nonce": "1"}}]}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, uint64(1), server.committed.Txs[0].Body.Nonce)
+	assert.Contains(t, w.Body.String(), `"hash":"AQID"`)
+
+	w = get(gw, "/v1/unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	gw.Authorize = func(r *http.Request, method string) error {
+		if method == "CommitTX" {
+			return status.Error(codes.PermissionDenied, "tx-submit role required")
+		}
+		return nil
+	}
+	w = post(gw, "/v1/txs", `{"txs": []}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = post(gw, "/jsonrpc", `{"jsonrpc": "2.0", "method": "CommitTX", "id": 1}`)
+	assert.Contains(t, w.Body.String(), `"data":"PermissionDenied"`)
+}
+
+func TestOpenAPI(t *testing.T) {
+	gw := New(&testServer{}, false)
+	w := get(gw, "/v1/openapi.json")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var doc struct {
+		OpenAPI    string                                       `json:"openapi"`
+		Paths      map[string]map[string]json.RawMessage        `json:"paths"`
+		Components map[string]map[string]map[string]interface{} `json:"components"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.0.0", doc.OpenAPI)
+	for _, rt := range restRoutes {
+		assert.Contains(t, doc.Paths[rt.path], strings.ToLower(rt.method), rt.path)
+	}
+	assert.Contains(t, doc.Paths["/jsonrpc"], "post")
+
+	schemas := doc.Components["schemas"]
+	tx := schemas["TxBody"]["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "uint64"}, tx["nonce"])
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "byte"}, tx["account"])
+	assert.Contains(t, tx["type"].(map[string]interface{})["enum"], "GOVERNANCE")
+	assert.Contains(t, schemas["BlockchainStatus"]["properties"], "bestChainIdHash")
+}
@@ -0,0 +1,211 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+type schema map[string]interface{}
+
+var customJSON = reflect.TypeOf((*jsonpb.JSONPBMarshaler)(nil)).Elem()
+
+// OpenAPI returns the OpenAPI 3.0 document of the gateway. The schemas are
+// generated from the protobuf messages as they are encoded by jsonpb.
+func (gw *Gateway) OpenAPI() map[string]interface{} {
+	schemas := make(map[string]interface{})
+	paths := make(map[string]interface{})
+
+	for _, rt := range gw.routes {
+		op := schema{
+			"operationId": rt.rpc,
+			"summary":     rt.summary,
+			"responses":   responses(gw.outputSchema(rt.rpc, schemas)),
+		}
+		var params []interface{}
+		for _, seg := range strings.Split(rt.path, "/") {
+			if strings.HasPrefix(seg, "{") {
+				params = append(params, schema{
+					"name": strings.Trim(seg, "{}"), "in": "path", "required": true,
+					"schema": schema{"type": "string"},
+				})
+			}
+		}
+		for _, q := range rt.query {
+			params = append(params, schema{
+				"name": q, "in": "query", "schema": schema{"type": "boolean"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		if rt.body != nil {
+			op["requestBody"] = schema{
+				"required": true,
+				"content":  jsonContent(typeSchema(reflect.TypeOf(rt.body), schemas)),
+			}
+		}
+		item, _ := paths[rt.path].(schema)
+		if item == nil {
+			item = schema{}
+			paths[rt.path] = item
+		}
+		item[strings.ToLower(rt.method)] = op
+	}
+
+	methods := make([]string, 0, len(gw.methods))
+	for name := range gw.methods {
+		methods = append(methods, name)
+	}
+	sort.Strings(methods)
+	var desc strings.Builder
+	desc.WriteString("JSON-RPC 2.0 requests or batches of requests. The params and the result of the methods are:\n\n")
+	for _, name := range methods {
+		in := typeSchema(gw.methods[name].Type().In(1), schemas)
+		out := gw.outputSchema(name, schemas)
+		desc.WriteString("- " + name + ": " + refName(in) + " → " + refName(out) + "\n")
+	}
+	paths["/jsonrpc"] = schema{
+		"post": schema{
+			"operationId": "JSONRPC",
+			"summary":     "Calls the rpc methods",
+			"description": desc.String(),
+			"requestBody": schema{"required": true, "content": jsonContent(schema{})},
+			"responses":   responses(schema{}),
+		},
+	}
+
+	return map[string]interface{}{
+		"openapi":    "3.0.0",
+		"info":       schema{"title": "Aergo gateway", "version": "v1"},
+		"paths":      paths,
+		"components": schema{"schemas": schemas},
+	}
+}
+
+func (gw *Gateway) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(gw.OpenAPI())
+}
+
+func (gw *Gateway) outputSchema(name string, schemas map[string]interface{}) schema {
+	return typeSchema(gw.methods[name].Type().Out(0), schemas)
+}
+
+func responses(out schema) schema {
+	errSchema := jsonContent(schema{
+		"type":       "object",
+		"properties": schema{"error": schema{"type": "string"}},
+	})
+	return schema{
+		"200":     schema{"description": "OK", "content": jsonContent(out)},
+		"default": schema{"description": "Error", "content": errSchema},
+	}
+}
+
+func jsonContent(s schema) schema {
+	return schema{"application/json": schema{"schema": s}}
+}
+
+func refName(s schema) string {
+	if ref, ok := s["$ref"].(string); ok {
+		return ref[strings.LastIndex(ref, "/")+1:]
+	}
+	return "object"
+}
+
+// typeSchema returns the schema of the go type of a protobuf message or
+// field, adding the schemas of the messages to schemas.
+func typeSchema(t reflect.Type, schemas map[string]interface{}) schema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int32:
+		return schema{"type": "integer", "format": "int32"}
+	case reflect.Uint32:
+		return schema{"type": "integer", "format": "uint32"}
+	case reflect.Int64, reflect.Uint64:
+		// jsonpb encodes 64 bit integers as strings
+		return schema{"type": "string", "format": t.Kind().String()}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return schema{"type": "string", "format": "byte"}
+		}
+		return schema{"type": "array", "items": typeSchema(t.Elem(), schemas)}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": typeSchema(t.Elem(), schemas)}
+	case reflect.Struct:
+		ref := schema{"$ref": "#/components/schemas/" + t.Name()}
+		if _, ok := schemas[t.Name()]; ok {
+			return ref
+		}
+		if reflect.PtrTo(t).Implements(customJSON) {
+			schemas[t.Name()] = schema{"type": "object"}
+			return ref
+		}
+		props := schema{}
+		schemas[t.Name()] = schema{"type": "object", "properties": props}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("protobuf")
+			if tag == "" {
+				continue
+			}
+			props[jsonName(f.Name, tag)] = fieldSchema(f, tag, schemas)
+		}
+		return ref
+	}
+	return schema{}
+}
+
+func fieldSchema(f reflect.StructField, tag string, schemas map[string]interface{}) schema {
+	for _, opt := range strings.Split(tag, ",") {
+		if !strings.HasPrefix(opt, "enum=") {
+			continue
+		}
+		values := proto.EnumValueMap(strings.TrimPrefix(opt, "enum="))
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool { return values[names[i]] < values[names[j]] })
+		enum := schema{"type": "string", "enum": names}
+		if f.Type.Kind() == reflect.Slice {
+			return schema{"type": "array", "items": enum}
+		}
+		return enum
+	}
+	return typeSchema(f.Type, schemas)
+}
+
+// jsonName returns the name of the field in the json encoded by jsonpb.
+func jsonName(name, tag string) string {
+	for _, opt := range strings.Split(tag, ",") {
+		if strings.HasPrefix(opt, "json=") {
+			return strings.TrimPrefix(opt, "json=")
+		}
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return strings.TrimPrefix(opt, "name=")
+		}
+	}
+	return name
+}
@@ -0,0 +1,233 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/mr-tron/base58/base58"
+)
+
+var errEmpty = errors.New("empty value")
+
+// route is a REST endpoint calling an rpc method. input fills the input
+// message of the method from the variables of the path, the query and the
+// body of the request. query and body describe the request for the OpenAPI
+// document.
+type route struct {
+	method  string
+	path    string
+	rpc     string
+	summary string
+	query   []string
+	body    interface{}
+	input   func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error
+}
+
+var restRoutes = []*route{
+	{
+		method: http.MethodGet, path: "/v1/blockchain", rpc: "Blockchain",
+		summary: "Returns the best block of the chain",
+		input:   noInput,
+	},
+	{
+		method: http.MethodGet, path: "/v1/chaininfo", rpc: "GetChainInfo",
+		summary: "Returns the parameters of the chain",
+		input:   noInput,
+	},
+	{
+		method: http.MethodGet, path: "/v1/blocks/{block}", rpc: "GetBlock",
+		summary: "Returns the block of the hash or the number",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			query, err := blockQuery(vars["block"])
+			in.(*types.SingleBytes).Value = query
+			return err
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/blocks/{block}/detail", rpc: "GetBlockDetail",
+		summary: "Returns the block with the receipts, the consensus info and the fee totals",
+		query:   []string{"receipts", "consensus", "fees"},
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			params := in.(*types.BlockDetailParams)
+			var err error
+			if params.Hashornumber, err = blockQuery(vars["block"]); err != nil {
+				return err
+			}
+			q := r.URL.Query()
+			params.Receipts = q.Get("receipts") == "true"
+			params.Consensus = q.Get("consensus") == "true"
+			params.Fees = q.Get("fees") == "true"
+			return nil
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/txs/{hash}", rpc: "GetBlockTX",
+		summary: "Returns the tx of the hash included in a block",
+		input:   hashInput("hash"),
+	},
+	{
+		method: http.MethodGet, path: "/v1/mempool/{hash}", rpc: "GetTX",
+		summary: "Returns the tx of the hash pending in the mempool",
+		input:   hashInput("hash"),
+	},
+	{
+		method: http.MethodPost, path: "/v1/txs", rpc: "CommitTX", body: types.TxList{},
+		summary: "Commits the signed txs of the body to the mempool",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			return unmarshal(body, in)
+		},
+	},
+	{
+		method: http.MethodPost, path: "/v1/bulk/blocks", rpc: "GetBlocksBulk", body: types.BulkParams{},
+		summary: "Returns the blocks of the hashes or the numbers of the body",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			return unmarshal(body, in)
+		},
+	},
+	{
+		method: http.MethodPost, path: "/v1/bulk/txs", rpc: "GetTxsBulk", body: types.BulkParams{},
+		summary: "Returns the txs of the hashes of the body included in blocks",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			return unmarshal(body, in)
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/receipts/{hash}", rpc: "GetReceipt",
+		summary: "Returns the receipt of the tx of the hash",
+		input:   hashInput("hash"),
+	},
+	{
+		method: http.MethodGet, path: "/v1/accounts/{address}", rpc: "GetState",
+		summary: "Returns the state of the account",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			addr, err := types.DecodeAddress(vars["address"])
+			in.(*types.SingleBytes).Value = addr
+			return wrapVarError("address", vars["address"], err)
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/accounts/{address}/staking", rpc: "GetStaking",
+		summary: "Returns the staking of the account",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			addr, err := types.DecodeAddress(vars["address"])
+			in.(*types.AccountAddress).Value = addr
+			return wrapVarError("address", vars["address"], err)
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/accounts/{address}/txs", rpc: "GetAccountTxHistory",
+		summary: "Returns a page of the txs sent or received by the account, the latest first",
+		query:   []string{"cursor", "size"},
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			params := in.(*types.AccountTxHistoryParams)
+			var err error
+			if params.Address, err = types.DecodeAddress(vars["address"]); err != nil {
+				return wrapVarError("address", vars["address"], err)
+			}
+			q := r.URL.Query()
+			if cursor := q.Get("cursor"); cursor != "" {
+				// the nextCursor of the previous page as encoded in the response
+				if params.Cursor, err = base64.StdEncoding.DecodeString(cursor); err != nil {
+					return wrapVarError("cursor", cursor, err)
+				}
+			}
+			if size := q.Get("size"); size != "" {
+				n, err := strconv.ParseUint(size, 10, 32)
+				if err != nil {
+					return wrapVarError("size", size, err)
+				}
+				params.Size = uint32(n)
+			}
+			return nil
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/names/{name}", rpc: "GetNameInfo",
+		summary: "Returns the owner and the destination of the name",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			in.(*types.Name).Name = vars["name"]
+			return nil
+		},
+	},
+	{
+		method: http.MethodGet, path: "/v1/contracts/{address}/abi", rpc: "GetABI",
+		summary: "Returns the ABI of the contract",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			addr, err := types.DecodeAddress(vars["address"])
+			in.(*types.SingleBytes).Value = addr
+			return wrapVarError("address", vars["address"], err)
+		},
+	},
+	{
+		method: http.MethodPost, path: "/v1/contracts/{address}/encode", rpc: "EncodeCall", body: types.CallEncodeParams{},
+		summary: "Returns the payload of the function call of the body checked against the ABI of the contract",
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			if err := unmarshal(body, in); err != nil {
+				return err
+			}
+			addr, err := types.DecodeAddress(vars["address"])
+			in.(*types.CallEncodeParams).ContractAddress = addr
+			return wrapVarError("address", vars["address"], err)
+		},
+	},
+	{
+		method: http.MethodPost, path: "/v1/contracts/{address}/query", rpc: "QueryContract", body: map[string]interface{}{},
+		summary: `Queries the contract with the function call of the body like {"Name": "get", "Args": ["key"]}`,
+		input: func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+			addr, err := types.DecodeAddress(vars["address"])
+			if err != nil {
+				return wrapVarError("address", vars["address"], err)
+			}
+			query := in.(*types.Query)
+			query.ContractAddress, query.Queryinfo = addr, body
+			return nil
+		},
+	},
+}
+
+func noInput(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+	return nil
+}
+
+func hashInput(name string) func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+	return func(in proto.Message, vars map[string]string, r *http.Request, body []byte) error {
+		hash, err := base58.Decode(vars[name])
+		if err == nil && len(hash) == 0 {
+			err = errEmpty
+		}
+		in.(*types.SingleBytes).Value = hash
+		return wrapVarError(name, vars[name], err)
+	}
+}
+
+// blockQuery returns the block query of GetBlock from a block number or a
+// base58 block hash.
+func blockQuery(s string) ([]byte, error) {
+	if no, err := strconv.ParseUint(s, 10, 64); err == nil {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, no)
+		return b, nil
+	}
+	hash, err := base58.Decode(s)
+	if err == nil && len(hash) == 0 {
+		err = errEmpty
+	}
+	return hash, wrapVarError("block", s, err)
+}
+
+func wrapVarError(name, value string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return errInvalidVar(name, value, err)
+}
@@ -0,0 +1,364 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/gorilla/websocket"
+)
+
+// The websocket endpoint takes JSON-RPC 2.0 requests to subscribe to
+//
+//	{"kind": "blocks"}: the metadata of the new blocks
+//	{"kind": "txs", "addresses": [...]}: the receipts of the txs from or to the addresses
+//	{"kind": "events", "filter": {...}}: the contract events matching the EventStreamFilter
+//
+// and pushes the notifications of the subscriptions as
+//
+//	{"jsonrpc": "2.0", "method": "subscription", "params": {"subscription": id, "result": ...}}
+
+const (
+	wsSendBuffer       = 256
+	wsMaxSubscriptions = 32
+	wsMaxMessageSize   = 1 << 16
+	wsWriteWait        = 10 * time.Second
+	wsCloseWait        = time.Second
+	wsPingPeriod       = 30 * time.Second
+)
+
+const (
+	subscribeBlocks = "blocks"
+	subscribeTxs    = "txs"
+	subscribeEvents = "events"
+)
+
+var (
+	errTooManySubscriptions = errors.New("too many subscriptions")
+	errNoSubscription       = errors.New("no such subscription")
+)
+
+type subscribeParams struct {
+	Kind      string          `json:"kind"`
+	Addresses []string        `json:"addresses,omitempty"`
+	Filter    json.RawMessage `json:"filter,omitempty"`
+}
+
+type unsubscribeParams struct {
+	Subscription string `json:"subscription"`
+}
+
+type subscription struct {
+	id        string
+	kind      string
+	addresses map[string]bool
+	filter    *types.EventStreamFilter
+	argFilter []types.ArgFilter
+}
+
+type notification struct {
+	Version string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  notificationParams `json:"params"`
+}
+
+type notificationParams struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// wsConn is a websocket client with its subscriptions. The messages are
+// queued to a buffer drained by the writer of the connection, and a client
+// too slow to keep the buffer from filling up is disconnected.
+type wsConn struct {
+	conn   *websocket.Conn
+	send   chan []byte
+	closed chan struct{}
+	once   sync.Once
+
+	lock   sync.RWMutex
+	subs   map[string]*subscription
+	nextID uint64
+}
+
+func (gw *Gateway) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	if gw.Authorize != nil {
+		if err := gw.Authorize(r, "ListEventFilterStream"); err != nil {
+			writeError(w, httpStatus(err), err.Error())
+			return
+		}
+	}
+	upgrader := websocket.Upgrader{}
+	if gw.allowCORS {
+		upgrader.CheckOrigin = func(r *http.Request) bool { return true }
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &wsConn{
+		conn:   conn,
+		send:   make(chan []byte, wsSendBuffer),
+		closed: make(chan struct{}),
+		subs:   make(map[string]*subscription),
+	}
+	gw.wsLock.Lock()
+	gw.wsConns[c] = struct{}{}
+	gw.wsLock.Unlock()
+
+	go c.writeLoop()
+	c.readLoop()
+
+	gw.wsLock.Lock()
+	delete(gw.wsConns, c)
+	gw.wsLock.Unlock()
+	c.close(websocket.CloseNormalClosure, "")
+}
+
+func (c *wsConn) readLoop() {
+	c.conn.SetReadLimit(wsMaxMessageSize)
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		rsp := c.handleRequest(data)
+		if rsp == nil {
+			continue
+		}
+		out, _ := json.Marshal(rsp)
+		if !c.queue(out) {
+			return
+		}
+	}
+}
+
+func (c *wsConn) writeLoop() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				c.close(websocket.CloseGoingAway, "")
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				c.close(websocket.CloseGoingAway, "")
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+// queue queues the message to the client, or disconnects the client if its
+// buffer is full. It returns false if the client is disconnected.
+func (c *wsConn) queue(msg []byte) bool {
+	select {
+	case <-c.closed:
+		return false
+	default:
+	}
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		c.close(websocket.CloseTryAgainLater, "too slow to receive the notifications")
+		return false
+	}
+}
+
+func (c *wsConn) close(code int, reason string) {
+	c.once.Do(func() {
+		close(c.closed)
+		// the notifiers don't wait for the client
+		go func() {
+			c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason),
+				time.Now().Add(wsCloseWait))
+			c.conn.Close()
+		}()
+	})
+}
+
+func (c *wsConn) handleRequest(data []byte) *rpcResponse {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errorResponse(nil, errParse, err.Error(), "")
+	}
+	if req.Version != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, errInvalidRequest, "not a JSON-RPC 2.0 request", "")
+	}
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "subscribe":
+		var params subscribeParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result, err = c.subscribe(&params)
+		}
+	case "unsubscribe":
+		var params unsubscribeParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result, err = true, c.unsubscribe(params.Subscription)
+		}
+	default:
+		return errorResponse(req.ID, errMethodNotFound, "method not found: "+req.Method, "")
+	}
+	if len(req.ID) == 0 {
+		return nil
+	}
+	if err != nil {
+		return errorResponse(req.ID, errInvalidParams, err.Error(), "")
+	}
+	out, _ := json.Marshal(result)
+	return &rpcResponse{Version: "2.0", Result: out, ID: req.ID}
+}
+
+func (c *wsConn) subscribe(params *subscribeParams) (string, error) {
+	sub := &subscription{kind: params.Kind}
+	switch params.Kind {
+	case subscribeBlocks:
+	case subscribeTxs:
+		if len(params.Addresses) == 0 {
+			return "", errors.New("no addresses to watch")
+		}
+		sub.addresses = make(map[string]bool)
+		for _, addr := range params.Addresses {
+			raw, err := types.DecodeAddress(addr)
+			if err != nil {
+				return "", errInvalidVar("address", addr, err)
+			}
+			sub.addresses[string(raw)] = true
+		}
+	case subscribeEvents:
+		sub.filter = &types.EventStreamFilter{}
+		if err := unmarshal(params.Filter, sub.filter); err != nil {
+			return "", err
+		}
+		if err := sub.filter.ValidateCheck(); err != nil {
+			return "", err
+		}
+		sub.argFilter, _ = sub.filter.GetExArgFilter()
+	default:
+		return "", errors.New("unknown subscription kind: " + params.Kind)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.subs) >= wsMaxSubscriptions {
+		return "", errTooManySubscriptions
+	}
+	c.nextID++
+	sub.id = strconv.FormatUint(c.nextID, 10)
+	c.subs[sub.id] = sub
+	return sub.id, nil
+}
+
+func (c *wsConn) unsubscribe(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.subs[id]; !ok {
+		return errNoSubscription
+	}
+	delete(c.subs, id)
+	return nil
+}
+
+// NotifyBlock pushes the new block to the block subscriptions and the
+// receipts of its txs to the subscriptions watching their accounts or
+// recipients. The receipts are in the order of the txs of the block.
+func (gw *Gateway) NotifyBlock(block *types.Block, receipts []*types.Receipt) {
+	gw.wsLock.RLock()
+	defer gw.wsLock.RUnlock()
+	if len(gw.wsConns) == 0 {
+		return
+	}
+	var meta json.RawMessage
+	txs := block.GetBody().GetTxs()
+	for c := range gw.wsConns {
+		c.lock.RLock()
+		for _, sub := range c.subs {
+			switch sub.kind {
+			case subscribeBlocks:
+				if meta == nil {
+					if meta, _ = marshal(block.GetMetadata()); meta == nil {
+						meta = json.RawMessage("null")
+					}
+				}
+				c.notify(sub, meta)
+			case subscribeTxs:
+				for i, tx := range txs {
+					body := tx.GetBody()
+					if !sub.addresses[string(body.GetAccount())] && !sub.addresses[string(body.GetRecipient())] {
+						continue
+					}
+					var receipt *types.Receipt
+					if i < len(receipts) {
+						receipt = receipts[i]
+					} else {
+						receipt = &types.Receipt{
+							TxHash:    tx.GetHash(),
+							BlockNo:   block.GetHeader().GetBlockNo(),
+							BlockHash: block.BlockHash(),
+							TxIndex:   int32(i),
+							From:      body.GetAccount(),
+							To:        body.GetRecipient(),
+						}
+					}
+					out, err := json.Marshal(receipt)
+					if err == nil {
+						c.notify(sub, out)
+					}
+				}
+			}
+		}
+		c.lock.RUnlock()
+	}
+}
+
+// NotifyEvents pushes the contract events to the subscriptions of the
+// filters they match.
+func (gw *Gateway) NotifyEvents(events []*types.Event) {
+	gw.wsLock.RLock()
+	defer gw.wsLock.RUnlock()
+	for c := range gw.wsConns {
+		c.lock.RLock()
+		for _, sub := range c.subs {
+			if sub.kind != subscribeEvents {
+				continue
+			}
+			for _, ev := range events {
+				if !sub.filter.Match(ev, sub.argFilter) {
+					continue
+				}
+				out, err := json.Marshal(ev)
+				if err == nil {
+					c.notify(sub, out)
+				}
+			}
+		}
+		c.lock.RUnlock()
+	}
+}
+
+func (c *wsConn) notify(sub *subscription, result json.RawMessage) {
+	out, _ := json.Marshal(&notification{
+		Version: "2.0",
+		Method:  "subscription",
+		Params:  notificationParams{Subscription: sub.id, Result: result},
+	})
+	c.queue(out)
+}
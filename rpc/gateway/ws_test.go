@@ -0,0 +1,118 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsocketSubscriptions(t *testing.T) {
+	const testAddr = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	gw := New(&testServer{}, false)
+	server := httptest.NewServer(gw)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	call := func(req string) rpcResponse {
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+		var rsp rpcResponse
+		assert.NoError(t, conn.ReadJSON(&rsp))
+		return rsp
+	}
+	rsp := call(`{"jsonrpc": "2.0", "id": 1, "method": "subscribe", "params": {"kind": "blocks"}}`)
+	assert.Equal(t, `"1"`, string(rsp.Result))
+	rsp = call(`{"jsonrpc": "2.0", "id": 2, "method": "subscribe", "params": {"kind": "txs", "addresses": ["` + testAddr + `"]}}`)
+	assert.Equal(t, `"2"`, string(rsp.Result))
+	rsp = call(`{"jsonrpc": "2.0", "id": 3, "method": "subscribe", "params": {"kind": "events", "filter": {"eventNames": ["transfer"]}}}`)
+	assert.Equal(t, `"3"`, string(rsp.Result))
+	rsp = call(`{"jsonrpc": "2.0", "id": 4, "method": "subscribe", "params": {"kind": "headers"}}`)
+	assert.Equal(t, errInvalidParams, rsp.Error.Code)
+	rsp = call(`{"jsonrpc": "2.0", "id": 5, "method": "unsubscribe", "params": {"subscription": "9"}}`)
+	assert.Equal(t, errInvalidParams, rsp.Error.Code)
+
+	account, _ := types.DecodeAddress(testAddr)
+	block := &types.Block{
+		Header: &types.BlockHeader{BlockNo: 7},
+		Body: &types.BlockBody{Txs: []*types.Tx{
+			{Hash: []byte{1}, Body: &types.TxBody{Account: []byte("other")}},
+			{Hash: []byte{2}, Body: &types.TxBody{Account: account}},
+		}},
+	}
+	receipts := []*types.Receipt{{Status: "SUCCESS"}, {Status: "ERROR", Ret: "failed"}}
+	gw.NotifyBlock(block, receipts)
+	gw.NotifyEvents([]*types.Event{
+		{ContractAddress: account, EventName: "approve", JsonArgs: "[]"},
+		{ContractAddress: account, EventName: "transfer", JsonArgs: `["a", 1]`, BlockNo: 7},
+	})
+
+	next := func() (string, map[string]interface{}) {
+		var n struct {
+			Method string `json:"method"`
+			Params struct {
+				Subscription string                 `json:"subscription"`
+				Result       map[string]interface{} `json:"result"`
+			} `json:"params"`
+		}
+		assert.NoError(t, conn.ReadJSON(&n))
+		assert.Equal(t, "subscription", n.Method)
+		return n.Params.Subscription, n.Params.Result
+	}
+	got := map[string]map[string]interface{}{}
+	for i := 0; i < 3; i++ {
+		id, result := next()
+		got[id] = result
+	}
+	assert.Equal(t, "7", got["1"]["header"].(map[string]interface{})["blockNo"])
+	assert.Equal(t, "ERROR", got["2"]["status"])
+	assert.Equal(t, "transfer", got["3"]["eventName"])
+
+	// no more notifications after unsubscribing
+	rsp = call(`{"jsonrpc": "2.0", "id": 6, "method": "unsubscribe", "params": {"subscription": "1"}}`)
+	assert.Equal(t, "true", string(rsp.Result))
+	gw.NotifyBlock(&types.Block{Header: &types.BlockHeader{BlockNo: 8}}, nil)
+	rsp = call(`{"jsonrpc": "2.0", "id": 7, "method": "unsubscribe", "params": {"subscription": "2"}}`)
+	assert.Equal(t, json.RawMessage("7"), rsp.ID)
+}
+
+func TestWebsocketSlowClient(t *testing.T) {
+	gw := New(&testServer{}, false)
+	server := httptest.NewServer(gw)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage,
+		[]byte(`{"jsonrpc": "2.0", "id": 1, "method": "subscribe", "params": {"kind": "blocks"}}`)))
+	var rsp rpcResponse
+	assert.NoError(t, conn.ReadJSON(&rsp))
+
+	// the client doesn't read the notifications
+	block := &types.Block{Header: &types.BlockHeader{ChainID: make([]byte, 1<<16)}}
+	for i := 0; i < 8*wsSendBuffer; i++ {
+		gw.NotifyBlock(block, nil)
+	}
+	connected := func() int {
+		gw.wsLock.RLock()
+		defer gw.wsLock.RUnlock()
+		return len(gw.wsConns)
+	}
+	for i := 0; i < 500 && connected() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, connected(), "the slow client is disconnected")
+}
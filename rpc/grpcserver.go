@@ -12,30 +12,39 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"math/big"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
-	"github.com/aergoio/aergo-lib/log"
+	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/consensus"
+	bc "github.com/aergoio/aergo/consensus/chain"
 	"github.com/aergoio/aergo/consensus/impl/raftv2"
+	"github.com/aergoio/aergo/internal/clock"
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
+	"github.com/aergoio/aergo/internal/tracing"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/p2p/metric"
 	"github.com/aergoio/aergo/p2p/p2pcommon"
 	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/rpc/auth"
 	"github.com/aergoio/aergo/types"
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/libp2p/go-libp2p-peer"
 	"google.golang.org/grpc/codes"
+	grpcpeer "google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 var (
-	logger = log.NewLogger("rpc")
+	logger = logctl.NewLogger("rpc")
 )
 
 var (
@@ -48,6 +57,17 @@ type EventStream struct {
 	stream types.AergoRPCService_ListEventStreamServer
 }
 
+type EventFilterStream struct {
+	filter    *types.EventStreamFilter
+	argFilter []types.ArgFilter
+	stream    types.AergoRPCService_ListEventFilterStreamServer
+}
+
+type blockDetailStream struct {
+	params *types.BlockStreamParams
+	stream types.AergoRPCService_ListBlockDetailStreamServer
+}
+
 // AergoRPCService implements GRPC server which is defined in rpc.proto
 type AergoRPCService struct {
 	hub               *component.ComponentHub
@@ -55,6 +75,14 @@ type AergoRPCService struct {
 	consensusAccessor consensus.ConsensusAccessor //TODO refactor with actorHelper
 	msgHelper         message.Helper
 
+	// profileAddr is the address of the pprof endpoints started by an rpc
+	// request, and dumpDir is the directory of the profile dumps
+	profileAddr string
+	dumpDir     string
+	// nsAuth tells whether the clients are authenticated. Without it, the
+	// debugging methods are allowed only to the local clients.
+	nsAuth bool
+
 	streamID                uint32
 	blockStreamLock         sync.RWMutex
 	blockStream             map[uint32]types.AergoRPCService_ListBlockStreamServer
@@ -63,6 +91,15 @@ type AergoRPCService struct {
 
 	eventStreamLock sync.RWMutex
 	eventStream     map[*EventStream]*EventStream
+
+	blockDetailStreamLock sync.RWMutex
+	blockDetailStream     map[uint32]*blockDetailStream
+	eventFilterStreamLock sync.RWMutex
+	eventFilterStream     map[*EventFilterStream]*EventFilterStream
+	consensusStreamLock   sync.RWMutex
+	consensusStream       map[uint32]types.AergoRPCService_ListConsensusEventStreamServer
+	watchTxStreamLock     sync.RWMutex
+	watchTxStream         map[uint32]types.AergoRPCService_ListWatchAccountTxStreamServer
 }
 
 // FIXME remove redundant constants
@@ -169,27 +206,90 @@ func (rpc *AergoRPCService) GetChainInfo(ctx context.Context, in *types.Empty) (
 
 // ListBlockMetadata handle rpc request
 func (rpc *AergoRPCService) ListBlockMetadata(ctx context.Context, in *types.ListParams) (*types.BlockMetadataList, error) {
-	blocks, err := rpc.getBlocks(ctx, in)
+	blocks, next, total, err := rpc.listBlocks(ctx, in)
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	var metas []*types.BlockMetadata
 	for _, block := range blocks {
 		metas = append(metas, block.GetMetadata())
 	}
-	return &types.BlockMetadataList{Blocks: metas}, nil
+	return &types.BlockMetadataList{Blocks: metas, NextCursor: next, TotalHint: total}, nil
 }
 
 // ListBlockHeaders (Deprecated) handle rpc request listblocks
 func (rpc *AergoRPCService) ListBlockHeaders(ctx context.Context, in *types.ListParams) (*types.BlockHeaderList, error) {
-	blocks, err := rpc.getBlocks(ctx, in)
+	blocks, next, total, err := rpc.listBlocks(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 	for _, block := range blocks {
 		block.Body = nil
 	}
-	return &types.BlockHeaderList{Blocks: blocks}, nil
+	return &types.BlockHeaderList{Blocks: blocks, NextCursor: next, TotalHint: total}, nil
+}
+
+// listBlocks returns a page of the blocks with the cursor of the next page
+// and the number of the blocks in the chain. Without a cursor, the page is
+// the one at the hash or the height of the params. The pages go on toward
+// the genesis block, or toward the best block in the ascending order.
+func (rpc *AergoRPCService) listBlocks(ctx context.Context, in *types.ListParams) ([]*types.Block, []byte, uint64, error) {
+	best, err := rpc.actorHelper.GetChainAccessor().GetBestBlock()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	bestNo := best.GetHeader().GetBlockNo()
+
+	size := pageSize(in.Size)
+	params := *in
+	params.Size = uint32(size)
+	var blocks []*types.Block
+	if len(in.Cursor) != 0 {
+		from, err := decodeBlockCursor(in.Cursor)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if in.Asc {
+			blocks, err = rpc.getBlocksFrom(from, bestNo, size)
+		} else {
+			params.Hash, params.Height, params.Offset = nil, from, 0
+			blocks, err = rpc.getBlocks(ctx, &params)
+		}
+	} else {
+		blocks, err = rpc.getBlocks(ctx, &params)
+	}
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var next []byte
+	if len(blocks) == size {
+		last := blocks[len(blocks)-1].GetHeader().GetBlockNo()
+		if in.Asc && last < bestNo {
+			next = encodeBlockCursor(last + 1)
+		} else if !in.Asc && last > 0 {
+			next = encodeBlockCursor(last - 1)
+		}
+	}
+	return blocks, next, bestNo + 1, nil
+}
+
+// getBlocksFrom returns the blocks from the block number in the ascending
+// order up to the best block.
+func (rpc *AergoRPCService) getBlocksFrom(from, best types.BlockNo, size int) ([]*types.Block, error) {
+	blocks := make([]*types.Block, 0, size)
+	for no := from; no <= best && len(blocks) < size; no++ {
+		block, err := extractBlockFromFuture(rpc.hub.RequestFuture(message.ChainSvc,
+			&message.GetBlockByNo{BlockNo: no}, defaultActorTimeout, "rpc.(*AergoRPCService).getBlocksFrom"))
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
 }
 
 func (rpc *AergoRPCService) getBlocks(ctx context.Context, in *types.ListParams) ([]*types.Block, error) {
@@ -333,6 +433,90 @@ func (rpc *AergoRPCService) ListBlockMetadataStream(in *types.Empty, stream type
 	}
 }
 
+// BroadcastToListBlockDetailStream sends the block with the details requested
+// by each stream.
+func (rpc *AergoRPCService) BroadcastToListBlockDetailStream(block *types.Block, receipts []*types.Receipt) {
+	rpc.blockDetailStreamLock.RLock()
+	defer rpc.blockDetailStreamLock.RUnlock()
+	if len(rpc.blockDetailStream) == 0 {
+		return
+	}
+
+	var consensusInfo string
+	if rpc.consensusAccessor != nil {
+		info, err := rpc.consensusAccessor.BlockConsensusInfo(block)
+		if err != nil && err != consensus.ErrNotSupportedMethod {
+			logger.Warn().Err(err).Msg("failed to get the consensus info of the block")
+		}
+		consensusInfo = info
+	}
+	totalFee, totalGasUsed := blockFees(receipts)
+
+	for _, bs := range rpc.blockDetailStream {
+		detail := &types.BlockDetail{Block: block}
+		if bs.params.Receipts {
+			detail.Receipts = receipts
+		}
+		if bs.params.Consensus {
+			detail.ConsensusInfo = consensusInfo
+		}
+		if bs.params.Fees {
+			detail.TotalFee, detail.TotalGasUsed = totalFee, totalGasUsed
+		}
+		if err := bs.stream.Send(detail); err != nil {
+			logger.Warn().Err(err).Msg("failed to broadcast block detail stream")
+		}
+	}
+}
+
+// ListBlockDetailStream starts a stream of new blocks with the details of params
+func (rpc *AergoRPCService) ListBlockDetailStream(in *types.BlockStreamParams, stream types.AergoRPCService_ListBlockDetailStreamServer) error {
+	streamID := atomic.AddUint32(&rpc.streamID, 1)
+	rpc.blockDetailStreamLock.Lock()
+	rpc.blockDetailStream[streamID] = &blockDetailStream{in, stream}
+	rpc.blockDetailStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("block detail stream added")
+
+	<-stream.Context().Done()
+	rpc.blockDetailStreamLock.Lock()
+	delete(rpc.blockDetailStream, streamID)
+	rpc.blockDetailStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("block detail stream deleted")
+	return nil
+}
+
+// BroadcastToConsensusEventStream sends a leader or membership change of the
+// cluster.
+func (rpc *AergoRPCService) BroadcastToConsensusEventStream(ev *types.ConsensusEvent) {
+	rpc.consensusStreamLock.RLock()
+	defer rpc.consensusStreamLock.RUnlock()
+	for _, stream := range rpc.consensusStream {
+		if err := stream.Send(ev); err != nil {
+			logger.Warn().Err(err).Msg("failed to broadcast consensus event stream")
+		}
+	}
+}
+
+// ListConsensusEventStream starts a stream of the leader and membership changes
+// of the raft cluster
+func (rpc *AergoRPCService) ListConsensusEventStream(in *types.Empty, stream types.AergoRPCService_ListConsensusEventStreamServer) error {
+	if err := rpc.checkRaftAccessor(); err != nil {
+		return err
+	}
+	streamID := atomic.AddUint32(&rpc.streamID, 1)
+	rpc.consensusStreamLock.Lock()
+	rpc.consensusStream[streamID] = stream
+	rpc.consensusStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("consensus event stream added")
+
+	<-stream.Context().Done()
+	rpc.consensusStreamLock.Lock()
+	delete(rpc.consensusStream, streamID)
+	rpc.consensusStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("consensus event stream deleted")
+	return nil
+}
+
 func extractBlockFromFuture(future *actor.Future) (*types.Block, error) {
 	rawResponse, err := future.Result()
 	if err != nil {
@@ -439,6 +623,56 @@ func (rpc *AergoRPCService) GetBlockBody(ctx context.Context, in *types.BlockBod
 	return response, nil
 }
 
+// GetBlockDetail handle rpc request getblockdetail
+func (rpc *AergoRPCService) GetBlockDetail(ctx context.Context, in *types.BlockDetailParams) (*types.BlockDetail, error) {
+	block, err := rpc.GetBlock(ctx, &types.SingleBytes{Value: in.Hashornumber})
+	if err != nil {
+		return nil, err
+	}
+	detail := &types.BlockDetail{Block: block}
+	if in.Receipts || in.Fees {
+		result, err := rpc.hub.RequestFuture(message.ChainSvc,
+			&message.GetBlockReceipts{Block: block}, defaultActorTimeout, "rpc.(*AergoRPCService).GetBlockDetail").Result()
+		if err != nil {
+			return nil, err
+		}
+		rsp, ok := result.(message.GetBlockReceiptsRsp)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+		}
+		if rsp.Err != nil {
+			return nil, rsp.Err
+		}
+		if in.Receipts {
+			detail.Receipts = rsp.Receipts
+		}
+		if in.Fees {
+			detail.TotalFee, detail.TotalGasUsed = blockFees(rsp.Receipts)
+		}
+	}
+	if in.Consensus {
+		if rpc.consensusAccessor == nil {
+			return nil, ErrUninitAccessor
+		}
+		info, err := rpc.consensusAccessor.BlockConsensusInfo(block)
+		if err != nil && err != consensus.ErrNotSupportedMethod {
+			return nil, err
+		}
+		detail.ConsensusInfo = info
+	}
+	return detail, nil
+}
+
+// blockFees returns the total fee and gas used by the txs of the receipts.
+func blockFees(receipts []*types.Receipt) ([]byte, uint64) {
+	total, gasUsed := new(big.Int), uint64(0)
+	for _, r := range receipts {
+		total.Add(total, new(big.Int).SetBytes(r.FeeUsed))
+		gasUsed += r.GasUsed
+	}
+	return total.Bytes(), gasUsed
+}
+
 // GetTX handle rpc request gettx
 func (rpc *AergoRPCService) GetTX(ctx context.Context, in *types.SingleBytes) (*types.Tx, error) {
 	result, err := rpc.actorHelper.CallRequestDefaultTimeout(message.MemPoolSvc,
@@ -472,6 +706,59 @@ func (rpc *AergoRPCService) GetBlockTX(ctx context.Context, in *types.SingleByte
 	return &types.TxInBlock{Tx: rsp.Tx, TxIdx: rsp.TxIds}, rsp.Err
 }
 
+// maxBulkSize is the maximum number of the keys of a bulk query.
+const maxBulkSize = 100
+
+func checkBulkParams(in *types.BulkParams) error {
+	if len(in.Keys) == 0 {
+		return status.Errorf(codes.InvalidArgument, "no keys")
+	}
+	if len(in.Keys) > maxBulkSize {
+		return status.Errorf(codes.InvalidArgument, "too many keys: %d > %d", len(in.Keys), maxBulkSize)
+	}
+	return nil
+}
+
+// GetBlocksBulk handle rpc request of the blocks of the hashes or numbers.
+// The error of a key is reported in its item and doesn't fail the others.
+func (rpc *AergoRPCService) GetBlocksBulk(ctx context.Context, in *types.BulkParams) (*types.BlockBulk, error) {
+	if err := checkBulkParams(in); err != nil {
+		return nil, err
+	}
+	items := make([]*types.BlockBulkItem, len(in.Keys))
+	for i, key := range in.Keys {
+		block, err := rpc.GetBlock(ctx, &types.SingleBytes{Value: key})
+		items[i] = &types.BlockBulkItem{Key: key}
+		if err != nil {
+			s := status.Convert(err)
+			items[i].Code, items[i].Error = uint32(s.Code()), s.Message()
+		} else {
+			items[i].Block = block
+		}
+	}
+	return &types.BlockBulk{Items: items}, nil
+}
+
+// GetTxsBulk handle rpc request of the txs in the blocks of the hashes. The
+// error of a hash is reported in its item and doesn't fail the others.
+func (rpc *AergoRPCService) GetTxsBulk(ctx context.Context, in *types.BulkParams) (*types.TxBulk, error) {
+	if err := checkBulkParams(in); err != nil {
+		return nil, err
+	}
+	items := make([]*types.TxBulkItem, len(in.Keys))
+	for i, hash := range in.Keys {
+		tx, err := rpc.GetBlockTX(ctx, &types.SingleBytes{Value: hash})
+		items[i] = &types.TxBulkItem{Hash: hash}
+		if err != nil {
+			s := status.Convert(err)
+			items[i].Code, items[i].Error = uint32(s.Code()), s.Message()
+		} else {
+			items[i].Tx = tx
+		}
+	}
+	return &types.TxBulk{Items: items}, nil
+}
+
 var emptyBytes = make([]byte, 0)
 
 // SendTX try to fill the nonce, sign, hash, chainIdHash in the transaction automatically and commit it
@@ -503,7 +790,7 @@ func (rpc *AergoRPCService) SendTX(ctx context.Context, tx *types.Tx) (*types.Co
 	}
 
 	signTxResult, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
-		&message.SignTx{Tx: tx, Requester: tx.Body.Account}, defaultActorTimeout, "rpc.(*AergoRPCService).SendTX")
+		newSignTx(ctx, tx, tx.Body.Account), defaultActorTimeout, "rpc.(*AergoRPCService).SendTX")
 	if err != nil {
 		if err == component.ErrHubUnregistered {
 			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
@@ -519,7 +806,8 @@ func (rpc *AergoRPCService) SendTX(ctx context.Context, tx *types.Tx) (*types.Co
 		return nil, signTxRsp.Err
 	}
 	tx = signTxRsp.Tx
-	memPoolPutResult, err := rpc.hub.RequestFuture(message.MemPoolSvc,
+	span := tracing.StartTx(ctx, tx.Hash)
+	memPoolPutResult, err := rpc.hub.RequestFutureWithSpan(span, message.MemPoolSvc,
 		&message.MemPoolPut{Tx: tx},
 		defaultActorTimeout, "rpc.(*AergoRPCService).SendTX").Result()
 	memPoolPutRsp, ok := memPoolPutResult.(*message.MemPoolPutRsp)
@@ -528,6 +816,7 @@ func (rpc *AergoRPCService) SendTX(ctx context.Context, tx *types.Tx) (*types.Co
 	}
 	resultErr := memPoolPutRsp.Err
 	if resultErr != nil {
+		tracing.AbortTx(tx.Hash, resultErr)
 		return &types.CommitResult{Hash: tx.Hash, Error: convertError(resultErr), Detail: resultErr.Error()}, err
 	}
 	return &types.CommitResult{Hash: tx.Hash, Error: convertError(resultErr)}, err
@@ -542,6 +831,12 @@ func (rpc *AergoRPCService) CommitTX(ctx context.Context, in *types.TxList) (*ty
 	if in.Txs == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "input tx is empty")
 	}
+	last, err := rpc.actorHelper.GetChainAccessor().GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	chainIdHash := common.Hasher(last.GetHeader().GetChainID())
+
 	rs := make([]*types.CommitResult, len(in.Txs))
 	futures := make([]*actor.Future, len(in.Txs))
 	results := &types.CommitResultList{Results: rs}
@@ -561,13 +856,28 @@ func (rpc *AergoRPCService) CommitTX(ctx context.Context, in *types.TxList) (*ty
 		results.Results[i] = &r
 		cnt++
 
+		// the tx of another chain is not passed to mempool
+		if given := tx.GetBody().GetChainIdHash(); !bytes.Equal(given, chainIdHash) {
+			r.Error = types.CommitStatus_TX_INVALID_CHAIN_ID
+			r.Detail = (&types.ChainMismatchError{
+				Subject:  "tx",
+				Expected: "chain id hash " + enc.ToString(chainIdHash),
+				Actual:   enc.ToString(given),
+			}).Error()
+			continue
+		}
+
 		//send tx message to mempool
-		f := rpc.hub.RequestFuture(message.MemPoolSvc,
+		span := tracing.StartTx(ctx, hash)
+		f := rpc.hub.RequestFutureWithSpan(span, message.MemPoolSvc,
 			&message.MemPoolPut{Tx: tx},
 			defaultActorTimeout, "rpc.(*AergoRPCService).CommitTX")
 		futures[i] = f
 	}
 	for i, future := range futures {
+		if future == nil {
+			continue
+		}
 		result, err := future.Result()
 		if err != nil {
 			return nil, err
@@ -581,6 +891,7 @@ func (rpc *AergoRPCService) CommitTX(ctx context.Context, in *types.TxList) (*ty
 		results.Results[i].Error = convertError(err)
 		if err != nil {
 			results.Results[i].Detail = err.Error()
+			tracing.AbortTx(in.Txs[i].Hash, err)
 		}
 	}
 
@@ -603,8 +914,12 @@ func (rpc *AergoRPCService) GetState(ctx context.Context, in *types.SingleBytes)
 
 // GetStateAndProof handle rpc request getstateproof
 func (rpc *AergoRPCService) GetStateAndProof(ctx context.Context, in *types.AccountAndRoot) (*types.AccountProof, error) {
+	root, err := rpc.stateRootOf(in.Root, in.BlockHash)
+	if err != nil {
+		return nil, err
+	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetStateAndProof{Account: in.Account, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAndProof").Result()
+		&message.GetStateAndProof{Account: in.Account, Root: root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAndProof").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -692,7 +1007,7 @@ func (rpc *AergoRPCService) LockAccount(ctx context.Context, in *types.Personal)
 // UnlockAccount handle rpc request unlockaccount
 func (rpc *AergoRPCService) UnlockAccount(ctx context.Context, in *types.Personal) (*types.Account, error) {
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
-		&message.UnlockAccount{Account: in.Account, Passphrase: in.Passphrase},
+		&message.UnlockAccount{Account: in.Account, Passphrase: in.Passphrase, Timeout: time.Duration(in.Timeout) * time.Second},
 		defaultActorTimeout, "rpc.(*AergoRPCService).UnlockAccount")
 	if err != nil {
 		if err == component.ErrHubUnregistered {
@@ -708,6 +1023,24 @@ func (rpc *AergoRPCService) UnlockAccount(ctx context.Context, in *types.Persona
 	return rsp.Account, rsp.Err
 }
 
+// GetUnlockStatus handle rpc request getunlockstatus
+func (rpc *AergoRPCService) GetUnlockStatus(ctx context.Context, in *types.Account) (*types.UnlockStatus, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.GetUnlockStatus{Account: in}, defaultActorTimeout, "rpc.(*AergoRPCService).GetUnlockStatus")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.GetUnlockStatusRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Status, rsp.Err
+}
+
 func (rpc *AergoRPCService) ImportAccount(ctx context.Context, in *types.ImportFormat) (*types.Account, error) {
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
 		&message.ImportAccount{Wif: in.Wif.Value, OldPass: in.Oldpass, NewPass: in.Newpass},
@@ -744,10 +1077,11 @@ func (rpc *AergoRPCService) ExportAccount(ctx context.Context, in *types.Persona
 	return &types.SingleBytes{Value: rsp.Wif}, rsp.Err
 }
 
-// SignTX handle rpc request signtx
-func (rpc *AergoRPCService) SignTX(ctx context.Context, in *types.Tx) (*types.Tx, error) {
+// ImportAccountKeystore handle rpc request importaccountkeystore
+func (rpc *AergoRPCService) ImportAccountKeystore(ctx context.Context, in *types.ImportFormat) (*types.Account, error) {
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
-		&message.SignTx{Tx: in}, defaultActorTimeout, "rpc.(*AergoRPCService).SignTX")
+		&message.ImportAccount{Wif: in.Wif.GetValue(), OldPass: in.Oldpass, NewPass: in.Newpass, Keystore: true},
+		defaultActorTimeout, "rpc.(*AergoRPCService).ImportAccountKeystore")
 	if err != nil {
 		if err == component.ErrHubUnregistered {
 			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
@@ -755,18 +1089,22 @@ func (rpc *AergoRPCService) SignTX(ctx context.Context, in *types.Tx) (*types.Tx
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	rsp, ok := result.(*message.SignTxRsp)
+	rsp, ok := result.(*message.ImportAccountRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return rsp.Tx, rsp.Err
+	return rsp.Account, rsp.Err
 }
 
-// VerifyTX handle rpc request verifytx
-func (rpc *AergoRPCService) VerifyTX(ctx context.Context, in *types.Tx) (*types.VerifyResult, error) {
-	//TODO : verify without account service
+// ExportAccountKeystore handle rpc request exportaccountkeystore
+func (rpc *AergoRPCService) ExportAccountKeystore(ctx context.Context, in *types.KeystoreParams) (*types.SingleBytes, error) {
+	kdf := in.Kdf
+	if kdf == "" {
+		kdf = key.KdfScrypt
+	}
 	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
-		&message.VerifyTx{Tx: in}, defaultActorTimeout, "rpc.(*AergoRPCService).VerifyTX")
+		&message.ExportAccount{Account: in.Account, Pass: in.Passphrase, Kdf: kdf},
+		defaultActorTimeout, "rpc.(*AergoRPCService).ExportAccountKeystore")
 	if err != nil {
 		if err == component.ErrHubUnregistered {
 			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
@@ -774,131 +1112,592 @@ func (rpc *AergoRPCService) VerifyTX(ctx context.Context, in *types.Tx) (*types.
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	rsp, ok := result.(*message.VerifyTxRsp)
+	rsp, ok := result.(*message.ExportAccountRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	ret := &types.VerifyResult{Tx: rsp.Tx}
-	if rsp.Err == types.ErrSignNotMatch {
-		ret.Error = types.VerifyStatus_VERIFY_STATUS_SIGN_NOT_MATCH
-	} else {
-		ret.Error = types.VerifyStatus_VERIFY_STATUS_OK
-	}
-	return ret, nil
+	return &types.SingleBytes{Value: rsp.Wif}, rsp.Err
 }
 
-// GetPeers handle rpc request getpeers
-func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams) (*types.PeerList, error) {
-	result, err := rpc.hub.RequestFuture(message.P2PSvc,
-		&message.GetPeers{in.NoHidden, in.ShowSelf}, halfMinute, "rpc.(*AergoRPCService).GetPeers").Result()
+// CreateHDWallet handle rpc request createhdwallet
+func (rpc *AergoRPCService) CreateHDWallet(ctx context.Context, in *types.HDWalletParams) (*types.HDWallet, error) {
+	words := int(in.Words)
+	if words == 0 {
+		words = 24
+	}
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.CreateHDWallet{Mnemonic: in.Mnemonic, SeedPass: in.SeedPassphrase, Words: words, Pass: in.Passphrase},
+		defaultActorTimeout, "rpc.(*AergoRPCService).CreateHDWallet")
 	if err != nil {
-		return nil, err
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	rsp, ok := result.(*message.GetPeersRsp)
+
+	rsp, ok := result.(*message.HDWalletRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-
-	ret := &types.PeerList{Peers: make([]*types.Peer, 0, len(rsp.Peers))}
-	for _, pi := range rsp.Peers {
-		blkNotice := &types.NewBlockNotice{BlockHash: pi.LastBlockHash, BlockNo: pi.LastBlockNumber}
-		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version}
-		ret.Peers = append(ret.Peers, peer)
+	if rsp.Err != nil {
+		return nil, rsp.Err
 	}
-
-	return ret, nil
+	return &types.HDWallet{Mnemonic: rsp.Mnemonic}, nil
 }
 
-// NodeState handle rpc request nodestate
-func (rpc *AergoRPCService) NodeState(ctx context.Context, in *types.NodeReq) (*types.SingleBytes, error) {
-	timeout := int64(binary.LittleEndian.Uint64(in.Timeout))
-	component := string(in.Component)
-
-	logger.Debug().Str("comp", component).Int64("timeout", timeout).Msg("nodestate")
-
-	statics, err := rpc.hub.Statistics(time.Duration(timeout)*time.Second, component)
+// ExportMnemonic handle rpc request exportmnemonic
+func (rpc *AergoRPCService) ExportMnemonic(ctx context.Context, in *types.Personal) (*types.HDWallet, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.ExportMnemonic{Pass: in.Passphrase},
+		defaultActorTimeout, "rpc.(*AergoRPCService).ExportMnemonic")
 	if err != nil {
-		return nil, err
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	data, err := json.MarshalIndent(statics, "", "\t")
-	if err != nil {
-		return nil, err
+	rsp, ok := result.(*message.HDWalletRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return &types.SingleBytes{Value: data}, nil
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
+	return &types.HDWallet{Mnemonic: rsp.Mnemonic}, nil
 }
 
-//GetVotes handle rpc request getvotes
-func (rpc *AergoRPCService) GetVotes(ctx context.Context, in *types.VoteParams) (*types.VoteList, error) {
-
-	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetElected{Id: in.GetId(), N: in.GetCount()}, defaultActorTimeout, "rpc.(*AergoRPCService).GetVote").Result()
-
+// DeriveAccounts handle rpc request deriveaccounts
+func (rpc *AergoRPCService) DeriveAccounts(ctx context.Context, in *types.DeriveParams) (*types.HDAccountList, error) {
+	count := in.Count
+	if count == 0 {
+		count = 1
+	}
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.DeriveAccounts{Pass: in.Passphrase, Account: in.Account, Index: in.Index, Count: count},
+		defaultActorTimeout, "rpc.(*AergoRPCService).DeriveAccounts")
 	if err != nil {
-		return nil, err
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	rsp, ok := result.(*message.GetVoteRsp)
+
+	rsp, ok := result.(*message.HDAccountsRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return rsp.Top, rsp.Err
+	return rsp.Accounts, rsp.Err
 }
 
-func (rpc *AergoRPCService) GetAccountVotes(ctx context.Context, in *types.AccountAddress) (*types.AccountVoteInfo, error) {
-	ids := []string{}
-	for _, v := range types.AllVotes {
-		ids = append(ids, v[2:])
-	}
-	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetVote{Addr: in.Value, Ids: ids}, defaultActorTimeout, "rpc.(*AergoRPCService).GetAccountVote").Result()
+// ListHDAccounts handle rpc request listhdaccounts
+func (rpc *AergoRPCService) ListHDAccounts(ctx context.Context, in *types.Empty) (*types.HDAccountList, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.GetHDAccounts{}, defaultActorTimeout, "rpc.(*AergoRPCService).ListHDAccounts")
 	if err != nil {
-		return nil, err
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	rsp, ok := result.(*message.GetAccountVoteRsp)
+
+	rsp, ok := result.(*message.HDAccountsRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return rsp.Info, rsp.Err
+	return rsp.Accounts, rsp.Err
 }
 
-//GetStaking handle rpc request getstaking
-func (rpc *AergoRPCService) GetStaking(ctx context.Context, in *types.AccountAddress) (*types.Staking, error) {
-	var err error
-	var result interface{}
-
-	if len(in.Value) <= types.AddressLength {
-		result, err = rpc.hub.RequestFuture(message.ChainSvc,
-			&message.GetStaking{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStaking").Result()
-		if err != nil {
-			return nil, err
+// SetAlias handle rpc request setalias
+func (rpc *AergoRPCService) SetAlias(ctx context.Context, in *types.Alias) (*types.Alias, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.SetAlias{Alias: in}, defaultActorTimeout, "rpc.(*AergoRPCService).SetAlias")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
 		}
-	} else {
-		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	rsp, ok := result.(*message.GetStakingRsp)
+
+	rsp, ok := result.(*message.AliasRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	return rsp.Staking, rsp.Err
+	return rsp.Alias, rsp.Err
 }
 
-func (rpc *AergoRPCService) GetNameInfo(ctx context.Context, in *types.Name) (*types.NameInfo, error) {
-	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetNameInfo{Name: in.Name, BlockNo: in.BlockNo}, defaultActorTimeout, "rpc.(*AergoRPCService).GetName").Result()
+// DeleteAlias handle rpc request deletealias
+func (rpc *AergoRPCService) DeleteAlias(ctx context.Context, in *types.Alias) (*types.Alias, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.DeleteAlias{Label: in.GetLabel()}, defaultActorTimeout, "rpc.(*AergoRPCService).DeleteAlias")
 	if err != nil {
-		return nil, err
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	rsp, ok := result.(*message.GetNameInfoRsp)
+
+	rsp, ok := result.(*message.AliasRsp)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
 	}
-	if rsp.Err == types.ErrNameNotFound {
-		return rsp.Owner, status.Errorf(codes.NotFound, rsp.Err.Error())
-	}
-	return rsp.Owner, rsp.Err
+	return rsp.Alias, rsp.Err
 }
 
-func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleBytes) (*types.Receipt, error) {
+// ListAliases handle rpc request listaliases
+func (rpc *AergoRPCService) ListAliases(ctx context.Context, in *types.Empty) (*types.AliasList, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.GetAliases{}, defaultActorTimeout, "rpc.(*AergoRPCService).ListAliases")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.AliasesRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Aliases, rsp.Err
+}
+
+// AddWatchAccount handle rpc request addwatchaccount
+func (rpc *AergoRPCService) AddWatchAccount(ctx context.Context, in *types.Account) (*types.WatchAccount, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.AddWatchAccount{Address: in.GetAddress()}, defaultActorTimeout, "rpc.(*AergoRPCService).AddWatchAccount")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.WatchAccountRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Account, rsp.Err
+}
+
+// RemoveWatchAccount handle rpc request removewatchaccount
+func (rpc *AergoRPCService) RemoveWatchAccount(ctx context.Context, in *types.Account) (*types.WatchAccount, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.RemoveWatchAccount{Address: in.GetAddress()}, defaultActorTimeout, "rpc.(*AergoRPCService).RemoveWatchAccount")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.WatchAccountRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Account, rsp.Err
+}
+
+// ListWatchAccounts handle rpc request listwatchaccounts
+func (rpc *AergoRPCService) ListWatchAccounts(ctx context.Context, in *types.Empty) (*types.WatchAccountList, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.GetWatchAccounts{}, defaultActorTimeout, "rpc.(*AergoRPCService).ListWatchAccounts")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.WatchAccountsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Accounts, rsp.Err
+}
+
+// BroadcastToWatchAccountTxStream sends the txs of the block sent or
+// received by the watched addresses.
+func (rpc *AergoRPCService) BroadcastToWatchAccountTxStream(block *types.Block, receipts []*types.Receipt) {
+	rpc.watchTxStreamLock.RLock()
+	defer rpc.watchTxStreamLock.RUnlock()
+	if len(rpc.watchTxStream) == 0 {
+		return
+	}
+
+	accounts, err := rpc.ListWatchAccounts(context.Background(), &types.Empty{})
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to get the watched addresses")
+		return
+	}
+	watched := make(map[string]bool)
+	for _, a := range accounts.GetAccounts() {
+		watched[string(a.GetAddress())] = true
+	}
+	if len(watched) == 0 {
+		return
+	}
+
+	for idx, tx := range block.GetBody().GetTxs() {
+		body := tx.GetBody()
+		if !watched[string(body.GetAccount())] && !watched[string(body.GetRecipient())] {
+			continue
+		}
+		accountTx := &types.AccountTx{
+			Tx:        tx,
+			BlockHash: block.BlockHash(),
+			BlockNo:   block.BlockNo(),
+			TxIdx:     int32(idx),
+		}
+		if idx < len(receipts) {
+			accountTx.Status = receipts[idx].Status
+		}
+		for _, stream := range rpc.watchTxStream {
+			if err := stream.Send(accountTx); err != nil {
+				logger.Warn().Err(err).Msg("failed to broadcast watch account tx stream")
+			}
+		}
+	}
+}
+
+// ListWatchAccountTxStream starts a stream of the txs of the watched addresses
+// in new blocks
+func (rpc *AergoRPCService) ListWatchAccountTxStream(in *types.Empty, stream types.AergoRPCService_ListWatchAccountTxStreamServer) error {
+	streamID := atomic.AddUint32(&rpc.streamID, 1)
+	rpc.watchTxStreamLock.Lock()
+	rpc.watchTxStream[streamID] = stream
+	rpc.watchTxStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("watch account tx stream added")
+
+	<-stream.Context().Done()
+	rpc.watchTxStreamLock.Lock()
+	delete(rpc.watchTxStream, streamID)
+	rpc.watchTxStreamLock.Unlock()
+	logger.Info().Uint32("id", streamID).Msg("watch account tx stream deleted")
+	return nil
+}
+
+// SignTX handle rpc request signtx
+func (rpc *AergoRPCService) SignTX(ctx context.Context, in *types.Tx) (*types.Tx, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		newSignTx(ctx, in, nil), defaultActorTimeout, "rpc.(*AergoRPCService).SignTX")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.SignTxRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Tx, rsp.Err
+}
+
+// newSignTx returns the request to sign tx with the client of ctx, which is
+// recorded in the audit log.
+func newSignTx(ctx context.Context, tx *types.Tx, requester []byte) *message.SignTx {
+	msg := &message.SignTx{Tx: tx, Requester: requester}
+	if id, ok := auth.FromContext(ctx); ok {
+		msg.Client = id.Name
+	}
+	if p, ok := grpcpeer.FromContext(ctx); ok {
+		msg.Peer = p.Addr.String()
+	}
+	return msg
+}
+
+// ListSignAudits handle rpc request listsignaudits
+func (rpc *AergoRPCService) ListSignAudits(ctx context.Context, in *types.SignAuditQuery) (*types.SignAuditList, error) {
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.GetSignAudits{Query: in}, defaultActorTimeout, "rpc.(*AergoRPCService).ListSignAudits")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.SignAuditsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Audits, rsp.Err
+}
+
+// VerifyTX handle rpc request verifytx
+func (rpc *AergoRPCService) VerifyTX(ctx context.Context, in *types.Tx) (*types.VerifyResult, error) {
+	//TODO : verify without account service
+	result, err := rpc.hub.RequestFutureResult(message.AccountsSvc,
+		&message.VerifyTx{Tx: in}, defaultActorTimeout, "rpc.(*AergoRPCService).VerifyTX")
+	if err != nil {
+		if err == component.ErrHubUnregistered {
+			return nil, status.Errorf(codes.Unavailable, "Unavailable personal feature")
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	rsp, ok := result.(*message.VerifyTxRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	ret := &types.VerifyResult{Tx: rsp.Tx}
+	if rsp.Err == types.ErrSignNotMatch {
+		ret.Error = types.VerifyStatus_VERIFY_STATUS_SIGN_NOT_MATCH
+	} else {
+		ret.Error = types.VerifyStatus_VERIFY_STATUS_OK
+	}
+	return ret, nil
+}
+
+// GetPeers handle rpc request getpeers
+func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams) (*types.PeerList, error) {
+	result, err := rpc.hub.RequestFuture(message.P2PSvc,
+		&message.GetPeers{in.NoHidden, in.ShowSelf, in.WithStats}, halfMinute, "rpc.(*AergoRPCService).GetPeers").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetPeersRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+
+	ret := &types.PeerList{Peers: make([]*types.Peer, 0, len(rsp.Peers)), TotalHint: uint64(len(rsp.Peers))}
+	for _, pi := range rsp.Peers {
+		blkNotice := &types.NewBlockNotice{BlockHash: pi.LastBlockHash, BlockNo: pi.LastBlockNumber}
+		peer := &types.Peer{Address: pi.Addr, State: int32(pi.State), Bestblock: blkNotice, LashCheck: pi.CheckTime.UnixNano(), Hidden: pi.Hidden, Selfpeer: pi.Self, Version: pi.Version, ProtocolStats: pi.ProtocolStats}
+		ret.Peers = append(ret.Peers, peer)
+	}
+
+	// all the peers are listed unless a page is requested
+	if in.Size == 0 && len(in.Cursor) == 0 {
+		return ret, nil
+	}
+	sort.Slice(ret.Peers, func(i, j int) bool {
+		return bytes.Compare(ret.Peers[i].Address.GetPeerID(), ret.Peers[j].Address.GetPeerID()) < 0
+	})
+	start, end, next, err := keyPage(cursorPeers, len(ret.Peers), func(i int) []byte {
+		return ret.Peers[i].Address.GetPeerID()
+	}, in.Cursor, pageSize(in.Size))
+	if err != nil {
+		return nil, err
+	}
+	ret.Peers, ret.NextCursor = ret.Peers[start:end], next
+	return ret, nil
+}
+
+// NodeState handle rpc request nodestate
+func (rpc *AergoRPCService) NodeState(ctx context.Context, in *types.NodeReq) (*types.SingleBytes, error) {
+	timeout := int64(binary.LittleEndian.Uint64(in.Timeout))
+	component := string(in.Component)
+
+	logger.Debug().Str("comp", component).Int64("timeout", timeout).Msg("nodestate")
+
+	statics, err := rpc.hub.Statistics(time.Duration(timeout)*time.Second, component)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(statics, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return &types.SingleBytes{Value: data}, nil
+}
+
+//GetVotes handle rpc request getvotes
+func (rpc *AergoRPCService) GetVotes(ctx context.Context, in *types.VoteParams) (*types.VoteList, error) {
+
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetElected{Id: in.GetId(), N: in.GetCount()}, defaultActorTimeout, "rpc.(*AergoRPCService).GetVote").Result()
+
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetVoteRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Top, rsp.Err
+}
+
+func (rpc *AergoRPCService) GetAccountVotes(ctx context.Context, in *types.AccountAddress) (*types.AccountVoteInfo, error) {
+	ids := []string{}
+	for _, v := range types.AllVotes {
+		ids = append(ids, v[2:])
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetVote{Addr: in.Value, Ids: ids}, defaultActorTimeout, "rpc.(*AergoRPCService).GetAccountVote").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetAccountVoteRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Info, rsp.Err
+}
+
+//GetStaking handle rpc request getstaking
+func (rpc *AergoRPCService) GetStaking(ctx context.Context, in *types.AccountAddress) (*types.Staking, error) {
+	var err error
+	var result interface{}
+
+	if len(in.Value) <= types.AddressLength {
+		result, err = rpc.hub.RequestFuture(message.ChainSvc,
+			&message.GetStaking{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStaking").Result()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+	}
+	rsp, ok := result.(*message.GetStakingRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Staking, rsp.Err
+}
+
+//GetPendingWithdrawals handle rpc request getpendingwithdrawals
+func (rpc *AergoRPCService) GetPendingWithdrawals(ctx context.Context, in *types.AccountAddress) (*types.WithdrawalList, error) {
+	if len(in.Value) > types.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetWithdrawals{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetPendingWithdrawals").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetWithdrawalsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Withdrawals, rsp.Err
+}
+
+//GetSystemAccountInfo handle rpc request getsystemaccountinfo
+func (rpc *AergoRPCService) GetSystemAccountInfo(ctx context.Context, in *types.AccountAddress) (*types.SystemAccountInfo, error) {
+	if len(in.Value) > types.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Only support valid address")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetSystemAccount{Addr: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetSystemAccountInfo").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetSystemAccountRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Info, rsp.Err
+}
+
+// SimulateSystemTx handle rpc request simulatesystemtx
+func (rpc *AergoRPCService) SimulateSystemTx(ctx context.Context, in *types.Tx) (*types.SystemTxSimulation, error) {
+	if in.GetBody() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tx body is empty")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.SimulateSystemTx{Tx: in}, defaultActorTimeout, "rpc.(*AergoRPCService).SimulateSystemTx").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.SimulateSystemTxRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Result, rsp.Err
+}
+
+//GetQuorumStatus handle rpc request getquorumstatus
+func (rpc *AergoRPCService) GetQuorumStatus(ctx context.Context, in *types.Empty) (*types.QuorumStatusList, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetQuorumStatus{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetQuorumStatus").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetQuorumStatusRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.QuorumStatusList{Params: rsp.Params}, rsp.Err
+}
+
+//EstimateFee handle rpc request estimatefee
+func (rpc *AergoRPCService) EstimateFee(ctx context.Context, in *types.TxBody) (*types.FeeEstimate, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetFeeEstimate{TxBody: in}, defaultActorTimeout, "rpc.(*AergoRPCService).EstimateFee").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetFeeEstimateRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Estimate, rsp.Err
+}
+
+//GetBaseFee handle rpc request getbasefee
+func (rpc *AergoRPCService) GetBaseFee(ctx context.Context, in *types.Empty) (*types.BaseFee, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetBaseFee{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetBaseFee").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetBaseFeeRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.BaseFee, rsp.Err
+}
+
+//GetElectionTally handle rpc request getelectiontally
+func (rpc *AergoRPCService) GetElectionTally(ctx context.Context, in *types.Empty) (*types.ElectionTally, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetElectionTally{}, defaultActorTimeout, "rpc.(*AergoRPCService).GetElectionTally").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetElectionTallyRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Tally, rsp.Err
+}
+
+func (rpc *AergoRPCService) GetNameInfo(ctx context.Context, in *types.Name) (*types.NameInfo, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetNameInfo{Name: in.Name, BlockNo: in.BlockNo}, defaultActorTimeout, "rpc.(*AergoRPCService).GetName").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetNameInfoRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err == types.ErrNameNotFound {
+		return rsp.Owner, status.Errorf(codes.NotFound, rsp.Err.Error())
+	}
+	return rsp.Owner, rsp.Err
+}
+
+//ListNameOffers handle rpc request listnameoffers
+func (rpc *AergoRPCService) ListNameOffers(ctx context.Context, in *types.Empty) (*types.NameInfoList, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListNameOffers{}, defaultActorTimeout, "rpc.(*AergoRPCService).ListNameOffers").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListNameOffersRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return &types.NameInfoList{Names: rsp.Names}, rsp.Err
+}
+
+func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleBytes) (*types.Receipt, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.GetReceipt{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetReceipt").Result()
 	if err != nil {
@@ -924,6 +1723,61 @@ func (rpc *AergoRPCService) GetABI(ctx context.Context, in *types.SingleBytes) (
 	return rsp.ABI, rsp.Err
 }
 
+// GetContractABI returns the ABI of the contract with the address it is
+// resolved to and the hash of its code.
+func (rpc *AergoRPCService) GetContractABI(ctx context.Context, in *types.SingleBytes) (*types.ContractABI, error) {
+	rsp, err := rpc.getABI(in.Value, "rpc.(*AergoRPCService).GetContractABI")
+	if err != nil {
+		return nil, err
+	}
+	return &types.ContractABI{Address: rsp.Address, CodeHash: rsp.CodeHash, Abi: rsp.ABI}, nil
+}
+
+// EncodeCall returns the payload of the call to the contract checked against
+// its ABI, or against the rules of the governance txs for the system
+// contracts.
+func (rpc *AergoRPCService) EncodeCall(ctx context.Context, in *types.CallEncodeParams) (*types.SingleBytes, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "no function name")
+	}
+	amount := new(big.Int).SetBytes(in.Amount)
+	var payload []byte
+	var err error
+	switch contract := string(in.ContractAddress); contract {
+	case types.AergoSystem, types.AergoName, types.AergoBridge:
+		payload, err = types.EncodeGovernanceCall(contract, in.Name, in.JsonArgs, amount)
+	default:
+		var rsp *message.GetABIRsp
+		if rsp, err = rpc.getABI(in.ContractAddress, "rpc.(*AergoRPCService).EncodeCall"); err != nil {
+			return nil, err
+		}
+		if rsp.ABI == nil {
+			return nil, status.Error(codes.NotFound, "no ABI of the contract")
+		}
+		payload, err = rsp.ABI.EncodeCall(in.Name, in.JsonArgs, amount)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.SingleBytes{Value: payload}, nil
+}
+
+func (rpc *AergoRPCService) getABI(contract []byte, caller string) (*message.GetABIRsp, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetABI{Contract: contract}, defaultActorTimeout, caller).Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetABIRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
+	return &rsp, nil
+}
+
 func (rpc *AergoRPCService) QueryContract(ctx context.Context, in *types.Query) (*types.SingleBytes, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.GetQuery{Contract: in.ContractAddress, Queryinfo: in.Queryinfo}, defaultActorTimeout, "rpc.(*AergoRPCService).QueryContract").Result()
@@ -939,8 +1793,12 @@ func (rpc *AergoRPCService) QueryContract(ctx context.Context, in *types.Query)
 
 // QueryContractState queries the state of a contract state variable without executing a contract function.
 func (rpc *AergoRPCService) QueryContractState(ctx context.Context, in *types.StateQuery) (*types.StateQueryProof, error) {
+	root, err := rpc.stateRootOf(in.Root, in.BlockHash)
+	if err != nil {
+		return nil, err
+	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetStateQuery{ContractAddress: in.ContractAddress, StorageKeys: in.StorageKeys, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateQuery").Result()
+		&message.GetStateQuery{ContractAddress: in.ContractAddress, StorageKeys: in.StorageKeys, Root: root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateQuery").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -951,6 +1809,61 @@ func (rpc *AergoRPCService) QueryContractState(ctx context.Context, in *types.St
 	return rsp.Result, rsp.Err
 }
 
+// GetBridgeProof returns the total deposited to the receiver in the bridge at
+// the block, the best block if no block number is given, with its proof
+// against the state root of the block. The receiver claims the total on the
+// paired chain by the proof once the root is anchored there.
+func (rpc *AergoRPCService) GetBridgeProof(ctx context.Context, in *types.BridgeProofQuery) (*types.BridgeProof, error) {
+	if len(in.Receiver) != types.AddressLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid receiver")
+	}
+	var block *types.Block
+	var err error
+	if in.BlockNo == 0 {
+		block, err = rpc.actorHelper.GetChainAccessor().GetBestBlock()
+	} else {
+		block, err = extractBlockFromFuture(rpc.hub.RequestFuture(message.ChainSvc,
+			&message.GetBlockByNo{BlockNo: in.BlockNo}, defaultActorTimeout, "rpc.(*AergoRPCService).GetBridgeProof"))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	root := block.GetHeader().GetBlocksRootHash()
+	query, err := rpc.QueryContractState(ctx, &types.StateQuery{
+		ContractAddress: []byte(types.AergoBridge),
+		StorageKeys:     []string{types.BridgeDepositKey(in.Receiver)},
+		Root:            root,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var deposited []byte
+	if varProofs := query.GetVarProofs(); len(varProofs) == 1 && varProofs[0].GetInclusion() {
+		deposited = varProofs[0].GetValue()
+	}
+	return &types.BridgeProof{
+		BlockNo:   block.GetHeader().GetBlockNo(),
+		BlockHash: block.BlockHash(),
+		Root:      root,
+		Deposited: deposited,
+		Proof:     query,
+	}, nil
+}
+
+// stateRootOf returns root if it is given, or else the state root of the
+// block blockHash. A nil root designates the latest state.
+func (rpc *AergoRPCService) stateRootOf(root, blockHash []byte) ([]byte, error) {
+	if len(root) != 0 || len(blockHash) == 0 {
+		return root, nil
+	}
+	block, err := extractBlockFromFuture(rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetBlock{BlockHash: blockHash}, defaultActorTimeout, "rpc.(*AergoRPCService).stateRootOf"))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+	return block.GetHeader().GetBlocksRootHash(), nil
+}
+
 func toTimestamp(time time.Time) *timestamp.Timestamp {
 	return &timestamp.Timestamp{
 		Seconds: time.Unix(),
@@ -1009,6 +1922,42 @@ func (rpc *AergoRPCService) BroadcastToEventStream(events []*types.Event) error
 	return nil
 }
 
+// ListEventFilterStream starts a stream of the new events matching the filter
+func (rpc *AergoRPCService) ListEventFilterStream(in *types.EventStreamFilter, stream types.AergoRPCService_ListEventFilterStreamServer) error {
+	if err := in.ValidateCheck(); err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	argFilter, _ := in.GetExArgFilter()
+
+	es := &EventFilterStream{in, argFilter, stream}
+	rpc.eventFilterStreamLock.Lock()
+	rpc.eventFilterStream[es] = es
+	rpc.eventFilterStreamLock.Unlock()
+
+	<-stream.Context().Done()
+	rpc.eventFilterStreamLock.Lock()
+	delete(rpc.eventFilterStream, es)
+	rpc.eventFilterStreamLock.Unlock()
+	return nil
+}
+
+func (rpc *AergoRPCService) BroadcastToEventFilterStream(events []*types.Event) {
+	rpc.eventFilterStreamLock.RLock()
+	defer rpc.eventFilterStreamLock.RUnlock()
+
+	for _, es := range rpc.eventFilterStream {
+		for _, event := range events {
+			if !es.filter.Match(event, es.argFilter) {
+				continue
+			}
+			if err := es.stream.Send(event); err != nil {
+				logger.Warn().Err(err).Msg("failed to broadcast event filter stream")
+				break
+			}
+		}
+	}
+}
+
 func (rpc *AergoRPCService) ListEvents(ctx context.Context, in *types.FilterInfo) (*types.EventList, error) {
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
 		&message.ListEvents{Filter: in}, defaultActorTimeout, "rpc.(*AergoRPCService).ListEvents").Result()
@@ -1022,6 +1971,162 @@ func (rpc *AergoRPCService) ListEvents(ctx context.Context, in *types.FilterInfo
 	return &types.EventList{Events: rsp.Events}, rsp.Err
 }
 
+// ListEventPage returns a page of the events matching the filter.
+func (rpc *AergoRPCService) ListEventPage(ctx context.Context, in *types.EventListParams) (*types.EventPage, error) {
+	params := *in
+	if len(in.Cursor) != 0 {
+		pos, err := decodeCursor(cursorEvents, in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.Cursor = pos
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListEventPage{Params: &params}, defaultActorTimeout, "rpc.(*AergoRPCService).ListEventPage").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListEventPageRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Page != nil && len(rsp.Page.NextCursor) != 0 {
+		rsp.Page.NextCursor = encodeCursor(cursorEvents, rsp.Page.NextCursor)
+	}
+	return rsp.Page, rsp.Err
+}
+
+// GetAccountTxHistory returns a page of the txs sent or received by the
+// account, the latest first.
+func (rpc *AergoRPCService) GetAccountTxHistory(ctx context.Context, in *types.AccountTxHistoryParams) (*types.AccountTxHistory, error) {
+	if len(in.Address) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no address")
+	}
+	params := *in
+	params.Size = uint32(pageSize(in.Size))
+	if len(in.Cursor) != 0 {
+		pos, err := decodeCursor(cursorAccountTxs, in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.Cursor = pos
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetAccountTxHistory{Params: &params}, defaultActorTimeout, "rpc.(*AergoRPCService).GetAccountTxHistory").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetAccountTxHistoryRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.History != nil && len(rsp.History.NextCursor) != 0 {
+		rsp.History.NextCursor = encodeCursor(cursorAccountTxs, rsp.History.NextCursor)
+	}
+	return rsp.History, rsp.Err
+}
+
+// ListStateDiffStream streams accounts and storage keys changed between the
+// states of two blocks. The diff is requested from the chain service by pages
+// so that it is never held entirely in memory.
+func (rpc *AergoRPCService) ListStateDiffStream(in *types.StateDiffParams, stream types.AergoRPCService_ListStateDiffStreamServer) error {
+	var cursor []byte
+	for {
+		result, err := rpc.hub.RequestFuture(message.ChainSvc,
+			&message.GetStateDiff{FromBlockHash: in.FromBlockHash, ToBlockHash: in.ToBlockHash, Cursor: cursor},
+			defaultActorTimeout, "rpc.(*AergoRPCService).ListStateDiffStream").Result()
+		if err != nil {
+			return err
+		}
+		rsp, ok := result.(*message.GetStateDiffRsp)
+		if !ok {
+			return status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+		}
+		if rsp.Err != nil {
+			return rsp.Err
+		}
+		for _, diff := range rsp.Diffs {
+			if err := stream.Send(diff); err != nil {
+				return err
+			}
+		}
+		if len(rsp.NextCursor) == 0 {
+			return nil
+		}
+		cursor = rsp.NextCursor
+	}
+}
+
+// ListContractStorage returns a page of key-value pairs stored by a contract.
+func (rpc *AergoRPCService) ListContractStorage(ctx context.Context, in *types.StorageListParams) (*types.StorageList, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListContractStorage{Params: in}, defaultActorTimeout, "rpc.(*AergoRPCService).ListContractStorage").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListContractStorageRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.List, rsp.Err
+}
+
+// GetContractStorageUsage returns the storage bytes used by a contract and the
+// storage quota of the contracts.
+func (rpc *AergoRPCService) GetContractStorageUsage(ctx context.Context, in *types.SingleBytes) (*types.ContractStorageUsage, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetContractStorageUsage{Contract: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetContractStorageUsage").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetContractStorageUsageRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Usage, rsp.Err
+}
+
+// GetInternalOperations returns the calls, the sends and the deploys made by
+// the contracts during a tx.
+func (rpc *AergoRPCService) GetInternalOperations(ctx context.Context, in *types.SingleBytes) (*types.InternalOperations, error) {
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetInternalOperations{TxHash: in.Value}, defaultActorTimeout, "rpc.(*AergoRPCService).GetInternalOperations").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.GetInternalOperationsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Operations, rsp.Err
+}
+
+// ListIndexedEvents returns a page of the events looked up by the values of
+// their indexed arguments.
+func (rpc *AergoRPCService) ListIndexedEvents(ctx context.Context, in *types.EventListParams) (*types.EventPage, error) {
+	params := *in
+	if len(in.Cursor) != 0 {
+		pos, err := decodeCursor(cursorIndexedEvents, in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.Cursor = pos
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.ListIndexedEvents{Params: &params}, defaultActorTimeout, "rpc.(*AergoRPCService).ListIndexedEvents").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.ListIndexedEventsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Page != nil && len(rsp.Page.NextCursor) != 0 {
+		rsp.Page.NextCursor = encodeCursor(cursorIndexedEvents, rsp.Page.NextCursor)
+	}
+	return rsp.Page, rsp.Err
+}
+
 func (rpc *AergoRPCService) GetServerInfo(ctx context.Context, in *types.KeyParams) (*types.ServerInfo, error) {
 	result, err := rpc.hub.RequestFuture(message.RPCSvc,
 		&message.GetServerInfo{Categories: in.Key}, defaultActorTimeout, "rpc.(*AergoRPCService).GetServerInfo").Result()
@@ -1053,17 +2158,86 @@ func (rpc *AergoRPCService) ChainStat(ctx context.Context, in *types.Empty) (*ty
 	return &types.ChainStats{Report: ca.GetChainStats()}, nil
 }
 
-func (rpc *AergoRPCService) ChangeMembership(ctx context.Context, in *types.MembershipChange) (*types.MembershipChangeReply, error) {
+// GetBlockTemplate handles rpc request getblocktemplate. The txs are the
+// ones the node would try for its next block in its tx order; some of them
+// may still fail on the execution.
+func (rpc *AergoRPCService) GetBlockTemplate(ctx context.Context, in *types.Empty) (*types.BlockTemplate, error) {
 	if rpc.consensusAccessor == nil {
 		return nil, ErrUninitAccessor
 	}
+	best, err := rpc.actorHelper.GetChainAccessor().GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	order := bc.GetTxOrder()
+	result, err := rpc.hub.RequestFuture(message.MemPoolSvc,
+		&message.MemPoolGet{MaxBlockBodySize: chain.MaxBlockBodySize(), Order: order},
+		defaultActorTimeout, "rpc.(*AergoRPCService).GetBlockTemplate").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.MemPoolGetRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, rsp.Err
+	}
 
+	ci := rpc.consensusAccessor.ConsensusInfo()
+	template := &types.BlockTemplate{
+		ChainID:         best.GetHeader().GetChainID(),
+		PrevBlockHash:   best.BlockHash(),
+		BlockNo:         best.GetHeader().GetBlockNo() + 1,
+		Timestamp:       clock.Now().UnixNano(),
+		CoinbaseAccount: chain.CoinbaseAccount,
+		ConsensusType:   ci.GetType(),
+		ConsensusInfo:   ci.GetInfo(),
+		TxOrder:         order.Name(),
+		Txs:             make([]*types.Tx, 0, len(rsp.Txs)),
+	}
+	for _, tx := range rsp.Txs {
+		template.Txs = append(template.Txs, tx.GetTx())
+	}
+	return template, nil
+}
+
+// SubmitBlock handles rpc request submitblock. The block is connected as the
+// ones received from the other BPs, so it must be signed by a BP of its turn.
+// Raft orders the blocks by its log, so it doesn't accept any block from
+// outside.
+func (rpc *AergoRPCService) SubmitBlock(ctx context.Context, in *types.Block) (*types.BlockMetadata, error) {
+	if in.GetHeader() == nil || in.GetBody() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "block header or body is empty")
+	}
 	if genesisInfo := rpc.actorHelper.GetChainAccessor().GetGenesisInfo(); genesisInfo != nil {
-		if genesisInfo.ID.Consensus != raftv2.GetName() {
-			return nil, ErrNotSupportedConsensus
+		if genesisInfo.ID.Consensus == raftv2.GetName() {
+			return nil, status.Errorf(codes.FailedPrecondition, ErrNotSupportedConsensus.Error())
 		}
 	}
 
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.AddBlock{PeerID: "", Block: in, Bstate: nil}, defaultActorTimeout,
+		"rpc.(*AergoRPCService).SubmitBlock").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.AddBlockRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, rsp.Err.Error())
+	}
+	return in.GetMetadata(), nil
+}
+
+func (rpc *AergoRPCService) ChangeMembership(ctx context.Context, in *types.MembershipChange) (*types.MembershipChangeReply, error) {
+	if err := rpc.checkRaftAccessor(); err != nil {
+		return nil, err
+	}
+
 	member, err := rpc.consensusAccessor.ConfChange(in)
 	if err != nil {
 		return nil, err
@@ -1072,3 +2246,48 @@ func (rpc *AergoRPCService) ChangeMembership(ctx context.Context, in *types.Memb
 	reply := &types.MembershipChangeReply{Attr: &types.MemberAttr{ID: uint64(member.ID), Name: member.Name, Url: member.Url, PeerID: []byte(peer.ID(member.PeerID))}}
 	return reply, nil
 }
+
+// checkRaftAccessor returns an error unless the consensus of the chain is raft.
+func (rpc *AergoRPCService) checkRaftAccessor() error {
+	if rpc.consensusAccessor == nil {
+		return ErrUninitAccessor
+	}
+
+	if genesisInfo := rpc.actorHelper.GetChainAccessor().GetGenesisInfo(); genesisInfo != nil {
+		if genesisInfo.ID.Consensus != raftv2.GetName() {
+			return ErrNotSupportedConsensus
+		}
+	}
+	return nil
+}
+
+// GetClusterStatus handle rpc request getclusterstatus
+func (rpc *AergoRPCService) GetClusterStatus(ctx context.Context, in *types.Empty) (*types.ClusterStatus, error) {
+	if err := rpc.checkRaftAccessor(); err != nil {
+		return nil, err
+	}
+
+	return rpc.consensusAccessor.ClusterStatus()
+}
+
+// TransferLeader handle rpc request transferleader
+func (rpc *AergoRPCService) TransferLeader(ctx context.Context, in *types.MemberAttr) (*types.MemberAttr, error) {
+	if err := rpc.checkRaftAccessor(); err != nil {
+		return nil, err
+	}
+
+	return rpc.consensusAccessor.TransferLeader(in.GetID())
+}
+
+// CreateClusterSnapshot handle rpc request createclustersnapshot
+func (rpc *AergoRPCService) CreateClusterSnapshot(ctx context.Context, in *types.Empty) (*types.ClusterSnapshot, error) {
+	if err := rpc.checkRaftAccessor(); err != nil {
+		return nil, err
+	}
+
+	index, err := rpc.consensusAccessor.CreateSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClusterSnapshot{Index: index}, nil
+}
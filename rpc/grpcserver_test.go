@@ -12,6 +12,7 @@ import (
 	"github.com/aergoio/aergo/p2p/p2pmock"
 	"github.com/golang/mock/gomock"
 	"math/big"
+	"net"
 	"reflect"
 	"testing"
 
@@ -22,6 +23,9 @@ import (
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/types"
 	"github.com/mr-tron/base58/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func TestAergoRPCService_dummys(t *testing.T) {
@@ -130,3 +134,42 @@ func (fs *FutureStub) Result() interface{} {
 func NewFutureStub(result interface{}) FutureStub {
 	return FutureStub{dumbResult: result}
 }
+
+func TestAergoRPCService_BulkParams(t *testing.T) {
+	rpc := &AergoRPCService{hub: hubStub}
+	tooMany := &types.BulkParams{Keys: make([][]byte, maxBulkSize+1)}
+	for _, in := range []*types.BulkParams{{}, tooMany} {
+		if _, err := rpc.GetBlocksBulk(mockCtx, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("AergoRPCService.GetBlocksBulk() error = %v, want InvalidArgument", err)
+		}
+		if _, err := rpc.GetTxsBulk(mockCtx, in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("AergoRPCService.GetTxsBulk() error = %v, want InvalidArgument", err)
+		}
+	}
+}
+
+func TestAergoRPCService_checkDebugAccess(t *testing.T) {
+	ctxFrom := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 7845}})
+	}
+	tests := []struct {
+		name   string
+		nsAuth bool
+		ctx    context.Context
+		want   codes.Code
+	}{
+		{"local", false, ctxFrom("127.0.0.1"), codes.OK},
+		{"local6", false, ctxFrom("::1"), codes.OK},
+		{"remote", false, ctxFrom("192.168.0.10"), codes.PermissionDenied},
+		{"nopeer", false, context.Background(), codes.PermissionDenied},
+		{"auth", true, ctxFrom("192.168.0.10"), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &AergoRPCService{nsAuth: tt.nsAuth}
+			if err := rpc.checkDebugAccess(tt.ctx); status.Code(err) != tt.want {
+				t.Errorf("AergoRPCService.checkDebugAccess() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/consensus/impl/raftv2"
+	"github.com/aergoio/aergo/internal/diskmon"
+	"github.com/aergoio/aergo/message"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	healthCheckInterval = 5 * time.Second
+	// healthCheckTimeout is the timeout of the requests to the other services
+	// in the checks
+	healthCheckTimeout = 3 * time.Second
+	// maxSyncLag is the number of blocks the node may be behind its peers
+	// and be ready
+	maxSyncLag = 10
+	// minDiskFree is the free space of the data directory below which the
+	// node is not ready
+	minDiskFree = 100 * 1024 * 1024
+)
+
+// healthServices are the grpc services whose status follows the readiness of
+// the node. The empty name is the status of the whole server.
+var healthServices = map[string]bool{"": true, "types.AergoRPCService": true}
+
+type healthCheck struct {
+	name  string
+	check func() (string, error)
+}
+
+type healthResult struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type healthReport struct {
+	Ready   bool           `json:"ready"`
+	Checked time.Time      `json:"checked"`
+	Checks  []healthResult `json:"checks"`
+}
+
+// healthChecker runs the readiness checks of the node periodically, and
+// serves the result as the grpc health service and to the http probes
+// /livez and /readyz. The node is live as long as the checks keep running,
+// so a node stuck by a deadlock is restarted.
+type healthChecker struct {
+	checks   []healthCheck
+	interval time.Duration
+
+	mutex  sync.RWMutex
+	report *healthReport
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+func newHealthChecker(interval time.Duration, checks ...healthCheck) *healthChecker {
+	return &healthChecker{
+		checks:   checks,
+		interval: interval,
+	}
+}
+
+func (hc *healthChecker) start() {
+	hc.stop, hc.done = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(hc.done)
+		ticker := time.NewTicker(hc.interval)
+		defer ticker.Stop()
+		for {
+			hc.run()
+			select {
+			case <-ticker.C:
+			case <-hc.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (hc *healthChecker) Stop() {
+	if hc.stop != nil {
+		close(hc.stop)
+		<-hc.done
+	}
+}
+
+func (hc *healthChecker) run() {
+	report := &healthReport{Ready: true, Checks: make([]healthResult, 0, len(hc.checks))}
+	for _, c := range hc.checks {
+		detail, err := c.check()
+		result := healthResult{Name: c.name, OK: err == nil, Detail: detail}
+		if err != nil {
+			result.Detail = err.Error()
+			report.Ready = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	report.Checked = time.Now()
+
+	hc.mutex.Lock()
+	changed := hc.report == nil || hc.report.Ready != report.Ready
+	hc.report = report
+	hc.mutex.Unlock()
+
+	if !changed {
+		return
+	}
+	if report.Ready {
+		logger.Info().Msg("node is ready")
+	} else {
+		logger.Warn().Interface("checks", report.Checks).Msg("node is not ready")
+	}
+}
+
+func (hc *healthChecker) lastReport() *healthReport {
+	hc.mutex.RLock()
+	defer hc.mutex.RUnlock()
+	return hc.report
+}
+
+// Check implements the grpc health service. The node is not serving until
+// the first checks.
+func (hc *healthChecker) Check(ctx context.Context, in *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !healthServices[in.GetService()] {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+	rsp := &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}
+	if report := hc.lastReport(); report != nil && report.Ready {
+		rsp.Status = healthpb.HealthCheckResponse_SERVING
+	}
+	return rsp, nil
+}
+
+// handler serves the http probes, and the other requests by next.
+func (hc *healthChecker) handler(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
+		// the checks may take the timeouts of all the requests
+		report := hc.lastReport()
+		if report != nil && time.Since(report.Checked) > 3*hc.interval+healthCheckTimeout*time.Duration(len(hc.checks)) {
+			http.Error(w, "health checks are stuck", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		report := hc.lastReport()
+		if report == nil {
+			http.Error(w, "not checked yet", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if !report.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(report)
+	})
+	mux.Handle("/", next)
+	return mux
+}
+
+func (ns *RPC) healthChecks() []healthCheck {
+	return []healthCheck{
+		{name: "db", check: ns.checkDB},
+		{name: "sync", check: ns.checkSync},
+		{name: "consensus", check: ns.checkConsensus},
+	}
+}
+
+// checkDB checks that the best block is read from the db and the data
+// directory has free space.
+func (ns *RPC) checkDB() (string, error) {
+	best, err := ns.ca.GetBestBlock()
+	if err != nil {
+		return "", err
+	}
+	if _, err := ns.ca.GetBlock(best.BlockHash()); err != nil {
+		return "", fmt.Errorf("failed to read the best block: %s", err.Error())
+	}
+	if _, free, err := diskmon.Usage(ns.conf.BaseConfig.DataDir); err == nil && free < minDiskFree {
+		return "", fmt.Errorf("%d bytes free in the data directory", free)
+	}
+	return fmt.Sprintf("best block %d", best.BlockNo()), nil
+}
+
+// checkSync checks that the node is not far behind the best block of its
+// peers. A node without peers is considered synced.
+func (ns *RPC) checkSync() (string, error) {
+	best, err := ns.ca.GetBestBlock()
+	if err != nil {
+		return "", err
+	}
+	result, err := ns.CallRequest(message.P2PSvc, &message.GetPeers{}, healthCheckTimeout)
+	if err != nil {
+		return "", err
+	}
+	rsp, ok := result.(*message.GetPeersRsp)
+	if !ok {
+		return "", errors.New("unexpected response of the peers")
+	}
+	var peerBest uint64
+	for _, p := range rsp.Peers {
+		if !p.Self && p.LastBlockNumber > peerBest {
+			peerBest = p.LastBlockNumber
+		}
+	}
+	if peerBest > best.BlockNo()+maxSyncLag {
+		return "", fmt.Errorf("%d blocks behind the peers", peerBest-best.BlockNo())
+	}
+	return fmt.Sprintf("%d peers", len(rsp.Peers)), nil
+}
+
+// checkConsensus checks that the raft cluster has a leader, that is a quorum
+// of the members is running.
+func (ns *RPC) checkConsensus() (string, error) {
+	genesis := ns.ca.GetGenesisInfo()
+	if genesis == nil {
+		return "", nil
+	}
+	if genesis.ID.Consensus != raftv2.GetName() {
+		return genesis.ID.Consensus, nil
+	}
+	if ns.actualServer.consensusAccessor == nil {
+		return "", ErrUninitAccessor
+	}
+	cluster, err := ns.actualServer.consensusAccessor.ClusterStatus()
+	if err != nil {
+		return "", err
+	}
+	if cluster.GetLeader() == 0 {
+		return "", errors.New("no raft leader")
+	}
+	return fmt.Sprintf("raft leader %x", cluster.GetLeader()), nil
+}
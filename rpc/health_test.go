@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthChecker(t *testing.T) {
+	var syncErr error
+	hc := newHealthChecker(time.Minute,
+		healthCheck{name: "db", check: func() (string, error) { return "best block 7", nil }},
+		healthCheck{name: "sync", check: func() (string, error) { return "", syncErr }},
+	)
+	handler := hc.handler(http.NotFoundHandler())
+	probe := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+	servingStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+		rsp, err := hc.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		return rsp.GetStatus()
+	}
+
+	assert.Equal(t, http.StatusServiceUnavailable, probe("/readyz").Code, "not checked yet")
+	assert.Equal(t, http.StatusOK, probe("/livez").Code)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus())
+
+	hc.run()
+	assert.Equal(t, http.StatusOK, probe("/readyz").Code)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus())
+
+	syncErr = errors.New("20 blocks behind the peers")
+	hc.run()
+	w := probe("/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var report healthReport
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.False(t, report.Ready)
+	assert.Equal(t, []healthResult{{Name: "db", OK: true, Detail: "best block 7"}, {Name: "sync", Detail: syncErr.Error()}}, report.Checks)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus())
+
+	// the node is not live when the checks stop running
+	hc.report.Checked = time.Now().Add(-time.Hour)
+	assert.Equal(t, http.StatusServiceUnavailable, probe("/livez").Code)
+
+	assert.Equal(t, http.StatusNotFound, probe("/other").Code)
+	_, err := hc.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "other"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,96 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/aergoio/aergo/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The cursors of the list rpcs are opaque to the clients. A cursor is the
+// kind of the list followed by the position where the next page starts, so
+// the cursor of a list is rejected by the others.
+const (
+	cursorBlocks byte = iota + 1
+	cursorPeers
+	cursorEvents
+	cursorAccountTxs
+	cursorIndexedEvents
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var errInvalidCursor = status.Error(codes.InvalidArgument, "invalid cursor")
+
+// pageSize returns the size of the page requested by size. The default size
+// is used when size is not given.
+func pageSize(size uint32) int {
+	if size == 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+func encodeCursor(kind byte, pos []byte) []byte {
+	return append([]byte{kind}, pos...)
+}
+
+// decodeCursor returns the position of the cursor of the kind.
+func decodeCursor(kind byte, cursor []byte) ([]byte, error) {
+	if len(cursor) < 2 || cursor[0] != kind {
+		return nil, errInvalidCursor
+	}
+	return cursor[1:], nil
+}
+
+func encodeBlockCursor(blockNo types.BlockNo) []byte {
+	pos := make([]byte, 8)
+	binary.BigEndian.PutUint64(pos, blockNo)
+	return encodeCursor(cursorBlocks, pos)
+}
+
+func decodeBlockCursor(cursor []byte) (types.BlockNo, error) {
+	pos, err := decodeCursor(cursorBlocks, cursor)
+	if err != nil {
+		return 0, err
+	}
+	if len(pos) != 8 {
+		return 0, errInvalidCursor
+	}
+	return binary.BigEndian.Uint64(pos), nil
+}
+
+// keyPage returns the range [start, end) of the page of n items sorted by
+// their keys. The page starts right after the key of the cursor, so the
+// items added or removed between the pages don't shift the pages. next is
+// the cursor of the following page, or nil at the end of the items.
+func keyPage(kind byte, n int, key func(i int) []byte, cursor []byte, size int) (start, end int, next []byte, err error) {
+	if len(cursor) != 0 {
+		after, err := decodeCursor(kind, cursor)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		start = sort.Search(n, func(i int) bool {
+			return bytes.Compare(key(i), after) > 0
+		})
+	}
+	end = start + size
+	if end >= n {
+		return start, n, nil, nil
+	}
+	return start, end, encodeCursor(kind, key(end-1)), nil
+}
@@ -0,0 +1,53 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCursor(t *testing.T) {
+	no, err := decodeBlockCursor(encodeBlockCursor(1234))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1234), no)
+
+	_, err = decodeBlockCursor(encodeCursor(cursorPeers, []byte("12345678")))
+	assert.Equal(t, errInvalidCursor, err, "cursor of another list")
+	_, err = decodeBlockCursor([]byte{cursorBlocks, 1})
+	assert.Equal(t, errInvalidCursor, err, "truncated cursor")
+}
+
+func TestKeyPage(t *testing.T) {
+	keys := [][]byte{[]byte("a"), []byte("c"), []byte("e"), []byte("g"), []byte("i")}
+	key := func(i int) []byte { return keys[i] }
+
+	start, end, next, err := keyPage(cursorPeers, len(keys), key, nil, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, []int{start, end})
+	assert.Equal(t, encodeCursor(cursorPeers, []byte("c")), next)
+
+	// a key removed before the next page doesn't shift it
+	keys = append(keys[:1], keys[2:]...)
+	start, end, next, err = keyPage(cursorPeers, len(keys), key, next, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, []int{start, end})
+	assert.Equal(t, encodeCursor(cursorPeers, []byte("g")), next)
+
+	start, end, next, err = keyPage(cursorPeers, len(keys), key, next, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, []int{start, end})
+	assert.Nil(t, next, "last page")
+
+	_, _, _, err = keyPage(cursorPeers, len(keys), key, encodeBlockCursor(1), 2)
+	assert.Equal(t, errInvalidCursor, err)
+}
+
+func TestPageSize(t *testing.T) {
+	assert.Equal(t, defaultPageSize, pageSize(0))
+	assert.Equal(t, 10, pageSize(10))
+	assert.Equal(t, maxPageSize, pageSize(maxPageSize+1))
+}
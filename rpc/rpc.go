@@ -6,9 +6,15 @@
 package rpc
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -20,15 +26,21 @@ import (
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/diskmon"
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/rpc/auth"
+	"github.com/aergoio/aergo/rpc/gateway"
 	"github.com/aergoio/aergo/types"
 	aergorpc "github.com/aergoio/aergo/types"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/opentracing/opentracing-go"
 	"github.com/soheilhy/cmux"
+	"golang.org/x/net/http2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // RPC is actor for providing rpc service
@@ -41,6 +53,10 @@ type RPC struct {
 	grpcWebServer *grpcweb.WrappedGrpcServer
 	actualServer  *AergoRPCService
 	httpServer    *http.Server
+	gatewayServer *http.Server
+	gateway       *gateway.Gateway
+	certs         *certReloader
+	health        *healthChecker
 
 	ca      types.ChainAccessor
 	version string
@@ -52,9 +68,16 @@ type RPC struct {
 func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version string) *RPC {
 	actualServer := &AergoRPCService{
 		msgHelper:           message.GetHelper(),
+		profileAddr:         fmt.Sprintf("127.0.0.1:%d", cfg.ProfilePort),
+		dumpDir:             filepath.Join(cfg.DataDir, "dumps"),
+		nsAuth:              cfg.RPC.NSAuth,
 		blockStream:         map[uint32]types.AergoRPCService_ListBlockStreamServer{},
 		blockMetadataStream: map[uint32]types.AergoRPCService_ListBlockMetadataStreamServer{},
 		eventStream:         make(map[*EventStream]*EventStream),
+		blockDetailStream:   make(map[uint32]*blockDetailStream),
+		eventFilterStream:   make(map[*EventFilterStream]*EventFilterStream),
+		consensusStream:     map[uint32]types.AergoRPCService_ListConsensusEventStreamServer{},
+		watchTxStream:       map[uint32]types.AergoRPCService_ListWatchAccountTxStreamServer{},
 	}
 
 	tracer := opentracing.GlobalTracer()
@@ -62,9 +85,28 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 		grpc.MaxRecvMsgSize(1024 * 1024 * 256),
 	}
 
+	var authenticator *auth.Authenticator
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if cfg.RPC.NSAuth {
+		var err error
+		authenticator, err = auth.NewAuthenticator(cfg.RPC.NSAuthTokens, cfg.RPC.NSAnonymousRole)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("invalid rpc auth config")
+		}
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, authenticator.StreamInterceptor)
+	}
 	if cfg.RPC.NetServiceTrace {
-		opts = append(opts, grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(tracer)))
-		opts = append(opts, grpc.StreamInterceptor(otgrpc.OpenTracingStreamServerInterceptor(tracer)))
+		unaryInterceptors = append(unaryInterceptors, otgrpc.OpenTracingServerInterceptor(tracer))
+		streamInterceptors = append(streamInterceptors, otgrpc.OpenTracingStreamServerInterceptor(tracer))
+	}
+	if len(unaryInterceptors) > 0 {
+		opts = append(opts, grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors)))
+		opts = append(opts, grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors)))
+	}
+	if cfg.RPC.NSEnableTLS {
+		opts = append(opts, grpc.Creds(muxTLSCredentials{}))
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -87,13 +129,28 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 	}
 	rpcsvc.BaseComponent = component.NewBaseComponent(message.RPCSvc, rpcsvc, logger)
 	actualServer.actorHelper = rpcsvc
+	rpcsvc.health = newHealthChecker(healthCheckInterval, rpcsvc.healthChecks()...)
+	healthpb.RegisterHealthServer(grpcServer, rpcsvc.health)
 
 	rpcsvc.httpServer = &http.Server{
-		Handler:        rpcsvc.grpcWebHandlerFunc(grpcWebServer, http.DefaultServeMux),
+		Handler:        rpcsvc.health.handler(rpcsvc.grpcWebHandlerFunc(grpcWebServer, http.DefaultServeMux)),
 		ReadTimeout:    4 * time.Second,
 		WriteTimeout:   4 * time.Second,
 		MaxHeaderBytes: 1 << 20,
 	}
+	if cfg.RPC.NSGateway {
+		gw := gateway.New(actualServer, cfg.RPC.NSAllowCORS)
+		if authenticator != nil {
+			gw.Authorize = authenticator.AuthorizeHTTP
+		}
+		rpcsvc.gateway = gw
+		rpcsvc.gatewayServer = &http.Server{
+			Handler:        gw,
+			ReadTimeout:    4 * time.Second,
+			WriteTimeout:   2 * defaultActorTimeout,
+			MaxHeaderBytes: 1 << 20,
+		}
+	}
 
 	return rpcsvc
 }
@@ -113,13 +170,29 @@ func (ns *RPC) BeforeStart() {
 }
 
 func (ns *RPC) AfterStart() {
+	if ns.conf.RPC.NSEnableTLS {
+		certs, err := newCertReloader(ns.conf.RPC.NSCert, ns.conf.RPC.NSKey, ns.conf.RPC.NSCACert)
+		if err != nil {
+			panic(err)
+		}
+		certs.start()
+		ns.certs = certs
+	}
+	ns.health.start()
 	go ns.serve()
 }
 
 // Stop stops rpc service.
 func (ns *RPC) BeforeStop() {
 	ns.httpServer.Close()
+	if ns.gatewayServer != nil {
+		ns.gatewayServer.Close()
+	}
 	ns.grpcServer.Stop()
+	ns.health.Stop()
+	if ns.certs != nil {
+		ns.certs.Stop()
+	}
 }
 
 func (ns *RPC) Statistics() *map[string]interface{} {
@@ -130,14 +203,26 @@ func (ns *RPC) Statistics() *map[string]interface{} {
 
 func (ns *RPC) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
-	case *types.Block:
+	case *message.NotifyBlock:
 		server := ns.actualServer
-		server.BroadcastToListBlockStream(msg)
-		meta := msg.GetMetadata()
+		server.BroadcastToListBlockStream(msg.Block)
+		meta := msg.Block.GetMetadata()
 		server.BroadcastToListBlockMetadataStream(meta)
+		server.BroadcastToListBlockDetailStream(msg.Block, msg.Receipts)
+		server.BroadcastToWatchAccountTxStream(msg.Block, msg.Receipts)
+		if ns.gateway != nil {
+			ns.gateway.NotifyBlock(msg.Block, msg.Receipts)
+		}
 	case []*types.Event:
 		server := ns.actualServer
 		server.BroadcastToEventStream(msg)
+		server.BroadcastToEventFilterStream(msg)
+		if ns.gateway != nil {
+			ns.gateway.NotifyEvents(msg)
+		}
+	case *types.ConsensusEvent:
+		server := ns.actualServer
+		server.BroadcastToConsensusEventStream(msg)
 	case *message.GetServerInfo:
 		context.Respond(ns.CollectServerInfo(msg.Categories))
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
@@ -178,6 +263,44 @@ func (ns *RPC) serveHTTP(l net.Listener, server *http.Server) {
 	}
 }
 
+// Serve HTTP/2 connections without grpc requests
+func (ns *RPC) serveHTTP2(l net.Listener, handler http.Handler) {
+	server := &http2.Server{}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go server.ServeConn(conn, &http2.ServeConnOpts{Handler: handler})
+	}
+}
+
+// loadTLSConfig returns the TLS config of the certificate of the server.
+// Client certificates are verified by the CA certificate if it's set.
+func loadTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = x509.NewCertPool()
+		if !config.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate in %s", caFile)
+		}
+		// clients may authenticate by tokens instead
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
+}
+
 // Serve TCP multiplexer
 func (ns *RPC) serve() {
 	ipAddr := net.ParseIP(ns.conf.RPC.NetServiceAddr)
@@ -191,6 +314,11 @@ func (ns *RPC) serve() {
 	if err != nil {
 		panic(err)
 	}
+	var tlsConfig *tls.Config
+	if ns.certs != nil {
+		tlsConfig = ns.certs.tlsConfig()
+		l = tls.NewListener(l, tlsConfig)
+	}
 
 	// Setup TCP multiplexer
 	tcpm := cmux.New(l)
@@ -199,13 +327,26 @@ func (ns *RPC) serve() {
 
 	ns.Info().Msg(fmt.Sprintf("Starting RPC server listening on %s, with TLS: %v", addr, ns.conf.RPC.NSEnableTLS))
 
-	if ns.conf.RPC.NSEnableTLS {
-		ns.Warn().Msg("TLS is enabled in configuration, but currently not supported")
-	}
-
 	// Server both servers
 	go ns.serveGRPC(grpcL, ns.grpcServer)
 	go ns.serveHTTP(httpL, ns.httpServer)
+	if tlsConfig != nil {
+		// browsers negotiate HTTP/2 for grpc-web over TLS
+		go ns.serveHTTP2(tcpm.Match(cmux.HTTP2()), ns.httpServer.Handler)
+	}
+
+	if ns.gatewayServer != nil {
+		gatewayAddr := fmt.Sprintf("%s:%d", ipAddr, ns.conf.RPC.NSGatewayPort)
+		gl, err := net.Listen("tcp", gatewayAddr)
+		if err != nil {
+			panic(err)
+		}
+		if tlsConfig != nil {
+			gl = tls.NewListener(gl, tlsConfig)
+		}
+		ns.Info().Msg(fmt.Sprintf("Starting JSON-RPC and REST gateway listening on %s", gatewayAddr))
+		go ns.serveHTTP(gl, ns.gatewayServer)
+	}
 
 	// Serve TCP multiplexer
 	if err := tcpm.Serve(); !strings.Contains(err.Error(), "use of closed network connection") {
@@ -229,6 +370,14 @@ func (ns *RPC) CollectServerInfo(categories []string) *types.ServerInfo {
 		statusInfo["addr"] = meta.IPAddress
 		statusInfo["port"] = strconv.Itoa(int(meta.Port))
 	}
+	// the clock and the disk space of the node for the health checks of clients
+	statusInfo["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	if total, free, err := diskmon.Usage(ns.conf.BaseConfig.DataDir); err != nil {
+		ns.Logger.Warn().Err(err).Msg("failed to get the disk usage of the data directory")
+	} else {
+		statusInfo["disktotal"] = strconv.FormatUint(total, 10)
+		statusInfo["diskfree"] = strconv.FormatUint(free, 10)
+	}
 	configInfo := make(map[string]*types.ConfigItem)
 	types.AddCategory(configInfo, "base").AddBool("personal", ns.conf.BaseConfig.Personal)
 	types.AddCategory(configInfo, "account").AddInt("unlocktimeout", int(ns.conf.Account.UnlockTimeout))
@@ -275,6 +424,9 @@ func (ns *RPC) GetChainAccessor() types.ChainAccessor {
 }
 
 func convertError(err error) types.CommitStatus {
+	if _, ok := err.(*types.ChainMismatchError); ok {
+		return types.CommitStatus_TX_INVALID_CHAIN_ID
+	}
 	switch err {
 	case nil:
 		return types.CommitStatus_TX_OK
@@ -290,8 +442,63 @@ func convertError(err error) types.CommitStatus {
 		return types.CommitStatus_TX_INSUFFICIENT_BALANCE
 	case types.ErrSameNonceAlreadyInMempool:
 		return types.CommitStatus_TX_HAS_SAME_NONCE
+	case types.ErrTxInvalidChainIdHash:
+		return types.CommitStatus_TX_INVALID_CHAIN_ID
 	default:
 		//logger.Info().Str("hash", err.Error()).Msg("RPC encountered unconvertable error")
 		return types.CommitStatus_TX_INTERNAL_ERROR
 	}
 }
+
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// muxTLSCredentials passes the state of the TLS connections to grpc. The
+// handshakes are done by the listener before the TCP multiplexer.
+type muxTLSCredentials struct{}
+
+func (muxTLSCredentials) ClientHandshake(ctx context.Context, addr string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("server credentials only")
+}
+
+func (muxTLSCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if mc, ok := conn.(*cmux.MuxConn); ok {
+		if tc, ok := mc.Conn.(*tls.Conn); ok {
+			return conn, credentials.TLSInfo{State: tc.ConnectionState()}, nil
+		}
+	}
+	return conn, nil, nil
+}
+
+func (muxTLSCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (c muxTLSCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (muxTLSCredentials) OverrideServerName(string) error {
+	return nil
+}
@@ -15,8 +15,22 @@ type BlockInfo struct {
 type BlockState struct {
 	StateDB
 	BpReward []byte //final bp reward, increment when tx executes
+	TxSize   uint64 //total size of the executed txs
 	receipts types.Receipts
 	CodeMap  map[types.AccountID][]byte
+	// internalOps is the calls among the contracts made by the txs
+	internalOps []*types.InternalOperations
+	// indexedEvents is the number of the indexed args of the events by the
+	// tx hash and the event index
+	indexedEvents map[string]map[int32]int32
+
+	// SystemChanges is the changes of the governance at the block, which are
+	// published as node events after the block state is committed
+	SystemChanges []string
+
+	// SqlUpdated is set if the txs updated the SQL databases of the
+	// contracts, which are stored out of the state trie
+	SqlUpdated bool
 }
 
 // NewBlockInfo create new blockInfo contains blockNo, blockHash and blockHash of previous block
@@ -61,6 +75,55 @@ func (bs *BlockState) AddReceipt(r *types.Receipt) error {
 	return nil
 }
 
+// AddBlockEvents adds events emitted by the block itself rather than by a tx.
+func (bs *BlockState) AddBlockEvents(events ...*types.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	bBloom := bloom.New(types.BloomBitBits, types.BloomHashKNum)
+	for _, e := range events {
+		bBloom.Add(e.ContractAddress)
+		bBloom.Add([]byte(e.EventName))
+	}
+	if err := bs.receipts.MergeBloom(bBloom); err != nil {
+		return err
+	}
+	bs.receipts.AddBlockEvents(events...)
+	return nil
+}
+
+// AddInternalOperations adds the operations made by the contracts in a tx.
+func (bs *BlockState) AddInternalOperations(ops *types.InternalOperations) {
+	bs.internalOps = append(bs.internalOps, ops)
+}
+
+// InternalOperations returns the operations made by the contracts in the txs
+// of the block.
+func (bs *BlockState) InternalOperations() []*types.InternalOperations {
+	if bs == nil {
+		return nil
+	}
+	return bs.internalOps
+}
+
+// AddIndexedEvents adds the number of the indexed args of the events emitted
+// by a tx.
+func (bs *BlockState) AddIndexedEvents(txHash []byte, indexed map[int32]int32) {
+	if bs.indexedEvents == nil {
+		bs.indexedEvents = make(map[string]map[int32]int32)
+	}
+	bs.indexedEvents[string(txHash)] = indexed
+}
+
+// IndexedEventArgs returns the number of the indexed args of an event emitted
+// by a tx of the block.
+func (bs *BlockState) IndexedEventArgs(txHash []byte, eventIdx int32) int {
+	if bs == nil {
+		return 0
+	}
+	return int(bs.indexedEvents[string(txHash)][eventIdx])
+}
+
 func (bs *BlockState) Receipts() *types.Receipts {
 	if bs == nil {
 		return nil
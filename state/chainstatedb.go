@@ -8,6 +8,7 @@ import (
 	"github.com/aergoio/aergo-lib/db"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/pkg/storage"
 	"github.com/aergoio/aergo/types"
 )
 
@@ -17,6 +18,8 @@ type ChainStateDB struct {
 	states   *StateDB
 	store    db.DB
 	testmode bool
+	// batchSize is the maximum number of writes per db bulk on commit
+	batchSize int
 }
 
 // NewChainStateDB creates instance of ChainStateDB
@@ -30,8 +33,9 @@ func (sdb *ChainStateDB) Clone() *ChainStateDB {
 	defer sdb.Unlock()
 
 	newSdb := &ChainStateDB{
-		store:  sdb.store,
-		states: sdb.GetStateDB().Clone(),
+		store:     sdb.store,
+		states:    sdb.GetStateDB().Clone(),
+		batchSize: sdb.batchSize,
 	}
 	return newSdb
 }
@@ -45,7 +49,11 @@ func (sdb *ChainStateDB) Init(dbType string, dataDir string, bestBlock *types.Bl
 	// init db
 	if sdb.store == nil {
 		dbPath := common.PathMkdirAll(dataDir, stateName)
-		sdb.store = db.NewDB(db.ImplType(dbType), dbPath)
+		store, err := storage.Open(dbType, dbPath)
+		if err != nil {
+			return err
+		}
+		sdb.store = store
 	}
 
 	// init trie
@@ -56,6 +64,7 @@ func (sdb *ChainStateDB) Init(dbType string, dataDir string, bestBlock *types.Bl
 		}
 
 		sdb.states = NewStateDB(&sdb.store, sroot, sdb.testmode)
+		sdb.states.batchSize = sdb.batchSize
 	}
 	return nil
 }
@@ -82,9 +91,24 @@ func (sdb *ChainStateDB) GetSystemAccountState() (*ContractState, error) {
 	return sdb.GetStateDB().GetSystemAccountState()
 }
 
+// SetBatchSize sets the maximum number of writes flushed to db at once when
+// committing the states of blocks. A size of zero flushes each commit in a
+// single bulk.
+func (sdb *ChainStateDB) SetBatchSize(size int) {
+	sdb.Lock()
+	defer sdb.Unlock()
+
+	sdb.batchSize = size
+	if sdb.states != nil {
+		sdb.states.SetBatchSize(size)
+	}
+}
+
 // OpenNewStateDB returns new instance of statedb given state root hash
 func (sdb *ChainStateDB) OpenNewStateDB(root []byte) *StateDB {
-	return NewStateDB(&sdb.store, root, sdb.testmode)
+	states := NewStateDB(&sdb.store, root, sdb.testmode)
+	states.batchSize = sdb.batchSize
+	return states
 }
 
 func (sdb *ChainStateDB) SetGenesis(genesis *types.Genesis, bpInit func(*StateDB, *types.Genesis) error) error {
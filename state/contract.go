@@ -102,7 +102,9 @@ func (st *ContractState) HasKey(key []byte) bool {
 
 // SetData store key and value pair to the storage.
 func (st *ContractState) SetData(key, value []byte) error {
-	st.storage.put(newValueEntry(types.GetHashID(key), value))
+	id := types.GetHashID(key)
+	st.storage.put(newValueEntry(id, value))
+	st.storage.setKey(id, key)
 	return nil
 }
 
@@ -139,6 +141,23 @@ func (st *ContractState) GetInitialData(key []byte) ([]byte, error) {
 	return st.getInitialData(id[:])
 }
 
+// IterateData calls fn for every key and value pair of the committed contract
+// storage in ascending order of trie key, starting from the trie key start.
+// The original key is nil when it has not been recorded. Iteration stops at
+// the first error returned by fn.
+func (st *ContractState) IterateData(start []byte, fn func(trieKey, key, value []byte) error) error {
+	return st.storage.trie.WalkFrom(st.storage.trie.Root, start, func(id, dkey []byte) error {
+		var key, value []byte
+		if err := loadData(st.store, storageKeyOf(id), &key); err != nil {
+			return err
+		}
+		if err := loadData(st.store, dkey, &value); err != nil {
+			return err
+		}
+		return fn(id, key, value)
+	})
+}
+
 // DeleteData remove key and value pair from the storage.
 func (st *ContractState) DeleteData(key []byte) error {
 	st.storage.put(newValueEntryDelete(types.GetHashID(key)))
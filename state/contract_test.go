@@ -294,3 +294,72 @@ func TestContractStateRollback(t *testing.T) {
 	res, _ = contractState.GetData(testKey)
 	assert.Nil(t, res)
 }
+
+func TestContractStateIterateData(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	testAddress := []byte("test_address")
+	testData := map[string]string{
+		"key_a": "value_a",
+		"key_b": "value_b",
+		"key_c": "value_c",
+	}
+
+	contractState, err := stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+	for k, v := range testData {
+		err = contractState.SetData([]byte(k), []byte(v))
+		assert.NoError(t, err, "set data to contract state")
+	}
+	err = stateDB.StageContractState(contractState)
+	assert.NoError(t, err, "stage contract state")
+	err = stateDB.Update()
+	assert.NoError(t, err, "failed to update")
+	err = stateDB.Commit()
+	assert.NoError(t, err, "failed to commit")
+
+	contractState, err = stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+	var trieKeys [][]byte
+	err = contractState.IterateData(nil, func(trieKey, key, value []byte) error {
+		assert.Equal(t, testData[string(key)], string(value))
+		trieKeys = append(trieKeys, trieKey)
+		return nil
+	})
+	assert.NoError(t, err, "iterate data")
+	assert.Len(t, trieKeys, len(testData))
+
+	// resume from the second trie key
+	count := 0
+	err = contractState.IterateData(trieKeys[1], func(trieKey, key, value []byte) error {
+		assert.Equal(t, trieKeys[count+1], trieKey)
+		count++
+		return nil
+	})
+	assert.NoError(t, err, "iterate data")
+	assert.Equal(t, len(testData)-1, count)
+}
+
+func TestContractStateKeyRollback(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	testAddress := []byte("test_address")
+
+	contractState, err := stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+	assert.NoError(t, contractState.SetData([]byte("kept"), []byte("value")))
+	assert.NoError(t, contractState.SetData([]byte("deleted"), []byte("value")))
+	assert.NoError(t, contractState.DeleteData([]byte("deleted")))
+	revision := contractState.Snapshot()
+	assert.NoError(t, contractState.SetData([]byte("rolledback"), []byte("value")))
+	assert.NoError(t, contractState.Rollback(revision))
+
+	assert.NoError(t, stateDB.StageContractState(contractState))
+	assert.NoError(t, stateDB.Update())
+	assert.NoError(t, stateDB.Commit())
+
+	for key, stored := range map[string]bool{"kept": true, "deleted": false, "rolledback": false} {
+		id := types.GetHashID([]byte(key))
+		assert.Equal(t, stored, len((*stateDB.store).Get(storageKeyOf(id[:]))) != 0, key)
+	}
+}
@@ -0,0 +1,80 @@
+package state
+
+import (
+	"github.com/aergoio/aergo-lib/db"
+)
+
+// batchTx coalesces the writes staged while committing a state. A key
+// written several times (e.g. identical values stored by different
+// contracts) is written once with its last value. The writes are flushed in
+// the order the keys were first staged, so the state marker which is staged
+// last is written only after every node and value of the state.
+type batchTx struct {
+	keys   []string
+	values map[string][]byte
+	// deleted holds the keys whose last operation is a deletion
+	deleted map[string]bool
+}
+
+func newBatchTx() *batchTx {
+	return &batchTx{
+		values:  map[string][]byte{},
+		deleted: map[string]bool{},
+	}
+}
+
+// Set implements trie.DbTx
+func (batch *batchTx) Set(key, value []byte) {
+	k := batch.touch(key)
+	batch.values[k] = value
+	delete(batch.deleted, k)
+}
+
+// Delete implements trie.DbTx
+func (batch *batchTx) Delete(key []byte) {
+	k := batch.touch(key)
+	delete(batch.values, k)
+	batch.deleted[k] = true
+}
+
+func (batch *batchTx) touch(key []byte) string {
+	k := string(key)
+	if _, ok := batch.values[k]; !ok && !batch.deleted[k] {
+		batch.keys = append(batch.keys, k)
+	}
+	return k
+}
+
+// len returns the number of distinct keys staged in the batch.
+func (batch *batchTx) len() int {
+	return len(batch.keys)
+}
+
+// flush writes the staged keys to the store using bulks of at most size
+// writes. A size less than or equal to zero writes everything in a single
+// bulk.
+func (batch *batchTx) flush(store db.DB, size int) {
+	bulk := store.NewBulk()
+	count := 0
+	for _, k := range batch.keys {
+		if batch.deleted[k] {
+			bulk.Delete([]byte(k))
+		} else {
+			bulk.Set([]byte(k), batch.values[k])
+		}
+		count++
+		if size > 0 && count == size {
+			bulk.Flush()
+			bulk = store.NewBulk()
+			count = 0
+		}
+	}
+	if count > 0 {
+		bulk.Flush()
+	} else {
+		bulk.DiscardLast()
+	}
+	batch.keys = nil
+	batch.values = map[string][]byte{}
+	batch.deleted = map[string]bool{}
+}
@@ -0,0 +1,45 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchTxCoalesce(t *testing.T) {
+	batch := newBatchTx()
+	batch.Set(testKey, testData)
+	batch.Set(testKey, testOver)
+	batch.Set([]byte("deleted"), testData)
+	batch.Delete([]byte("deleted"))
+	assert.Equal(t, 2, batch.len())
+	assert.Equal(t, testOver, batch.values[string(testKey)])
+	assert.True(t, batch.deleted["deleted"])
+
+	// a deleted key written again is not deleted anymore
+	batch.Set([]byte("deleted"), testData)
+	assert.Equal(t, 2, batch.len())
+	assert.False(t, batch.deleted["deleted"])
+}
+
+func TestBatchTxFlush(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	batch := newBatchTx()
+	for i := 0; i < 10; i++ {
+		batch.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	batch.Delete([]byte("key9"))
+	batch.flush(*stateDB.store, 3)
+	assert.Equal(t, 0, batch.len())
+
+	for i := 0; i < 9; i++ {
+		data := []byte{}
+		err := loadData(stateDB.store, []byte(fmt.Sprintf("key%d", i)), &data)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), data)
+	}
+	assert.False(t, (*stateDB.store).Exist([]byte("key9")))
+}
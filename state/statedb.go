@@ -13,9 +13,9 @@ import (
 	"sync"
 
 	"github.com/aergoio/aergo-lib/db"
-	"github.com/aergoio/aergo-lib/log"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/pkg/trie"
 	"github.com/aergoio/aergo/types"
 )
@@ -30,7 +30,7 @@ var (
 )
 
 var (
-	logger = log.NewLogger(stateName)
+	logger = logctl.NewLogger(stateName)
 )
 
 var (
@@ -64,6 +64,8 @@ type StateDB struct {
 	store    *db.DB
 	batchtx  db.Transaction
 	testmode bool
+	// batchSize is the maximum number of writes per db bulk on commit
+	batchSize int
 }
 
 // NewStateDB craete StateDB instance
@@ -83,7 +85,17 @@ func (states *StateDB) Clone() *StateDB {
 	states.lock.RLock()
 	defer states.lock.RUnlock()
 
-	return NewStateDB(states.store, states.GetRoot(), states.testmode)
+	clone := NewStateDB(states.store, states.GetRoot(), states.testmode)
+	clone.batchSize = states.batchSize
+	return clone
+}
+
+// SetBatchSize sets the maximum number of writes flushed to db at once by
+// Commit. A size of zero flushes a commit in a single bulk.
+func (states *StateDB) SetBatchSize(size int) {
+	states.lock.Lock()
+	defer states.lock.Unlock()
+	states.batchSize = size
 }
 
 // GetRoot returns root hash of trie
@@ -484,19 +496,19 @@ func (states *StateDB) Commit() error {
 	states.lock.Lock()
 	defer states.lock.Unlock()
 
-	bulk := (*states.store).NewBulk()
+	// coalesce the writes of all tries into a single batch
+	batch := newBatchTx()
 	for _, storage := range states.cache.storages {
 		// stage changes
-		if err := storage.stage(bulk); err != nil {
-			bulk.DiscardLast()
+		if err := storage.stage(batch); err != nil {
 			return err
 		}
 	}
-	if err := states.stage(bulk); err != nil {
-		bulk.DiscardLast()
+	if err := states.stage(batch); err != nil {
 		return err
 	}
-	bulk.Flush()
+	logger.Debug().Int("writes", batch.len()).Int("batchSize", states.batchSize).Msg("commit state")
+	batch.flush(*states.store, states.batchSize)
 	return nil
 }
 
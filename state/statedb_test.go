@@ -249,3 +249,68 @@ func TestStateDBMarker(t *testing.T) {
 	assert.False(t, stateDB.HasMarker([]byte{}))
 	assert.False(t, stateDB.HasMarker(nil))
 }
+
+func TestStateDBDiff(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	testKey := []byte("test_key")
+
+	_ = stateDB.PutState(testAccount, &testStates[0])
+	err := stateDB.Update()
+	assert.NoError(t, err, "failed to update")
+	err = stateDB.Commit()
+	assert.NoError(t, err, "failed to commit")
+	from := stateDB.GetRoot()
+
+	// change balance of test account and storage of another account
+	_ = stateDB.PutState(testAccount, &testStates[1])
+	contractState, err := stateDB.OpenContractStateAccount(types.ToAccountID([]byte("test_contract")))
+	assert.NoError(t, err, "could not open contract state")
+	err = contractState.SetData(testKey, []byte("test_value"))
+	assert.NoError(t, err, "set data to contract state")
+	err = stateDB.StageContractState(contractState)
+	assert.NoError(t, err, "stage contract state")
+	err = stateDB.Update()
+	assert.NoError(t, err, "failed to update")
+	err = stateDB.Commit()
+	assert.NoError(t, err, "failed to commit")
+	to := stateDB.GetRoot()
+
+	var diffs []*types.AccountDiff
+	err = stateDB.Diff(from, to, nil, func(diff *types.AccountDiff) error {
+		diffs = append(diffs, diff)
+		return nil
+	})
+	assert.NoError(t, err, "failed to get diff")
+	assert.Len(t, diffs, 2)
+	for _, diff := range diffs {
+		if bytes.Equal(diff.AccountID, testAccount[:]) {
+			assert.True(t, stateEquals(&testStates[0], diff.OldState))
+			assert.True(t, stateEquals(&testStates[1], diff.NewState))
+			assert.Empty(t, diff.Storage)
+		} else {
+			assert.Nil(t, diff.OldState)
+			assert.Len(t, diff.Storage, 1)
+			assert.Equal(t, []byte("test_value"), diff.Storage[0].NewValue)
+		}
+	}
+
+	// reverse order reports the same accounts
+	count := 0
+	err = stateDB.Diff(to, from, nil, func(diff *types.AccountDiff) error {
+		count++
+		return nil
+	})
+	assert.NoError(t, err, "failed to get diff")
+	assert.Equal(t, 2, count)
+
+	// the accounts up to the start are skipped
+	var rest []*types.AccountDiff
+	err = stateDB.Diff(from, to, diffs[0].AccountID, func(diff *types.AccountDiff) error {
+		rest = append(rest, diff)
+		return nil
+	})
+	assert.NoError(t, err, "failed to get diff")
+	assert.Len(t, rest, 1)
+	assert.Equal(t, diffs[1].AccountID, rest[0].AccountID)
+}
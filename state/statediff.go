@@ -0,0 +1,70 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+)
+
+// Diff computes the accounts changed between the state roots from and to,
+// and calls fn for each of them in ascending order of account id. The
+// accounts up to start are skipped, so that a diff can be listed by pages.
+// When the storage root of an account has changed, the changed storage keys
+// are attached to the reported diff. Iteration stops at the first error
+// returned by fn.
+func (states *StateDB) Diff(from, to, start []byte, fn func(*types.AccountDiff) error) error {
+	states.lock.RLock()
+	defer states.lock.RUnlock()
+
+	accounts := trie.NewTrie(nil, common.Hasher, *states.store)
+	return accounts.Diff(common.Compactz(from), common.Compactz(to), func(key, oldKey, newKey []byte) error {
+		if start != nil && bytes.Compare(key, start) <= 0 {
+			return nil
+		}
+		diff := &types.AccountDiff{AccountID: key}
+		var err error
+		if oldKey != nil {
+			if diff.OldState, err = states.loadStateData(oldKey); err != nil {
+				return err
+			}
+		}
+		if newKey != nil {
+			if diff.NewState, err = states.loadStateData(newKey); err != nil {
+				return err
+			}
+		}
+		oldRoot := diff.OldState.GetStorageRoot()
+		newRoot := diff.NewState.GetStorageRoot()
+		if !bytes.Equal(oldRoot, newRoot) {
+			if diff.Storage, err = states.storageDiff(oldRoot, newRoot); err != nil {
+				return err
+			}
+		}
+		return fn(diff)
+	})
+}
+
+// storageDiff returns the changed keys between two contract storage roots.
+// Keys are hashed storage keys since original keys are not kept in the trie.
+func (states *StateDB) storageDiff(from, to []byte) ([]*types.StorageDiff, error) {
+	var diffs []*types.StorageDiff
+	storage := trie.NewTrie(nil, common.Hasher, *states.store)
+	err := storage.Diff(common.Compactz(from), common.Compactz(to), func(key, oldKey, newKey []byte) error {
+		diff := &types.StorageDiff{Key: key}
+		if oldKey != nil {
+			if err := loadData(states.store, oldKey, &diff.OldValue); err != nil {
+				return err
+			}
+		}
+		if newKey != nil {
+			if err := loadData(states.store, newKey, &diff.NewValue); err != nil {
+				return err
+			}
+		}
+		diffs = append(diffs, diff)
+		return nil
+	})
+	return diffs, err
+}
@@ -13,6 +13,9 @@ import (
 
 var (
 	checkpointKey = types.ToHashID([]byte("checkpoint"))
+	// storageKeyPrefix prefixes the db keys holding the original storage keys
+	// of the hashed trie keys.
+	storageKeyPrefix = []byte("storage_key.")
 )
 
 type storageCache struct {
@@ -43,6 +46,7 @@ func (cache *storageCache) put(key types.AccountID, storage *bufferedStorage) {
 type bufferedStorage struct {
 	buffer *stateBuffer
 	trie   *trie.Trie
+	keys   map[types.HashID][]byte
 	dirty  bool
 }
 
@@ -50,6 +54,7 @@ func newBufferedStorage(root []byte, store db.DB) *bufferedStorage {
 	return &bufferedStorage{
 		buffer: newStateBuffer(),
 		trie:   trie.NewTrie(root, common.Hasher, store),
+		keys:   map[types.HashID][]byte{},
 		dirty:  false,
 	}
 }
@@ -72,6 +77,13 @@ func (storage *bufferedStorage) put(et entry) {
 	storage.buffer.put(et)
 }
 
+// setKey remembers the original key of a hashed storage key, so that it can
+// be recovered when iterating the storage trie. It is stored at the stage only
+// if the key still has a value in the buffer.
+func (storage *bufferedStorage) setKey(id types.HashID, key []byte) {
+	storage.keys[id] = key
+}
+
 func (storage *bufferedStorage) checkpoint(revision int) {
 	storage.buffer.put(newMetaEntry(checkpointKey, revision))
 }
@@ -125,8 +137,19 @@ func (storage *bufferedStorage) stage(txn trie.DbTx) error {
 	if err := storage.buffer.stage(txn); err != nil {
 		return err
 	}
+	for id, key := range storage.keys {
+		// the key of a value rolled back or deleted is not stored
+		if et := storage.buffer.get(id); et != nil && et.Value() != nil {
+			txn.Set(storageKeyOf(id[:]), key)
+		}
+	}
+	storage.keys = map[types.HashID][]byte{}
 	if err := storage.buffer.reset(); err != nil {
 		return err
 	}
 	return nil
 }
+
+func storageKeyOf(id []byte) []byte {
+	return append(append([]byte{}, storageKeyPrefix...), id...)
+}
@@ -0,0 +1,106 @@
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// storageUsageKey is the key under which a contract keeps the bytes used by
+// its storage. The key itself is not counted.
+var storageUsageKey = []byte("_storage_usage")
+
+// storageEntrySize returns the bytes counted for a key and its value. A key
+// whose original is not recorded counts as its hash.
+func storageEntrySize(key, value []byte) uint64 {
+	if len(key) == 0 {
+		return uint64(types.HashIDLength + len(value))
+	}
+	return uint64(len(key) + len(value))
+}
+
+// StorageUsage returns the bytes of the keys and the values in the storage of
+// the contract. The usage is kept up to date by SetDataCounted and
+// DeleteDataCounted. For a contract whose storage is not counted yet, it is
+// computed from the committed storage.
+func (st *ContractState) StorageUsage() (uint64, error) {
+	data, err := st.GetData(storageUsageKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) == 8 {
+		return binary.LittleEndian.Uint64(data), nil
+	}
+	var usage uint64
+	err = st.IterateData(nil, func(trieKey, key, value []byte) error {
+		if !bytes.Equal(key, storageUsageKey) {
+			usage += storageEntrySize(key, value)
+		}
+		return nil
+	})
+	return usage, err
+}
+
+func (st *ContractState) setStorageUsage(usage uint64) error {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, usage)
+	return st.SetData(storageUsageKey, data)
+}
+
+// SetDataCounted stores the key and value pair as SetData and counts the
+// change of the bytes in the storage usage. If the usage grows beyond the
+// quota, nothing is stored and ErrStorageQuotaExceeded is returned. A quota
+// of 0 is no limit.
+func (st *ContractState) SetDataCounted(key, value []byte, quota uint64) error {
+	usage, err := st.StorageUsage()
+	if err != nil {
+		return err
+	}
+	old, err := st.GetData(key)
+	if err != nil {
+		return err
+	}
+	var oldSize uint64
+	if old != nil {
+		oldSize = storageEntrySize(key, old)
+	}
+	newSize := storageEntrySize(key, value)
+	usage = subUsage(usage, oldSize) + newSize
+	if quota != 0 && newSize > oldSize && usage > quota {
+		return types.ErrStorageQuotaExceeded
+	}
+	if err := st.SetData(key, value); err != nil {
+		return err
+	}
+	return st.setStorageUsage(usage)
+}
+
+// DeleteDataCounted removes the key and value pair as DeleteData and takes
+// its bytes off the storage usage.
+func (st *ContractState) DeleteDataCounted(key []byte) error {
+	usage, err := st.StorageUsage()
+	if err != nil {
+		return err
+	}
+	old, err := st.GetData(key)
+	if err != nil {
+		return err
+	}
+	if err := st.DeleteData(key); err != nil {
+		return err
+	}
+	if old == nil {
+		return st.setStorageUsage(usage)
+	}
+	return st.setStorageUsage(subUsage(usage, storageEntrySize(key, old)))
+}
+
+// subUsage subtracts size from usage. The size may exceed the usage computed
+// from the storage where the original of the key was not recorded.
+func subUsage(usage, size uint64) uint64 {
+	if size > usage {
+		return 0
+	}
+	return usage - size
+}
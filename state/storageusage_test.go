@@ -0,0 +1,72 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractStateStorageUsage(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	testAddress := []byte("test_address")
+
+	contractState, err := stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+
+	// the data stored before the counting
+	assert.NoError(t, contractState.SetData([]byte("key1"), []byte("value1")))
+	assert.NoError(t, contractState.SetData([]byte("key2"), []byte("value22")))
+	assert.NoError(t, stateDB.StageContractState(contractState))
+	assert.NoError(t, stateDB.Update())
+	assert.NoError(t, stateDB.Commit())
+
+	contractState, err = stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+	usage, err := contractState.StorageUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10+11), usage, "computed from the committed storage")
+
+	// a new key
+	assert.NoError(t, contractState.SetDataCounted([]byte("key3"), []byte("v3"), 0))
+	usage, _ = contractState.StorageUsage()
+	assert.Equal(t, uint64(21+6), usage)
+
+	// an updated value
+	assert.NoError(t, contractState.SetDataCounted([]byte("key1"), []byte("v1"), 0))
+	usage, _ = contractState.StorageUsage()
+	assert.Equal(t, uint64(27-4), usage)
+
+	// a deleted key, and a key never stored
+	assert.NoError(t, contractState.DeleteDataCounted([]byte("key2")))
+	assert.NoError(t, contractState.DeleteDataCounted([]byte("nokey")))
+	usage, _ = contractState.StorageUsage()
+	assert.Equal(t, uint64(23-11), usage)
+
+	// the quota limits the growth only
+	assert.Equal(t, types.ErrStorageQuotaExceeded, contractState.SetDataCounted([]byte("key4"), []byte("value4"), 20))
+	value, _ := contractState.GetData([]byte("key4"))
+	assert.Nil(t, value, "nothing stored over the quota")
+	assert.NoError(t, contractState.SetDataCounted([]byte("key4"), []byte("v4"), 20))
+	assert.NoError(t, contractState.SetDataCounted([]byte("key3"), []byte("3"), 10), "shrinking over the quota")
+	usage, _ = contractState.StorageUsage()
+	assert.Equal(t, uint64(12+6-1), usage)
+
+	// the usage is rolled back with the data
+	snapshot := contractState.Snapshot()
+	assert.NoError(t, contractState.SetDataCounted([]byte("key5"), []byte("value5"), 0))
+	assert.NoError(t, contractState.Rollback(snapshot))
+	usage, _ = contractState.StorageUsage()
+	assert.Equal(t, uint64(17), usage)
+
+	// the usage is committed
+	assert.NoError(t, stateDB.StageContractState(contractState))
+	assert.NoError(t, stateDB.Update())
+	assert.NoError(t, stateDB.Commit())
+	contractState, err = stateDB.OpenContractStateAccount(types.ToAccountID(testAddress))
+	assert.NoError(t, err, "could not open contract state")
+	usage, err = contractState.StorageUsage()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(17), usage)
+}
@@ -0,0 +1,162 @@
+package syncer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	DfltMinBlockFetchSize = 10
+	DfltMaxBlockFetchSize = 500
+
+	// batchTargetLatency is the time a block request is expected to take. The
+	// batch grows while the requests take less than half of it and shrinks
+	// while they take more.
+	batchTargetLatency = time.Second * 2
+	// batchTargetBytes is the size of the blocks a request is expected to
+	// fetch, which makes the batch of small blocks larger.
+	batchTargetBytes = float64(4 * 1024 * 1024)
+	// batchMaxErrRate is the rate of the failed requests above which the
+	// batch doesn't grow.
+	batchMaxErrRate = 0.1
+	// batchWeight is the weight of the latest request in the averages.
+	batchWeight = 0.2
+)
+
+// batchSizer adapts the number of blocks fetched by a request to the latency
+// and the failures of the requests and the size of the blocks, between min
+// and max.
+type batchSizer struct {
+	mutex sync.Mutex
+
+	min  int
+	max  int
+	size int
+
+	latency    time.Duration
+	blockBytes float64
+	errRate    float64
+
+	started  time.Time
+	requests uint64
+	failures uint64
+	blocks   uint64
+	bytes    uint64
+}
+
+func newBatchSizer(min, max, initial int) *batchSizer {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		min = max
+		if min < 1 {
+			min, max = 1, 1
+		}
+	}
+	bs := &batchSizer{min: min, max: max, started: time.Now()}
+	bs.size = bs.clamp(initial)
+	return bs
+}
+
+func (bs *batchSizer) clamp(size int) int {
+	if size < bs.min {
+		return bs.min
+	}
+	if size > bs.max {
+		return bs.max
+	}
+	return size
+}
+
+func average(avg, sample float64) float64 {
+	return avg*(1-batchWeight) + sample*batchWeight
+}
+
+// next returns the number of blocks of the next request.
+func (bs *batchSizer) next() int {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	return bs.size
+}
+
+// succeeded records the blocks fetched by a request in elapsed.
+func (bs *batchSizer) succeeded(blocks []*types.Block, elapsed time.Duration) {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	var bytes int
+	for _, block := range blocks {
+		bytes += block.Size()
+	}
+
+	if bs.requests == bs.failures {
+		bs.latency = elapsed
+		bs.blockBytes = float64(bytes) / float64(len(blocks))
+	} else {
+		bs.latency = time.Duration(average(float64(bs.latency), float64(elapsed)))
+		bs.blockBytes = average(bs.blockBytes, float64(bytes)/float64(len(blocks)))
+	}
+	bs.errRate = average(bs.errRate, 0)
+	bs.requests++
+	bs.blocks += uint64(len(blocks))
+	bs.bytes += uint64(bytes)
+
+	size := bs.size
+	switch {
+	case bs.latency > batchTargetLatency:
+		size /= 2
+	case bs.latency < batchTargetLatency/2 && bs.errRate < batchMaxErrRate:
+		size += size/2 + 1
+	}
+	if bs.blockBytes > 0 {
+		if limit := int(batchTargetBytes / bs.blockBytes); size > limit {
+			size = limit
+		}
+	}
+	bs.resize(size)
+}
+
+// failed records a request timed out or failed.
+func (bs *batchSizer) failed() {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	bs.errRate = average(bs.errRate, 1)
+	bs.requests++
+	bs.failures++
+	bs.resize(bs.size / 2)
+}
+
+func (bs *batchSizer) resize(size int) {
+	size = bs.clamp(size)
+	if size != bs.size {
+		logger.Debug().Int("from", bs.size).Int("to", size).Dur("latency", bs.latency).
+			Float64("blockBytes", bs.blockBytes).Float64("errRate", bs.errRate).Msg("block fetch size adjusted")
+		bs.size = size
+	}
+}
+
+// statistics returns the current batch size and the effective throughput of
+// the fetches.
+func (bs *batchSizer) statistics() map[string]interface{} {
+	bs.mutex.Lock()
+	defer bs.mutex.Unlock()
+
+	var blocksPerSec, bytesPerSec float64
+	if elapsed := time.Since(bs.started).Seconds(); elapsed > 0 {
+		blocksPerSec = float64(bs.blocks) / elapsed
+		bytesPerSec = float64(bs.bytes) / elapsed
+	}
+	return map[string]interface{}{
+		"fetch_size":           bs.size,
+		"fetch_latency":        bs.latency.String(),
+		"fetch_requests":       bs.requests,
+		"fetch_failures":       bs.failures,
+		"fetch_blocks_per_sec": blocksPerSec,
+		"fetch_bytes_per_sec":  bytesPerSec,
+	}
+}
@@ -0,0 +1,59 @@
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSizer(t *testing.T) {
+	makeBlocks := func(count int, txs int) []*types.Block {
+		blocks := make([]*types.Block, count)
+		for i := range blocks {
+			body := make([]*types.Tx, txs)
+			for j := range body {
+				body[j] = &types.Tx{Body: &types.TxBody{Payload: make([]byte, 1024)}}
+			}
+			blocks[i] = types.NewBlock(nil, nil, nil, body, nil, 0)
+		}
+		return blocks
+	}
+
+	bs := newBatchSizer(10, 500, 100)
+	assert.Equal(t, 100, bs.next())
+
+	// fast responses of small blocks grow the batch up to max
+	for i := 0; i < 20; i++ {
+		bs.succeeded(makeBlocks(bs.next(), 0), time.Millisecond*100)
+	}
+	assert.Equal(t, 500, bs.next())
+
+	// failures shrink it down to min
+	for i := 0; i < 10; i++ {
+		bs.failed()
+	}
+	assert.Equal(t, 10, bs.next())
+
+	// it doesn't grow while the error rate is high
+	bs.succeeded(makeBlocks(bs.next(), 0), time.Millisecond*100)
+	assert.Equal(t, 10, bs.next())
+
+	// large blocks limit the batch to the target bytes
+	bs = newBatchSizer(1, 500, 100)
+	bs.succeeded(makeBlocks(10, 1024), time.Millisecond*100)
+	assert.True(t, bs.next() <= 4)
+
+	// slow responses shrink it
+	bs = newBatchSizer(10, 500, 100)
+	bs.succeeded(makeBlocks(100, 0), batchTargetLatency*2)
+	assert.Equal(t, 50, bs.next())
+
+	stats := bs.statistics()
+	assert.Equal(t, 50, stats["fetch_size"])
+	assert.Equal(t, uint64(1), stats["fetch_requests"])
+
+	// the bounds are kept consistent
+	assert.Equal(t, 2, newBatchSizer(10, 2, 100).next())
+}
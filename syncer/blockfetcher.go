@@ -39,7 +39,7 @@ type BlockFetcher struct {
 
 	name string
 
-	maxFetchSize   int
+	sizer          *batchSizer
 	maxFetchTasks  int
 	maxPendingConn int
 
@@ -135,7 +135,7 @@ func newBlockFetcher(ctx *types.SyncContext, compRequester component.IComponentR
 	bf.responseCh = make(chan interface{}, cfg.maxBlockReqTasks*2) //for safety. In normal situdation, it should use only one
 
 	bf.peers = newPeerSet()
-	bf.maxFetchSize = cfg.maxBlockReqSize
+	bf.sizer = newBatchSizer(cfg.minBlockReqSize, cfg.maxBlockReqSize, DfltBlockFetchSize)
 	bf.maxFetchTasks = cfg.maxBlockReqTasks
 	bf.maxPendingConn = cfg.maxPendingConn
 
@@ -366,6 +366,7 @@ func (bf *BlockFetcher) processFailedTask(task *FetchTask, isErr bool) error {
 	logBadPeer(failPeer, bf.peers, bf.cfg)
 
 	bf.peers.processPeerFail(failPeer, isErr)
+	bf.sizer.failed()
 
 	task.retry++
 	task.syncPeer = nil
@@ -427,11 +428,12 @@ func (bf *BlockFetcher) searchCandidateTask() (*FetchTask, error) {
 	addNewFetchTasks := func(hashSet *HashSet) {
 		start, end := 0, 0
 		count := hashSet.Count
+		fetchSize := bf.sizer.next()
 
 		logger.Debug().Uint64("startno", hashSet.StartNo).Str("start", enc.ToString(hashSet.Hashes[0])).Int("count", hashSet.Count).Msg("add new fetchtasks from HashSet")
 
 		for start < count {
-			end = start + bf.maxFetchSize
+			end = start + fetchSize
 			if end > count {
 				end = count
 			}
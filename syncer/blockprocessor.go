@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"sort"
+	"time"
 
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/message"
@@ -13,6 +14,10 @@ import (
 	"github.com/libp2p/go-libp2p-peer"
 )
 
+// PreVerifyBlockCount is the number of the blocks to be connected next, which
+// the chain verifies while connecting the current block.
+var PreVerifyBlockCount = 4
+
 type BlockProcessor struct {
 	compRequester component.IComponentRequester //for communicate with other service
 
@@ -143,6 +148,7 @@ func (bproc *BlockProcessor) GetBlockChunkRsp(msg *message.GetBlockChunksRsp) er
 	}
 
 	bf.pushFreePeer(task.syncPeer)
+	bf.sizer.succeeded(msg.Blocks, time.Since(task.started))
 
 	bf.stat.setMaxChunkRsp(msg.Blocks[len(msg.Blocks)-1])
 
@@ -270,6 +276,33 @@ func (bproc *BlockProcessor) connectBlock(block *types.Block) {
 		Msg("request connecting block to chainsvc")
 
 	bproc.compRequester.RequestTo(message.ChainSvc, &message.AddBlock{PeerID: "", Block: block, Bstate: nil, IsSync: true})
+
+	if next := bproc.nextBlocksToConnect(PreVerifyBlockCount); len(next) > 0 {
+		bproc.compRequester.RequestTo(message.ChainSvc, &message.PreVerifyBlocks{Blocks: next})
+	}
+}
+
+// nextBlocksToConnect returns at most n blocks to be connected after the
+// current block, so that the chain verifies them while connecting it.
+func (bproc *BlockProcessor) nextBlocksToConnect(n int) []*types.Block {
+	var blocks []*types.Block
+
+	if req := bproc.curConnRequest; req != nil && req.cur+1 < len(req.Blocks) {
+		blocks = append(blocks, req.Blocks[req.cur+1:]...)
+	}
+	// the tasks in the queue are verified only if they follow without a gap
+	nextNo := bproc.curBlock.BlockNo() + uint64(len(blocks)) + 1
+	for _, req := range bproc.connQueue {
+		if len(blocks) >= n || req.firstNo != nextNo {
+			break
+		}
+		blocks = append(blocks, req.Blocks...)
+		nextNo += uint64(len(req.Blocks))
+	}
+	if len(blocks) > n {
+		blocks = blocks[:n]
+	}
+	return blocks
 }
 
 func (bproc *BlockProcessor) pushToConnQueue(newReq *ConnectTask) {
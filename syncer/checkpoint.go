@@ -0,0 +1,102 @@
+package syncer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	// checkpointFile is the file of the data directory the progress of the
+	// running sync is kept in across restarts.
+	checkpointFile = "synccheckpoint.json"
+	// checkpointInterval is the number of blocks added between the saves of
+	// the progress.
+	checkpointInterval = 100
+)
+
+// syncCheckpoint is the progress of a sync. The blocks from the ancestor up
+// to the added one are connected to the main chain, so a sync interrupted by
+// a restart resumes from the best block without finding the ancestor again
+// if the main chain still has the added block.
+type syncCheckpoint struct {
+	TargetNo     uint64 `json:"targetNo"`
+	TargetHash   []byte `json:"targetHash,omitempty"`
+	AncestorNo   uint64 `json:"ancestorNo"`
+	AncestorHash []byte `json:"ancestorHash"`
+	AddedNo      uint64 `json:"addedNo"`
+	AddedHash    []byte `json:"addedHash"`
+}
+
+func newSyncCheckpoint(ctx *types.SyncContext) *syncCheckpoint {
+	ancestor := ctx.CommonAncestor
+	return &syncCheckpoint{
+		TargetNo:     ctx.TargetNo,
+		AncestorNo:   ancestor.BlockNo(),
+		AncestorHash: ancestor.BlockHash(),
+		AddedNo:      ancestor.BlockNo(),
+		AddedHash:    ancestor.BlockHash(),
+	}
+}
+
+// loadCheckpoint returns the checkpoint saved at path, or nil if none.
+func loadCheckpoint(path string) (*syncCheckpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cp := &syncCheckpoint{}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (cp *syncCheckpoint) save(path string) error {
+	data, err := json.MarshalIndent(cp, "", " ")
+	if err != nil {
+		return err
+	}
+	// the file is replaced at once not to leave a partial one
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func removeCheckpoint(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// update records the blocks added and fetched so far.
+func (cp *syncCheckpoint) update(added *types.Block, fetched *types.Block) {
+	if added != nil {
+		cp.AddedNo, cp.AddedHash = added.BlockNo(), added.BlockHash()
+	}
+	if fetched != nil && fetched.BlockNo() == cp.TargetNo {
+		cp.TargetHash = fetched.BlockHash()
+	}
+}
+
+// resumable reports whether a sync to targetNo can start from the best block
+// of the chain: the best block is in the range of the checkpoint and the main
+// chain has the added block.
+func (cp *syncCheckpoint) resumable(chain types.ChainAccessor, best *types.Block, targetNo uint64) bool {
+	bestNo := best.BlockNo()
+	if bestNo < cp.AddedNo || bestNo >= cp.TargetNo || targetNo < cp.TargetNo {
+		return false
+	}
+	hash, err := chain.GetHashByNo(cp.AddedNo)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(hash, cp.AddedHash)
+}
@@ -0,0 +1,54 @@
+package syncer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "synccheckpoint")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, checkpointFile)
+
+	cp, err := loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Nil(t, cp)
+
+	remoteChain := chain.InitStubBlockChain(nil, 101)
+	localChain := chain.InitStubBlockChain(remoteChain.Blocks[0:11], 0)
+
+	ctx := types.NewSyncCtx(1, targetPeerID, 100, 10, nil)
+	ctx.SetAncestor(remoteChain.Blocks[5])
+	cp = newSyncCheckpoint(ctx)
+	cp.update(remoteChain.Blocks[10], remoteChain.Blocks[100])
+	assert.NoError(t, cp.save(path))
+
+	saved, err := loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, cp, saved)
+	assert.Equal(t, remoteChain.Blocks[100].BlockHash(), saved.TargetHash)
+
+	best, _ := localChain.GetBestBlock()
+	assert.True(t, saved.resumable(localChain, best, 100))
+	assert.True(t, saved.resumable(localChain, best, 120))
+	// the peer is behind the target of the checkpoint
+	assert.False(t, saved.resumable(localChain, best, 50))
+
+	// the added block is not in the main chain any more
+	forkChain := chain.InitStubBlockChain(remoteChain.Blocks[0:6], 5)
+	forkBest, _ := forkChain.GetBestBlock()
+	assert.False(t, saved.resumable(forkChain, forkBest, 100))
+
+	assert.NoError(t, removeCheckpoint(path))
+	assert.NoError(t, removeCheckpoint(path))
+	cp, err = loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Nil(t, cp)
+}
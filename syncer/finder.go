@@ -21,6 +21,7 @@ type Finder struct {
 	anchorCh chan chain.ChainAnchor
 	lScanCh  chan *types.BlockInfo
 	fScanCh  chan *message.GetHashByNoRsp
+	sScanCh  chan *message.GetSkeletonRsp
 
 	quitCh chan interface{}
 
@@ -55,6 +56,7 @@ func newFinder(ctx *types.SyncContext, compRequester component.IComponentRequest
 	finder.lScanCh = make(chan *types.BlockInfo)
 	finder.lScanCh = make(chan *types.BlockInfo)
 	finder.fScanCh = make(chan *message.GetHashByNoRsp)
+	finder.sScanCh = make(chan *message.GetSkeletonRsp, 1)
 
 	return finder
 }
@@ -77,7 +79,17 @@ func (finder *Finder) start() {
 		//   gather summary of my chain nodes, runTask searching ancestor to remote node
 		ancestor, err = finder.lightscan()
 
-		//2. heavy sync
+		//2. skeleton sync
+		//   compare exponentially spaced hashes, narrowing down the range each round trip
+		if ancestor == nil && err == nil && !finder.cfg.useFullScanOnly {
+			if ancestor, err = finder.skeletonscan(); err != nil && err != ErrFinderQuit {
+				// the peer may not support the skeleton request
+				logger.Info().Err(err).Msg("skeletonscan failed, fall back to fullscan")
+				ancestor, err = nil, nil
+			}
+		}
+
+		//3. heavy sync
 		//	 full binary search in my chain
 		if ancestor == nil && err == nil {
 			ancestor, err = finder.fullscan()
@@ -123,6 +135,18 @@ func (finder *Finder) GetHashByNoRsp(rsp *message.GetHashByNoRsp) {
 	finder.fScanCh <- rsp
 }
 
+func (finder *Finder) GetSkeletonRsp(rsp *message.GetSkeletonRsp) {
+	if rsp.Seq != finder.GetSeq() {
+		logger.Debug().Uint64("seq", rsp.Seq).Uint64("expected", finder.GetSeq()).Msg("finder dropped skeleton response of other sync")
+		return
+	}
+	select {
+	case finder.sScanCh <- rsp:
+	default:
+		logger.Debug().Msg("finder dropped unexpected skeleton response")
+	}
+}
+
 func (finder *Finder) lightscan() (*types.BlockInfo, error) {
 	if finder.cfg.useFullScanOnly {
 		finder.ctx.LastAnchor = finder.ctx.BestNo + 1
@@ -192,6 +216,82 @@ func (finder *Finder) getAncestor(anchors [][]byte) (*types.BlockInfo, error) {
 	}
 }
 
+// skeletonscan searches the ancestor below the last anchor. Each round trip
+// gets the hashes of the skeleton of the range from the peer and narrows the
+// range down to between the highest block of the same hash and the block of
+// the skeleton above it.
+func (finder *Finder) skeletonscan() (*types.BlockInfo, error) {
+	logger.Debug().Msg("finder skeletonscan")
+
+	if finder.ctx.LastAnchor == 0 {
+		return nil, nil
+	}
+
+	bottom, top := uint64(0), finder.ctx.LastAnchor-1
+	for {
+		nos := types.SkeletonNos(top, bottom)
+		hashes, err := finder.getSkeleton(top, bottom, len(nos))
+		if err != nil {
+			return nil, err
+		}
+
+		matched := -1
+		for i, no := range nos {
+			localHash, err := finder.chain.GetHashByNo(no)
+			if err != nil {
+				logger.Error().Uint64("no", no).Err(err).Msg("finder failed to get local hash")
+				return nil, err
+			}
+			if bytes.Equal(localHash, hashes[i]) {
+				matched = i
+				break
+			}
+		}
+
+		logger.Debug().Uint64("top", top).Uint64("bottom", bottom).Int("matched", matched).Msg("finder skeleton compared")
+
+		if matched < 0 {
+			logger.Info().Msg("failed to search ancestor in skeletonscan")
+			return nil, nil
+		}
+		if matched == 0 || nos[matched-1] == nos[matched]+1 {
+			ancestor := &types.BlockInfo{Hash: hashes[matched], No: nos[matched]}
+			logger.Info().Uint64("no", ancestor.No).Str("hash", enc.ToString(ancestor.Hash)).Msg("find ancestor in skeletonscan")
+			return ancestor, nil
+		}
+		bottom, top = nos[matched], nos[matched-1]-1
+	}
+}
+
+func (finder *Finder) getSkeleton(top, bottom types.BlockNo, count int) ([][]byte, error) {
+	// a response left over from an earlier request is not the answer to this one
+	select {
+	case <-finder.sScanCh:
+		logger.Debug().Msg("finder drained stale skeleton response")
+	default:
+	}
+	finder.compRequester.TellTo(message.P2PSvc, &message.GetSkeleton{Seq: finder.GetSeq(), ToWhom: finder.ctx.PeerID, Top: top, Bottom: bottom})
+
+	timer := time.NewTimer(finder.dfltTimeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-finder.sScanCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		if len(result.Hashes) != count {
+			return nil, ErrFinderInternal
+		}
+		return result.Hashes, nil
+	case <-timer.C:
+		logger.Error().Float64("sec", finder.dfltTimeout.Seconds()).Msg("finder get skeleton timeout")
+		return nil, ErrFinderTimeout
+	case <-finder.quitCh:
+		return nil, ErrFinderQuit
+	}
+}
+
 //TODO binary search scan
 func (finder *Finder) fullscan() (*types.BlockInfo, error) {
 	logger.Debug().Msg("finder fullscan")
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func testFullscanSucceed(t *testing.T, expAncestor uint64) {
@@ -42,6 +44,49 @@ func TestFinder_fullscan_found(t *testing.T) {
 	}
 }
 
+func testSkeletonscanSucceed(t *testing.T, expAncestor uint64) {
+	logger.Debug().Uint64("expAncestor", expAncestor).Msg("testskeletonscan")
+
+	remoteChainLen := 1002
+	localChainLen := 1000
+	targetNo := uint64(1000)
+
+	remoteChain := chain.InitStubBlockChain(nil, remoteChainLen)
+	localChain := chain.InitStubBlockChain(remoteChain.Blocks[0:expAncestor+1], localChainLen-int(expAncestor+1))
+
+	remoteChains := []*chain.StubBlockChain{remoteChain}
+	peers := makeStubPeerSet(remoteChains)
+
+	//set debug property
+	testCfg := *SyncerCfg
+	testCfg.debugContext = &SyncerDebug{t: t, debugFinder: true, expAncestor: int(expAncestor)}
+
+	syncer := NewTestSyncer(t, localChain, remoteChain, peers, &testCfg)
+
+	syncer.start()
+
+	syncReq := &message.SyncStart{PeerID: targetPeerID, TargetNo: targetNo}
+	syncer.stubRequester.TellTo(message.SyncerSvc, syncReq)
+
+	syncer.waitStop()
+}
+
+func TestFinder_skeletonscan_found(t *testing.T) {
+	for _, no := range []uint64{0, 1, 37, 255, 256, 500, 700} {
+		testSkeletonscanSucceed(t, no)
+	}
+}
+
+func TestFinder_skeletonRspOfOtherSync(t *testing.T) {
+	finder := newFinder(&types.SyncContext{Seq: 2}, nil, nil, SyncerCfg)
+
+	finder.GetSkeletonRsp(&message.GetSkeletonRsp{Seq: 1})
+	assert.Len(t, finder.sScanCh, 0, "response of the old sync is dropped")
+
+	finder.GetSkeletonRsp(&message.GetSkeletonRsp{Seq: 2})
+	assert.Len(t, finder.sScanCh, 1)
+}
+
 func TestFinder_fullscan_notfound(t *testing.T) {
 	remoteChainLen := 1002
 	localChainLen := 1000
@@ -101,6 +101,8 @@ func isOtherActorRequest(msg interface{}) bool {
 		return true
 	case *message.GetHashByNo:
 		return true
+	case *message.GetSkeleton:
+		return true
 	case *message.GetHashes:
 		return true
 	case *message.GetPeers:
@@ -109,6 +111,8 @@ func isOtherActorRequest(msg interface{}) bool {
 		return true
 	case *message.AddBlock:
 		return true
+	case *message.PreVerifyBlocks:
+		return true
 	}
 
 	return false
@@ -184,6 +188,8 @@ func (stubSyncer *StubSyncer) handleActorMsg(inmsg interface{}) {
 		stubSyncer.GetSyncAncestor(msg)
 	case *message.GetHashByNo:
 		stubSyncer.GetHashByNo(msg)
+	case *message.GetSkeleton:
+		stubSyncer.GetSkeleton(msg)
 
 	case *message.GetHashes:
 		stubSyncer.GetHashes(msg, nil)
@@ -197,6 +203,8 @@ func (stubSyncer *StubSyncer) handleActorMsg(inmsg interface{}) {
 	case *message.AddBlock:
 		stubSyncer.AddBlock(msg, nil)
 
+	case *message.PreVerifyBlocks: // the stub chain doesn't verify blocks ahead
+
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 
 	default:
@@ -255,6 +263,17 @@ func (syncer *StubSyncer) GetHashByNo(msg *message.GetHashByNo) {
 	rsp := &message.GetHashByNoRsp{Seq: msg.Seq, BlockHash: hash, Err: err}
 	syncer.stubRequester.TellTo(message.SyncerSvc, rsp)
 }
+func (syncer *StubSyncer) GetSkeleton(msg *message.GetSkeleton) {
+	//targetPeer = 0
+	nos := types.SkeletonNos(msg.Top, msg.Bottom)
+	hashes := make([][]byte, len(nos))
+	for i, no := range nos {
+		hashes[i], _ = syncer.stubPeers[0].blockChain.GetHashByNo(no)
+	}
+	rsp := &message.GetSkeletonRsp{Seq: msg.Seq, Hashes: hashes}
+	syncer.stubRequester.TellTo(message.SyncerSvc, rsp)
+}
+
 func (syncer *StubSyncer) GetHashes(msg *message.GetHashes, responseErr error) {
 	blkHashes, _ := syncer.remoteChain.GetHashes(msg.PrevInfo, msg.Count)
 
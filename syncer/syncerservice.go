@@ -5,11 +5,12 @@ import (
 	"github.com/aergoio/aergo/p2p/p2putil"
 	"runtime/debug"
 
-	"github.com/aergoio/aergo-lib/log"
 	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/internal/logctl"
 	"github.com/aergoio/aergo/pkg/component"
 
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -35,11 +36,16 @@ type Syncer struct {
 	hashFetcher  *HashFetcher
 	blockFetcher *BlockFetcher
 
+	checkpointPath string
+	checkpoint     *syncCheckpoint
+	resumed        bool
+
 	compRequester component.IComponentRequester //for test
 }
 
 type SyncerConfig struct {
 	maxHashReqSize   uint64
+	minBlockReqSize  int
 	maxBlockReqSize  int
 	maxPendingConn   int
 	maxBlockReqTasks int
@@ -66,14 +72,15 @@ type SyncerDebug struct {
 }
 
 var (
-	logger             = log.NewLogger("syncer")
+	logger             = logctl.NewLogger("syncer")
 	NameFinder         = "Finder"
 	NameHashFetcher    = "HashFetcher"
 	NameBlockFetcher   = "BlockFetcher"
 	NameBlockProcessor = "BlockProcessor"
 	SyncerCfg          = &SyncerConfig{
 		maxHashReqSize:   DfltHashReqSize,
-		maxBlockReqSize:  DfltBlockFetchSize,
+		minBlockReqSize:  DfltMinBlockFetchSize,
+		maxBlockReqSize:  DfltMaxBlockFetchSize,
 		maxPendingConn:   MaxBlockPendingTasks,
 		maxBlockReqTasks: DfltBlockFetchTasks,
 		fetchTimeOut:     DfltFetchTimeOut,
@@ -97,6 +104,12 @@ func (ec *ErrSyncMsg) Error() string {
 func NewSyncer(cfg *cfg.Config, chain types.ChainAccessor, syncerCfg *SyncerConfig) *Syncer {
 	if syncerCfg == nil {
 		syncerCfg = SyncerCfg
+		if cfg != nil && cfg.Blockchain != nil {
+			custom := *SyncerCfg
+			custom.minBlockReqSize = cfg.Blockchain.SyncMinFetchSize
+			custom.maxBlockReqSize = cfg.Blockchain.SyncMaxFetchSize
+			syncerCfg = &custom
+		}
 	}
 
 	syncer := &Syncer{cfg: cfg, syncerCfg: syncerCfg}
@@ -105,6 +118,9 @@ func NewSyncer(cfg *cfg.Config, chain types.ChainAccessor, syncerCfg *SyncerConf
 	syncer.compRequester = syncer.BaseComponent
 	syncer.chain = chain
 	syncer.Seq = 1
+	if cfg != nil && cfg.DataDir != "" {
+		syncer.checkpointPath = filepath.Join(cfg.DataDir, checkpointFile)
+	}
 
 	logger.Info().Uint64("seq", syncer.Seq).Msg("Syncer started")
 
@@ -131,6 +147,8 @@ func (syncer *Syncer) Reset(err error) {
 	if syncer.isRunning {
 		logger.Info().Uint64("targetNo", syncer.ctx.TargetNo).Msg("syncer stop#1")
 
+		syncer.closeCheckpoint(err)
+
 		syncer.finder.stop()
 		syncer.hashFetcher.stop()
 		syncer.blockFetcher.stop()
@@ -193,6 +211,7 @@ func (syncer *Syncer) Receive(context actor.Context) {
 			*message.FinderResult,
 			*message.GetHashesRsp,
 			*message.GetHashByNoRsp,
+			*message.GetSkeletonRsp,
 			*message.GetBlockChunks,
 			*message.GetBlockChunksRsp,
 			*message.AddBlockRsp,
@@ -229,6 +248,9 @@ func (syncer *Syncer) verifySeq(inmsg interface{}) bool {
 	case *message.GetHashByNoRsp:
 		seq = msg.Seq
 		match = isMatch(seq)
+	case *message.GetSkeletonRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
 	case *message.GetBlockChunksRsp:
 		seq = msg.Seq
 		match = isMatch(seq)
@@ -266,6 +288,8 @@ func (syncer *Syncer) handleMessage(inmsg interface{}) {
 		syncer.handleAncestorRsp(msg)
 	case *message.GetHashByNoRsp:
 		syncer.handleGetHashByNoRsp(msg)
+	case *message.GetSkeletonRsp:
+		syncer.handleGetSkeletonRsp(msg)
 	case *message.FinderResult:
 		err := syncer.handleFinderResult(msg)
 		if err != nil {
@@ -286,6 +310,8 @@ func (syncer *Syncer) handleMessage(inmsg interface{}) {
 		if err != nil {
 			syncer.Reset(err)
 			logger.Error().Err(err).Msg("AddBlockRsp failed")
+		} else {
+			syncer.saveCheckpoint(false)
 		}
 	case *message.SyncStop:
 		if msg.Err == nil {
@@ -348,6 +374,17 @@ func (syncer *Syncer) handleSyncStart(msg *message.SyncStart) error {
 	syncer.ctx = types.NewSyncCtx(syncer.GetSeq(), msg.PeerID, msg.TargetNo, bestBlockNo, msg.NotifyC)
 	syncer.isRunning = true
 
+	if cp := syncer.resumableCheckpoint(bestBlock, msg.TargetNo); cp != nil {
+		logger.Info().Uint64("ancestorNo", cp.AncestorNo).Uint64("addedNo", cp.AddedNo).Uint64("bestNo", bestBlockNo).
+			Msg("syncer resumes from checkpoint")
+
+		syncer.ctx.SetAncestor(bestBlock)
+		syncer.checkpoint = cp
+		syncer.resumed = true
+		syncer.startFetchers()
+		return nil
+	}
+
 	syncer.finder = newFinder(syncer.ctx, syncer.getCompRequester(), syncer.chain, syncer.syncerCfg)
 	syncer.finder.start()
 
@@ -384,6 +421,17 @@ func (syncer *Syncer) handleGetHashByNoRsp(msg *message.GetHashByNoRsp) {
 	syncer.finder.GetHashByNoRsp(msg)
 }
 
+func (syncer *Syncer) handleGetSkeletonRsp(msg *message.GetSkeletonRsp) {
+	logger.Debug().Int("count", len(msg.Hashes)).Msg("syncer received getskeleton response")
+
+	if syncer.finder == nil {
+		logger.Debug().Msg("finder already stopped. so drop unexpected GetSkeletonRsp message")
+		return
+	}
+
+	syncer.finder.GetSkeletonRsp(msg)
+}
+
 func (syncer *Syncer) handleFinderResult(msg *message.FinderResult) error {
 	logger.Debug().Msg("syncer received finder result message")
 
@@ -412,15 +460,98 @@ func (syncer *Syncer) handleFinderResult(msg *message.FinderResult) error {
 		return nil
 	}
 
+	if syncer.checkpointPath != "" {
+		syncer.checkpoint = newSyncCheckpoint(syncer.ctx)
+		syncer.resumed = false
+	}
+	syncer.startFetchers()
+
+	return nil
+}
+
+func (syncer *Syncer) startFetchers() {
 	syncer.blockFetcher = newBlockFetcher(syncer.ctx, syncer.getCompRequester(), syncer.syncerCfg)
 	syncer.hashFetcher = newHashFetcher(syncer.ctx, syncer.getCompRequester(), syncer.blockFetcher.hfCh, syncer.syncerCfg)
 
+	syncer.saveCheckpoint(true)
+
 	syncer.blockFetcher.Start()
 	syncer.hashFetcher.Start()
+}
+
+// resumableCheckpoint returns the saved checkpoint if the sync to targetNo can
+// resume from the best block. The checkpoint which can't be resumed is
+// removed.
+func (syncer *Syncer) resumableCheckpoint(bestBlock *types.Block, targetNo uint64) *syncCheckpoint {
+	if syncer.checkpointPath == "" {
+		return nil
+	}
 
+	cp, err := loadCheckpoint(syncer.checkpointPath)
+	if err != nil {
+		logger.Warn().Err(err).Msg("invalid sync checkpoint")
+	} else if cp == nil {
+		return nil
+	} else if cp.resumable(syncer.chain, bestBlock, targetNo) {
+		// the blocks after the added one are also added by the sync
+		cp.AddedNo, cp.AddedHash = bestBlock.BlockNo(), bestBlock.BlockHash()
+		if cp.TargetNo != targetNo {
+			cp.TargetNo, cp.TargetHash = targetNo, nil
+		}
+		return cp
+	}
+
+	logger.Info().Msg("sync checkpoint is dropped")
+	if err := removeCheckpoint(syncer.checkpointPath); err != nil {
+		logger.Warn().Err(err).Msg("failed to remove sync checkpoint")
+	}
 	return nil
 }
 
+// saveCheckpoint saves the progress of the sync every checkpointInterval
+// blocks added, or at once if force.
+func (syncer *Syncer) saveCheckpoint(force bool) {
+	cp := syncer.checkpoint
+	if cp == nil || syncer.blockFetcher == nil {
+		return
+	}
+
+	added := syncer.blockFetcher.stat.getLastAddBlock()
+	if !force && (added == nil || added.BlockNo() < cp.AddedNo+checkpointInterval) {
+		return
+	}
+
+	cp.update(added, syncer.blockFetcher.stat.getMaxChunkRsp())
+	if err := cp.save(syncer.checkpointPath); err != nil {
+		logger.Warn().Err(err).Msg("failed to save sync checkpoint")
+	}
+}
+
+// closeCheckpoint removes the checkpoint of the sync finished or resumed in
+// vain, since the peer may not have the best block, and saves it otherwise.
+func (syncer *Syncer) closeCheckpoint(err error) {
+	cp := syncer.checkpoint
+	if cp == nil {
+		return
+	}
+
+	var added *types.Block
+	if syncer.blockFetcher != nil {
+		added = syncer.blockFetcher.stat.getLastAddBlock()
+	}
+
+	if (added != nil && added.BlockNo() >= cp.TargetNo) || (err != nil && syncer.resumed && added == nil) {
+		if err := removeCheckpoint(syncer.checkpointPath); err != nil {
+			logger.Warn().Err(err).Msg("failed to remove sync checkpoint")
+		}
+	} else {
+		syncer.saveCheckpoint(true)
+	}
+
+	syncer.checkpoint = nil
+	syncer.resumed = false
+}
+
 func (syncer *Syncer) Statistics() *map[string]interface{} {
 	var start, end, total, added, blockfetched uint64
 
@@ -441,15 +572,17 @@ func (syncer *Syncer) Statistics() *map[string]interface{} {
 		}
 	}
 
+	var fetchStats map[string]interface{}
 	if syncer.blockFetcher != nil {
 		lastblock := syncer.blockFetcher.stat.getLastAddBlock()
 		added = lastblock.BlockNo()
 		if syncer.blockFetcher.stat.getMaxChunkRsp() != nil {
 			blockfetched = syncer.blockFetcher.stat.getMaxChunkRsp().BlockNo()
 		}
+		fetchStats = syncer.blockFetcher.sizer.statistics()
 	}
 
-	return &map[string]interface{}{
+	stats := map[string]interface{}{
 		"running":       syncer.isRunning,
 		"total":         total,
 		"start":         start,
@@ -457,6 +590,10 @@ func (syncer *Syncer) Statistics() *map[string]interface{} {
 		"block_added":   added,
 		"block_fetched": blockfetched,
 	}
+	for k, v := range fetchStats {
+		stats[k] = v
+	}
+	return &stats
 }
 
 func (syncer *Syncer) RecoverSyncerSelf() {
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// maxSafeInteger is the largest integer kept exact by the numbers of the lua
+// contracts.
+const maxSafeInteger = 1<<53 - 1
+
+const (
+	abiDefaultFunction = "default"
+	abiVarArgs         = "..."
+	bignumKey          = "_bignum"
+)
+
+// Function returns the function of the ABI called by the name. A name not in
+// the ABI calls the default function of the contract if it has one, like the
+// contract VM does.
+func (abi *ABI) Function(name string) (*Function, error) {
+	var defaultFunc *Function
+	for _, f := range abi.GetFunctions() {
+		if f.Name == name {
+			return f, nil
+		}
+		if f.Name == abiDefaultFunction {
+			defaultFunc = f
+		}
+	}
+	if defaultFunc != nil {
+		return defaultFunc, nil
+	}
+	return nil, fmt.Errorf("function %s not found in the ABI", name)
+}
+
+// EncodeCall returns the payload of a call to the function of the name with
+// the arguments given as a JSON array, checked against the ABI: the function
+// must be payable for a positive amount, the number of the arguments must not
+// exceed the parameters of the function, and the numbers must be kept exact
+// by the contract, the bigger ones being given as {"_bignum": "<decimal>"}.
+func (abi *ABI) EncodeCall(name string, jsonArgs string, amount *big.Int) ([]byte, error) {
+	fn, err := abi.Function(name)
+	if err != nil {
+		return nil, err
+	}
+	if amount != nil && amount.Sign() > 0 && !fn.Payable {
+		return nil, fmt.Errorf("function %s is not payable", fn.Name)
+	}
+	args, err := decodeCallArgs(jsonArgs)
+	if err != nil {
+		return nil, err
+	}
+	// the default function takes the arguments of any name
+	if fn.Name == name && !fn.hasVarArgs() && len(args) > len(fn.Arguments) {
+		return nil, fmt.Errorf("too many arguments to %s: %d > %d", name, len(args), len(fn.Arguments))
+	}
+	return json.Marshal(&CallInfo{Name: name, Args: args})
+}
+
+func (fn *Function) hasVarArgs() bool {
+	n := len(fn.Arguments)
+	return n > 0 && fn.Arguments[n-1].Name == abiVarArgs
+}
+
+// EncodeGovernanceCall returns the payload of a call to the system contract of
+// the name, like aergo.system or aergo.name, validated as a governance tx.
+func EncodeGovernanceCall(contract string, name string, jsonArgs string, amount *big.Int) ([]byte, error) {
+	args, err := decodeCallArgs(jsonArgs)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(&CallInfo{Name: name, Args: args})
+	if err != nil {
+		return nil, err
+	}
+	body := &TxBody{Recipient: []byte(contract), Payload: payload, Type: TxType_GOVERNANCE}
+	if amount != nil {
+		body.Amount = amount.Bytes()
+	}
+	switch contract {
+	case AergoSystem:
+		err = ValidateSystemTx(body)
+	case AergoName:
+		err = validateNameTx(body)
+	case AergoBridge:
+		_, err = ParseBridgeTx(body)
+	default:
+		err = ErrTxInvalidRecipient
+	}
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// decodeCallArgs decodes the arguments of a call given as a JSON array. The
+// numbers keep their text to be encoded as given.
+func decodeCallArgs(jsonArgs string) ([]interface{}, error) {
+	if strings.TrimSpace(jsonArgs) == "" {
+		return nil, nil
+	}
+	d := json.NewDecoder(bytes.NewReader([]byte(jsonArgs)))
+	d.UseNumber()
+	var args []interface{}
+	if err := d.Decode(&args); err != nil {
+		return nil, fmt.Errorf("arguments are not a JSON array: %s", err.Error())
+	}
+	if d.More() {
+		return nil, fmt.Errorf("arguments are not a JSON array: trailing data")
+	}
+	for i, arg := range args {
+		if err := checkCallArg(arg); err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %s", i+1, err.Error())
+		}
+	}
+	return args, nil
+}
+
+func checkCallArg(arg interface{}) error {
+	switch v := arg.(type) {
+	case json.Number:
+		if i, ok := new(big.Int).SetString(v.String(), 10); ok && i.IsInt64() &&
+			i.Int64() <= maxSafeInteger && i.Int64() >= -maxSafeInteger {
+			return nil
+		} else if ok {
+			return fmt.Errorf("%s is too big for a number, give it as {\"%s\": \"%s\"}", v, bignumKey, v)
+		}
+		if _, err := v.Float64(); err != nil {
+			return fmt.Errorf("%s is out of the range of a number", v)
+		}
+	case map[string]interface{}:
+		if value, ok := v[bignumKey]; ok {
+			s, isString := value.(string)
+			if _, valid := new(big.Int).SetString(s, 10); len(v) != 1 || !isString || !valid {
+				return fmt.Errorf("%s should be a decimal integer string", bignumKey)
+			}
+			return nil
+		}
+		for _, elem := range v {
+			if err := checkCallArg(elem); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if err := checkCallArg(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestABIEncodeCall(t *testing.T) {
+	abi := &ABI{Functions: []*Function{
+		{Name: "set", Arguments: []*FnArgument{{Name: "key"}, {Name: "value"}}},
+		{Name: "deposit", Payable: true},
+		{Name: "log", Arguments: []*FnArgument{{Name: "level"}, {Name: "..."}}},
+	}}
+
+	payload, err := abi.EncodeCall("set", `["a", 1]`, nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Name": "set", "Args": ["a", 1]}`, string(payload))
+
+	payload, err = abi.EncodeCall("set", `["a", {"_bignum": "123456789012345678901234567890"}]`, nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Name": "set", "Args": ["a", {"_bignum": "123456789012345678901234567890"}]}`, string(payload))
+
+	tests := []struct {
+		name     string
+		fn       string
+		args     string
+		amount   int64
+		expected bool
+	}{
+		{"fewer args", "set", `["a"]`, 0, true},
+		{"too many args", "set", `["a", 1, 2]`, 0, false},
+		{"varargs", "log", `[1, 2, 3, 4]`, 0, true},
+		{"payable", "deposit", ``, 10, true},
+		{"not payable", "set", `[]`, 10, false},
+		{"unknown function", "get", `[]`, 0, false},
+		{"not an array", "set", `{"key": "a"}`, 0, false},
+		{"trailing data", "set", `[] []`, 0, false},
+		{"exact number", "set", `["a", 9007199254740991]`, 0, true},
+		{"big number", "set", `["a", 9007199254740992]`, 0, false},
+		{"nested big number", "set", `["a", {"list": [1, 18446744073709551616]}]`, 0, false},
+		{"invalid bignum", "set", `["a", {"_bignum": "0x10"}]`, 0, false},
+		{"bignum number", "set", `["a", {"_bignum": 10}]`, 0, false},
+		{"float", "set", `["a", 1.5]`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := abi.EncodeCall(tt.fn, tt.args, big.NewInt(tt.amount))
+			assert.Equal(t, tt.expected, err == nil, "%v", err)
+		})
+	}
+
+	// a name not in the ABI calls the default function with any arguments
+	abi.Functions = append(abi.Functions, &Function{Name: "default"})
+	payload, err = abi.EncodeCall("get", `["a", 1, 2]`, nil)
+	assert.NoError(t, err)
+	var ci CallInfo
+	assert.NoError(t, json.Unmarshal(payload, &ci))
+	assert.Equal(t, "get", ci.Name)
+}
+
+func TestEncodeGovernanceCall(t *testing.T) {
+	payload, err := EncodeGovernanceCall(AergoSystem, Stake, "", big.NewInt(1))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"Name": "`+Stake+`", "Args": null}`, string(payload))
+
+	_, err = EncodeGovernanceCall(AergoSystem, Delegate, `[1]`, nil)
+	assert.Error(t, err, "invalid candidate")
+	_, err = EncodeGovernanceCall("aergo.unknown", Stake, "", nil)
+	assert.Equal(t, ErrTxInvalidRecipient, err)
+}
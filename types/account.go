@@ -1,9 +1,11 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/anaskhan96/base58check"
@@ -29,6 +31,15 @@ func ToAddress(addr string) []byte {
 	return ret
 }
 
+//CreateContractID return the address of the contract deployed by the account with the nonce
+func CreateContractID(account []byte, nonce uint64) []byte {
+	h := sha256.New()
+	h.Write(account)
+	h.Write([]byte(strconv.FormatUint(nonce, 10)))
+	recipientHash := h.Sum(nil)                   // byte array with length 32
+	return append([]byte{0x0C}, recipientHash...) // prepend 0x0C to make it same length as account addresses
+}
+
 //ToString return base58check encoded string of address
 func (a *Account) ToString() string {
 	return EncodeAddress(a.Address)
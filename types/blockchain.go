@@ -16,6 +16,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/internal/merkle"
 	"github.com/gogo/protobuf/proto"
@@ -56,6 +57,14 @@ var MaxAER *big.Int
 //StakingMinimum is minimum amount for staking
 var StakingMinimum *big.Int
 
+//StakingDelay is the number of blocks for which a stake or an unstake locks the
+//staking of the account
+const StakingDelay = 60 * 60 * 24
+
+//VotingDelay is the number of blocks after a stake or an unstake before the
+//account can change its vote
+const VotingDelay = 60 * 60 * 24
+
 ///NamePrice is default value of creating and updating name
 var NamePrice *big.Int
 
@@ -175,6 +184,24 @@ func (bi *BlockInfo) Equal(target *BlockInfo) bool {
 	}
 }
 
+// SkeletonNos returns the numbers of the blocks of the skeleton from top down
+// to bottom, which are spaced exponentially: top, top-1, top-2, top-4, ... and
+// bottom. The ancestor with a peer is found by comparing the hashes of the
+// skeleton in O(log n) round trips.
+func SkeletonNos(top, bottom BlockNo) []BlockNo {
+	if top < bottom {
+		return nil
+	}
+	nos := []BlockNo{top}
+	for offset := BlockNo(1); offset < top-bottom; offset *= 2 {
+		nos = append(nos, top-offset)
+	}
+	if top > bottom {
+		nos = append(nos, bottom)
+	}
+	return nos
+}
+
 // BlockNo is the height of a block, which starts from 0 (genesis block).
 type BlockNo = uint64
 
@@ -357,9 +384,15 @@ func (block *Block) BlockNo() BlockNo {
 
 // Sign adds a pubkey and a block signature to block.
 func (block *Block) Sign(privKey crypto.PrivKey) error {
+	return block.SignWith(privKey.GetPublic(), privKey.Sign)
+}
+
+// SignWith signs block by sign, which returns the signature of the header
+// bytes by the private key of pubKey.
+func (block *Block) SignWith(pubKey crypto.PubKey, sign func(msg []byte) ([]byte, error)) error {
 	var err error
 
-	if err = block.setPubKey(privKey.GetPublic()); err != nil {
+	if err = block.setPubKey(pubKey); err != nil {
 		return err
 	}
 
@@ -369,7 +402,7 @@ func (block *Block) Sign(privKey crypto.PrivKey) error {
 	}
 
 	var sig []byte
-	if sig, err = privKey.Sign(msg); err != nil {
+	if sig, err = sign(msg); err != nil {
 		return err
 	}
 	block.Header.Sign = sig
@@ -509,6 +542,13 @@ func (tx *Tx) CalculateTxHash() []byte {
 	digest.Write(txBody.GasPrice)
 	binary.Write(digest, binary.LittleEndian, txBody.Type)
 	digest.Write(txBody.ChainIdHash)
+	if txBody.Expiry != 0 || txBody.NotBefore != 0 {
+		// written only when given to keep the hashes of the txs before
+		binary.Write(digest, binary.LittleEndian, txBody.Expiry)
+	}
+	if txBody.NotBefore != 0 {
+		binary.Write(digest, binary.LittleEndian, txBody.NotBefore)
+	}
 	digest.Write(txBody.Sign)
 	return digest.Sum(nil)
 }
@@ -543,6 +583,8 @@ func (tx *Tx) Clone() *Tx {
 		Type:        tx.Body.Type,
 		ChainIdHash: Clone(tx.Body.ChainIdHash).([]byte),
 		Sign:        Clone(tx.Body.Sign).([]byte),
+		Expiry:      tx.Body.Expiry,
+		NotBefore:   tx.Body.NotBefore,
 	}
 	res := &Tx{
 		Body: body,
@@ -559,6 +601,46 @@ func (b *TxBody) GetGasPriceBigInt() *big.Int {
 	return new(big.Int).SetBytes(b.GetGasPrice())
 }
 
+// ExpiryTimeThreshold divides the expiries of the txs: an expiry below it is
+// the last block number, and the others are the last unix time in seconds
+// at which the tx can be executed.
+const ExpiryTimeThreshold uint64 = 500000000
+
+// IsExpired reports whether the tx can't be executed any more in the block of
+// the number and the timestamp in nanoseconds. A tx without the expiry
+// never expires.
+func (b *TxBody) IsExpired(blockNo BlockNo, ts int64) bool {
+	expiry := b.GetExpiry()
+	switch {
+	case expiry == 0:
+		return false
+	case expiry < ExpiryTimeThreshold:
+		return blockNo > expiry
+	default:
+		return ts/int64(time.Second) > int64(expiry)
+	}
+}
+
+// IsPremature reports whether the tx can't be executed yet in the block of
+// the number since it is scheduled at a later block.
+func (b *TxBody) IsPremature(blockNo BlockNo) bool {
+	return blockNo < b.GetNotBefore()
+}
+
+// FeeKind classifies the tx by the way its fee is charged.
+func (b *TxBody) FeeKind() fee.TxKind {
+	switch {
+	case b.GetType() == TxType_GOVERNANCE:
+		return fee.TxGovernance
+	case len(b.GetRecipient()) == 0:
+		return fee.TxDeploy
+	case len(b.GetPayload()) == 0:
+		return fee.TxTransfer
+	default:
+		return fee.TxCall
+	}
+}
+
 type MovingAverage struct {
 	values []int64
 	size   int
@@ -23,18 +23,21 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type TxType int32
 
 const (
-	TxType_NORMAL     TxType = 0
-	TxType_GOVERNANCE TxType = 1
+	TxType_NORMAL        TxType = 0
+	TxType_GOVERNANCE    TxType = 1
+	TxType_FEEDELEGATION TxType = 2
 )
 
 var TxType_name = map[int32]string{
 	0: "NORMAL",
 	1: "GOVERNANCE",
+	2: "FEEDELEGATION",
 }
 
 var TxType_value = map[string]int32{
-	"NORMAL":     0,
-	"GOVERNANCE": 1,
+	"NORMAL":        0,
+	"GOVERNANCE":    1,
+	"FEEDELEGATION": 2,
 }
 
 func (x TxType) String() string {
@@ -355,6 +358,8 @@ type TxBody struct {
 	Type                 TxType   `protobuf:"varint,8,opt,name=type,proto3,enum=types.TxType" json:"type,omitempty"`
 	ChainIdHash          []byte   `protobuf:"bytes,9,opt,name=chainIdHash,proto3" json:"chainIdHash,omitempty"`
 	Sign                 []byte   `protobuf:"bytes,10,opt,name=sign,proto3" json:"sign,omitempty"`
+	Expiry               uint64   `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	NotBefore            uint64   `protobuf:"varint,12,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -455,6 +460,20 @@ func (m *TxBody) GetSign() []byte {
 	return nil
 }
 
+func (m *TxBody) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *TxBody) GetNotBefore() uint64 {
+	if m != nil {
+		return m.NotBefore
+	}
+	return 0
+}
+
 // TxIdx specifies a transaction's block hash and index within the block body
 type TxIdx struct {
 	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
@@ -872,6 +891,8 @@ type Receipt struct {
 	TxIndex              int32    `protobuf:"varint,11,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
 	From                 []byte   `protobuf:"bytes,12,opt,name=from,proto3" json:"from,omitempty"`
 	To                   []byte   `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
+	GasUsed              uint64   `protobuf:"varint,14,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	FeeDelegation        bool     `protobuf:"varint,15,opt,name=feeDelegation,proto3" json:"feeDelegation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -993,6 +1014,20 @@ func (m *Receipt) GetTo() []byte {
 	return nil
 }
 
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetFeeDelegation() bool {
+	if m != nil {
+		return m.FeeDelegation
+	}
+	return false
+}
+
 type Event struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	EventName            string   `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
@@ -1360,6 +1395,7 @@ type StateQuery struct {
 	StorageKeys          []string `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
 	Root                 []byte   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
 	Compressed           bool     `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,5,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1418,6 +1454,13 @@ func (m *StateQuery) GetCompressed() bool {
 	return false
 }
 
+func (m *StateQuery) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
 type FilterInfo struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	EventName            string   `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
@@ -1533,89 +1576,94 @@ func init() {
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_e9ac6287ce250c9a) }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x23, 0x45,
-	0x13, 0x7e, 0xc7, 0xf6, 0x38, 0x76, 0xe5, 0xcb, 0xdb, 0xef, 0x0a, 0x06, 0x58, 0xad, 0xcc, 0x28,
-	0xa0, 0x68, 0x05, 0x59, 0x69, 0x11, 0x02, 0xc4, 0x29, 0xd9, 0xdd, 0x80, 0xd9, 0x25, 0x1b, 0x9a,
-	0x90, 0x03, 0x17, 0xd4, 0x9e, 0x69, 0xdb, 0xc3, 0x7a, 0xa6, 0xbd, 0x33, 0x3d, 0x66, 0x7c, 0xe0,
-	0xc4, 0x95, 0x3f, 0xc0, 0x11, 0x89, 0xdf, 0xc5, 0x11, 0x71, 0x41, 0x88, 0x7f, 0x80, 0xaa, 0xba,
-	0xe7, 0xc3, 0x4e, 0x40, 0x44, 0xe2, 0xc0, 0x29, 0x5d, 0x4f, 0x57, 0xb7, 0xab, 0x9e, 0xa7, 0xaa,
-	0x7a, 0x02, 0x83, 0xf1, 0x5c, 0x05, 0xcf, 0x83, 0x99, 0x88, 0x92, 0xa3, 0x45, 0xaa, 0xb4, 0x62,
-	0xae, 0x5e, 0x2d, 0x64, 0xe6, 0xc7, 0xe0, 0x9e, 0xe0, 0x16, 0x63, 0xd0, 0x99, 0x89, 0x6c, 0xe6,
-	0x39, 0x43, 0xe7, 0x70, 0x87, 0xd3, 0x9a, 0xdd, 0x83, 0xee, 0x4c, 0x8a, 0x50, 0xa6, 0x5e, 0x6b,
-	0xe8, 0x1c, 0x6e, 0x3f, 0x60, 0x47, 0x74, 0xe8, 0x88, 0x4e, 0x7c, 0x4c, 0x3b, 0xdc, 0x7a, 0xb0,
-	0x03, 0xe8, 0x8c, 0x55, 0xb8, 0xf2, 0xda, 0xe4, 0x39, 0x68, 0x7a, 0x9e, 0xa8, 0x70, 0xc5, 0x69,
-	0xd7, 0xff, 0xad, 0x05, 0xdb, 0x8d, 0xd3, 0xcc, 0x83, 0x2d, 0x0a, 0x6a, 0xf4, 0xc8, 0xfe, 0x70,
-	0x69, 0xb2, 0x03, 0xd8, 0x5d, 0xa4, 0x72, 0x69, 0x9c, 0x31, 0xb0, 0x16, 0xed, 0xaf, 0x83, 0x78,
-	0x9e, 0x32, 0x3b, 0x53, 0xf4, 0xc3, 0x1d, 0x5e, 0x9a, 0xec, 0x0e, 0xf4, 0x75, 0x14, 0xcb, 0x4c,
-	0x8b, 0x78, 0xe1, 0x75, 0x86, 0xce, 0x61, 0x9b, 0xd7, 0x00, 0x7b, 0x13, 0xf6, 0xc8, 0x31, 0xe3,
-	0x4a, 0x69, 0xba, 0xde, 0xa5, 0xeb, 0x37, 0x50, 0x36, 0x84, 0x6d, 0x5d, 0xd4, 0x4e, 0x5d, 0x72,
-	0x6a, 0x42, 0xec, 0x1e, 0x0c, 0x52, 0x19, 0xc8, 0x68, 0xa1, 0x6b, 0xb7, 0x2d, 0x72, 0xbb, 0x82,
-	0xb3, 0x57, 0xa1, 0x17, 0xa8, 0x64, 0x12, 0xa5, 0x71, 0xe6, 0xf5, 0x28, 0xdc, 0xca, 0x66, 0x2f,
-	0x41, 0x77, 0x91, 0x8f, 0x9f, 0xc8, 0x95, 0xd7, 0xa7, 0xd3, 0xd6, 0x62, 0x87, 0xb0, 0x1f, 0xa8,
-	0x28, 0x19, 0x8b, 0x4c, 0x1e, 0x07, 0x81, 0xca, 0x13, 0xed, 0x01, 0x39, 0x6c, 0xc2, 0xa8, 0x60,
-	0x16, 0x4d, 0x13, 0x6f, 0xdb, 0x28, 0x88, 0x6b, 0xff, 0x10, 0xfa, 0x95, 0x04, 0xec, 0x35, 0x68,
-	0xeb, 0x22, 0xf3, 0x9c, 0x61, 0xfb, 0x70, 0xfb, 0x41, 0xdf, 0x2a, 0x74, 0x51, 0x70, 0x44, 0xfd,
-	0x37, 0xa0, 0x7b, 0x51, 0x3c, 0x8d, 0x32, 0xfd, 0xf7, 0x6e, 0x1f, 0x42, 0xeb, 0xa2, 0xb8, 0xb6,
-	0x58, 0x5e, 0xb7, 0x05, 0x60, 0x4a, 0x65, 0xb7, 0x3a, 0xd7, 0x50, 0xff, 0x87, 0x16, 0xfe, 0x08,
-	0xc5, 0x72, 0x1b, 0xdc, 0x44, 0x25, 0x81, 0xa4, 0x2b, 0x3a, 0xdc, 0x18, 0x28, 0xa7, 0xb0, 0x49,
-	0x1a, 0xb9, 0x4b, 0x13, 0xe5, 0x4c, 0x65, 0x10, 0x2d, 0x22, 0x99, 0x68, 0x92, 0x7a, 0x87, 0xd7,
-	0x00, 0x92, 0x27, 0x62, 0x3a, 0xd6, 0x31, 0xe4, 0x19, 0x0b, 0xef, 0x5b, 0x88, 0xd5, 0x5c, 0x89,
-	0xd0, 0xea, 0x5b, 0x9a, 0x28, 0xc5, 0x54, 0x64, 0x4f, 0xa3, 0x38, 0xd2, 0xa4, 0x6a, 0x87, 0x57,
-	0xb6, 0xdd, 0x3b, 0x4f, 0xa3, 0x40, 0x5a, 0x29, 0x2b, 0x1b, 0xb3, 0xc4, 0xc4, 0x48, 0xbe, 0xbd,
-	0x46, 0x96, 0x17, 0xab, 0x85, 0xe4, 0xb4, 0x85, 0x35, 0x63, 0x8a, 0x38, 0xa4, 0x62, 0x30, 0x72,
-	0x36, 0xa1, 0x4a, 0x29, 0x68, 0x28, 0xf5, 0x1e, 0xb8, 0x17, 0xc5, 0x28, 0x2c, 0x30, 0xd3, 0x71,
-	0x55, 0xf4, 0x86, 0xe0, 0x1a, 0x60, 0x03, 0x68, 0x47, 0x61, 0x41, 0xec, 0xb8, 0x1c, 0x97, 0xfe,
-	0x27, 0xd0, 0xbf, 0x28, 0x46, 0x89, 0xe9, 0x62, 0x1f, 0x5c, 0x8d, 0xb7, 0xd0, 0xc1, 0xed, 0x07,
-	0x3b, 0x55, 0x7c, 0xa3, 0xb0, 0xe0, 0x66, 0x8b, 0xbd, 0x02, 0x2d, 0x5d, 0x58, 0x99, 0x1a, 0xf2,
-	0xb6, 0x74, 0xe1, 0xff, 0xe8, 0x80, 0xfb, 0xb9, 0x16, 0x5a, 0xfe, 0xb5, 0x3e, 0x63, 0x31, 0x17,
-	0x88, 0x5b, 0x7d, 0xac, 0x69, 0x4a, 0x3b, 0x94, 0x14, 0xb4, 0x91, 0xa7, 0xb2, 0x91, 0x90, 0x4c,
-	0xab, 0x54, 0x4c, 0x25, 0x76, 0x82, 0x95, 0xa8, 0x09, 0x61, 0x13, 0x65, 0x2f, 0xe6, 0x5c, 0x06,
-	0x6a, 0x29, 0xd3, 0xd5, 0xb9, 0x8a, 0x12, 0x4d, 0x82, 0x75, 0xf8, 0x15, 0xdc, 0xff, 0xd5, 0x81,
-	0x1d, 0x5b, 0xf2, 0xe7, 0xa9, 0x52, 0x13, 0xcc, 0x39, 0xc3, 0x98, 0x37, 0x72, 0xa6, 0x3c, 0xb8,
-	0xd9, 0x42, 0x52, 0xa3, 0x24, 0x98, 0xe7, 0x59, 0xa4, 0x12, 0x0a, 0xbd, 0xc7, 0x6b, 0x00, 0x49,
-	0x7d, 0x2e, 0x57, 0x36, 0x6e, 0x5c, 0x62, 0x3a, 0x0b, 0xbc, 0x1c, 0xfb, 0xd1, 0xc4, 0x5b, 0xd9,
-	0xd5, 0xde, 0xa5, 0x98, 0xdb, 0xaa, 0xaa, 0x6c, 0x2c, 0xc4, 0x71, 0xa4, 0x63, 0xb1, 0xb0, 0xa3,
-	0xc2, 0x5a, 0x88, 0xcf, 0x64, 0x34, 0x9d, 0x69, 0x2a, 0xa8, 0x5d, 0x6e, 0x2d, 0x8c, 0x4b, 0xe4,
-	0x61, 0xa4, 0xcf, 0x85, 0x9e, 0x79, 0xbd, 0x61, 0x1b, 0xc5, 0xae, 0x00, 0xff, 0x67, 0x07, 0x06,
-	0x0f, 0x55, 0xa2, 0x53, 0x11, 0xe8, 0x4b, 0x91, 0x9a, 0x74, 0x6f, 0x83, 0xbb, 0x14, 0xf3, 0x5c,
-	0xda, 0xda, 0x30, 0xc6, 0x3f, 0x4f, 0xb0, 0xff, 0x5f, 0x4a, 0xf0, 0x3b, 0x07, 0xf6, 0x49, 0xa7,
-	0xcf, 0x72, 0xd4, 0x97, 0xf2, 0xfb, 0x00, 0x76, 0x03, 0x9b, 0x33, 0x01, 0x56, 0xd6, 0xff, 0x5b,
-	0x59, 0x9b, 0xd2, 0xf3, 0x75, 0x4f, 0xf6, 0x2e, 0xf4, 0x97, 0x96, 0xa6, 0xcc, 0x6b, 0xd1, 0xfc,
-	0x7a, 0xd9, 0x1e, 0xdb, 0xa4, 0x91, 0xd7, 0x9e, 0xfe, 0xef, 0x2d, 0xd8, 0xe2, 0x66, 0x56, 0x9b,
-	0x71, 0x6b, 0x5c, 0x8f, 0xc3, 0x30, 0x95, 0x59, 0x66, 0x79, 0xde, 0x84, 0x31, 0x63, 0xac, 0xad,
-	0x3c, 0x23, 0xba, 0xfb, 0xdc, 0x5a, 0xc8, 0x75, 0x2a, 0x75, 0xc9, 0x75, 0x2a, 0x69, 0x3a, 0xe9,
-	0x82, 0x3a, 0xc3, 0x4e, 0x27, 0x63, 0x61, 0x37, 0x4d, 0xa4, 0xfc, 0x22, 0x93, 0xd5, 0x74, 0xb2,
-	0x26, 0x7b, 0x0b, 0x6e, 0x05, 0x79, 0x9c, 0xcf, 0x85, 0x8e, 0x96, 0xf2, 0xd4, 0xfa, 0x18, 0xc2,
-	0xaf, 0x6e, 0x60, 0x45, 0x8c, 0xe7, 0x4a, 0xc5, 0x76, 0x58, 0x19, 0x83, 0x1d, 0x40, 0x57, 0x2e,
-	0x65, 0xa2, 0x33, 0xa2, 0xbd, 0xee, 0x8b, 0xc7, 0x08, 0x72, 0xbb, 0xd7, 0x7c, 0x40, 0xfb, 0x57,
-	0x1e, 0xd0, 0x7a, 0x0e, 0xc1, 0xe6, 0x1c, 0xf2, 0x60, 0x4b, 0x17, 0xa3, 0x24, 0x94, 0x05, 0xbd,
-	0x37, 0x2e, 0x2f, 0x4d, 0x1c, 0x6e, 0x93, 0x54, 0xc5, 0xde, 0x8e, 0x19, 0x6e, 0xb8, 0x66, 0x7b,
-	0xd0, 0xd2, 0xca, 0xdb, 0x25, 0xa4, 0xa5, 0x95, 0xff, 0x87, 0x03, 0x2e, 0xc5, 0x71, 0x03, 0xbe,
-	0xef, 0x40, 0x9f, 0x62, 0x3e, 0x13, 0xb1, 0xb4, 0x94, 0xd7, 0x00, 0xd6, 0xec, 0xd7, 0x99, 0x4a,
-	0x8e, 0xd3, 0x69, 0x66, 0xa9, 0xaf, 0x6c, 0xdc, 0x23, 0x47, 0x9c, 0x8b, 0x1d, 0x0a, 0xb6, 0xb2,
-	0x1b, 0xda, 0xb8, 0x6b, 0xda, 0xac, 0x65, 0xdf, 0xbd, 0x26, 0xfb, 0x92, 0xb5, 0xad, 0x75, 0xd6,
-	0x1a, 0xbc, 0xf4, 0xd6, 0x78, 0xf1, 0x87, 0x00, 0xa7, 0x18, 0x4f, 0x1e, 0x4b, 0xf3, 0x58, 0x27,
-	0x98, 0x88, 0x43, 0xb1, 0xd2, 0xda, 0xff, 0x16, 0x7a, 0xa7, 0x79, 0x12, 0x68, 0xec, 0xd8, 0x6b,
-	0xf6, 0xd9, 0x7d, 0xe8, 0x0b, 0x7b, 0xbe, 0x2c, 0xef, 0x5b, 0x56, 0xd4, 0xfa, 0x66, 0x5e, 0xfb,
-	0xd8, 0xe7, 0x4f, 0x8c, 0xe7, 0x92, 0x38, 0xe9, 0xf1, 0xd2, 0xc4, 0xeb, 0x97, 0x91, 0xfc, 0x86,
-	0xe8, 0xe8, 0x71, 0x5a, 0xfb, 0x8f, 0xa0, 0x47, 0xbd, 0x78, 0x29, 0xd2, 0x6b, 0x7f, 0x9e, 0xd9,
-	0xa7, 0xcf, 0x70, 0x6f, 0xde, 0xba, 0x01, 0xb4, 0xe7, 0x32, 0xa1, 0xdb, 0x5d, 0x8e, 0x4b, 0xff,
-	0x27, 0x07, 0xda, 0xc7, 0x27, 0x23, 0xfc, 0xed, 0xa5, 0x4c, 0x69, 0x1c, 0x99, 0x4b, 0x4a, 0x13,
-	0xe5, 0x98, 0x8b, 0x64, 0x9a, 0x8b, 0x69, 0x79, 0x57, 0x65, 0xb3, 0xb7, 0xa1, 0x3f, 0xb1, 0x14,
-	0xa0, 0x8e, 0x98, 0xe2, 0x7e, 0x99, 0xa2, 0xc5, 0x79, 0xed, 0xc1, 0xde, 0x87, 0x7d, 0x9a, 0xef,
-	0x5f, 0x2d, 0x45, 0x1a, 0x61, 0x62, 0x99, 0xd7, 0x59, 0x3b, 0x54, 0x26, 0xc4, 0xf7, 0x32, 0xbb,
-	0x32, 0x6e, 0xfe, 0x33, 0x70, 0x69, 0xe6, 0xdc, 0xac, 0x00, 0x5f, 0xe0, 0x91, 0x28, 0x99, 0x28,
-	0xfb, 0xfc, 0xd5, 0x80, 0xff, 0xbd, 0x03, 0x50, 0x8f, 0xb2, 0x1b, 0x5c, 0x5b, 0xbf, 0x8e, 0x4f,
-	0xe4, 0xca, 0xe8, 0xda, 0xe7, 0x4d, 0x08, 0x89, 0x4f, 0xf1, 0xe1, 0x34, 0xef, 0x13, 0xad, 0xd9,
-	0x5d, 0x80, 0x40, 0xc5, 0x0b, 0xbc, 0x41, 0x86, 0x56, 0xc6, 0x06, 0xe2, 0xff, 0xe2, 0x00, 0x9c,
-	0x46, 0x73, 0x2d, 0xd3, 0x51, 0x32, 0x51, 0xff, 0x5a, 0x9b, 0x95, 0x6d, 0x41, 0x1d, 0x6e, 0xbe,
-	0xb8, 0x6b, 0xa0, 0x6a, 0x0b, 0xad, 0x28, 0xa2, 0xb2, 0x2d, 0xb4, 0xc2, 0x14, 0x42, 0x99, 0x05,
-	0xd4, 0x64, 0x3d, 0x4e, 0x6b, 0x7a, 0x1a, 0xd2, 0xa9, 0x09, 0xb2, 0x6c, 0xb1, 0x0a, 0xc0, 0x2f,
-	0x74, 0xfc, 0x7e, 0x4e, 0x34, 0x7d, 0xd8, 0x3c, 0x4c, 0xcc, 0xc3, 0xe2, 0xf2, 0x0d, 0xf4, 0xde,
-	0x01, 0x7e, 0x52, 0xe2, 0xd7, 0x17, 0x03, 0xe8, 0x9e, 0x3d, 0xe3, 0x9f, 0x1e, 0x3f, 0x1d, 0xfc,
-	0x8f, 0xed, 0x01, 0x7c, 0xf4, 0xec, 0xf2, 0x31, 0x3f, 0x3b, 0x3e, 0x7b, 0xf8, 0x78, 0xe0, 0x9c,
-	0x0c, 0xbf, 0xbc, 0x3b, 0x8d, 0xf4, 0x2c, 0x1f, 0x1f, 0x05, 0x2a, 0xbe, 0x2f, 0x64, 0x3a, 0x55,
-	0x91, 0x32, 0x7f, 0xef, 0x53, 0xa5, 0x8c, 0xbb, 0xf4, 0x6f, 0xd1, 0x3b, 0x7f, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x1c, 0x39, 0xe4, 0x2a, 0x0d, 0x00, 0x00,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0x23, 0xc5,
+	0x16, 0xbe, 0x6d, 0xbb, 0x1d, 0xfb, 0xe4, 0xcf, 0x53, 0x77, 0x74, 0x6f, 0xdf, 0xcb, 0x68, 0x64,
+	0x5a, 0x03, 0x8a, 0x46, 0x90, 0x91, 0x06, 0xa1, 0x01, 0xb1, 0x4a, 0x26, 0xc9, 0x60, 0x26, 0x24,
+	0xa1, 0x30, 0x59, 0xb0, 0x41, 0xe5, 0xee, 0xb2, 0xdd, 0x8c, 0xbb, 0xcb, 0xd3, 0x5d, 0x36, 0xed,
+	0x05, 0x2b, 0x9e, 0x04, 0x89, 0x15, 0x1b, 0x1e, 0x85, 0x37, 0x60, 0x89, 0xd8, 0xb0, 0xe0, 0x0d,
+	0xd0, 0x39, 0x55, 0xfd, 0x63, 0x27, 0x20, 0x46, 0x62, 0xc1, 0xca, 0xf5, 0x7d, 0x75, 0xea, 0xe7,
+	0x9c, 0xef, 0x9c, 0x53, 0x6d, 0xe8, 0x8d, 0x66, 0x2a, 0x78, 0x11, 0x4c, 0x45, 0x94, 0x1c, 0xce,
+	0x53, 0xa5, 0x15, 0x73, 0xf5, 0x6a, 0x2e, 0x33, 0x3f, 0x06, 0xf7, 0x18, 0xa7, 0x18, 0x83, 0xd6,
+	0x54, 0x64, 0x53, 0xcf, 0xe9, 0x3b, 0x07, 0x3b, 0x9c, 0xc6, 0xec, 0x21, 0xb4, 0xa7, 0x52, 0x84,
+	0x32, 0xf5, 0x1a, 0x7d, 0xe7, 0x60, 0xfb, 0x31, 0x3b, 0xa4, 0x45, 0x87, 0xb4, 0xe2, 0x43, 0x9a,
+	0xe1, 0xd6, 0x82, 0x3d, 0x80, 0xd6, 0x48, 0x85, 0x2b, 0xaf, 0x49, 0x96, 0xbd, 0xba, 0xe5, 0xb1,
+	0x0a, 0x57, 0x9c, 0x66, 0xfd, 0x5f, 0x1b, 0xb0, 0x5d, 0x5b, 0xcd, 0x3c, 0xd8, 0xa2, 0x4b, 0x0d,
+	0x4e, 0xec, 0xc1, 0x05, 0x64, 0x0f, 0x60, 0x77, 0x9e, 0xca, 0xa5, 0x31, 0xc6, 0x8b, 0x35, 0x68,
+	0x7e, 0x9d, 0xc4, 0xf5, 0xe4, 0xd9, 0x85, 0xa2, 0x83, 0x5b, 0xbc, 0x80, 0xec, 0x1e, 0x74, 0x75,
+	0x14, 0xcb, 0x4c, 0x8b, 0x78, 0xee, 0xb5, 0xfa, 0xce, 0x41, 0x93, 0x57, 0x04, 0x7b, 0x13, 0xf6,
+	0xc8, 0x30, 0xe3, 0x4a, 0x69, 0xda, 0xde, 0xa5, 0xed, 0x37, 0x58, 0xd6, 0x87, 0x6d, 0x9d, 0x57,
+	0x46, 0x6d, 0x32, 0xaa, 0x53, 0xec, 0x21, 0xf4, 0x52, 0x19, 0xc8, 0x68, 0xae, 0x2b, 0xb3, 0x2d,
+	0x32, 0xbb, 0xc1, 0xb3, 0xff, 0x43, 0x27, 0x50, 0xc9, 0x38, 0x4a, 0xe3, 0xcc, 0xeb, 0xd0, 0x75,
+	0x4b, 0xcc, 0xfe, 0x03, 0xed, 0xf9, 0x62, 0xf4, 0x5c, 0xae, 0xbc, 0x2e, 0xad, 0xb6, 0x88, 0x1d,
+	0xc0, 0x7e, 0xa0, 0xa2, 0x64, 0x24, 0x32, 0x79, 0x14, 0x04, 0x6a, 0x91, 0x68, 0x0f, 0xc8, 0x60,
+	0x93, 0x46, 0x05, 0xb3, 0x68, 0x92, 0x78, 0xdb, 0x46, 0x41, 0x1c, 0xfb, 0x07, 0xd0, 0x2d, 0x25,
+	0x60, 0xaf, 0x41, 0x53, 0xe7, 0x99, 0xe7, 0xf4, 0x9b, 0x07, 0xdb, 0x8f, 0xbb, 0x56, 0xa1, 0x61,
+	0xce, 0x91, 0xf5, 0xdf, 0x80, 0xf6, 0x30, 0x3f, 0x8f, 0x32, 0xfd, 0xe7, 0x66, 0x1f, 0x40, 0x63,
+	0x98, 0xdf, 0x9a, 0x2c, 0xaf, 0xdb, 0x04, 0x30, 0xa9, 0xb2, 0x5b, 0xae, 0xab, 0xa9, 0xff, 0x63,
+	0x03, 0xda, 0x86, 0x60, 0x77, 0xc1, 0x4d, 0x54, 0x12, 0x48, 0xda, 0xa2, 0xc5, 0x0d, 0x40, 0x39,
+	0x85, 0x75, 0xd2, 0xc8, 0x5d, 0x40, 0x94, 0x33, 0x95, 0x41, 0x34, 0x8f, 0x64, 0xa2, 0x49, 0xea,
+	0x1d, 0x5e, 0x11, 0x18, 0x3c, 0x11, 0xd3, 0xb2, 0x96, 0x09, 0x9e, 0x41, 0xb8, 0xdf, 0x5c, 0xac,
+	0x66, 0x4a, 0x84, 0x56, 0xdf, 0x02, 0xa2, 0x14, 0x13, 0x91, 0x9d, 0x47, 0x71, 0xa4, 0x49, 0xd5,
+	0x16, 0x2f, 0xb1, 0x9d, 0xbb, 0x4a, 0xa3, 0x40, 0x5a, 0x29, 0x4b, 0x8c, 0x5e, 0xa2, 0x63, 0x24,
+	0xdf, 0x5e, 0xcd, 0xcb, 0xe1, 0x6a, 0x2e, 0x39, 0x4d, 0x61, 0xce, 0x98, 0x24, 0x0e, 0x29, 0x19,
+	0x8c, 0x9c, 0x75, 0xaa, 0x54, 0x0a, 0x2a, 0xa5, 0xd0, 0x05, 0x99, 0xcf, 0xa3, 0x74, 0x45, 0xfa,
+	0xb5, 0xb8, 0x45, 0xe8, 0x78, 0xa2, 0xf4, 0xb1, 0x1c, 0xab, 0x54, 0x7a, 0x3b, 0x34, 0x55, 0x11,
+	0xfe, 0x13, 0x70, 0x87, 0xf9, 0x20, 0xcc, 0xd1, 0x6c, 0x54, 0x96, 0x8a, 0x91, 0xa5, 0x22, 0x58,
+	0x0f, 0x9a, 0x51, 0x98, 0x53, 0x4c, 0x5d, 0x8e, 0x43, 0xff, 0x23, 0xe8, 0x0e, 0xf3, 0x41, 0x62,
+	0x6a, 0xdf, 0x07, 0x57, 0xe3, 0x2e, 0xb4, 0x70, 0xfb, 0xf1, 0x4e, 0xe9, 0xd5, 0x20, 0xcc, 0xb9,
+	0x99, 0x62, 0xff, 0x83, 0x86, 0xce, 0xad, 0xb8, 0xb5, 0xa4, 0x68, 0xe8, 0xdc, 0xff, 0xd6, 0x01,
+	0xf7, 0x53, 0x2d, 0xb4, 0xfc, 0x63, 0x55, 0x47, 0x62, 0x26, 0x90, 0xb7, 0xaa, 0x5a, 0x68, 0x0a,
+	0x22, 0x94, 0x74, 0x69, 0x23, 0x6a, 0x89, 0x31, 0x8c, 0x99, 0x56, 0xa9, 0x98, 0x48, 0xac, 0x1f,
+	0x2b, 0x6c, 0x9d, 0xc2, 0xd2, 0xcb, 0x5e, 0xce, 0xb8, 0x0c, 0xd4, 0x52, 0xa6, 0xab, 0x2b, 0x15,
+	0x25, 0x9a, 0x64, 0x6e, 0xf1, 0x1b, 0xbc, 0xff, 0x8b, 0x03, 0x3b, 0xb6, 0x50, 0xae, 0x52, 0xa5,
+	0xc6, 0xe8, 0x73, 0x86, 0x77, 0xde, 0xf0, 0x99, 0xfc, 0xe0, 0x66, 0x0a, 0x83, 0x1a, 0x25, 0xc1,
+	0x6c, 0x91, 0x45, 0x2a, 0xa1, 0xab, 0x77, 0x78, 0x45, 0x60, 0x50, 0x5f, 0xc8, 0x95, 0xbd, 0x37,
+	0x0e, 0xd1, 0x9d, 0x39, 0x6e, 0x8e, 0x55, 0x6c, 0xee, 0x5b, 0xe2, 0x72, 0xee, 0x5a, 0xcc, 0x6c,
+	0x2e, 0x96, 0x18, 0xb5, 0x1f, 0x45, 0x3a, 0x16, 0x73, 0xdb, 0x60, 0x2c, 0x42, 0x7e, 0x2a, 0xa3,
+	0xc9, 0x54, 0x53, 0x1a, 0xee, 0x72, 0x8b, 0xf0, 0x5e, 0x62, 0x11, 0x46, 0xfa, 0x4a, 0xe8, 0xa9,
+	0xd7, 0xe9, 0x37, 0x51, 0xec, 0x92, 0xf0, 0x7f, 0x72, 0xa0, 0xf7, 0x54, 0x25, 0x3a, 0x15, 0x81,
+	0xbe, 0x16, 0xa9, 0x71, 0xf7, 0x2e, 0xb8, 0x4b, 0x31, 0x5b, 0x48, 0x9b, 0x1b, 0x06, 0xfc, 0x75,
+	0x07, 0xbb, 0xff, 0x24, 0x07, 0xbf, 0x71, 0x60, 0x9f, 0x74, 0xfa, 0x64, 0x81, 0xfa, 0x92, 0x7f,
+	0xef, 0xc3, 0x6e, 0x60, 0x7d, 0x26, 0xc2, 0xca, 0xfa, 0x6f, 0x2b, 0x6b, 0x5d, 0x7a, 0xbe, 0x6e,
+	0xc9, 0xde, 0x85, 0xee, 0xd2, 0x86, 0x29, 0xf3, 0x1a, 0xd4, 0xf5, 0xfe, 0x6b, 0x97, 0x6d, 0x86,
+	0x91, 0x57, 0x96, 0xfe, 0x0f, 0x4d, 0xd8, 0xe2, 0xa6, 0xc3, 0x9b, 0x26, 0x6d, 0x4c, 0x8f, 0xc2,
+	0x30, 0x95, 0x59, 0x66, 0xe3, 0xbc, 0x49, 0xa3, 0xc7, 0x98, 0x5b, 0x8b, 0x8c, 0xc2, 0xdd, 0xe5,
+	0x16, 0x61, 0xac, 0x53, 0xa9, 0x8b, 0x58, 0xa7, 0x92, 0x7a, 0x9a, 0xce, 0xa9, 0x32, 0x6c, 0x4f,
+	0x33, 0x08, 0xab, 0x69, 0x2c, 0xe5, 0x67, 0x99, 0x2c, 0x7b, 0x9a, 0x85, 0xec, 0x2d, 0xb8, 0x13,
+	0x2c, 0xe2, 0xc5, 0x4c, 0xe8, 0x68, 0x29, 0xcf, 0xac, 0x8d, 0x09, 0xf8, 0xcd, 0x09, 0xcc, 0x88,
+	0xd1, 0x4c, 0xa9, 0xd8, 0xb6, 0x38, 0x03, 0xd8, 0x03, 0x68, 0xcb, 0xa5, 0x4c, 0x74, 0x46, 0x61,
+	0xaf, 0xea, 0xe2, 0x14, 0x49, 0x6e, 0xe7, 0xea, 0xcf, 0x6e, 0xf7, 0xc6, 0xb3, 0x5b, 0xf5, 0x21,
+	0xd8, 0xec, 0x43, 0x1e, 0x6c, 0xe9, 0x7c, 0x90, 0x84, 0x32, 0xa7, 0x2e, 0xe7, 0xf2, 0x02, 0x62,
+	0x4b, 0x1c, 0xa7, 0x2a, 0xa6, 0x0e, 0xb7, 0xc3, 0x69, 0xcc, 0xf6, 0xa0, 0xa1, 0x95, 0xb7, 0x4b,
+	0x4c, 0x43, 0x2b, 0x5c, 0x3d, 0x11, 0x19, 0x79, 0xb5, 0x67, 0x4e, 0xb5, 0x10, 0x3f, 0x16, 0xc6,
+	0x52, 0x9e, 0xc8, 0x99, 0x9c, 0x08, 0x8d, 0xb9, 0xbc, 0x4f, 0xb9, 0xbc, 0x4e, 0xfa, 0xbf, 0x39,
+	0xe0, 0x92, 0x1f, 0xaf, 0xa0, 0xd7, 0x3d, 0xe8, 0x92, 0xcf, 0x17, 0x22, 0x96, 0x56, 0xb2, 0x8a,
+	0xc0, 0x9c, 0xff, 0x32, 0x53, 0xc9, 0x51, 0x3a, 0xc9, 0xac, 0x74, 0x25, 0xc6, 0x39, 0x32, 0xc4,
+	0xbe, 0xda, 0x22, 0x67, 0x4b, 0x5c, 0xd3, 0xd6, 0x5d, 0xd3, 0x76, 0x2d, 0x7a, 0xed, 0x5b, 0xa2,
+	0x57, 0x44, 0x7d, 0x6b, 0x3d, 0xea, 0xb5, 0xb8, 0x76, 0xd6, 0xe2, 0xea, 0xf7, 0x01, 0xce, 0xf0,
+	0x3e, 0x8b, 0x58, 0x9a, 0x4f, 0x84, 0x04, 0x1d, 0x71, 0xe8, 0xae, 0x34, 0xf6, 0xbf, 0x86, 0xce,
+	0xd9, 0x22, 0x09, 0x30, 0x42, 0xb7, 0xcd, 0xb3, 0x47, 0xd0, 0x15, 0x76, 0x7d, 0x51, 0x1e, 0x77,
+	0x6c, 0x52, 0x54, 0x3b, 0xf3, 0xca, 0xc6, 0x3e, 0xba, 0x62, 0x34, 0x93, 0x14, 0x93, 0x0e, 0x2f,
+	0x20, 0x6e, 0xbf, 0x8c, 0xe4, 0x57, 0x14, 0x8e, 0x0e, 0xa7, 0xb1, 0x7f, 0x02, 0x1d, 0xaa, 0xe5,
+	0x6b, 0x91, 0xde, 0x7a, 0x3c, 0xb3, 0x0f, 0xae, 0x89, 0x3d, 0x8d, 0xb1, 0x58, 0x66, 0x32, 0xa1,
+	0xdd, 0x5d, 0x8e, 0x43, 0xff, 0x3b, 0x07, 0x9a, 0x47, 0xc7, 0x03, 0x3c, 0x7b, 0x29, 0x53, 0x6a,
+	0x67, 0x66, 0x93, 0x02, 0xa2, 0x1c, 0x33, 0x91, 0x4c, 0x16, 0x62, 0x52, 0xec, 0x55, 0x62, 0xf6,
+	0x36, 0x74, 0xc7, 0x36, 0x04, 0xa8, 0x23, 0xba, 0xb8, 0x5f, 0xb8, 0x68, 0x79, 0x5e, 0x59, 0xb0,
+	0xf7, 0x60, 0x9f, 0xde, 0x87, 0x2f, 0x96, 0x22, 0x8d, 0xd0, 0xb1, 0xcc, 0x6b, 0xad, 0x2d, 0x2a,
+	0x1c, 0xe2, 0x7b, 0x99, 0x1d, 0x19, 0x33, 0xff, 0x12, 0x5c, 0xea, 0x59, 0xaf, 0x96, 0x80, 0x2f,
+	0x71, 0x49, 0x94, 0x8c, 0x95, 0x7d, 0x3e, 0x2b, 0xc2, 0xff, 0xde, 0x01, 0xa8, 0x5a, 0xe1, 0x2b,
+	0x6c, 0x5b, 0xbd, 0xae, 0xcf, 0xe5, 0xca, 0xe8, 0xda, 0xe5, 0x75, 0x0a, 0x03, 0x9f, 0xe2, 0xc3,
+	0x6b, 0xde, 0x37, 0x1a, 0xb3, 0xfb, 0x00, 0x81, 0x8a, 0xe7, 0xb8, 0x83, 0x0c, 0xad, 0x8c, 0x35,
+	0x66, 0x3d, 0x7f, 0xdd, 0x8d, 0xfc, 0xf5, 0x7f, 0x76, 0x00, 0xce, 0xa2, 0x99, 0x96, 0xe9, 0x20,
+	0x19, 0xab, 0xbf, 0xad, 0x08, 0x8b, 0x43, 0xa9, 0x7f, 0x98, 0x7f, 0x01, 0x15, 0x51, 0x16, 0x8d,
+	0x56, 0x5e, 0xab, 0x56, 0x34, 0x5a, 0xa1, 0x83, 0xa1, 0xcc, 0x02, 0xba, 0x67, 0x87, 0xd3, 0x98,
+	0x1e, 0x9e, 0x74, 0x62, 0x2e, 0x59, 0x14, 0x60, 0x49, 0xe0, 0xbf, 0x06, 0xfc, 0xa6, 0x4f, 0x34,
+	0x7d, 0x36, 0x3d, 0x4d, 0xcc, 0xb3, 0xe5, 0xf2, 0x0d, 0xf6, 0xe1, 0x13, 0x68, 0x9b, 0x2f, 0x42,
+	0x06, 0xd0, 0xbe, 0xb8, 0xe4, 0x1f, 0x1f, 0x9d, 0xf7, 0xfe, 0xc5, 0xf6, 0x00, 0x9e, 0x5d, 0x5e,
+	0x9f, 0xf2, 0x8b, 0xa3, 0x8b, 0xa7, 0xa7, 0x3d, 0x87, 0xdd, 0x81, 0xdd, 0xb3, 0xd3, 0xd3, 0x93,
+	0xd3, 0xf3, 0xd3, 0x67, 0x47, 0xc3, 0xc1, 0xe5, 0x45, 0xaf, 0x71, 0xdc, 0xff, 0xfc, 0xfe, 0x24,
+	0xd2, 0xd3, 0xc5, 0xe8, 0x30, 0x50, 0xf1, 0x23, 0x21, 0xd3, 0x89, 0x8a, 0x94, 0xf9, 0x7d, 0x44,
+	0xa9, 0x35, 0x6a, 0xd3, 0xbf, 0xb7, 0x77, 0x7e, 0x1f, 0x00, 0x90, 0xbb, 0x2e, 0xb1, 0xd1, 0x0d,
+	0x00, 0x00,
 }
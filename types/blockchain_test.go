@@ -242,3 +242,11 @@ func TestBlockLimit(t *testing.T) {
 	a.True(block.Size() <= txSize*i+hdrSize, "block size violation")
 	a.True(block.Size() <= limit, "block size violation")
 }
+
+func TestSkeletonNos(t *testing.T) {
+	assert.Equal(t, []BlockNo{100, 99, 98, 96, 92, 84, 68, 36, 0}, SkeletonNos(100, 0))
+	assert.Equal(t, []BlockNo{10, 9, 8, 6, 5}, SkeletonNos(10, 5))
+	assert.Equal(t, []BlockNo{6, 5}, SkeletonNos(6, 5))
+	assert.Equal(t, []BlockNo{5}, SkeletonNos(5, 5))
+	assert.Nil(t, SkeletonNos(4, 5))
+}
@@ -0,0 +1,253 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/golang/protobuf/proto"
+)
+
+// AergoBridge is the system contract transferring the aergo between the chain
+// and its paired chain, e.g. the mainnet and an enterprise sidechain. The
+// deposits to the paired chain are locked in the contract, or burned on a
+// minting chain. The validators anchor the state roots of the paired chain,
+// and a deposit there is claimed here by the merkle proof of its total in the
+// bridge storage against an anchored root, which unlocks the locked aergo, or
+// mints it on a minting chain.
+const AergoBridge = "aergo.bridge"
+
+// The functions of the bridge
+const (
+	// BridgeAnchor votes for the state root of the paired chain at a block.
+	BridgeAnchor = "v1bridgeAnchor"
+	// BridgeDeposit deposits the amount to the receiver on the paired chain.
+	BridgeDeposit = "v1bridgeDeposit"
+	// BridgeClaim claims the deposits to the receiver on the paired chain by
+	// the proof of their total.
+	BridgeClaim = "v1bridgeClaim"
+)
+
+// The storage keys of the bridge, which are queried with their proofs by
+// QueryContractState.
+const (
+	BridgeAnchorKey     = "anchor"
+	bridgeDepositPrefix = "deposit-"
+	bridgeClaimPrefix   = "claim-"
+)
+
+var (
+	ErrBridgeDisabled     = errors.New("bridge is not enabled on the chain")
+	ErrBridgeNoAnchor     = errors.New("no anchored root of the paired chain")
+	ErrBridgeNotValidator = errors.New("sender is not a validator of the bridge")
+	ErrBridgeNoDeposit    = errors.New("no deposit to claim")
+)
+
+// BridgeConfig is the bridge of a chain in its genesis.
+type BridgeConfig struct {
+	// Validators are the accounts anchoring the roots of the paired chain.
+	Validators []string `json:"validators"`
+	// Threshold is the number of the validators voting for a root to anchor
+	// it.
+	Threshold int `json:"threshold"`
+	// Mint makes the chain mint the claimed aergo and burn the deposits,
+	// instead of unlocking and locking them. It is set on the sidechain.
+	Mint bool `json:"mint,omitempty"`
+}
+
+// Validate checks the addresses of the validators and the threshold.
+func (c *BridgeConfig) Validate() error {
+	seen := make(map[string]bool)
+	for _, v := range c.Validators {
+		if account, err := DecodeAddress(v); err != nil || len(account) != AddressLength {
+			return fmt.Errorf("invalid address of bridge validator: %s", v)
+		}
+		if seen[v] {
+			return fmt.Errorf("duplicate bridge validator: %s", v)
+		}
+		seen[v] = true
+	}
+	if c.Threshold <= 0 || c.Threshold > len(c.Validators) {
+		return fmt.Errorf("invalid bridge threshold %d of %d validators", c.Threshold, len(c.Validators))
+	}
+	return nil
+}
+
+// IsValidator reports whether the account is a validator of the bridge.
+func (c *BridgeConfig) IsValidator(account []byte) bool {
+	for _, v := range c.Validators {
+		if decoded, err := DecodeAddress(v); err == nil && string(decoded) == string(account) {
+			return true
+		}
+	}
+	return false
+}
+
+// BridgeDepositKey is the storage key of the total deposited to the receiver
+// on the paired chain.
+func BridgeDepositKey(receiver Address) string {
+	return bridgeDepositPrefix + EncodeAddress(receiver)
+}
+
+// BridgeClaimKey is the storage key of the total claimed by the receiver.
+func BridgeClaimKey(receiver Address) string {
+	return bridgeClaimPrefix + EncodeAddress(receiver)
+}
+
+// BridgeRoot is an anchored state root of the paired chain.
+type BridgeRoot struct {
+	Height BlockNo
+	Root   []byte
+}
+
+// Bytes returns the stored anchor.
+func (r *BridgeRoot) Bytes() []byte {
+	b := make([]byte, 8, 8+len(r.Root))
+	binary.LittleEndian.PutUint64(b, r.Height)
+	return append(b, r.Root...)
+}
+
+// DecodeBridgeRoot decodes the stored anchor. It returns nil if there is none.
+func DecodeBridgeRoot(b []byte) *BridgeRoot {
+	if len(b) < 8 {
+		return nil
+	}
+	return &BridgeRoot{Height: binary.LittleEndian.Uint64(b), Root: b[8:]}
+}
+
+// EncodeBridgeProof encodes the proof of the deposits as an argument of
+// BridgeClaim.
+func EncodeBridgeProof(proof *StateQueryProof) (string, error) {
+	b, err := proto.Marshal(proof)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// DecodeBridgeProof decodes the proof argument of BridgeClaim.
+func DecodeBridgeProof(encoded string) (*StateQueryProof, error) {
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	proof := &StateQueryProof{}
+	if err = proto.Unmarshal(b, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// BridgeCall is the decoded arguments of a bridge tx.
+type BridgeCall struct {
+	Name string
+	// Anchor is the root voted by BridgeAnchor.
+	Anchor *BridgeRoot
+	// Receiver is the receiver of BridgeDeposit on the paired chain, or of
+	// BridgeClaim on this chain.
+	Receiver Address
+	// Proof is the proof of the deposits claimed by BridgeClaim.
+	Proof *StateQueryProof
+}
+
+// ParseBridgeTx decodes and checks the arguments of the bridge tx.
+func ParseBridgeTx(tx *TxBody) (*BridgeCall, error) {
+	var ci CallInfo
+	if err := json.Unmarshal(tx.GetPayload(), &ci); err != nil {
+		return nil, ErrTxInvalidPayload
+	}
+	stringArg := func(i int) (string, error) {
+		if i >= len(ci.Args) {
+			return "", fmt.Errorf("invalid arguments in %s", ci)
+		}
+		s, ok := ci.Args[i].(string)
+		if !ok {
+			return "", fmt.Errorf("invalid arguments in %s", ci)
+		}
+		return s, nil
+	}
+	receiverArg := func(i int) (Address, error) {
+		s, err := stringArg(i)
+		if err != nil {
+			return nil, err
+		}
+		receiver, err := DecodeAddress(s)
+		if err != nil || len(receiver) != AddressLength {
+			return nil, fmt.Errorf("invalid receiver in %s", ci)
+		}
+		return receiver, nil
+	}
+
+	call := &BridgeCall{Name: ci.Name}
+	amount := tx.GetAmountBigInt()
+	switch ci.Name {
+	case BridgeAnchor:
+		if len(ci.Args) != 2 || amount.Sign() != 0 {
+			return nil, fmt.Errorf("invalid arguments in %s", ci)
+		}
+		h, err := stringArg(0)
+		if err != nil {
+			return nil, err
+		}
+		height, err := strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height in %s", ci)
+		}
+		r, err := stringArg(1)
+		if err != nil {
+			return nil, err
+		}
+		root, err := enc.ToBytes(r)
+		if err != nil || len(root) != HashIDLength {
+			return nil, fmt.Errorf("invalid root in %s", ci)
+		}
+		call.Anchor = &BridgeRoot{Height: height, Root: root}
+	case BridgeDeposit:
+		if len(ci.Args) != 1 {
+			return nil, fmt.Errorf("invalid arguments in %s", ci)
+		}
+		if amount.Sign() <= 0 {
+			return nil, ErrTxInvalidAmount
+		}
+		receiver, err := receiverArg(0)
+		if err != nil {
+			return nil, err
+		}
+		call.Receiver = receiver
+	case BridgeClaim:
+		if len(ci.Args) != 2 || amount.Sign() != 0 {
+			return nil, fmt.Errorf("invalid arguments in %s", ci)
+		}
+		receiver, err := receiverArg(0)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := stringArg(1)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := DecodeBridgeProof(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof in %s", ci.Name)
+		}
+		call.Receiver, call.Proof = receiver, proof
+	default:
+		return nil, ErrTxInvalidPayload
+	}
+	return call, nil
+}
+
+// BridgeAmount decodes a total of the bridge storage.
+func BridgeAmount(b []byte) *big.Int {
+	return new(big.Int).SetBytes(b)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/stretchr/testify/assert"
+)
+
+func bridgeTx(name string, amount int64, args ...interface{}) *TxBody {
+	payload, _ := json.Marshal(&CallInfo{Name: name, Args: args})
+	return &TxBody{
+		Recipient: []byte(AergoBridge),
+		Amount:    big.NewInt(amount).Bytes(),
+		Payload:   payload,
+		Type:      TxType_GOVERNANCE,
+	}
+}
+
+func TestParseBridgeTx(t *testing.T) {
+	root := make([]byte, HashIDLength)
+	root[0] = 1
+	proof, err := EncodeBridgeProof(&StateQueryProof{ContractProof: &AccountProof{Key: []byte(AergoBridge)}})
+	assert.NoError(t, err)
+
+	call, err := ParseBridgeTx(bridgeTx(BridgeAnchor, 0, "10", enc.ToString(root)))
+	if assert.NoError(t, err) {
+		assert.Equal(t, &BridgeRoot{Height: 10, Root: root}, call.Anchor)
+		assert.Equal(t, call.Anchor, DecodeBridgeRoot(call.Anchor.Bytes()))
+	}
+	call, err = ParseBridgeTx(bridgeTx(BridgeDeposit, 1, testAddress1))
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAddress1, EncodeAddress(call.Receiver))
+	}
+	call, err = ParseBridgeTx(bridgeTx(BridgeClaim, 0, testAddress1, proof))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte(AergoBridge), call.Proof.GetContractProof().GetKey())
+	}
+
+	for _, tx := range []*TxBody{
+		bridgeTx("unknown", 0),
+		bridgeTx(BridgeAnchor, 1, "10", enc.ToString(root)),
+		bridgeTx(BridgeAnchor, 0, "-1", enc.ToString(root)),
+		bridgeTx(BridgeAnchor, 0, "10", enc.ToString(root[:10])),
+		bridgeTx(BridgeDeposit, 0, testAddress1),
+		bridgeTx(BridgeDeposit, 1, "invalid"),
+		bridgeTx(BridgeDeposit, 1, 1),
+		bridgeTx(BridgeClaim, 1, testAddress1, proof),
+		bridgeTx(BridgeClaim, 0, testAddress1, "invalid"),
+		bridgeTx(BridgeClaim, 0, testAddress1),
+	} {
+		_, err := ParseBridgeTx(tx)
+		assert.Error(t, err, string(tx.Payload))
+	}
+	assert.Nil(t, DecodeBridgeRoot(nil))
+}
+
+func TestBridgeConfig(t *testing.T) {
+	config := &BridgeConfig{Validators: []string{testAddress1, testAddress2}, Threshold: 2}
+	assert.NoError(t, config.Validate())
+	account, _ := DecodeAddress(testAddress2)
+	assert.True(t, config.IsValidator(account))
+	assert.False(t, config.IsValidator([]byte(AergoBridge)))
+
+	for _, invalid := range []*BridgeConfig{
+		{Validators: []string{testAddress1}, Threshold: 2},
+		{Validators: []string{testAddress1}},
+		{Validators: []string{testAddress1, testAddress1}, Threshold: 1},
+		{Validators: []string{"invalid"}, Threshold: 1},
+	} {
+		assert.Error(t, invalid.Validate())
+	}
+
+	g := GetDefaultGenesis()
+	g.Bridge = &BridgeConfig{Validators: []string{testAddress1}}
+	assert.Error(t, g.Validate())
+}
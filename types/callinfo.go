@@ -4,3 +4,11 @@ type CallInfo struct {
 	Name string
 	Args []interface{}
 }
+
+// BatchOp is an operation of a batch system tx. Amount is the decimal amount
+// in aer used by the operation in place of the amount of the tx.
+type BatchOp struct {
+	Name   string
+	Args   []interface{}
+	Amount string `json:",omitempty"`
+}
@@ -0,0 +1,275 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/libp2p/go-libp2p-peer"
+	"gopkg.in/yaml.v2"
+)
+
+// ChainSpecFile is the name of the chain spec written in the data directory
+// by the init from a spec.
+const ChainSpecFile = "chainspec.json"
+
+const consensusRaft = "raft"
+
+var ErrChainSpecMismatch = errors.New("chain does not match the chain spec")
+
+// ChainSpec is the specification of a chain in a single file, either in JSON
+// or YAML: the genesis, the consensus and its parameters, the fee policy and
+// the activation heights of the features. The data directory of a node is
+// initialized from it, and the node verifies its chain against it at start.
+type ChainSpec struct {
+	Genesis *Genesis `json:"genesis"`
+	// GenesisHash is the hash of the genesis block created from the spec,
+	// which covers the initial balances. It is filled by the init.
+	GenesisHash string         `json:"genesis_hash,omitempty"`
+	Consensus   *ConsensusSpec `json:"consensus,omitempty"`
+	Fee         *FeeSpec       `json:"fee,omitempty"`
+	// Forks schedules the versions of the features, same as the forks of the
+	// genesis, either of which may be given.
+	Forks ForkSchedule `json:"forks,omitempty"`
+}
+
+// ConsensusSpec is the consensus of the chain and its parameters.
+type ConsensusSpec struct {
+	Type string `json:"type,omitempty"`
+	// BlockInterval is the block production interval in seconds. 0 means
+	// any.
+	BlockInterval int64 `json:"block_interval,omitempty"`
+	// RaftMembers is the initial members of the raft cluster, which are set
+	// by the config of the nodes. Empty means any.
+	RaftMembers []*RaftMember `json:"raft_members,omitempty"`
+}
+
+// RaftMember is an initial member of a raft cluster.
+type RaftMember struct {
+	Name   string `json:"name"`
+	Url    string `json:"url"`
+	PeerID string `json:"peer_id"`
+}
+
+// Validate checks that the url and the peer id of the member are well
+// formed.
+func (m *RaftMember) Validate() error {
+	if m.Name == "" {
+		return errors.New("no name of raft member")
+	}
+	if u, err := url.Parse(m.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url of raft member %s: %s", m.Name, m.Url)
+	}
+	if _, err := peer.IDB58Decode(m.PeerID); err != nil {
+		return fmt.Errorf("invalid peer id of raft member %s: %s", m.Name, m.PeerID)
+	}
+	return nil
+}
+
+func (m *RaftMember) String() string {
+	return fmt.Sprintf("%s(%s, %s)", m.Name, m.Url, m.PeerID)
+}
+
+// raftMembersEqual reports whether the clusters have the same members in any
+// order.
+func raftMembersEqual(a, b []*RaftMember) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	members := make(map[string]RaftMember, len(a))
+	for _, m := range a {
+		members[m.Name] = *m
+	}
+	for _, m := range b {
+		if other, exist := members[m.Name]; !exist || other != *m {
+			return false
+		}
+	}
+	return true
+}
+
+// FeeSpec is the fee policy of a private chain, which is set by the config of
+// the nodes.
+type FeeSpec struct {
+	ZeroFee     bool   `json:"zero_fee,omitempty"`
+	GasFee      bool   `json:"gas_fee,omitempty"`
+	DynamicFee  bool   `json:"dynamic_fee,omitempty"`
+	FreeTxCount uint64 `json:"free_tx_count,omitempty"`
+	FreeTxBytes uint64 `json:"free_tx_bytes,omitempty"`
+}
+
+// LoadChainSpec reads the chain spec from the file.
+func LoadChainSpec(path string) (*ChainSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChainSpec(data)
+}
+
+// ParseChainSpec decodes the chain spec in JSON or YAML and validates it.
+func ParseChainSpec(data []byte) (*ChainSpec, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		var err error
+		if data, err = yamlToJSON(data); err != nil {
+			return nil, err
+		}
+	}
+	spec := &ChainSpec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, err
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// yamlToJSON converts the YAML document to JSON, so that the spec is decoded
+// by the same json tags.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	doc, err := jsonValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+func jsonValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			key, ok := k.(string)
+			if !ok {
+				key = fmt.Sprint(k)
+			}
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = e
+		}
+		return m, nil
+	case []interface{}:
+		for i, e := range v {
+			e, err := jsonValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+	}
+	return v, nil
+}
+
+// Validate checks the spec, and completes the genesis by the consensus type
+// and the forks of the spec.
+func (s *ChainSpec) Validate() error {
+	g := s.Genesis
+	if g == nil {
+		return errors.New("no genesis in the chain spec")
+	}
+	if s.Consensus != nil && s.Consensus.Type != "" {
+		if g.ID.Consensus == "" {
+			g.ID.Consensus = s.Consensus.Type
+		} else if g.ID.Consensus != s.Consensus.Type {
+			return fmt.Errorf("consensus %s of the chain spec differs from %s of the genesis", s.Consensus.Type, g.ID.Consensus)
+		}
+	}
+	if s.Consensus != nil && s.Consensus.BlockInterval < 0 {
+		return fmt.Errorf("invalid block interval %d", s.Consensus.BlockInterval)
+	}
+	if s.Consensus != nil && len(s.Consensus.RaftMembers) > 0 {
+		if g.ID.Consensus != consensusRaft {
+			return fmt.Errorf("raft members are not allowed for consensus %s", g.ID.Consensus)
+		}
+		names := make(map[string]bool)
+		for _, m := range s.Consensus.RaftMembers {
+			if err := m.Validate(); err != nil {
+				return err
+			}
+			if names[m.Name] {
+				return fmt.Errorf("duplicate raft member %s", m.Name)
+			}
+			names[m.Name] = true
+		}
+	}
+	if len(s.Forks) > 0 {
+		if len(g.Forks) > 0 && !g.Forks.Equals(s.Forks) {
+			return errors.New("forks of the chain spec differ from the ones of the genesis")
+		}
+		g.Forks = s.Forks
+	}
+	if g.ID.PublicNet && s.Fee != nil && *s.Fee != (FeeSpec{}) {
+		return errors.New("fee policy is not allowed on a public chain")
+	}
+	if s.GenesisHash != "" {
+		if _, err := enc.ToBytes(s.GenesisHash); err != nil {
+			return fmt.Errorf("invalid genesis hash %s", s.GenesisHash)
+		}
+	}
+	return g.Validate()
+}
+
+// Verify checks that the genesis and the genesis block of a chain and the
+// consensus and the fee policy of a node match the spec.
+func (s *ChainSpec) Verify(g *Genesis, genesisHash []byte, consensus *ConsensusSpec, fee *FeeSpec) error {
+	mismatch := func(what string, spec, chain interface{}) error {
+		return fmt.Errorf("%s: %s %v in the spec, %v in the chain", ErrChainSpecMismatch, what, spec, chain)
+	}
+
+	if !s.Genesis.ID.Equals(&g.ID) {
+		return mismatch("chain id", s.Genesis.ID.ToJSON(), g.ID.ToJSON())
+	}
+	if s.Genesis.Timestamp != g.Timestamp {
+		return mismatch("genesis timestamp", s.Genesis.Timestamp, g.Timestamp)
+	}
+	if !s.Genesis.Forks.Equals(g.Forks) {
+		return mismatch("forks", s.Genesis.Forks, g.Forks)
+	}
+	if !bytes.Equal(s.Genesis.Bytes(), g.Bytes()) {
+		return fmt.Errorf("%s: genesis differs in the bps, the deploy allow list, the tx policy or the bridge", ErrChainSpecMismatch)
+	}
+	if s.GenesisHash != "" {
+		if hash := enc.ToString(genesisHash); s.GenesisHash != hash {
+			return mismatch("genesis hash", s.GenesisHash, hash)
+		}
+	}
+	if s.Consensus != nil && s.Consensus.BlockInterval != 0 && s.Consensus.BlockInterval != consensus.BlockInterval {
+		return mismatch("block interval", s.Consensus.BlockInterval, consensus.BlockInterval)
+	}
+	if s.Consensus != nil && len(s.Consensus.RaftMembers) > 0 && !raftMembersEqual(s.Consensus.RaftMembers, consensus.RaftMembers) {
+		return mismatch("raft members", s.Consensus.RaftMembers, consensus.RaftMembers)
+	}
+	if specFee := s.GetFee(); specFee != *fee {
+		return mismatch("fee policy", specFee, *fee)
+	}
+	return nil
+}
+
+// GetFee returns the fee policy of the spec, the default if not specified.
+func (s *ChainSpec) GetFee() FeeSpec {
+	if s.Fee == nil {
+		return FeeSpec{}
+	}
+	return *s.Fee
+}
+
+// JSON returns the spec encoded in JSON.
+func (s *ChainSpec) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpecYAML = `
+genesis:
+  chain_id:
+    magic: spec.test
+    consensus: raft
+  timestamp: 1559883600000000000
+  balance:
+    AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ: "1000000000000000000000"
+  bps: []
+consensus:
+  type: raft
+  block_interval: 2
+fee:
+  gas_fee: true
+  free_tx_count: 10
+forks:
+  - version: 1
+    height: 100
+`
+
+const testSpecJSON = `{
+  "genesis": {
+    "chain_id": {"magic": "spec.test", "consensus": "raft"},
+    "timestamp": 1559883600000000000,
+    "balance": {"AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ": "1000000000000000000000"},
+    "bps": [],
+    "forks": [{"version": 1, "height": 100}]
+  },
+  "consensus": {"block_interval": 2},
+  "fee": {"gas_fee": true, "free_tx_count": 10}
+}`
+
+func TestChainSpec(t *testing.T) {
+	fromYAML, err := ParseChainSpec([]byte(testSpecYAML))
+	assert.NoError(t, err)
+	fromJSON, err := ParseChainSpec([]byte(testSpecJSON))
+	assert.NoError(t, err)
+
+	for _, spec := range []*ChainSpec{fromYAML, fromJSON} {
+		assert.Equal(t, "raft", spec.Genesis.ID.Consensus)
+		assert.Equal(t, int64(1559883600000000000), spec.Genesis.Timestamp)
+		assert.Equal(t, "1000000000000000000000", spec.Genesis.Balance["AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ"])
+		assert.True(t, spec.Genesis.Forks.Equals(ForkSchedule{{Version: 1, Height: 100}}))
+		assert.Equal(t, int64(2), spec.Consensus.BlockInterval)
+		assert.Equal(t, FeeSpec{GasFee: true, FreeTxCount: 10}, spec.GetFee())
+	}
+
+	// the spec written by init is read back
+	spec := fromYAML
+	spec.GenesisHash = "ASeFHk7PXSQ2qKEBQvXTAtNC4ZHSrdZPVSwF6ZMDEWCv"
+	data, err := spec.JSON()
+	assert.NoError(t, err)
+	written, err := ParseChainSpec(data)
+	assert.NoError(t, err)
+	assert.Equal(t, spec.GenesisHash, written.GenesisHash)
+
+	// a genesis stored in the chain is decoded from its bytes
+	chain := GetGenesisFromBytes(written.Genesis.Bytes())
+	hash := DecodeB58(spec.GenesisHash)
+	consensus := &ConsensusSpec{Type: "raft", BlockInterval: 2}
+	fee := &FeeSpec{GasFee: true, FreeTxCount: 10}
+	assert.NoError(t, spec.Verify(chain, hash, consensus, fee))
+
+	assert.Error(t, spec.Verify(chain, []byte("other hash"), consensus, fee))
+	assert.Error(t, spec.Verify(chain, hash, &ConsensusSpec{Type: "raft", BlockInterval: 1}, fee))
+	assert.Error(t, spec.Verify(chain, hash, consensus, &FeeSpec{GasFee: true}))
+	chain.BPs = []string{"bp"}
+	assert.Error(t, spec.Verify(chain, hash, consensus, fee))
+	chain = GetGenesisFromBytes(written.Genesis.Bytes())
+	chain.Forks = ForkSchedule{{Version: 1, Height: 200}}
+	assert.Error(t, spec.Verify(chain, hash, consensus, fee))
+
+	// invalid specs
+	for _, invalid := range []string{
+		`{}`,
+		`{"genesis": {"chain_id": {"magic": "x", "consensus": "dpos"}}, "consensus": {"type": "raft"}}`,
+		`{"genesis": {"chain_id": {"magic": "x", "consensus": "raft", "public": true}}, "fee": {"zero_fee": true}}`,
+		`{"genesis": {"chain_id": {"magic": "x", "consensus": "raft"}, "forks": [{"version": 1, "height": 1}]}, "forks": [{"version": 1, "height": 2}]}`,
+		`{"genesis": {"chain_id": {"magic": "x", "consensus": "raft"}, "forks": [{"version": 99, "height": 1}]}}`,
+		"genesis: [",
+	} {
+		_, err := ParseChainSpec([]byte(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	//ErrTxNotFound is returned by MemPool Service if transaction does not exists
@@ -12,6 +15,9 @@ var (
 	//ErrTxAlreadyInMempool is returned by MemPool Service if exact same transaction is already exists
 	ErrTxAlreadyInMempool = errors.New("tx is already in mempool")
 
+	//ErrTxAlreadyInChain is returned by ChainService if a transaction of a block is already in the main chain
+	ErrTxAlreadyInChain = errors.New("tx is already in the main chain")
+
 	//ErrSameNonceInMempool is returned by MemPool Service if transaction which has same nonce is already exists
 	ErrSameNonceAlreadyInMempool = errors.New("tx with same nonce is already in mempool")
 
@@ -40,12 +46,48 @@ var (
 
 	ErrTxInvalidPrice = errors.New("tx invalid price")
 
+	ErrTxInvalidGasLimit = errors.New("tx invalid gas limit")
+
+	ErrTxGasPriceTooLow = errors.New("tx gas price is lower than the voted gas price")
+
+	ErrNotAllowedFeeDelegation = errors.New("fee delegation is not allowed")
+
+	ErrFeeDelegationBudget = errors.New("exceeded the fee delegation budget")
+
 	ErrTxInvalidPayload = errors.New("tx invalid payload")
 
 	ErrTxInvalidSize = errors.New("size of tx exceeds max length")
 
+	ErrTxPayloadTooLarge = errors.New("size of payload exceeds the maximum of the chain")
+
+	ErrTxPayloadTypeNotAllowed = errors.New("payload type is not allowed in the chain")
+
+	ErrTxExpired = errors.New("tx is expired")
+
+	ErrTxExpiryNotActive = errors.New("tx expiry is not active yet")
+
+	ErrTxPremature = errors.New("tx is scheduled at a later block")
+
+	ErrTxScheduleNotActive = errors.New("tx schedule is not active yet")
+
+	ErrTxFeeDelegationNotActive = errors.New("fee delegation is not active yet")
+
+	ErrTxInvalidSchedule = errors.New("tx is scheduled after its expiry")
+
+	ErrTxScheduleTooFar = errors.New("tx is scheduled too far ahead")
+
+	ErrTxScheduleFull = errors.New("too many scheduled txs in mempool")
+
+	ErrStorageQuotaExceeded = errors.New("exceeded the storage quota of the contract")
+
+	ErrDeployNotAllowed = errors.New("account is not allowed to deploy contracts")
+
 	ErrSignNotMatch = errors.New("signature not matched")
 
+	ErrTxNotAuthorized = errors.New("tx is not authorized by the authorization contract")
+
+	ErrAuthContractNotActive = errors.New("authorization contract is not active yet")
+
 	ErrCouldNotRecoverPubKey = errors.New("could not recover pubkey from sign")
 
 	ErrShouldUnlockAccount = errors.New("should unlock account first")
@@ -68,4 +110,74 @@ var (
 
 	//ErrTooSmallAmount
 	ErrExceedAmount = errors.New("request amount exceeds")
+
+	//ErrMustStakeBeforeDelegate
+	ErrMustStakeBeforeDelegate = errors.New("must stake before delegate")
+
+	//ErrMustDelegateBeforeUndelegate
+	ErrMustDelegateBeforeUndelegate = errors.New("must delegate before undelegate")
+
+	//ErrDelegatedToOther
+	ErrDelegatedToOther = errors.New("already delegated to another candidate")
+
+	//ErrDelegatedStaking
+	ErrDelegatedStaking = errors.New("delegated staking can not be unstaked")
+
+	//ErrInvalidEvidence
+	ErrInvalidEvidence = errors.New("invalid slashing evidence")
+
+	//ErrEvidenceAlreadyUsed
+	ErrEvidenceAlreadyUsed = errors.New("slashing evidence already used")
+
+	//ErrAlreadyAttested
+	ErrAlreadyAttested = errors.New("downtime already attested")
+
+	//ErrMustStakeBeforePropose
+	ErrMustStakeBeforePropose = errors.New("must stake before propose")
+
+	//ErrProposalExists
+	ErrProposalExists = errors.New("proposal already exists")
+
+	//ErrProposalNotFound
+	ErrProposalNotFound = errors.New("proposal not found")
+
+	//ErrProposalClosed
+	ErrProposalClosed = errors.New("voting period of the proposal is over")
+
+	//ErrNothingToClaim
+	ErrNothingToClaim = errors.New("no reward to claim")
+
+	//ErrMultisigExists
+	ErrMultisigExists = errors.New("multisig account already exists")
+
+	//ErrMultisigNotFound
+	ErrMultisigNotFound = errors.New("multisig account not found")
+
+	//ErrNotMultisigSigner
+	ErrNotMultisigSigner = errors.New("not a signer of the multisig account")
+
+	//ErrNotEnoughSignatures
+	ErrNotEnoughSignatures = errors.New("not enough signatures of the multisig account")
+
+	//ErrMustStakeBeforeRegister
+	ErrMustStakeBeforeRegister = errors.New("must stake before register BP candidate")
+
+	//ErrBPNotRegistered
+	ErrBPNotRegistered = errors.New("BP candidate not registered")
+
+	//ErrNotBPOwner
+	ErrNotBPOwner = errors.New("BP candidate registered by another account")
 )
+
+// ChainMismatchError is returned when a tx, a block or a peer of another
+// chain is rejected. Expected is the chain of the node and Actual is the one
+// of the rejected.
+type ChainMismatchError struct {
+	Subject  string
+	Expected string
+	Actual   string
+}
+
+func (e *ChainMismatchError) Error() string {
+	return fmt.Sprintf("%s of another chain: expected %s, but %s", e.Subject, e.Expected, e.Actual)
+}
@@ -0,0 +1,284 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// MaxIndexedEventArgs is the maximum number of the leading arguments of an
+// event which a contract marks as indexed.
+const MaxIndexedEventArgs = 3
+
+// The tags of the kinds of the indexed values. The values of a kind are
+// ordered among themselves only.
+const (
+	indexTagBool   = 'b'
+	indexTagBignum = 'i'
+	indexTagNumber = 'n'
+	indexTagString = 's'
+)
+
+// The bounds of a range filter of an argument
+const (
+	rangeGt  = "gt"
+	rangeGte = "gte"
+	rangeLt  = "lt"
+	rangeLte = "lte"
+)
+
+// EncodeEventIndexValue encodes an argument of an event decoded from JSON so
+// that the encoded values of the same kind are ordered bytewise as the values
+// and no encoded value is a prefix of another. It returns nil for the values
+// which are not indexed such as null, arrays and tables.
+func EncodeEventIndexValue(value interface{}) []byte {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return []byte{indexTagBool, 1}
+		}
+		return []byte{indexTagBool, 0}
+	case float64:
+		if v == 0 {
+			v = 0 // -0 equals 0
+		}
+		bits := math.Float64bits(v)
+		if v < 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 63
+		}
+		buf := make([]byte, 9)
+		buf[0] = indexTagNumber
+		binary.BigEndian.PutUint64(buf[1:], bits)
+		return buf
+	case string:
+		if len(v) > math.MaxUint16 {
+			return nil
+		}
+		buf := make([]byte, 3, 3+len(v))
+		buf[0] = indexTagString
+		binary.BigEndian.PutUint16(buf[1:], uint16(len(v)))
+		return append(buf, v...)
+	case map[string]interface{}:
+		n, ok := bignumOf(v)
+		if !ok {
+			return nil
+		}
+		mag := n.Bytes()
+		if len(mag) > math.MaxUint8 {
+			return nil
+		}
+		if n.Sign() >= 0 {
+			return append([]byte{indexTagBignum, 1, byte(len(mag))}, mag...)
+		}
+		// the larger magnitude of a negative number orders first
+		buf := []byte{indexTagBignum, 0, ^byte(len(mag))}
+		for _, b := range mag {
+			buf = append(buf, ^b)
+		}
+		return buf
+	}
+	return nil
+}
+
+// bignumOf returns the number of a table {"_bignum": "<digits>"} which a
+// contract emits for a bignum.
+func bignumOf(v map[string]interface{}) (*big.Int, bool) {
+	if len(v) != 1 {
+		return nil, false
+	}
+	s, ok := v[bignumKey].(string)
+	if !ok {
+		return nil, false
+	}
+	return new(big.Int).SetString(s, 10)
+}
+
+// numberOf returns a number or a bignum as a float for the comparison.
+func numberOf(value interface{}) (*big.Float, bool) {
+	switch v := value.(type) {
+	case float64:
+		if math.IsNaN(v) {
+			return nil, false
+		}
+		return big.NewFloat(v), true
+	case map[string]interface{}:
+		if n, ok := bignumOf(v); ok {
+			return new(big.Float).SetInt(n), true
+		}
+	}
+	return nil, false
+}
+
+// indexPrefixEnd returns the least key larger than all the keys beginning with
+// the prefix.
+func indexPrefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] != 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// rangeBound is a bound of a range filter.
+type rangeBound struct {
+	value     interface{}
+	number    *big.Float
+	inclusive bool
+}
+
+// parseRange parses a range filter of an argument, which is a table of the
+// bounds gt, gte, lt and lte of numbers or bignums.
+func (f *ArgFilter) parseRange(bounds map[string]interface{}) error {
+	for key, value := range bounds {
+		n, ok := numberOf(value)
+		if !ok {
+			return errors.New("invalid bound of argument range:" + key)
+		}
+		bound := &rangeBound{value: value, number: n}
+		switch key {
+		case rangeGt:
+		case rangeGte:
+			bound.inclusive = true
+		case rangeLt:
+		case rangeLte:
+			bound.inclusive = true
+		default:
+			return errors.New("invalid bound of argument range:" + key)
+		}
+		if key == rangeGt || key == rangeGte {
+			if f.lower != nil {
+				return errors.New("duplicated lower bound of argument range")
+			}
+			f.lower = bound
+		} else {
+			if f.upper != nil {
+				return errors.New("duplicated upper bound of argument range")
+			}
+			f.upper = bound
+		}
+	}
+	if f.lower == nil && f.upper == nil {
+		return errors.New("empty argument range")
+	}
+	return nil
+}
+
+// IsRange reports whether the filter is a range of numbers rather than a
+// value.
+func (f *ArgFilter) IsRange() bool {
+	return f.lower != nil || f.upper != nil
+}
+
+// ArgNo returns the position of the filtered argument.
+func (f *ArgFilter) ArgNo() int {
+	return f.argNo
+}
+
+// match reports whether the argument matches the filter.
+func (f *ArgFilter) match(value interface{}) bool {
+	if f.IsRange() {
+		n, ok := numberOf(value)
+		if !ok {
+			return false
+		}
+		if f.lower != nil {
+			c := n.Cmp(f.lower.number)
+			if c < 0 || (c == 0 && !f.lower.inclusive) {
+				return false
+			}
+		}
+		if f.upper != nil {
+			c := n.Cmp(f.upper.number)
+			if c > 0 || (c == 0 && !f.upper.inclusive) {
+				return false
+			}
+		}
+		return true
+	}
+	check := f.value
+	switch v := value.(type) {
+	case string:
+		c, ok := check.(string)
+		return ok && v == c
+	case float64:
+		c, ok := check.(float64)
+		return ok && v == c
+	case bool:
+		c, ok := check.(bool)
+		return ok && v == c
+	case json.Number:
+		c, ok := check.(json.Number)
+		return ok && v == c
+	case map[string]interface{}:
+		c, ok := check.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		n, ok := bignumOf(v)
+		if !ok {
+			return false
+		}
+		m, ok := bignumOf(c)
+		return ok && n.Cmp(m) == 0
+	case nil:
+		return check == nil
+	}
+	return false
+}
+
+// IndexRange returns the range [lower, upper) of the encoded values of the
+// index which holds all the values matching the filter. A range of numbers covers the float numbers only unless its
+// bounds are bignums. It returns false if the filter can not be looked up in
+// the index.
+func (f *ArgFilter) IndexRange() (lower, upper []byte, ok bool) {
+	if !f.IsRange() {
+		enc := EncodeEventIndexValue(f.value)
+		if enc == nil {
+			return nil, nil, false
+		}
+		return enc, indexPrefixEnd(enc), true
+	}
+	kind := func(b *rangeBound) byte {
+		if _, ok := b.value.(float64); ok {
+			return indexTagNumber
+		}
+		return indexTagBignum
+	}
+	var tag byte
+	if f.lower != nil {
+		tag = kind(f.lower)
+	}
+	if f.upper != nil {
+		if tag != 0 && tag != kind(f.upper) {
+			return nil, nil, false
+		}
+		tag = kind(f.upper)
+	}
+	lower = []byte{tag}
+	if f.lower != nil {
+		lower = EncodeEventIndexValue(f.lower.value)
+		if !f.lower.inclusive {
+			lower = indexPrefixEnd(lower)
+		}
+	}
+	upper = []byte{tag + 1}
+	if f.upper != nil {
+		upper = EncodeEventIndexValue(f.upper.value)
+		if f.upper.inclusive {
+			upper = indexPrefixEnd(upper)
+		}
+	}
+	return lower, upper, true
+}
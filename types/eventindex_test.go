@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventIndexValueOrder(t *testing.T) {
+	ordered := []string{
+		`false`, `true`,
+		`{"_bignum":"-300"}`, `{"_bignum":"-2"}`, `{"_bignum":"0"}`, `{"_bignum":"2"}`, `{"_bignum":"300"}`,
+		`-1e10`, `-1.5`, `0`, `0.5`, `1`, `1e10`,
+		`""`, `"a"`, `"b"`, `"ab"`,
+	}
+	var prev []byte
+	for _, s := range ordered {
+		var v interface{}
+		assert.NoError(t, json.Unmarshal([]byte(s), &v), s)
+		enc := EncodeEventIndexValue(v)
+		assert.NotNil(t, enc, s)
+		if prev != nil {
+			assert.True(t, bytes.Compare(prev, enc) < 0, "%s follows the previous value", s)
+		}
+		prev = enc
+	}
+	assert.Equal(t, EncodeEventIndexValue(0.0), EncodeEventIndexValue(-0.0*1), "-0 is 0")
+	assert.Nil(t, EncodeEventIndexValue(nil), "null")
+	assert.Nil(t, EncodeEventIndexValue([]interface{}{1.0}), "array")
+	assert.Nil(t, EncodeEventIndexValue(map[string]interface{}{"a": 1.0}), "table")
+}
+
+func TestEventArgFilterRange(t *testing.T) {
+	ev := &Event{ContractAddress: []byte("c"), EventName: "transfer",
+		JsonArgs: `["a", 15, {"_bignum":"1000000000000000000000"}]`}
+	filter := &FilterInfo{}
+	check := func(argFilter string) bool {
+		filter.ArgFilter = []byte(argFilter)
+		f, err := filter.GetExArgFilter()
+		assert.NoError(t, err, argFilter)
+		return ev.Filter(filter, f)
+	}
+	assert.True(t, check(`{"1":{"gte":15}}`), "inclusive lower bound")
+	assert.False(t, check(`{"1":{"gt":15}}`), "exclusive lower bound")
+	assert.True(t, check(`{"1":{"gt":10,"lt":20}}`), "in the range")
+	assert.False(t, check(`{"1":{"lte":14.5}}`), "above the upper bound")
+	assert.False(t, check(`{"0":{"gt":0}}`), "not a number")
+	assert.True(t, check(`{"2":{"gte":{"_bignum":"1000000000000000000000"}}}`), "bignum range")
+	assert.True(t, check(`{"2":{"gt":1e20}}`), "bignum in a float range")
+	assert.True(t, check(`{"2":{"_bignum":"1000000000000000000000"}}`), "bignum value")
+	assert.False(t, check(`{"2":{"_bignum":"1"}}`), "other bignum")
+
+	for _, invalid := range []string{`{"1":{}}`, `{"1":{"gt":"a"}}`, `{"1":{"eq":1}}`, `{"1":{"gt":1,"gte":2}}`} {
+		filter.ArgFilter = []byte(invalid)
+		_, err := filter.GetExArgFilter()
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestEventArgFilterIndexRange(t *testing.T) {
+	filter := &FilterInfo{ArgFilter: []byte(`{"0":{"gte":10,"lt":20}}`)}
+	f, err := filter.GetExArgFilter()
+	assert.NoError(t, err, "range")
+	lower, upper, ok := f[0].IndexRange()
+	assert.True(t, ok, "range of floats")
+	for v, in := range map[float64]bool{9.5: false, 10: true, 19.9: true, 20: false} {
+		enc := EncodeEventIndexValue(v)
+		assert.Equal(t, in, bytes.Compare(enc, lower) >= 0 && bytes.Compare(enc, upper) < 0, "%v", v)
+	}
+
+	filter.ArgFilter = []byte(`{"0":"a"}`)
+	f, err = filter.GetExArgFilter()
+	assert.NoError(t, err, "value")
+	lower, upper, ok = f[0].IndexRange()
+	assert.True(t, ok, "string value")
+	enc := append(EncodeEventIndexValue("a"), bytes.Repeat([]byte{0xff}, 16)...)
+	assert.True(t, bytes.Compare(enc, lower) >= 0 && bytes.Compare(enc, upper) < 0, "entry of the value")
+	enc = append(EncodeEventIndexValue("ab"), make([]byte, 16)...)
+	assert.False(t, bytes.Compare(enc, lower) >= 0 && bytes.Compare(enc, upper) < 0, "entry of another value")
+
+	filter.ArgFilter = []byte(`{"0":{"gt":1,"lt":{"_bignum":"5"}}}`)
+	f, err = filter.GetExArgFilter()
+	assert.NoError(t, err, "mixed range")
+	_, _, ok = f[0].IndexRange()
+	assert.False(t, ok, "bounds of different kinds")
+}
@@ -0,0 +1,268 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// The features are the changes of the behavior of the chain, which must be
+// activated at the same block across the network. They are grouped by
+// versions: the features of a version are activated together at the block
+// scheduled for the version, either in the genesis or by the votes of the
+// stakers on VoteForkVersion. A version is activated only after the lower
+// ones, so the features of a chain are decided by its version at a block.
+
+// The versions of the features
+const (
+	ForkVersion1 uint64 = 1
+
+	// LatestForkVersion is the highest version known to this node. A chain
+	// scheduling a higher version needs an upgrade of the node.
+	LatestForkVersion = ForkVersion1
+)
+
+// The features of ForkVersion1
+const (
+	// FeatureDynamicFee adjusts the fee per byte to the fullness of the
+	// blocks, which is enabled only on private networks by the config before.
+	FeatureDynamicFee = "dynamicfee"
+	// FeatureGovernanceRecipient rejects the normal and the fee-delegated txs
+	// to the system and the name contracts, whose amounts are locked in the
+	// contracts out of their accounting.
+	FeatureGovernanceRecipient = "governancerecipient"
+	// FeatureTxExpiry honors the expiry of the txs, which changes their
+	// hashes and so is rejected before.
+	FeatureTxExpiry = "txexpiry"
+	// FeatureStorageQuota counts the bytes of the storage of each contract
+	// and limits them by the storage quota parameter.
+	FeatureStorageQuota = "storagequota"
+	// FeatureTxSchedule holds the txs until the block they are scheduled at,
+	// which changes their hashes and so is rejected before.
+	FeatureTxSchedule = "txschedule"
+	// FeatureAuthContract lets an account designate a contract authorizing
+	// its txs in place of the signature of its key.
+	FeatureAuthContract = "authcontract"
+	// FeatureVoterReward shares the rewards of the voted BPs with their
+	// voters by the voter reward rate, which are credited to the coinbase
+	// accounts in full before.
+	FeatureVoterReward = "voterreward"
+	// FeatureWithdrawalQueue holds the unstaked amounts in the system account
+	// until the staking delay passes, in place of the staking delay before
+	// unstaking.
+	FeatureWithdrawalQueue = "withdrawalqueue"
+	// FeatureParamDelay activates the voted values of the parameters only
+	// after their activation delays and provided that the quorum votes, which
+	// are activated as soon as they become the top before.
+	FeatureParamDelay = "paramdelay"
+	// FeatureFeeDelegation accepts the fee-delegated txs, whose fees are paid
+	// by the contracts they call, which are rejected as an unknown type
+	// before.
+	FeatureFeeDelegation = "feedelegation"
+)
+
+// Feature is a change of the behavior of the chain.
+type Feature struct {
+	Name        string
+	Version     uint64
+	Description string
+}
+
+var features = map[string]*Feature{}
+
+func registerFeature(f *Feature) {
+	features[f.Name] = f
+}
+
+func init() {
+	registerFeature(&Feature{
+		Name:        FeatureDynamicFee,
+		Version:     ForkVersion1,
+		Description: "adjust the fee per byte to the fullness of the blocks",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureGovernanceRecipient,
+		Version:     ForkVersion1,
+		Description: "reject the normal txs to the system and the name contracts",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureTxExpiry,
+		Version:     ForkVersion1,
+		Description: "drop the txs after their expiry",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureStorageQuota,
+		Version:     ForkVersion1,
+		Description: "count the storage of the contracts and limit it by the storage quota",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureTxSchedule,
+		Version:     ForkVersion1,
+		Description: "execute the txs at or after the block they are scheduled at",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureAuthContract,
+		Version:     ForkVersion1,
+		Description: "authorize the txs of an account by its authorization contract",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureVoterReward,
+		Version:     ForkVersion1,
+		Description: "share the rewards of the voted BPs with their voters",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureWithdrawalQueue,
+		Version:     ForkVersion1,
+		Description: "release the unstaked amounts after the staking delay",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureParamDelay,
+		Version:     ForkVersion1,
+		Description: "activate the voted parameters after their activation delays",
+	})
+	registerFeature(&Feature{
+		Name:        FeatureFeeDelegation,
+		Version:     ForkVersion1,
+		Description: "let the contracts pay the fees of the txs calling them",
+	})
+}
+
+// GetFeature returns the registered feature of the name.
+func GetFeature(name string) (*Feature, bool) {
+	f, ok := features[name]
+	return f, ok
+}
+
+// Features returns the registered features ordered by their versions and
+// names.
+func Features() []*Feature {
+	list := make([]*Feature, 0, len(features))
+	for _, f := range features {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Version != list[j].Version {
+			return list[i].Version < list[j].Version
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Fork is the activation of a version at a block.
+type Fork struct {
+	Version uint64  `json:"version"`
+	Height  BlockNo `json:"height"`
+}
+
+func (f *Fork) String() string {
+	return fmt.Sprintf("version %d at %d", f.Version, f.Height)
+}
+
+// ForkSchedule is the activations of the versions in the ascending order of
+// the versions.
+type ForkSchedule []*Fork
+
+// Validate checks that the versions are known and activated in order.
+func (s ForkSchedule) Validate() error {
+	for i, fork := range s {
+		if fork.Version == 0 || fork.Version > LatestForkVersion {
+			return fmt.Errorf("unknown fork version %d", fork.Version)
+		}
+		if i > 0 && (fork.Version <= s[i-1].Version || fork.Height < s[i-1].Height) {
+			return fmt.Errorf("fork version %d is not scheduled after version %d", fork.Version, s[i-1].Version)
+		}
+	}
+	return nil
+}
+
+// VersionAt returns the version active at the block.
+func (s ForkSchedule) VersionAt(blockNo BlockNo) uint64 {
+	var version uint64
+	for _, fork := range s {
+		if fork.Height > blockNo {
+			break
+		}
+		version = fork.Version
+	}
+	return version
+}
+
+// Equals reports whether s schedules the same versions at the same blocks as
+// other.
+func (s ForkSchedule) Equals(other ForkSchedule) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i, fork := range s {
+		if *fork != *other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge returns the schedule activating each version at the earlier block of
+// s and other.
+func (s ForkSchedule) Merge(other ForkSchedule) ForkSchedule {
+	heights := make(map[uint64]BlockNo)
+	for _, list := range []ForkSchedule{s, other} {
+		for _, fork := range list {
+			if h, exist := heights[fork.Version]; !exist || fork.Height < h {
+				heights[fork.Version] = fork.Height
+			}
+		}
+	}
+	merged := make(ForkSchedule, 0, len(heights))
+	for v, h := range heights {
+		merged = append(merged, &Fork{Version: v, Height: h})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Version < merged[j].Version })
+	// a lower version is activated no later than the higher ones
+	for i := len(merged) - 2; i >= 0; i-- {
+		if merged[i].Height > merged[i+1].Height {
+			merged[i].Height = merged[i+1].Height
+		}
+	}
+	return merged
+}
+
+var (
+	forkScheduleLock sync.RWMutex
+	forkSchedule     ForkSchedule
+)
+
+// SetForkSchedule sets the schedule of the chain, which the modules consult by
+// IsFeatureActive.
+func SetForkSchedule(s ForkSchedule) {
+	forkScheduleLock.Lock()
+	defer forkScheduleLock.Unlock()
+	forkSchedule = s
+}
+
+// GetForkSchedule returns the schedule of the chain.
+func GetForkSchedule() ForkSchedule {
+	forkScheduleLock.RLock()
+	defer forkScheduleLock.RUnlock()
+	return forkSchedule
+}
+
+// ForkVersionAt returns the version of the chain at the block.
+func ForkVersionAt(blockNo BlockNo) uint64 {
+	return GetForkSchedule().VersionAt(blockNo)
+}
+
+// IsFeatureActive reports whether the feature is active at the block by the
+// schedule.
+func (s ForkSchedule) IsFeatureActive(name string, blockNo BlockNo) bool {
+	f, ok := features[name]
+	if !ok {
+		return false
+	}
+	return s.VersionAt(blockNo) >= f.Version
+}
+
+// IsFeatureActive reports whether the feature is active at the block.
+func IsFeatureActive(name string, blockNo BlockNo) bool {
+	return GetForkSchedule().IsFeatureActive(name, blockNo)
+}
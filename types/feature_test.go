@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkSchedule(t *testing.T) {
+	assert.NoError(t, ForkSchedule(nil).Validate())
+	assert.NoError(t, ForkSchedule{{Version: ForkVersion1, Height: 100}}.Validate())
+	assert.Error(t, ForkSchedule{{Version: LatestForkVersion + 1, Height: 100}}.Validate(), "unknown version")
+	assert.Error(t, ForkSchedule{{Version: 0, Height: 100}}.Validate(), "zero version")
+
+	s := ForkSchedule{{Version: ForkVersion1, Height: 100}}
+	assert.Equal(t, uint64(0), s.VersionAt(99))
+	assert.Equal(t, ForkVersion1, s.VersionAt(100))
+	assert.Equal(t, ForkVersion1, s.VersionAt(1000))
+	assert.False(t, s.IsFeatureActive(FeatureDynamicFee, 99))
+	assert.True(t, s.IsFeatureActive(FeatureDynamicFee, 100), "regardless of the schedule of the chain")
+
+	merged := ForkSchedule{{Version: 1, Height: 100}, {Version: 2, Height: 300}}.
+		Merge(ForkSchedule{{Version: 1, Height: 200}, {Version: 2, Height: 50}})
+	assert.Equal(t, ForkSchedule{{Version: 1, Height: 50}, {Version: 2, Height: 50}}, merged,
+		"earlier heights and a lower version no later than the higher ones")
+}
+
+func TestFeatureActivation(t *testing.T) {
+	defer SetForkSchedule(nil)
+
+	body := &TxBody{Type: TxType_NORMAL, Recipient: []byte(AergoSystem), Amount: []byte{1}}
+
+	SetForkSchedule(nil)
+	assert.False(t, IsFeatureActive(FeatureGovernanceRecipient, 1000))
+	assert.NoError(t, ValidateWithFeatures(body, 1000))
+
+	SetForkSchedule(ForkSchedule{{Version: ForkVersion1, Height: 100}})
+	assert.False(t, IsFeatureActive(FeatureGovernanceRecipient, 99))
+	assert.True(t, IsFeatureActive(FeatureGovernanceRecipient, 100))
+	assert.False(t, IsFeatureActive("unknown", 100))
+	assert.NoError(t, ValidateWithFeatures(body, 99))
+	assert.Equal(t, ErrTxInvalidRecipient, ValidateWithFeatures(body, 100))
+
+	body.Type = TxType_GOVERNANCE
+	assert.NoError(t, ValidateWithFeatures(body, 100), "governance tx to the system contract")
+
+	body.Expiry = 1000
+	assert.Equal(t, ErrTxExpiryNotActive, ValidateWithFeatures(body, 99), "expiry before the activation")
+	assert.NoError(t, ValidateWithFeatures(body, 100))
+
+	body = &TxBody{Type: TxType_FEEDELEGATION, Recipient: []byte("contract")}
+	assert.Equal(t, ErrTxFeeDelegationNotActive, ValidateWithFeatures(body, 99), "fee delegation before the activation")
+	assert.NoError(t, ValidateWithFeatures(body, 100))
+
+	for _, f := range Features() {
+		assert.True(t, f.Version <= LatestForkVersion, f.Name)
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeEstimate(t *testing.T) {
+	recipient := make([]byte, AddressLength)
+	payload := make([]byte, 300)
+
+	transfer := fee.Estimate(&TxBody{Recipient: recipient})
+	assert.Equal(t, fee.TxTransfer, transfer.Kind, "kind of a transfer")
+	assert.Equal(t, fee.DefaultBaseTxFee(), transfer.MinFee, "min fee of a transfer")
+	assert.Equal(t, transfer.MinFee, transfer.MaxFee, "max fee of a transfer")
+
+	call := fee.Estimate(&TxBody{Recipient: recipient, Payload: payload})
+	assert.Equal(t, fee.TxCall, call.Kind, "kind of a call")
+	assert.Equal(t, fee.PayloadTxFee(len(payload)), call.MinFee, "min fee of a call")
+	assert.Equal(t, fee.MaxPayloadTxFee(len(payload)), call.MaxFee, "max fee of a call")
+
+	deploy := fee.Estimate(&TxBody{Payload: payload})
+	assert.Equal(t, fee.TxDeploy, deploy.Kind, "kind of a deploy")
+	assert.Equal(t, call.MaxFee, deploy.MaxFee, "max fee of a deploy")
+
+	governance := fee.Estimate(&TxBody{Recipient: []byte(AergoSystem), Payload: payload, Type: TxType_GOVERNANCE})
+	assert.Equal(t, fee.TxGovernance, governance.Kind, "kind of a governance tx")
+	assert.Equal(t, 0, governance.MaxFee.Sign(), "governance tx is free")
+
+}
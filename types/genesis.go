@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/libp2p/go-libp2p-peer"
 )
 
 const (
@@ -152,6 +154,16 @@ func (cid *ChainID) Read(data []byte) error {
 	return nil
 }
 
+// ChainIDString returns the binary chain id in the JSON form, or in base58 if
+// it is not decoded.
+func ChainIDString(id []byte) string {
+	cid := NewChainID()
+	if err := cid.Read(id); err != nil {
+		return enc.ToString(id)
+	}
+	return cid.ToJSON()
+}
+
 // AsDefault set *cid to the default chaind id (cid must be a valid pointer).
 func (cid *ChainID) AsDefault() {
 	*cid = defaultChainID
@@ -188,6 +200,15 @@ type Genesis struct {
 	Timestamp int64             `json:"timestamp,omitempty"`
 	Balance   map[string]string `json:"balance"`
 	BPs       []string          `json:"bps"`
+	// Forks schedules the versions of the features activated
+	Forks ForkSchedule `json:"forks,omitempty"`
+	// DeployAllowList makes the deployment of contracts permissioned. Only
+	// the listed accounts and the ones approved by the proposals deploy.
+	DeployAllowList []string `json:"deploy_allow_list,omitempty"`
+	// TxPolicy limits the payloads of the txs until the stakers change it.
+	TxPolicy *TxPolicy `json:"tx_policy,omitempty"`
+	// Bridge enables the bridge to the paired chain.
+	Bridge *BridgeConfig `json:"bridge,omitempty"`
 
 	// followings are for internal use only
 	totalBalance *big.Int
@@ -201,10 +222,61 @@ func (g *Genesis) Validate() error {
 	if err != nil {
 		return err
 	}
+	if err = g.Forks.Validate(); err != nil {
+		return err
+	}
+	if err = g.validateBalance(); err != nil {
+		return err
+	}
+	for _, bp := range g.BPs {
+		if _, err = peer.IDB58Decode(bp); err != nil {
+			return fmt.Errorf("invalid bp peer id: %s", bp)
+		}
+	}
+	if _, err = g.DeployAllowAccounts(); err != nil {
+		return err
+	}
+	if g.TxPolicy != nil {
+		if _, err = g.TxPolicy.Mask(); err != nil {
+			return err
+		}
+	}
+	if g.Bridge != nil {
+		if err = g.Bridge.Validate(); err != nil {
+			return err
+		}
+	}
 	//TODO check BP count
 	return nil
 }
 
+// validateBalance checks that the initial balances are of well formed
+// addresses and are decimal amounts.
+func (g *Genesis) validateBalance() error {
+	for address, balance := range g.Balance {
+		if account, err := DecodeAddress(address); err != nil || len(account) != AddressLength {
+			return fmt.Errorf("invalid address in balance: %s", address)
+		}
+		if v, ok := new(big.Int).SetString(balance, 10); !ok || v.Sign() < 0 {
+			return fmt.Errorf("invalid balance %s of %s", balance, address)
+		}
+	}
+	return nil
+}
+
+// DeployAllowAccounts decodes the addresses of g.DeployAllowList.
+func (g *Genesis) DeployAllowAccounts() ([]Address, error) {
+	var accounts []Address
+	for _, encoded := range g.DeployAllowList {
+		account, err := DecodeAddress(encoded)
+		if err != nil || len(account) != AddressLength {
+			return nil, fmt.Errorf("invalid address in deploy allow list: %s", encoded)
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
 // Block returns Block corresponding to g.
 func (g *Genesis) Block() *Block {
 	if g.block == nil {
@@ -66,3 +66,16 @@ func TestCodecChainID(t *testing.T) {
 	a.Nil(err)
 	a.True(id1.Equals(id2))
 }
+
+func TestGenesisDeployAllowList(t *testing.T) {
+	a := assert.New(t)
+	g := GetDefaultGenesis()
+	g.DeployAllowList = []string{"AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"}
+	a.NoError(g.Validate())
+	accounts, err := g.DeployAllowAccounts()
+	a.NoError(err)
+	a.Len(accounts, 1)
+
+	g.DeployAllowList = append(g.DeployAllowList, "aergo.system")
+	a.Error(g.Validate(), "a name is not an account")
+}
@@ -0,0 +1,164 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/libp2p/go-libp2p-peer"
+)
+
+// GenesisBuilder builds the chain spec of a new chain: the genesis with the
+// allocations, the initial BPs and the system parameters, and the consensus
+// with the initial raft members. The inputs are checked as they are added,
+// and the first invalid one is returned by Build.
+type GenesisBuilder struct {
+	spec *ChainSpec
+	err  error
+}
+
+// NewGenesisBuilder returns the builder of a private chain of the magic and
+// the consensus, created now.
+func NewGenesisBuilder(magic, consensus string) *GenesisBuilder {
+	return &GenesisBuilder{
+		spec: &ChainSpec{
+			Genesis: &Genesis{
+				ID:        ChainID{Magic: magic, Consensus: consensus},
+				Timestamp: time.Now().UnixNano(),
+				Balance:   make(map[string]string),
+			},
+			Consensus: &ConsensusSpec{Type: consensus},
+		},
+	}
+}
+
+func (b *GenesisBuilder) fail(format string, args ...interface{}) *GenesisBuilder {
+	if b.err == nil {
+		b.err = fmt.Errorf(format, args...)
+	}
+	return b
+}
+
+// PublicNet makes the chain public, the main net if mainnet.
+func (b *GenesisBuilder) PublicNet(mainnet bool) *GenesisBuilder {
+	b.spec.Genesis.ID.PublicNet = true
+	b.spec.Genesis.ID.MainNet = mainnet
+	return b
+}
+
+// Timestamp sets the timestamp of the genesis block in nanoseconds.
+func (b *GenesisBuilder) Timestamp(ts int64) *GenesisBuilder {
+	b.spec.Genesis.Timestamp = ts
+	return b
+}
+
+// Allocate gives the initial balance in aer to the address.
+func (b *GenesisBuilder) Allocate(address string, amount *big.Int) *GenesisBuilder {
+	if account, err := DecodeAddress(address); err != nil || len(account) != AddressLength {
+		return b.fail("invalid address of allocation: %s", address)
+	}
+	if amount == nil || amount.Sign() < 0 {
+		return b.fail("invalid amount of allocation to %s", address)
+	}
+	if _, exist := b.spec.Genesis.Balance[address]; exist {
+		return b.fail("duplicate allocation to %s", address)
+	}
+	b.spec.Genesis.Balance[address] = amount.String()
+	return b
+}
+
+// AddBP adds the initial BP of the peer id.
+func (b *GenesisBuilder) AddBP(peerID string) *GenesisBuilder {
+	if _, err := peer.IDB58Decode(peerID); err != nil {
+		return b.fail("invalid bp peer id: %s", peerID)
+	}
+	for _, bp := range b.spec.Genesis.BPs {
+		if bp == peerID {
+			return b.fail("duplicate bp: %s", peerID)
+		}
+	}
+	b.spec.Genesis.BPs = append(b.spec.Genesis.BPs, peerID)
+	return b
+}
+
+// AddRaftMember adds the initial member of the raft cluster.
+func (b *GenesisBuilder) AddRaftMember(name, url, peerID string) *GenesisBuilder {
+	m := &RaftMember{Name: name, Url: url, PeerID: peerID}
+	if err := m.Validate(); err != nil {
+		return b.fail("%s", err)
+	}
+	for _, other := range b.spec.Consensus.RaftMembers {
+		if other.Name == name {
+			return b.fail("duplicate raft member %s", name)
+		}
+	}
+	b.spec.Consensus.RaftMembers = append(b.spec.Consensus.RaftMembers, m)
+	return b
+}
+
+// BlockInterval sets the block production interval in seconds.
+func (b *GenesisBuilder) BlockInterval(sec int64) *GenesisBuilder {
+	b.spec.Consensus.BlockInterval = sec
+	return b
+}
+
+// ScheduleFork activates the version of the features at the block.
+func (b *GenesisBuilder) ScheduleFork(version uint64, height BlockNo) *GenesisBuilder {
+	b.spec.Forks = append(b.spec.Forks, &Fork{Version: version, Height: height})
+	return b
+}
+
+// AllowDeploy adds the address to the accounts allowed to deploy contracts,
+// which makes the deployment permissioned.
+func (b *GenesisBuilder) AllowDeploy(address string) *GenesisBuilder {
+	if account, err := DecodeAddress(address); err != nil || len(account) != AddressLength {
+		return b.fail("invalid address in deploy allow list: %s", address)
+	}
+	b.spec.Genesis.DeployAllowList = append(b.spec.Genesis.DeployAllowList, address)
+	return b
+}
+
+// TxPolicy sets the initial policy on the payloads of the txs.
+func (b *GenesisBuilder) TxPolicy(policy *TxPolicy) *GenesisBuilder {
+	if _, err := policy.Mask(); err != nil {
+		return b.fail("%s", err)
+	}
+	b.spec.Genesis.TxPolicy = policy
+	return b
+}
+
+// Bridge enables the bridge to the paired chain with the validators
+// anchoring its roots.
+func (b *GenesisBuilder) Bridge(config *BridgeConfig) *GenesisBuilder {
+	if err := config.Validate(); err != nil {
+		return b.fail("%s", err)
+	}
+	b.spec.Genesis.Bridge = config
+	return b
+}
+
+// Fee sets the fee policy of the private chain.
+func (b *GenesisBuilder) Fee(fee FeeSpec) *GenesisBuilder {
+	b.spec.Fee = &fee
+	return b
+}
+
+// Build returns the chain spec, or the first invalid input.
+func (b *GenesisBuilder) Build() (*ChainSpec, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.spec.Genesis.ID.Magic) == 0 {
+		return nil, errors.New("no magic of the chain id")
+	}
+	if err := b.spec.Validate(); err != nil {
+		return nil, err
+	}
+	return b.spec, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAddress1 = "AmMK3LZiR1oEf66xzXir7mA5SUVVHSinWUYmh5FwueoVmciH3CuJ"
+	testAddress2 = "AmLsSfxo9aQRZJBvMBoLFb9QZABQK2RiG3Uq1JBhyAfbDYPf31J2"
+	testPeerID1  = "16Uiu2HAmAokYAtLbZxJAPRgp2jCc4bD35cJD921trqUANh59Rc4n"
+	testPeerID2  = "16Uiu2HAm4xYtGsqk7WGKUxr8prfVpJ25hD23AQ3Be6anEL9Kxkgw"
+)
+
+func TestGenesisBuilder(t *testing.T) {
+	spec, err := NewGenesisBuilder("builder.test", "raft").
+		Timestamp(1).
+		Allocate(testAddress1, big.NewInt(1000)).
+		Allocate(testAddress2, big.NewInt(0)).
+		AddRaftMember("bp1", "http://127.0.0.1:11001", testPeerID1).
+		AddRaftMember("bp2", "https://bp2.aergo.io:11001", testPeerID2).
+		BlockInterval(2).
+		ScheduleFork(ForkVersion1, 10).
+		AllowDeploy(testAddress1).
+		TxPolicy(&TxPolicy{PayloadTypes: []string{"transfer", "call"}}).
+		Fee(FeeSpec{ZeroFee: true}).
+		Bridge(&BridgeConfig{Validators: []string{testAddress1}, Threshold: 1, Mint: true}).
+		Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "raft", spec.Genesis.ID.Consensus)
+	assert.Equal(t, int64(1), spec.Genesis.Timestamp)
+	assert.Equal(t, map[string]string{testAddress1: "1000", testAddress2: "0"}, spec.Genesis.Balance)
+	assert.Len(t, spec.Consensus.RaftMembers, 2)
+	assert.True(t, spec.Genesis.Forks.Equals(ForkSchedule{{Version: ForkVersion1, Height: 10}}))
+
+	// the spec is read back by the init
+	data, err := spec.JSON()
+	assert.NoError(t, err)
+	parsed, err := ParseChainSpec(data)
+	assert.NoError(t, err)
+	assert.Equal(t, spec, parsed)
+
+	spec, err = NewGenesisBuilder("builder.test", "dpos").PublicNet(false).AddBP(testPeerID1).AddBP(testPeerID2).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{testPeerID1, testPeerID2}, spec.Genesis.BPs)
+	assert.True(t, spec.Genesis.ID.PublicNet)
+
+	for _, b := range []*GenesisBuilder{
+		NewGenesisBuilder("", "dpos"),
+		NewGenesisBuilder("x", "dpos").Allocate("invalid", big.NewInt(1)),
+		NewGenesisBuilder("x", "dpos").Allocate(testAddress1, big.NewInt(-1)),
+		NewGenesisBuilder("x", "dpos").Allocate(testAddress1, big.NewInt(1)).Allocate(testAddress1, big.NewInt(2)),
+		NewGenesisBuilder("x", "dpos").AddBP("invalid"),
+		NewGenesisBuilder("x", "dpos").AddBP(testPeerID1).AddBP(testPeerID1),
+		NewGenesisBuilder("x", "dpos").AddRaftMember("bp1", "http://127.0.0.1:11001", testPeerID1),
+		NewGenesisBuilder("x", "raft").AddRaftMember("bp1", "127.0.0.1:11001", testPeerID1),
+		NewGenesisBuilder("x", "raft").AddRaftMember("bp1", "http://127.0.0.1:11001", "invalid"),
+		NewGenesisBuilder("x", "raft").AddRaftMember("bp1", "http://127.0.0.1:11001", testPeerID1).AddRaftMember("bp1", "http://127.0.0.1:11002", testPeerID2),
+		NewGenesisBuilder("x", "dpos").ScheduleFork(LatestForkVersion+1, 1),
+		NewGenesisBuilder("x", "dpos").AllowDeploy("invalid"),
+		NewGenesisBuilder("x", "dpos").TxPolicy(&TxPolicy{PayloadTypes: []string{"unknown"}}),
+		NewGenesisBuilder("x", "dpos").PublicNet(true).Fee(FeeSpec{GasFee: true}),
+		NewGenesisBuilder("x", "dpos").Bridge(&BridgeConfig{Validators: []string{testAddress1}, Threshold: 2}),
+	} {
+		_, err := b.Build()
+		assert.Error(t, err)
+	}
+}
+
+func TestGenesisValidateAccounts(t *testing.T) {
+	g := GetDefaultGenesis()
+	g.Balance = map[string]string{testAddress1: "1"}
+	g.BPs = []string{testPeerID1}
+	assert.NoError(t, g.Validate())
+
+	g.Balance = map[string]string{"invalid": "1"}
+	assert.Error(t, g.Validate())
+	g.Balance = map[string]string{testAddress1: "1aergo"}
+	assert.Error(t, g.Validate())
+	g.Balance = nil
+	g.BPs = []string{"invalid"}
+	assert.Error(t, g.Validate())
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+func (op *InternalOperation) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(op)
+}
+
+// MarshalJSON encodes the operation with the addresses in base58 and the
+// amount in aer.
+func (op *InternalOperation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Op       string               `json:"op"`
+		Caller   string               `json:"caller"`
+		Callee   string               `json:"callee"`
+		Function string               `json:"function,omitempty"`
+		Args     string               `json:"args,omitempty"`
+		Amount   string               `json:"amount"`
+		Status   string               `json:"status"`
+		Error    string               `json:"error,omitempty"`
+		Calls    []*InternalOperation `json:"calls,omitempty"`
+	}{
+		Op:       op.Op,
+		Caller:   EncodeAddress(op.Caller),
+		Callee:   EncodeAddress(op.Callee),
+		Function: op.Function,
+		Args:     op.Args,
+		Amount:   new(big.Int).SetBytes(op.Amount).String(),
+		Status:   op.Status,
+		Error:    op.Error,
+		Calls:    op.Calls,
+	})
+}
+
+func (ops *InternalOperations) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(ops)
+}
+
+// MarshalJSON encodes the internal operations of a tx with its hash in
+// base58.
+func (ops *InternalOperations) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		TxHash     string               `json:"txHash"`
+		BlockNo    uint64               `json:"blockNo"`
+		Operations []*InternalOperation `json:"operations"`
+	}{
+		TxHash:     enc.ToString(ops.TxHash),
+		BlockNo:    ops.BlockNo,
+		Operations: ops.Operations,
+	})
+}
@@ -0,0 +1,310 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+var ErrLazyDecode = errors.New("malformed protobuf message")
+
+// wire types of protobuf
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// walkFields calls fn with each field of the protobuf encoded data: the field
+// number, the value of a varint field and the bytes of a length-delimited
+// field, which is a slice of data. The fixed size fields are skipped.
+func walkFields(data []byte, fn func(field uint64, varint uint64, value []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrLazyDecode
+		}
+		data = data[n:]
+
+		var (
+			varint uint64
+			value  []byte
+		)
+		switch key & 7 {
+		case wireVarint:
+			if varint, n = binary.Uvarint(data); n <= 0 {
+				return ErrLazyDecode
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return ErrLazyDecode
+			}
+			data = data[8:]
+			continue
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return ErrLazyDecode
+			}
+			value, data = data[n:n+int(l)], data[n+int(l):]
+		case wireFixed32:
+			if len(data) < 4 {
+				return ErrLazyDecode
+			}
+			data = data[4:]
+			continue
+		default:
+			return ErrLazyDecode
+		}
+		if err := fn(key>>3, varint, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LazyBlock is a block decoded from the wire, whose header is decoded eagerly
+// and body on the first access, for the receivers which inspect only the
+// headers of most blocks.
+type LazyBlock struct {
+	Hash   []byte
+	Header *BlockHeader
+
+	size    int
+	hasBody bool
+	rawBody []byte
+
+	once sync.Once
+	body *BlockBody
+	err  error
+}
+
+// DecodeLazyBlock decodes the protobuf encoded Block lazily. data must not be
+// modified after that, since the body is decoded from it.
+func DecodeLazyBlock(data []byte) (*LazyBlock, error) {
+	b := &LazyBlock{}
+	err := walkFields(data, func(field uint64, _ uint64, value []byte) error {
+		switch field {
+		case 1:
+			b.Hash = append([]byte(nil), value...)
+		case 2:
+			b.Header = &BlockHeader{}
+			return proto.Unmarshal(value, b.Header)
+		case 3:
+			b.hasBody, b.rawBody = true, value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the size is the same as Block.Size() without decoding the txs
+	b.size = proto.Size(b.Header) + len(b.Hash)
+	err = walkFields(b.rawBody, func(field uint64, _ uint64, value []byte) error {
+		if field == 1 {
+			b.size += len(value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// NewLazyBlock returns the lazy block of the block.
+func NewLazyBlock(block *Block) (*LazyBlock, error) {
+	data, err := proto.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeLazyBlock(data)
+}
+
+func (b *LazyBlock) GetHeader() *BlockHeader {
+	if b != nil {
+		return b.Header
+	}
+	return nil
+}
+
+func (b *LazyBlock) BlockNo() BlockNo {
+	return b.GetHeader().GetBlockNo()
+}
+
+func (b *LazyBlock) BlockID() BlockID {
+	return ToBlockID(b.Hash)
+}
+
+// Size returns the size of the block, which is the same as Block.Size().
+func (b *LazyBlock) Size() int {
+	return b.size
+}
+
+// Body decodes the body of the block at the first call.
+func (b *LazyBlock) Body() (*BlockBody, error) {
+	b.once.Do(func() {
+		if !b.hasBody {
+			return
+		}
+		b.body = &BlockBody{}
+		if b.err = proto.Unmarshal(b.rawBody, b.body); b.err != nil {
+			b.body = nil
+		}
+	})
+	return b.body, b.err
+}
+
+// LazyTxs returns the txs of the block, whose bodies are decoded lazily. It
+// doesn't decode the body of the block.
+func (b *LazyBlock) LazyTxs() ([]*LazyTx, error) {
+	var txs []*LazyTx
+	err := walkFields(b.rawBody, func(field uint64, _ uint64, value []byte) error {
+		if field != 1 {
+			return nil
+		}
+		tx, err := DecodeLazyTx(value)
+		if err == nil {
+			txs = append(txs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// Block returns the fully decoded block.
+func (b *LazyBlock) Block() (*Block, error) {
+	body, err := b.Body()
+	if err != nil {
+		return nil, err
+	}
+	return &Block{Hash: b.Hash, Header: b.Header, Body: body}, nil
+}
+
+// LazyTx is a tx decoded from the wire, whose body including the payload is
+// decoded on the first access.
+type LazyTx struct {
+	Hash []byte
+
+	hasBody bool
+	rawBody []byte
+
+	once sync.Once
+	body *TxBody
+	err  error
+}
+
+// DecodeLazyTx decodes the protobuf encoded Tx lazily. data must not be
+// modified after that, since the body is decoded from it.
+func DecodeLazyTx(data []byte) (*LazyTx, error) {
+	tx := &LazyTx{}
+	err := walkFields(data, func(field uint64, _ uint64, value []byte) error {
+		switch field {
+		case 1:
+			tx.Hash = append([]byte(nil), value...)
+		case 2:
+			tx.hasBody, tx.rawBody = true, value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Body decodes the body of the tx at the first call.
+func (tx *LazyTx) Body() (*TxBody, error) {
+	tx.once.Do(func() {
+		if !tx.hasBody {
+			return
+		}
+		tx.body = &TxBody{}
+		if tx.err = proto.Unmarshal(tx.rawBody, tx.body); tx.err != nil {
+			tx.body = nil
+		}
+	})
+	return tx.body, tx.err
+}
+
+// Tx returns the fully decoded tx.
+func (tx *LazyTx) Tx() (*Tx, error) {
+	body, err := tx.Body()
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Hash: tx.Hash, Body: body}, nil
+}
+
+// LazyBlockResponse is the GetBlockResponse whose blocks are decoded lazily.
+// It is only decoded from the wire.
+type LazyBlockResponse struct {
+	Status  ResultStatus
+	Blocks  []*LazyBlock
+	HasNext bool
+}
+
+func (m *LazyBlockResponse) Reset() { *m = LazyBlockResponse{} }
+func (m *LazyBlockResponse) String() string {
+	return fmt.Sprintf("status:%s blocks:%d hasNext:%t", m.Status, len(m.Blocks), m.HasNext)
+}
+func (*LazyBlockResponse) ProtoMessage() {}
+
+func (m *LazyBlockResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+// Unmarshal decodes the protobuf encoded GetBlockResponse.
+func (m *LazyBlockResponse) Unmarshal(data []byte) error {
+	return walkFields(data, func(field uint64, varint uint64, value []byte) error {
+		switch field {
+		case 1:
+			m.Status = ResultStatus(varint)
+		case 2:
+			block, err := DecodeLazyBlock(value)
+			if err != nil {
+				return err
+			}
+			m.Blocks = append(m.Blocks, block)
+		case 3:
+			m.HasNext = varint != 0
+		}
+		return nil
+	})
+}
+
+// Response returns the fully decoded GetBlockResponse.
+func (m *LazyBlockResponse) Response() (*GetBlockResponse, error) {
+	resp := &GetBlockResponse{Status: m.Status, HasNext: m.HasNext}
+	if len(m.Blocks) > 0 {
+		resp.Blocks = make([]*Block, len(m.Blocks))
+	}
+	for i, lazy := range m.Blocks {
+		if lazy == nil {
+			continue
+		}
+		block, err := lazy.Block()
+		if err != nil {
+			return nil, err
+		}
+		resp.Blocks[i] = block
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyBlock(t *testing.T) {
+	txs := []*Tx{
+		{Body: &TxBody{Nonce: 1, Account: []byte("account"), Payload: []byte("payload"), Sign: []byte("sign")}},
+		{Body: &TxBody{Nonce: 2, Account: []byte("account"), Recipient: []byte("recipient")}},
+	}
+	for _, tx := range txs {
+		tx.Hash = tx.CalculateTxHash()
+	}
+	genesis := NewBlock(nil, nil, nil, nil, nil, 0)
+	block := NewBlock(genesis, []byte("root"), nil, txs, []byte("coinbase"), 100)
+	genesis.BlockHash()
+	block.BlockHash()
+
+	data, err := proto.Marshal(block)
+	assert.NoError(t, err)
+	lazy, err := DecodeLazyBlock(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, block.Hash, lazy.Hash)
+	assert.Equal(t, block.BlockID(), lazy.BlockID())
+	assert.Equal(t, block.BlockNo(), lazy.BlockNo())
+	assert.True(t, proto.Equal(block.Header, lazy.Header))
+	assert.Equal(t, block.Size(), lazy.Size())
+
+	// the txs are decoded without the block body
+	lazyTxs, err := lazy.LazyTxs()
+	assert.NoError(t, err)
+	assert.Len(t, lazyTxs, len(txs))
+	assert.Nil(t, lazy.body)
+	for i, lazyTx := range lazyTxs {
+		assert.Equal(t, txs[i].Hash, lazyTx.Hash)
+		tx, err := lazyTx.Tx()
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(txs[i], tx))
+	}
+
+	decoded, err := lazy.Block()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(block, decoded))
+
+	// the body is decoded once
+	body, _ := lazy.Body()
+	assert.True(t, body == decoded.Body)
+
+	// the response
+	data, err = proto.Marshal(&GetBlockResponse{Status: ResultStatus_NOT_FOUND, Blocks: []*Block{genesis, block}, HasNext: true})
+	assert.NoError(t, err)
+	resp := &LazyBlockResponse{}
+	assert.NoError(t, proto.Unmarshal(data, resp))
+	assert.Equal(t, ResultStatus_NOT_FOUND, resp.GetStatus())
+	assert.True(t, resp.HasNext)
+	assert.Len(t, resp.Blocks, 2)
+	full, err := resp.Response()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(genesis, full.Blocks[0]))
+	assert.True(t, proto.Equal(block, full.Blocks[1]))
+
+	// malformed
+	_, err = DecodeLazyBlock(data[:len(data)-1])
+	assert.Error(t, err)
+	assert.Error(t, proto.Unmarshal([]byte{0x12, 0x05, 0x01}, &LazyBlockResponse{}))
+}
@@ -1420,6 +1420,102 @@ func (m *GetHashesResponse) GetHasNext() bool {
 	return false
 }
 
+// GetSkeletonRequest asks the hashes of the blocks of the skeleton from top down to bottom
+type GetSkeletonRequest struct {
+	Top                  uint64   `protobuf:"varint,1,opt,name=top,proto3" json:"top,omitempty"`
+	Bottom               uint64   `protobuf:"varint,2,opt,name=bottom,proto3" json:"bottom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSkeletonRequest) Reset()         { *m = GetSkeletonRequest{} }
+func (m *GetSkeletonRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSkeletonRequest) ProtoMessage()    {}
+func (*GetSkeletonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{24}
+}
+
+func (m *GetSkeletonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSkeletonRequest.Unmarshal(m, b)
+}
+func (m *GetSkeletonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSkeletonRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSkeletonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSkeletonRequest.Merge(m, src)
+}
+func (m *GetSkeletonRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSkeletonRequest.Size(m)
+}
+func (m *GetSkeletonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSkeletonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSkeletonRequest proto.InternalMessageInfo
+
+func (m *GetSkeletonRequest) GetTop() uint64 {
+	if m != nil {
+		return m.Top
+	}
+	return 0
+}
+
+func (m *GetSkeletonRequest) GetBottom() uint64 {
+	if m != nil {
+		return m.Bottom
+	}
+	return 0
+}
+
+// GetSkeletonResponse is the hashes of the blocks of the skeleton in order. The hash of a block the peer doesn't have is empty
+type GetSkeletonResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Hashes               [][]byte     `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetSkeletonResponse) Reset()         { *m = GetSkeletonResponse{} }
+func (m *GetSkeletonResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSkeletonResponse) ProtoMessage()    {}
+func (*GetSkeletonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{25}
+}
+
+func (m *GetSkeletonResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSkeletonResponse.Unmarshal(m, b)
+}
+func (m *GetSkeletonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSkeletonResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSkeletonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSkeletonResponse.Merge(m, src)
+}
+func (m *GetSkeletonResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSkeletonResponse.Size(m)
+}
+func (m *GetSkeletonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSkeletonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSkeletonResponse proto.InternalMessageInfo
+
+func (m *GetSkeletonResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetSkeletonResponse) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.ResultStatus", ResultStatus_name, ResultStatus_value)
 	proto.RegisterType((*MsgHeader)(nil), "types.MsgHeader")
@@ -1446,86 +1542,90 @@ func init() {
 	proto.RegisterType((*GetHashByNoResponse)(nil), "types.GetHashByNoResponse")
 	proto.RegisterType((*GetHashesRequest)(nil), "types.GetHashesRequest")
 	proto.RegisterType((*GetHashesResponse)(nil), "types.GetHashesResponse")
+	proto.RegisterType((*GetSkeletonRequest)(nil), "types.GetSkeletonRequest")
+	proto.RegisterType((*GetSkeletonResponse)(nil), "types.GetSkeletonResponse")
 }
 
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor_e7fdddb109e6467a) }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x72, 0xda, 0x46,
-	0x14, 0xae, 0x00, 0x63, 0x38, 0x80, 0x2d, 0xaf, 0x9b, 0x84, 0x71, 0x33, 0x2e, 0xa3, 0xc9, 0xb4,
-	0x34, 0xcd, 0x38, 0x1d, 0xe7, 0x09, 0x64, 0x6b, 0x03, 0x6a, 0xf0, 0x8a, 0x59, 0x20, 0x4d, 0x7b,
-	0x43, 0x05, 0x6c, 0x40, 0xad, 0x2d, 0xa9, 0xda, 0x25, 0xc1, 0xb9, 0xe9, 0x4c, 0x2f, 0xfa, 0x06,
-	0x7d, 0x85, 0x3e, 0x46, 0x1f, 0xa0, 0xef, 0xd4, 0x99, 0xce, 0xae, 0x56, 0x20, 0xf2, 0x53, 0x4f,
-	0x3d, 0xb9, 0x62, 0xcf, 0xb7, 0x67, 0xbf, 0x3d, 0x3f, 0xdf, 0x1e, 0x04, 0xd5, 0xf8, 0x34, 0x3e,
-	0x89, 0x93, 0x48, 0x44, 0x68, 0x47, 0x5c, 0xc7, 0x8c, 0x1f, 0x99, 0x93, 0xcb, 0x68, 0xfa, 0xf3,
-	0x74, 0xe1, 0x07, 0x61, 0xba, 0x71, 0x04, 0x61, 0x34, 0x63, 0xe9, 0xda, 0xfa, 0xc7, 0x80, 0xea,
-	0x05, 0x9f, 0x77, 0x99, 0x3f, 0x63, 0x09, 0x7a, 0x00, 0x8d, 0xe9, 0x65, 0xc0, 0x42, 0xf1, 0x9c,
-	0x25, 0x3c, 0x88, 0xc2, 0xa6, 0xd1, 0x32, 0xda, 0x55, 0xba, 0x0d, 0xa2, 0xfb, 0x50, 0x15, 0xc1,
-	0x15, 0xe3, 0xc2, 0xbf, 0x8a, 0x9b, 0x85, 0x96, 0xd1, 0x2e, 0xd2, 0x0d, 0x80, 0xf6, 0xa0, 0x10,
-	0xcc, 0x9a, 0x45, 0x75, 0xb0, 0x10, 0xcc, 0xd0, 0x5d, 0x28, 0xcf, 0x23, 0xce, 0x83, 0xb8, 0x59,
-	0x6a, 0x19, 0xed, 0x0a, 0xd5, 0x96, 0xc4, 0x63, 0xc6, 0x12, 0xd7, 0x69, 0xee, 0xb4, 0x8c, 0x76,
-	0x9d, 0x6a, 0x0b, 0x1d, 0x83, 0x8a, 0xaf, 0xbf, 0x9c, 0x3c, 0x63, 0xd7, 0xcd, 0xb2, 0xda, 0xcb,
-	0x21, 0x08, 0x41, 0x89, 0x07, 0xf3, 0xb0, 0xb9, 0xab, 0x76, 0xd4, 0x1a, 0xb5, 0xa0, 0xc6, 0x97,
-	0x13, 0x95, 0xd1, 0x34, 0xba, 0x6c, 0x56, 0x5a, 0x46, 0xbb, 0x41, 0xf3, 0x90, 0xbc, 0xed, 0x92,
-	0x85, 0x73, 0xb1, 0x68, 0x56, 0xd5, 0xa6, 0xb6, 0xac, 0x6f, 0x01, 0xfa, 0xa7, 0xfd, 0x0b, 0xc6,
-	0xb9, 0x3f, 0x67, 0xa8, 0x0d, 0xe5, 0x85, 0xaa, 0x84, 0x4a, 0xbc, 0x76, 0x6a, 0x9e, 0xa8, 0x1a,
-	0x9e, 0xac, 0x2b, 0x44, 0xf5, 0xbe, 0x8c, 0x62, 0xe6, 0x0b, 0x5f, 0xa5, 0x5f, 0xa7, 0x6a, 0x6d,
-	0x79, 0x50, 0xea, 0x07, 0xe1, 0x1c, 0x7d, 0x01, 0xfb, 0x13, 0xc6, 0xc5, 0x58, 0x15, 0x7e, 0xbc,
-	0xf0, 0xf9, 0x42, 0xd1, 0xd5, 0x69, 0x43, 0xc2, 0x67, 0x12, 0xed, 0xfa, 0x7c, 0x81, 0x3e, 0x87,
-	0x9a, 0xf2, 0x5b, 0xb0, 0x60, 0xbe, 0x10, 0x8a, 0xaa, 0x44, 0x41, 0x42, 0x5d, 0x85, 0x58, 0x3d,
-	0x28, 0xf5, 0xa3, 0x70, 0x2e, 0xdb, 0xb2, 0x75, 0xf2, 0xfd, 0x74, 0xc7, 0x90, 0x3b, 0xfb, 0x1e,
-	0xb6, 0xbf, 0x0d, 0x28, 0x0f, 0x84, 0x2f, 0x96, 0x1c, 0x3d, 0x84, 0x32, 0x67, 0xe1, 0x26, 0x4f,
-	0xa4, 0xf3, 0xec, 0x33, 0x96, 0xd8, 0xb3, 0x59, 0xc2, 0x38, 0xa7, 0xda, 0xe3, 0xdd, 0xcb, 0x0b,
-	0x37, 0x5f, 0x5e, 0x7c, 0xfb, 0x72, 0xd4, 0x84, 0x5d, 0x25, 0x41, 0xd7, 0x51, 0x32, 0xa8, 0xd3,
-	0xcc, 0x44, 0x47, 0x50, 0x09, 0x23, 0xbc, 0x8a, 0x23, 0xce, 0x94, 0x12, 0x2a, 0x74, 0x6d, 0xcb,
-	0x53, 0xaf, 0xb4, 0x12, 0xcb, 0x4a, 0x50, 0x99, 0x69, 0xb5, 0xa1, 0xde, 0x89, 0xec, 0xd7, 0xfe,
-	0x35, 0x89, 0x44, 0x30, 0x55, 0x9e, 0x57, 0x69, 0x13, 0xb5, 0x66, 0x33, 0xd3, 0x7a, 0x01, 0xa6,
-	0x4e, 0x89, 0x71, 0xca, 0x7e, 0x59, 0x32, 0x2e, 0xfe, 0x57, 0xfe, 0x92, 0xd9, 0x5f, 0x0d, 0x82,
-	0x37, 0x4c, 0x65, 0xde, 0xa0, 0x99, 0x69, 0xfd, 0x04, 0x07, 0x39, 0x66, 0x1e, 0x47, 0x21, 0x67,
-	0xe8, 0x6b, 0x28, 0x73, 0x55, 0x64, 0x45, 0xbd, 0x77, 0x7a, 0xa8, 0xa9, 0x29, 0xe3, 0xcb, 0x4b,
-	0x91, 0xd6, 0x9f, 0x6a, 0x17, 0xd4, 0x86, 0x1d, 0xa9, 0x7a, 0xde, 0x2c, 0xb4, 0x8a, 0x1f, 0x08,
-	0x23, 0x75, 0xb0, 0xba, 0xb0, 0x47, 0xd8, 0x6b, 0x55, 0x6f, 0x9d, 0xf1, 0x7d, 0xa8, 0x4e, 0xde,
-	0x12, 0xc4, 0x06, 0x90, 0x51, 0x4f, 0x52, 0x67, 0xad, 0x84, 0xcc, 0xb4, 0x38, 0x1c, 0x2a, 0x9a,
-	0x7e, 0x12, 0xcd, 0x96, 0x53, 0x36, 0xd3, 0x74, 0xc7, 0x00, 0x71, 0x8a, 0xc8, 0x27, 0x99, 0xf2,
-	0xe5, 0x90, 0x0f, 0x13, 0x22, 0x0b, 0x76, 0xd4, 0x52, 0x75, 0xbd, 0x76, 0x5a, 0xd7, 0x49, 0xa8,
-	0x4b, 0x68, 0xba, 0x65, 0xfd, 0x66, 0xc0, 0xdd, 0x0e, 0xd3, 0x7a, 0x51, 0x2f, 0x68, 0xdd, 0x0b,
-	0x04, 0xa5, 0xdc, 0x13, 0x51, 0x6b, 0xf9, 0x5a, 0xb7, 0x1e, 0x85, 0xb6, 0x24, 0x1e, 0xbd, 0x7c,
-	0xc9, 0x59, 0xa6, 0x30, 0x6d, 0xa5, 0x33, 0xe1, 0x0d, 0x53, 0xd2, 0x6a, 0x50, 0xb5, 0x46, 0x26,
-	0x14, 0x7d, 0x3e, 0xd5, 0x92, 0x92, 0x4b, 0xeb, 0x4f, 0x03, 0xee, 0xbd, 0x13, 0xc4, 0x6d, 0xda,
-	0x26, 0xc3, 0xf3, 0xf9, 0x82, 0xa5, 0x7d, 0xab, 0x53, 0x6d, 0xa1, 0x47, 0xb0, 0x9b, 0x8e, 0x07,
-	0xde, 0x2c, 0x6e, 0x35, 0x34, 0x77, 0x25, 0xcd, 0x5c, 0x64, 0x45, 0x17, 0x3e, 0x27, 0x6c, 0x25,
-	0xf4, 0x64, 0xcc, 0x4c, 0xeb, 0x2b, 0xd8, 0xcf, 0xe2, 0xcc, 0xaa, 0xb4, 0xb9, 0xd2, 0xc8, 0x5f,
-	0x69, 0xfd, 0x0a, 0xe6, 0xc6, 0xf5, 0x36, 0xb9, 0x3c, 0x80, 0xb2, 0x6a, 0x51, 0xa6, 0xc1, 0xed,
-	0xf6, 0xe9, 0xbd, 0x7c, 0xac, 0xc5, 0xed, 0x58, 0x9f, 0xc0, 0x1d, 0xc2, 0x5e, 0x0f, 0x13, 0x3f,
-	0xe4, 0xfe, 0x54, 0x04, 0x51, 0xc8, 0xb5, 0xa0, 0x8e, 0xa0, 0x22, 0x56, 0xdd, 0x7c, 0xcc, 0x6b,
-	0xdb, 0xfa, 0x46, 0xa9, 0x21, 0x7f, 0xe8, 0xa6, 0x3c, 0xff, 0x48, 0x7b, 0xb7, 0x7d, 0xe4, 0x63,
-	0xf6, 0xee, 0x33, 0x28, 0x8a, 0x55, 0xd6, 0xb7, 0xaa, 0x66, 0x18, 0xae, 0xa8, 0x44, 0xff, 0xa3,
-	0x55, 0x1d, 0x38, 0xe8, 0x30, 0x71, 0x11, 0x70, 0x1e, 0x84, 0xf3, 0x1b, 0x92, 0x90, 0x25, 0xe1,
-	0x22, 0x8a, 0x17, 0x9b, 0x29, 0xba, 0xb6, 0xad, 0x47, 0x80, 0x3a, 0x4c, 0xd8, 0xe1, 0x94, 0x71,
-	0x11, 0x25, 0x37, 0x95, 0xe3, 0x77, 0x03, 0x0e, 0xb7, 0xdc, 0x6f, 0x53, 0x0a, 0x0b, 0xea, 0xbe,
-	0x26, 0xc8, 0x0d, 0xf6, 0x2d, 0x4c, 0x8e, 0x85, 0xcc, 0x26, 0x51, 0x36, 0xd7, 0x37, 0x88, 0xf5,
-	0x25, 0xd4, 0x3a, 0x4c, 0x48, 0xd7, 0xb3, 0x6b, 0x12, 0xe5, 0xa7, 0x84, 0xb1, 0x3d, 0x76, 0x7e,
-	0x54, 0x01, 0x67, 0x8e, 0xb7, 0x0b, 0x78, 0x6b, 0xe4, 0x15, 0xde, 0x1a, 0x79, 0xd6, 0x44, 0x3d,
-	0x85, 0x54, 0x61, 0x59, 0xfd, 0x8e, 0xa0, 0x12, 0x27, 0xec, 0x55, 0x6e, 0x46, 0xae, 0xed, 0x74,
-	0xe2, 0xb1, 0x57, 0x64, 0x79, 0x35, 0x61, 0x49, 0xf6, 0x7f, 0xb9, 0x41, 0xd6, 0x43, 0x25, 0x4d,
-	0x5a, 0xad, 0xad, 0x44, 0xb5, 0x3b, 0xbb, 0xe3, 0x63, 0xea, 0xef, 0x83, 0x2f, 0xec, 0xe1, 0x5f,
-	0x05, 0xa8, 0xe7, 0xa9, 0x50, 0x19, 0x0a, 0xde, 0x33, 0xf3, 0x13, 0x54, 0x87, 0xca, 0xb9, 0x4d,
-	0xce, 0x71, 0x0f, 0x3b, 0xa6, 0x81, 0x6a, 0xb0, 0x3b, 0x22, 0xcf, 0x88, 0xf7, 0x1d, 0x31, 0x0b,
-	0xe8, 0x53, 0x30, 0x5d, 0xf2, 0xdc, 0xee, 0xb9, 0xce, 0xd8, 0xa6, 0x9d, 0xd1, 0x05, 0x26, 0x43,
-	0xb3, 0x88, 0xee, 0xc0, 0x81, 0x83, 0x6d, 0xa7, 0xe7, 0x12, 0x3c, 0xc6, 0x2f, 0xce, 0x31, 0x76,
-	0xb0, 0x63, 0x96, 0x50, 0x03, 0xaa, 0xc4, 0x1b, 0x8e, 0x9f, 0x7a, 0x23, 0xe2, 0x98, 0x3b, 0x08,
-	0xc1, 0x9e, 0xdd, 0xa3, 0xd8, 0x76, 0xbe, 0x1f, 0xe3, 0x17, 0xee, 0x60, 0x38, 0x30, 0xcb, 0xf2,
-	0x64, 0x1f, 0xd3, 0x0b, 0x77, 0x30, 0x70, 0x3d, 0x32, 0x76, 0x30, 0x71, 0xb1, 0x63, 0xee, 0xa2,
-	0xbb, 0x80, 0x28, 0x1e, 0x78, 0x23, 0x7a, 0x2e, 0x09, 0xbb, 0xf6, 0x68, 0x30, 0xc4, 0x8e, 0x59,
-	0x41, 0xf7, 0xe0, 0xf0, 0xa9, 0xed, 0xf6, 0xb0, 0x33, 0xee, 0x53, 0x7c, 0xee, 0x11, 0xc7, 0x1d,
-	0xba, 0x1e, 0x31, 0xab, 0x32, 0x48, 0xfb, 0xcc, 0xa3, 0xd2, 0x0b, 0x90, 0x09, 0x75, 0x6f, 0x34,
-	0x1c, 0x7b, 0x4f, 0xc7, 0xd4, 0x26, 0x1d, 0x6c, 0xd6, 0xd0, 0x01, 0x34, 0x46, 0xc4, 0xbd, 0xe8,
-	0xf7, 0xb0, 0x8c, 0x18, 0x3b, 0x66, 0x5d, 0x26, 0xe9, 0x92, 0x21, 0xa6, 0xc4, 0xee, 0x99, 0x0d,
-	0xb4, 0x0f, 0xb5, 0x11, 0xb1, 0x9f, 0xdb, 0x6e, 0xcf, 0x3e, 0xeb, 0x61, 0x73, 0x4f, 0xc6, 0xee,
-	0xd8, 0x43, 0x7b, 0xdc, 0xf3, 0x06, 0x03, 0x73, 0x1f, 0x1d, 0xc2, 0xfe, 0x88, 0xd8, 0xa3, 0x61,
-	0x17, 0x93, 0xa1, 0x7b, 0x6e, 0x4b, 0x0a, 0xf3, 0xac, 0xf5, 0xc3, 0xf1, 0x3c, 0x10, 0x8b, 0xe5,
-	0xe4, 0x64, 0x1a, 0x5d, 0x3d, 0xf6, 0x59, 0x32, 0x8f, 0x82, 0x28, 0xfd, 0x7d, 0xac, 0x3a, 0x35,
-	0x29, 0xab, 0xef, 0xc4, 0x27, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x9f, 0x40, 0xe2, 0x3e,
-	0x0b, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x72, 0xda, 0xc6,
+	0x1a, 0x3f, 0x02, 0x8c, 0xe1, 0x03, 0x6c, 0x79, 0x39, 0x49, 0x18, 0x9f, 0x8c, 0x0f, 0xa3, 0xc9,
+	0x9c, 0x43, 0xd3, 0x8c, 0xd3, 0x71, 0xee, 0x3b, 0x23, 0x5b, 0x0a, 0xa8, 0xc1, 0x2b, 0x66, 0x81,
+	0x34, 0xcd, 0x0d, 0x15, 0xb0, 0x01, 0x35, 0xb6, 0x56, 0xd5, 0x2e, 0x89, 0x9d, 0x9b, 0xce, 0xf4,
+	0xa2, 0x6f, 0xd0, 0x57, 0xe8, 0x63, 0xf4, 0x01, 0xfa, 0x4e, 0x9d, 0xe9, 0xec, 0x6a, 0x05, 0x22,
+	0x7f, 0xea, 0xa9, 0x27, 0x57, 0xec, 0xef, 0xdb, 0xef, 0xff, 0xf7, 0xdb, 0x0f, 0x41, 0x35, 0x3e,
+	0x89, 0x8f, 0xe3, 0x84, 0x09, 0x86, 0x76, 0xc4, 0x75, 0x4c, 0xf9, 0xa1, 0x39, 0xbd, 0x60, 0xb3,
+	0xd7, 0xb3, 0x65, 0x10, 0x46, 0xe9, 0xc5, 0x21, 0x44, 0x6c, 0x4e, 0xd3, 0xb3, 0xf5, 0xa7, 0x01,
+	0xd5, 0x73, 0xbe, 0xe8, 0xd1, 0x60, 0x4e, 0x13, 0xf4, 0x00, 0x1a, 0xb3, 0x8b, 0x90, 0x46, 0xe2,
+	0x39, 0x4d, 0x78, 0xc8, 0xa2, 0x96, 0xd1, 0x36, 0x3a, 0x55, 0xb2, 0x2d, 0x44, 0xf7, 0xa1, 0x2a,
+	0xc2, 0x4b, 0xca, 0x45, 0x70, 0x19, 0xb7, 0x0a, 0x6d, 0xa3, 0x53, 0x24, 0x1b, 0x01, 0xda, 0x83,
+	0x42, 0x38, 0x6f, 0x15, 0x95, 0x61, 0x21, 0x9c, 0xa3, 0xbb, 0x50, 0x5e, 0x30, 0xce, 0xc3, 0xb8,
+	0x55, 0x6a, 0x1b, 0x9d, 0x0a, 0xd1, 0x48, 0xca, 0x63, 0x4a, 0x13, 0xcf, 0x69, 0xed, 0xb4, 0x8d,
+	0x4e, 0x9d, 0x68, 0x84, 0x8e, 0x40, 0xe5, 0x37, 0x58, 0x4d, 0x9f, 0xd1, 0xeb, 0x56, 0x59, 0xdd,
+	0xe5, 0x24, 0x08, 0x41, 0x89, 0x87, 0x8b, 0xa8, 0xb5, 0xab, 0x6e, 0xd4, 0x19, 0xb5, 0xa1, 0xc6,
+	0x57, 0x53, 0x55, 0xd1, 0x8c, 0x5d, 0xb4, 0x2a, 0x6d, 0xa3, 0xd3, 0x20, 0x79, 0x91, 0x8c, 0x76,
+	0x41, 0xa3, 0x85, 0x58, 0xb6, 0xaa, 0xea, 0x52, 0x23, 0xeb, 0x1b, 0x80, 0xc1, 0xc9, 0xe0, 0x9c,
+	0x72, 0x1e, 0x2c, 0x28, 0xea, 0x40, 0x79, 0xa9, 0x3a, 0xa1, 0x0a, 0xaf, 0x9d, 0x98, 0xc7, 0xaa,
+	0x87, 0xc7, 0xeb, 0x0e, 0x11, 0x7d, 0x2f, 0xb3, 0x98, 0x07, 0x22, 0x50, 0xe5, 0xd7, 0x89, 0x3a,
+	0x5b, 0x3e, 0x94, 0x06, 0x61, 0xb4, 0x40, 0xff, 0x83, 0xfd, 0x29, 0xe5, 0x62, 0xa2, 0x1a, 0x3f,
+	0x59, 0x06, 0x7c, 0xa9, 0xdc, 0xd5, 0x49, 0x43, 0x8a, 0x4f, 0xa5, 0xb4, 0x17, 0xf0, 0x25, 0xfa,
+	0x2f, 0xd4, 0x94, 0xde, 0x92, 0x86, 0x8b, 0xa5, 0x50, 0xae, 0x4a, 0x04, 0xa4, 0xa8, 0xa7, 0x24,
+	0x56, 0x1f, 0x4a, 0x03, 0x16, 0x2d, 0xe4, 0x58, 0xb6, 0x2c, 0x3f, 0xee, 0xee, 0x08, 0x72, 0xb6,
+	0x1f, 0xf1, 0xf6, 0x87, 0x01, 0xe5, 0xa1, 0x08, 0xc4, 0x8a, 0xa3, 0x87, 0x50, 0xe6, 0x34, 0xda,
+	0xd4, 0x89, 0x74, 0x9d, 0x03, 0x4a, 0x13, 0x7b, 0x3e, 0x4f, 0x28, 0xe7, 0x44, 0x6b, 0x7c, 0x18,
+	0xbc, 0x70, 0x73, 0xf0, 0xe2, 0xfb, 0xc1, 0x51, 0x0b, 0x76, 0x15, 0x05, 0x3d, 0x47, 0xd1, 0xa0,
+	0x4e, 0x32, 0x88, 0x0e, 0xa1, 0x12, 0x31, 0xf7, 0x2a, 0x66, 0x9c, 0x2a, 0x26, 0x54, 0xc8, 0x1a,
+	0x4b, 0xab, 0x37, 0x9a, 0x89, 0x65, 0x45, 0xa8, 0x0c, 0x5a, 0x1d, 0xa8, 0x77, 0x99, 0xfd, 0x36,
+	0xb8, 0xc6, 0x4c, 0x84, 0x33, 0xa5, 0x79, 0x99, 0x0e, 0x51, 0x73, 0x36, 0x83, 0xd6, 0x0b, 0x30,
+	0x75, 0x49, 0x94, 0x13, 0xfa, 0xe3, 0x8a, 0x72, 0xf1, 0x8f, 0xea, 0x97, 0x9e, 0x83, 0xab, 0x61,
+	0xf8, 0x8e, 0xaa, 0xca, 0x1b, 0x24, 0x83, 0xd6, 0x0f, 0x70, 0x90, 0xf3, 0xcc, 0x63, 0x16, 0x71,
+	0x8a, 0xbe, 0x84, 0x32, 0x57, 0x4d, 0x56, 0xae, 0xf7, 0x4e, 0x9a, 0xda, 0x35, 0xa1, 0x7c, 0x75,
+	0x21, 0xd2, 0xfe, 0x13, 0xad, 0x82, 0x3a, 0xb0, 0x23, 0x59, 0xcf, 0x5b, 0x85, 0x76, 0xf1, 0x13,
+	0x69, 0xa4, 0x0a, 0x56, 0x0f, 0xf6, 0x30, 0x7d, 0xab, 0xfa, 0xad, 0x2b, 0xbe, 0x0f, 0xd5, 0xe9,
+	0x7b, 0x84, 0xd8, 0x08, 0x64, 0xd6, 0xd3, 0x54, 0x59, 0x33, 0x21, 0x83, 0x16, 0x87, 0xa6, 0x72,
+	0x33, 0x48, 0xd8, 0x7c, 0x35, 0xa3, 0x73, 0xed, 0xee, 0x08, 0x20, 0x4e, 0x25, 0xf2, 0x49, 0xa6,
+	0xfe, 0x72, 0x92, 0x4f, 0x3b, 0x44, 0x16, 0xec, 0xa8, 0xa3, 0x9a, 0x7a, 0xed, 0xa4, 0xae, 0x8b,
+	0x50, 0x41, 0x48, 0x7a, 0x65, 0xfd, 0x6c, 0xc0, 0xdd, 0x2e, 0xd5, 0x7c, 0x51, 0x2f, 0x68, 0x3d,
+	0x0b, 0x04, 0xa5, 0xdc, 0x13, 0x51, 0x67, 0xf9, 0x5a, 0xb7, 0x1e, 0x85, 0x46, 0x52, 0xce, 0x5e,
+	0xbd, 0xe2, 0x34, 0x63, 0x98, 0x46, 0xe9, 0x4e, 0x78, 0x47, 0x15, 0xb5, 0x1a, 0x44, 0x9d, 0x91,
+	0x09, 0xc5, 0x80, 0xcf, 0x34, 0xa5, 0xe4, 0xd1, 0xfa, 0xcd, 0x80, 0x7b, 0x1f, 0x24, 0x71, 0x9b,
+	0xb1, 0xc9, 0xf4, 0x02, 0xbe, 0xa4, 0xe9, 0xdc, 0xea, 0x44, 0x23, 0xf4, 0x08, 0x76, 0xd3, 0xf5,
+	0xc0, 0x5b, 0xc5, 0xad, 0x81, 0xe6, 0x42, 0x92, 0x4c, 0x45, 0x76, 0x74, 0x19, 0x70, 0x4c, 0xaf,
+	0x84, 0xde, 0x8c, 0x19, 0xb4, 0xbe, 0x80, 0xfd, 0x2c, 0xcf, 0xac, 0x4b, 0x9b, 0x90, 0x46, 0x3e,
+	0xa4, 0xf5, 0x13, 0x98, 0x1b, 0xd5, 0xdb, 0xd4, 0xf2, 0x00, 0xca, 0x6a, 0x44, 0x19, 0x07, 0xb7,
+	0xc7, 0xa7, 0xef, 0xf2, 0xb9, 0x16, 0xb7, 0x73, 0x7d, 0x02, 0x77, 0x30, 0x7d, 0x3b, 0x4a, 0x82,
+	0x88, 0x07, 0x33, 0x11, 0xb2, 0x88, 0x6b, 0x42, 0x1d, 0x42, 0x45, 0x5c, 0xf5, 0xf2, 0x39, 0xaf,
+	0xb1, 0xf5, 0x95, 0x62, 0x43, 0xde, 0xe8, 0xa6, 0x3a, 0x7f, 0x4d, 0x67, 0xb7, 0x6d, 0xf2, 0x39,
+	0x67, 0xf7, 0x1f, 0x28, 0x8a, 0xab, 0x6c, 0x6e, 0x55, 0xed, 0x61, 0x74, 0x45, 0xa4, 0xf4, 0x6f,
+	0x46, 0xd5, 0x85, 0x83, 0x2e, 0x15, 0xe7, 0x21, 0xe7, 0x61, 0xb4, 0xb8, 0xa1, 0x08, 0xd9, 0x12,
+	0x2e, 0x58, 0xbc, 0xdc, 0x6c, 0xd1, 0x35, 0xb6, 0x1e, 0x01, 0xea, 0x52, 0x61, 0x47, 0x33, 0xca,
+	0x05, 0x4b, 0x6e, 0x6a, 0xc7, 0x2f, 0x06, 0x34, 0xb7, 0xd4, 0x6f, 0xd3, 0x0a, 0x0b, 0xea, 0x81,
+	0x76, 0x90, 0x5b, 0xec, 0x5b, 0x32, 0xb9, 0x16, 0x32, 0x8c, 0x59, 0xb6, 0xd7, 0x37, 0x12, 0xeb,
+	0xff, 0x50, 0xeb, 0x52, 0x21, 0x55, 0x4f, 0xaf, 0x31, 0xcb, 0x6f, 0x09, 0x63, 0x7b, 0xed, 0x7c,
+	0x0f, 0xcd, 0x9c, 0xe2, 0xed, 0x12, 0xde, 0x5a, 0x79, 0x85, 0xf7, 0x56, 0x9e, 0x35, 0x55, 0x4f,
+	0x21, 0x65, 0x58, 0xd6, 0xbf, 0x43, 0xa8, 0xc4, 0x09, 0x7d, 0x93, 0xdb, 0x91, 0x6b, 0x9c, 0x6e,
+	0x3c, 0xfa, 0x06, 0xaf, 0x2e, 0xa7, 0x34, 0xc9, 0xfe, 0x2f, 0x37, 0x92, 0xf5, 0x52, 0x49, 0x8b,
+	0x56, 0x67, 0x2b, 0x51, 0xe3, 0xce, 0x62, 0x7c, 0x4e, 0xfe, 0x7d, 0xfa, 0x85, 0x7d, 0xad, 0x98,
+	0x31, 0x7c, 0x4d, 0x2f, 0xa8, 0x60, 0x51, 0x56, 0x99, 0x09, 0x45, 0xc1, 0x62, 0xdd, 0x65, 0x79,
+	0x94, 0x9e, 0xa7, 0x4c, 0x08, 0x76, 0x99, 0x2d, 0xcd, 0x14, 0x59, 0x2f, 0xa1, 0xb9, 0x65, 0xff,
+	0x19, 0xb3, 0x7e, 0xf8, 0x7b, 0x01, 0xea, 0x79, 0x03, 0x54, 0x86, 0x82, 0xff, 0xcc, 0xfc, 0x17,
+	0xaa, 0x43, 0xe5, 0xcc, 0xc6, 0x67, 0x6e, 0xdf, 0x75, 0x4c, 0x03, 0xd5, 0x60, 0x77, 0x8c, 0x9f,
+	0x61, 0xff, 0x5b, 0x6c, 0x16, 0xd0, 0xbf, 0xc1, 0xf4, 0xf0, 0x73, 0xbb, 0xef, 0x39, 0x13, 0x9b,
+	0x74, 0xc7, 0xe7, 0x2e, 0x1e, 0x99, 0x45, 0x74, 0x07, 0x0e, 0x1c, 0xd7, 0x76, 0xfa, 0x1e, 0x76,
+	0x27, 0xee, 0x8b, 0x33, 0xd7, 0x75, 0x5c, 0xc7, 0x2c, 0xa1, 0x06, 0x54, 0xb1, 0x3f, 0x9a, 0x3c,
+	0xf5, 0xc7, 0xd8, 0x31, 0x77, 0x10, 0x82, 0x3d, 0xbb, 0x4f, 0x5c, 0xdb, 0xf9, 0x6e, 0xe2, 0xbe,
+	0xf0, 0x86, 0xa3, 0xa1, 0x59, 0x96, 0x96, 0x03, 0x97, 0x9c, 0x7b, 0xc3, 0xa1, 0xe7, 0xe3, 0x89,
+	0xe3, 0x62, 0xcf, 0x75, 0xcc, 0x5d, 0x74, 0x17, 0x10, 0x71, 0x87, 0xfe, 0x98, 0x9c, 0x49, 0x87,
+	0x3d, 0x7b, 0x3c, 0x1c, 0xb9, 0x8e, 0x59, 0x41, 0xf7, 0xa0, 0xf9, 0xd4, 0xf6, 0xfa, 0xae, 0x33,
+	0x19, 0x10, 0xf7, 0xcc, 0xc7, 0x8e, 0x37, 0xf2, 0x7c, 0x6c, 0x56, 0x65, 0x92, 0xf6, 0xa9, 0x4f,
+	0xa4, 0x16, 0x20, 0x13, 0xea, 0xfe, 0x78, 0x34, 0xf1, 0x9f, 0x4e, 0x88, 0x8d, 0xbb, 0xae, 0x59,
+	0x43, 0x07, 0xd0, 0x18, 0x63, 0xef, 0x7c, 0xd0, 0x77, 0x65, 0xc6, 0xae, 0x63, 0xd6, 0x65, 0x91,
+	0x1e, 0x1e, 0xb9, 0x04, 0xdb, 0x7d, 0xb3, 0x81, 0xf6, 0xa1, 0x36, 0xc6, 0xf6, 0x73, 0xdb, 0xeb,
+	0xdb, 0xa7, 0x7d, 0xd7, 0xdc, 0x93, 0xb9, 0x3b, 0xf6, 0xc8, 0x9e, 0xf4, 0xfd, 0xe1, 0xd0, 0xdc,
+	0x47, 0x4d, 0xd8, 0x1f, 0x63, 0x7b, 0x3c, 0xea, 0xb9, 0x78, 0xe4, 0x9d, 0xd9, 0xd2, 0x85, 0x79,
+	0xda, 0x7e, 0x79, 0xb4, 0x08, 0xc5, 0x72, 0x35, 0x3d, 0x9e, 0xb1, 0xcb, 0xc7, 0x01, 0x4d, 0x16,
+	0x2c, 0x64, 0xe9, 0xef, 0x63, 0x35, 0x8f, 0x69, 0x59, 0x7d, 0xc3, 0x3e, 0xf9, 0x6b, 0x00, 0x0c,
+	0x5d, 0xa2, 0x63, 0xda, 0x0b, 0x00, 0x00,
 }
@@ -2,12 +2,12 @@ package types
 
 import (
 	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
-	"reflect"
 	"strconv"
 
 	"github.com/aergoio/aergo/internal/enc"
@@ -21,6 +21,13 @@ const (
 	successStatus = 0
 	createdStatus = 1
 	errorStatus   = 2
+
+	// gasUsedFlag is set in the status byte of a receipt carrying the gas
+	// used, so that receipts stored before gas metering read unchanged.
+	gasUsedFlag = 0x80
+	// feeDelegationFlag is set in the status byte of a receipt whose fee was
+	// paid by the called contract.
+	feeDelegationFlag = 0x40
 )
 
 func NewReceipt(contractAddress []byte, status string, jsonRet string) *Receipt {
@@ -45,7 +52,17 @@ func (r *Receipt) marshalBody(b *bytes.Buffer, isMerkle bool) error {
 	default:
 		return errors.New("unsupported status in receipt")
 	}
-	b.WriteByte(status)
+	flags := status
+	if r.FeeDelegation {
+		flags |= feeDelegationFlag
+	}
+	if r.GasUsed != 0 {
+		b.WriteByte(flags | gasUsedFlag)
+		binary.LittleEndian.PutUint64(l, r.GasUsed)
+		b.Write(l)
+	} else {
+		b.WriteByte(flags)
+	}
 	if !isMerkle || status != errorStatus {
 		binary.LittleEndian.PutUint32(l[:4], uint32(len(r.Ret)))
 		b.Write(l[:4])
@@ -92,6 +109,16 @@ func (r *Receipt) marshalStoreBinary() ([]byte, error) {
 func (r *Receipt) unmarshalBody(data []byte) ([]byte, uint32) {
 	r.ContractAddress = data[:33]
 	status := data[33]
+	pos := uint32(34)
+	if status&gasUsedFlag != 0 {
+		r.GasUsed = binary.LittleEndian.Uint64(data[pos:])
+		pos += 8
+		status &^= gasUsedFlag
+	}
+	if status&feeDelegationFlag != 0 {
+		r.FeeDelegation = true
+		status &^= feeDelegationFlag
+	}
 	switch status {
 	case successStatus:
 		r.Status = "SUCCESS"
@@ -100,7 +127,6 @@ func (r *Receipt) unmarshalBody(data []byte) ([]byte, uint32) {
 	case errorStatus:
 		r.Status = "ERROR"
 	}
-	pos := uint32(34)
 	l := binary.LittleEndian.Uint32(data[pos:])
 	pos += 4
 	r.Ret = string(data[pos : pos+l])
@@ -235,6 +261,13 @@ func (r *Receipt) MarshalJSON() ([]byte, error) {
 	b.WriteString(EncodeAddress(r.To))
 	b.WriteString(`","usedFee":`)
 	b.WriteString(new(big.Int).SetBytes(r.FeeUsed).String())
+	if r.GasUsed != 0 {
+		b.WriteString(`,"gasUsed":`)
+		b.WriteString(strconv.FormatUint(r.GasUsed, 10))
+	}
+	if r.FeeDelegation {
+		b.WriteString(`,"feeDelegation":true`)
+	}
 	b.WriteString(`,"events":[`)
 	for i, ev := range r.Events {
 		if i != 0 {
@@ -306,19 +339,54 @@ func (bf *bloomFilter) GetHash() []byte {
 type Receipts struct {
 	bloom    *bloomFilter
 	receipts []*Receipt
+	// events are emitted by the block itself rather than by any of its txs.
+	// They are committed to the merkle root only through the bloom filter.
+	events []*Event
+	// packed is the compressed receipts and events read from the storage,
+	// which are decoded on the first access. Its decoding error is kept in
+	// packedErr.
+	packed    []byte
+	packedErr error
 }
 
 func (rs *Receipts) Get() []*Receipt {
 	if rs == nil {
 		return nil
 	}
+	rs.unpack()
 	return rs.receipts
 }
 
 func (rs *Receipts) Set(receipts []*Receipt) {
+	rs.unpack()
 	rs.receipts = receipts
 }
 
+// BlockEvents returns the events emitted by the block itself.
+func (rs *Receipts) BlockEvents() []*Event {
+	if rs == nil {
+		return nil
+	}
+	rs.unpack()
+	return rs.events
+}
+
+// AddBlockEvents adds events emitted by the block itself.
+func (rs *Receipts) AddBlockEvents(events ...*Event) {
+	rs.unpack()
+	rs.events = append(rs.events, events...)
+}
+
+// Err returns the error of decoding the packed receipts, which happens on
+// the first access of them.
+func (rs *Receipts) Err() error {
+	if rs == nil {
+		return nil
+	}
+	rs.unpack()
+	return rs.packedErr
+}
+
 const BloomBitByte = 256
 const BloomBitBits = BloomBitByte * 8
 const BloomHashKNum = 3
@@ -346,6 +414,7 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs == nil {
 		return merkle.CalculateMerkleRoot(nil)
 	}
+	rs.unpack()
 	rsSize := len(rs.receipts)
 	if rs.bloom != nil {
 		rsSize++
@@ -361,6 +430,10 @@ func (rs *Receipts) MerkleRoot() []byte {
 }
 
 func (rs *Receipts) MarshalBinary() ([]byte, error) {
+	rs.unpack()
+	if rs.packedErr != nil {
+		return nil, rs.packedErr
+	}
 	var b bytes.Buffer
 	l := make([]byte, 4)
 
@@ -383,6 +456,20 @@ func (rs *Receipts) MarshalBinary() ([]byte, error) {
 		}
 		b.Write(rB)
 	}
+	// the block events are appended only when present, which keeps the
+	// format stored before them
+	if len(rs.events) > 0 {
+		binary.LittleEndian.PutUint32(l, uint32(len(rs.events)))
+		b.Write(l)
+		noReceipt := &Receipt{}
+		for _, ev := range rs.events {
+			evB, err := ev.marshalStoreBinary(noReceipt)
+			if err != nil {
+				return nil, err
+			}
+			b.Write(evB)
+		}
+	}
 
 	return b.Bytes(), nil
 }
@@ -421,9 +508,111 @@ func (rs *Receipts) UnmarshalBinary(data []byte) error {
 		}
 		rs.receipts[i] = &r
 	}
+	if len(unread) < 4 {
+		return nil
+	}
+	evCount := binary.LittleEndian.Uint32(unread)
+	unread = unread[4:]
+	rs.events = make([]*Event, evCount)
+	noReceipt := &Receipt{}
+	for i := uint32(0); i < evCount; i++ {
+		var ev Event
+		unread, err = ev.unmarshalStoreBinary(unread, noReceipt)
+		if err != nil {
+			return err
+		}
+		rs.events[i] = &ev
+	}
 	return nil
 }
 
+// receiptsPacked is the first byte of the packed receipts. The receipts
+// stored before the packing are gob encoded, whose first byte is the length
+// of a message and never 0.
+const receiptsPacked = 0
+
+// IsPackedReceipts reports whether data is packed by MarshalPacked.
+func IsPackedReceipts(data []byte) bool {
+	return len(data) > 0 && data[0] == receiptsPacked
+}
+
+// MarshalPacked returns the binary of the receipts with the receipts and the
+// events compressed. The bloom filter is left uncompressed, so the filtering
+// of the events by it doesn't decode the receipts. It also returns the size of
+// the binary before the compression.
+func (rs *Receipts) MarshalPacked() ([]byte, int, error) {
+	raw, err := rs.MarshalBinary()
+	if err != nil {
+		return nil, 0, err
+	}
+	hdr := bloomHeaderSize(raw)
+
+	var b bytes.Buffer
+	b.WriteByte(receiptsPacked)
+	b.Write(raw[:hdr])
+	w, err := flate.NewWriter(&b, flate.DefaultCompression)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, err := w.Write(raw[hdr:]); err != nil {
+		return nil, 0, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, 0, err
+	}
+	return b.Bytes(), len(raw), nil
+}
+
+// UnmarshalPacked reads the bloom filter of the packed receipts. The
+// receipts and the events are decoded on the first access of them.
+func (rs *Receipts) UnmarshalPacked(data []byte) error {
+	if !IsPackedReceipts(data) {
+		return errors.New("receipts are not packed")
+	}
+	data = data[1:]
+	if len(data) == 0 || (data[0] == 1 && len(data) < 1+BloomBitByte) {
+		return errors.New("packed receipts are truncated")
+	}
+	hdr := bloomHeaderSize(data)
+	// the bloom filter only, with no receipt
+	bloomOnly := append(append([]byte{}, data[:hdr]...), 0, 0, 0, 0)
+	*rs = Receipts{}
+	if err := rs.UnmarshalBinary(bloomOnly); err != nil {
+		return err
+	}
+	rs.receipts = nil
+	rs.packed = data
+	return nil
+}
+
+func bloomHeaderSize(raw []byte) int {
+	if raw[0] == 1 {
+		return 1 + BloomBitByte
+	}
+	return 1
+}
+
+func (rs *Receipts) unpack() {
+	if rs.packed == nil {
+		return
+	}
+	packed := rs.packed
+	rs.packed = nil
+
+	hdr := bloomHeaderSize(packed)
+	var raw bytes.Buffer
+	raw.Write(packed[:hdr])
+	r := flate.NewReader(bytes.NewReader(packed[hdr:]))
+	defer r.Close()
+	if _, err := raw.ReadFrom(r); err != nil {
+		rs.packedErr = fmt.Errorf("failed to decompress receipts: %v", err)
+		return
+	}
+	if err := rs.UnmarshalBinary(raw.Bytes()); err != nil {
+		rs.packedErr = fmt.Errorf("failed to decode receipts: %v", err)
+	}
+}
+
 func (ev *Event) marshalCommonBinary(b *bytes.Buffer) {
 	l := make([]byte, 4)
 	b.Write(ev.ContractAddress)
@@ -560,7 +749,9 @@ func (ev *Event) MarshalJSON() ([]byte, error) {
 }
 
 func (ev *Event) SetMemoryInfo(receipt *Receipt, blkHash []byte, blkNo BlockNo, txIdx int32) {
-	ev.TxHash = receipt.TxHash
+	if receipt != nil {
+		ev.TxHash = receipt.TxHash
+	}
 	ev.TxIndex = txIdx
 	ev.BlockHash = blkHash
 	ev.BlockNo = blkNo
@@ -584,30 +775,7 @@ func (ev *Event) Filter(filter *FilterInfo, argFilter []ArgFilter) bool {
 			if filter.argNo >= argLen {
 				continue
 			}
-			value := args[filter.argNo]
-			check := filter.value
-			if reflect.TypeOf(value) != reflect.TypeOf(check) {
-				return false
-			}
-			switch value.(type) {
-			case string:
-				if value.(string) != check.(string) {
-					return false
-				}
-			case float64:
-				if value.(float64) != check.(float64) {
-					return false
-				}
-			case bool:
-				if value.(bool) != check.(bool) {
-					return false
-				}
-			case json.Number:
-				if value.(json.Number) != check.(json.Number) {
-					return false
-				}
-			case nil:
-			default:
+			if !filter.match(args[filter.argNo]) {
 				return false
 			}
 		}
@@ -616,9 +784,13 @@ func (ev *Event) Filter(filter *FilterInfo, argFilter []ArgFilter) bool {
 	return true
 }
 
+// ArgFilter matches an argument of the events by the value or, if the bounds
+// are set, by the range of numbers.
 type ArgFilter struct {
 	argNo int
 	value interface{}
+	lower *rangeBound
+	upper *rangeBound
 }
 
 const MAXBLOCKRANGE = 10000
@@ -661,6 +833,45 @@ func (fi *FilterInfo) ValidateCheck(to uint64) error {
 	return nil
 }
 
+// ValidateCheck checks the contract addresses of the filter, padding the
+// names of the contracts like FilterInfo.
+func (fi *EventStreamFilter) ValidateCheck() error {
+	for i, addr := range fi.ContractAddresses {
+		if len(addr) == 0 || len(addr) > AddressLength {
+			return errors.New("invalid contractAddress:" + string(addr))
+		}
+		if len(addr) < AddressLength {
+			fi.ContractAddresses[i] = AddressPadding(addr)
+		}
+	}
+	_, err := fi.GetExArgFilter()
+	return err
+}
+
+func (fi *EventStreamFilter) GetExArgFilter() ([]ArgFilter, error) {
+	return (&FilterInfo{ArgFilter: fi.ArgFilter}).GetExArgFilter()
+}
+
+// Match returns whether the event is of any of the contracts with any of the
+// names of the filter and its arguments match argFilter.
+func (fi *EventStreamFilter) Match(ev *Event, argFilter []ArgFilter) bool {
+	contracts, names := fi.ContractAddresses, fi.EventNames
+	if len(contracts) == 0 {
+		contracts = [][]byte{nil}
+	}
+	if len(names) == 0 {
+		names = []string{""}
+	}
+	for _, contract := range contracts {
+		for _, name := range names {
+			if ev.Filter(&FilterInfo{ContractAddress: contract, EventName: name}, argFilter) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (fi *FilterInfo) GetExArgFilter() ([]ArgFilter, error) {
 	if len(fi.ArgFilter) == 0 {
 		return nil, nil
@@ -680,6 +891,15 @@ func (fi *FilterInfo) GetExArgFilter() ([]ArgFilter, error) {
 			return nil, errors.New("invalid argument number:" + key)
 		}
 		argFilter[i].argNo = int(idx)
+		if bounds, ok := value.(map[string]interface{}); ok {
+			if _, ok := bignumOf(bounds); !ok {
+				if err := argFilter[i].parseRange(bounds); err != nil {
+					return nil, err
+				}
+				i++
+				continue
+			}
+		}
 		argFilter[i].value = value
 		i++
 	}
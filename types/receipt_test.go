@@ -0,0 +1,157 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
+)
+
+func TestReceiptBinary(t *testing.T) {
+	newReceipt := func(gasUsed uint64) *Receipt {
+		r := NewReceipt(make([]byte, 33), "SUCCESS", `{"ret":1}`)
+		r.TxHash = make([]byte, 32)
+		r.FeeUsed = []byte{1, 2}
+		r.GasUsed = gasUsed
+		return r
+	}
+
+	for _, gasUsed := range []uint64{0, 1, 12345678} {
+		r := newReceipt(gasUsed)
+		b, err := r.MarshalBinary()
+		assert.NoError(t, err, "marshal receipt")
+
+		var read Receipt
+		assert.NoError(t, read.UnmarshalBinary(b), "unmarshal receipt")
+		assert.Equal(t, gasUsed, read.GasUsed, "gas used")
+		assert.Equal(t, "SUCCESS", read.Status, "status")
+		assert.Equal(t, r.Ret, read.Ret, "ret")
+		assert.Equal(t, r.FeeUsed, read.FeeUsed, "fee used")
+	}
+
+	r := newReceipt(7)
+	r.Status = "ERROR"
+	r.FeeDelegation = true
+	b, err := r.MarshalBinary()
+	assert.NoError(t, err, "marshal receipt")
+	var read Receipt
+	assert.NoError(t, read.UnmarshalBinary(b), "unmarshal receipt")
+	assert.True(t, read.FeeDelegation, "fee delegation")
+	assert.Equal(t, "ERROR", read.Status, "status")
+	assert.Equal(t, uint64(7), read.GasUsed, "gas used")
+
+	// a receipt without gas used keeps the format stored before gas metering
+	withoutGas, _ := newReceipt(0).MarshalMerkleBinary()
+	withGas, _ := newReceipt(1).MarshalMerkleBinary()
+	assert.Equal(t, len(withoutGas)+8, len(withGas), "gas used is appended only when set")
+}
+
+func TestReceiptsBlockEvents(t *testing.T) {
+	r := NewReceipt(make([]byte, 33), "SUCCESS", `{}`)
+	r.TxHash = make([]byte, 32)
+
+	var rs Receipts
+	rs.Set([]*Receipt{r})
+	withoutEvents, err := rs.MarshalBinary()
+	assert.NoError(t, err, "marshal receipts")
+
+	rs.AddBlockEvents(&Event{
+		ContractAddress: AddressPadding([]byte(AergoSystem)),
+		EventIdx:        1,
+		EventName:       "burnFee",
+		JsonArgs:        `{"amount":"300"}`,
+	})
+	withEvents, err := rs.MarshalBinary()
+	assert.NoError(t, err, "marshal receipts")
+	assert.Equal(t, withoutEvents, withEvents[:len(withoutEvents)], "block events are appended")
+
+	var read Receipts
+	assert.NoError(t, read.UnmarshalBinary(withoutEvents), "unmarshal receipts")
+	assert.Empty(t, read.BlockEvents(), "no block events")
+	assert.NoError(t, read.UnmarshalBinary(withEvents), "unmarshal receipts")
+	assert.Len(t, read.Get(), 1, "receipts")
+	if assert.Len(t, read.BlockEvents(), 1, "block events") {
+		ev := read.BlockEvents()[0]
+		assert.Equal(t, AddressPadding([]byte(AergoSystem)), ev.ContractAddress, "address")
+		assert.Equal(t, "burnFee", ev.EventName, "name")
+		assert.Equal(t, `{"amount":"300"}`, ev.JsonArgs, "args")
+		assert.Equal(t, int32(1), ev.EventIdx, "index")
+	}
+}
+
+func TestEventStreamFilter(t *testing.T) {
+	a, b := AddressPadding([]byte("a")), AddressPadding([]byte("b"))
+	transfer := &Event{ContractAddress: a, EventName: "transfer", JsonArgs: `["x", 1]`}
+	mint := &Event{ContractAddress: b, EventName: "mint", JsonArgs: `["y", 2]`}
+
+	filter := &EventStreamFilter{ContractAddresses: [][]byte{[]byte("a"), []byte("b")}}
+	assert.NoError(t, filter.ValidateCheck(), "names are padded")
+	assert.Equal(t, a, filter.ContractAddresses[0], "padded name")
+	assert.True(t, filter.Match(transfer, nil), "any name of the contracts")
+	assert.True(t, filter.Match(mint, nil), "any name of the contracts")
+
+	filter = &EventStreamFilter{EventNames: []string{"mint"}}
+	assert.False(t, filter.Match(transfer, nil), "other name")
+	assert.True(t, filter.Match(mint, nil), "name of any contract")
+
+	filter = &EventStreamFilter{ArgFilter: []byte(`{"0": "x"}`)}
+	argFilter, err := filter.GetExArgFilter()
+	assert.NoError(t, err, "arg filter")
+	assert.True(t, filter.Match(transfer, argFilter), "matching argument")
+	assert.False(t, filter.Match(mint, argFilter), "other argument")
+
+	filter = &EventStreamFilter{ContractAddresses: [][]byte{make([]byte, AddressLength+1)}}
+	assert.Error(t, filter.ValidateCheck(), "too long address")
+}
+
+func TestReceiptsPacked(t *testing.T) {
+	var rs Receipts
+	var receipts []*Receipt
+	for i := 0; i < 20; i++ {
+		r := NewReceipt(make([]byte, 33), "SUCCESS", `{"ret":"the same result of every call"}`)
+		r.TxHash = make([]byte, 32)
+		r.GasUsed = uint64(i)
+		receipts = append(receipts, r)
+	}
+	rs.Set(receipts)
+	bf := bloom.New(BloomBitBits, BloomHashKNum)
+	bf.Add([]byte("transfer"))
+	assert.NoError(t, rs.MergeBloom(bf), "merge bloom")
+	rs.AddBlockEvents(&Event{ContractAddress: AddressPadding([]byte(AergoSystem)), EventName: "burnFee", JsonArgs: `{}`})
+	raw, err := rs.MarshalBinary()
+	assert.NoError(t, err, "marshal receipts")
+
+	packed, rawSize, err := rs.MarshalPacked()
+	assert.NoError(t, err, "pack receipts")
+	assert.True(t, IsPackedReceipts(packed), "packed")
+	assert.Equal(t, len(raw), rawSize, "raw size")
+	assert.True(t, len(packed) < len(raw), "compressed")
+
+	var read Receipts
+	assert.NoError(t, read.UnmarshalPacked(packed), "unpack receipts")
+	assert.NotNil(t, read.packed, "receipts are not decoded before the access")
+	assert.True(t, read.BloomFilter(&FilterInfo{EventName: "transfer"}), "bloom is decoded")
+	assert.NotNil(t, read.packed, "bloom filter doesn't decode the receipts")
+	assert.NoError(t, read.Err(), "decode receipts")
+	assert.Len(t, read.Get(), 20, "receipts")
+	assert.Equal(t, uint64(19), read.Get()[19].GasUsed, "gas used")
+	assert.Len(t, read.BlockEvents(), 1, "block events")
+	assert.Equal(t, rs.MerkleRoot(), read.MerkleRoot(), "merkle root")
+
+	// without a bloom filter
+	var noBloom Receipts
+	noBloom.Set(receipts[:1])
+	packed, _, err = noBloom.MarshalPacked()
+	assert.NoError(t, err, "pack receipts")
+	read = Receipts{}
+	assert.NoError(t, read.UnmarshalPacked(packed), "unpack receipts")
+	assert.Len(t, read.Get(), 1, "receipts")
+
+	// corrupted
+	packed[len(packed)-1] ^= 0xff
+	packed = packed[:len(packed)-2]
+	read = Receipts{}
+	assert.NoError(t, read.UnmarshalPacked(packed), "unpack receipts")
+	assert.Error(t, read.Err(), "corrupted receipts")
+	assert.Error(t, read.UnmarshalPacked(raw), "not packed")
+}
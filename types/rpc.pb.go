@@ -34,18 +34,20 @@ const (
 	CommitStatus_TX_INSUFFICIENT_BALANCE CommitStatus = 6
 	CommitStatus_TX_HAS_SAME_NONCE       CommitStatus = 7
 	CommitStatus_TX_INTERNAL_ERROR       CommitStatus = 9
+	CommitStatus_TX_INVALID_CHAIN_ID     CommitStatus = 10
 )
 
 var CommitStatus_name = map[int32]string{
-	0: "TX_OK",
-	1: "TX_NONCE_TOO_LOW",
-	2: "TX_ALREADY_EXISTS",
-	3: "TX_INVALID_HASH",
-	4: "TX_INVALID_SIGN",
-	5: "TX_INVALID_FORMAT",
-	6: "TX_INSUFFICIENT_BALANCE",
-	7: "TX_HAS_SAME_NONCE",
-	9: "TX_INTERNAL_ERROR",
+	0:  "TX_OK",
+	1:  "TX_NONCE_TOO_LOW",
+	2:  "TX_ALREADY_EXISTS",
+	3:  "TX_INVALID_HASH",
+	4:  "TX_INVALID_SIGN",
+	5:  "TX_INVALID_FORMAT",
+	6:  "TX_INSUFFICIENT_BALANCE",
+	7:  "TX_HAS_SAME_NONCE",
+	9:  "TX_INTERNAL_ERROR",
+	10: "TX_INVALID_CHAIN_ID",
 }
 
 var CommitStatus_value = map[string]int32{
@@ -58,6 +60,7 @@ var CommitStatus_value = map[string]int32{
 	"TX_INSUFFICIENT_BALANCE": 6,
 	"TX_HAS_SAME_NONCE":       7,
 	"TX_INTERNAL_ERROR":       9,
+	"TX_INVALID_CHAIN_ID":     10,
 }
 
 func (x CommitStatus) String() string {
@@ -598,6 +601,7 @@ type AccountAndRoot struct {
 	Account              []byte   `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
 	Root                 []byte   `protobuf:"bytes,2,opt,name=Root,proto3" json:"Root,omitempty"`
 	Compressed           bool     `protobuf:"varint,3,opt,name=Compressed,proto3" json:"Compressed,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,4,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -649,17 +653,25 @@ func (m *AccountAndRoot) GetCompressed() bool {
 	return false
 }
 
+func (m *AccountAndRoot) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
 type Peer struct {
-	Address              *PeerAddress    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Bestblock            *NewBlockNotice `protobuf:"bytes,2,opt,name=bestblock,proto3" json:"bestblock,omitempty"`
-	State                int32           `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
-	Hidden               bool            `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
-	LashCheck            int64           `protobuf:"varint,5,opt,name=lashCheck,proto3" json:"lashCheck,omitempty"`
-	Selfpeer             bool            `protobuf:"varint,6,opt,name=selfpeer,proto3" json:"selfpeer,omitempty"`
-	Version              string          `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Address              *PeerAddress        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Bestblock            *NewBlockNotice     `protobuf:"bytes,2,opt,name=bestblock,proto3" json:"bestblock,omitempty"`
+	State                int32               `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	Hidden               bool                `protobuf:"varint,4,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	LashCheck            int64               `protobuf:"varint,5,opt,name=lashCheck,proto3" json:"lashCheck,omitempty"`
+	Selfpeer             bool                `protobuf:"varint,6,opt,name=selfpeer,proto3" json:"selfpeer,omitempty"`
+	Version              string              `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	ProtocolStats        []*PeerProtocolStat `protobuf:"bytes,8,rep,name=protocolStats,proto3" json:"protocolStats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Peer) Reset()         { *m = Peer{} }
@@ -736,8 +748,17 @@ func (m *Peer) GetVersion() string {
 	return ""
 }
 
+func (m *Peer) GetProtocolStats() []*PeerProtocolStat {
+	if m != nil {
+		return m.ProtocolStats
+	}
+	return nil
+}
+
 type PeerList struct {
 	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	NextCursor           []byte   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalHint            uint64   `protobuf:"varint,3,opt,name=totalHint,proto3" json:"totalHint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -775,12 +796,27 @@ func (m *PeerList) GetPeers() []*Peer {
 	return nil
 }
 
+func (m *PeerList) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+func (m *PeerList) GetTotalHint() uint64 {
+	if m != nil {
+		return m.TotalHint
+	}
+	return 0
+}
+
 type ListParams struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	Size                 uint32   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	Asc                  bool     `protobuf:"varint,5,opt,name=asc,proto3" json:"asc,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -846,6 +882,13 @@ func (m *ListParams) GetAsc() bool {
 	return false
 }
 
+func (m *ListParams) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 type PageParams struct {
 	Offset               uint32   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	Size                 uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
@@ -1005,6 +1048,8 @@ func (m *BlockBodyParams) GetPaging() *PageParams {
 
 type BlockHeaderList struct {
 	Blocks               []*Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	NextCursor           []byte   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalHint            uint64   `protobuf:"varint,3,opt,name=totalHint,proto3" json:"totalHint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1042,6 +1087,20 @@ func (m *BlockHeaderList) GetBlocks() []*Block {
 	return nil
 }
 
+func (m *BlockHeaderList) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+func (m *BlockHeaderList) GetTotalHint() uint64 {
+	if m != nil {
+		return m.TotalHint
+	}
+	return 0
+}
+
 type BlockMetadata struct {
 	Hash                 []byte       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Header               *BlockHeader `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
@@ -1107,6 +1166,8 @@ func (m *BlockMetadata) GetSize() int64 {
 
 type BlockMetadataList struct {
 	Blocks               []*BlockMetadata `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	NextCursor           []byte           `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	TotalHint            uint64           `protobuf:"varint,3,opt,name=totalHint,proto3" json:"totalHint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1144,6 +1205,20 @@ func (m *BlockMetadataList) GetBlocks() []*BlockMetadata {
 	return nil
 }
 
+func (m *BlockMetadataList) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+func (m *BlockMetadataList) GetTotalHint() uint64 {
+	if m != nil {
+		return m.TotalHint
+	}
+	return 0
+}
+
 type CommitResult struct {
 	Hash                 []byte       `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Error                CommitStatus `protobuf:"varint,2,opt,name=error,proto3,enum=types.CommitStatus" json:"error,omitempty"`
@@ -1288,6 +1363,7 @@ func (m *VerifyResult) GetError() VerifyStatus {
 type Personal struct {
 	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	Account              *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Timeout              uint64   `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1332,6 +1408,13 @@ func (m *Personal) GetAccount() *Account {
 	return nil
 }
 
+func (m *Personal) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type ImportFormat struct {
 	Wif                  *SingleBytes `protobuf:"bytes,1,opt,name=wif,proto3" json:"wif,omitempty"`
 	Oldpass              string       `protobuf:"bytes,2,opt,name=oldpass,proto3" json:"oldpass,omitempty"`
@@ -1578,6 +1661,8 @@ func (m *AccountVoteInfo) GetVoting() []*VoteInfo {
 type VoteInfo struct {
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	Candidates           []string `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Expiry               uint64   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Remaining            uint64   `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1622,6 +1707,20 @@ func (m *VoteInfo) GetCandidates() []string {
 	return nil
 }
 
+func (m *VoteInfo) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *VoteInfo) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 type VoteList struct {
 	Votes                []*Vote  `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1764,12 +1863,14 @@ func (m *Name) GetBlockNo() uint64 {
 }
 
 type NameInfo struct {
-	Name                 *Name    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Owner                []byte   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Destination          []byte   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 *Name          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                []byte         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Destination          []byte         `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Expiry               uint64         `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Offer                *NameSaleOffer `protobuf:"bytes,5,opt,name=offer,proto3" json:"offer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *NameInfo) Reset()         { *m = NameInfo{} }
@@ -1818,9 +1919,26 @@ func (m *NameInfo) GetDestination() []byte {
 	return nil
 }
 
+func (m *NameInfo) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *NameInfo) GetOffer() *NameSaleOffer {
+	if m != nil {
+		return m.Offer
+	}
+	return nil
+}
+
 type PeersParams struct {
 	NoHidden             bool     `protobuf:"varint,1,opt,name=noHidden,proto3" json:"noHidden,omitempty"`
 	ShowSelf             bool     `protobuf:"varint,2,opt,name=showSelf,proto3" json:"showSelf,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Size                 uint32   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	WithStats            bool     `protobuf:"varint,5,opt,name=withStats,proto3" json:"withStats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1865,6 +1983,27 @@ func (m *PeersParams) GetShowSelf() bool {
 	return false
 }
 
+func (m *PeersParams) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *PeersParams) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PeersParams) GetWithStats() bool {
+	if m != nil {
+		return m.WithStats
+	}
+	return false
+}
+
 type KeyParams struct {
 	Key                  []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2085,764 +2224,5959 @@ func (m *ConsensusInfo) GetBps() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
-	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
-	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
-	proto.RegisterType((*ChainId)(nil), "types.ChainId")
-	proto.RegisterType((*ChainInfo)(nil), "types.ChainInfo")
-	proto.RegisterType((*ChainStats)(nil), "types.ChainStats")
-	proto.RegisterType((*Input)(nil), "types.Input")
-	proto.RegisterType((*Output)(nil), "types.Output")
-	proto.RegisterType((*Empty)(nil), "types.Empty")
-	proto.RegisterType((*SingleBytes)(nil), "types.SingleBytes")
-	proto.RegisterType((*AccountAddress)(nil), "types.AccountAddress")
-	proto.RegisterType((*AccountAndRoot)(nil), "types.AccountAndRoot")
-	proto.RegisterType((*Peer)(nil), "types.Peer")
-	proto.RegisterType((*PeerList)(nil), "types.PeerList")
-	proto.RegisterType((*ListParams)(nil), "types.ListParams")
-	proto.RegisterType((*PageParams)(nil), "types.PageParams")
-	proto.RegisterType((*BlockBodyPaged)(nil), "types.BlockBodyPaged")
-	proto.RegisterType((*BlockBodyParams)(nil), "types.BlockBodyParams")
-	proto.RegisterType((*BlockHeaderList)(nil), "types.BlockHeaderList")
-	proto.RegisterType((*BlockMetadata)(nil), "types.BlockMetadata")
-	proto.RegisterType((*BlockMetadataList)(nil), "types.BlockMetadataList")
-	proto.RegisterType((*CommitResult)(nil), "types.CommitResult")
-	proto.RegisterType((*CommitResultList)(nil), "types.CommitResultList")
-	proto.RegisterType((*VerifyResult)(nil), "types.VerifyResult")
-	proto.RegisterType((*Personal)(nil), "types.Personal")
-	proto.RegisterType((*ImportFormat)(nil), "types.ImportFormat")
-	proto.RegisterType((*Staking)(nil), "types.Staking")
-	proto.RegisterType((*Vote)(nil), "types.Vote")
-	proto.RegisterType((*VoteParams)(nil), "types.VoteParams")
-	proto.RegisterType((*AccountVoteInfo)(nil), "types.AccountVoteInfo")
-	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
-	proto.RegisterType((*VoteList)(nil), "types.VoteList")
-	proto.RegisterType((*NodeReq)(nil), "types.NodeReq")
-	proto.RegisterType((*Name)(nil), "types.Name")
-	proto.RegisterType((*NameInfo)(nil), "types.NameInfo")
-	proto.RegisterType((*PeersParams)(nil), "types.PeersParams")
-	proto.RegisterType((*KeyParams)(nil), "types.KeyParams")
-	proto.RegisterType((*ServerInfo)(nil), "types.ServerInfo")
-	proto.RegisterMapType((map[string]*ConfigItem)(nil), "types.ServerInfo.ConfigEntry")
-	proto.RegisterMapType((map[string]string)(nil), "types.ServerInfo.StatusEntry")
-	proto.RegisterType((*ConfigItem)(nil), "types.ConfigItem")
-	proto.RegisterMapType((map[string]string)(nil), "types.ConfigItem.PropsEntry")
-	proto.RegisterType((*EventList)(nil), "types.EventList")
-	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
+type StateDiffParams struct {
+	FromBlockHash        []byte   `protobuf:"bytes,1,opt,name=fromBlockHash,proto3" json:"fromBlockHash,omitempty"`
+	ToBlockHash          []byte   `protobuf:"bytes,2,opt,name=toBlockHash,proto3" json:"toBlockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+func (m *StateDiffParams) Reset()         { *m = StateDiffParams{} }
+func (m *StateDiffParams) String() string { return proto.CompactTextString(m) }
+func (*StateDiffParams) ProtoMessage()    {}
+func (*StateDiffParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{39}
+}
 
-var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 2423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x39, 0xeb, 0x76, 0x22, 0xc7,
-	0xd1, 0x80, 0x04, 0x82, 0x02, 0xa4, 0x51, 0x5b, 0xde, 0xd5, 0xc7, 0xb7, 0x5e, 0x2b, 0x1d, 0xc7,
-	0x96, 0x1d, 0x5b, 0xf6, 0x6a, 0x6d, 0xc7, 0xf1, 0x49, 0xe2, 0x20, 0x8c, 0x56, 0x1c, 0x4b, 0x48,
-	0x69, 0xf0, 0x46, 0xce, 0x8f, 0x90, 0x11, 0xd3, 0xc0, 0x1c, 0x31, 0x17, 0xcf, 0x34, 0xba, 0xf8,
-	0x9c, 0xfc, 0xca, 0x03, 0x24, 0x6f, 0x92, 0x77, 0xc9, 0x6b, 0xe4, 0x25, 0x72, 0xba, 0xba, 0x7b,
-	0x2e, 0x08, 0xe5, 0x9c, 0xcd, 0x2f, 0x4d, 0x55, 0xd7, 0xbd, 0xaa, 0xab, 0xaa, 0x11, 0xd4, 0xa2,
-	0x70, 0x7c, 0x10, 0x46, 0x81, 0x08, 0x48, 0x59, 0xdc, 0x87, 0x3c, 0x6e, 0x59, 0x57, 0xf3, 0x60,
-	0x7c, 0x3d, 0x9e, 0xd9, 0xae, 0xaf, 0x0e, 0x5a, 0x4d, 0x7b, 0x3c, 0x0e, 0x16, 0xbe, 0xd0, 0x20,
-	0xf8, 0x81, 0xc3, 0xf5, 0x77, 0x2d, 0x3c, 0x0c, 0xf5, 0x67, 0xc3, 0xe3, 0x22, 0x72, 0xc7, 0x86,
-	0x28, 0xb2, 0x27, 0x9a, 0x81, 0xfe, 0xb3, 0x08, 0xd6, 0x51, 0x22, 0x74, 0x20, 0x6c, 0xb1, 0x88,
-	0xc9, 0xfb, 0xb0, 0x75, 0xc5, 0x63, 0x31, 0x42, 0x6d, 0xa3, 0x99, 0x1d, 0xcf, 0x76, 0x8b, 0x7b,
-	0xc5, 0xfd, 0x06, 0x6b, 0x4a, 0x34, 0x92, 0x9f, 0xd8, 0xf1, 0x8c, 0xbc, 0x0b, 0x75, 0xa4, 0x9b,
-	0x71, 0x77, 0x3a, 0x13, 0xbb, 0xa5, 0xbd, 0xe2, 0xfe, 0x3a, 0x03, 0x89, 0x3a, 0x41, 0x0c, 0xf9,
-	0x05, 0x6c, 0x8e, 0x03, 0x3f, 0xe6, 0x7e, 0xbc, 0x88, 0x47, 0xae, 0x3f, 0x09, 0x76, 0xd7, 0xf6,
-	0x8a, 0xfb, 0x35, 0xd6, 0x4c, 0xb0, 0x3d, 0x7f, 0x12, 0x90, 0x5f, 0x02, 0x41, 0x39, 0x68, 0xc3,
-	0xc8, 0x75, 0x94, 0xca, 0x75, 0x54, 0x89, 0x96, 0x74, 0xe4, 0x41, 0xcf, 0x91, 0x4a, 0x69, 0x00,
-	0x1b, 0x1a, 0x24, 0x3b, 0x50, 0xf6, 0xec, 0xa9, 0x3b, 0x46, 0xeb, 0x6a, 0x4c, 0x01, 0xe4, 0x09,
-	0x54, 0xc2, 0xc5, 0xd5, 0xdc, 0x1d, 0xa3, 0x41, 0x55, 0xa6, 0x21, 0xb2, 0x0b, 0x1b, 0x9e, 0xed,
-	0xfa, 0x3e, 0x17, 0x68, 0x45, 0x95, 0x19, 0x90, 0x3c, 0x83, 0x5a, 0x62, 0x10, 0xaa, 0xad, 0xb1,
-	0x14, 0x41, 0xff, 0x5e, 0x82, 0x9a, 0xd2, 0x28, 0x6d, 0x7d, 0x0e, 0x25, 0xd7, 0x41, 0x85, 0xf5,
-	0xc3, 0xcd, 0x03, 0x4c, 0xcb, 0x81, 0xb6, 0x87, 0x95, 0x5c, 0x87, 0xb4, 0xa0, 0x7a, 0x15, 0xf6,
-	0x17, 0xde, 0x15, 0x8f, 0x50, 0x7f, 0x93, 0x25, 0x30, 0xa1, 0xd0, 0xf0, 0xec, 0x3b, 0x8c, 0x6a,
-	0xec, 0xfe, 0xc4, 0xd1, 0x8c, 0x75, 0x96, 0xc3, 0x49, 0x5b, 0x3c, 0xfb, 0x4e, 0x04, 0xd7, 0xdc,
-	0x8f, 0x75, 0x08, 0x52, 0x04, 0x79, 0x1f, 0x36, 0x63, 0x61, 0x5f, 0xbb, 0xfe, 0xd4, 0x73, 0x7d,
-	0xd7, 0x5b, 0x78, 0xbb, 0x65, 0x24, 0x59, 0xc2, 0x4a, 0x4d, 0x22, 0x10, 0xf6, 0x5c, 0xa3, 0x77,
-	0x2b, 0x48, 0x95, 0xc3, 0x49, 0x4b, 0xa7, 0x76, 0x1c, 0x46, 0xee, 0x98, 0xef, 0x6e, 0xe0, 0x79,
-	0x02, 0x4b, 0x2b, 0x7c, 0xdb, 0xe3, 0xea, 0xb0, 0xaa, 0xac, 0x48, 0x10, 0xf4, 0x3d, 0x80, 0x8e,
-	0x29, 0x97, 0x58, 0xc6, 0x3b, 0xe2, 0x61, 0x10, 0x09, 0x9d, 0x06, 0x0d, 0xd1, 0x31, 0x94, 0x7b,
-	0x7e, 0xb8, 0x10, 0x84, 0xc0, 0x7a, 0xa6, 0x86, 0xf0, 0x5b, 0x26, 0xc3, 0x76, 0x9c, 0x88, 0xc7,
-	0xf1, 0x6e, 0x69, 0x6f, 0x6d, 0xbf, 0xc1, 0x0c, 0x28, 0x93, 0x7a, 0x63, 0xcf, 0x17, 0x2a, 0x3a,
-	0x0d, 0xa6, 0x00, 0xa9, 0x24, 0x1e, 0x47, 0x6e, 0x28, 0x74, 0x4c, 0x34, 0x44, 0x27, 0x50, 0x39,
-	0x5f, 0x08, 0xa9, 0x65, 0x07, 0xca, 0xae, 0xef, 0xf0, 0x3b, 0x54, 0xd3, 0x64, 0x0a, 0xc8, 0xeb,
-	0x29, 0xfe, 0xef, 0x7a, 0x36, 0xa0, 0xdc, 0xf5, 0x42, 0x71, 0x4f, 0x7f, 0x0e, 0xf5, 0x81, 0xeb,
-	0x4f, 0xe7, 0xfc, 0xe8, 0x5e, 0xf0, 0x8c, 0x94, 0x62, 0x46, 0x0a, 0x7d, 0x1f, 0x36, 0xdb, 0xea,
-	0x5e, 0xb6, 0x97, 0xb5, 0xe5, 0xe8, 0xfe, 0x9c, 0xd2, 0xf9, 0x0e, 0x0b, 0x02, 0x21, 0xed, 0xd5,
-	0x18, 0x4d, 0x69, 0x40, 0x19, 0x45, 0x49, 0xa1, 0xdd, 0xc0, 0x6f, 0xf2, 0x1c, 0xa0, 0x13, 0x78,
-	0xa1, 0xd4, 0xc0, 0x1d, 0x5d, 0xd5, 0x19, 0x0c, 0xfd, 0x77, 0x11, 0xd6, 0x2f, 0x38, 0x8f, 0xc8,
-	0xc7, 0x69, 0x18, 0x54, 0xe9, 0x12, 0x5d, 0xba, 0xf2, 0x54, 0xdb, 0x98, 0x86, 0xe6, 0x25, 0xd4,
-	0xe4, 0xad, 0xc3, 0xa2, 0x44, 0x7d, 0xf5, 0xc3, 0xb7, 0x35, 0x7d, 0x9f, 0xdf, 0xe2, 0xfd, 0xef,
-	0x07, 0xc2, 0x1d, 0x73, 0x96, 0xd2, 0x49, 0x0f, 0x63, 0x61, 0x0b, 0x15, 0xcf, 0x32, 0x53, 0x80,
-	0x8c, 0xe7, 0xcc, 0x75, 0x1c, 0xee, 0x63, 0x3c, 0xab, 0x4c, 0x43, 0xb2, 0xc0, 0xe6, 0x76, 0x3c,
-	0xeb, 0xcc, 0xf8, 0xf8, 0x1a, 0x6b, 0x78, 0x8d, 0xa5, 0x08, 0x59, 0x9a, 0x31, 0x9f, 0x4f, 0x42,
-	0xce, 0x23, 0x2c, 0xdd, 0x2a, 0x4b, 0x60, 0x19, 0xa1, 0x1b, 0x1e, 0xc5, 0x6e, 0xe0, 0x63, 0xd5,
-	0xd6, 0x98, 0x01, 0xe9, 0x27, 0x50, 0x95, 0xee, 0x9c, 0xba, 0xb1, 0x20, 0x3f, 0x83, 0xb2, 0xa4,
-	0x96, 0xee, 0xae, 0xed, 0xd7, 0x0f, 0xeb, 0x19, 0x77, 0x99, 0x3a, 0xa1, 0x37, 0x00, 0x92, 0xf4,
-	0xc2, 0x8e, 0x6c, 0x2f, 0x5e, 0x59, 0xa4, 0xd2, 0xf8, 0x6c, 0x6b, 0xd3, 0x90, 0xa4, 0x4d, 0xee,
-	0x6f, 0x93, 0xe1, 0xb7, 0xa4, 0x0d, 0x26, 0x93, 0x98, 0xab, 0xc2, 0x69, 0x32, 0x0d, 0x11, 0x0b,
-	0xd6, 0xec, 0x78, 0x8c, 0x2e, 0x56, 0x99, 0xfc, 0xa4, 0x5f, 0x01, 0x5c, 0xd8, 0x53, 0xae, 0xf5,
-	0xa6, 0x7c, 0xc5, 0x1c, 0x9f, 0xd1, 0x51, 0x4a, 0x75, 0xd0, 0x3b, 0xd8, 0xc4, 0xe0, 0x1f, 0x05,
-	0xce, 0xbd, 0x14, 0x81, 0x1d, 0x10, 0xef, 0xb4, 0x29, 0x7a, 0x04, 0x32, 0x32, 0x4b, 0x2b, 0x65,
-	0x66, 0xed, 0x7e, 0x0f, 0xd6, 0xaf, 0x02, 0xe7, 0x1e, 0xad, 0xae, 0x1f, 0x5a, 0x3a, 0x4e, 0x89,
-	0x1a, 0x86, 0xa7, 0xf4, 0x2f, 0xb0, 0x95, 0xd1, 0x8c, 0x86, 0x53, 0x68, 0xc8, 0x20, 0x05, 0x91,
-	0xaf, 0x9a, 0x9d, 0x0a, 0x5c, 0x0e, 0x47, 0x3e, 0x84, 0x4a, 0x68, 0x4f, 0x65, 0x03, 0x52, 0x55,
-	0xb4, 0x6d, 0xd2, 0x90, 0xf8, 0xcf, 0x34, 0x01, 0xfd, 0x95, 0xd6, 0x70, 0xc2, 0x6d, 0x47, 0xe7,
-	0xf0, 0x3d, 0xa8, 0xa8, 0xbe, 0xa8, 0x93, 0xd8, 0xc8, 0x1a, 0xc7, 0xf4, 0x19, 0xfd, 0x2b, 0x34,
-	0x11, 0x71, 0xc6, 0x85, 0xed, 0xd8, 0xc2, 0x5e, 0x99, 0xc9, 0x8f, 0x64, 0x26, 0xa5, 0x60, 0x6d,
-	0x08, 0xc9, 0x8a, 0x52, 0x2a, 0x99, 0xa6, 0x90, 0x05, 0x26, 0xee, 0xd4, 0x15, 0x54, 0xa5, 0x6c,
-	0xc0, 0x24, 0x7e, 0xeb, 0x58, 0xaf, 0x2a, 0x27, 0x6d, 0xd8, 0xce, 0xa9, 0x47, 0xcb, 0x3f, 0x5e,
-	0xb2, 0x7c, 0x27, 0xab, 0xce, 0x50, 0x26, 0x1e, 0x70, 0x68, 0x74, 0x02, 0xcf, 0x73, 0x05, 0xe3,
-	0xf1, 0x62, 0xbe, 0xba, 0x5f, 0x7e, 0x08, 0x65, 0x1e, 0x45, 0x81, 0xb2, 0x7f, 0xf3, 0xf0, 0x2d,
-	0x33, 0x79, 0x90, 0x4f, 0x8d, 0x6d, 0xa6, 0x28, 0x64, 0xf6, 0x1d, 0x2e, 0x6c, 0x77, 0xae, 0x87,
-	0xad, 0x86, 0x68, 0x1b, 0xac, 0xac, 0x1a, 0x34, 0xf4, 0x13, 0xd8, 0x88, 0x10, 0x32, 0x96, 0xe6,
-	0x05, 0x2b, 0x4a, 0x66, 0x68, 0xe8, 0x10, 0x1a, 0xaf, 0x79, 0xe4, 0x4e, 0xee, 0xb5, 0xa5, 0xff,
-	0x07, 0x25, 0x71, 0xa7, 0x3b, 0x4a, 0x4d, 0x73, 0x0e, 0xef, 0x58, 0x49, 0xdc, 0x3d, 0x66, 0xb0,
-	0x62, 0xcf, 0x19, 0x4c, 0x87, 0xf2, 0xde, 0x46, 0x71, 0xe0, 0xdb, 0x73, 0xd9, 0xd1, 0x42, 0x3b,
-	0x8e, 0xc3, 0x59, 0x64, 0xc7, 0x5c, 0x0f, 0x94, 0x0c, 0x86, 0xec, 0xc3, 0x86, 0xde, 0x78, 0x74,
-	0x26, 0xcd, 0x0c, 0xd6, 0x6d, 0x92, 0x99, 0x63, 0x3a, 0x83, 0x46, 0xcf, 0x93, 0x83, 0xe8, 0x38,
-	0x88, 0x3c, 0x5b, 0x56, 0xd3, 0xda, 0xad, 0x3b, 0x59, 0x6a, 0x7f, 0x99, 0x56, 0xce, 0xe4, 0xb1,
-	0x4c, 0x7e, 0x30, 0x77, 0xa4, 0x42, 0x94, 0x5f, 0x63, 0x06, 0x94, 0x27, 0x3e, 0xbf, 0xc5, 0x13,
-	0x15, 0x57, 0x03, 0xd2, 0x2f, 0x60, 0x63, 0xa0, 0x67, 0xea, 0x13, 0xa8, 0xd8, 0x5e, 0xa6, 0x7b,
-	0x6b, 0x48, 0xa6, 0xf4, 0x76, 0xc6, 0x7d, 0xdd, 0x47, 0xf0, 0x9b, 0xfe, 0x06, 0xd6, 0x5f, 0x07,
-	0x02, 0x67, 0xed, 0xd8, 0xf6, 0x1d, 0xd7, 0x91, 0xcd, 0x53, 0xb1, 0xa5, 0x88, 0x8c, 0xc4, 0x52,
-	0x56, 0x22, 0x3d, 0x04, 0x90, 0xdc, 0xfa, 0x32, 0x6e, 0x26, 0x5b, 0x49, 0x0d, 0xb7, 0x90, 0x1d,
-	0x28, 0xa7, 0x41, 0x6a, 0x32, 0x05, 0x50, 0x07, 0xb6, 0x74, 0x98, 0x24, 0x2b, 0xae, 0x33, 0xfb,
-	0xb0, 0x61, 0x76, 0x84, 0xfc, 0x4e, 0xa3, 0x3d, 0x62, 0xe6, 0x98, 0x7c, 0x00, 0x95, 0x9b, 0x40,
-	0xa8, 0xbb, 0x2c, 0x2b, 0x65, 0xcb, 0x64, 0x54, 0x8b, 0x62, 0xfa, 0x98, 0x7e, 0x0d, 0xd5, 0x44,
-	0xbc, 0xb2, 0xab, 0x94, 0xd8, 0xf5, 0x1c, 0x20, 0x71, 0x4d, 0xc6, 0x71, 0x4d, 0xa6, 0x37, 0xc5,
-	0xd0, 0xdf, 0x2a, 0x5e, 0xd3, 0xc2, 0x6f, 0x02, 0x49, 0x96, 0x6f, 0xe1, 0xf2, 0x9c, 0xa9, 0x93,
-	0x65, 0xf1, 0xb4, 0x0d, 0x1b, 0xfd, 0xc0, 0xe1, 0x8c, 0xff, 0x88, 0xb7, 0xd8, 0xf5, 0x78, 0xb0,
-	0x48, 0x06, 0xa9, 0x06, 0xd5, 0xb6, 0xe7, 0x85, 0x81, 0xcf, 0x93, 0xa0, 0xa6, 0x08, 0xfa, 0x39,
-	0xac, 0xf7, 0x6d, 0x8f, 0xcb, 0x8c, 0xc9, 0x85, 0x47, 0xc7, 0x14, 0xbf, 0xa5, 0xcc, 0x2b, 0x35,
-	0xfc, 0x74, 0x22, 0x0d, 0x48, 0xc7, 0x50, 0x95, 0x5c, 0xe8, 0xf3, 0xbb, 0x19, 0xce, 0xd4, 0x6c,
-	0x79, 0xac, 0xc5, 0xec, 0x40, 0x39, 0xb8, 0xf5, 0x75, 0x2f, 0x6a, 0x30, 0x05, 0x90, 0x3d, 0xa8,
-	0x3b, 0x3c, 0x16, 0xae, 0x6f, 0x0b, 0x39, 0xdb, 0xd4, 0x56, 0x92, 0x45, 0xd1, 0x2e, 0xd4, 0xe5,
-	0xfc, 0x8a, 0x75, 0xce, 0x5b, 0x50, 0xf5, 0x83, 0x13, 0x35, 0x5c, 0x8b, 0x6a, 0x48, 0x1a, 0x18,
-	0x07, 0xe8, 0x2c, 0xb8, 0x1d, 0xf0, 0xf9, 0x44, 0x6f, 0xc1, 0x09, 0x4c, 0xdf, 0x81, 0xda, 0x77,
-	0xdc, 0x74, 0x71, 0x0b, 0xd6, 0xae, 0xf9, 0x3d, 0x86, 0xb8, 0xc6, 0xe4, 0x27, 0xfd, 0x5b, 0x09,
-	0x60, 0xc0, 0xa3, 0x1b, 0x1e, 0xa1, 0x37, 0x5f, 0x40, 0x25, 0xc6, 0xdb, 0xaa, 0xd3, 0xf0, 0x8e,
-	0xa9, 0x8f, 0x84, 0xe4, 0x40, 0xdd, 0xe6, 0xae, 0x2f, 0xa2, 0x7b, 0xa6, 0x89, 0x25, 0xdb, 0x38,
-	0xf0, 0x27, 0xae, 0xa9, 0x96, 0x15, 0x6c, 0x1d, 0x3c, 0xd7, 0x6c, 0x8a, 0xb8, 0xf5, 0x6b, 0xa8,
-	0x67, 0xa4, 0xa5, 0xd6, 0x15, 0xb5, 0x75, 0xe9, 0x1e, 0xa5, 0x92, 0xae, 0x80, 0xaf, 0x4b, 0x5f,
-	0x15, 0x5b, 0xa7, 0x50, 0xcf, 0x48, 0x5c, 0xc1, 0xfa, 0x41, 0x96, 0x35, 0x9d, 0x45, 0x8a, 0xa9,
-	0x27, 0xb8, 0x97, 0x91, 0x46, 0x7f, 0x92, 0x9b, 0x95, 0x39, 0x20, 0x87, 0x50, 0x0e, 0xa3, 0x20,
-	0x8c, 0xb5, 0x33, 0xcf, 0x1e, 0xb0, 0x1e, 0x5c, 0xc8, 0x63, 0xe5, 0x8b, 0x22, 0x6d, 0xc9, 0x31,
-	0x9f, 0x20, 0xdf, 0xc4, 0x13, 0xfa, 0x02, 0x6a, 0xdd, 0x1b, 0xee, 0x0b, 0x33, 0x04, 0xb9, 0x04,
-	0x96, 0x87, 0x20, 0x52, 0x30, 0x7d, 0x46, 0x7b, 0xd0, 0xec, 0xe4, 0x9e, 0x54, 0x04, 0xd6, 0x25,
-	0x9d, 0x29, 0x5f, 0xf9, 0x2d, 0x71, 0xf8, 0x06, 0x53, 0x0a, 0xf1, 0x5b, 0xda, 0x75, 0x15, 0x9a,
-	0x9b, 0x28, 0x3f, 0x3f, 0xfa, 0x57, 0xd1, 0x8c, 0x23, 0xfd, 0x1a, 0xac, 0x41, 0x79, 0x78, 0x39,
-	0x3a, 0xff, 0xce, 0x2a, 0x90, 0x1d, 0xb0, 0x86, 0x97, 0xa3, 0xfe, 0x79, 0xbf, 0xd3, 0x1d, 0x0d,
-	0xcf, 0xcf, 0x47, 0xa7, 0xe7, 0x7f, 0xb4, 0x8a, 0xe4, 0x6d, 0xd8, 0x1e, 0x5e, 0x8e, 0xda, 0xa7,
-	0xac, 0xdb, 0xfe, 0xf6, 0x87, 0x51, 0xf7, 0xb2, 0x37, 0x18, 0x0e, 0xac, 0x12, 0x79, 0x0b, 0xb6,
-	0x86, 0x97, 0xa3, 0x5e, 0xff, 0x75, 0xfb, 0xb4, 0xf7, 0xed, 0xe8, 0xa4, 0x3d, 0x38, 0xb1, 0xd6,
-	0x96, 0x90, 0x83, 0xde, 0xab, 0xbe, 0xb5, 0xae, 0x05, 0x18, 0xe4, 0xf1, 0x39, 0x3b, 0x6b, 0x0f,
-	0xad, 0x32, 0xf9, 0x7f, 0x78, 0x8a, 0xe8, 0xc1, 0xf7, 0xc7, 0xc7, 0xbd, 0x4e, 0xaf, 0xdb, 0x1f,
-	0x8e, 0x8e, 0xda, 0xa7, 0xed, 0x7e, 0xa7, 0x6b, 0x55, 0x34, 0xcf, 0x49, 0x7b, 0x30, 0x1a, 0xb4,
-	0xcf, 0xba, 0xca, 0x26, 0x6b, 0x23, 0x11, 0x35, 0xec, 0xb2, 0x7e, 0xfb, 0x74, 0xd4, 0x65, 0xec,
-	0x9c, 0x59, 0xb5, 0x8f, 0x26, 0x66, 0x70, 0x69, 0x9f, 0x76, 0xc0, 0x7a, 0xdd, 0x65, 0xbd, 0xe3,
-	0x1f, 0x46, 0x83, 0x61, 0x7b, 0xf8, 0xfd, 0x40, 0xb9, 0xb7, 0x07, 0xcf, 0xf2, 0x58, 0x69, 0xdf,
-	0xa8, 0x7f, 0x3e, 0x1c, 0x9d, 0xb5, 0x87, 0x9d, 0x13, 0xab, 0x48, 0x9e, 0x43, 0x2b, 0x4f, 0x91,
-	0x73, 0xaf, 0x74, 0xf8, 0x8f, 0x6d, 0xd8, 0x6a, 0xf3, 0x68, 0x1a, 0xb0, 0x8b, 0x8e, 0x2c, 0x75,
-	0xf9, 0x96, 0x7a, 0x01, 0x35, 0xd9, 0x94, 0x06, 0xb8, 0x0f, 0x9b, 0xf6, 0xaa, 0xdb, 0x54, 0x6b,
-	0xc5, 0x20, 0xa2, 0x05, 0xf2, 0x02, 0x2a, 0x67, 0xf8, 0x62, 0x27, 0x66, 0xef, 0x56, 0x60, 0xcc,
-	0xf8, 0x8f, 0x0b, 0x1e, 0x8b, 0xd6, 0x66, 0x1e, 0x4d, 0x0b, 0xe4, 0x0b, 0x80, 0xf4, 0x1d, 0x4f,
-	0x92, 0x2a, 0x91, 0x6f, 0x96, 0xd6, 0xd3, 0xec, 0xfa, 0x91, 0x79, 0xe8, 0xd3, 0x02, 0xf9, 0x0c,
-	0x1a, 0xaf, 0xb8, 0x48, 0x9f, 0xb7, 0x79, 0x46, 0x2b, 0xf7, 0xc0, 0xf5, 0x27, 0x01, 0x2d, 0x90,
-	0x03, 0xfd, 0x1a, 0x96, 0x22, 0x96, 0xc8, 0xb7, 0xb3, 0xe4, 0xf8, 0x38, 0xa4, 0x05, 0xf2, 0x0d,
-	0x58, 0xb2, 0x90, 0x33, 0x9b, 0x56, 0x4c, 0x0c, 0x61, 0xba, 0x7f, 0xb7, 0x9e, 0x3c, 0xdc, 0xc8,
-	0xe4, 0x29, 0x2d, 0x90, 0x23, 0xd8, 0x4e, 0x04, 0x24, 0x4b, 0xde, 0x0a, 0x09, 0xbb, 0xab, 0x96,
-	0x2c, 0x2d, 0xe3, 0x05, 0x6c, 0x25, 0x32, 0x06, 0x22, 0xe2, 0xb6, 0xb7, 0x64, 0x7a, 0x6e, 0xb7,
-	0xa4, 0x85, 0xcf, 0x8a, 0xa4, 0x0d, 0x4f, 0x1f, 0xa8, 0x5d, 0xc9, 0xba, 0x72, 0xb9, 0x43, 0x11,
-	0x07, 0x50, 0x7d, 0xc5, 0x95, 0x04, 0xb2, 0x22, 0xd1, 0xcb, 0x4a, 0xc9, 0xef, 0xc0, 0x32, 0xf4,
-	0xe9, 0x36, 0xbb, 0x82, 0xef, 0x11, 0x8d, 0xe4, 0x1b, 0x4c, 0x66, 0xb2, 0xa8, 0x93, 0x27, 0xcb,
-	0xdb, 0xbc, 0x8e, 0xd4, 0xdb, 0x0f, 0xf1, 0x53, 0xee, 0xd0, 0x02, 0xd9, 0x87, 0xf2, 0x2b, 0x2e,
-	0x86, 0x97, 0x2b, 0xb5, 0xa6, 0x0b, 0x1e, 0x2d, 0x90, 0xcf, 0x01, 0x8c, 0xaa, 0x47, 0xc8, 0xad,
-	0x84, 0xbc, 0xe7, 0x1b, 0x07, 0x0f, 0x91, 0x8b, 0xf1, 0x31, 0x77, 0x43, 0xb1, 0x92, 0xcb, 0x14,
-	0xb6, 0xa6, 0xa1, 0x05, 0xb9, 0xba, 0xbf, 0xe2, 0xa2, 0x7d, 0xd4, 0x5b, 0x49, 0x0f, 0x66, 0xfd,
-	0x3b, 0xea, 0x29, 0xda, 0x01, 0xf7, 0x9d, 0xe1, 0x25, 0x49, 0x8d, 0x6d, 0xad, 0x5a, 0x69, 0xa9,
-	0xbc, 0xec, 0x95, 0x81, 0x3b, 0xf5, 0xf3, 0xb4, 0x39, 0x1f, 0x3f, 0x86, 0xaa, 0x6a, 0x1a, 0xab,
-	0xe5, 0x65, 0x37, 0x61, 0x8c, 0x48, 0x55, 0x69, 0x18, 0x5e, 0x92, 0x66, 0x42, 0x2d, 0x4b, 0x28,
-	0xb9, 0x7f, 0xcb, 0xeb, 0x37, 0xde, 0x26, 0x59, 0x22, 0xaa, 0x37, 0xfc, 0xb7, 0x12, 0x41, 0x0a,
-	0x5a, 0x20, 0xbf, 0xc7, 0x12, 0x41, 0xa8, 0xed, 0x3b, 0x17, 0x51, 0x10, 0x4c, 0x92, 0x1e, 0x91,
-	0xff, 0x29, 0x21, 0xb1, 0x53, 0xa3, 0x91, 0x16, 0x73, 0xd0, 0xec, 0x44, 0x5c, 0xf2, 0xeb, 0x1f,
-	0x16, 0xb6, 0x92, 0xb7, 0xb1, 0xda, 0xc1, 0x5b, 0x4b, 0x2b, 0x35, 0x5e, 0x9f, 0xba, 0xcc, 0x81,
-	0x82, 0xe3, 0xa5, 0xfa, 0x27, 0x79, 0x72, 0xed, 0xd8, 0x67, 0x50, 0x3f, 0x0d, 0xc6, 0xd7, 0x6f,
-	0xa0, 0xe4, 0x10, 0x9a, 0xdf, 0xfb, 0xf3, 0x37, 0xe3, 0xf9, 0x12, 0x9a, 0x6a, 0xc9, 0x37, 0x3c,
-	0xc6, 0xe9, 0xec, 0xea, 0xbf, 0x9a, 0xaf, 0x7b, 0x97, 0xe5, 0x7b, 0xa0, 0x6b, 0x75, 0x63, 0x7e,
-	0x09, 0xcd, 0x3f, 0x2c, 0x78, 0x74, 0xdf, 0x09, 0x7c, 0x11, 0xd9, 0xe3, 0xb4, 0x01, 0x22, 0xf6,
-	0x11, 0xa6, 0x36, 0x90, 0x1c, 0x93, 0xca, 0xf6, 0x76, 0x36, 0xb3, 0x8a, 0xfd, 0xc9, 0x03, 0x94,
-	0x49, 0xda, 0x0b, 0x2c, 0x13, 0xdc, 0xfe, 0x48, 0xf6, 0xa7, 0x1b, 0xbd, 0x0b, 0xb6, 0xb6, 0x32,
-	0xb8, 0x24, 0x01, 0x92, 0xe5, 0x35, 0xee, 0xc9, 0xdb, 0x99, 0xdd, 0x79, 0x89, 0xc3, 0xac, 0xdb,
-	0xd8, 0x68, 0xb7, 0xd2, 0x2c, 0x2b, 0xc6, 0xe5, 0xd2, 0x52, 0x3f, 0x10, 0x25, 0x86, 0x2e, 0xbd,
-	0x26, 0xd4, 0x18, 0x52, 0xf5, 0x89, 0x6f, 0x86, 0x47, 0xd8, 0x97, 0xde, 0x18, 0xb4, 0x40, 0x3e,
-	0xc1, 0x02, 0x4b, 0x56, 0xe8, 0xec, 0xd2, 0x9c, 0x58, 0x6a, 0x4e, 0x31, 0x7d, 0xd8, 0xce, 0x71,
-	0x07, 0xd2, 0x3d, 0xd9, 0xb8, 0x78, 0xec, 0xce, 0x85, 0x5a, 0x30, 0x5b, 0xb9, 0x55, 0x09, 0x1b,
-	0xf2, 0x4b, 0xf5, 0x93, 0x0f, 0x22, 0xe2, 0x55, 0x2c, 0x56, 0x96, 0x45, 0x87, 0xe5, 0x4b, 0x68,
-	0x4a, 0x97, 0xd2, 0x95, 0xd8, 0x10, 0x25, 0x5b, 0x74, 0x32, 0xf8, 0x52, 0x22, 0x5a, 0x20, 0x5f,
-	0xe1, 0x55, 0xcd, 0xaf, 0x65, 0xab, 0x27, 0x47, 0x8e, 0x86, 0x16, 0xc8, 0x77, 0x60, 0x75, 0x66,
-	0xb6, 0x3f, 0xe5, 0x67, 0xdc, 0xbb, 0xe2, 0x51, 0x3c, 0x73, 0x43, 0xf2, 0x34, 0x99, 0xf8, 0x06,
-	0xa5, 0x48, 0x5a, 0xcf, 0x1e, 0x39, 0x60, 0x3c, 0x9c, 0xdf, 0xd3, 0xc2, 0xd1, 0xde, 0x9f, 0x9e,
-	0x4f, 0x5d, 0x31, 0x5b, 0x5c, 0x1d, 0x8c, 0x03, 0xef, 0x53, 0x5b, 0x2e, 0x27, 0x6e, 0xa0, 0xfe,
-	0x7e, 0x8a, 0x9c, 0x57, 0x15, 0xfc, 0x57, 0xc0, 0xcb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x7b,
-	0xe4, 0xc4, 0xfc, 0x70, 0x18, 0x00, 0x00,
+func (m *StateDiffParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateDiffParams.Unmarshal(m, b)
+}
+func (m *StateDiffParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateDiffParams.Marshal(b, m, deterministic)
+}
+func (m *StateDiffParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiffParams.Merge(m, src)
+}
+func (m *StateDiffParams) XXX_Size() int {
+	return xxx_messageInfo_StateDiffParams.Size(m)
+}
+func (m *StateDiffParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiffParams.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_StateDiffParams proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *StateDiffParams) GetFromBlockHash() []byte {
+	if m != nil {
+		return m.FromBlockHash
+	}
+	return nil
+}
 
-// AergoRPCServiceClient is the client API for AergoRPCService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AergoRPCServiceClient interface {
-	// Returns the current state of this node
-	NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Returns node metrics according to request
-	Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error)
-	// Returns current blockchain status (best block's height and hash)
-	Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error)
-	// Returns current blockchain's basic information
-	GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error)
-	// Returns current chain statistics
-	ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error)
-	// Returns list of Blocks without body according to request
-	ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error)
-	// Returns list of block metadata (hash, header, and number of transactions) according to request
-	ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error)
-	// Returns a stream of new blocks as they get added to the blockchain
-	ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error)
-	// Returns a stream of new block's metadata as they get added to the blockchain
-	ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error)
-	// Return a single block incl. header and body, queried by hash or number
-	GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error)
-	// Return a single block's metdata (hash, header, and number of transactions), queried by hash or number
-	GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error)
-	// Return a single block's body, queried by hash or number and list parameters
-	GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error)
-	// Return a single transaction, queried by transaction hash
-	GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error)
-	// Return information about transaction in block, queried by transaction hash
-	GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error)
-	// Return transaction receipt, queried by transaction hash
-	GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error)
-	// Return ABI stored at contract address
-	GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error)
-	// Sign and send a transaction from an unlocked account
-	SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error)
-	// Sign transaction with unlocked account
-	SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error)
-	// Verify validity of transaction
-	VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error)
-	// Commit a signed transaction
-	CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error)
-	// Return state of account
-	GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error)
-	// Return state of account, including merkle proof
-	GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error)
-	// Create a new account in this node
-	CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Return list of accounts in this node
-	GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error)
-	// Lock account in this node
-	LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Unlock account in this node
-	UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
-	// Import account to this node
-	ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error)
-	// Export account stored in this node
-	ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Query a contract method
-	QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error)
-	// Query contract state
-	QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error)
-	// Return list of peers of this node and their state
-	GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error)
-	// Return result of vote
-	GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error)
-	// Return staking, voting info for account
-	GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error)
-	// Return staking information
-	GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error)
-	// Return name information
-	GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error)
-	// Returns a stream of event as they get added to the blockchain
-	ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error)
-	// Returns list of event
-	ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error)
-	// Returns configs and statuses of server
-	GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error)
-	// Returns status of consensus and bps
-	GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error)
-	// Add & remove member of raft cluster
-	ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error)
+func (m *StateDiffParams) GetToBlockHash() []byte {
+	if m != nil {
+		return m.ToBlockHash
+	}
+	return nil
 }
 
-type aergoRPCServiceClient struct {
-	cc *grpc.ClientConn
+type StorageDiff struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue             []byte   `protobuf:"bytes,2,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue             []byte   `protobuf:"bytes,3,opt,name=newValue,proto3" json:"newValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewAergoRPCServiceClient(cc *grpc.ClientConn) AergoRPCServiceClient {
-	return &aergoRPCServiceClient{cc}
+func (m *StorageDiff) Reset()         { *m = StorageDiff{} }
+func (m *StorageDiff) String() string { return proto.CompactTextString(m) }
+func (*StorageDiff) ProtoMessage()    {}
+func (*StorageDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
 }
 
-func (c *aergoRPCServiceClient) NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error) {
-	out := new(SingleBytes)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/NodeState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StorageDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageDiff.Unmarshal(m, b)
+}
+func (m *StorageDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageDiff.Marshal(b, m, deterministic)
+}
+func (m *StorageDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDiff.Merge(m, src)
+}
+func (m *StorageDiff) XXX_Size() int {
+	return xxx_messageInfo_StorageDiff.Size(m)
+}
+func (m *StorageDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDiff.DiscardUnknown(m)
 }
 
-func (c *aergoRPCServiceClient) Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error) {
-	out := new(Metrics)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Metric", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StorageDiff proto.InternalMessageInfo
+
+func (m *StorageDiff) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error) {
-	out := new(BlockchainStatus)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Blockchain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageDiff) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error) {
-	out := new(ChainInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetChainInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageDiff) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error) {
-	out := new(ChainStats)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChainStat", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// accountID is the trie key of the account, which is the hash of its address
+type AccountDiff struct {
+	AccountID            []byte         `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	OldState             *State         `protobuf:"bytes,2,opt,name=oldState,proto3" json:"oldState,omitempty"`
+	NewState             *State         `protobuf:"bytes,3,opt,name=newState,proto3" json:"newState,omitempty"`
+	Storage              []*StorageDiff `protobuf:"bytes,4,rep,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error) {
-	out := new(BlockHeaderList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AccountDiff) Reset()         { *m = AccountDiff{} }
+func (m *AccountDiff) String() string { return proto.CompactTextString(m) }
+func (*AccountDiff) ProtoMessage()    {}
+func (*AccountDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
 }
 
-func (c *aergoRPCServiceClient) ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error) {
-	out := new(BlockMetadataList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *AccountDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountDiff.Unmarshal(m, b)
+}
+func (m *AccountDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountDiff.Marshal(b, m, deterministic)
+}
+func (m *AccountDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDiff.Merge(m, src)
+}
+func (m *AccountDiff) XXX_Size() int {
+	return xxx_messageInfo_AccountDiff.Size(m)
+}
+func (m *AccountDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDiff.DiscardUnknown(m)
 }
 
-func (c *aergoRPCServiceClient) ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[0], "/types.AergoRPCService/ListBlockStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aergoRPCServiceListBlockStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_AccountDiff proto.InternalMessageInfo
+
+func (m *AccountDiff) GetAccountID() []byte {
+	if m != nil {
+		return m.AccountID
 	}
-	return x, nil
+	return nil
 }
 
-type AergoRPCService_ListBlockStreamClient interface {
-	Recv() (*Block, error)
-	grpc.ClientStream
+func (m *AccountDiff) GetOldState() *State {
+	if m != nil {
+		return m.OldState
+	}
+	return nil
 }
 
-type aergoRPCServiceListBlockStreamClient struct {
-	grpc.ClientStream
+func (m *AccountDiff) GetNewState() *State {
+	if m != nil {
+		return m.NewState
+	}
+	return nil
 }
 
-func (x *aergoRPCServiceListBlockStreamClient) Recv() (*Block, error) {
-	m := new(Block)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *AccountDiff) GetStorage() []*StorageDiff {
+	if m != nil {
+		return m.Storage
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[1], "/types.AergoRPCService/ListBlockMetadataStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aergoRPCServiceListBlockMetadataStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type StorageListParams struct {
+	ContractAddress []byte `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Prefix          []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Cursor          []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Size            uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	BlockNo         uint64 `protobuf:"varint,5,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	// lists the storage at the best block instead of the one of blockNo
+	Latest               bool     `protobuf:"varint,6,opt,name=latest,proto3" json:"latest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type AergoRPCService_ListBlockMetadataStreamClient interface {
-	Recv() (*BlockMetadata, error)
-	grpc.ClientStream
+func (m *StorageListParams) Reset()         { *m = StorageListParams{} }
+func (m *StorageListParams) String() string { return proto.CompactTextString(m) }
+func (*StorageListParams) ProtoMessage()    {}
+func (*StorageListParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
 }
 
-type aergoRPCServiceListBlockMetadataStreamClient struct {
-	grpc.ClientStream
+func (m *StorageListParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageListParams.Unmarshal(m, b)
+}
+func (m *StorageListParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageListParams.Marshal(b, m, deterministic)
+}
+func (m *StorageListParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageListParams.Merge(m, src)
+}
+func (m *StorageListParams) XXX_Size() int {
+	return xxx_messageInfo_StorageListParams.Size(m)
+}
+func (m *StorageListParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageListParams.DiscardUnknown(m)
 }
 
-func (x *aergoRPCServiceListBlockMetadataStreamClient) Recv() (*BlockMetadata, error) {
-	m := new(BlockMetadata)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_StorageListParams proto.InternalMessageInfo
+
+func (m *StorageListParams) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error) {
-	out := new(Block)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageListParams) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error) {
-	out := new(BlockMetadata)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageListParams) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error) {
-	out := new(BlockBodyPaged)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockBody", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageListParams) GetSize() uint32 {
+	if m != nil {
+		return m.Size
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error) {
-	out := new(Tx)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageListParams) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error) {
-	out := new(TxInBlock)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageListParams) GetLatest() bool {
+	if m != nil {
+		return m.Latest
 	}
-	return out, nil
+	return false
 }
 
-func (c *aergoRPCServiceClient) GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error) {
-	out := new(Receipt)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetReceipt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type StorageEntry struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TrieKey              []byte   `protobuf:"bytes,2,opt,name=trieKey,proto3" json:"trieKey,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error) {
-	out := new(ABI)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetABI", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StorageEntry) Reset()         { *m = StorageEntry{} }
+func (m *StorageEntry) String() string { return proto.CompactTextString(m) }
+func (*StorageEntry) ProtoMessage()    {}
+func (*StorageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
 }
 
-func (c *aergoRPCServiceClient) SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error) {
-	out := new(CommitResult)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SendTX", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StorageEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageEntry.Unmarshal(m, b)
+}
+func (m *StorageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageEntry.Marshal(b, m, deterministic)
+}
+func (m *StorageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageEntry.Merge(m, src)
+}
+func (m *StorageEntry) XXX_Size() int {
+	return xxx_messageInfo_StorageEntry.Size(m)
+}
+func (m *StorageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageEntry.DiscardUnknown(m)
 }
 
-func (c *aergoRPCServiceClient) SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error) {
-	out := new(Tx)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SignTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StorageEntry proto.InternalMessageInfo
+
+func (m *StorageEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error) {
-	out := new(VerifyResult)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/VerifyTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageEntry) GetTrieKey() []byte {
+	if m != nil {
+		return m.TrieKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error) {
-	out := new(CommitResultList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CommitTX", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error) {
-	out := new(State)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type StorageList struct {
+	Entries              []*StorageEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor           []byte          `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error) {
-	out := new(AccountProof)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStateAndProof", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StorageList) Reset()         { *m = StorageList{} }
+func (m *StorageList) String() string { return proto.CompactTextString(m) }
+func (*StorageList) ProtoMessage()    {}
+func (*StorageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 
-func (c *aergoRPCServiceClient) CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CreateAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageList.Unmarshal(m, b)
+}
+func (m *StorageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageList.Marshal(b, m, deterministic)
+}
+func (m *StorageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageList.Merge(m, src)
+}
+func (m *StorageList) XXX_Size() int {
+	return xxx_messageInfo_StorageList.Size(m)
+}
+func (m *StorageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageList proto.InternalMessageInfo
+
+func (m *StorageList) GetEntries() []*StorageEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error) {
-	out := new(AccountList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StorageList) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/LockAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Withdrawal struct {
+	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Release              uint64   `protobuf:"varint,2,opt,name=release,proto3" json:"release,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aergoRPCServiceClient) UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/UnlockAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
+func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
+func (*Withdrawal) ProtoMessage()    {}
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+}
+
+func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Withdrawal.Unmarshal(m, b)
+}
+func (m *Withdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Withdrawal.Marshal(b, m, deterministic)
+}
+func (m *Withdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawal.Merge(m, src)
+}
+func (m *Withdrawal) XXX_Size() int {
+	return xxx_messageInfo_Withdrawal.Size(m)
+}
+func (m *Withdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawal proto.InternalMessageInfo
+
+func (m *Withdrawal) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error) {
-	out := new(Account)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ImportAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Withdrawal) GetRelease() uint64 {
+	if m != nil {
+		return m.Release
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error) {
-	out := new(SingleBytes)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ExportAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+type WithdrawalList struct {
+	Withdrawals          []*Withdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *WithdrawalList) Reset()         { *m = WithdrawalList{} }
+func (m *WithdrawalList) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalList) ProtoMessage()    {}
+func (*WithdrawalList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+}
+
+func (m *WithdrawalList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawalList.Unmarshal(m, b)
+}
+func (m *WithdrawalList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawalList.Marshal(b, m, deterministic)
+}
+func (m *WithdrawalList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalList.Merge(m, src)
+}
+func (m *WithdrawalList) XXX_Size() int {
+	return xxx_messageInfo_WithdrawalList.Size(m)
+}
+func (m *WithdrawalList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalList proto.InternalMessageInfo
+
+func (m *WithdrawalList) GetWithdrawals() []*Withdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error) {
-	out := new(SingleBytes)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/QueryContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SystemAccountInfo struct {
+	Account              []byte        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Staking              *Staking      `protobuf:"bytes,2,opt,name=staking,proto3" json:"staking,omitempty"`
+	Withdrawals          []*Withdrawal `protobuf:"bytes,3,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Voting               []*VoteInfo   `protobuf:"bytes,4,rep,name=voting,proto3" json:"voting,omitempty"`
+	DelegatedTo          string        `protobuf:"bytes,5,opt,name=delegatedTo,proto3" json:"delegatedTo,omitempty"`
+	Delegated            []byte        `protobuf:"bytes,6,opt,name=delegated,proto3" json:"delegated,omitempty"`
+	Reward               []byte        `protobuf:"bytes,7,opt,name=reward,proto3" json:"reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SystemAccountInfo) Reset()         { *m = SystemAccountInfo{} }
+func (m *SystemAccountInfo) String() string { return proto.CompactTextString(m) }
+func (*SystemAccountInfo) ProtoMessage()    {}
+func (*SystemAccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+}
+
+func (m *SystemAccountInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemAccountInfo.Unmarshal(m, b)
+}
+func (m *SystemAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemAccountInfo.Marshal(b, m, deterministic)
+}
+func (m *SystemAccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemAccountInfo.Merge(m, src)
+}
+func (m *SystemAccountInfo) XXX_Size() int {
+	return xxx_messageInfo_SystemAccountInfo.Size(m)
+}
+func (m *SystemAccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemAccountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemAccountInfo proto.InternalMessageInfo
+
+func (m *SystemAccountInfo) GetAccount() []byte {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error) {
-	out := new(StateQueryProof)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/QueryContractState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetStaking() *Staking {
+	if m != nil {
+		return m.Staking
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error) {
-	out := new(PeerList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetWithdrawals() []*Withdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error) {
-	out := new(VoteList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetVoting() []*VoteInfo {
+	if m != nil {
+		return m.Voting
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error) {
-	out := new(AccountVoteInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAccountVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetDelegatedTo() string {
+	if m != nil {
+		return m.DelegatedTo
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aergoRPCServiceClient) GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error) {
-	out := new(Staking)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStaking", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetDelegated() []byte {
+	if m != nil {
+		return m.Delegated
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error) {
-	out := new(NameInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetNameInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SystemAccountInfo) GetReward() []byte {
+	if m != nil {
+		return m.Reward
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[2], "/types.AergoRPCService/ListEventStream", opts...)
-	if err != nil {
-		return nil, err
+type NameSaleOffer struct {
+	Price                []byte   `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Buyer                []byte   `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NameSaleOffer) Reset()         { *m = NameSaleOffer{} }
+func (m *NameSaleOffer) String() string { return proto.CompactTextString(m) }
+func (*NameSaleOffer) ProtoMessage()    {}
+func (*NameSaleOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+}
+
+func (m *NameSaleOffer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NameSaleOffer.Unmarshal(m, b)
+}
+func (m *NameSaleOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NameSaleOffer.Marshal(b, m, deterministic)
+}
+func (m *NameSaleOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameSaleOffer.Merge(m, src)
+}
+func (m *NameSaleOffer) XXX_Size() int {
+	return xxx_messageInfo_NameSaleOffer.Size(m)
+}
+func (m *NameSaleOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameSaleOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameSaleOffer proto.InternalMessageInfo
+
+func (m *NameSaleOffer) GetPrice() []byte {
+	if m != nil {
+		return m.Price
 	}
-	x := &aergoRPCServiceListEventStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *NameSaleOffer) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+type NameInfoList struct {
+	Names                []*NameInfo `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *NameInfoList) Reset()         { *m = NameInfoList{} }
+func (m *NameInfoList) String() string { return proto.CompactTextString(m) }
+func (*NameInfoList) ProtoMessage()    {}
+func (*NameInfoList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+}
+
+func (m *NameInfoList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NameInfoList.Unmarshal(m, b)
+}
+func (m *NameInfoList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NameInfoList.Marshal(b, m, deterministic)
+}
+func (m *NameInfoList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameInfoList.Merge(m, src)
+}
+func (m *NameInfoList) XXX_Size() int {
+	return xxx_messageInfo_NameInfoList.Size(m)
+}
+func (m *NameInfoList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameInfoList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameInfoList proto.InternalMessageInfo
+
+func (m *NameInfoList) GetNames() []*NameInfo {
+	if m != nil {
+		return m.Names
 	}
-	return x, nil
+	return nil
 }
 
-type AergoRPCService_ListEventStreamClient interface {
-	Recv() (*Event, error)
-	grpc.ClientStream
+// QuorumStatus is the turnout of the votes on a governance parameter against the quorum. A pending value of the parameter is activated only if the quorum is reached at its activation block.
+type QuorumStatus struct {
+	Param                string   `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	Turnout              []byte   `protobuf:"bytes,2,opt,name=turnout,proto3" json:"turnout,omitempty"`
+	TotalStaking         []byte   `protobuf:"bytes,3,opt,name=totalStaking,proto3" json:"totalStaking,omitempty"`
+	Quorum               uint64   `protobuf:"varint,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Reached              bool     `protobuf:"varint,5,opt,name=reached,proto3" json:"reached,omitempty"`
+	Pending              []byte   `protobuf:"bytes,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	Activation           uint64   `protobuf:"varint,7,opt,name=activation,proto3" json:"activation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aergoRPCServiceListEventStreamClient struct {
-	grpc.ClientStream
+func (m *QuorumStatus) Reset()         { *m = QuorumStatus{} }
+func (m *QuorumStatus) String() string { return proto.CompactTextString(m) }
+func (*QuorumStatus) ProtoMessage()    {}
+func (*QuorumStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 
-func (x *aergoRPCServiceListEventStreamClient) Recv() (*Event, error) {
-	m := new(Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *QuorumStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuorumStatus.Unmarshal(m, b)
+}
+func (m *QuorumStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuorumStatus.Marshal(b, m, deterministic)
+}
+func (m *QuorumStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumStatus.Merge(m, src)
+}
+func (m *QuorumStatus) XXX_Size() int {
+	return xxx_messageInfo_QuorumStatus.Size(m)
+}
+func (m *QuorumStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumStatus proto.InternalMessageInfo
+
+func (m *QuorumStatus) GetParam() string {
+	if m != nil {
+		return m.Param
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aergoRPCServiceClient) ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error) {
-	out := new(EventList)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuorumStatus) GetTurnout() []byte {
+	if m != nil {
+		return m.Turnout
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error) {
-	out := new(ServerInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetServerInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuorumStatus) GetTotalStaking() []byte {
+	if m != nil {
+		return m.TotalStaking
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aergoRPCServiceClient) GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error) {
-	out := new(ConsensusInfo)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetConsensusInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuorumStatus) GetQuorum() uint64 {
+	if m != nil {
+		return m.Quorum
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aergoRPCServiceClient) ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error) {
-	out := new(MembershipChangeReply)
-	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChangeMembership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuorumStatus) GetReached() bool {
+	if m != nil {
+		return m.Reached
 	}
-	return out, nil
+	return false
 }
 
-// AergoRPCServiceServer is the server API for AergoRPCService service.
-type AergoRPCServiceServer interface {
-	// Returns the current state of this node
-	NodeState(context.Context, *NodeReq) (*SingleBytes, error)
-	// Returns node metrics according to request
-	Metric(context.Context, *MetricsRequest) (*Metrics, error)
-	// Returns current blockchain status (best block's height and hash)
-	Blockchain(context.Context, *Empty) (*BlockchainStatus, error)
-	// Returns current blockchain's basic information
-	GetChainInfo(context.Context, *Empty) (*ChainInfo, error)
-	// Returns current chain statistics
-	ChainStat(context.Context, *Empty) (*ChainStats, error)
-	// Returns list of Blocks without body according to request
-	ListBlockHeaders(context.Context, *ListParams) (*BlockHeaderList, error)
-	// Returns list of block metadata (hash, header, and number of transactions) according to request
-	ListBlockMetadata(context.Context, *ListParams) (*BlockMetadataList, error)
-	// Returns a stream of new blocks as they get added to the blockchain
-	ListBlockStream(*Empty, AergoRPCService_ListBlockStreamServer) error
-	// Returns a stream of new block's metadata as they get added to the blockchain
-	ListBlockMetadataStream(*Empty, AergoRPCService_ListBlockMetadataStreamServer) error
-	// Return a single block incl. header and body, queried by hash or number
-	GetBlock(context.Context, *SingleBytes) (*Block, error)
+func (m *QuorumStatus) GetPending() []byte {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *QuorumStatus) GetActivation() uint64 {
+	if m != nil {
+		return m.Activation
+	}
+	return 0
+}
+
+type QuorumStatusList struct {
+	Params               []*QuorumStatus `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QuorumStatusList) Reset()         { *m = QuorumStatusList{} }
+func (m *QuorumStatusList) String() string { return proto.CompactTextString(m) }
+func (*QuorumStatusList) ProtoMessage()    {}
+func (*QuorumStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+}
+
+func (m *QuorumStatusList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuorumStatusList.Unmarshal(m, b)
+}
+func (m *QuorumStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuorumStatusList.Marshal(b, m, deterministic)
+}
+func (m *QuorumStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumStatusList.Merge(m, src)
+}
+func (m *QuorumStatusList) XXX_Size() int {
+	return xxx_messageInfo_QuorumStatusList.Size(m)
+}
+func (m *QuorumStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumStatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumStatusList proto.InternalMessageInfo
+
+func (m *QuorumStatusList) GetParams() []*QuorumStatus {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// BPCandidateInfo is the votes of a BP candidate joined with the metadata which it registered. The metadata is empty if the candidate is not registered.
+type BPCandidateInfo struct {
+	Candidate            []byte   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Registered           bool     `protobuf:"varint,3,opt,name=registered,proto3" json:"registered,omitempty"`
+	Owner                []byte   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name                 string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Website              string   `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
+	Commission           uint32   `protobuf:"varint,7,opt,name=commission,proto3" json:"commission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BPCandidateInfo) Reset()         { *m = BPCandidateInfo{} }
+func (m *BPCandidateInfo) String() string { return proto.CompactTextString(m) }
+func (*BPCandidateInfo) ProtoMessage()    {}
+func (*BPCandidateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+}
+
+func (m *BPCandidateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BPCandidateInfo.Unmarshal(m, b)
+}
+func (m *BPCandidateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BPCandidateInfo.Marshal(b, m, deterministic)
+}
+func (m *BPCandidateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BPCandidateInfo.Merge(m, src)
+}
+func (m *BPCandidateInfo) XXX_Size() int {
+	return xxx_messageInfo_BPCandidateInfo.Size(m)
+}
+func (m *BPCandidateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BPCandidateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BPCandidateInfo proto.InternalMessageInfo
+
+func (m *BPCandidateInfo) GetCandidate() []byte {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *BPCandidateInfo) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BPCandidateInfo) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+func (m *BPCandidateInfo) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *BPCandidateInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BPCandidateInfo) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *BPCandidateInfo) GetCommission() uint32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+type ElectionTally struct {
+	Candidates           []*BPCandidateInfo `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ElectionTally) Reset()         { *m = ElectionTally{} }
+func (m *ElectionTally) String() string { return proto.CompactTextString(m) }
+func (*ElectionTally) ProtoMessage()    {}
+func (*ElectionTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+}
+
+func (m *ElectionTally) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ElectionTally.Unmarshal(m, b)
+}
+func (m *ElectionTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ElectionTally.Marshal(b, m, deterministic)
+}
+func (m *ElectionTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionTally.Merge(m, src)
+}
+func (m *ElectionTally) XXX_Size() int {
+	return xxx_messageInfo_ElectionTally.Size(m)
+}
+func (m *ElectionTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionTally proto.InternalMessageInfo
+
+func (m *ElectionTally) GetCandidates() []*BPCandidateInfo {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+// FeeEstimate is the range of the fee charged to a tx. Pending is the estimate under the fee parameters waiting for activation at the block Activation.
+type FeeEstimate struct {
+	Kind                 string       `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	MinFee               []byte       `protobuf:"bytes,2,opt,name=minFee,proto3" json:"minFee,omitempty"`
+	MaxFee               []byte       `protobuf:"bytes,3,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	IntrinsicGas         uint64       `protobuf:"varint,4,opt,name=intrinsicGas,proto3" json:"intrinsicGas,omitempty"`
+	GasLimit             uint64       `protobuf:"varint,5,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice             []byte       `protobuf:"bytes,6,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	Activation           uint64       `protobuf:"varint,7,opt,name=activation,proto3" json:"activation,omitempty"`
+	Pending              *FeeEstimate `protobuf:"bytes,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FeeEstimate) Reset()         { *m = FeeEstimate{} }
+func (m *FeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*FeeEstimate) ProtoMessage()    {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
+}
+
+func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeEstimate.Unmarshal(m, b)
+}
+func (m *FeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeEstimate.Marshal(b, m, deterministic)
+}
+func (m *FeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimate.Merge(m, src)
+}
+func (m *FeeEstimate) XXX_Size() int {
+	return xxx_messageInfo_FeeEstimate.Size(m)
+}
+func (m *FeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEstimate proto.InternalMessageInfo
+
+func (m *FeeEstimate) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *FeeEstimate) GetMinFee() []byte {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+func (m *FeeEstimate) GetMaxFee() []byte {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+func (m *FeeEstimate) GetIntrinsicGas() uint64 {
+	if m != nil {
+		return m.IntrinsicGas
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetGasPrice() []byte {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *FeeEstimate) GetActivation() uint64 {
+	if m != nil {
+		return m.Activation
+	}
+	return 0
+}
+
+func (m *FeeEstimate) GetPending() *FeeEstimate {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// BaseFee is the fee per byte charged to the txs of the next block
+type BaseFee struct {
+	AerPerByte           []byte   `protobuf:"bytes,1,opt,name=aerPerByte,proto3" json:"aerPerByte,omitempty"`
+	Dynamic              bool     `protobuf:"varint,2,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BaseFee) Reset()         { *m = BaseFee{} }
+func (m *BaseFee) String() string { return proto.CompactTextString(m) }
+func (*BaseFee) ProtoMessage()    {}
+func (*BaseFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
+}
+
+func (m *BaseFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BaseFee.Unmarshal(m, b)
+}
+func (m *BaseFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BaseFee.Marshal(b, m, deterministic)
+}
+func (m *BaseFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFee.Merge(m, src)
+}
+func (m *BaseFee) XXX_Size() int {
+	return xxx_messageInfo_BaseFee.Size(m)
+}
+func (m *BaseFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFee proto.InternalMessageInfo
+
+func (m *BaseFee) GetAerPerByte() []byte {
+	if m != nil {
+		return m.AerPerByte
+	}
+	return nil
+}
+
+func (m *BaseFee) GetDynamic() bool {
+	if m != nil {
+		return m.Dynamic
+	}
+	return false
+}
+
+func (m *BaseFee) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+// ClusterMemberStatus is the replication state of a member of a raft cluster.
+type ClusterMemberStatus struct {
+	Attr                 *MemberAttr `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
+	IsLeader             bool        `protobuf:"varint,2,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	Match                uint64      `protobuf:"varint,3,opt,name=match,proto3" json:"match,omitempty"`
+	Lag                  uint64      `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	State                string      `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Active               bool        `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	LastContact          int64       `protobuf:"varint,7,opt,name=lastContact,proto3" json:"lastContact,omitempty"`
+	Snapshot             string      `protobuf:"bytes,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ClusterMemberStatus) Reset()         { *m = ClusterMemberStatus{} }
+func (m *ClusterMemberStatus) String() string { return proto.CompactTextString(m) }
+func (*ClusterMemberStatus) ProtoMessage()    {}
+func (*ClusterMemberStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
+}
+
+func (m *ClusterMemberStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterMemberStatus.Unmarshal(m, b)
+}
+func (m *ClusterMemberStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterMemberStatus.Marshal(b, m, deterministic)
+}
+func (m *ClusterMemberStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterMemberStatus.Merge(m, src)
+}
+func (m *ClusterMemberStatus) XXX_Size() int {
+	return xxx_messageInfo_ClusterMemberStatus.Size(m)
+}
+func (m *ClusterMemberStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterMemberStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterMemberStatus proto.InternalMessageInfo
+
+func (m *ClusterMemberStatus) GetAttr() *MemberAttr {
+	if m != nil {
+		return m.Attr
+	}
+	return nil
+}
+
+func (m *ClusterMemberStatus) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *ClusterMemberStatus) GetMatch() uint64 {
+	if m != nil {
+		return m.Match
+	}
+	return 0
+}
+
+func (m *ClusterMemberStatus) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *ClusterMemberStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ClusterMemberStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *ClusterMemberStatus) GetLastContact() int64 {
+	if m != nil {
+		return m.LastContact
+	}
+	return 0
+}
+
+func (m *ClusterMemberStatus) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+// ClusterStatus is the membership and the progress of a raft cluster seen by a node. Match, lag and state of the members are known only when the node is the leader.
+type ClusterStatus struct {
+	ChainID              []byte                 `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	NodeID               uint64                 `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Leader               uint64                 `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	Term                 uint64                 `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	Commit               uint64                 `protobuf:"varint,5,opt,name=commit,proto3" json:"commit,omitempty"`
+	Applied              uint64                 `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	SnapshotIndex        uint64                 `protobuf:"varint,7,opt,name=snapshotIndex,proto3" json:"snapshotIndex,omitempty"`
+	HasProgress          bool                   `protobuf:"varint,8,opt,name=hasProgress,proto3" json:"hasProgress,omitempty"`
+	Members              []*ClusterMemberStatus `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ClusterStatus) Reset()         { *m = ClusterStatus{} }
+func (m *ClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*ClusterStatus) ProtoMessage()    {}
+func (*ClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
+}
+
+func (m *ClusterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterStatus.Unmarshal(m, b)
+}
+func (m *ClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterStatus.Marshal(b, m, deterministic)
+}
+func (m *ClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterStatus.Merge(m, src)
+}
+func (m *ClusterStatus) XXX_Size() int {
+	return xxx_messageInfo_ClusterStatus.Size(m)
+}
+func (m *ClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterStatus proto.InternalMessageInfo
+
+func (m *ClusterStatus) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *ClusterStatus) GetNodeID() uint64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetLeader() uint64 {
+	if m != nil {
+		return m.Leader
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetApplied() uint64 {
+	if m != nil {
+		return m.Applied
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetSnapshotIndex() uint64 {
+	if m != nil {
+		return m.SnapshotIndex
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetHasProgress() bool {
+	if m != nil {
+		return m.HasProgress
+	}
+	return false
+}
+
+func (m *ClusterStatus) GetMembers() []*ClusterMemberStatus {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// ClusterSnapshot is the raft log index of the latest snapshot of a node.
+type ClusterSnapshot struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterSnapshot) Reset()         { *m = ClusterSnapshot{} }
+func (m *ClusterSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ClusterSnapshot) ProtoMessage()    {}
+func (*ClusterSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
+}
+
+func (m *ClusterSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterSnapshot.Unmarshal(m, b)
+}
+func (m *ClusterSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterSnapshot.Marshal(b, m, deterministic)
+}
+func (m *ClusterSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterSnapshot.Merge(m, src)
+}
+func (m *ClusterSnapshot) XXX_Size() int {
+	return xxx_messageInfo_ClusterSnapshot.Size(m)
+}
+func (m *ClusterSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterSnapshot proto.InternalMessageInfo
+
+func (m *ClusterSnapshot) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// EventListParams is a request for a page of the events matching the filter. The page starts at the cursor returned with the previous page.
+type EventListParams struct {
+	Filter               *FilterInfo `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Cursor               []byte      `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Size                 uint32      `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *EventListParams) Reset()         { *m = EventListParams{} }
+func (m *EventListParams) String() string { return proto.CompactTextString(m) }
+func (*EventListParams) ProtoMessage()    {}
+func (*EventListParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+}
+
+func (m *EventListParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventListParams.Unmarshal(m, b)
+}
+func (m *EventListParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventListParams.Marshal(b, m, deterministic)
+}
+func (m *EventListParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventListParams.Merge(m, src)
+}
+func (m *EventListParams) XXX_Size() int {
+	return xxx_messageInfo_EventListParams.Size(m)
+}
+func (m *EventListParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventListParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventListParams proto.InternalMessageInfo
+
+func (m *EventListParams) GetFilter() *FilterInfo {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *EventListParams) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *EventListParams) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// EventPage is a page of events. NextCursor is set when more events remain.
+type EventPage struct {
+	Events               []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor           []byte   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventPage) Reset()         { *m = EventPage{} }
+func (m *EventPage) String() string { return proto.CompactTextString(m) }
+func (*EventPage) ProtoMessage()    {}
+func (*EventPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+}
+
+func (m *EventPage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventPage.Unmarshal(m, b)
+}
+func (m *EventPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventPage.Marshal(b, m, deterministic)
+}
+func (m *EventPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPage.Merge(m, src)
+}
+func (m *EventPage) XXX_Size() int {
+	return xxx_messageInfo_EventPage.Size(m)
+}
+func (m *EventPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPage proto.InternalMessageInfo
+
+func (m *EventPage) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *EventPage) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+// KeystoreParams is a request to export an account in keystore format with the kdf, scrypt or argon2id.
+type KeystoreParams struct {
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Passphrase           string   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Kdf                  string   `protobuf:"bytes,3,opt,name=kdf,proto3" json:"kdf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeystoreParams) Reset()         { *m = KeystoreParams{} }
+func (m *KeystoreParams) String() string { return proto.CompactTextString(m) }
+func (*KeystoreParams) ProtoMessage()    {}
+func (*KeystoreParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+}
+
+func (m *KeystoreParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeystoreParams.Unmarshal(m, b)
+}
+func (m *KeystoreParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeystoreParams.Marshal(b, m, deterministic)
+}
+func (m *KeystoreParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeystoreParams.Merge(m, src)
+}
+func (m *KeystoreParams) XXX_Size() int {
+	return xxx_messageInfo_KeystoreParams.Size(m)
+}
+func (m *KeystoreParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeystoreParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeystoreParams proto.InternalMessageInfo
+
+func (m *KeystoreParams) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *KeystoreParams) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *KeystoreParams) GetKdf() string {
+	if m != nil {
+		return m.Kdf
+	}
+	return ""
+}
+
+// BlockDetailParams is a request for a block with the details to include in the response.
+type BlockDetailParams struct {
+	Hashornumber         []byte   `protobuf:"bytes,1,opt,name=hashornumber,proto3" json:"hashornumber,omitempty"`
+	Receipts             bool     `protobuf:"varint,2,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	Consensus            bool     `protobuf:"varint,3,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	Fees                 bool     `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockDetailParams) Reset()         { *m = BlockDetailParams{} }
+func (m *BlockDetailParams) String() string { return proto.CompactTextString(m) }
+func (*BlockDetailParams) ProtoMessage()    {}
+func (*BlockDetailParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+}
+
+func (m *BlockDetailParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockDetailParams.Unmarshal(m, b)
+}
+func (m *BlockDetailParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockDetailParams.Marshal(b, m, deterministic)
+}
+func (m *BlockDetailParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDetailParams.Merge(m, src)
+}
+func (m *BlockDetailParams) XXX_Size() int {
+	return xxx_messageInfo_BlockDetailParams.Size(m)
+}
+func (m *BlockDetailParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDetailParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDetailParams proto.InternalMessageInfo
+
+func (m *BlockDetailParams) GetHashornumber() []byte {
+	if m != nil {
+		return m.Hashornumber
+	}
+	return nil
+}
+
+func (m *BlockDetailParams) GetReceipts() bool {
+	if m != nil {
+		return m.Receipts
+	}
+	return false
+}
+
+func (m *BlockDetailParams) GetConsensus() bool {
+	if m != nil {
+		return m.Consensus
+	}
+	return false
+}
+
+func (m *BlockDetailParams) GetFees() bool {
+	if m != nil {
+		return m.Fees
+	}
+	return false
+}
+
+// BlockDetail is a block with the receipts of its txs, its consensus info as JSON and the totals of
+// the fees and the gas used by its txs, as requested.
+type BlockDetail struct {
+	Block                *Block     `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Receipts             []*Receipt `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	ConsensusInfo        string     `protobuf:"bytes,3,opt,name=consensusInfo,proto3" json:"consensusInfo,omitempty"`
+	TotalFee             []byte     `protobuf:"bytes,4,opt,name=totalFee,proto3" json:"totalFee,omitempty"`
+	TotalGasUsed         uint64     `protobuf:"varint,5,opt,name=totalGasUsed,proto3" json:"totalGasUsed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BlockDetail) Reset()         { *m = BlockDetail{} }
+func (m *BlockDetail) String() string { return proto.CompactTextString(m) }
+func (*BlockDetail) ProtoMessage()    {}
+func (*BlockDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
+}
+
+func (m *BlockDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockDetail.Unmarshal(m, b)
+}
+func (m *BlockDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockDetail.Marshal(b, m, deterministic)
+}
+func (m *BlockDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDetail.Merge(m, src)
+}
+func (m *BlockDetail) XXX_Size() int {
+	return xxx_messageInfo_BlockDetail.Size(m)
+}
+func (m *BlockDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDetail proto.InternalMessageInfo
+
+func (m *BlockDetail) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockDetail) GetReceipts() []*Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *BlockDetail) GetConsensusInfo() string {
+	if m != nil {
+		return m.ConsensusInfo
+	}
+	return ""
+}
+
+func (m *BlockDetail) GetTotalFee() []byte {
+	if m != nil {
+		return m.TotalFee
+	}
+	return nil
+}
+
+func (m *BlockDetail) GetTotalGasUsed() uint64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+// BlockStreamParams selects the details streamed with each new block.
+type BlockStreamParams struct {
+	Receipts             bool     `protobuf:"varint,1,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	Consensus            bool     `protobuf:"varint,2,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	Fees                 bool     `protobuf:"varint,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockStreamParams) Reset()         { *m = BlockStreamParams{} }
+func (m *BlockStreamParams) String() string { return proto.CompactTextString(m) }
+func (*BlockStreamParams) ProtoMessage()    {}
+func (*BlockStreamParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
+}
+
+func (m *BlockStreamParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockStreamParams.Unmarshal(m, b)
+}
+func (m *BlockStreamParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockStreamParams.Marshal(b, m, deterministic)
+}
+func (m *BlockStreamParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStreamParams.Merge(m, src)
+}
+func (m *BlockStreamParams) XXX_Size() int {
+	return xxx_messageInfo_BlockStreamParams.Size(m)
+}
+func (m *BlockStreamParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStreamParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStreamParams proto.InternalMessageInfo
+
+func (m *BlockStreamParams) GetReceipts() bool {
+	if m != nil {
+		return m.Receipts
+	}
+	return false
+}
+
+func (m *BlockStreamParams) GetConsensus() bool {
+	if m != nil {
+		return m.Consensus
+	}
+	return false
+}
+
+func (m *BlockStreamParams) GetFees() bool {
+	if m != nil {
+		return m.Fees
+	}
+	return false
+}
+
+// EventStreamFilter matches the events of any of the contracts with any of the names and the
+// arguments of the filter. No contract or no name matches all of them.
+type EventStreamFilter struct {
+	ContractAddresses    [][]byte `protobuf:"bytes,1,rep,name=contractAddresses,proto3" json:"contractAddresses,omitempty"`
+	EventNames           []string `protobuf:"bytes,2,rep,name=eventNames,proto3" json:"eventNames,omitempty"`
+	ArgFilter            []byte   `protobuf:"bytes,3,opt,name=argFilter,proto3" json:"argFilter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventStreamFilter) Reset()         { *m = EventStreamFilter{} }
+func (m *EventStreamFilter) String() string { return proto.CompactTextString(m) }
+func (*EventStreamFilter) ProtoMessage()    {}
+func (*EventStreamFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
+}
+
+func (m *EventStreamFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventStreamFilter.Unmarshal(m, b)
+}
+func (m *EventStreamFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventStreamFilter.Marshal(b, m, deterministic)
+}
+func (m *EventStreamFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStreamFilter.Merge(m, src)
+}
+func (m *EventStreamFilter) XXX_Size() int {
+	return xxx_messageInfo_EventStreamFilter.Size(m)
+}
+func (m *EventStreamFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStreamFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStreamFilter proto.InternalMessageInfo
+
+func (m *EventStreamFilter) GetContractAddresses() [][]byte {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
+}
+
+func (m *EventStreamFilter) GetEventNames() []string {
+	if m != nil {
+		return m.EventNames
+	}
+	return nil
+}
+
+func (m *EventStreamFilter) GetArgFilter() []byte {
+	if m != nil {
+		return m.ArgFilter
+	}
+	return nil
+}
+
+// ConsensusEvent is a change of the leader or the membership of a raft cluster.
+type ConsensusEvent struct {
+	Type                 string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Term                 uint64      `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	Member               *MemberAttr `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Timestamp            int64       `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ConsensusEvent) Reset()         { *m = ConsensusEvent{} }
+func (m *ConsensusEvent) String() string { return proto.CompactTextString(m) }
+func (*ConsensusEvent) ProtoMessage()    {}
+func (*ConsensusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
+}
+
+func (m *ConsensusEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusEvent.Unmarshal(m, b)
+}
+func (m *ConsensusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusEvent.Marshal(b, m, deterministic)
+}
+func (m *ConsensusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusEvent.Merge(m, src)
+}
+func (m *ConsensusEvent) XXX_Size() int {
+	return xxx_messageInfo_ConsensusEvent.Size(m)
+}
+func (m *ConsensusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusEvent proto.InternalMessageInfo
+
+func (m *ConsensusEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConsensusEvent) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *ConsensusEvent) GetMember() *MemberAttr {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *ConsensusEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// BulkParams are the keys of the items of a bulk query, the hashes or the 8 byte little endian numbers of the blocks, or the hashes of the txs.
+type BulkParams struct {
+	Keys                 [][]byte `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BulkParams) Reset()         { *m = BulkParams{} }
+func (m *BulkParams) String() string { return proto.CompactTextString(m) }
+func (*BulkParams) ProtoMessage()    {}
+func (*BulkParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
+}
+
+func (m *BulkParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkParams.Unmarshal(m, b)
+}
+func (m *BulkParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkParams.Marshal(b, m, deterministic)
+}
+func (m *BulkParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkParams.Merge(m, src)
+}
+func (m *BulkParams) XXX_Size() int {
+	return xxx_messageInfo_BulkParams.Size(m)
+}
+func (m *BulkParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BulkParams proto.InternalMessageInfo
+
+func (m *BulkParams) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// BlockBulkItem is the block of a key of a bulk query, or the grpc status code and the message of the error of the key.
+type BlockBulkItem struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Block                *Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Code                 uint32   `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockBulkItem) Reset()         { *m = BlockBulkItem{} }
+func (m *BlockBulkItem) String() string { return proto.CompactTextString(m) }
+func (*BlockBulkItem) ProtoMessage()    {}
+func (*BlockBulkItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
+}
+
+func (m *BlockBulkItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockBulkItem.Unmarshal(m, b)
+}
+func (m *BlockBulkItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockBulkItem.Marshal(b, m, deterministic)
+}
+func (m *BlockBulkItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBulkItem.Merge(m, src)
+}
+func (m *BlockBulkItem) XXX_Size() int {
+	return xxx_messageInfo_BlockBulkItem.Size(m)
+}
+func (m *BlockBulkItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBulkItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBulkItem proto.InternalMessageInfo
+
+func (m *BlockBulkItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *BlockBulkItem) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockBulkItem) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BlockBulkItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BlockBulk struct {
+	Items                []*BlockBulkItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BlockBulk) Reset()         { *m = BlockBulk{} }
+func (m *BlockBulk) String() string { return proto.CompactTextString(m) }
+func (*BlockBulk) ProtoMessage()    {}
+func (*BlockBulk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
+}
+
+func (m *BlockBulk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockBulk.Unmarshal(m, b)
+}
+func (m *BlockBulk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockBulk.Marshal(b, m, deterministic)
+}
+func (m *BlockBulk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockBulk.Merge(m, src)
+}
+func (m *BlockBulk) XXX_Size() int {
+	return xxx_messageInfo_BlockBulk.Size(m)
+}
+func (m *BlockBulk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockBulk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockBulk proto.InternalMessageInfo
+
+func (m *BlockBulk) GetItems() []*BlockBulkItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// TxBulkItem is the tx of a hash of a bulk query, or the grpc status code and the message of the error of the hash.
+type TxBulkItem struct {
+	Hash                 []byte     `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Tx                   *TxInBlock `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+	Code                 uint32     `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error                string     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *TxBulkItem) Reset()         { *m = TxBulkItem{} }
+func (m *TxBulkItem) String() string { return proto.CompactTextString(m) }
+func (*TxBulkItem) ProtoMessage()    {}
+func (*TxBulkItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
+}
+
+func (m *TxBulkItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxBulkItem.Unmarshal(m, b)
+}
+func (m *TxBulkItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxBulkItem.Marshal(b, m, deterministic)
+}
+func (m *TxBulkItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxBulkItem.Merge(m, src)
+}
+func (m *TxBulkItem) XXX_Size() int {
+	return xxx_messageInfo_TxBulkItem.Size(m)
+}
+func (m *TxBulkItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxBulkItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxBulkItem proto.InternalMessageInfo
+
+func (m *TxBulkItem) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TxBulkItem) GetTx() *TxInBlock {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TxBulkItem) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxBulkItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type TxBulk struct {
+	Items                []*TxBulkItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TxBulk) Reset()         { *m = TxBulk{} }
+func (m *TxBulk) String() string { return proto.CompactTextString(m) }
+func (*TxBulk) ProtoMessage()    {}
+func (*TxBulk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+}
+
+func (m *TxBulk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxBulk.Unmarshal(m, b)
+}
+func (m *TxBulk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxBulk.Marshal(b, m, deterministic)
+}
+func (m *TxBulk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxBulk.Merge(m, src)
+}
+func (m *TxBulk) XXX_Size() int {
+	return xxx_messageInfo_TxBulk.Size(m)
+}
+func (m *TxBulk) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxBulk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxBulk proto.InternalMessageInfo
+
+func (m *TxBulk) GetItems() []*TxBulkItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// LogLevel is the log level of the loggers of a module like chain, mempool, p2p or raft. An empty module is all the modules.
+type LogLevel struct {
+	Module               string   `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogLevel) Reset()         { *m = LogLevel{} }
+func (m *LogLevel) String() string { return proto.CompactTextString(m) }
+func (*LogLevel) ProtoMessage()    {}
+func (*LogLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+}
+
+func (m *LogLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevel.Unmarshal(m, b)
+}
+func (m *LogLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevel.Marshal(b, m, deterministic)
+}
+func (m *LogLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevel.Merge(m, src)
+}
+func (m *LogLevel) XXX_Size() int {
+	return xxx_messageInfo_LogLevel.Size(m)
+}
+func (m *LogLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevel proto.InternalMessageInfo
+
+func (m *LogLevel) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *LogLevel) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+type LogLevelList struct {
+	Levels               []*LogLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LogLevelList) Reset()         { *m = LogLevelList{} }
+func (m *LogLevelList) String() string { return proto.CompactTextString(m) }
+func (*LogLevelList) ProtoMessage()    {}
+func (*LogLevelList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+}
+
+func (m *LogLevelList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelList.Unmarshal(m, b)
+}
+func (m *LogLevelList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelList.Marshal(b, m, deterministic)
+}
+func (m *LogLevelList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelList.Merge(m, src)
+}
+func (m *LogLevelList) XXX_Size() int {
+	return xxx_messageInfo_LogLevelList.Size(m)
+}
+func (m *LogLevelList) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelList proto.InternalMessageInfo
+
+func (m *LogLevelList) GetLevels() []*LogLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+// Profiling is whether the pprof endpoints of the node are served, and their address if they are.
+type Profiling struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profiling) Reset()         { *m = Profiling{} }
+func (m *Profiling) String() string { return proto.CompactTextString(m) }
+func (*Profiling) ProtoMessage()    {}
+func (*Profiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+}
+
+func (m *Profiling) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profiling.Unmarshal(m, b)
+}
+func (m *Profiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profiling.Marshal(b, m, deterministic)
+}
+func (m *Profiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profiling.Merge(m, src)
+}
+func (m *Profiling) XXX_Size() int {
+	return xxx_messageInfo_Profiling.Size(m)
+}
+func (m *Profiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profiling proto.InternalMessageInfo
+
+func (m *Profiling) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Profiling) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ProfileDump is a profile of the node, like goroutine or heap, and the path of the file it is written to.
+type ProfileDump struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileDump) Reset()         { *m = ProfileDump{} }
+func (m *ProfileDump) String() string { return proto.CompactTextString(m) }
+func (*ProfileDump) ProtoMessage()    {}
+func (*ProfileDump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+}
+
+func (m *ProfileDump) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileDump.Unmarshal(m, b)
+}
+func (m *ProfileDump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileDump.Marshal(b, m, deterministic)
+}
+func (m *ProfileDump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileDump.Merge(m, src)
+}
+func (m *ProfileDump) XXX_Size() int {
+	return xxx_messageInfo_ProfileDump.Size(m)
+}
+func (m *ProfileDump) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileDump.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileDump proto.InternalMessageInfo
+
+func (m *ProfileDump) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ProfileDump) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// AccountTxHistoryParams selects a page of the txs sent or received by an account, the latest first.
+type AccountTxHistoryParams struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor               []byte   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Size                 uint32   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountTxHistoryParams) Reset()         { *m = AccountTxHistoryParams{} }
+func (m *AccountTxHistoryParams) String() string { return proto.CompactTextString(m) }
+func (*AccountTxHistoryParams) ProtoMessage()    {}
+func (*AccountTxHistoryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+}
+
+func (m *AccountTxHistoryParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxHistoryParams.Unmarshal(m, b)
+}
+func (m *AccountTxHistoryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxHistoryParams.Marshal(b, m, deterministic)
+}
+func (m *AccountTxHistoryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxHistoryParams.Merge(m, src)
+}
+func (m *AccountTxHistoryParams) XXX_Size() int {
+	return xxx_messageInfo_AccountTxHistoryParams.Size(m)
+}
+func (m *AccountTxHistoryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxHistoryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxHistoryParams proto.InternalMessageInfo
+
+func (m *AccountTxHistoryParams) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountTxHistoryParams) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *AccountTxHistoryParams) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+// AccountTx is a tx of an account with the block including it and the status of its receipt.
+type AccountTx struct {
+	Tx                   *Tx      `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	TxIdx                int32    `protobuf:"varint,4,opt,name=txIdx,proto3" json:"txIdx,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountTx) Reset()         { *m = AccountTx{} }
+func (m *AccountTx) String() string { return proto.CompactTextString(m) }
+func (*AccountTx) ProtoMessage()    {}
+func (*AccountTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+}
+
+func (m *AccountTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTx.Unmarshal(m, b)
+}
+func (m *AccountTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTx.Marshal(b, m, deterministic)
+}
+func (m *AccountTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTx.Merge(m, src)
+}
+func (m *AccountTx) XXX_Size() int {
+	return xxx_messageInfo_AccountTx.Size(m)
+}
+func (m *AccountTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTx proto.InternalMessageInfo
+
+func (m *AccountTx) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *AccountTx) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountTx) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *AccountTx) GetTxIdx() int32 {
+	if m != nil {
+		return m.TxIdx
+	}
+	return 0
+}
+
+func (m *AccountTx) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type AccountTxHistory struct {
+	Txs                  []*AccountTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	NextCursor           []byte       `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AccountTxHistory) Reset()         { *m = AccountTxHistory{} }
+func (m *AccountTxHistory) String() string { return proto.CompactTextString(m) }
+func (*AccountTxHistory) ProtoMessage()    {}
+func (*AccountTxHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{78}
+}
+
+func (m *AccountTxHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxHistory.Unmarshal(m, b)
+}
+func (m *AccountTxHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxHistory.Marshal(b, m, deterministic)
+}
+func (m *AccountTxHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxHistory.Merge(m, src)
+}
+func (m *AccountTxHistory) XXX_Size() int {
+	return xxx_messageInfo_AccountTxHistory.Size(m)
+}
+func (m *AccountTxHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxHistory proto.InternalMessageInfo
+
+func (m *AccountTxHistory) GetTxs() []*AccountTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *AccountTxHistory) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
+}
+
+// CallEncodeParams is a call to a function of a contract, given by its address or name, with the arguments as a JSON array and the amount sent with the call.
+type CallEncodeParams struct {
+	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	JsonArgs             string   `protobuf:"bytes,3,opt,name=jsonArgs,proto3" json:"jsonArgs,omitempty"`
+	Amount               []byte   `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallEncodeParams) Reset()         { *m = CallEncodeParams{} }
+func (m *CallEncodeParams) String() string { return proto.CompactTextString(m) }
+func (*CallEncodeParams) ProtoMessage()    {}
+func (*CallEncodeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{79}
+}
+
+func (m *CallEncodeParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallEncodeParams.Unmarshal(m, b)
+}
+func (m *CallEncodeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallEncodeParams.Marshal(b, m, deterministic)
+}
+func (m *CallEncodeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallEncodeParams.Merge(m, src)
+}
+func (m *CallEncodeParams) XXX_Size() int {
+	return xxx_messageInfo_CallEncodeParams.Size(m)
+}
+func (m *CallEncodeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallEncodeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallEncodeParams proto.InternalMessageInfo
+
+func (m *CallEncodeParams) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *CallEncodeParams) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CallEncodeParams) GetJsonArgs() string {
+	if m != nil {
+		return m.JsonArgs
+	}
+	return ""
+}
+
+func (m *CallEncodeParams) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ContractABI is the ABI of a contract with the address its name is resolved to and the hash of its code.
+type ContractABI struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CodeHash             []byte   `protobuf:"bytes,2,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Abi                  *ABI     `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractABI) Reset()         { *m = ContractABI{} }
+func (m *ContractABI) String() string { return proto.CompactTextString(m) }
+func (*ContractABI) ProtoMessage()    {}
+func (*ContractABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+}
+
+func (m *ContractABI) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractABI.Unmarshal(m, b)
+}
+func (m *ContractABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractABI.Marshal(b, m, deterministic)
+}
+func (m *ContractABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractABI.Merge(m, src)
+}
+func (m *ContractABI) XXX_Size() int {
+	return xxx_messageInfo_ContractABI.Size(m)
+}
+func (m *ContractABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractABI proto.InternalMessageInfo
+
+func (m *ContractABI) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ContractABI) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *ContractABI) GetAbi() *ABI {
+	if m != nil {
+		return m.Abi
+	}
+	return nil
+}
+
+// HDWalletParams is a request to create the hd wallet of the node from the mnemonic, or from a new mnemonic of the words if it is empty.
+type HDWalletParams struct {
+	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Mnemonic             string   `protobuf:"bytes,2,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	SeedPassphrase       string   `protobuf:"bytes,3,opt,name=seedPassphrase,proto3" json:"seedPassphrase,omitempty"`
+	Words                uint32   `protobuf:"varint,4,opt,name=words,proto3" json:"words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HDWalletParams) Reset()         { *m = HDWalletParams{} }
+func (m *HDWalletParams) String() string { return proto.CompactTextString(m) }
+func (*HDWalletParams) ProtoMessage()    {}
+func (*HDWalletParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+}
+
+func (m *HDWalletParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDWalletParams.Unmarshal(m, b)
+}
+func (m *HDWalletParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDWalletParams.Marshal(b, m, deterministic)
+}
+func (m *HDWalletParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDWalletParams.Merge(m, src)
+}
+func (m *HDWalletParams) XXX_Size() int {
+	return xxx_messageInfo_HDWalletParams.Size(m)
+}
+func (m *HDWalletParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDWalletParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDWalletParams proto.InternalMessageInfo
+
+func (m *HDWalletParams) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *HDWalletParams) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *HDWalletParams) GetSeedPassphrase() string {
+	if m != nil {
+		return m.SeedPassphrase
+	}
+	return ""
+}
+
+func (m *HDWalletParams) GetWords() uint32 {
+	if m != nil {
+		return m.Words
+	}
+	return 0
+}
+
+// HDWallet is the mnemonic of the hd wallet of the node.
+type HDWallet struct {
+	Mnemonic             string   `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HDWallet) Reset()         { *m = HDWallet{} }
+func (m *HDWallet) String() string { return proto.CompactTextString(m) }
+func (*HDWallet) ProtoMessage()    {}
+func (*HDWallet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+}
+
+func (m *HDWallet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDWallet.Unmarshal(m, b)
+}
+func (m *HDWallet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDWallet.Marshal(b, m, deterministic)
+}
+func (m *HDWallet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDWallet.Merge(m, src)
+}
+func (m *HDWallet) XXX_Size() int {
+	return xxx_messageInfo_HDWallet.Size(m)
+}
+func (m *HDWallet) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDWallet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDWallet proto.InternalMessageInfo
+
+func (m *HDWallet) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+// DeriveParams is a request to derive the accounts of the count indices from index in the hd account.
+type DeriveParams struct {
+	Passphrase           string   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Count                uint32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeriveParams) Reset()         { *m = DeriveParams{} }
+func (m *DeriveParams) String() string { return proto.CompactTextString(m) }
+func (*DeriveParams) ProtoMessage()    {}
+func (*DeriveParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+}
+
+func (m *DeriveParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeriveParams.Unmarshal(m, b)
+}
+func (m *DeriveParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeriveParams.Marshal(b, m, deterministic)
+}
+func (m *DeriveParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeriveParams.Merge(m, src)
+}
+func (m *DeriveParams) XXX_Size() int {
+	return xxx_messageInfo_DeriveParams.Size(m)
+}
+func (m *DeriveParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeriveParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeriveParams proto.InternalMessageInfo
+
+func (m *DeriveParams) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *DeriveParams) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *DeriveParams) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DeriveParams) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// HDAccount is an account derived from the hd wallet along the path.
+type HDAccount struct {
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HDAccount) Reset()         { *m = HDAccount{} }
+func (m *HDAccount) String() string { return proto.CompactTextString(m) }
+func (*HDAccount) ProtoMessage()    {}
+func (*HDAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+}
+
+func (m *HDAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDAccount.Unmarshal(m, b)
+}
+func (m *HDAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDAccount.Marshal(b, m, deterministic)
+}
+func (m *HDAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDAccount.Merge(m, src)
+}
+func (m *HDAccount) XXX_Size() int {
+	return xxx_messageInfo_HDAccount.Size(m)
+}
+func (m *HDAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDAccount proto.InternalMessageInfo
+
+func (m *HDAccount) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *HDAccount) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type HDAccountList struct {
+	Accounts             []*HDAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HDAccountList) Reset()         { *m = HDAccountList{} }
+func (m *HDAccountList) String() string { return proto.CompactTextString(m) }
+func (*HDAccountList) ProtoMessage()    {}
+func (*HDAccountList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{85}
+}
+
+func (m *HDAccountList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HDAccountList.Unmarshal(m, b)
+}
+func (m *HDAccountList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HDAccountList.Marshal(b, m, deterministic)
+}
+func (m *HDAccountList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HDAccountList.Merge(m, src)
+}
+func (m *HDAccountList) XXX_Size() int {
+	return xxx_messageInfo_HDAccountList.Size(m)
+}
+func (m *HDAccountList) XXX_DiscardUnknown() {
+	xxx_messageInfo_HDAccountList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HDAccountList proto.InternalMessageInfo
+
+func (m *HDAccountList) GetAccounts() []*HDAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// SignBlockRequest is a request to a remote signer to sign the header of a block produced.
+type SignBlockRequest struct {
+	ChainID              []byte   `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Header               []byte   `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignBlockRequest) Reset()         { *m = SignBlockRequest{} }
+func (m *SignBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*SignBlockRequest) ProtoMessage()    {}
+func (*SignBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{86}
+}
+
+func (m *SignBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignBlockRequest.Unmarshal(m, b)
+}
+func (m *SignBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *SignBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBlockRequest.Merge(m, src)
+}
+func (m *SignBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_SignBlockRequest.Size(m)
+}
+func (m *SignBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignBlockRequest proto.InternalMessageInfo
+
+func (m *SignBlockRequest) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *SignBlockRequest) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *SignBlockRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type BlockSignature struct {
+	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockSignature) Reset()         { *m = BlockSignature{} }
+func (m *BlockSignature) String() string { return proto.CompactTextString(m) }
+func (*BlockSignature) ProtoMessage()    {}
+func (*BlockSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{87}
+}
+
+func (m *BlockSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSignature.Unmarshal(m, b)
+}
+func (m *BlockSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSignature.Marshal(b, m, deterministic)
+}
+func (m *BlockSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSignature.Merge(m, src)
+}
+func (m *BlockSignature) XXX_Size() int {
+	return xxx_messageInfo_BlockSignature.Size(m)
+}
+func (m *BlockSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSignature proto.InternalMessageInfo
+
+func (m *BlockSignature) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+// SignerKey is the public key of a remote signer in the format of the block header.
+type SignerKey struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignerKey) Reset()         { *m = SignerKey{} }
+func (m *SignerKey) String() string { return proto.CompactTextString(m) }
+func (*SignerKey) ProtoMessage()    {}
+func (*SignerKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{88}
+}
+
+func (m *SignerKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignerKey.Unmarshal(m, b)
+}
+func (m *SignerKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignerKey.Marshal(b, m, deterministic)
+}
+func (m *SignerKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerKey.Merge(m, src)
+}
+func (m *SignerKey) XXX_Size() int {
+	return xxx_messageInfo_SignerKey.Size(m)
+}
+func (m *SignerKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerKey proto.InternalMessageInfo
+
+func (m *SignerKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// UnlockStatus is the unlock session of an account in the node. The times are in unix nanoseconds, and expireAt is 0 if the account is not locked automatically.
+type UnlockStatus struct {
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Unlocked             bool     `protobuf:"varint,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt           int64    `protobuf:"varint,3,opt,name=unlockedAt,proto3" json:"unlockedAt,omitempty"`
+	ExpireAt             int64    `protobuf:"varint,4,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockStatus) Reset()         { *m = UnlockStatus{} }
+func (m *UnlockStatus) String() string { return proto.CompactTextString(m) }
+func (*UnlockStatus) ProtoMessage()    {}
+func (*UnlockStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+}
+
+func (m *UnlockStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockStatus.Unmarshal(m, b)
+}
+func (m *UnlockStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockStatus.Marshal(b, m, deterministic)
+}
+func (m *UnlockStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockStatus.Merge(m, src)
+}
+func (m *UnlockStatus) XXX_Size() int {
+	return xxx_messageInfo_UnlockStatus.Size(m)
+}
+func (m *UnlockStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockStatus proto.InternalMessageInfo
+
+func (m *UnlockStatus) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *UnlockStatus) GetUnlocked() bool {
+	if m != nil {
+		return m.Unlocked
+	}
+	return false
+}
+
+func (m *UnlockStatus) GetUnlockedAt() int64 {
+	if m != nil {
+		return m.UnlockedAt
+	}
+	return 0
+}
+
+func (m *UnlockStatus) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+// KeyShareInfo describes the share of the block signing key held by a threshold signer.
+type KeyShareInfo struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Dealing              string   `protobuf:"bytes,2,opt,name=dealing,proto3" json:"dealing,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Threshold            uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	NextPresig           uint64   `protobuf:"varint,5,opt,name=nextPresig,proto3" json:"nextPresig,omitempty"`
+	Presigs              uint64   `protobuf:"varint,6,opt,name=presigs,proto3" json:"presigs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyShareInfo) Reset()         { *m = KeyShareInfo{} }
+func (m *KeyShareInfo) String() string { return proto.CompactTextString(m) }
+func (*KeyShareInfo) ProtoMessage()    {}
+func (*KeyShareInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+}
+
+func (m *KeyShareInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyShareInfo.Unmarshal(m, b)
+}
+func (m *KeyShareInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyShareInfo.Marshal(b, m, deterministic)
+}
+func (m *KeyShareInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShareInfo.Merge(m, src)
+}
+func (m *KeyShareInfo) XXX_Size() int {
+	return xxx_messageInfo_KeyShareInfo.Size(m)
+}
+func (m *KeyShareInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShareInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShareInfo proto.InternalMessageInfo
+
+func (m *KeyShareInfo) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *KeyShareInfo) GetDealing() string {
+	if m != nil {
+		return m.Dealing
+	}
+	return ""
+}
+
+func (m *KeyShareInfo) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *KeyShareInfo) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *KeyShareInfo) GetNextPresig() uint64 {
+	if m != nil {
+		return m.NextPresig
+	}
+	return 0
+}
+
+func (m *KeyShareInfo) GetPresigs() uint64 {
+	if m != nil {
+		return m.Presigs
+	}
+	return 0
+}
+
+// SignShareRequest is a request to a threshold signer to sign the header of a block with a presignature.
+type SignShareRequest struct {
+	ChainID              []byte   `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Header               []byte   `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Dealing              string   `protobuf:"bytes,4,opt,name=dealing,proto3" json:"dealing,omitempty"`
+	Presig               uint64   `protobuf:"varint,5,opt,name=presig,proto3" json:"presig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignShareRequest) Reset()         { *m = SignShareRequest{} }
+func (m *SignShareRequest) String() string { return proto.CompactTextString(m) }
+func (*SignShareRequest) ProtoMessage()    {}
+func (*SignShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+}
+
+func (m *SignShareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignShareRequest.Unmarshal(m, b)
+}
+func (m *SignShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignShareRequest.Marshal(b, m, deterministic)
+}
+func (m *SignShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignShareRequest.Merge(m, src)
+}
+func (m *SignShareRequest) XXX_Size() int {
+	return xxx_messageInfo_SignShareRequest.Size(m)
+}
+func (m *SignShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignShareRequest proto.InternalMessageInfo
+
+func (m *SignShareRequest) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *SignShareRequest) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *SignShareRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignShareRequest) GetDealing() string {
+	if m != nil {
+		return m.Dealing
+	}
+	return ""
+}
+
+func (m *SignShareRequest) GetPresig() uint64 {
+	if m != nil {
+		return m.Presig
+	}
+	return 0
+}
+
+// SignatureShare is the share of the signature of a block by a threshold signer.
+type SignatureShare struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	S                    []byte   `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	R                    []byte   `protobuf:"bytes,3,opt,name=r,proto3" json:"r,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureShare) Reset()         { *m = SignatureShare{} }
+func (m *SignatureShare) String() string { return proto.CompactTextString(m) }
+func (*SignatureShare) ProtoMessage()    {}
+func (*SignatureShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+}
+
+func (m *SignatureShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureShare.Unmarshal(m, b)
+}
+func (m *SignatureShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureShare.Marshal(b, m, deterministic)
+}
+func (m *SignatureShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureShare.Merge(m, src)
+}
+func (m *SignatureShare) XXX_Size() int {
+	return xxx_messageInfo_SignatureShare.Size(m)
+}
+func (m *SignatureShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureShare proto.InternalMessageInfo
+
+func (m *SignatureShare) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SignatureShare) GetS() []byte {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+func (m *SignatureShare) GetR() []byte {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+// SignAudit is a record of the signing of a transaction by the node.
+type SignAudit struct {
+	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Account              []byte   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Client               string   `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Peer                 string   `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignAudit) Reset()         { *m = SignAudit{} }
+func (m *SignAudit) String() string { return proto.CompactTextString(m) }
+func (*SignAudit) ProtoMessage()    {}
+func (*SignAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+}
+
+func (m *SignAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignAudit.Unmarshal(m, b)
+}
+func (m *SignAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignAudit.Marshal(b, m, deterministic)
+}
+func (m *SignAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignAudit.Merge(m, src)
+}
+func (m *SignAudit) XXX_Size() int {
+	return xxx_messageInfo_SignAudit.Size(m)
+}
+func (m *SignAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignAudit proto.InternalMessageInfo
+
+func (m *SignAudit) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SignAudit) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *SignAudit) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *SignAudit) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *SignAudit) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *SignAudit) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// SignAuditQuery selects the latest signing records of the account in the time range, in unix nanoseconds. Zero values are not used to select.
+type SignAuditQuery struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	From                 int64    `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit                uint32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignAuditQuery) Reset()         { *m = SignAuditQuery{} }
+func (m *SignAuditQuery) String() string { return proto.CompactTextString(m) }
+func (*SignAuditQuery) ProtoMessage()    {}
+func (*SignAuditQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+}
+
+func (m *SignAuditQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignAuditQuery.Unmarshal(m, b)
+}
+func (m *SignAuditQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignAuditQuery.Marshal(b, m, deterministic)
+}
+func (m *SignAuditQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignAuditQuery.Merge(m, src)
+}
+func (m *SignAuditQuery) XXX_Size() int {
+	return xxx_messageInfo_SignAuditQuery.Size(m)
+}
+func (m *SignAuditQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignAuditQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignAuditQuery proto.InternalMessageInfo
+
+func (m *SignAuditQuery) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *SignAuditQuery) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *SignAuditQuery) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *SignAuditQuery) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SignAuditList struct {
+	Audits               []*SignAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SignAuditList) Reset()         { *m = SignAuditList{} }
+func (m *SignAuditList) String() string { return proto.CompactTextString(m) }
+func (*SignAuditList) ProtoMessage()    {}
+func (*SignAuditList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+}
+
+func (m *SignAuditList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignAuditList.Unmarshal(m, b)
+}
+func (m *SignAuditList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignAuditList.Marshal(b, m, deterministic)
+}
+func (m *SignAuditList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignAuditList.Merge(m, src)
+}
+func (m *SignAuditList) XXX_Size() int {
+	return xxx_messageInfo_SignAuditList.Size(m)
+}
+func (m *SignAuditList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignAuditList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignAuditList proto.InternalMessageInfo
+
+func (m *SignAuditList) GetAudits() []*SignAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+// Alias is a label of an address in the address book of the node, distinct from the names on the chain.
+type Alias struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Address              []byte   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Alias) Reset()         { *m = Alias{} }
+func (m *Alias) String() string { return proto.CompactTextString(m) }
+func (*Alias) ProtoMessage()    {}
+func (*Alias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+}
+
+func (m *Alias) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Alias.Unmarshal(m, b)
+}
+func (m *Alias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Alias.Marshal(b, m, deterministic)
+}
+func (m *Alias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Alias.Merge(m, src)
+}
+func (m *Alias) XXX_Size() int {
+	return xxx_messageInfo_Alias.Size(m)
+}
+func (m *Alias) XXX_DiscardUnknown() {
+	xxx_messageInfo_Alias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Alias proto.InternalMessageInfo
+
+func (m *Alias) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Alias) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type AliasList struct {
+	Aliases              []*Alias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AliasList) Reset()         { *m = AliasList{} }
+func (m *AliasList) String() string { return proto.CompactTextString(m) }
+func (*AliasList) ProtoMessage()    {}
+func (*AliasList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+}
+
+func (m *AliasList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AliasList.Unmarshal(m, b)
+}
+func (m *AliasList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AliasList.Marshal(b, m, deterministic)
+}
+func (m *AliasList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AliasList.Merge(m, src)
+}
+func (m *AliasList) XXX_Size() int {
+	return xxx_messageInfo_AliasList.Size(m)
+}
+func (m *AliasList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AliasList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AliasList proto.InternalMessageInfo
+
+func (m *AliasList) GetAliases() []*Alias {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+// WatchAccount is an address watched by the node without its private key, with its nonce and balance in the best state.
+type WatchAccount struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce                uint64   `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Balance              []byte   `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchAccount) Reset()         { *m = WatchAccount{} }
+func (m *WatchAccount) String() string { return proto.CompactTextString(m) }
+func (*WatchAccount) ProtoMessage()    {}
+func (*WatchAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+}
+
+func (m *WatchAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAccount.Unmarshal(m, b)
+}
+func (m *WatchAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAccount.Marshal(b, m, deterministic)
+}
+func (m *WatchAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAccount.Merge(m, src)
+}
+func (m *WatchAccount) XXX_Size() int {
+	return xxx_messageInfo_WatchAccount.Size(m)
+}
+func (m *WatchAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAccount proto.InternalMessageInfo
+
+func (m *WatchAccount) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *WatchAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *WatchAccount) GetBalance() []byte {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+type WatchAccountList struct {
+	Accounts             []*WatchAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WatchAccountList) Reset()         { *m = WatchAccountList{} }
+func (m *WatchAccountList) String() string { return proto.CompactTextString(m) }
+func (*WatchAccountList) ProtoMessage()    {}
+func (*WatchAccountList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+}
+
+func (m *WatchAccountList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAccountList.Unmarshal(m, b)
+}
+func (m *WatchAccountList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAccountList.Marshal(b, m, deterministic)
+}
+func (m *WatchAccountList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAccountList.Merge(m, src)
+}
+func (m *WatchAccountList) XXX_Size() int {
+	return xxx_messageInfo_WatchAccountList.Size(m)
+}
+func (m *WatchAccountList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAccountList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAccountList proto.InternalMessageInfo
+
+func (m *WatchAccountList) GetAccounts() []*WatchAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// SystemTxSimulation is the result of a system tx run against the best state without committing it: the events or the error, and the system account info of the sender after the tx.
+type SystemTxSimulation struct {
+	Events               []*Event           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Error                string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Info                 *SystemAccountInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SystemTxSimulation) Reset()         { *m = SystemTxSimulation{} }
+func (m *SystemTxSimulation) String() string { return proto.CompactTextString(m) }
+func (*SystemTxSimulation) ProtoMessage()    {}
+func (*SystemTxSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+}
+
+func (m *SystemTxSimulation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemTxSimulation.Unmarshal(m, b)
+}
+func (m *SystemTxSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemTxSimulation.Marshal(b, m, deterministic)
+}
+func (m *SystemTxSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemTxSimulation.Merge(m, src)
+}
+func (m *SystemTxSimulation) XXX_Size() int {
+	return xxx_messageInfo_SystemTxSimulation.Size(m)
+}
+func (m *SystemTxSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemTxSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemTxSimulation proto.InternalMessageInfo
+
+func (m *SystemTxSimulation) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *SystemTxSimulation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SystemTxSimulation) GetInfo() *SystemAccountInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+// BlockTemplate is the candidate of the next block: the header fields known before the execution, the txs the node would try in its order, and the consensus of the chain.
+type BlockTemplate struct {
+	ChainID              []byte   `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	PrevBlockHash        []byte   `protobuf:"bytes,2,opt,name=prevBlockHash,proto3" json:"prevBlockHash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	CoinbaseAccount      []byte   `protobuf:"bytes,5,opt,name=coinbaseAccount,proto3" json:"coinbaseAccount,omitempty"`
+	ConsensusType        string   `protobuf:"bytes,6,opt,name=consensusType,proto3" json:"consensusType,omitempty"`
+	ConsensusInfo        string   `protobuf:"bytes,7,opt,name=consensusInfo,proto3" json:"consensusInfo,omitempty"`
+	TxOrder              string   `protobuf:"bytes,8,opt,name=txOrder,proto3" json:"txOrder,omitempty"`
+	Txs                  []*Tx    `protobuf:"bytes,9,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTemplate) Reset()         { *m = BlockTemplate{} }
+func (m *BlockTemplate) String() string { return proto.CompactTextString(m) }
+func (*BlockTemplate) ProtoMessage()    {}
+func (*BlockTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+}
+
+func (m *BlockTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTemplate.Unmarshal(m, b)
+}
+func (m *BlockTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTemplate.Marshal(b, m, deterministic)
+}
+func (m *BlockTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTemplate.Merge(m, src)
+}
+func (m *BlockTemplate) XXX_Size() int {
+	return xxx_messageInfo_BlockTemplate.Size(m)
+}
+func (m *BlockTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTemplate proto.InternalMessageInfo
+
+func (m *BlockTemplate) GetChainID() []byte {
+	if m != nil {
+		return m.ChainID
+	}
+	return nil
+}
+
+func (m *BlockTemplate) GetPrevBlockHash() []byte {
+	if m != nil {
+		return m.PrevBlockHash
+	}
+	return nil
+}
+
+func (m *BlockTemplate) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *BlockTemplate) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockTemplate) GetCoinbaseAccount() []byte {
+	if m != nil {
+		return m.CoinbaseAccount
+	}
+	return nil
+}
+
+func (m *BlockTemplate) GetConsensusType() string {
+	if m != nil {
+		return m.ConsensusType
+	}
+	return ""
+}
+
+func (m *BlockTemplate) GetConsensusInfo() string {
+	if m != nil {
+		return m.ConsensusInfo
+	}
+	return ""
+}
+
+func (m *BlockTemplate) GetTxOrder() string {
+	if m != nil {
+		return m.TxOrder
+	}
+	return ""
+}
+
+func (m *BlockTemplate) GetTxs() []*Tx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// ContractStorageUsage is the bytes of the keys and the values stored by a contract and the storage quota of the contracts, 0 for no quota.
+type ContractStorageUsage struct {
+	Usage                uint64   `protobuf:"varint,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	Quota                uint64   `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+}
+
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractStorageUsage.Unmarshal(m, b)
+}
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+}
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+func (m *ContractStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_ContractStorageUsage.Size(m)
+}
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+func (m *ContractStorageUsage) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+func (m *ContractStorageUsage) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
+// InternalOperation is a call, a send or a deploy made by a contract during a tx. Calls are the operations made by the callee in turn.
+type InternalOperation struct {
+	Op                   string               `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Caller               []byte               `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	Callee               []byte               `protobuf:"bytes,3,opt,name=callee,proto3" json:"callee,omitempty"`
+	Function             string               `protobuf:"bytes,4,opt,name=function,proto3" json:"function,omitempty"`
+	Args                 string               `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
+	Amount               []byte               `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               string               `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string               `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Calls                []*InternalOperation `protobuf:"bytes,9,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InternalOperation) Reset()         { *m = InternalOperation{} }
+func (m *InternalOperation) String() string { return proto.CompactTextString(m) }
+func (*InternalOperation) ProtoMessage()    {}
+func (*InternalOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+}
+
+func (m *InternalOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalOperation.Unmarshal(m, b)
+}
+func (m *InternalOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalOperation.Marshal(b, m, deterministic)
+}
+func (m *InternalOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalOperation.Merge(m, src)
+}
+func (m *InternalOperation) XXX_Size() int {
+	return xxx_messageInfo_InternalOperation.Size(m)
+}
+func (m *InternalOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalOperation proto.InternalMessageInfo
+
+func (m *InternalOperation) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *InternalOperation) GetCaller() []byte {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+func (m *InternalOperation) GetCallee() []byte {
+	if m != nil {
+		return m.Callee
+	}
+	return nil
+}
+
+func (m *InternalOperation) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *InternalOperation) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *InternalOperation) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *InternalOperation) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InternalOperation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *InternalOperation) GetCalls() []*InternalOperation {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// InternalOperations is the internal operations of a tx in the block of the number.
+type InternalOperations struct {
+	TxHash               []byte               `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BlockNo              uint64               `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Operations           []*InternalOperation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InternalOperations) Reset()         { *m = InternalOperations{} }
+func (m *InternalOperations) String() string { return proto.CompactTextString(m) }
+func (*InternalOperations) ProtoMessage()    {}
+func (*InternalOperations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+}
+
+func (m *InternalOperations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InternalOperations.Unmarshal(m, b)
+}
+func (m *InternalOperations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InternalOperations.Marshal(b, m, deterministic)
+}
+func (m *InternalOperations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InternalOperations.Merge(m, src)
+}
+func (m *InternalOperations) XXX_Size() int {
+	return xxx_messageInfo_InternalOperations.Size(m)
+}
+func (m *InternalOperations) XXX_DiscardUnknown() {
+	xxx_messageInfo_InternalOperations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InternalOperations proto.InternalMessageInfo
+
+func (m *InternalOperations) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *InternalOperations) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *InternalOperations) GetOperations() []*InternalOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// BanParams is the peer to ban for the duration in nanoseconds, 0 for ever, and the reason.
+type BanParams struct {
+	PeerID               []byte   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Duration             int64    `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanParams) Reset()         { *m = BanParams{} }
+func (m *BanParams) String() string { return proto.CompactTextString(m) }
+func (*BanParams) ProtoMessage()    {}
+func (*BanParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+}
+
+func (m *BanParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanParams.Unmarshal(m, b)
+}
+func (m *BanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanParams.Marshal(b, m, deterministic)
+}
+func (m *BanParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanParams.Merge(m, src)
+}
+func (m *BanParams) XXX_Size() int {
+	return xxx_messageInfo_BanParams.Size(m)
+}
+func (m *BanParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanParams proto.InternalMessageInfo
+
+func (m *BanParams) GetPeerID() []byte {
+	if m != nil {
+		return m.PeerID
+	}
+	return nil
+}
+
+func (m *BanParams) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *BanParams) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// BannedPeer is a peer banned by the operator since and until the unix times in nanoseconds. Until is 0 for a ban for ever.
+type BannedPeer struct {
+	PeerID               []byte   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Since                int64    `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until                int64    `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BannedPeer) Reset()         { *m = BannedPeer{} }
+func (m *BannedPeer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeer) ProtoMessage()    {}
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+}
+
+func (m *BannedPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeer.Unmarshal(m, b)
+}
+func (m *BannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeer.Marshal(b, m, deterministic)
+}
+func (m *BannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeer.Merge(m, src)
+}
+func (m *BannedPeer) XXX_Size() int {
+	return xxx_messageInfo_BannedPeer.Size(m)
+}
+func (m *BannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeer proto.InternalMessageInfo
+
+func (m *BannedPeer) GetPeerID() []byte {
+	if m != nil {
+		return m.PeerID
+	}
+	return nil
+}
+
+func (m *BannedPeer) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *BannedPeer) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *BannedPeer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// BannedPeerList is the peers banned by the operator.
+type BannedPeerList struct {
+	Peers                []*BannedPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BannedPeerList) Reset()         { *m = BannedPeerList{} }
+func (m *BannedPeerList) String() string { return proto.CompactTextString(m) }
+func (*BannedPeerList) ProtoMessage()    {}
+func (*BannedPeerList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+}
+
+func (m *BannedPeerList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeerList.Unmarshal(m, b)
+}
+func (m *BannedPeerList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeerList.Marshal(b, m, deterministic)
+}
+func (m *BannedPeerList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeerList.Merge(m, src)
+}
+func (m *BannedPeerList) XXX_Size() int {
+	return xxx_messageInfo_BannedPeerList.Size(m)
+}
+func (m *BannedPeerList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeerList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeerList proto.InternalMessageInfo
+
+func (m *BannedPeerList) GetPeers() []*BannedPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+// PeerProtocolStat is the statistics of the messages of a subprotocol exchanged with a peer
+type PeerProtocolStat struct {
+	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Sent                 uint64   `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	SentBytes            uint64   `protobuf:"varint,3,opt,name=sentBytes,proto3" json:"sentBytes,omitempty"`
+	Received             uint64   `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	ReceivedBytes        uint64   `protobuf:"varint,5,opt,name=receivedBytes,proto3" json:"receivedBytes,omitempty"`
+	Errors               uint64   `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	Responses            uint64   `protobuf:"varint,7,opt,name=responses,proto3" json:"responses,omitempty"`
+	AvgLatency           int64    `protobuf:"varint,8,opt,name=avgLatency,proto3" json:"avgLatency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerProtocolStat) Reset()         { *m = PeerProtocolStat{} }
+func (m *PeerProtocolStat) String() string { return proto.CompactTextString(m) }
+func (*PeerProtocolStat) ProtoMessage()    {}
+func (*PeerProtocolStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+}
+
+func (m *PeerProtocolStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerProtocolStat.Unmarshal(m, b)
+}
+func (m *PeerProtocolStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerProtocolStat.Marshal(b, m, deterministic)
+}
+func (m *PeerProtocolStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerProtocolStat.Merge(m, src)
+}
+func (m *PeerProtocolStat) XXX_Size() int {
+	return xxx_messageInfo_PeerProtocolStat.Size(m)
+}
+func (m *PeerProtocolStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerProtocolStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerProtocolStat proto.InternalMessageInfo
+
+func (m *PeerProtocolStat) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *PeerProtocolStat) GetSent() uint64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetSentBytes() uint64 {
+	if m != nil {
+		return m.SentBytes
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetReceivedBytes() uint64 {
+	if m != nil {
+		return m.ReceivedBytes
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
+	}
+	return 0
+}
+
+func (m *PeerProtocolStat) GetAvgLatency() int64 {
+	if m != nil {
+		return m.AvgLatency
+	}
+	return 0
+}
+
+// BridgeProofQuery is the receiver of the deposits to prove, and the block of the state to prove them in
+type BridgeProofQuery struct {
+	Receiver             []byte   `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BridgeProofQuery) Reset()         { *m = BridgeProofQuery{} }
+func (m *BridgeProofQuery) String() string { return proto.CompactTextString(m) }
+func (*BridgeProofQuery) ProtoMessage()    {}
+func (*BridgeProofQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+}
+
+func (m *BridgeProofQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeProofQuery.Unmarshal(m, b)
+}
+func (m *BridgeProofQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeProofQuery.Marshal(b, m, deterministic)
+}
+func (m *BridgeProofQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeProofQuery.Merge(m, src)
+}
+func (m *BridgeProofQuery) XXX_Size() int {
+	return xxx_messageInfo_BridgeProofQuery.Size(m)
+}
+func (m *BridgeProofQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeProofQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeProofQuery proto.InternalMessageInfo
+
+func (m *BridgeProofQuery) GetReceiver() []byte {
+	if m != nil {
+		return m.Receiver
+	}
+	return nil
+}
+
+func (m *BridgeProofQuery) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+// BridgeProof is the total deposited to a receiver in the bridge, with its proof against the state root of a block
+type BridgeProof struct {
+	BlockNo              uint64           `protobuf:"varint,1,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte           `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Root                 []byte           `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Deposited            []byte           `protobuf:"bytes,4,opt,name=deposited,proto3" json:"deposited,omitempty"`
+	Proof                *StateQueryProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BridgeProof) Reset()         { *m = BridgeProof{} }
+func (m *BridgeProof) String() string { return proto.CompactTextString(m) }
+func (*BridgeProof) ProtoMessage()    {}
+func (*BridgeProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+}
+
+func (m *BridgeProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BridgeProof.Unmarshal(m, b)
+}
+func (m *BridgeProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BridgeProof.Marshal(b, m, deterministic)
+}
+func (m *BridgeProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeProof.Merge(m, src)
+}
+func (m *BridgeProof) XXX_Size() int {
+	return xxx_messageInfo_BridgeProof.Size(m)
+}
+func (m *BridgeProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeProof proto.InternalMessageInfo
+
+func (m *BridgeProof) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *BridgeProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BridgeProof) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *BridgeProof) GetDeposited() []byte {
+	if m != nil {
+		return m.Deposited
+	}
+	return nil
+}
+
+func (m *BridgeProof) GetProof() *StateQueryProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
+	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
+	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
+	proto.RegisterType((*ChainId)(nil), "types.ChainId")
+	proto.RegisterType((*ChainInfo)(nil), "types.ChainInfo")
+	proto.RegisterType((*ChainStats)(nil), "types.ChainStats")
+	proto.RegisterType((*Input)(nil), "types.Input")
+	proto.RegisterType((*Output)(nil), "types.Output")
+	proto.RegisterType((*Empty)(nil), "types.Empty")
+	proto.RegisterType((*SingleBytes)(nil), "types.SingleBytes")
+	proto.RegisterType((*AccountAddress)(nil), "types.AccountAddress")
+	proto.RegisterType((*AccountAndRoot)(nil), "types.AccountAndRoot")
+	proto.RegisterType((*Peer)(nil), "types.Peer")
+	proto.RegisterType((*PeerList)(nil), "types.PeerList")
+	proto.RegisterType((*ListParams)(nil), "types.ListParams")
+	proto.RegisterType((*PageParams)(nil), "types.PageParams")
+	proto.RegisterType((*BlockBodyPaged)(nil), "types.BlockBodyPaged")
+	proto.RegisterType((*BlockBodyParams)(nil), "types.BlockBodyParams")
+	proto.RegisterType((*BlockHeaderList)(nil), "types.BlockHeaderList")
+	proto.RegisterType((*BlockMetadata)(nil), "types.BlockMetadata")
+	proto.RegisterType((*BlockMetadataList)(nil), "types.BlockMetadataList")
+	proto.RegisterType((*CommitResult)(nil), "types.CommitResult")
+	proto.RegisterType((*CommitResultList)(nil), "types.CommitResultList")
+	proto.RegisterType((*VerifyResult)(nil), "types.VerifyResult")
+	proto.RegisterType((*Personal)(nil), "types.Personal")
+	proto.RegisterType((*ImportFormat)(nil), "types.ImportFormat")
+	proto.RegisterType((*Staking)(nil), "types.Staking")
+	proto.RegisterType((*Vote)(nil), "types.Vote")
+	proto.RegisterType((*VoteParams)(nil), "types.VoteParams")
+	proto.RegisterType((*AccountVoteInfo)(nil), "types.AccountVoteInfo")
+	proto.RegisterType((*VoteInfo)(nil), "types.VoteInfo")
+	proto.RegisterType((*VoteList)(nil), "types.VoteList")
+	proto.RegisterType((*NodeReq)(nil), "types.NodeReq")
+	proto.RegisterType((*Name)(nil), "types.Name")
+	proto.RegisterType((*NameInfo)(nil), "types.NameInfo")
+	proto.RegisterType((*PeersParams)(nil), "types.PeersParams")
+	proto.RegisterType((*KeyParams)(nil), "types.KeyParams")
+	proto.RegisterType((*ServerInfo)(nil), "types.ServerInfo")
+	proto.RegisterMapType((map[string]*ConfigItem)(nil), "types.ServerInfo.ConfigEntry")
+	proto.RegisterMapType((map[string]string)(nil), "types.ServerInfo.StatusEntry")
+	proto.RegisterType((*ConfigItem)(nil), "types.ConfigItem")
+	proto.RegisterMapType((map[string]string)(nil), "types.ConfigItem.PropsEntry")
+	proto.RegisterType((*EventList)(nil), "types.EventList")
+	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
+	proto.RegisterType((*StateDiffParams)(nil), "types.StateDiffParams")
+	proto.RegisterType((*StorageDiff)(nil), "types.StorageDiff")
+	proto.RegisterType((*AccountDiff)(nil), "types.AccountDiff")
+	proto.RegisterType((*StorageListParams)(nil), "types.StorageListParams")
+	proto.RegisterType((*StorageEntry)(nil), "types.StorageEntry")
+	proto.RegisterType((*StorageList)(nil), "types.StorageList")
+	proto.RegisterType((*Withdrawal)(nil), "types.Withdrawal")
+	proto.RegisterType((*WithdrawalList)(nil), "types.WithdrawalList")
+	proto.RegisterType((*SystemAccountInfo)(nil), "types.SystemAccountInfo")
+	proto.RegisterType((*NameSaleOffer)(nil), "types.NameSaleOffer")
+	proto.RegisterType((*NameInfoList)(nil), "types.NameInfoList")
+	proto.RegisterType((*QuorumStatus)(nil), "types.QuorumStatus")
+	proto.RegisterType((*QuorumStatusList)(nil), "types.QuorumStatusList")
+	proto.RegisterType((*BPCandidateInfo)(nil), "types.BPCandidateInfo")
+	proto.RegisterType((*ElectionTally)(nil), "types.ElectionTally")
+	proto.RegisterType((*FeeEstimate)(nil), "types.FeeEstimate")
+	proto.RegisterType((*BaseFee)(nil), "types.BaseFee")
+	proto.RegisterType((*ClusterMemberStatus)(nil), "types.ClusterMemberStatus")
+	proto.RegisterType((*ClusterStatus)(nil), "types.ClusterStatus")
+	proto.RegisterType((*ClusterSnapshot)(nil), "types.ClusterSnapshot")
+	proto.RegisterType((*EventListParams)(nil), "types.EventListParams")
+	proto.RegisterType((*EventPage)(nil), "types.EventPage")
+	proto.RegisterType((*KeystoreParams)(nil), "types.KeystoreParams")
+	proto.RegisterType((*BlockDetailParams)(nil), "types.BlockDetailParams")
+	proto.RegisterType((*BlockDetail)(nil), "types.BlockDetail")
+	proto.RegisterType((*BlockStreamParams)(nil), "types.BlockStreamParams")
+	proto.RegisterType((*EventStreamFilter)(nil), "types.EventStreamFilter")
+	proto.RegisterType((*ConsensusEvent)(nil), "types.ConsensusEvent")
+	proto.RegisterType((*BulkParams)(nil), "types.BulkParams")
+	proto.RegisterType((*BlockBulkItem)(nil), "types.BlockBulkItem")
+	proto.RegisterType((*BlockBulk)(nil), "types.BlockBulk")
+	proto.RegisterType((*TxBulkItem)(nil), "types.TxBulkItem")
+	proto.RegisterType((*TxBulk)(nil), "types.TxBulk")
+	proto.RegisterType((*LogLevel)(nil), "types.LogLevel")
+	proto.RegisterType((*LogLevelList)(nil), "types.LogLevelList")
+	proto.RegisterType((*Profiling)(nil), "types.Profiling")
+	proto.RegisterType((*ProfileDump)(nil), "types.ProfileDump")
+	proto.RegisterType((*AccountTxHistoryParams)(nil), "types.AccountTxHistoryParams")
+	proto.RegisterType((*AccountTx)(nil), "types.AccountTx")
+	proto.RegisterType((*AccountTxHistory)(nil), "types.AccountTxHistory")
+	proto.RegisterType((*CallEncodeParams)(nil), "types.CallEncodeParams")
+	proto.RegisterType((*ContractABI)(nil), "types.ContractABI")
+	proto.RegisterType((*HDWalletParams)(nil), "types.HDWalletParams")
+	proto.RegisterType((*HDWallet)(nil), "types.HDWallet")
+	proto.RegisterType((*DeriveParams)(nil), "types.DeriveParams")
+	proto.RegisterType((*HDAccount)(nil), "types.HDAccount")
+	proto.RegisterType((*HDAccountList)(nil), "types.HDAccountList")
+	proto.RegisterType((*SignBlockRequest)(nil), "types.SignBlockRequest")
+	proto.RegisterType((*BlockSignature)(nil), "types.BlockSignature")
+	proto.RegisterType((*SignerKey)(nil), "types.SignerKey")
+	proto.RegisterType((*UnlockStatus)(nil), "types.UnlockStatus")
+	proto.RegisterType((*KeyShareInfo)(nil), "types.KeyShareInfo")
+	proto.RegisterType((*SignShareRequest)(nil), "types.SignShareRequest")
+	proto.RegisterType((*SignatureShare)(nil), "types.SignatureShare")
+	proto.RegisterType((*SignAudit)(nil), "types.SignAudit")
+	proto.RegisterType((*SignAuditQuery)(nil), "types.SignAuditQuery")
+	proto.RegisterType((*SignAuditList)(nil), "types.SignAuditList")
+	proto.RegisterType((*Alias)(nil), "types.Alias")
+	proto.RegisterType((*AliasList)(nil), "types.AliasList")
+	proto.RegisterType((*WatchAccount)(nil), "types.WatchAccount")
+	proto.RegisterType((*WatchAccountList)(nil), "types.WatchAccountList")
+	proto.RegisterType((*SystemTxSimulation)(nil), "types.SystemTxSimulation")
+	proto.RegisterType((*BlockTemplate)(nil), "types.BlockTemplate")
+	proto.RegisterType((*ContractStorageUsage)(nil), "types.ContractStorageUsage")
+	proto.RegisterType((*InternalOperation)(nil), "types.InternalOperation")
+	proto.RegisterType((*InternalOperations)(nil), "types.InternalOperations")
+	proto.RegisterType((*BanParams)(nil), "types.BanParams")
+	proto.RegisterType((*BannedPeer)(nil), "types.BannedPeer")
+	proto.RegisterType((*BannedPeerList)(nil), "types.BannedPeerList")
+	proto.RegisterType((*PeerProtocolStat)(nil), "types.PeerProtocolStat")
+	proto.RegisterType((*BridgeProofQuery)(nil), "types.BridgeProofQuery")
+	proto.RegisterType((*BridgeProof)(nil), "types.BridgeProof")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 6032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x5d, 0x93, 0x1b, 0x49,
+	0x52, 0xa3, 0x8f, 0x99, 0x91, 0x72, 0xa4, 0x19, 0xb9, 0xec, 0xb1, 0xe7, 0x74, 0xbe, 0x3d, 0x53,
+	0xb7, 0xb7, 0xeb, 0xdd, 0xdb, 0xf3, 0xd9, 0xe3, 0x5d, 0x9f, 0xf7, 0xee, 0xf6, 0x16, 0xcd, 0x78,
+	0xec, 0x19, 0x6c, 0x8f, 0xe7, 0x5a, 0x5a, 0xdb, 0x47, 0xc0, 0x0d, 0x3d, 0x52, 0x69, 0xd4, 0x58,
+	0xea, 0xd6, 0x76, 0xb7, 0xe6, 0xe3, 0x02, 0xee, 0x20, 0x80, 0x20, 0x82, 0x00, 0x8e, 0x08, 0x9e,
+	0x79, 0x24, 0x88, 0x20, 0x02, 0x78, 0x80, 0x7f, 0xc0, 0x13, 0x41, 0x04, 0x7f, 0x04, 0x7e, 0x00,
+	0x04, 0x2f, 0x44, 0x66, 0x7d, 0x74, 0x55, 0x4b, 0x1a, 0xdb, 0x07, 0x3c, 0xa9, 0x33, 0x2b, 0xab,
+	0x2a, 0xab, 0x2a, 0x2b, 0x33, 0x2b, 0x33, 0x05, 0xd5, 0x78, 0xdc, 0xbd, 0x35, 0x8e, 0xa3, 0x34,
+	0x62, 0x8b, 0xe9, 0xf9, 0x58, 0x24, 0xcd, 0xc6, 0xd1, 0x30, 0xea, 0xbe, 0xea, 0x0e, 0xfc, 0x20,
+	0x94, 0x0d, 0xcd, 0xba, 0xdf, 0xed, 0x46, 0x93, 0x30, 0x55, 0x20, 0x84, 0x51, 0x4f, 0xa8, 0xef,
+	0xea, 0x78, 0x73, 0xac, 0x3e, 0x6b, 0x23, 0x91, 0xc6, 0x41, 0x57, 0x13, 0xc5, 0x7e, 0x5f, 0x75,
+	0xe0, 0xff, 0x50, 0x80, 0xc6, 0x96, 0x19, 0xb4, 0x9d, 0xfa, 0xe9, 0x24, 0x61, 0xef, 0xc1, 0xda,
+	0x91, 0x48, 0xd2, 0x43, 0x9a, 0xed, 0x70, 0xe0, 0x27, 0x83, 0x8d, 0xc2, 0x8d, 0xc2, 0xcd, 0x9a,
+	0x57, 0x47, 0x34, 0x91, 0xef, 0xfa, 0xc9, 0x80, 0x7d, 0x1d, 0x56, 0x88, 0x6e, 0x20, 0x82, 0xe3,
+	0x41, 0xba, 0x51, 0xbc, 0x51, 0xb8, 0x59, 0xf6, 0x00, 0x51, 0xbb, 0x84, 0x61, 0xdf, 0x84, 0xd5,
+	0x6e, 0x14, 0x26, 0x22, 0x4c, 0x26, 0xc9, 0x61, 0x10, 0xf6, 0xa3, 0x8d, 0xd2, 0x8d, 0xc2, 0xcd,
+	0xaa, 0x57, 0x37, 0xd8, 0xbd, 0xb0, 0x1f, 0xb1, 0x6f, 0x01, 0xa3, 0x71, 0x88, 0x87, 0xc3, 0xa0,
+	0x27, 0xa7, 0x2c, 0xd3, 0x94, 0xc4, 0xc9, 0x36, 0x36, 0xec, 0xf5, 0x70, 0x52, 0x1e, 0xc1, 0xb2,
+	0x02, 0xd9, 0x15, 0x58, 0x1c, 0xf9, 0xc7, 0x41, 0x97, 0xb8, 0xab, 0x7a, 0x12, 0x60, 0x57, 0x61,
+	0x69, 0x3c, 0x39, 0x1a, 0x06, 0x5d, 0x62, 0xa8, 0xe2, 0x29, 0x88, 0x6d, 0xc0, 0xf2, 0xc8, 0x0f,
+	0xc2, 0x50, 0xa4, 0xc4, 0x45, 0xc5, 0xd3, 0x20, 0xbb, 0x0e, 0x55, 0xc3, 0x10, 0x4d, 0x5b, 0xf5,
+	0x32, 0x04, 0xff, 0x45, 0x11, 0xaa, 0x72, 0x46, 0xe4, 0xf5, 0x1d, 0x28, 0x06, 0x3d, 0x9a, 0x70,
+	0x65, 0x73, 0xf5, 0x16, 0x1d, 0xcb, 0x2d, 0xc5, 0x8f, 0x57, 0x0c, 0x7a, 0xac, 0x09, 0x95, 0xa3,
+	0xf1, 0xfe, 0x64, 0x74, 0x24, 0x62, 0x9a, 0xbf, 0xee, 0x19, 0x98, 0x71, 0xa8, 0x8d, 0xfc, 0x33,
+	0xda, 0xd5, 0x24, 0xf8, 0xa9, 0x20, 0x36, 0xca, 0x9e, 0x83, 0x43, 0x5e, 0x46, 0xfe, 0x59, 0x1a,
+	0xbd, 0x12, 0x61, 0xa2, 0xb6, 0x20, 0x43, 0xb0, 0xf7, 0x60, 0x35, 0x49, 0xfd, 0x57, 0x41, 0x78,
+	0x3c, 0x0a, 0xc2, 0x60, 0x34, 0x19, 0x6d, 0x2c, 0x12, 0x49, 0x0e, 0x8b, 0x33, 0xa5, 0x51, 0xea,
+	0x0f, 0x15, 0x7a, 0x63, 0x89, 0xa8, 0x1c, 0x1c, 0x72, 0x7a, 0xec, 0x27, 0xe3, 0x38, 0xe8, 0x8a,
+	0x8d, 0x65, 0x6a, 0x37, 0x30, 0x72, 0x11, 0xfa, 0x23, 0x21, 0x1b, 0x2b, 0x92, 0x0b, 0x83, 0xe0,
+	0xef, 0x02, 0x6c, 0x6b, 0x71, 0x49, 0x70, 0xbf, 0x63, 0x31, 0x8e, 0xe2, 0x54, 0x1d, 0x83, 0x82,
+	0x78, 0x17, 0x16, 0xf7, 0xc2, 0xf1, 0x24, 0x65, 0x0c, 0xca, 0x96, 0x0c, 0xd1, 0x37, 0x1e, 0x86,
+	0xdf, 0xeb, 0xc5, 0x22, 0x49, 0x36, 0x8a, 0x37, 0x4a, 0x37, 0x6b, 0x9e, 0x06, 0xf1, 0x50, 0x4f,
+	0xfc, 0xe1, 0x44, 0xee, 0x4e, 0xcd, 0x93, 0x00, 0x4e, 0x92, 0x74, 0xe3, 0x60, 0x9c, 0xaa, 0x3d,
+	0x51, 0x10, 0xef, 0xc3, 0xd2, 0xb3, 0x49, 0x8a, 0xb3, 0x5c, 0x81, 0xc5, 0x20, 0xec, 0x89, 0x33,
+	0x9a, 0xa6, 0xee, 0x49, 0xc0, 0x9d, 0xa7, 0xf0, 0xcb, 0xcf, 0xb3, 0x0c, 0x8b, 0x3b, 0xa3, 0x71,
+	0x7a, 0xce, 0xbf, 0x01, 0x2b, 0xed, 0x20, 0x3c, 0x1e, 0x8a, 0xad, 0xf3, 0x54, 0x58, 0xa3, 0x14,
+	0xac, 0x51, 0xf8, 0x7b, 0xb0, 0xda, 0x92, 0xf7, 0xb2, 0x95, 0x9f, 0xcd, 0xa1, 0xfb, 0x9d, 0x8c,
+	0x2e, 0xec, 0x79, 0x51, 0x94, 0x22, 0xbf, 0x0a, 0xa3, 0x28, 0x35, 0x88, 0xbb, 0x88, 0x14, 0x6a,
+	0x19, 0xf4, 0xcd, 0xde, 0x01, 0xd8, 0x8e, 0x46, 0x63, 0x9c, 0x41, 0xf4, 0x94, 0x54, 0x5b, 0x18,
+	0x3c, 0x46, 0x73, 0x5b, 0xb5, 0x30, 0x19, 0x04, 0xff, 0xdb, 0x22, 0x94, 0x0f, 0x84, 0x88, 0xd9,
+	0x47, 0xd9, 0x26, 0x49, 0xc1, 0x66, 0x4a, 0xb0, 0xb1, 0x55, 0xad, 0x20, 0xdb, 0xb8, 0xbb, 0x50,
+	0xc5, 0x3b, 0x49, 0x22, 0x4b, 0xdc, 0xac, 0x6c, 0xae, 0x2b, 0xfa, 0x7d, 0x71, 0x4a, 0xc3, 0xef,
+	0x47, 0x69, 0xd0, 0x15, 0x5e, 0x46, 0x87, 0xeb, 0x4f, 0x52, 0x3f, 0x95, 0xbb, 0xbd, 0xe8, 0x49,
+	0x00, 0x77, 0x7b, 0x10, 0xf4, 0x7a, 0x22, 0x24, 0xe6, 0x2a, 0x9e, 0x82, 0x90, 0xef, 0xa1, 0x9f,
+	0x0c, 0xb6, 0x07, 0xa2, 0xfb, 0x8a, 0x24, 0xbc, 0xe4, 0x65, 0x08, 0x14, 0xdc, 0x44, 0x0c, 0xfb,
+	0x63, 0x21, 0x62, 0x12, 0xec, 0x8a, 0x67, 0x60, 0xdc, 0xbf, 0x13, 0x11, 0x27, 0x41, 0x14, 0x92,
+	0x4c, 0x57, 0x3d, 0x0d, 0xb2, 0xcf, 0xa0, 0x4e, 0x2a, 0xaf, 0x1b, 0x0d, 0x49, 0x6e, 0x37, 0x2a,
+	0x37, 0x4a, 0x37, 0x57, 0x36, 0xaf, 0x59, 0x4b, 0x3d, 0xb0, 0xda, 0x3d, 0x97, 0x9a, 0xbf, 0x82,
+	0x0a, 0x92, 0x3c, 0x09, 0x92, 0x94, 0xfd, 0x0a, 0x2c, 0xe2, 0x64, 0xb8, 0x5b, 0x38, 0xc4, 0x8a,
+	0x35, 0x84, 0x27, 0x5b, 0xf0, 0x64, 0x42, 0x71, 0x96, 0x6e, 0x4f, 0xe2, 0x24, 0x8a, 0xd5, 0x99,
+	0x59, 0x18, 0x5c, 0x21, 0x5d, 0xc6, 0xdd, 0x20, 0x4c, 0x95, 0x1e, 0xc8, 0x10, 0xfc, 0xcf, 0x0b,
+	0x00, 0x38, 0xd3, 0x81, 0x1f, 0xfb, 0xa3, 0x64, 0xe6, 0x05, 0xc2, 0xad, 0xb3, 0xd5, 0xae, 0x82,
+	0x90, 0xd6, 0xe8, 0x96, 0xba, 0x47, 0xdf, 0x48, 0x1b, 0xf5, 0xfb, 0x89, 0x90, 0x42, 0x5d, 0xf7,
+	0x14, 0xc4, 0x1a, 0x50, 0xf2, 0x93, 0x2e, 0x6d, 0x70, 0xc5, 0xc3, 0x4f, 0xa4, 0xec, 0x4a, 0x96,
+	0xa5, 0xc6, 0x50, 0x10, 0xbf, 0x0f, 0x70, 0xe0, 0x1f, 0x0b, 0xc5, 0x4f, 0x36, 0x5e, 0xc1, 0x19,
+	0x4f, 0xcf, 0x5d, 0xcc, 0xe6, 0xe6, 0x67, 0xb0, 0x4a, 0x22, 0xb1, 0x15, 0xf5, 0xce, 0x71, 0x08,
+	0xd2, 0xda, 0xb4, 0x52, 0x7d, 0x51, 0x09, 0xb0, 0xc6, 0x2c, 0xce, 0x1c, 0xd3, 0x5e, 0xcf, 0xbb,
+	0x50, 0x3e, 0x8a, 0x7a, 0xe7, 0xb4, 0x9a, 0x95, 0xcd, 0x86, 0xda, 0x7e, 0x33, 0x8d, 0x47, 0xad,
+	0xfc, 0xb7, 0x60, 0xcd, 0x9a, 0x99, 0x18, 0xe7, 0x50, 0xc3, 0xcd, 0x8b, 0xe2, 0x50, 0x2a, 0x68,
+	0xb9, 0xa1, 0x0e, 0x8e, 0x7d, 0x00, 0x4b, 0x63, 0xff, 0x18, 0x95, 0xa6, 0x94, 0xed, 0x4b, 0xfa,
+	0x74, 0xcd, 0xfa, 0x3d, 0x45, 0xc0, 0x27, 0x6a, 0x86, 0x5d, 0xe1, 0xf7, 0x94, 0x68, 0xbc, 0x0b,
+	0x4b, 0x52, 0x97, 0x2b, 0xd9, 0xa8, 0xd9, 0xcc, 0x79, 0xaa, 0xed, 0x7f, 0x29, 0x1d, 0xbf, 0x0b,
+	0x75, 0x1a, 0xee, 0xa9, 0x48, 0xfd, 0x9e, 0x9f, 0xfa, 0x33, 0xe5, 0xe3, 0x43, 0x94, 0x0f, 0x64,
+	0x6b, 0xa3, 0xe8, 0x5c, 0x69, 0x8b, 0x61, 0x4f, 0x51, 0xe0, 0xa5, 0x49, 0xcf, 0xa4, 0xd2, 0x91,
+	0xd7, 0x53, 0x83, 0x66, 0xf7, 0xcb, 0x74, 0x07, 0xe5, 0x89, 0xfe, 0x1c, 0x2e, 0x39, 0xd3, 0xd3,
+	0xba, 0x3f, 0xca, 0xad, 0xfb, 0x8a, 0x3d, 0x9d, 0xa6, 0xfc, 0x3f, 0x5a, 0xbf, 0x80, 0xda, 0x76,
+	0x34, 0x1a, 0x05, 0xa9, 0x27, 0x92, 0xc9, 0x70, 0xb6, 0x7d, 0xf9, 0x00, 0x16, 0x45, 0x1c, 0xab,
+	0xc1, 0x57, 0x37, 0x2f, 0x6b, 0x4b, 0x4d, 0xfd, 0xa4, 0x9b, 0xe3, 0x49, 0x0a, 0x94, 0xbc, 0x9e,
+	0x48, 0xfd, 0x60, 0xa8, 0x9c, 0x13, 0x05, 0xf1, 0x16, 0x34, 0xec, 0x69, 0x68, 0x99, 0xdf, 0x86,
+	0xe5, 0x98, 0x20, 0xbd, 0x4e, 0x77, 0x60, 0x49, 0xe9, 0x69, 0x1a, 0xde, 0x81, 0xda, 0x73, 0x11,
+	0x07, 0xfd, 0x73, 0xc5, 0xe9, 0x57, 0xa0, 0x98, 0x9e, 0x29, 0x1d, 0x5b, 0x55, 0x3d, 0x3b, 0x67,
+	0x5e, 0x31, 0x3d, 0x9b, 0xc7, 0xb0, 0xec, 0xee, 0x30, 0xcc, 0x43, 0x54, 0x45, 0x71, 0x12, 0x85,
+	0xfe, 0x10, 0x77, 0x72, 0xec, 0x27, 0xc9, 0x78, 0x10, 0xfb, 0x89, 0x50, 0x06, 0xd8, 0xc2, 0xb0,
+	0x9b, 0xb0, 0xac, 0x3c, 0xc4, 0x8d, 0xa2, 0xe3, 0xb3, 0x28, 0xb3, 0xe2, 0xe9, 0x66, 0x12, 0x82,
+	0x60, 0x24, 0xa2, 0x89, 0xde, 0x71, 0x0d, 0xf2, 0x01, 0xd4, 0xf6, 0x46, 0x68, 0xd2, 0x1f, 0x46,
+	0xf1, 0xc8, 0x47, 0x19, 0x2f, 0x9d, 0x06, 0xfd, 0x9c, 0xa9, 0xb0, 0x8c, 0xa2, 0x87, 0xcd, 0x38,
+	0x5e, 0x34, 0xec, 0x21, 0x2b, 0x34, 0x73, 0xd5, 0xd3, 0x20, 0xb6, 0x84, 0xe2, 0x94, 0x5a, 0xe4,
+	0x8e, 0x6b, 0x90, 0x7f, 0x02, 0xcb, 0x6d, 0xe5, 0x9d, 0x5c, 0x85, 0x25, 0x7f, 0x64, 0xd9, 0x41,
+	0x05, 0xe1, 0x61, 0x9f, 0x0e, 0x44, 0xa8, 0xb4, 0x1e, 0x7d, 0xf3, 0x1f, 0x40, 0xf9, 0x79, 0x94,
+	0x92, 0xd7, 0xd2, 0xf5, 0xc3, 0x5e, 0xd0, 0x43, 0x43, 0x23, 0xbb, 0x65, 0x08, 0x6b, 0xc4, 0xa2,
+	0x3d, 0x22, 0xdf, 0x04, 0xc0, 0xde, 0x4a, 0x45, 0xac, 0x1a, 0xff, 0xae, 0x4a, 0xfe, 0xdc, 0x15,
+	0x58, 0xcc, 0xb6, 0xaf, 0xee, 0x49, 0x80, 0xf7, 0x60, 0x4d, 0x6d, 0x20, 0x76, 0x25, 0xc7, 0xf0,
+	0x26, 0x2c, 0x6b, 0x6f, 0xcb, 0xf5, 0x0e, 0xd5, 0x8a, 0x3c, 0xdd, 0xcc, 0xde, 0x87, 0xa5, 0x93,
+	0x28, 0x95, 0x1a, 0x06, 0x65, 0x68, 0x4d, 0x9f, 0xb5, 0x1a, 0xca, 0x53, 0xcd, 0x7c, 0x0c, 0x15,
+	0x33, 0xbc, 0xe4, 0xab, 0x68, 0xf8, 0x7a, 0x07, 0xc0, 0x2c, 0x0d, 0xf7, 0xb1, 0x84, 0x07, 0x9f,
+	0x61, 0x70, 0xb5, 0xe2, 0x6c, 0x1c, 0xc4, 0x52, 0x4b, 0x96, 0x3d, 0x05, 0xe1, 0x1e, 0xc5, 0x02,
+	0x1d, 0x5f, 0x9c, 0x7f, 0x51, 0x5e, 0x2d, 0x83, 0xe0, 0x9f, 0xc9, 0x19, 0xb5, 0x95, 0x3b, 0x89,
+	0x52, 0xa1, 0x25, 0x7d, 0xc5, 0xe2, 0xd2, 0x93, 0x2d, 0x79, 0xa6, 0x78, 0x0b, 0x96, 0xf7, 0xa3,
+	0x9e, 0xf0, 0xc4, 0x97, 0xb6, 0x38, 0x29, 0x47, 0x46, 0x81, 0xd2, 0xdb, 0x1e, 0x8d, 0xa3, 0x50,
+	0x98, 0xa3, 0xc8, 0x10, 0xfc, 0x63, 0x28, 0xef, 0xfb, 0x23, 0x81, 0xe7, 0x8c, 0x0e, 0xa7, 0x3a,
+	0x09, 0xfa, 0xc6, 0x31, 0x8f, 0xa4, 0x7b, 0xa1, 0x8e, 0x5f, 0x83, 0xfc, 0x6f, 0x0a, 0x50, 0xc1,
+	0x6e, 0xb4, 0x55, 0x5f, 0xb7, 0xba, 0x66, 0x7c, 0x63, 0xb3, 0x1a, 0xe7, 0x0a, 0x2c, 0x46, 0xa7,
+	0xa1, 0xd0, 0x9a, 0x47, 0x02, 0xec, 0x06, 0xac, 0xf4, 0x44, 0x92, 0x06, 0xa1, 0x9f, 0xa2, 0xfb,
+	0x20, 0xdd, 0x42, 0x1b, 0x35, 0x77, 0x4f, 0x3f, 0x84, 0xc5, 0xa8, 0xdf, 0x17, 0x31, 0xed, 0x67,
+	0xa6, 0xfb, 0x70, 0xc6, 0xb6, 0x3f, 0x14, 0xcf, 0xb0, 0xcd, 0x93, 0x24, 0xfc, 0x17, 0x05, 0x58,
+	0x41, 0x47, 0x21, 0x51, 0xf2, 0xd6, 0x84, 0x4a, 0x18, 0xed, 0x4a, 0x27, 0xa8, 0x20, 0x9d, 0x19,
+	0x0d, 0x63, 0x5b, 0x32, 0x88, 0x4e, 0xdb, 0x62, 0xd8, 0x57, 0x6f, 0x19, 0x03, 0x5b, 0x96, 0xba,
+	0x64, 0x5b, 0x6a, 0x47, 0x63, 0x6b, 0x7b, 0x79, 0x1d, 0xaa, 0xa7, 0x41, 0x3a, 0x90, 0x6e, 0x8f,
+	0xb4, 0xf6, 0x19, 0x82, 0x7f, 0x0d, 0xaa, 0x8f, 0x85, 0xb6, 0x90, 0x0d, 0x28, 0xbd, 0x12, 0xe7,
+	0x74, 0xe4, 0x55, 0x0f, 0x3f, 0xf9, 0x1f, 0x14, 0x01, 0xda, 0x22, 0x3e, 0x11, 0x31, 0x6d, 0xee,
+	0x27, 0xb0, 0x94, 0x90, 0x36, 0x52, 0x62, 0xf1, 0x35, 0x2d, 0xe5, 0x86, 0xe4, 0x96, 0xd4, 0x56,
+	0x3b, 0x61, 0x1a, 0x9f, 0x7b, 0x8a, 0x18, 0xbb, 0x75, 0xa3, 0xb0, 0x1f, 0x68, 0x99, 0x9f, 0xd1,
+	0x6d, 0x9b, 0xda, 0x55, 0x37, 0x49, 0xdc, 0xfc, 0x14, 0x56, 0xac, 0xd1, 0x32, 0xee, 0x0a, 0x8a,
+	0xbb, 0xcc, 0xaf, 0x96, 0x42, 0x28, 0x81, 0xef, 0x15, 0xef, 0x17, 0x9a, 0x4f, 0x60, 0xc5, 0x1a,
+	0x71, 0x46, 0xd7, 0xf7, 0xed, 0xae, 0x99, 0x9d, 0x97, 0x9d, 0xf6, 0x52, 0x31, 0xb2, 0x46, 0xe3,
+	0x3f, 0x05, 0xc8, 0x1a, 0xd8, 0x26, 0x2c, 0x8e, 0xe3, 0x68, 0x9c, 0xa8, 0xc5, 0x5c, 0x9f, 0xea,
+	0x7a, 0xeb, 0x00, 0x9b, 0xe5, 0x5a, 0x24, 0x69, 0x13, 0x5d, 0x28, 0x83, 0x7c, 0x9b, 0x95, 0xf0,
+	0x3b, 0x50, 0xdd, 0x39, 0x11, 0x61, 0xaa, 0x1d, 0x0c, 0x81, 0x40, 0xde, 0xc1, 0x20, 0x0a, 0x4f,
+	0xb5, 0xf1, 0x3d, 0xa8, 0x6f, 0x3b, 0x4f, 0x6c, 0x06, 0x65, 0xa4, 0xd3, 0xd7, 0x09, 0xbf, 0x11,
+	0x47, 0x6f, 0x72, 0x39, 0x21, 0x7d, 0x23, 0x5f, 0x47, 0x63, 0xad, 0x4f, 0xf0, 0x93, 0xff, 0x18,
+	0xd6, 0xf0, 0x08, 0xc4, 0x83, 0xa0, 0xdf, 0x57, 0x42, 0xf2, 0x2e, 0xd4, 0xfb, 0x71, 0x34, 0xca,
+	0x9e, 0x16, 0x2a, 0x3a, 0xe0, 0x20, 0xf1, 0x3e, 0xa5, 0x51, 0x46, 0x23, 0xef, 0x9a, 0x8d, 0xe2,
+	0x2f, 0xf0, 0x74, 0xa3, 0xd8, 0x3f, 0xa6, 0xc1, 0xed, 0x3d, 0xa9, 0xc9, 0x3d, 0x69, 0x42, 0x25,
+	0x1a, 0xf6, 0x9e, 0x9b, 0x6d, 0xa9, 0x79, 0x06, 0xc6, 0xb6, 0x50, 0x9c, 0x3e, 0xb7, 0x9e, 0x70,
+	0x06, 0xe6, 0x7f, 0x57, 0x80, 0x15, 0xa5, 0x9f, 0x69, 0xe4, 0xeb, 0x50, 0x55, 0x66, 0x6e, 0xef,
+	0x81, 0x36, 0x0c, 0x06, 0xc1, 0x6e, 0xd2, 0x2c, 0xb4, 0x48, 0x25, 0x0b, 0xb5, 0x4c, 0x75, 0xa7,
+	0xc2, 0x33, 0xad, 0x48, 0x19, 0x8a, 0xd3, 0xb6, 0x79, 0xc8, 0x4c, 0x51, 0xea, 0x56, 0x7c, 0x52,
+	0x25, 0x72, 0x69, 0x1b, 0xe5, 0x1b, 0x25, 0xdb, 0x4e, 0x66, 0x0b, 0xf6, 0x34, 0x09, 0xff, 0xc7,
+	0x02, 0x5c, 0x52, 0x0d, 0x96, 0xdb, 0x7f, 0x13, 0xd6, 0xba, 0x51, 0x98, 0xc6, 0x7e, 0x57, 0x3f,
+	0x23, 0x15, 0xef, 0x79, 0x34, 0x85, 0x3c, 0x62, 0xd1, 0x0f, 0xce, 0xb4, 0x69, 0x93, 0xd0, 0x5b,
+	0x29, 0x09, 0x4b, 0xb9, 0x2e, 0x3a, 0xca, 0x15, 0x47, 0x19, 0xa2, 0x4d, 0x49, 0xd5, 0x6b, 0x4b,
+	0x41, 0xfc, 0x00, 0x6a, 0x8a, 0xe9, 0x29, 0x99, 0x56, 0xe7, 0x87, 0x46, 0x20, 0x0e, 0xc4, 0x63,
+	0x71, 0xae, 0x5f, 0xdf, 0x0a, 0x9c, 0xfd, 0xfa, 0xe6, 0xbf, 0x01, 0x2b, 0xd6, 0x36, 0xa0, 0xb7,
+	0x25, 0x42, 0xec, 0x91, 0xf7, 0xb6, 0xec, 0x69, 0x3d, 0x4d, 0xf3, 0x3a, 0xaf, 0x92, 0xff, 0x10,
+	0xe0, 0x45, 0x90, 0x0e, 0x7a, 0xb1, 0x7f, 0x2a, 0x1f, 0x1c, 0x33, 0x1d, 0x8c, 0x0d, 0x74, 0xf1,
+	0x86, 0x02, 0xdd, 0x29, 0x65, 0x64, 0x14, 0xc8, 0x77, 0x60, 0x35, 0xeb, 0x4f, 0x0c, 0xde, 0x85,
+	0x95, 0x53, 0x83, 0xd1, 0x4c, 0x6a, 0x45, 0x92, 0xd1, 0x7a, 0x36, 0x15, 0xff, 0xb3, 0x22, 0x5c,
+	0x6a, 0x9f, 0x27, 0xa9, 0x18, 0x29, 0x11, 0xa5, 0x0b, 0xba, 0x91, 0x39, 0x6a, 0xca, 0x5e, 0x2a,
+	0xd0, 0x76, 0x2c, 0x8a, 0x17, 0x3b, 0x16, 0x39, 0x76, 0x4a, 0x6f, 0xc2, 0x8e, 0xe5, 0x8d, 0x94,
+	0x2f, 0xf4, 0x46, 0xa4, 0x7d, 0x1c, 0x8a, 0x63, 0x3f, 0x15, 0xbd, 0x8e, 0x14, 0x92, 0xaa, 0x67,
+	0xa3, 0xf0, 0x9a, 0x19, 0x50, 0x3d, 0x20, 0x33, 0x84, 0x8c, 0x13, 0x9d, 0xfa, 0x71, 0x4f, 0x45,
+	0x9b, 0x14, 0xc4, 0xbf, 0x0f, 0x75, 0xc7, 0x52, 0xa2, 0x6c, 0xc8, 0xc0, 0x93, 0x8a, 0x95, 0x10,
+	0x80, 0xd8, 0xa3, 0xc9, 0x79, 0x66, 0xb4, 0x09, 0xe0, 0x9f, 0x40, 0x4d, 0xdb, 0x7d, 0x3a, 0x91,
+	0x6f, 0xc2, 0x22, 0x9a, 0x78, 0x7d, 0x16, 0x6b, 0x96, 0x29, 0xa6, 0xc5, 0xc8, 0x56, 0xfe, 0x6f,
+	0x05, 0xa8, 0xfd, 0x68, 0x12, 0xc5, 0x93, 0x91, 0x0a, 0x79, 0xe2, 0x9c, 0x78, 0xeb, 0x74, 0x28,
+	0x91, 0x00, 0x92, 0xdf, 0x49, 0x1c, 0xa2, 0x13, 0xa3, 0xe5, 0x57, 0x82, 0x26, 0xc0, 0xa6, 0xce,
+	0x40, 0x89, 0xb1, 0x83, 0xc3, 0x05, 0x7f, 0x49, 0x73, 0x68, 0x77, 0x41, 0x42, 0x52, 0xc2, 0xfc,
+	0xee, 0x40, 0xf4, 0x94, 0x31, 0xd6, 0x20, 0xb6, 0x8c, 0x45, 0xd8, 0xcb, 0x22, 0x76, 0x1a, 0x44,
+	0xd9, 0xf6, 0xbb, 0x69, 0x70, 0x22, 0x7d, 0x93, 0x65, 0x1a, 0xcf, 0xc2, 0xf0, 0xcf, 0xa1, 0x61,
+	0xaf, 0x87, 0xf6, 0xe2, 0x5b, 0xf8, 0x92, 0x45, 0x4d, 0x92, 0xbb, 0x3d, 0x36, 0xa1, 0xa7, 0x48,
+	0xf8, 0xbf, 0x16, 0x60, 0x6d, 0xeb, 0x60, 0x5b, 0x3b, 0x90, 0x24, 0x93, 0xbf, 0x94, 0x3f, 0x8d,
+	0xac, 0xc6, 0xe2, 0x38, 0x48, 0x52, 0x11, 0x67, 0x41, 0xa9, 0x0c, 0x93, 0x79, 0x5f, 0x65, 0xdb,
+	0xfb, 0xd2, 0xfe, 0xde, 0xa2, 0xeb, 0xef, 0x9d, 0x8a, 0xa3, 0x24, 0x48, 0x05, 0x6d, 0x47, 0xd5,
+	0xd3, 0x20, 0xce, 0xd1, 0xc5, 0x17, 0x57, 0x62, 0x22, 0x3d, 0x75, 0xcf, 0xc2, 0xf0, 0x47, 0x50,
+	0xdf, 0x19, 0x8a, 0x2e, 0x6e, 0x4d, 0xc7, 0x1f, 0x0e, 0xcf, 0xd9, 0x3d, 0xc7, 0x5d, 0x96, 0xfb,
+	0x71, 0x55, 0xbf, 0x51, 0xdd, 0x65, 0xdb, 0x6e, 0x34, 0xff, 0xef, 0x02, 0xac, 0x3c, 0x14, 0x62,
+	0x27, 0x49, 0x83, 0x11, 0x2e, 0x9a, 0x41, 0xf9, 0x55, 0x10, 0xea, 0x07, 0x02, 0x7d, 0xe3, 0x46,
+	0x8c, 0x82, 0xf0, 0xa1, 0xd0, 0x36, 0x4a, 0x41, 0x84, 0xf7, 0xcf, 0x10, 0xaf, 0xb4, 0xaf, 0x84,
+	0x50, 0x76, 0x02, 0x54, 0x59, 0x61, 0x12, 0x74, 0x1f, 0xf9, 0x89, 0x92, 0x0e, 0x07, 0xa7, 0x82,
+	0xb3, 0x4f, 0x82, 0x51, 0x90, 0x2a, 0x75, 0x6c, 0x60, 0xd5, 0x76, 0x40, 0x57, 0x64, 0xc9, 0x04,
+	0x6e, 0x09, 0x7e, 0x9d, 0x9c, 0xa0, 0x5d, 0xd2, 0x12, 0x56, 0x71, 0xde, 0x6f, 0xd6, 0x22, 0x8d,
+	0xd4, 0xf1, 0xdf, 0x84, 0xe5, 0x2d, 0x3f, 0x11, 0x0f, 0x85, 0x1c, 0x58, 0xc4, 0x07, 0x22, 0xc6,
+	0x27, 0x9e, 0x12, 0x06, 0x0b, 0x83, 0x67, 0xd5, 0x3b, 0x0f, 0xfd, 0x91, 0x09, 0xbb, 0x6b, 0xd0,
+	0x36, 0x2c, 0x25, 0xd7, 0x6b, 0xff, 0x8f, 0x02, 0x5c, 0xde, 0x1e, 0x4e, 0x50, 0x2e, 0x9e, 0x0a,
+	0x0c, 0xbe, 0xa8, 0xcb, 0xf8, 0x4d, 0x28, 0xfb, 0x69, 0x1a, 0x2b, 0x07, 0x5e, 0x2b, 0x30, 0x49,
+	0xd2, 0x4a, 0xd3, 0xd8, 0xa3, 0x66, 0xdc, 0x87, 0x20, 0x79, 0x92, 0x05, 0x39, 0x2a, 0x9e, 0x81,
+	0x65, 0x6a, 0x20, 0xed, 0x0e, 0xd4, 0x94, 0x12, 0x40, 0x0b, 0x35, 0xf4, 0x8f, 0xd5, 0x86, 0xe3,
+	0x67, 0x16, 0x97, 0x94, 0x72, 0x97, 0xc5, 0x25, 0x69, 0xcf, 0x84, 0xb6, 0x78, 0x12, 0x42, 0x15,
+	0x38, 0xf4, 0x93, 0x74, 0x3b, 0x0a, 0x53, 0xbf, 0x9b, 0xd2, 0xf6, 0x96, 0x3c, 0x1b, 0x85, 0x3c,
+	0x25, 0xa1, 0x3f, 0x4e, 0x06, 0x51, 0x4a, 0x1b, 0x5c, 0xf5, 0x0c, 0xcc, 0xff, 0xaa, 0x08, 0x75,
+	0xb5, 0x5c, 0xb5, 0xd0, 0x0d, 0x58, 0xa6, 0x9c, 0x87, 0xf1, 0x4a, 0x34, 0x88, 0x1c, 0x60, 0x2a,
+	0x67, 0xef, 0x81, 0x0e, 0xef, 0x49, 0x08, 0xf1, 0x43, 0xb9, 0x62, 0xb9, 0x30, 0x05, 0x91, 0x7f,
+	0x27, 0x62, 0xad, 0x69, 0xe8, 0x1b, 0x69, 0xe9, 0x4a, 0x68, 0x09, 0x52, 0x10, 0x99, 0x9a, 0xf1,
+	0x78, 0x18, 0x28, 0x25, 0x5d, 0xf6, 0x34, 0x88, 0x8e, 0x9d, 0xe6, 0x76, 0x8f, 0x62, 0xe9, 0x52,
+	0x80, 0x5c, 0x24, 0xee, 0xc2, 0x00, 0xe5, 0x2d, 0x3a, 0x26, 0x9f, 0xa4, 0x42, 0x5b, 0x64, 0xa3,
+	0xd8, 0xc7, 0xb0, 0x3c, 0xa2, 0xd3, 0x4a, 0x36, 0xaa, 0x74, 0xd5, 0x9a, 0xda, 0x43, 0x9e, 0x3e,
+	0x6d, 0x4f, 0x93, 0xf2, 0xf7, 0x61, 0x4d, 0x6f, 0x8f, 0x9a, 0xcf, 0x0d, 0xea, 0x97, 0x55, 0x50,
+	0x9f, 0x0f, 0x60, 0xcd, 0x38, 0xc4, 0xca, 0x57, 0xfa, 0x00, 0x96, 0xfa, 0xc1, 0x30, 0x15, 0x79,
+	0xa1, 0x79, 0x48, 0x48, 0x69, 0xc7, 0x24, 0x81, 0xe5, 0x14, 0x15, 0x67, 0x3a, 0x45, 0x56, 0xa4,
+	0x91, 0xff, 0x48, 0xb9, 0xde, 0x18, 0xfc, 0x7b, 0x33, 0xd7, 0xfb, 0xb5, 0x5e, 0xc8, 0x10, 0x56,
+	0x1f, 0x8b, 0x73, 0xf4, 0xfc, 0x84, 0xf1, 0xf3, 0x1c, 0xd3, 0x7f, 0x41, 0x8c, 0xc6, 0x8d, 0xf6,
+	0x14, 0xa7, 0xa2, 0x3d, 0xe8, 0x81, 0xf5, 0xfa, 0x2a, 0xaa, 0x82, 0x9f, 0xfc, 0x8f, 0x0a, 0x2a,
+	0x5a, 0xf7, 0x80, 0x82, 0x5a, 0x6f, 0x11, 0x07, 0x6d, 0x42, 0x25, 0x16, 0x5d, 0x11, 0x8c, 0xd3,
+	0x44, 0xdf, 0x2e, 0x0d, 0xbb, 0x09, 0x33, 0xa9, 0xe1, 0x33, 0x04, 0x6e, 0x64, 0x5f, 0x88, 0x44,
+	0xc5, 0xf4, 0xe9, 0x9b, 0xff, 0x73, 0x01, 0x56, 0x2c, 0x3e, 0x18, 0x87, 0x45, 0x99, 0x40, 0x28,
+	0x38, 0x6e, 0x34, 0x91, 0x78, 0xb2, 0x89, 0x7d, 0xe8, 0x70, 0x50, 0xb2, 0x36, 0xc6, 0x93, 0x68,
+	0x8b, 0xa3, 0x77, 0xc1, 0xcd, 0x29, 0xce, 0x4e, 0x34, 0x36, 0xa1, 0x42, 0x16, 0x1a, 0x75, 0xb2,
+	0xb4, 0x3e, 0x06, 0x36, 0x16, 0xfd, 0x91, 0x9f, 0x7c, 0x91, 0x28, 0xd3, 0x5c, 0xf6, 0x1c, 0x1c,
+	0xf7, 0xd5, 0x66, 0xb6, 0xd3, 0x58, 0xf8, 0xa3, 0xec, 0x05, 0x6f, 0xd8, 0x2c, 0x5c, 0xb4, 0x51,
+	0xc5, 0x79, 0x1b, 0x55, 0xb2, 0x36, 0xea, 0xe7, 0x70, 0x89, 0xe4, 0x49, 0x4e, 0x21, 0xc5, 0x97,
+	0x7d, 0x04, 0x97, 0x72, 0x2e, 0xbf, 0x32, 0x62, 0x35, 0x6f, 0xba, 0x01, 0xa5, 0x84, 0x64, 0x71,
+	0x9f, 0x1c, 0xa1, 0xa2, 0x0c, 0x0d, 0x65, 0x18, 0x7a, 0x0d, 0xc5, 0xc7, 0x72, 0x68, 0x65, 0x9a,
+	0x32, 0x04, 0xff, 0xfd, 0x02, 0xac, 0x9a, 0xb7, 0x23, 0xb1, 0x32, 0xef, 0xf1, 0x48, 0x0a, 0xa7,
+	0x68, 0x29, 0x9c, 0x0f, 0x60, 0x49, 0xde, 0xe5, 0x8d, 0x92, 0x73, 0x09, 0x2d, 0xcd, 0xad, 0x08,
+	0x90, 0x07, 0x8c, 0x07, 0x25, 0xa9, 0x3f, 0x1a, 0xab, 0xe8, 0x72, 0x86, 0xe0, 0x37, 0x00, 0xb6,
+	0x26, 0xc3, 0x57, 0x59, 0xfa, 0xe3, 0x95, 0x38, 0xd7, 0x0b, 0xa6, 0x6f, 0x1e, 0xa9, 0x18, 0x38,
+	0x92, 0xd1, 0x93, 0x7c, 0xfa, 0xf1, 0x61, 0x44, 0xac, 0x38, 0x5f, 0xc4, 0x18, 0x94, 0xbb, 0x51,
+	0xcf, 0xdc, 0x79, 0xfc, 0x46, 0x9d, 0x23, 0x23, 0xb1, 0x32, 0x13, 0x2c, 0x01, 0xfe, 0x5d, 0xa8,
+	0x9a, 0x09, 0x31, 0xe0, 0x13, 0xa4, 0x62, 0x34, 0x33, 0xd8, 0xad, 0x39, 0xf2, 0x24, 0x09, 0x1f,
+	0x02, 0x74, 0xce, 0x0c, 0x9b, 0xb3, 0x62, 0xd5, 0x37, 0x28, 0x2a, 0x5c, 0x74, 0x92, 0x19, 0x9d,
+	0xb3, 0xbd, 0x50, 0x72, 0x8a, 0xc1, 0xe1, 0x37, 0x67, 0xf3, 0x0e, 0x2c, 0xc9, 0xd9, 0x30, 0xbc,
+	0x61, 0xf3, 0x78, 0xc9, 0x0c, 0x9c, 0x67, 0xf0, 0x3e, 0x54, 0x9e, 0x44, 0xc7, 0x4f, 0xc4, 0x89,
+	0xa0, 0x47, 0xd1, 0x28, 0xea, 0x4d, 0x86, 0xfa, 0xac, 0x15, 0x84, 0x93, 0x0d, 0x91, 0x40, 0x07,
+	0x27, 0x08, 0xe0, 0xdf, 0x85, 0x9a, 0xee, 0x49, 0x0e, 0xe7, 0xfb, 0x68, 0x9c, 0x4e, 0xc4, 0x30,
+	0xef, 0x7d, 0x6b, 0x22, 0x4f, 0x35, 0xf3, 0xcf, 0xa1, 0x7a, 0x10, 0x47, 0xfd, 0x60, 0x88, 0xae,
+	0xed, 0x06, 0xbe, 0xf2, 0xfc, 0xa3, 0xa1, 0xe8, 0xa9, 0xeb, 0xa3, 0xc1, 0x7c, 0xf2, 0xb6, 0x6a,
+	0x72, 0x90, 0xfc, 0x13, 0x58, 0x91, 0x03, 0x88, 0x07, 0x93, 0xd1, 0x78, 0xa6, 0x57, 0xc6, 0xa0,
+	0x3c, 0xf6, 0xd3, 0x81, 0xea, 0x49, 0xdf, 0xfc, 0x27, 0x70, 0x55, 0xe9, 0xd4, 0xce, 0xd9, 0x6e,
+	0x80, 0x3a, 0x58, 0xc7, 0xbd, 0x36, 0xdc, 0x14, 0xa8, 0x95, 0x27, 0x7e, 0x1b, 0x73, 0xf1, 0xa7,
+	0x05, 0xa8, 0x9a, 0x09, 0x2e, 0x8a, 0xf6, 0x5f, 0x87, 0xea, 0x51, 0x2e, 0x32, 0x92, 0x21, 0xe6,
+	0x7b, 0x4c, 0x78, 0x0e, 0xe9, 0xd9, 0x5e, 0xef, 0x8c, 0x0e, 0x7d, 0xd1, 0x93, 0x00, 0xb2, 0xa8,
+	0x62, 0x72, 0xd2, 0x8b, 0x51, 0x10, 0x7f, 0x0e, 0x8d, 0xfc, 0x72, 0x19, 0x87, 0x52, 0x7a, 0xa6,
+	0x0f, 0xa8, 0xe1, 0x1a, 0x9a, 0xce, 0x99, 0x87, 0x8d, 0xaf, 0x35, 0x61, 0x7f, 0x58, 0x80, 0xc6,
+	0xb6, 0x3f, 0x1c, 0xee, 0x84, 0x28, 0x89, 0x6f, 0x1d, 0xad, 0xd0, 0xae, 0x7e, 0xd1, 0x72, 0xf5,
+	0x9b, 0x50, 0xf9, 0xed, 0x24, 0x0a, 0x5b, 0xf1, 0xb1, 0x4e, 0x0a, 0x18, 0xd8, 0x7a, 0x68, 0x94,
+	0x9d, 0xc0, 0xbd, 0x4f, 0x11, 0x3e, 0x39, 0xf4, 0xd6, 0xde, 0x05, 0x47, 0xd8, 0x84, 0x0a, 0x32,
+	0x6a, 0x6d, 0xb6, 0x81, 0xd9, 0x75, 0x28, 0xf9, 0x47, 0x81, 0x52, 0x58, 0xa0, 0xf7, 0x63, 0x6b,
+	0xcf, 0x43, 0x34, 0xff, 0x93, 0x02, 0xac, 0xee, 0x3e, 0x78, 0xe1, 0x0f, 0x87, 0x42, 0x7b, 0x1a,
+	0xaf, 0xcb, 0xb8, 0x34, 0xa1, 0x32, 0x0a, 0xc5, 0x28, 0x0a, 0x95, 0x27, 0x5c, 0xf5, 0x0c, 0x4c,
+	0xe5, 0x1b, 0x42, 0xf4, 0x0e, 0xb2, 0xfe, 0x72, 0xad, 0x39, 0x2c, 0x1e, 0xf3, 0x69, 0x14, 0xf7,
+	0x12, 0x15, 0xa0, 0x91, 0x00, 0x7f, 0x0f, 0x2a, 0x9a, 0x17, 0x67, 0x96, 0x82, 0x3b, 0x0b, 0x4f,
+	0xa1, 0xf6, 0x40, 0xc4, 0xc1, 0x89, 0x78, 0x43, 0x8e, 0x37, 0xdc, 0x1c, 0x51, 0x3d, 0xf3, 0x37,
+	0x8c, 0xfb, 0x55, 0xb2, 0x6b, 0x2a, 0x4c, 0x4a, 0xa4, 0x6c, 0xa7, 0x44, 0xf6, 0xa0, 0xba, 0xfb,
+	0xa0, 0x95, 0xc5, 0x2c, 0xde, 0xd0, 0xa5, 0x99, 0x75, 0x4d, 0x3f, 0x83, 0xba, 0x19, 0x4a, 0x65,
+	0x17, 0x2b, 0x8a, 0x3e, 0x2f, 0xb9, 0x86, 0xce, 0x33, 0x14, 0xfc, 0x27, 0xd0, 0x68, 0x07, 0xc7,
+	0x4a, 0x7d, 0x8a, 0x2f, 0x27, 0x22, 0x49, 0x2f, 0xf0, 0xb4, 0xe7, 0x26, 0x15, 0x64, 0x8a, 0xdd,
+	0xf8, 0xda, 0x35, 0x9d, 0x2e, 0xe5, 0xef, 0xaa, 0x94, 0x36, 0x4e, 0xe2, 0xa7, 0x93, 0x58, 0x48,
+	0x5d, 0x70, 0x1c, 0x6a, 0xad, 0x8e, 0xdf, 0xfc, 0x1b, 0x50, 0x45, 0x02, 0x11, 0x63, 0xb8, 0x4b,
+	0xd6, 0x24, 0x3d, 0x36, 0x06, 0x4a, 0x41, 0xfc, 0x2f, 0x0a, 0x50, 0xfb, 0x22, 0xa4, 0xc1, 0xe4,
+	0x8b, 0xe0, 0xcd, 0x37, 0xae, 0x09, 0x95, 0x09, 0xf5, 0x14, 0x3d, 0xed, 0x9f, 0x69, 0x18, 0x4f,
+	0x5c, 0x7f, 0xb7, 0x64, 0x3a, 0xaf, 0xe4, 0x59, 0x18, 0xec, 0x4b, 0xa9, 0x0b, 0xd1, 0x4a, 0x95,
+	0xf1, 0x35, 0x30, 0xff, 0xfb, 0x02, 0xd4, 0x1e, 0x8b, 0xf3, 0xf6, 0xc0, 0x8f, 0x65, 0x14, 0x60,
+	0x0e, 0xef, 0xf4, 0xe2, 0x13, 0xfe, 0x50, 0xc7, 0xa5, 0xaa, 0x9e, 0x06, 0xe7, 0x88, 0x0d, 0x9a,
+	0xfc, 0x41, 0x2c, 0x92, 0x41, 0x34, 0xec, 0x29, 0xd1, 0xc9, 0x10, 0x5a, 0xe7, 0x1c, 0xc4, 0x22,
+	0x09, 0x74, 0x62, 0xca, 0xc2, 0xe0, 0x6c, 0x63, 0xfa, 0x4a, 0xf4, 0xa3, 0x45, 0x81, 0xb8, 0x87,
+	0x74, 0xde, 0xc4, 0xf1, 0xff, 0xc3, 0x79, 0xdb, 0x0b, 0x2d, 0xbb, 0x0b, 0x95, 0x71, 0xd7, 0x8c,
+	0x61, 0x05, 0xf1, 0x2d, 0x58, 0x35, 0xc2, 0x41, 0x6c, 0xcd, 0xa9, 0x4e, 0xaa, 0x41, 0x41, 0xd7,
+	0x25, 0x15, 0x12, 0x84, 0xf4, 0xd4, 0x85, 0x98, 0xff, 0x65, 0x41, 0x0a, 0x50, 0x6b, 0xd2, 0x0b,
+	0xa4, 0x0b, 0x16, 0xa8, 0x9c, 0x56, 0xc9, 0xa3, 0xef, 0xfc, 0xbd, 0xb5, 0x42, 0x86, 0x57, 0x61,
+	0x29, 0x3d, 0x23, 0x75, 0xa7, 0x56, 0x22, 0x21, 0xc4, 0x77, 0x87, 0x81, 0x50, 0x57, 0xb7, 0xea,
+	0x29, 0x88, 0x2e, 0xa1, 0x50, 0xf9, 0x2b, 0xbc, 0x84, 0x42, 0xbe, 0xa0, 0xa5, 0x7f, 0xb1, 0x64,
+	0xfb, 0x17, 0x3d, 0x58, 0x35, 0x4c, 0xfd, 0x68, 0x22, 0xe2, 0xf3, 0x0b, 0x02, 0x97, 0xe8, 0xde,
+	0xc6, 0x91, 0x74, 0x11, 0x4b, 0x1e, 0x7d, 0x63, 0xc6, 0x30, 0x8d, 0x94, 0x44, 0x16, 0x53, 0x32,
+	0x68, 0x43, 0x0a, 0x72, 0x28, 0x5d, 0x42, 0x00, 0xff, 0x14, 0xea, 0x66, 0x16, 0x52, 0x00, 0x37,
+	0x61, 0xc9, 0x47, 0x20, 0x7f, 0xfd, 0x0d, 0x95, 0xa7, 0xda, 0xf9, 0x77, 0x61, 0xb1, 0x35, 0x0c,
+	0x7c, 0x8a, 0xe8, 0x0d, 0xfd, 0x23, 0x31, 0xd4, 0x11, 0x3d, 0x02, 0xe6, 0xd7, 0x83, 0xf1, 0xbb,
+	0x50, 0xa5, 0x8e, 0x34, 0xdf, 0x7b, 0xb0, 0xec, 0x23, 0x20, 0xf2, 0x6f, 0x3d, 0x22, 0xf1, 0x74,
+	0x23, 0x7f, 0x09, 0xb5, 0x17, 0x18, 0x59, 0x68, 0x65, 0x49, 0xf4, 0x39, 0x36, 0xe8, 0x0a, 0x2c,
+	0x86, 0x51, 0xd8, 0xd5, 0x41, 0x65, 0x09, 0x90, 0x30, 0xfa, 0x43, 0x1f, 0xf1, 0xf2, 0xa4, 0x34,
+	0xc8, 0xb7, 0xa1, 0x61, 0x8f, 0x4c, 0x5c, 0x7d, 0x67, 0x4a, 0x0d, 0xea, 0x90, 0x9e, 0x4d, 0x6a,
+	0x69, 0xc2, 0x9f, 0x01, 0x93, 0x91, 0xe6, 0xce, 0x59, 0x3b, 0x18, 0x4d, 0x86, 0x32, 0x06, 0xf4,
+	0x66, 0xef, 0xd8, 0x2b, 0x76, 0x41, 0x82, 0x3e, 0x7f, 0xf6, 0x91, 0xca, 0x19, 0x49, 0x7b, 0xb9,
+	0xa1, 0x8f, 0x21, 0x1f, 0xce, 0x96, 0xd9, 0x24, 0xfe, 0x4f, 0x45, 0xe5, 0xa6, 0x77, 0xc4, 0x68,
+	0x8c, 0x59, 0x83, 0x0b, 0xee, 0xe5, 0xbb, 0x58, 0x9f, 0x25, 0x4e, 0xf2, 0x09, 0x23, 0x17, 0x79,
+	0x81, 0x6b, 0x74, 0xe1, 0x8b, 0x42, 0x7a, 0x27, 0x41, 0x78, 0xe4, 0x27, 0x42, 0xb1, 0xa9, 0x2a,
+	0x27, 0xf3, 0x68, 0xe7, 0x25, 0xd9, 0xc1, 0x57, 0xcf, 0x52, 0xee, 0x25, 0x89, 0xc8, 0xe9, 0xf7,
+	0xe6, 0xf2, 0xac, 0xf7, 0x26, 0x15, 0xd6, 0x3c, 0x8b, 0x51, 0xa5, 0xc8, 0x60, 0x90, 0x06, 0xd9,
+	0x57, 0xa5, 0x1b, 0x26, 0xa3, 0x23, 0x96, 0x73, 0x88, 0x58, 0xbe, 0x05, 0x57, 0xb4, 0x63, 0xa3,
+	0x32, 0x1d, 0x5f, 0x24, 0x18, 0x80, 0xb8, 0x02, 0x8b, 0x13, 0xfc, 0xd0, 0xd1, 0x90, 0x89, 0xc6,
+	0x7e, 0x39, 0x89, 0x52, 0x5f, 0x4b, 0x16, 0x01, 0xfc, 0xbf, 0x0a, 0x70, 0x69, 0x2f, 0x4c, 0x45,
+	0x1c, 0xfa, 0xc3, 0x67, 0x63, 0x11, 0xcb, 0xa3, 0x5f, 0x85, 0x62, 0x34, 0xd6, 0xd5, 0x0d, 0xd1,
+	0x98, 0x14, 0x02, 0x3a, 0x14, 0x99, 0x73, 0x4b, 0x90, 0xc1, 0x9b, 0xd0, 0xa5, 0x84, 0xd0, 0x70,
+	0xf4, 0x27, 0x21, 0xc5, 0x55, 0x95, 0x0a, 0x31, 0x30, 0x5e, 0x77, 0x1f, 0xdd, 0x37, 0xa5, 0x44,
+	0x7c, 0xd7, 0x75, 0x5b, 0x72, 0x62, 0xc4, 0x99, 0xc7, 0xba, 0x6c, 0x7b, 0xac, 0x99, 0xd0, 0x55,
+	0x6c, 0xa1, 0xbb, 0x05, 0x8b, 0x38, 0xbf, 0xde, 0x2e, 0x2d, 0x75, 0x53, 0xcb, 0xf3, 0x24, 0x19,
+	0xff, 0xbd, 0x02, 0xb0, 0xa9, 0xc6, 0xc4, 0xd2, 0x8a, 0x05, 0x47, 0x2b, 0xce, 0xb7, 0x08, 0xf7,
+	0x01, 0x22, 0xd3, 0x7f, 0xa3, 0xf4, 0x9a, 0xd9, 0x2d, 0x5a, 0xfe, 0x02, 0xaa, 0x5b, 0x7e, 0x98,
+	0xd5, 0xcb, 0xa1, 0x4a, 0x35, 0x32, 0xaf, 0x20, 0xdc, 0xcd, 0xde, 0x44, 0xf6, 0x50, 0x4a, 0xd2,
+	0xc0, 0x32, 0x5b, 0xe2, 0x27, 0xaa, 0x10, 0xa1, 0xea, 0x29, 0x88, 0x0f, 0x00, 0xb6, 0xfc, 0x30,
+	0x14, 0x3d, 0xaa, 0xdc, 0x9c, 0x37, 0x32, 0x86, 0x35, 0x03, 0xad, 0x6d, 0x4a, 0x9e, 0x04, 0x48,
+	0x7e, 0xc2, 0x54, 0x15, 0x3a, 0x95, 0x3c, 0x09, 0x58, 0x33, 0x95, 0x9d, 0x99, 0x3e, 0x85, 0xd5,
+	0x6c, 0x26, 0xf5, 0xbe, 0x73, 0xea, 0x1e, 0xf5, 0x93, 0x32, 0xa3, 0x52, 0xd5, 0x8f, 0xfc, 0x3f,
+	0x0b, 0xd0, 0xc8, 0x17, 0x54, 0xe2, 0x6a, 0x75, 0x49, 0xa5, 0x76, 0x59, 0x35, 0x4c, 0x0e, 0x94,
+	0x2e, 0x07, 0x29, 0x7b, 0xf4, 0x8d, 0x17, 0x1a, 0x7f, 0xa9, 0xa4, 0x48, 0x17, 0x81, 0x19, 0x84,
+	0x89, 0xba, 0x9c, 0x88, 0x9e, 0x0a, 0x7a, 0x1a, 0x18, 0x2f, 0xa7, 0xfe, 0x96, 0xbd, 0xa5, 0x75,
+	0x76, 0x91, 0xb8, 0x6e, 0x12, 0x2f, 0xed, 0x50, 0x28, 0x48, 0x56, 0xc8, 0x24, 0x63, 0xbc, 0xc8,
+	0x89, 0x0a, 0x80, 0x66, 0x08, 0x8a, 0x83, 0x9f, 0x1c, 0x3f, 0xf1, 0x53, 0x11, 0x76, 0xcf, 0x49,
+	0x4c, 0x4b, 0x9e, 0x85, 0xe1, 0xbb, 0xd0, 0xd8, 0x8a, 0x83, 0xde, 0xb1, 0x38, 0x88, 0xa3, 0xa8,
+	0x2f, 0x4d, 0x64, 0xc6, 0xab, 0x0e, 0xb5, 0x19, 0xf8, 0x82, 0x9a, 0x96, 0xbf, 0xc6, 0x90, 0x59,
+	0x36, 0x94, 0x4d, 0x59, 0x98, 0x52, 0x7d, 0x17, 0xbc, 0x26, 0x19, 0x94, 0xe3, 0x28, 0x4a, 0xd5,
+	0x4d, 0xa6, 0x6f, 0x99, 0xa9, 0x1b, 0x47, 0x49, 0x90, 0xaa, 0xed, 0xab, 0x79, 0x19, 0x82, 0x7d,
+	0x44, 0xe5, 0x0d, 0x51, 0x5f, 0xd5, 0xb3, 0x5c, 0xb5, 0x73, 0xdc, 0xb4, 0x22, 0x62, 0xc8, 0x93,
+	0x44, 0x1f, 0xfe, 0x7b, 0x41, 0xd7, 0xe3, 0x29, 0x1f, 0xb6, 0x0a, 0x8b, 0x9d, 0x97, 0x87, 0xcf,
+	0x1e, 0x37, 0x16, 0xd8, 0x15, 0x68, 0x74, 0x5e, 0x1e, 0xee, 0x3f, 0xdb, 0xdf, 0xde, 0x39, 0xec,
+	0x3c, 0x7b, 0x76, 0xf8, 0xe4, 0xd9, 0x8b, 0x46, 0x81, 0xad, 0xc3, 0xa5, 0xce, 0xcb, 0xc3, 0xd6,
+	0x13, 0x6f, 0xa7, 0xf5, 0xe0, 0xc7, 0x87, 0x3b, 0x2f, 0xf7, 0xda, 0x9d, 0x76, 0xa3, 0xc8, 0x2e,
+	0xc3, 0x5a, 0xe7, 0xe5, 0xe1, 0xde, 0xfe, 0xf3, 0xd6, 0x93, 0xbd, 0x07, 0x87, 0xbb, 0xad, 0xf6,
+	0x6e, 0xa3, 0x94, 0x43, 0xb6, 0xf7, 0x1e, 0xed, 0x37, 0xca, 0x6a, 0x00, 0x8d, 0x7c, 0xf8, 0xcc,
+	0x7b, 0xda, 0xea, 0x34, 0x16, 0xd9, 0x57, 0xe1, 0x1a, 0xa1, 0xdb, 0x5f, 0x3c, 0x7c, 0xb8, 0xb7,
+	0xbd, 0xb7, 0xb3, 0xdf, 0x39, 0xdc, 0x6a, 0x3d, 0x69, 0xed, 0x6f, 0xef, 0x34, 0x96, 0x54, 0x9f,
+	0xdd, 0x56, 0xfb, 0xb0, 0xdd, 0x7a, 0xba, 0x23, 0x79, 0x6a, 0x2c, 0x9b, 0xa1, 0x3a, 0x3b, 0xde,
+	0x7e, 0xeb, 0xc9, 0xe1, 0x8e, 0xe7, 0x3d, 0xf3, 0x1a, 0x55, 0x76, 0x0d, 0x2e, 0x5b, 0x33, 0x6c,
+	0xef, 0xb6, 0xf6, 0xf6, 0x0f, 0xf7, 0x1e, 0x34, 0xe0, 0xc3, 0xbe, 0x2e, 0xe9, 0x33, 0x89, 0xc3,
+	0xc6, 0xf3, 0x1d, 0x6f, 0xef, 0xe1, 0x8f, 0x0f, 0xdb, 0x9d, 0x56, 0xe7, 0x8b, 0xb6, 0x5c, 0xf7,
+	0x0d, 0xb8, 0xee, 0x62, 0x91, 0xf1, 0xc3, 0xfd, 0x67, 0x9d, 0xc3, 0xa7, 0xad, 0xce, 0xf6, 0x6e,
+	0xa3, 0xc0, 0xde, 0x81, 0xa6, 0x4b, 0xe1, 0xac, 0xbb, 0xb8, 0xf9, 0x2f, 0x37, 0x61, 0xad, 0x25,
+	0xe2, 0xe3, 0xc8, 0x3b, 0xd8, 0xc6, 0x22, 0x19, 0x4c, 0xee, 0xdc, 0x81, 0x2a, 0x96, 0x57, 0xd1,
+	0x39, 0x30, 0xfd, 0x30, 0x50, 0x05, 0x57, 0xcd, 0x19, 0x85, 0x78, 0x7c, 0x81, 0xdd, 0x81, 0xa5,
+	0xa7, 0xf4, 0xdf, 0x0f, 0xb6, 0x6e, 0x82, 0x71, 0x08, 0x26, 0xca, 0x51, 0x6e, 0xae, 0xba, 0x68,
+	0xbe, 0xc0, 0x3e, 0x01, 0xc8, 0xfe, 0x11, 0xc2, 0x8c, 0x73, 0x80, 0xd5, 0xef, 0xcd, 0x6b, 0x76,
+	0xa4, 0xcb, 0xfa, 0xcb, 0x08, 0x5f, 0x60, 0xb7, 0xa1, 0xf6, 0x48, 0xa4, 0xd9, 0x1f, 0x25, 0xdc,
+	0x8e, 0x0d, 0xe7, 0xaf, 0x12, 0xe8, 0x1b, 0x2c, 0xb0, 0x5b, 0xea, 0x7f, 0x15, 0xa4, 0x1d, 0x5c,
+	0xf2, 0x4b, 0x36, 0xb9, 0x2c, 0x53, 0x5a, 0x60, 0x9f, 0x43, 0x03, 0xd5, 0x90, 0x55, 0xc1, 0x9a,
+	0x30, 0x4d, 0x98, 0xa5, 0x02, 0x9a, 0x57, 0xa7, 0x2b, 0x5d, 0xb1, 0x95, 0x2f, 0xb0, 0x2d, 0xb8,
+	0x64, 0x06, 0x30, 0xc5, 0xb3, 0x33, 0x46, 0xd8, 0x98, 0x55, 0xbc, 0xaa, 0xc6, 0xb8, 0x03, 0x6b,
+	0x66, 0x0c, 0x19, 0xa3, 0xcd, 0xb1, 0xee, 0xc4, 0x19, 0xf9, 0xc2, 0xed, 0x02, 0x6b, 0xc1, 0xb5,
+	0xa9, 0x69, 0x67, 0x76, 0x9d, 0x59, 0x34, 0x4b, 0x43, 0xdc, 0x82, 0xca, 0x23, 0x21, 0x47, 0x60,
+	0x33, 0x0e, 0x3a, 0x3f, 0x29, 0xfb, 0x21, 0x34, 0x34, 0xbd, 0x59, 0xe8, 0xac, 0x7e, 0x73, 0x66,
+	0x64, 0x9f, 0xd3, 0x61, 0x9a, 0xf2, 0x69, 0x76, 0x35, 0x5f, 0x63, 0xad, 0x76, 0x6a, 0x7d, 0x1a,
+	0x7f, 0x2c, 0x7a, 0x7c, 0x81, 0xdd, 0x84, 0xc5, 0x47, 0x22, 0xed, 0xbc, 0x9c, 0x39, 0x6b, 0xe6,
+	0xef, 0xf0, 0x05, 0xf6, 0x31, 0x80, 0x9e, 0x6a, 0x0e, 0xf9, 0x54, 0x4c, 0x94, 0x2f, 0xb0, 0x4d,
+	0xea, 0xa5, 0xf2, 0x00, 0x33, 0x7b, 0xe5, 0x72, 0x05, 0x7c, 0x01, 0x4b, 0xa2, 0x1f, 0x09, 0x0a,
+	0x15, 0xcd, 0xa2, 0xb7, 0x62, 0x3f, 0x92, 0xb6, 0x2d, 0xc2, 0x5e, 0xe7, 0x25, 0xcb, 0x98, 0x6d,
+	0xce, 0x2a, 0xf6, 0xe5, 0x78, 0xd9, 0x97, 0xf0, 0x1d, 0xe2, 0xd2, 0x3a, 0x6b, 0xfc, 0x08, 0x2a,
+	0x52, 0x69, 0xcc, 0x1e, 0xcf, 0xae, 0x11, 0xa6, 0x1d, 0xa9, 0xc8, 0x19, 0x3a, 0x2f, 0x59, 0xdd,
+	0x50, 0xa3, 0x08, 0x99, 0xfb, 0x97, 0x2f, 0x4c, 0xe6, 0x0b, 0x4a, 0x44, 0xa4, 0x6e, 0xb8, 0x48,
+	0x44, 0x88, 0x82, 0x2f, 0xb0, 0x5f, 0x25, 0x11, 0x21, 0xa8, 0x15, 0xf6, 0xa4, 0x89, 0x59, 0x77,
+	0x83, 0x0d, 0xea, 0x4f, 0x29, 0xcd, 0xcb, 0x2e, 0x9a, 0x68, 0xe9, 0x0c, 0xea, 0xdb, 0xb1, 0xc0,
+	0xfe, 0x12, 0xcf, 0xd6, 0xcc, 0x1f, 0x21, 0x64, 0x75, 0x72, 0x33, 0x17, 0xbc, 0xa0, 0xeb, 0xb3,
+	0x82, 0x67, 0x20, 0xe1, 0x24, 0x27, 0xff, 0xcc, 0x25, 0x57, 0x0b, 0xbb, 0x0d, 0x2b, 0x4f, 0xa2,
+	0xee, 0xab, 0xb7, 0x98, 0x64, 0x13, 0xea, 0x32, 0xa8, 0xf2, 0x16, 0x7d, 0xee, 0x41, 0x5d, 0x16,
+	0x39, 0xeb, 0x3e, 0x7a, 0xd1, 0x76, 0xe9, 0xf3, 0xec, 0x7e, 0x3b, 0x67, 0x76, 0xbf, 0xa9, 0xb9,
+	0x66, 0x2b, 0xe6, 0xbb, 0x50, 0x27, 0x53, 0xaa, 0x1d, 0x7d, 0xb3, 0x15, 0x84, 0x9d, 0xd3, 0xa9,
+	0x05, 0xcc, 0xe9, 0x24, 0x4f, 0xfb, 0xd2, 0x94, 0x7d, 0x6e, 0xce, 0x31, 0xd9, 0x7c, 0x81, 0x7d,
+	0x06, 0xab, 0x78, 0xdd, 0x2c, 0xbf, 0xc2, 0xe8, 0xf4, 0x9c, 0xdb, 0xd2, 0x64, 0xd3, 0x0d, 0xec,
+	0x0e, 0x49, 0x19, 0x15, 0xb0, 0x32, 0xfb, 0x5f, 0x42, 0xaa, 0x9c, 0xb5, 0xb9, 0x66, 0xe1, 0xcc,
+	0xf9, 0x61, 0x97, 0xe7, 0x54, 0x30, 0x7c, 0xc9, 0x2a, 0x2e, 0xca, 0xf5, 0xd0, 0x75, 0xc7, 0xa4,
+	0xa7, 0xd7, 0x32, 0x21, 0x91, 0x1d, 0xf3, 0x92, 0x29, 0x5f, 0xd5, 0xcd, 0xab, 0x2e, 0x5a, 0xd7,
+	0x2c, 0x49, 0x2b, 0x26, 0xc5, 0x9b, 0x4a, 0x71, 0xe6, 0x74, 0xcf, 0x55, 0x52, 0xf1, 0x05, 0xf6,
+	0x6d, 0x92, 0x4f, 0x53, 0x4a, 0x6c, 0x17, 0x0f, 0x37, 0xf3, 0xc5, 0x44, 0x74, 0xfa, 0x64, 0x0d,
+	0xac, 0x8c, 0x1d, 0x9b, 0xce, 0x3c, 0x37, 0x9d, 0x07, 0x36, 0xe9, 0xf3, 0xbb, 0xf2, 0xff, 0x3d,
+	0x3b, 0xf2, 0xa9, 0x3d, 0xa3, 0x4b, 0xc3, 0xee, 0xa2, 0xb6, 0xe5, 0x1e, 0xd4, 0x71, 0x49, 0x59,
+	0x2d, 0xae, 0x26, 0x32, 0xe5, 0xbb, 0xc6, 0x6e, 0x66, 0x44, 0x7c, 0x81, 0xdd, 0xa7, 0x9b, 0xee,
+	0xd6, 0x83, 0xce, 0x36, 0x3c, 0x0e, 0x0d, 0x5f, 0x60, 0x8f, 0xa1, 0xb1, 0x3d, 0xf0, 0xc3, 0x63,
+	0x21, 0x33, 0x78, 0xc9, 0x20, 0x18, 0x1b, 0x71, 0xc9, 0x50, 0x92, 0xa4, 0x79, 0x7d, 0x4e, 0x83,
+	0x27, 0xc6, 0xc3, 0x73, 0xbe, 0xc0, 0xb6, 0xe1, 0x32, 0x2e, 0xc4, 0x14, 0x93, 0xaa, 0xfd, 0x72,
+	0x44, 0x35, 0x2b, 0x32, 0xcd, 0x2b, 0x03, 0x6c, 0xb9, 0x5d, 0xd0, 0x83, 0xe4, 0x9e, 0xc7, 0x6c,
+	0xc3, 0x2d, 0x0c, 0xb4, 0x6c, 0x39, 0x9b, 0x6e, 0x61, 0x3b, 0xb0, 0x4e, 0x42, 0x4c, 0x45, 0x2e,
+	0x2f, 0xac, 0x5a, 0xb8, 0x39, 0x62, 0xb2, 0x3e, 0x55, 0x42, 0x47, 0xc3, 0x3c, 0x82, 0x2b, 0x78,
+	0x1e, 0x53, 0xa5, 0x7c, 0x73, 0x46, 0x99, 0x1b, 0x2c, 0x61, 0xf7, 0xe8, 0x80, 0xdc, 0x82, 0xa5,
+	0xd9, 0x07, 0xe4, 0xd2, 0xdc, 0x85, 0x55, 0x64, 0x04, 0xe5, 0x91, 0x2a, 0xe7, 0xf2, 0xfa, 0xf4,
+	0x72, 0x4e, 0x60, 0x89, 0xeb, 0xfb, 0x74, 0xb9, 0x9c, 0xe2, 0xb7, 0xd9, 0x3e, 0xde, 0x54, 0x3d,
+	0xd9, 0x6d, 0x58, 0xd1, 0x25, 0x42, 0x98, 0x30, 0xcf, 0x4c, 0x13, 0x1a, 0xfe, 0xe6, 0x8c, 0x42,
+	0x22, 0xf6, 0xa1, 0xb4, 0xed, 0xaa, 0x84, 0xc8, 0x9d, 0x66, 0xd5, 0xbc, 0x1e, 0x65, 0xab, 0xdc,
+	0x04, 0xb7, 0x3e, 0x66, 0x8e, 0x94, 0x3a, 0x34, 0xf7, 0x60, 0xb5, 0x13, 0xfb, 0x61, 0xd2, 0x17,
+	0xb1, 0xaa, 0xfd, 0x99, 0x4e, 0x3b, 0x37, 0xa7, 0x51, 0xec, 0x33, 0x58, 0x97, 0xd6, 0x2b, 0x5f,
+	0x73, 0xe2, 0x4e, 0x7a, 0x35, 0x37, 0xa9, 0xa6, 0xfa, 0x14, 0xea, 0xe6, 0x06, 0x53, 0x75, 0xc8,
+	0xd5, 0xfc, 0x8d, 0x55, 0x02, 0xe8, 0xdc, 0x64, 0xa2, 0xfc, 0x01, 0xac, 0x3b, 0xa6, 0x46, 0x97,
+	0x83, 0xbc, 0x91, 0xc9, 0x61, 0x5b, 0xb0, 0xbe, 0x73, 0x36, 0xab, 0xf7, 0x7a, 0xa6, 0x0d, 0xac,
+	0xea, 0x92, 0x59, 0x96, 0x84, 0xfd, 0x50, 0x1a, 0x01, 0xab, 0x1e, 0xc3, 0x71, 0x79, 0xed, 0x5a,
+	0x91, 0x26, 0x9b, 0x6e, 0x61, 0x8f, 0x60, 0xdd, 0x78, 0xb4, 0x12, 0xa5, 0x2e, 0xb3, 0x33, 0x8c,
+	0x5d, 0x25, 0x31, 0x6b, 0x18, 0x72, 0x8d, 0xd7, 0xcd, 0x2e, 0x4a, 0xed, 0x97, 0x1b, 0x68, 0xaa,
+	0x16, 0xc2, 0x55, 0xa6, 0x34, 0xc4, 0x86, 0xd2, 0x08, 0x56, 0xc9, 0xc2, 0x4c, 0xf7, 0x7a, 0x3d,
+	0xaf, 0xe5, 0xf4, 0x10, 0x1f, 0x93, 0x62, 0x25, 0xce, 0x12, 0xca, 0x9d, 0x9b, 0xc8, 0x86, 0x29,
+	0x42, 0x68, 0x36, 0xf2, 0x39, 0x7e, 0x76, 0x8b, 0x84, 0xbb, 0x73, 0x36, 0xb7, 0x4b, 0xdd, 0x49,
+	0xb9, 0xb3, 0x3b, 0xe4, 0x53, 0xeb, 0x5c, 0xf8, 0xbc, 0xbb, 0xea, 0x24, 0xd4, 0xef, 0xc2, 0x4a,
+	0x3b, 0xeb, 0xc2, 0xf2, 0xf9, 0xf4, 0xd9, 0x9d, 0x36, 0xa1, 0xd6, 0x16, 0x69, 0x96, 0x5f, 0xd7,
+	0x9c, 0x1b, 0x4c, 0x73, 0x0a, 0xc3, 0x3e, 0x81, 0x15, 0x4c, 0xa4, 0x4b, 0x44, 0xe6, 0x3f, 0x5a,
+	0x39, 0xf6, 0xe6, 0x0c, 0x1c, 0x7b, 0x0a, 0x97, 0x33, 0x43, 0x9d, 0xe5, 0x98, 0xbf, 0x96, 0x4f,
+	0x2b, 0x3b, 0xb9, 0xf6, 0xe6, 0xb5, 0x39, 0xcd, 0xec, 0x3e, 0x89, 0xa5, 0x9d, 0xd3, 0x9d, 0xe5,
+	0xc8, 0xb2, 0xec, 0x18, 0x0d, 0xdd, 0xa7, 0x00, 0x32, 0x19, 0x8d, 0x69, 0x69, 0x63, 0xa2, 0xf2,
+	0x39, 0xea, 0x99, 0x77, 0xe1, 0x3e, 0xac, 0x4a, 0x3d, 0x60, 0x72, 0xab, 0xeb, 0x26, 0xb7, 0x68,
+	0x27, 0x7e, 0x9b, 0x6b, 0x39, 0x34, 0xdb, 0x84, 0x55, 0x79, 0x13, 0x9f, 0xea, 0xfc, 0xee, 0x94,
+	0xef, 0x37, 0xd5, 0xe7, 0xfb, 0xb0, 0x2a, 0x73, 0xb3, 0xc6, 0x05, 0xd6, 0x67, 0x68, 0xa7, 0x6c,
+	0x9b, 0x57, 0xf2, 0xe9, 0x4d, 0x3a, 0xd9, 0x8f, 0xa5, 0xbe, 0x37, 0xc8, 0x79, 0x0a, 0xd2, 0xed,
+	0x75, 0x8f, 0x14, 0xbe, 0x93, 0x65, 0xcc, 0xe9, 0x14, 0x23, 0x47, 0x0e, 0xd1, 0x67, 0x72, 0x36,
+	0x93, 0x62, 0xc9, 0xcc, 0xa3, 0x9b, 0x01, 0x6a, 0x5e, 0xc9, 0xa3, 0x55, 0x0a, 0xa5, 0xd2, 0x16,
+	0xa9, 0xcc, 0xc5, 0x38, 0xd9, 0x93, 0xa6, 0x03, 0xb1, 0x0f, 0x60, 0xe5, 0x81, 0x18, 0x8a, 0x54,
+	0xbc, 0x9e, 0xf4, 0xdb, 0xb0, 0x82, 0x43, 0x13, 0x20, 0x92, 0x39, 0x11, 0x86, 0x2c, 0x89, 0x73,
+	0x0f, 0xd6, 0x5a, 0xbd, 0x9e, 0x93, 0x9f, 0x99, 0xb7, 0x70, 0x87, 0xe8, 0x53, 0x60, 0x9e, 0x18,
+	0x45, 0x27, 0xe2, 0xed, 0xbb, 0x7e, 0x4f, 0x46, 0x18, 0x6c, 0xdc, 0x3c, 0xf3, 0x3a, 0x95, 0xdd,
+	0xf9, 0x81, 0x54, 0x64, 0x36, 0xbe, 0x73, 0x36, 0x53, 0x91, 0x4d, 0x95, 0x6d, 0xdc, 0x2e, 0xa0,
+	0x93, 0xa7, 0x52, 0x3c, 0x42, 0xa7, 0x7c, 0xec, 0xa7, 0xe6, 0x57, 0x1c, 0xe7, 0xc3, 0x49, 0x07,
+	0xdd, 0xcb, 0x62, 0x05, 0x26, 0x4d, 0x73, 0x41, 0x5c, 0xc2, 0xd0, 0xdc, 0x81, 0x95, 0xf6, 0xe4,
+	0x68, 0x14, 0xc8, 0xae, 0xcc, 0x09, 0x41, 0xcc, 0x0e, 0x2c, 0xb0, 0x5f, 0x83, 0x6b, 0xd6, 0x05,
+	0x77, 0x72, 0x1b, 0xb3, 0x6e, 0xfa, 0x57, 0x73, 0x37, 0xdd, 0xe9, 0xf0, 0x90, 0x9c, 0xb8, 0x19,
+	0x61, 0xfe, 0x59, 0x23, 0x7d, 0x65, 0x5e, 0xd0, 0x1e, 0xc5, 0x9c, 0x8e, 0x8c, 0x4a, 0x5b, 0x45,
+	0x4f, 0x79, 0xe4, 0x6f, 0x6e, 0xcc, 0x37, 0xb1, 0x44, 0x3a, 0xa4, 0x60, 0x7c, 0x23, 0x8b, 0x87,
+	0xe7, 0xc3, 0x23, 0x6e, 0x1c, 0xfd, 0x1e, 0x54, 0xbf, 0x08, 0x8f, 0x54, 0xaf, 0x59, 0xec, 0xce,
+	0xed, 0x27, 0x63, 0x4f, 0x06, 0x9b, 0xcc, 0xb1, 0x70, 0x6e, 0xbf, 0xcd, 0x9f, 0x01, 0x33, 0x05,
+	0x0b, 0x22, 0xd6, 0xd1, 0xc4, 0x6f, 0x41, 0x15, 0xbd, 0x60, 0x99, 0xcc, 0x9f, 0x2d, 0x60, 0x59,
+	0x01, 0xc3, 0xf7, 0x65, 0x32, 0x5a, 0x1e, 0xf5, 0x35, 0xab, 0xd9, 0xae, 0xb2, 0x70, 0xc3, 0x41,
+	0x26, 0x03, 0xbe, 0xf9, 0xc7, 0x05, 0xb8, 0xda, 0xd1, 0x99, 0x7e, 0x97, 0x89, 0xdb, 0xf4, 0xde,
+	0xd2, 0xf5, 0x06, 0x73, 0x6c, 0xa2, 0x53, 0x8e, 0xa0, 0x38, 0x91, 0xf4, 0x36, 0x27, 0x76, 0xfe,
+	0xbf, 0x69, 0xab, 0xaa, 0x2c, 0x0d, 0xbf, 0x75, 0xe3, 0xd7, 0xdf, 0x39, 0x0e, 0xd2, 0xc1, 0xe4,
+	0xe8, 0x56, 0x37, 0x1a, 0x7d, 0xc7, 0xc7, 0xf8, 0x6a, 0x10, 0xc9, 0xdf, 0xef, 0x50, 0x87, 0xa3,
+	0x25, 0xca, 0x49, 0xdc, 0xfd, 0x9f, 0x01, 0x00, 0x08, 0xec, 0x92, 0xa8, 0x7d, 0x47, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AergoRPCServiceClient is the client API for AergoRPCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AergoRPCServiceClient interface {
+	// Returns the current state of this node
+	NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Returns node metrics according to request
+	Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error)
+	// Returns current blockchain status (best block's height and hash)
+	Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error)
+	// Returns current blockchain's basic information
+	GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error)
+	// Returns current chain statistics
+	ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error)
+	// Returns list of Blocks without body according to request
+	ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error)
+	// Returns list of block metadata (hash, header, and number of transactions) according to request
+	ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error)
+	// Returns a stream of new blocks as they get added to the blockchain
+	ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error)
+	// Returns a stream of new block's metadata as they get added to the blockchain
+	ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error)
+	// Return a single block incl. header and body, queried by hash or number
+	GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error)
+	// Return a single block's metdata (hash, header, and number of transactions), queried by hash or number
+	GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error)
+	// Return a single block's body, queried by hash or number and list parameters
+	GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error)
+	// Return a single transaction, queried by transaction hash
+	GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error)
+	// Return information about transaction in block, queried by transaction hash
+	GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error)
+	// Return transaction receipt, queried by transaction hash
+	GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error)
+	// Return ABI stored at contract address
+	GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error)
+	// Sign and send a transaction from an unlocked account
+	SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error)
+	// Sign transaction with unlocked account
+	SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error)
+	// Verify validity of transaction
+	VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error)
+	// Commit a signed transaction
+	CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error)
+	// Return state of account
+	GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error)
+	// Return state of account, including merkle proof
+	GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error)
+	// Create a new account in this node
+	CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Return list of accounts in this node
+	GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error)
+	// Lock account in this node
+	LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Unlock account in this node
+	UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error)
+	// Import account to this node
+	ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error)
+	// Export account stored in this node
+	ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Query a contract method
+	QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Query contract state
+	QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error)
+	// Returns the total deposited to a receiver in the bridge with its proof, to claim it on the paired chain
+	GetBridgeProof(ctx context.Context, in *BridgeProofQuery, opts ...grpc.CallOption) (*BridgeProof, error)
+	// Return list of peers of this node and their state
+	GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error)
+	// Return result of vote
+	GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error)
+	// Return staking, voting info for account
+	GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error)
+	// Return staking information
+	GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error)
+	// Return name information
+	GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error)
+	// Returns a stream of event as they get added to the blockchain
+	ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error)
+	// Returns list of event
+	ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error)
+	// Returns configs and statuses of server
+	GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error)
+	// Returns status of consensus and bps
+	GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error)
+	// Add & remove member of raft cluster
+	ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error)
+	// Returns accounts and storage keys changed between the states of two blocks
+	ListStateDiffStream(ctx context.Context, in *StateDiffParams, opts ...grpc.CallOption) (AergoRPCService_ListStateDiffStreamClient, error)
+	// Returns a page of key-value pairs stored by a contract
+	ListContractStorage(ctx context.Context, in *StorageListParams, opts ...grpc.CallOption) (*StorageList, error)
+	// Return the unstaked amounts waiting for release of an account
+	GetPendingWithdrawals(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*WithdrawalList, error)
+	// Return the staking, pending withdrawals, votes, delegation and reward of an account in the system contract
+	GetSystemAccountInfo(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*SystemAccountInfo, error)
+	// Return the votes of all the BP candidates in descending order with the registered metadata
+	GetElectionTally(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ElectionTally, error)
+	// Return the names on offer and their offers
+	ListNameOffers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NameInfoList, error)
+	// Returns the turnout of the votes on the governance parameters against the quorum
+	GetQuorumStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QuorumStatusList, error)
+	// Estimate the fee of a tx
+	EstimateFee(ctx context.Context, in *TxBody, opts ...grpc.CallOption) (*FeeEstimate, error)
+	// Return the fee per byte charged to the txs of the next block
+	GetBaseFee(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseFee, error)
+	// Returns the membership, the leader and the replication progress of the raft cluster
+	GetClusterStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClusterStatus, error)
+	// Transfers the leadership of the raft cluster to the member of the given id
+	TransferLeader(ctx context.Context, in *MemberAttr, opts ...grpc.CallOption) (*MemberAttr, error)
+	// Takes a snapshot of the raft log of the node and compacts the log
+	CreateClusterSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClusterSnapshot, error)
+	// Returns a page of the events matching the filter
+	ListEventPage(ctx context.Context, in *EventListParams, opts ...grpc.CallOption) (*EventPage, error)
+	// Import an account in keystore format
+	ImportAccountKeystore(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error)
+	// Export an account in keystore format
+	ExportAccountKeystore(ctx context.Context, in *KeystoreParams, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Returns a block with the receipts, the consensus info and the fee totals as requested
+	GetBlockDetail(ctx context.Context, in *BlockDetailParams, opts ...grpc.CallOption) (*BlockDetail, error)
+	// Starts a stream of new blocks with the receipts, the consensus info and the fee totals as requested
+	ListBlockDetailStream(ctx context.Context, in *BlockStreamParams, opts ...grpc.CallOption) (AergoRPCService_ListBlockDetailStreamClient, error)
+	// Starts a stream of the new events of any of the contracts and names of the filter
+	ListEventFilterStream(ctx context.Context, in *EventStreamFilter, opts ...grpc.CallOption) (AergoRPCService_ListEventFilterStreamClient, error)
+	// Starts a stream of the leader and membership changes of the raft cluster
+	ListConsensusEventStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListConsensusEventStreamClient, error)
+	// Returns the blocks of up to 100 hashes or numbers, with the status of each of them
+	GetBlocksBulk(ctx context.Context, in *BulkParams, opts ...grpc.CallOption) (*BlockBulk, error)
+	// Returns the txs in the blocks of up to 100 hashes, with the status of each of them
+	GetTxsBulk(ctx context.Context, in *BulkParams, opts ...grpc.CallOption) (*TxBulk, error)
+	// Returns the log levels of the modules of the node
+	GetLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogLevelList, error)
+	// Changes the log level of a module, or of all the modules
+	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevelList, error)
+	// Starts or stops serving the pprof endpoints of the node
+	SetProfiling(ctx context.Context, in *Profiling, opts ...grpc.CallOption) (*Profiling, error)
+	// Writes a profile of the node to a file in its data directory
+	DumpProfile(ctx context.Context, in *ProfileDump, opts ...grpc.CallOption) (*ProfileDump, error)
+	// Returns a page of the txs sent or received by an account, including the system and governance txs
+	GetAccountTxHistory(ctx context.Context, in *AccountTxHistoryParams, opts ...grpc.CallOption) (*AccountTxHistory, error)
+	// Returns the ABI of a contract with its resolved address and code hash
+	GetContractABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractABI, error)
+	// Returns the payload of a call to a contract checked against its ABI
+	EncodeCall(ctx context.Context, in *CallEncodeParams, opts ...grpc.CallOption) (*SingleBytes, error)
+	// Create the hd wallet of the node and return its mnemonic
+	CreateHDWallet(ctx context.Context, in *HDWalletParams, opts ...grpc.CallOption) (*HDWallet, error)
+	// Return the mnemonic of the hd wallet of the node
+	ExportMnemonic(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*HDWallet, error)
+	// Derive the accounts of the hd wallet of the node
+	DeriveAccounts(ctx context.Context, in *DeriveParams, opts ...grpc.CallOption) (*HDAccountList, error)
+	// Return the accounts derived from the hd wallet of the node
+	ListHDAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HDAccountList, error)
+	// Return the unlock session of the account
+	GetUnlockStatus(ctx context.Context, in *Account, opts ...grpc.CallOption) (*UnlockStatus, error)
+	// Returns the records of the signing by the node
+	ListSignAudits(ctx context.Context, in *SignAuditQuery, opts ...grpc.CallOption) (*SignAuditList, error)
+	// Labels an address in the address book
+	SetAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error)
+	// Removes a label from the address book
+	DeleteAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error)
+	// Returns the aliases of the address book
+	ListAliases(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AliasList, error)
+	// Add an address to watch without its private key
+	AddWatchAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*WatchAccount, error)
+	// Stop watching an address
+	RemoveWatchAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*WatchAccount, error)
+	// Return the watched addresses with their nonces and balances
+	ListWatchAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WatchAccountList, error)
+	// Stream the txs of the watched addresses in the new blocks
+	ListWatchAccountTxStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListWatchAccountTxStreamClient, error)
+	// Run a system tx against the best state without committing it
+	SimulateSystemTx(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*SystemTxSimulation, error)
+	// Return the candidate of the next block built by the node
+	GetBlockTemplate(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockTemplate, error)
+	// Add a signed block built outside of the node, on the consensus allowing it
+	SubmitBlock(ctx context.Context, in *Block, opts ...grpc.CallOption) (*BlockMetadata, error)
+	// Return the storage bytes used by a contract and the storage quota
+	GetContractStorageUsage(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractStorageUsage, error)
+	// Return the internal operations of a contract tx
+	GetInternalOperations(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*InternalOperations, error)
+	// Returns a page of the events looked up by the values of their indexed arguments
+	ListIndexedEvents(ctx context.Context, in *EventListParams, opts ...grpc.CallOption) (*EventPage, error)
+	// Ban a peer and disconnect it, returning the banned peers
+	BanPeer(ctx context.Context, in *BanParams, opts ...grpc.CallOption) (*BannedPeerList, error)
+	// Lift the ban of a peer, returning the banned peers
+	UnbanPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BannedPeerList, error)
+	// Return the peers banned by the operator
+	ListBannedPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BannedPeerList, error)
+}
+
+type aergoRPCServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAergoRPCServiceClient(cc *grpc.ClientConn) AergoRPCServiceClient {
+	return &aergoRPCServiceClient{cc}
+}
+
+func (c *aergoRPCServiceClient) NodeState(ctx context.Context, in *NodeReq, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/NodeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) Metric(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (*Metrics, error) {
+	out := new(Metrics)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Metric", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) Blockchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockchainStatus, error) {
+	out := new(BlockchainStatus)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/Blockchain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetChainInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainInfo, error) {
+	out := new(ChainInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ChainStat(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChainStats, error) {
+	out := new(ChainStats)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChainStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockHeaders(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockHeaderList, error) {
+	out := new(BlockHeaderList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockMetadata(ctx context.Context, in *ListParams, opts ...grpc.CallOption) (*BlockMetadataList, error) {
+	out := new(BlockMetadataList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBlockMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[0], "/types.AergoRPCService/ListBlockStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListBlockStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListBlockStreamClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListBlockStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListBlockStreamClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockMetadataStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListBlockMetadataStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[1], "/types.AergoRPCService/ListBlockMetadataStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListBlockMetadataStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListBlockMetadataStreamClient interface {
+	Recv() (*BlockMetadata, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListBlockMetadataStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListBlockMetadataStreamClient) Recv() (*BlockMetadata, error) {
+	m := new(BlockMetadata)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlock(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlockMetadata(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BlockMetadata, error) {
+	out := new(BlockMetadata)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlockBody(ctx context.Context, in *BlockBodyParams, opts ...grpc.CallOption) (*BlockBodyPaged, error) {
+	out := new(BlockBodyPaged)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockBody", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Tx, error) {
+	out := new(Tx)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlockTX(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*TxInBlock, error) {
+	out := new(TxInBlock)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetReceipt(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ABI, error) {
+	out := new(ABI)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SendTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*CommitResult, error) {
+	out := new(CommitResult)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SendTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SignTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*Tx, error) {
+	out := new(Tx)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SignTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) VerifyTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*VerifyResult, error) {
+	out := new(VerifyResult)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/VerifyTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) CommitTX(ctx context.Context, in *TxList, opts ...grpc.CallOption) (*CommitResultList, error) {
+	out := new(CommitResultList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CommitTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetState(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*State, error) {
+	out := new(State)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetStateAndProof(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*AccountProof, error) {
+	out := new(AccountProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStateAndProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) CreateAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccountList, error) {
+	out := new(AccountList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) LockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/LockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) UnlockAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/UnlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ImportAccount(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ImportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ExportAccount(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ExportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) QueryContract(ctx context.Context, in *Query, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/QueryContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) QueryContractState(ctx context.Context, in *StateQuery, opts ...grpc.CallOption) (*StateQueryProof, error) {
+	out := new(StateQueryProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/QueryContractState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBridgeProof(ctx context.Context, in *BridgeProofQuery, opts ...grpc.CallOption) (*BridgeProof, error) {
+	out := new(BridgeProof)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBridgeProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetPeers(ctx context.Context, in *PeersParams, opts ...grpc.CallOption) (*PeerList, error) {
+	out := new(PeerList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetVotes(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*VoteList, error) {
+	out := new(VoteList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetAccountVotes(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*AccountVoteInfo, error) {
+	out := new(AccountVoteInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAccountVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetStaking(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*Staking, error) {
+	out := new(Staking)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetNameInfo(ctx context.Context, in *Name, opts ...grpc.CallOption) (*NameInfo, error) {
+	out := new(NameInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetNameInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListEventStream(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (AergoRPCService_ListEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[2], "/types.AergoRPCService/ListEventStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListEventStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListEventStreamClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListEventStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListEventStreamClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) ListEvents(ctx context.Context, in *FilterInfo, opts ...grpc.CallOption) (*EventList, error) {
+	out := new(EventList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetServerInfo(ctx context.Context, in *KeyParams, opts ...grpc.CallOption) (*ServerInfo, error) {
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetConsensusInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ConsensusInfo, error) {
+	out := new(ConsensusInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetConsensusInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ChangeMembership(ctx context.Context, in *MembershipChange, opts ...grpc.CallOption) (*MembershipChangeReply, error) {
+	out := new(MembershipChangeReply)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ChangeMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListStateDiffStream(ctx context.Context, in *StateDiffParams, opts ...grpc.CallOption) (AergoRPCService_ListStateDiffStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[3], "/types.AergoRPCService/ListStateDiffStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListStateDiffStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListStateDiffStreamClient interface {
+	Recv() (*AccountDiff, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListStateDiffStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListStateDiffStreamClient) Recv() (*AccountDiff, error) {
+	m := new(AccountDiff)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) ListContractStorage(ctx context.Context, in *StorageListParams, opts ...grpc.CallOption) (*StorageList, error) {
+	out := new(StorageList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetPendingWithdrawals(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*WithdrawalList, error) {
+	out := new(WithdrawalList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetPendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetSystemAccountInfo(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*SystemAccountInfo, error) {
+	out := new(SystemAccountInfo)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetSystemAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetElectionTally(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ElectionTally, error) {
+	out := new(ElectionTally)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetElectionTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListNameOffers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NameInfoList, error) {
+	out := new(NameInfoList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListNameOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetQuorumStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*QuorumStatusList, error) {
+	out := new(QuorumStatusList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetQuorumStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) EstimateFee(ctx context.Context, in *TxBody, opts ...grpc.CallOption) (*FeeEstimate, error) {
+	out := new(FeeEstimate)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBaseFee(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BaseFee, error) {
+	out := new(BaseFee)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetClusterStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClusterStatus, error) {
+	out := new(ClusterStatus)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetClusterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) TransferLeader(ctx context.Context, in *MemberAttr, opts ...grpc.CallOption) (*MemberAttr, error) {
+	out := new(MemberAttr)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/TransferLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) CreateClusterSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClusterSnapshot, error) {
+	out := new(ClusterSnapshot)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CreateClusterSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListEventPage(ctx context.Context, in *EventListParams, opts ...grpc.CallOption) (*EventPage, error) {
+	out := new(EventPage)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListEventPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ImportAccountKeystore(ctx context.Context, in *ImportFormat, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ImportAccountKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ExportAccountKeystore(ctx context.Context, in *KeystoreParams, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ExportAccountKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlockDetail(ctx context.Context, in *BlockDetailParams, opts ...grpc.CallOption) (*BlockDetail, error) {
+	out := new(BlockDetail)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBlockDetailStream(ctx context.Context, in *BlockStreamParams, opts ...grpc.CallOption) (AergoRPCService_ListBlockDetailStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[4], "/types.AergoRPCService/ListBlockDetailStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListBlockDetailStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListBlockDetailStreamClient interface {
+	Recv() (*BlockDetail, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListBlockDetailStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListBlockDetailStreamClient) Recv() (*BlockDetail, error) {
+	m := new(BlockDetail)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) ListEventFilterStream(ctx context.Context, in *EventStreamFilter, opts ...grpc.CallOption) (AergoRPCService_ListEventFilterStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[5], "/types.AergoRPCService/ListEventFilterStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListEventFilterStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListEventFilterStreamClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListEventFilterStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListEventFilterStreamClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) ListConsensusEventStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListConsensusEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[6], "/types.AergoRPCService/ListConsensusEventStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListConsensusEventStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListConsensusEventStreamClient interface {
+	Recv() (*ConsensusEvent, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListConsensusEventStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListConsensusEventStreamClient) Recv() (*ConsensusEvent, error) {
+	m := new(ConsensusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlocksBulk(ctx context.Context, in *BulkParams, opts ...grpc.CallOption) (*BlockBulk, error) {
+	out := new(BlockBulk)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlocksBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetTxsBulk(ctx context.Context, in *BulkParams, opts ...grpc.CallOption) (*TxBulk, error) {
+	out := new(TxBulk)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetTxsBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetLogLevels(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LogLevelList, error) {
+	out := new(LogLevelList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*LogLevelList, error) {
+	out := new(LogLevelList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SetProfiling(ctx context.Context, in *Profiling, opts ...grpc.CallOption) (*Profiling, error) {
+	out := new(Profiling)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SetProfiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) DumpProfile(ctx context.Context, in *ProfileDump, opts ...grpc.CallOption) (*ProfileDump, error) {
+	out := new(ProfileDump)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/DumpProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetAccountTxHistory(ctx context.Context, in *AccountTxHistoryParams, opts ...grpc.CallOption) (*AccountTxHistory, error) {
+	out := new(AccountTxHistory)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetAccountTxHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetContractABI(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractABI, error) {
+	out := new(ContractABI)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetContractABI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) EncodeCall(ctx context.Context, in *CallEncodeParams, opts ...grpc.CallOption) (*SingleBytes, error) {
+	out := new(SingleBytes)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/EncodeCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) CreateHDWallet(ctx context.Context, in *HDWalletParams, opts ...grpc.CallOption) (*HDWallet, error) {
+	out := new(HDWallet)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/CreateHDWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ExportMnemonic(ctx context.Context, in *Personal, opts ...grpc.CallOption) (*HDWallet, error) {
+	out := new(HDWallet)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ExportMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) DeriveAccounts(ctx context.Context, in *DeriveParams, opts ...grpc.CallOption) (*HDAccountList, error) {
+	out := new(HDAccountList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/DeriveAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListHDAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HDAccountList, error) {
+	out := new(HDAccountList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListHDAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetUnlockStatus(ctx context.Context, in *Account, opts ...grpc.CallOption) (*UnlockStatus, error) {
+	out := new(UnlockStatus)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetUnlockStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListSignAudits(ctx context.Context, in *SignAuditQuery, opts ...grpc.CallOption) (*SignAuditList, error) {
+	out := new(SignAuditList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListSignAudits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SetAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error) {
+	out := new(Alias)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) DeleteAlias(ctx context.Context, in *Alias, opts ...grpc.CallOption) (*Alias, error) {
+	out := new(Alias)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/DeleteAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListAliases(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AliasList, error) {
+	out := new(AliasList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListAliases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) AddWatchAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*WatchAccount, error) {
+	out := new(WatchAccount)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/AddWatchAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) RemoveWatchAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*WatchAccount, error) {
+	out := new(WatchAccount)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/RemoveWatchAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListWatchAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WatchAccountList, error) {
+	out := new(WatchAccountList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListWatchAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListWatchAccountTxStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (AergoRPCService_ListWatchAccountTxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[7], "/types.AergoRPCService/ListWatchAccountTxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListWatchAccountTxStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListWatchAccountTxStreamClient interface {
+	Recv() (*AccountTx, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListWatchAccountTxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListWatchAccountTxStreamClient) Recv() (*AccountTx, error) {
+	m := new(AccountTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aergoRPCServiceClient) SimulateSystemTx(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*SystemTxSimulation, error) {
+	out := new(SystemTxSimulation)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SimulateSystemTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetBlockTemplate(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlockTemplate, error) {
+	out := new(BlockTemplate)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetBlockTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SubmitBlock(ctx context.Context, in *Block, opts ...grpc.CallOption) (*BlockMetadata, error) {
+	out := new(BlockMetadata)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SubmitBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetContractStorageUsage(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ContractStorageUsage, error) {
+	out := new(ContractStorageUsage)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetContractStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetInternalOperations(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*InternalOperations, error) {
+	out := new(InternalOperations)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetInternalOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListIndexedEvents(ctx context.Context, in *EventListParams, opts ...grpc.CallOption) (*EventPage, error) {
+	out := new(EventPage)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListIndexedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) BanPeer(ctx context.Context, in *BanParams, opts ...grpc.CallOption) (*BannedPeerList, error) {
+	out := new(BannedPeerList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) UnbanPeer(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*BannedPeerList, error) {
+	out := new(BannedPeerList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListBannedPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BannedPeerList, error) {
+	out := new(BannedPeerList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AergoRPCServiceServer is the server API for AergoRPCService service.
+type AergoRPCServiceServer interface {
+	// Returns the current state of this node
+	NodeState(context.Context, *NodeReq) (*SingleBytes, error)
+	// Returns node metrics according to request
+	Metric(context.Context, *MetricsRequest) (*Metrics, error)
+	// Returns current blockchain status (best block's height and hash)
+	Blockchain(context.Context, *Empty) (*BlockchainStatus, error)
+	// Returns current blockchain's basic information
+	GetChainInfo(context.Context, *Empty) (*ChainInfo, error)
+	// Returns current chain statistics
+	ChainStat(context.Context, *Empty) (*ChainStats, error)
+	// Returns list of Blocks without body according to request
+	ListBlockHeaders(context.Context, *ListParams) (*BlockHeaderList, error)
+	// Returns list of block metadata (hash, header, and number of transactions) according to request
+	ListBlockMetadata(context.Context, *ListParams) (*BlockMetadataList, error)
+	// Returns a stream of new blocks as they get added to the blockchain
+	ListBlockStream(*Empty, AergoRPCService_ListBlockStreamServer) error
+	// Returns a stream of new block's metadata as they get added to the blockchain
+	ListBlockMetadataStream(*Empty, AergoRPCService_ListBlockMetadataStreamServer) error
+	// Return a single block incl. header and body, queried by hash or number
+	GetBlock(context.Context, *SingleBytes) (*Block, error)
 	// Return a single block's metdata (hash, header, and number of transactions), queried by hash or number
 	GetBlockMetadata(context.Context, *SingleBytes) (*BlockMetadata, error)
 	// Return a single block's body, queried by hash or number and list parameters
@@ -2883,6 +8217,8 @@ type AergoRPCServiceServer interface {
 	QueryContract(context.Context, *Query) (*SingleBytes, error)
 	// Query contract state
 	QueryContractState(context.Context, *StateQuery) (*StateQueryProof, error)
+	// Returns the total deposited to a receiver in the bridge with its proof, to claim it on the paired chain
+	GetBridgeProof(context.Context, *BridgeProofQuery) (*BridgeProof, error)
 	// Return list of peers of this node and their state
 	GetPeers(context.Context, *PeersParams) (*PeerList, error)
 	// Return result of vote
@@ -2903,737 +8239,1770 @@ type AergoRPCServiceServer interface {
 	GetConsensusInfo(context.Context, *Empty) (*ConsensusInfo, error)
 	// Add & remove member of raft cluster
 	ChangeMembership(context.Context, *MembershipChange) (*MembershipChangeReply, error)
+	// Returns accounts and storage keys changed between the states of two blocks
+	ListStateDiffStream(*StateDiffParams, AergoRPCService_ListStateDiffStreamServer) error
+	// Returns a page of key-value pairs stored by a contract
+	ListContractStorage(context.Context, *StorageListParams) (*StorageList, error)
+	// Return the unstaked amounts waiting for release of an account
+	GetPendingWithdrawals(context.Context, *AccountAddress) (*WithdrawalList, error)
+	// Return the staking, pending withdrawals, votes, delegation and reward of an account in the system contract
+	GetSystemAccountInfo(context.Context, *AccountAddress) (*SystemAccountInfo, error)
+	// Return the votes of all the BP candidates in descending order with the registered metadata
+	GetElectionTally(context.Context, *Empty) (*ElectionTally, error)
+	// Return the names on offer and their offers
+	ListNameOffers(context.Context, *Empty) (*NameInfoList, error)
+	// Returns the turnout of the votes on the governance parameters against the quorum
+	GetQuorumStatus(context.Context, *Empty) (*QuorumStatusList, error)
+	// Estimate the fee of a tx
+	EstimateFee(context.Context, *TxBody) (*FeeEstimate, error)
+	// Return the fee per byte charged to the txs of the next block
+	GetBaseFee(context.Context, *Empty) (*BaseFee, error)
+	// Returns the membership, the leader and the replication progress of the raft cluster
+	GetClusterStatus(context.Context, *Empty) (*ClusterStatus, error)
+	// Transfers the leadership of the raft cluster to the member of the given id
+	TransferLeader(context.Context, *MemberAttr) (*MemberAttr, error)
+	// Takes a snapshot of the raft log of the node and compacts the log
+	CreateClusterSnapshot(context.Context, *Empty) (*ClusterSnapshot, error)
+	// Returns a page of the events matching the filter
+	ListEventPage(context.Context, *EventListParams) (*EventPage, error)
+	// Import an account in keystore format
+	ImportAccountKeystore(context.Context, *ImportFormat) (*Account, error)
+	// Export an account in keystore format
+	ExportAccountKeystore(context.Context, *KeystoreParams) (*SingleBytes, error)
+	// Returns a block with the receipts, the consensus info and the fee totals as requested
+	GetBlockDetail(context.Context, *BlockDetailParams) (*BlockDetail, error)
+	// Starts a stream of new blocks with the receipts, the consensus info and the fee totals as requested
+	ListBlockDetailStream(*BlockStreamParams, AergoRPCService_ListBlockDetailStreamServer) error
+	// Starts a stream of the new events of any of the contracts and names of the filter
+	ListEventFilterStream(*EventStreamFilter, AergoRPCService_ListEventFilterStreamServer) error
+	// Starts a stream of the leader and membership changes of the raft cluster
+	ListConsensusEventStream(*Empty, AergoRPCService_ListConsensusEventStreamServer) error
+	// Returns the blocks of up to 100 hashes or numbers, with the status of each of them
+	GetBlocksBulk(context.Context, *BulkParams) (*BlockBulk, error)
+	// Returns the txs in the blocks of up to 100 hashes, with the status of each of them
+	GetTxsBulk(context.Context, *BulkParams) (*TxBulk, error)
+	// Returns the log levels of the modules of the node
+	GetLogLevels(context.Context, *Empty) (*LogLevelList, error)
+	// Changes the log level of a module, or of all the modules
+	SetLogLevel(context.Context, *LogLevel) (*LogLevelList, error)
+	// Starts or stops serving the pprof endpoints of the node
+	SetProfiling(context.Context, *Profiling) (*Profiling, error)
+	// Writes a profile of the node to a file in its data directory
+	DumpProfile(context.Context, *ProfileDump) (*ProfileDump, error)
+	// Returns a page of the txs sent or received by an account, including the system and governance txs
+	GetAccountTxHistory(context.Context, *AccountTxHistoryParams) (*AccountTxHistory, error)
+	// Returns the ABI of a contract with its resolved address and code hash
+	GetContractABI(context.Context, *SingleBytes) (*ContractABI, error)
+	// Returns the payload of a call to a contract checked against its ABI
+	EncodeCall(context.Context, *CallEncodeParams) (*SingleBytes, error)
+	// Create the hd wallet of the node and return its mnemonic
+	CreateHDWallet(context.Context, *HDWalletParams) (*HDWallet, error)
+	// Return the mnemonic of the hd wallet of the node
+	ExportMnemonic(context.Context, *Personal) (*HDWallet, error)
+	// Derive the accounts of the hd wallet of the node
+	DeriveAccounts(context.Context, *DeriveParams) (*HDAccountList, error)
+	// Return the accounts derived from the hd wallet of the node
+	ListHDAccounts(context.Context, *Empty) (*HDAccountList, error)
+	// Return the unlock session of the account
+	GetUnlockStatus(context.Context, *Account) (*UnlockStatus, error)
+	// Returns the records of the signing by the node
+	ListSignAudits(context.Context, *SignAuditQuery) (*SignAuditList, error)
+	// Labels an address in the address book
+	SetAlias(context.Context, *Alias) (*Alias, error)
+	// Removes a label from the address book
+	DeleteAlias(context.Context, *Alias) (*Alias, error)
+	// Returns the aliases of the address book
+	ListAliases(context.Context, *Empty) (*AliasList, error)
+	// Add an address to watch without its private key
+	AddWatchAccount(context.Context, *Account) (*WatchAccount, error)
+	// Stop watching an address
+	RemoveWatchAccount(context.Context, *Account) (*WatchAccount, error)
+	// Return the watched addresses with their nonces and balances
+	ListWatchAccounts(context.Context, *Empty) (*WatchAccountList, error)
+	// Stream the txs of the watched addresses in the new blocks
+	ListWatchAccountTxStream(*Empty, AergoRPCService_ListWatchAccountTxStreamServer) error
+	// Run a system tx against the best state without committing it
+	SimulateSystemTx(context.Context, *Tx) (*SystemTxSimulation, error)
+	// Return the candidate of the next block built by the node
+	GetBlockTemplate(context.Context, *Empty) (*BlockTemplate, error)
+	// Add a signed block built outside of the node, on the consensus allowing it
+	SubmitBlock(context.Context, *Block) (*BlockMetadata, error)
+	// Return the storage bytes used by a contract and the storage quota
+	GetContractStorageUsage(context.Context, *SingleBytes) (*ContractStorageUsage, error)
+	// Return the internal operations of a contract tx
+	GetInternalOperations(context.Context, *SingleBytes) (*InternalOperations, error)
+	// Returns a page of the events looked up by the values of their indexed arguments
+	ListIndexedEvents(context.Context, *EventListParams) (*EventPage, error)
+	// Ban a peer and disconnect it, returning the banned peers
+	BanPeer(context.Context, *BanParams) (*BannedPeerList, error)
+	// Lift the ban of a peer, returning the banned peers
+	UnbanPeer(context.Context, *SingleBytes) (*BannedPeerList, error)
+	// Return the peers banned by the operator
+	ListBannedPeers(context.Context, *Empty) (*BannedPeerList, error)
+}
+
+func RegisterAergoRPCServiceServer(s *grpc.Server, srv AergoRPCServiceServer) {
+	s.RegisterService(&_AergoRPCService_serviceDesc, srv)
+}
+
+func _AergoRPCService_NodeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).NodeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/NodeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).NodeState(ctx, req.(*NodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_Metric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).Metric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/Metric",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).Metric(ctx, req.(*MetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_Blockchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).Blockchain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/Blockchain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).Blockchain(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetChainInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetChainInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetChainInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ChainStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ChainStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ChainStat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ChainStat(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListBlockHeaders(ctx, req.(*ListParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListBlockMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListBlockMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListBlockMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListBlockMetadata(ctx, req.(*ListParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListBlockStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListBlockStream(m, &aergoRPCServiceListBlockStreamServer{stream})
+}
+
+type AergoRPCService_ListBlockStreamServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListBlockStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListBlockStreamServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_ListBlockMetadataStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListBlockMetadataStream(m, &aergoRPCServiceListBlockMetadataStreamServer{stream})
+}
+
+type AergoRPCService_ListBlockMetadataStreamServer interface {
+	Send(*BlockMetadata) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListBlockMetadataStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListBlockMetadataStreamServer) Send(m *BlockMetadata) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBlock(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBlockMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBlockMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBlockMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBlockMetadata(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBlockBody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockBodyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBlockBody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBlockBody",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBlockBody(ctx, req.(*BlockBodyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetTX(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBlockTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBlockTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBlockTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBlockTX(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetReceipt(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetABI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetABI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetABI(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_SendTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).SendTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/SendTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).SendTX(ctx, req.(*Tx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_SignTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).SignTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/SignTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).SignTX(ctx, req.(*Tx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_VerifyTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).VerifyTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/VerifyTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).VerifyTX(ctx, req.(*Tx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_CommitTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).CommitTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/CommitTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).CommitTX(ctx, req.(*TxList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetState(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetStateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAndRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetStateAndProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetStateAndProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetStateAndProof(ctx, req.(*AccountAndRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).CreateAccount(ctx, req.(*Personal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetAccounts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).LockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/LockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).LockAccount(ctx, req.(*Personal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/UnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).UnlockAccount(ctx, req.(*Personal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFormat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ImportAccount(ctx, req.(*ImportFormat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ExportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ExportAccount(ctx, req.(*Personal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_QueryContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).QueryContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/QueryContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).QueryContract(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_QueryContractState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).QueryContractState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/QueryContractState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).QueryContractState(ctx, req.(*StateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBridgeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeProofQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBridgeProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBridgeProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBridgeProof(ctx, req.(*BridgeProofQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetPeers(ctx, req.(*PeersParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetVotes(ctx, req.(*VoteParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetAccountVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetAccountVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetAccountVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetAccountVotes(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAergoRPCServiceServer(s *grpc.Server, srv AergoRPCServiceServer) {
-	s.RegisterService(&_AergoRPCService_serviceDesc, srv)
+func _AergoRPCService_GetStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetStaking(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetNameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Name)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetNameInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetNameInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetNameInfo(ctx, req.(*Name))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FilterInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListEventStream(m, &aergoRPCServiceListEventStreamServer{stream})
+}
+
+type AergoRPCService_ListEventStreamServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListEventStreamServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListEvents(ctx, req.(*FilterInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetServerInfo(ctx, req.(*KeyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetConsensusInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetConsensusInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetConsensusInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetConsensusInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembershipChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ChangeMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ChangeMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ChangeMembership(ctx, req.(*MembershipChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListStateDiffStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StateDiffParams)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListStateDiffStream(m, &aergoRPCServiceListStateDiffStreamServer{stream})
+}
+
+type AergoRPCService_ListStateDiffStreamServer interface {
+	Send(*AccountDiff) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListStateDiffStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListStateDiffStreamServer) Send(m *AccountDiff) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_ListContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageListParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListContractStorage(ctx, req.(*StorageListParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetPendingWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetPendingWithdrawals(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetSystemAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetSystemAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetSystemAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetSystemAccountInfo(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetElectionTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetElectionTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetElectionTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetElectionTally(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListNameOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListNameOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListNameOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListNameOffers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetQuorumStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetQuorumStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetQuorumStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetQuorumStatus(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxBody)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).EstimateFee(ctx, req.(*TxBody))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetBaseFee(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_NodeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeReq)
+func _AergoRPCService_GetClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).NodeState(ctx, in)
+		return srv.(AergoRPCServiceServer).GetClusterStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/NodeState",
+		FullMethod: "/types.AergoRPCService/GetClusterStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).NodeState(ctx, req.(*NodeReq))
+		return srv.(AergoRPCServiceServer).GetClusterStatus(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_Metric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetricsRequest)
+func _AergoRPCService_TransferLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberAttr)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).Metric(ctx, in)
+		return srv.(AergoRPCServiceServer).TransferLeader(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/Metric",
+		FullMethod: "/types.AergoRPCService/TransferLeader",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).Metric(ctx, req.(*MetricsRequest))
+		return srv.(AergoRPCServiceServer).TransferLeader(ctx, req.(*MemberAttr))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_Blockchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AergoRPCService_CreateClusterSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).Blockchain(ctx, in)
+		return srv.(AergoRPCServiceServer).CreateClusterSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/Blockchain",
+		FullMethod: "/types.AergoRPCService/CreateClusterSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).Blockchain(ctx, req.(*Empty))
+		return srv.(AergoRPCServiceServer).CreateClusterSnapshot(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _AergoRPCService_ListEventPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventListParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetChainInfo(ctx, in)
+		return srv.(AergoRPCServiceServer).ListEventPage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetChainInfo",
+		FullMethod: "/types.AergoRPCService/ListEventPage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetChainInfo(ctx, req.(*Empty))
+		return srv.(AergoRPCServiceServer).ListEventPage(ctx, req.(*EventListParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ChainStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _AergoRPCService_ImportAccountKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFormat)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ChainStat(ctx, in)
+		return srv.(AergoRPCServiceServer).ImportAccountKeystore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ChainStat",
+		FullMethod: "/types.AergoRPCService/ImportAccountKeystore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ChainStat(ctx, req.(*Empty))
+		return srv.(AergoRPCServiceServer).ImportAccountKeystore(ctx, req.(*ImportFormat))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ListBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListParams)
+func _AergoRPCService_ExportAccountKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeystoreParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ListBlockHeaders(ctx, in)
+		return srv.(AergoRPCServiceServer).ExportAccountKeystore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ListBlockHeaders",
+		FullMethod: "/types.AergoRPCService/ExportAccountKeystore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ListBlockHeaders(ctx, req.(*ListParams))
+		return srv.(AergoRPCServiceServer).ExportAccountKeystore(ctx, req.(*KeystoreParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ListBlockMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListParams)
+func _AergoRPCService_GetBlockDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDetailParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ListBlockMetadata(ctx, in)
+		return srv.(AergoRPCServiceServer).GetBlockDetail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ListBlockMetadata",
+		FullMethod: "/types.AergoRPCService/GetBlockDetail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ListBlockMetadata(ctx, req.(*ListParams))
+		return srv.(AergoRPCServiceServer).GetBlockDetail(ctx, req.(*BlockDetailParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ListBlockStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
+func _AergoRPCService_ListBlockDetailStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockStreamParams)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(AergoRPCServiceServer).ListBlockStream(m, &aergoRPCServiceListBlockStreamServer{stream})
+	return srv.(AergoRPCServiceServer).ListBlockDetailStream(m, &aergoRPCServiceListBlockDetailStreamServer{stream})
 }
 
-type AergoRPCService_ListBlockStreamServer interface {
-	Send(*Block) error
+type AergoRPCService_ListBlockDetailStreamServer interface {
+	Send(*BlockDetail) error
 	grpc.ServerStream
 }
 
-type aergoRPCServiceListBlockStreamServer struct {
+type aergoRPCServiceListBlockDetailStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *aergoRPCServiceListBlockStreamServer) Send(m *Block) error {
+func (x *aergoRPCServiceListBlockDetailStreamServer) Send(m *BlockDetail) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _AergoRPCService_ListBlockMetadataStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+func _AergoRPCService_ListEventFilterStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventStreamFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListEventFilterStream(m, &aergoRPCServiceListEventFilterStreamServer{stream})
+}
+
+type AergoRPCService_ListEventFilterStreamServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListEventFilterStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListEventFilterStreamServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_ListConsensusEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(AergoRPCServiceServer).ListBlockMetadataStream(m, &aergoRPCServiceListBlockMetadataStreamServer{stream})
+	return srv.(AergoRPCServiceServer).ListConsensusEventStream(m, &aergoRPCServiceListConsensusEventStreamServer{stream})
 }
 
-type AergoRPCService_ListBlockMetadataStreamServer interface {
-	Send(*BlockMetadata) error
+type AergoRPCService_ListConsensusEventStreamServer interface {
+	Send(*ConsensusEvent) error
 	grpc.ServerStream
 }
 
-type aergoRPCServiceListBlockMetadataStreamServer struct {
+type aergoRPCServiceListConsensusEventStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *aergoRPCServiceListBlockMetadataStreamServer) Send(m *BlockMetadata) error {
+func (x *aergoRPCServiceListConsensusEventStreamServer) Send(m *ConsensusEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _AergoRPCService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_GetBlocksBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetBlock(ctx, in)
+		return srv.(AergoRPCServiceServer).GetBlocksBulk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetBlock",
+		FullMethod: "/types.AergoRPCService/GetBlocksBulk",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetBlock(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).GetBlocksBulk(ctx, req.(*BulkParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetBlockMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_GetTxsBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetBlockMetadata(ctx, in)
+		return srv.(AergoRPCServiceServer).GetTxsBulk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetBlockMetadata",
+		FullMethod: "/types.AergoRPCService/GetTxsBulk",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetBlockMetadata(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).GetTxsBulk(ctx, req.(*BulkParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetBlockBody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockBodyParams)
+func _AergoRPCService_GetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetBlockBody(ctx, in)
+		return srv.(AergoRPCServiceServer).GetLogLevels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetBlockBody",
+		FullMethod: "/types.AergoRPCService/GetLogLevels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetBlockBody(ctx, req.(*BlockBodyParams))
+		return srv.(AergoRPCServiceServer).GetLogLevels(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevel)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetTX(ctx, in)
+		return srv.(AergoRPCServiceServer).SetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetTX",
+		FullMethod: "/types.AergoRPCService/SetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetTX(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).SetLogLevel(ctx, req.(*LogLevel))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetBlockTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_SetProfiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Profiling)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetBlockTX(ctx, in)
+		return srv.(AergoRPCServiceServer).SetProfiling(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetBlockTX",
+		FullMethod: "/types.AergoRPCService/SetProfiling",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetBlockTX(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).SetProfiling(ctx, req.(*Profiling))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_DumpProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileDump)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetReceipt(ctx, in)
+		return srv.(AergoRPCServiceServer).DumpProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetReceipt",
+		FullMethod: "/types.AergoRPCService/DumpProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetReceipt(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).DumpProfile(ctx, req.(*ProfileDump))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_GetAccountTxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountTxHistoryParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetABI(ctx, in)
+		return srv.(AergoRPCServiceServer).GetAccountTxHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetABI",
+		FullMethod: "/types.AergoRPCService/GetAccountTxHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetABI(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).GetAccountTxHistory(ctx, req.(*AccountTxHistoryParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_SendTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tx)
+func _AergoRPCService_GetContractABI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).SendTX(ctx, in)
+		return srv.(AergoRPCServiceServer).GetContractABI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/SendTX",
+		FullMethod: "/types.AergoRPCService/GetContractABI",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).SendTX(ctx, req.(*Tx))
+		return srv.(AergoRPCServiceServer).GetContractABI(ctx, req.(*SingleBytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_SignTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tx)
+func _AergoRPCService_EncodeCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallEncodeParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).SignTX(ctx, in)
+		return srv.(AergoRPCServiceServer).EncodeCall(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/SignTX",
+		FullMethod: "/types.AergoRPCService/EncodeCall",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).SignTX(ctx, req.(*Tx))
+		return srv.(AergoRPCServiceServer).EncodeCall(ctx, req.(*CallEncodeParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_VerifyTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tx)
+func _AergoRPCService_CreateHDWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDWalletParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).VerifyTX(ctx, in)
+		return srv.(AergoRPCServiceServer).CreateHDWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/VerifyTX",
+		FullMethod: "/types.AergoRPCService/CreateHDWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).VerifyTX(ctx, req.(*Tx))
+		return srv.(AergoRPCServiceServer).CreateHDWallet(ctx, req.(*HDWalletParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_CommitTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxList)
+func _AergoRPCService_ExportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Personal)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).CommitTX(ctx, in)
+		return srv.(AergoRPCServiceServer).ExportMnemonic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/CommitTX",
+		FullMethod: "/types.AergoRPCService/ExportMnemonic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).CommitTX(ctx, req.(*TxList))
+		return srv.(AergoRPCServiceServer).ExportMnemonic(ctx, req.(*Personal))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleBytes)
+func _AergoRPCService_DeriveAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetState(ctx, in)
+		return srv.(AergoRPCServiceServer).DeriveAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetState",
+		FullMethod: "/types.AergoRPCService/DeriveAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetState(ctx, req.(*SingleBytes))
+		return srv.(AergoRPCServiceServer).DeriveAccounts(ctx, req.(*DeriveParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetStateAndProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountAndRoot)
+func _AergoRPCService_ListHDAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetStateAndProof(ctx, in)
+		return srv.(AergoRPCServiceServer).ListHDAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetStateAndProof",
+		FullMethod: "/types.AergoRPCService/ListHDAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetStateAndProof(ctx, req.(*AccountAndRoot))
+		return srv.(AergoRPCServiceServer).ListHDAccounts(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Personal)
+func _AergoRPCService_GetUnlockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).CreateAccount(ctx, in)
+		return srv.(AergoRPCServiceServer).GetUnlockStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/CreateAccount",
+		FullMethod: "/types.AergoRPCService/GetUnlockStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).CreateAccount(ctx, req.(*Personal))
+		return srv.(AergoRPCServiceServer).GetUnlockStatus(ctx, req.(*Account))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _AergoRPCService_ListSignAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignAuditQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetAccounts(ctx, in)
+		return srv.(AergoRPCServiceServer).ListSignAudits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetAccounts",
+		FullMethod: "/types.AergoRPCService/ListSignAudits",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetAccounts(ctx, req.(*Empty))
+		return srv.(AergoRPCServiceServer).ListSignAudits(ctx, req.(*SignAuditQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Personal)
+func _AergoRPCService_SetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Alias)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).LockAccount(ctx, in)
+		return srv.(AergoRPCServiceServer).SetAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/LockAccount",
+		FullMethod: "/types.AergoRPCService/SetAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).LockAccount(ctx, req.(*Personal))
+		return srv.(AergoRPCServiceServer).SetAlias(ctx, req.(*Alias))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Personal)
+func _AergoRPCService_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Alias)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).UnlockAccount(ctx, in)
+		return srv.(AergoRPCServiceServer).DeleteAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/UnlockAccount",
+		FullMethod: "/types.AergoRPCService/DeleteAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).UnlockAccount(ctx, req.(*Personal))
+		return srv.(AergoRPCServiceServer).DeleteAlias(ctx, req.(*Alias))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportFormat)
+func _AergoRPCService_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ImportAccount(ctx, in)
+		return srv.(AergoRPCServiceServer).ListAliases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ImportAccount",
+		FullMethod: "/types.AergoRPCService/ListAliases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ImportAccount(ctx, req.(*ImportFormat))
+		return srv.(AergoRPCServiceServer).ListAliases(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Personal)
+func _AergoRPCService_AddWatchAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ExportAccount(ctx, in)
+		return srv.(AergoRPCServiceServer).AddWatchAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ExportAccount",
+		FullMethod: "/types.AergoRPCService/AddWatchAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ExportAccount(ctx, req.(*Personal))
+		return srv.(AergoRPCServiceServer).AddWatchAccount(ctx, req.(*Account))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_QueryContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Query)
+func _AergoRPCService_RemoveWatchAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).QueryContract(ctx, in)
+		return srv.(AergoRPCServiceServer).RemoveWatchAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/QueryContract",
+		FullMethod: "/types.AergoRPCService/RemoveWatchAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).QueryContract(ctx, req.(*Query))
+		return srv.(AergoRPCServiceServer).RemoveWatchAccount(ctx, req.(*Account))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_QueryContractState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StateQuery)
+func _AergoRPCService_ListWatchAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).QueryContractState(ctx, in)
+		return srv.(AergoRPCServiceServer).ListWatchAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/QueryContractState",
+		FullMethod: "/types.AergoRPCService/ListWatchAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).QueryContractState(ctx, req.(*StateQuery))
+		return srv.(AergoRPCServiceServer).ListWatchAccounts(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PeersParams)
+func _AergoRPCService_ListWatchAccountTxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListWatchAccountTxStream(m, &aergoRPCServiceListWatchAccountTxStreamServer{stream})
+}
+
+type AergoRPCService_ListWatchAccountTxStreamServer interface {
+	Send(*AccountTx) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListWatchAccountTxStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListWatchAccountTxStreamServer) Send(m *AccountTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AergoRPCService_SimulateSystemTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tx)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetPeers(ctx, in)
+		return srv.(AergoRPCServiceServer).SimulateSystemTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetPeers",
+		FullMethod: "/types.AergoRPCService/SimulateSystemTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetPeers(ctx, req.(*PeersParams))
+		return srv.(AergoRPCServiceServer).SimulateSystemTx(ctx, req.(*Tx))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VoteParams)
+func _AergoRPCService_GetBlockTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetVotes(ctx, in)
+		return srv.(AergoRPCServiceServer).GetBlockTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetVotes",
+		FullMethod: "/types.AergoRPCService/GetBlockTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetVotes(ctx, req.(*VoteParams))
+		return srv.(AergoRPCServiceServer).GetBlockTemplate(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetAccountVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountAddress)
+func _AergoRPCService_SubmitBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Block)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetAccountVotes(ctx, in)
+		return srv.(AergoRPCServiceServer).SubmitBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetAccountVotes",
+		FullMethod: "/types.AergoRPCService/SubmitBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetAccountVotes(ctx, req.(*AccountAddress))
+		return srv.(AergoRPCServiceServer).SubmitBlock(ctx, req.(*Block))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountAddress)
+func _AergoRPCService_GetContractStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetStaking(ctx, in)
+		return srv.(AergoRPCServiceServer).GetContractStorageUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetStaking",
+		FullMethod: "/types.AergoRPCService/GetContractStorageUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetStaking(ctx, req.(*AccountAddress))
+		return srv.(AergoRPCServiceServer).GetContractStorageUsage(ctx, req.(*SingleBytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetNameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Name)
+func _AergoRPCService_GetInternalOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetNameInfo(ctx, in)
+		return srv.(AergoRPCServiceServer).GetInternalOperations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetNameInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetNameInfo(ctx, req.(*Name))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _AergoRPCService_ListEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FilterInfo)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(AergoRPCServiceServer).ListEventStream(m, &aergoRPCServiceListEventStreamServer{stream})
-}
-
-type AergoRPCService_ListEventStreamServer interface {
-	Send(*Event) error
-	grpc.ServerStream
-}
-
-type aergoRPCServiceListEventStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *aergoRPCServiceListEventStreamServer) Send(m *Event) error {
-	return x.ServerStream.SendMsg(m)
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetInternalOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetInternalOperations(ctx, req.(*SingleBytes))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilterInfo)
+func _AergoRPCService_ListIndexedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventListParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ListEvents(ctx, in)
+		return srv.(AergoRPCServiceServer).ListIndexedEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ListEvents",
+		FullMethod: "/types.AergoRPCService/ListIndexedEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ListEvents(ctx, req.(*FilterInfo))
+		return srv.(AergoRPCServiceServer).ListIndexedEvents(ctx, req.(*EventListParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyParams)
+func _AergoRPCService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetServerInfo(ctx, in)
+		return srv.(AergoRPCServiceServer).BanPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetServerInfo",
+		FullMethod: "/types.AergoRPCService/BanPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetServerInfo(ctx, req.(*KeyParams))
+		return srv.(AergoRPCServiceServer).BanPeer(ctx, req.(*BanParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_GetConsensusInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _AergoRPCService_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleBytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).GetConsensusInfo(ctx, in)
+		return srv.(AergoRPCServiceServer).UnbanPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/GetConsensusInfo",
+		FullMethod: "/types.AergoRPCService/UnbanPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).GetConsensusInfo(ctx, req.(*Empty))
+		return srv.(AergoRPCServiceServer).UnbanPeer(ctx, req.(*SingleBytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AergoRPCService_ChangeMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MembershipChange)
+func _AergoRPCService_ListBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AergoRPCServiceServer).ChangeMembership(ctx, in)
+		return srv.(AergoRPCServiceServer).ListBannedPeers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/types.AergoRPCService/ChangeMembership",
+		FullMethod: "/types.AergoRPCService/ListBannedPeers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AergoRPCServiceServer).ChangeMembership(ctx, req.(*MembershipChange))
+		return srv.(AergoRPCServiceServer).ListBannedPeers(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3754,6 +10123,10 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryContractState",
 			Handler:    _AergoRPCService_QueryContractState_Handler,
 		},
+		{
+			MethodName: "GetBridgeProof",
+			Handler:    _AergoRPCService_GetBridgeProof_Handler,
+		},
 		{
 			MethodName: "GetPeers",
 			Handler:    _AergoRPCService_GetPeers_Handler,
@@ -3790,6 +10163,186 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeMembership",
 			Handler:    _AergoRPCService_ChangeMembership_Handler,
 		},
+		{
+			MethodName: "ListContractStorage",
+			Handler:    _AergoRPCService_ListContractStorage_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _AergoRPCService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "GetSystemAccountInfo",
+			Handler:    _AergoRPCService_GetSystemAccountInfo_Handler,
+		},
+		{
+			MethodName: "GetElectionTally",
+			Handler:    _AergoRPCService_GetElectionTally_Handler,
+		},
+		{
+			MethodName: "ListNameOffers",
+			Handler:    _AergoRPCService_ListNameOffers_Handler,
+		},
+		{
+			MethodName: "GetQuorumStatus",
+			Handler:    _AergoRPCService_GetQuorumStatus_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _AergoRPCService_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetBaseFee",
+			Handler:    _AergoRPCService_GetBaseFee_Handler,
+		},
+		{
+			MethodName: "GetClusterStatus",
+			Handler:    _AergoRPCService_GetClusterStatus_Handler,
+		},
+		{
+			MethodName: "TransferLeader",
+			Handler:    _AergoRPCService_TransferLeader_Handler,
+		},
+		{
+			MethodName: "CreateClusterSnapshot",
+			Handler:    _AergoRPCService_CreateClusterSnapshot_Handler,
+		},
+		{
+			MethodName: "ListEventPage",
+			Handler:    _AergoRPCService_ListEventPage_Handler,
+		},
+		{
+			MethodName: "ImportAccountKeystore",
+			Handler:    _AergoRPCService_ImportAccountKeystore_Handler,
+		},
+		{
+			MethodName: "ExportAccountKeystore",
+			Handler:    _AergoRPCService_ExportAccountKeystore_Handler,
+		},
+		{
+			MethodName: "GetBlockDetail",
+			Handler:    _AergoRPCService_GetBlockDetail_Handler,
+		},
+		{
+			MethodName: "GetBlocksBulk",
+			Handler:    _AergoRPCService_GetBlocksBulk_Handler,
+		},
+		{
+			MethodName: "GetTxsBulk",
+			Handler:    _AergoRPCService_GetTxsBulk_Handler,
+		},
+		{
+			MethodName: "GetLogLevels",
+			Handler:    _AergoRPCService_GetLogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AergoRPCService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetProfiling",
+			Handler:    _AergoRPCService_SetProfiling_Handler,
+		},
+		{
+			MethodName: "DumpProfile",
+			Handler:    _AergoRPCService_DumpProfile_Handler,
+		},
+		{
+			MethodName: "GetAccountTxHistory",
+			Handler:    _AergoRPCService_GetAccountTxHistory_Handler,
+		},
+		{
+			MethodName: "GetContractABI",
+			Handler:    _AergoRPCService_GetContractABI_Handler,
+		},
+		{
+			MethodName: "EncodeCall",
+			Handler:    _AergoRPCService_EncodeCall_Handler,
+		},
+		{
+			MethodName: "CreateHDWallet",
+			Handler:    _AergoRPCService_CreateHDWallet_Handler,
+		},
+		{
+			MethodName: "ExportMnemonic",
+			Handler:    _AergoRPCService_ExportMnemonic_Handler,
+		},
+		{
+			MethodName: "DeriveAccounts",
+			Handler:    _AergoRPCService_DeriveAccounts_Handler,
+		},
+		{
+			MethodName: "ListHDAccounts",
+			Handler:    _AergoRPCService_ListHDAccounts_Handler,
+		},
+		{
+			MethodName: "GetUnlockStatus",
+			Handler:    _AergoRPCService_GetUnlockStatus_Handler,
+		},
+		{
+			MethodName: "ListSignAudits",
+			Handler:    _AergoRPCService_ListSignAudits_Handler,
+		},
+		{
+			MethodName: "SetAlias",
+			Handler:    _AergoRPCService_SetAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _AergoRPCService_DeleteAlias_Handler,
+		},
+		{
+			MethodName: "ListAliases",
+			Handler:    _AergoRPCService_ListAliases_Handler,
+		},
+		{
+			MethodName: "AddWatchAccount",
+			Handler:    _AergoRPCService_AddWatchAccount_Handler,
+		},
+		{
+			MethodName: "RemoveWatchAccount",
+			Handler:    _AergoRPCService_RemoveWatchAccount_Handler,
+		},
+		{
+			MethodName: "ListWatchAccounts",
+			Handler:    _AergoRPCService_ListWatchAccounts_Handler,
+		},
+		{
+			MethodName: "SimulateSystemTx",
+			Handler:    _AergoRPCService_SimulateSystemTx_Handler,
+		},
+		{
+			MethodName: "GetBlockTemplate",
+			Handler:    _AergoRPCService_GetBlockTemplate_Handler,
+		},
+		{
+			MethodName: "SubmitBlock",
+			Handler:    _AergoRPCService_SubmitBlock_Handler,
+		},
+		{
+			MethodName: "GetContractStorageUsage",
+			Handler:    _AergoRPCService_GetContractStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetInternalOperations",
+			Handler:    _AergoRPCService_GetInternalOperations_Handler,
+		},
+		{
+			MethodName: "ListIndexedEvents",
+			Handler:    _AergoRPCService_ListIndexedEvents_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _AergoRPCService_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _AergoRPCService_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListBannedPeers",
+			Handler:    _AergoRPCService_ListBannedPeers_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3807,6 +10360,233 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AergoRPCService_ListEventStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListStateDiffStream",
+			Handler:       _AergoRPCService_ListStateDiffStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBlockDetailStream",
+			Handler:       _AergoRPCService_ListBlockDetailStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListEventFilterStream",
+			Handler:       _AergoRPCService_ListEventFilterStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListConsensusEventStream",
+			Handler:       _AergoRPCService_ListConsensusEventStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListWatchAccountTxStream",
+			Handler:       _AergoRPCService_ListWatchAccountTxStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
+
+// BlockSignerServiceClient is the client API for BlockSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockSignerServiceClient interface {
+	// Returns the public key of the signer
+	GetPubKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SignerKey, error)
+	// Signs the header of a block
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*BlockSignature, error)
+}
+
+type blockSignerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockSignerServiceClient(cc *grpc.ClientConn) BlockSignerServiceClient {
+	return &blockSignerServiceClient{cc}
+}
+
+func (c *blockSignerServiceClient) GetPubKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SignerKey, error) {
+	out := new(SignerKey)
+	err := c.cc.Invoke(ctx, "/types.BlockSignerService/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockSignerServiceClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*BlockSignature, error) {
+	out := new(BlockSignature)
+	err := c.cc.Invoke(ctx, "/types.BlockSignerService/SignBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockSignerServiceServer is the server API for BlockSignerService service.
+type BlockSignerServiceServer interface {
+	// Returns the public key of the signer
+	GetPubKey(context.Context, *Empty) (*SignerKey, error)
+	// Signs the header of a block
+	SignBlock(context.Context, *SignBlockRequest) (*BlockSignature, error)
+}
+
+func RegisterBlockSignerServiceServer(s *grpc.Server, srv BlockSignerServiceServer) {
+	s.RegisterService(&_BlockSignerService_serviceDesc, srv)
+}
+
+func _BlockSignerService_GetPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).GetPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.BlockSignerService/GetPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).GetPubKey(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockSignerService_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).SignBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.BlockSignerService/SignBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockSignerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.BlockSignerService",
+	HandlerType: (*BlockSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubKey",
+			Handler:    _BlockSignerService_GetPubKey_Handler,
+		},
+		{
+			MethodName: "SignBlock",
+			Handler:    _BlockSignerService_SignBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// ThresholdSignerServiceClient is the client API for ThresholdSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ThresholdSignerServiceClient interface {
+	// Returns the key share of the signer
+	GetKeyShare(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KeyShareInfo, error)
+	// Signs the header of a block with a presignature of the share
+	SignShare(ctx context.Context, in *SignShareRequest, opts ...grpc.CallOption) (*SignatureShare, error)
+}
+
+type thresholdSignerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewThresholdSignerServiceClient(cc *grpc.ClientConn) ThresholdSignerServiceClient {
+	return &thresholdSignerServiceClient{cc}
+}
+
+func (c *thresholdSignerServiceClient) GetKeyShare(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*KeyShareInfo, error) {
+	out := new(KeyShareInfo)
+	err := c.cc.Invoke(ctx, "/types.ThresholdSignerService/GetKeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thresholdSignerServiceClient) SignShare(ctx context.Context, in *SignShareRequest, opts ...grpc.CallOption) (*SignatureShare, error) {
+	out := new(SignatureShare)
+	err := c.cc.Invoke(ctx, "/types.ThresholdSignerService/SignShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThresholdSignerServiceServer is the server API for ThresholdSignerService service.
+type ThresholdSignerServiceServer interface {
+	// Returns the key share of the signer
+	GetKeyShare(context.Context, *Empty) (*KeyShareInfo, error)
+	// Signs the header of a block with a presignature of the share
+	SignShare(context.Context, *SignShareRequest) (*SignatureShare, error)
+}
+
+func RegisterThresholdSignerServiceServer(s *grpc.Server, srv ThresholdSignerServiceServer) {
+	s.RegisterService(&_ThresholdSignerService_serviceDesc, srv)
+}
+
+func _ThresholdSignerService_GetKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThresholdSignerServiceServer).GetKeyShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ThresholdSignerService/GetKeyShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThresholdSignerServiceServer).GetKeyShare(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThresholdSignerService_SignShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThresholdSignerServiceServer).SignShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.ThresholdSignerService/SignShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThresholdSignerServiceServer).SignShare(ctx, req.(*SignShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ThresholdSignerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.ThresholdSignerService",
+	HandlerType: (*ThresholdSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetKeyShare",
+			Handler:    _ThresholdSignerService_GetKeyShare_Handler,
+		},
+		{
+			MethodName: "SignShare",
+			Handler:    _ThresholdSignerService_SignShare_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/aergoio/aergo/fee"
@@ -17,9 +18,28 @@ import (
 
 const Stake = "v1stake"
 const Unstake = "v1unstake"
+const Delegate = "v1delegate"
+const Undelegate = "v1undelegate"
+const Slash = "v1slash"
+const SlashDoubleSign = "doublesign"
+const SlashDowntime = "downtime"
+const Propose = "v1propose"
+const VoteProposal = "v1voteProposal"
+const ClaimReward = "v1claimReward"
+const Claim = "v1claim"
+const CreateMultisig = "v1createMultisig"
+const MultisigCall = "v1multisigCall"
+const Batch = "v1batch"
+const RegisterBP = "v1registerBP"
+const UnregisterBP = "v1unregisterBP"
+const SetAuthContract = "v1setAuthContract"
 const SetContractOwner = "v1setOwner"
 const NameCreate = "v1createName"
 const NameUpdate = "v1updateName"
+const NameRenew = "v1renewName"
+const NameOffer = "v1offerName"
+const NameCancelOffer = "v1cancelNameOffer"
+const NameAccept = "v1acceptName"
 
 const TxMaxSize = 200 * 1024
 
@@ -35,11 +55,16 @@ type Transaction interface {
 	SetVerifedAccount(account Address) bool
 	RemoveVerifedAccount() bool
 	GetMaxFee() *big.Int
+	IsFreeTx() bool
+	SetFreeTx(free bool)
 }
 
 type transaction struct {
 	Tx              *Tx
 	VerifiedAccount Address
+	// FreeTx is set when the fee of the tx is waived by the free-tx quota of
+	// the sender.
+	FreeTx bool
 }
 
 var _ Transaction = (*transaction)(nil)
@@ -105,12 +130,25 @@ func (tx *transaction) Validate(chainidhash []byte) error {
 		return ErrTxInvalidRecipient
 	}
 
+	if expiry := tx.GetBody().GetExpiry(); expiry != 0 && expiry < ExpiryTimeThreshold &&
+		tx.GetBody().GetNotBefore() > expiry {
+		return ErrTxInvalidSchedule
+	}
+
 	switch tx.GetBody().Type {
-	case TxType_NORMAL:
+	case TxType_NORMAL, TxType_FEEDELEGATION:
 		if tx.GetBody().GetRecipient() == nil && len(tx.GetBody().GetPayload()) == 0 {
 			//contract deploy
 			return ErrTxInvalidRecipient
 		}
+		// the fee of a deploy can't be delegated to the contract being deployed
+		if tx.GetBody().Type == TxType_FEEDELEGATION && tx.GetBody().GetRecipient() == nil {
+			return ErrTxInvalidRecipient
+		}
+		if fee.IsGasFeeEnabled() &&
+			!fee.ValidateGasLimit(tx.GetBody().GetGasLimit(), len(tx.GetBody().GetPayload())) {
+			return ErrTxInvalidGasLimit
+		}
 	case TxType_GOVERNANCE:
 		if len(tx.GetBody().GetPayload()) <= 0 {
 			return ErrTxFormatInvalid
@@ -120,6 +158,9 @@ func (tx *transaction) Validate(chainidhash []byte) error {
 			return ValidateSystemTx(tx.GetBody())
 		case AergoName:
 			return validateNameTx(tx.GetBody())
+		case AergoBridge:
+			_, err := ParseBridgeTx(tx.GetBody())
+			return err
 		default:
 			return ErrTxInvalidRecipient
 		}
@@ -136,7 +177,80 @@ func ValidateSystemTx(tx *TxBody) error {
 	}
 	switch ci.Name {
 	case Stake,
-		Unstake:
+		Unstake,
+		Undelegate,
+		ClaimReward,
+		Claim:
+	case Delegate:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		candidate, err := base58.Decode(encoded)
+		if err != nil {
+			return ErrTxInvalidPayload
+		}
+		if _, err := peer.IDFromBytes(candidate); err != nil {
+			return ErrTxInvalidPayload
+		}
+	case Slash:
+		if err := validateSlashArgs(&ci); err != nil {
+			return err
+		}
+	case Propose:
+		if err := validateProposeArgs(&ci); err != nil {
+			return err
+		}
+	case VoteProposal:
+		if err := validateVoteProposalArgs(&ci); err != nil {
+			return err
+		}
+	case CreateMultisig:
+		if err := validateCreateMultisigArgs(&ci); err != nil {
+			return err
+		}
+	case MultisigCall:
+		if err := validateMultisigCallArgs(tx, &ci); err != nil {
+			return err
+		}
+	case RegisterBP:
+		if err := validateRegisterBPArgs(&ci); err != nil {
+			return err
+		}
+	case UnregisterBP:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok || !isPeerID(encoded) {
+			return ErrTxInvalidPayload
+		}
+	case SetAuthContract:
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		encoded, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if encoded != "" {
+			if address, err := DecodeAddress(encoded); err != nil || len(address) != AddressLength {
+				return ErrTxInvalidPayload
+			}
+		}
+	case Batch:
+		ops, err := BatchOps(tx, &ci)
+		if err != nil {
+			return err
+		}
+		for _, op := range ops {
+			if err := ValidateSystemTx(op); err != nil {
+				return err
+			}
+		}
 	case VoteBP:
 		unique := map[string]int{}
 		for i, v := range ci.Args {
@@ -160,24 +274,261 @@ func ValidateSystemTx(tx *TxBody) error {
 				return ErrTxInvalidPayload
 			}
 		}
-		/* TODO: will be changed
-		case VoteNumBP,
-			VoteGasPrice,
-			VoteNamePrice,
-			VoteMinStaking:
-			for i, v := range ci.Args {
-				if i > 1 {
-					return ErrTxInvalidPayload
-				}
-				vstr, ok := v.(string)
-				if !ok {
-					return ErrTxInvalidPayload
-				}
-				if _, ok := new(big.Int).SetString(vstr, 10); !ok {
-					return ErrTxInvalidPayload
-				}
+	default:
+		if !IsParamVote(ci.Name) {
+			return ErrTxInvalidPayload
+		}
+		// the range of the value is checked by the system contract
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		vstr, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if value, ok := new(big.Int).SetString(vstr, 10); !ok || value.Sign() < 0 {
+			return ErrTxInvalidPayload
+		}
+	}
+	return nil
+}
+
+// validateCreateMultisigArgs checks the arguments of a multisig account: the
+// number of the signatures required and the distinct addresses of the signers.
+func validateCreateMultisigArgs(ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 || len(args) > 1+MaxMultisigSigners {
+		return ErrTxInvalidPayload
+	}
+	threshold, err := strconv.ParseUint(args[0], 10, 8)
+	if err != nil || threshold == 0 || int(threshold) > len(args)-1 {
+		return ErrTxInvalidPayload
+	}
+	unique := map[string]bool{}
+	for _, v := range args[1:] {
+		signer, err := DecodeAddress(v)
+		if err != nil || len(signer) != AddressLength || unique[string(signer)] {
+			return ErrTxInvalidPayload
+		}
+		unique[string(signer)] = true
+	}
+	return nil
+}
+
+// validateMultisigCallArgs checks the arguments of a system tx on behalf of a
+// multisig account: the address of the account, the payload of the system tx
+// and the base58 signatures of the other signers. The payload is validated as
+// a system tx itself.
+func validateMultisigCallArgs(tx *TxBody, ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 || len(args) > 2+MaxMultisigSigners {
+		return ErrTxInvalidPayload
+	}
+	if account, err := DecodeAddress(args[0]); err != nil || len(account) != AddressLength {
+		return ErrTxInvalidPayload
+	}
+	var inner CallInfo
+	if err := json.Unmarshal([]byte(args[1]), &inner); err != nil {
+		return ErrTxInvalidPayload
+	}
+	if inner.Name == CreateMultisig || inner.Name == MultisigCall || inner.Name == Batch {
+		return ErrTxInvalidPayload
+	}
+	for _, v := range args[2:] {
+		if _, err := base58.Decode(v); err != nil {
+			return ErrTxInvalidPayload
+		}
+	}
+	return ValidateSystemTx(&TxBody{Amount: tx.GetAmount(), Payload: []byte(args[1])})
+}
+
+// BatchOps returns the operations of the batch system tx as the system txs of
+// the sender, in the order of execution. The amount of each operation is given
+// by the operation itself, and the amount of the tx must be their sum.
+func BatchOps(tx *TxBody, ci *CallInfo) ([]*TxBody, error) {
+	if len(ci.Args) == 0 || len(ci.Args) > MaxBatchOps {
+		return nil, ErrTxInvalidPayload
+	}
+	var ops []*TxBody
+	total := new(big.Int)
+	for _, arg := range ci.Args {
+		// an operation is decoded as a generic map by the call info
+		encoded, err := json.Marshal(arg)
+		if err != nil {
+			return nil, ErrTxInvalidPayload
+		}
+		var op BatchOp
+		if err := json.Unmarshal(encoded, &op); err != nil {
+			return nil, ErrTxInvalidPayload
+		}
+		if op.Name == Batch || op.Name == MultisigCall {
+			return nil, ErrTxInvalidPayload
+		}
+		amount := new(big.Int)
+		if op.Amount != "" {
+			if _, ok := amount.SetString(op.Amount, 10); !ok || amount.Sign() < 0 {
+				return nil, ErrTxInvalidAmount
 			}
-		*/
+		}
+		total.Add(total, amount)
+		payload, err := json.Marshal(&CallInfo{Name: op.Name, Args: op.Args})
+		if err != nil {
+			return nil, ErrTxInvalidPayload
+		}
+		ops = append(ops, &TxBody{
+			Nonce:       tx.GetNonce(),
+			Account:     tx.GetAccount(),
+			Recipient:   tx.GetRecipient(),
+			Amount:      amount.Bytes(),
+			Payload:     payload,
+			Type:        tx.GetType(),
+			ChainIdHash: tx.GetChainIdHash(),
+		})
+	}
+	// the amount of the tx is checked against the balance of the sender
+	if total.Cmp(tx.GetAmountBigInt()) != 0 {
+		return nil, ErrTxInvalidAmount
+	}
+	return ops, nil
+}
+
+// validateProposeArgs checks the arguments of a proposal: the proposal
+// identifier, the base58 hash of the description, the voting period in blocks
+// and at least two options.
+func validateProposeArgs(ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) < 5 || len(args) > 3+MaxProposalOptions {
+		return ErrTxInvalidPayload
+	}
+	if !isProposalID(args[0]) {
+		return ErrTxInvalidPayload
+	}
+	if hash, err := base58.Decode(args[1]); err != nil || len(hash) != HashIDLength {
+		return ErrTxInvalidPayload
+	}
+	if _, err := strconv.ParseUint(args[2], 10, 64); err != nil {
+		return ErrTxInvalidPayload
+	}
+	return uniqueOptions(args[3:])
+}
+
+// validateVoteProposalArgs checks the arguments of a vote for a proposal: the
+// proposal identifier and the chosen options.
+func validateVoteProposalArgs(ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 || len(args) > 1+MaxProposalOptions {
+		return ErrTxInvalidPayload
+	}
+	if !isProposalID(args[0]) {
+		return ErrTxInvalidPayload
+	}
+	return uniqueOptions(args[1:])
+}
+
+// validateRegisterBPArgs checks the arguments of a BP candidate registration:
+// the peer ID, the name, the website and the commission in percent.
+func validateRegisterBPArgs(ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) != 4 || !isPeerID(args[0]) {
+		return ErrTxInvalidPayload
+	}
+	if len(args[1]) == 0 || len(args[1]) > MaxBPNameLength || len(args[2]) > MaxBPWebsiteLength {
+		return ErrTxInvalidPayload
+	}
+	if commission, err := strconv.ParseUint(args[3], 10, 32); err != nil || commission > MaxBPCommission {
+		return ErrTxInvalidPayload
+	}
+	return nil
+}
+
+func isPeerID(encoded string) bool {
+	candidate, err := base58.Decode(encoded)
+	if err != nil {
+		return false
+	}
+	_, err = peer.IDFromBytes(candidate)
+	return err == nil
+}
+
+func isProposalID(id string) bool {
+	if len(id) == 0 || len(id) > MaxProposalIDLength {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueOptions(options []string) error {
+	unique := map[string]bool{}
+	for _, option := range options {
+		if len(option) == 0 || len(option) > MaxProposalOptionLength || unique[option] {
+			return ErrTxInvalidPayload
+		}
+		unique[option] = true
+	}
+	return nil
+}
+
+func stringArgs(ci *CallInfo) ([]string, error) {
+	var args []string
+	for _, v := range ci.Args {
+		arg, ok := v.(string)
+		if !ok {
+			return nil, ErrTxInvalidPayload
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func validateSlashArgs(ci *CallInfo) error {
+	args, err := stringArgs(ci)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return ErrTxInvalidPayload
+	}
+	switch args[0] {
+	case SlashDoubleSign:
+		if len(args) != 3 {
+			return ErrTxInvalidPayload
+		}
+		for _, evidence := range args[1:] {
+			if len(DecodeB64(evidence)) == 0 {
+				return ErrTxInvalidPayload
+			}
+		}
+	case SlashDowntime:
+		if len(args) != 2 {
+			return ErrTxInvalidPayload
+		}
+		candidate, err := base58.Decode(args[1])
+		if err != nil {
+			return ErrTxInvalidPayload
+		}
+		if _, err := peer.IDFromBytes(candidate); err != nil {
+			return ErrTxInvalidPayload
+		}
 	default:
 		return ErrTxInvalidPayload
 	}
@@ -211,6 +562,57 @@ func validateNameTx(tx *TxBody) error {
 		if len(to) > AddressLength {
 			return fmt.Errorf("too long name %s", string(tx.GetPayload()))
 		}
+	case NameRenew:
+		if err := _validateNameTx(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+	case NameOffer:
+		if err := validateNameArg(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 2 && len(ci.Args) != 3 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return ErrTxInvalidAmount
+		}
+		price, ok := ci.Args[1].(string)
+		if !ok {
+			return fmt.Errorf("invalid price in %s", ci)
+		}
+		if n, ok := new(big.Int).SetString(price, 10); !ok || n.Sign() < 0 {
+			return fmt.Errorf("invalid price in %s", ci)
+		}
+		if len(ci.Args) == 3 {
+			buyer, ok := ci.Args[2].(string)
+			if !ok {
+				return fmt.Errorf("invalid buyer in %s", ci)
+			}
+			to, err := DecodeAddress(buyer)
+			if err != nil || len(to) != AddressLength {
+				return fmt.Errorf("invalid buyer in %s", ci)
+			}
+		}
+	case NameCancelOffer:
+		if err := validateNameArg(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return ErrTxInvalidAmount
+		}
+	case NameAccept:
+		if err := validateNameArg(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
 	case SetContractOwner:
 		owner, ok := ci.Args[0].(string)
 		if !ok {
@@ -227,6 +629,18 @@ func validateNameTx(tx *TxBody) error {
 }
 
 func _validateNameTx(tx *TxBody, ci *CallInfo) error {
+	if err := validateNameArg(tx, ci); err != nil {
+		return err
+	}
+	if new(big.Int).SetUint64(1000000000000000000).Cmp(tx.GetAmountBigInt()) > 0 {
+		return ErrTooSmallAmount
+	}
+	return nil
+
+}
+
+// validateNameArg checks the name given as the first argument.
+func validateNameArg(tx *TxBody, ci *CallInfo) error {
 	if len(ci.Args) < 1 {
 		return fmt.Errorf("invalid arguments in %s", ci)
 	}
@@ -241,14 +655,31 @@ func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 	if len(nameParam) != NameLength {
 		return fmt.Errorf("not supported yet")
 	}
-	if err := validateAllowedChar([]byte(nameParam)); err != nil {
-		return err
+	return validateAllowedChar([]byte(nameParam))
+}
+
+// ValidateWithFeatures checks the tx against the rules of the features active
+// at the block executing it.
+func ValidateWithFeatures(txBody *TxBody, blockNo BlockNo) error {
+	if txBody.GetExpiry() != 0 && !IsFeatureActive(FeatureTxExpiry, blockNo) {
+		return ErrTxExpiryNotActive
 	}
-	if new(big.Int).SetUint64(1000000000000000000).Cmp(tx.GetAmountBigInt()) > 0 {
-		return ErrTooSmallAmount
+	if txBody.GetNotBefore() != 0 && !IsFeatureActive(FeatureTxSchedule, blockNo) {
+		return ErrTxScheduleNotActive
+	}
+	if txBody.GetType() == TxType_FEEDELEGATION && !IsFeatureActive(FeatureFeeDelegation, blockNo) {
+		return ErrTxFeeDelegationNotActive
+	}
+	switch txBody.GetType() {
+	case TxType_NORMAL, TxType_FEEDELEGATION:
+		if IsFeatureActive(FeatureGovernanceRecipient, blockNo) {
+			switch string(txBody.GetRecipient()) {
+			case AergoSystem, AergoName, AergoBridge:
+				return ErrTxInvalidRecipient
+			}
+		}
 	}
 	return nil
-
 }
 
 func (tx *transaction) ValidateWithSenderState(senderState *State) error {
@@ -259,10 +690,18 @@ func (tx *transaction) ValidateWithSenderState(senderState *State) error {
 	balance := senderState.GetBalanceBigInt()
 	switch tx.GetBody().GetType() {
 	case TxType_NORMAL:
-		spending := new(big.Int).Add(amount, tx.GetMaxFee())
+		spending := amount
+		if !tx.IsFreeTx() {
+			spending = new(big.Int).Add(amount, tx.GetMaxFee())
+		}
 		if spending.Cmp(balance) > 0 {
 			return ErrInsufficientBalance
 		}
+	case TxType_FEEDELEGATION:
+		// the fee is paid by the contract
+		if amount.Cmp(balance) > 0 {
+			return ErrInsufficientBalance
+		}
 	case TxType_GOVERNANCE:
 		switch string(tx.GetBody().GetRecipient()) {
 		case AergoSystem:
@@ -270,11 +709,16 @@ func (tx *transaction) ValidateWithSenderState(senderState *State) error {
 			if err := json.Unmarshal(tx.GetBody().GetPayload(), &ci); err != nil {
 				return ErrTxInvalidPayload
 			}
-			if ci.Name == Stake &&
+			// the amount of a batch is spent by its operations
+			if (ci.Name == Stake || ci.Name == Batch) &&
 				amount.Cmp(balance) > 0 {
 				return ErrInsufficientBalance
 			}
 		case AergoName:
+		case AergoBridge:
+			if amount.Cmp(balance) > 0 {
+				return ErrInsufficientBalance
+			}
 		default:
 			return ErrTxInvalidRecipient
 		}
@@ -309,6 +753,14 @@ func (tx *transaction) RemoveVerifedAccount() bool {
 	return tx.SetVerifedAccount(nil)
 }
 
+func (tx *transaction) IsFreeTx() bool {
+	return tx.FreeTx
+}
+
+func (tx *transaction) SetFreeTx(free bool) {
+	tx.FreeTx = free
+}
+
 func (tx *transaction) Clone() *transaction {
 	if tx == nil {
 		return nil
@@ -335,6 +787,9 @@ func (tx *transaction) Clone() *transaction {
 }
 
 func (tx *transaction) GetMaxFee() *big.Int {
+	if fee.IsGasFeeEnabled() {
+		return fee.MaxGasFee(tx.GetBody().GetGasLimit(), tx.GetBody().GetGasPriceBigInt())
+	}
 	return fee.MaxPayloadTxFee(len(tx.GetBody().GetPayload()))
 }
 
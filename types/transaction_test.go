@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -111,6 +112,42 @@ func TestGovernanceTypeTransaction(t *testing.T) {
 	assert.Error(t, err, "invalid name length in update")
 }
 
+func TestFeeDelegationTransaction(t *testing.T) {
+	const testSender = "AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"
+	const testContract = "AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN"
+	account, err := DecodeAddress(testSender)
+	assert.NoError(t, err, "should success to decode test address")
+	contract, err := DecodeAddress(testContract)
+	assert.NoError(t, err, "should success to decode test address")
+	chainid := []byte("chainid")
+
+	transaction := NewTransaction(&Tx{
+		Body: &TxBody{
+			Account:     account,
+			Payload:     []byte(`{"Name":"inc"}`),
+			Amount:      big.NewInt(10).Bytes(),
+			Type:        TxType_FEEDELEGATION,
+			ChainIdHash: chainid,
+		},
+	})
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid)
+	assert.EqualError(t, err, ErrTxInvalidRecipient.Error(), "deploy can't delegate its fee")
+
+	transaction.GetTx().GetBody().Recipient = contract
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid)
+	assert.NoError(t, err, "should success")
+
+	// the sender only needs the amount, the contract pays the fee
+	senderState := &State{Balance: big.NewInt(10).Bytes()}
+	transaction.GetTx().GetBody().Nonce = 1
+	assert.NoError(t, transaction.ValidateWithSenderState(senderState), "should success")
+	senderState.Balance = big.NewInt(9).Bytes()
+	err = transaction.ValidateWithSenderState(senderState)
+	assert.EqualError(t, err, ErrInsufficientBalance.Error(), "insufficient amount")
+}
+
 func buildVoteBPPayloadEx(count int, err int) []byte {
 	var ci CallInfo
 	ci.Name = VoteBP
@@ -149,3 +186,56 @@ func buildVoteNumBPPayloadEx(count int, err int) []byte {
 	payload, _ := json.Marshal(ci)
 	return payload
 }
+
+func TestFreeTxBalance(t *testing.T) {
+	tx := NewTransaction(&Tx{Body: &TxBody{
+		Nonce:     1,
+		Recipient: make([]byte, AddressLength),
+		Amount:    big.NewInt(10).Bytes(),
+		Payload:   []byte("payload"),
+	}})
+	st := &State{Balance: big.NewInt(10).Bytes()}
+	assert.Equal(t, ErrInsufficientBalance, tx.ValidateWithSenderState(st), "fee is charged")
+	tx.SetFreeTx(true)
+	assert.NoError(t, tx.ValidateWithSenderState(st), "fee is waived")
+	st.Balance = big.NewInt(9).Bytes()
+	assert.Equal(t, ErrInsufficientBalance, tx.ValidateWithSenderState(st), "amount is still checked")
+}
+
+func TestTxExpiry(t *testing.T) {
+	body := &TxBody{Nonce: 1, Account: []byte("account"), Amount: []byte{1}}
+	assert.False(t, body.IsExpired(1<<40, 1<<62), "no expiry")
+
+	tx := &Tx{Body: body}
+	hash := tx.CalculateTxHash()
+
+	body.Expiry = 100
+	assert.NotEqual(t, hash, tx.CalculateTxHash(), "the expiry is in the hash")
+	assert.False(t, body.IsExpired(100, 0))
+	assert.True(t, body.IsExpired(101, 0))
+
+	body.Expiry = 1600000000
+	assert.False(t, body.IsExpired(1<<40, 1600000000*int64(1e9)+999), "within the second")
+	assert.True(t, body.IsExpired(0, 1600000001*int64(1e9)))
+
+	body.Expiry = 0
+	assert.Equal(t, hash, tx.CalculateTxHash(), "the hash without the expiry is kept")
+}
+
+func TestTxSchedule(t *testing.T) {
+	body := &TxBody{Nonce: 1, Account: []byte("account"), Amount: []byte{1}}
+	assert.False(t, body.IsPremature(0), "not scheduled")
+
+	tx := &Tx{Body: body}
+	body.Expiry = 100
+	expiring := tx.CalculateTxHash()
+
+	body.Expiry, body.NotBefore = 0, 100
+	assert.NotEqual(t, expiring, tx.CalculateTxHash(), "the schedule differs from the expiry in the hash")
+	assert.True(t, body.IsPremature(99))
+	assert.False(t, body.IsPremature(100))
+
+	body.Expiry = 99
+	tx.Hash = tx.CalculateTxHash()
+	assert.Equal(t, ErrTxInvalidSchedule, NewTransaction(tx).Validate(nil), "never executable")
+}
@@ -0,0 +1,88 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package types
+
+import (
+	"fmt"
+)
+
+// The payload types of the txs. The payload types parameter of a chain is the
+// mask of the allowed ones. The governance txs are always allowed so that the
+// stakers can change the policy.
+const (
+	PayloadTransfer uint64 = 1 << iota
+	PayloadCall
+	PayloadDeploy
+	PayloadGovernance
+
+	AllPayloadTypes = PayloadTransfer | PayloadCall | PayloadDeploy | PayloadGovernance
+)
+
+var payloadTypeNames = map[string]uint64{
+	"transfer":   PayloadTransfer,
+	"call":       PayloadCall,
+	"deploy":     PayloadDeploy,
+	"governance": PayloadGovernance,
+}
+
+// ParsePayloadTypes returns the mask of the named payload types, which always
+// allows the governance txs.
+func ParsePayloadTypes(names []string) (uint64, error) {
+	mask := PayloadGovernance
+	for _, name := range names {
+		t, ok := payloadTypeNames[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown payload type: %s", name)
+		}
+		mask |= t
+	}
+	return mask, nil
+}
+
+// PayloadTypeOf returns the payload type of the tx.
+func PayloadTypeOf(txBody *TxBody) uint64 {
+	switch {
+	case txBody.GetType() == TxType_GOVERNANCE:
+		return PayloadGovernance
+	case len(txBody.GetRecipient()) == 0:
+		return PayloadDeploy
+	case len(txBody.GetPayload()) != 0:
+		return PayloadCall
+	}
+	return PayloadTransfer
+}
+
+// TxPolicy is the policy of a chain on the payloads of the txs, which the
+// genesis sets and the stakers change by the votes on VoteMaxPayloadSize and
+// VotePayloadTypes.
+type TxPolicy struct {
+	// MaxPayloadSize is the maximum size of a payload in bytes. 0 means
+	// the default.
+	MaxPayloadSize uint64 `json:"max_payload_size,omitempty"`
+	// PayloadTypes names the allowed payload types: transfer, call, deploy
+	// and governance. Empty means all.
+	PayloadTypes []string `json:"payload_types,omitempty"`
+}
+
+// Mask returns the mask of the allowed payload types.
+func (p *TxPolicy) Mask() (uint64, error) {
+	if len(p.PayloadTypes) == 0 {
+		return AllPayloadTypes, nil
+	}
+	return ParsePayloadTypes(p.PayloadTypes)
+}
+
+// ValidateWithPolicy checks the payload of the tx against the maximum size
+// and the mask of the allowed payload types of the chain.
+func ValidateWithPolicy(txBody *TxBody, maxPayloadSize, payloadTypes uint64) error {
+	if uint64(len(txBody.GetPayload())) > maxPayloadSize {
+		return ErrTxPayloadTooLarge
+	}
+	if PayloadTypeOf(txBody)&payloadTypes == 0 {
+		return ErrTxPayloadTypeNotAllowed
+	}
+	return nil
+}
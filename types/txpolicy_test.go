@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxPolicy(t *testing.T) {
+	transfer := &TxBody{Type: TxType_NORMAL, Recipient: []byte("receiver")}
+	call := &TxBody{Type: TxType_NORMAL, Recipient: []byte("receiver"), Payload: []byte(`{"Name":"f"}`)}
+	deploy := &TxBody{Type: TxType_NORMAL, Payload: []byte("code")}
+	governance := &TxBody{Type: TxType_GOVERNANCE, Recipient: []byte(AergoSystem), Payload: []byte(`{"Name":"v1stake"}`)}
+	assert.Equal(t, PayloadTransfer, PayloadTypeOf(transfer))
+	assert.Equal(t, PayloadCall, PayloadTypeOf(call))
+	assert.Equal(t, PayloadDeploy, PayloadTypeOf(deploy))
+	assert.Equal(t, PayloadGovernance, PayloadTypeOf(governance))
+
+	policy := &TxPolicy{}
+	mask, err := policy.Mask()
+	assert.NoError(t, err)
+	assert.Equal(t, AllPayloadTypes, mask, "all allowed by default")
+
+	policy.PayloadTypes = []string{"transfer", "call"}
+	mask, err = policy.Mask()
+	assert.NoError(t, err)
+	assert.NoError(t, ValidateWithPolicy(call, 100, mask))
+	assert.NoError(t, ValidateWithPolicy(governance, 100, mask), "governance is always allowed")
+	assert.Equal(t, ErrTxPayloadTypeNotAllowed, ValidateWithPolicy(deploy, 100, mask))
+	assert.Equal(t, ErrTxPayloadTooLarge, ValidateWithPolicy(call, 5, mask))
+
+	policy.PayloadTypes = []string{"transfer", "upgrade"}
+	_, err = policy.Mask()
+	assert.Error(t, err, "unknown payload type")
+}
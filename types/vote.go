@@ -5,19 +5,68 @@ import "math/big"
 const (
 	AergoSystem = "aergo.system"
 	AergoName   = "aergo.name"
+	// AergoTreasury is the account receiving the treasury share of the fees.
+	AergoTreasury = "aergo.treasury"
+	// AergoVault is the pool account paying the voting reward.
+	AergoVault = "aergo.vault"
 
 	MaxCandidates = 30
 
+	MaxProposalOptions      = 16
+	MaxProposalIDLength     = 64
+	MaxProposalOptionLength = 64
+
+	MaxMultisigSigners = 16
+	MaxBatchOps        = 16
+
+	MaxBPNameLength    = 64
+	MaxBPWebsiteLength = 256
+	MaxBPCommission    = 100
+
 	votePrefixLen  = 2
 	VoteBP         = "v1voteBP"
 	VoteGasPrice   = "v1voteGasPrice"
 	VoteNumBP      = "v1voteNumBP"
 	VoteNamePrice  = "v1voteNamePrice"
 	VoteMinStaking = "v1voteMinStaking"
+
+	VoteMaxBlockSize    = "v1voteMaxBlockSize"
+	VoteVoterRewardRate = "v1voteVoterRewardRate"
+	VoteBPExpiry        = "v1voteBPExpiry"
+	VoteSlashDoubleSign = "v1voteSlashDoubleSign"
+	VoteSlashDowntime   = "v1voteSlashDowntime"
+	VoteParamQuorum     = "v1voteParamQuorum"
+	VoteAerPerByte      = "v1voteAerPerByte"
+	VoteBaseTxFee       = "v1voteBaseTxFee"
+	VoteFeeBurnRate     = "v1voteFeeBurnRate"
+	VoteFeeTreasuryRate = "v1voteFeeTreasuryRate"
+	VoteForkVersion     = "v1voteForkVersion"
+	VoteVotingReward    = "v1voteVotingReward"
+	VoteStorageQuota    = "v1voteStorageQuota"
+	VoteMaxPayloadSize  = "v1voteMaxPayloadSize"
+	VotePayloadTypes    = "v1votePayloadTypes"
 )
 
-//var AllVotes = [...]string{VoteBP, VoteGasPrice, VoteNumBP, VoteNamePrice, VoteMinStaking}
-var AllVotes = [...]string{VoteBP}
+// ParamVotes are the votes deciding the governance parameters.
+var ParamVotes = [...]string{VoteNamePrice, VoteMinStaking, VoteNumBP, VoteGasPrice,
+	VoteMaxBlockSize, VoteVoterRewardRate, VoteBPExpiry, VoteSlashDoubleSign, VoteSlashDowntime,
+	VoteParamQuorum, VoteAerPerByte, VoteBaseTxFee, VoteFeeBurnRate, VoteFeeTreasuryRate, VoteForkVersion,
+	VoteVotingReward, VoteStorageQuota, VoteMaxPayloadSize, VotePayloadTypes}
+
+var AllVotes = [...]string{VoteBP, VoteNamePrice, VoteMinStaking, VoteNumBP, VoteGasPrice,
+	VoteMaxBlockSize, VoteVoterRewardRate, VoteBPExpiry, VoteSlashDoubleSign, VoteSlashDowntime,
+	VoteParamQuorum, VoteAerPerByte, VoteBaseTxFee, VoteFeeBurnRate, VoteFeeTreasuryRate, VoteForkVersion,
+	VoteVotingReward, VoteStorageQuota, VoteMaxPayloadSize, VotePayloadTypes}
+
+// IsParamVote reports whether the vote decides a governance parameter.
+func IsParamVote(name string) bool {
+	for _, v := range ParamVotes {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
 
 func (vl VoteList) Len() int { return len(vl.Votes) }
 func (vl VoteList) Less(i, j int) bool {